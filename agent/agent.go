@@ -173,6 +173,11 @@ const (
 	LogSinkRateLimitBurst      = "LOGSINK_RATELIMIT_BURST"
 	LogSinkRateLimitRefill     = "LOGSINK_RATELIMIT_REFILL"
 
+	// ChangeStreamDurableConsumerMaxLag is the maximum number of change log
+	// entries that a durable change stream consumer can fall behind, before
+	// the change stream pruner evicts it.
+	ChangeStreamDurableConsumerMaxLag = "CHANGESTREAM_DURABLE_CONSUMER_MAX_LAG"
+
 	// These values are used to override various aspects of worker behaviour.
 	// They are used for debugging or testing purposes.
 
@@ -307,6 +307,7 @@ func stateServingInfo() controller.StateServingInfo {
 		ControllerAPIPort: 52,
 		SharedSecret:      "shared",
 		SystemIdentity:    "identity",
+		SecretContentKeys: []string{"content-key"},
 	}
 }
 
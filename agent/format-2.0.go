@@ -64,6 +64,7 @@ type format_2_0Serialization struct {
 	StatePort             int           `yaml:"stateport,omitempty"`
 	SharedSecret          string        `yaml:"sharedsecret,omitempty"`
 	SystemIdentity        string        `yaml:"systemidentity,omitempty"`
+	SecretContentKeys     []string      `yaml:"secretcontentkeys,omitempty"`
 	MongoMemoryProfile    string        `yaml:"mongomemoryprofile,omitempty"`
 	JujuDBSnapChannel     string        `yaml:"juju-db-snap-channel,omitempty"`
 	QueryTracingEnabled   bool          `yaml:"querytracingenabled,omitempty"`
@@ -156,6 +157,7 @@ func (formatter_2_0) unmarshal(data []byte) (*configInternal, error) {
 			StatePort:         format.StatePort,
 			SharedSecret:      format.SharedSecret,
 			SystemIdentity:    format.SystemIdentity,
+			SecretContentKeys: format.SecretContentKeys,
 		}
 		// If private key is not present, infer it from the ports in the state addresses.
 		if config.servingInfo.StatePort == 0 {
@@ -242,6 +244,7 @@ func (formatter_2_0) marshal(config *configInternal) ([]byte, error) {
 		format.StatePort = config.servingInfo.StatePort
 		format.SharedSecret = config.servingInfo.SharedSecret
 		format.SystemIdentity = config.servingInfo.SystemIdentity
+		format.SecretContentKeys = config.servingInfo.SecretContentKeys
 		format.StatePassword = config.statePassword
 	}
 	if config.apiDetails != nil {
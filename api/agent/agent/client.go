@@ -84,6 +84,7 @@ func (st *Client) StateServingInfo(ctx context.Context) (controller.StateServing
 		CAPrivateKey:      results.CAPrivateKey,
 		SharedSecret:      results.SharedSecret,
 		SystemIdentity:    results.SystemIdentity,
+		SecretContentKeys: results.SecretContentKeys,
 	}, nil
 }
 
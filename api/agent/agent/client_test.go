@@ -47,6 +47,7 @@ func (s *clientSuite) TestStateServingInfo(c *gc.C) {
 			CAPrivateKey:      "private-key",
 			SharedSecret:      "secret",
 			SystemIdentity:    "fred",
+			SecretContentKeys: []string{"content-key"},
 		}
 		return nil
 	})
@@ -63,6 +64,7 @@ func (s *clientSuite) TestStateServingInfo(c *gc.C) {
 		CAPrivateKey:      "private-key",
 		SharedSecret:      "secret",
 		SystemIdentity:    "fred",
+		SecretContentKeys: []string{"content-key"},
 	})
 }
 
@@ -169,6 +169,7 @@ func (c *Client) SecretMetadata(ctx context.Context) ([]coresecrets.SecretOwnerM
 		md := coresecrets.SecretMetadata{
 			URI:                    uri,
 			Owner:                  owner,
+			Type:                   coresecrets.SecretType(info.Type),
 			Description:            info.Description,
 			Label:                  info.Label,
 			RotatePolicy:           coresecrets.RotatePolicy(info.RotatePolicy),
@@ -395,6 +395,7 @@ func (s *SecretsSuite) TestSecretMetadata(c *gc.C) {
 			Results: []params.ListSecretResult{{
 				URI:                    uri.String(),
 				OwnerTag:               coretesting.ModelTag.String(),
+				Type:                   "tls",
 				Label:                  "label",
 				LatestRevision:         667,
 				LatestRevisionChecksum: "checksum",
@@ -427,6 +428,7 @@ func (s *SecretsSuite) TestSecretMetadata(c *gc.C) {
 	for _, info := range result {
 		c.Assert(info.Metadata.URI.String(), gc.Equals, uri.String())
 		c.Assert(info.Metadata.Owner, jc.DeepEquals, coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: coretesting.ModelTag.Id()})
+		c.Assert(info.Metadata.Type, gc.Equals, coresecrets.TypeTLS)
 		c.Assert(info.Metadata.Label, gc.Equals, "label")
 		c.Assert(info.Metadata.LatestRevision, gc.Equals, 667)
 		c.Assert(info.Metadata.LatestRevisionChecksum, gc.Equals, "checksum")
@@ -15,6 +15,7 @@ type Settings struct {
 	relationTag string
 	unitTag     string
 	settings    params.Settings
+	original    params.Settings
 	dirty       bool
 }
 
@@ -23,10 +24,15 @@ func NewSettings(relationTag, unitTag string, settings params.Settings) *Setting
 	if settings == nil {
 		settings = make(params.Settings)
 	}
+	original := make(params.Settings, len(settings))
+	for k, v := range settings {
+		original[k] = v
+	}
 	return &Settings{
 		relationTag: relationTag,
 		unitTag:     unitTag,
 		settings:    settings,
+		original:    original,
 	}
 }
 
@@ -70,3 +76,15 @@ func (s *Settings) FinalResult() params.Settings {
 func (s *Settings) IsDirty() bool {
 	return s.dirty
 }
+
+// Changes returns the keys whose values differ from those the settings were
+// read with. Deleted keys have an empty value.
+func (s *Settings) Changes() params.Settings {
+	changes := make(params.Settings)
+	for k, v := range s.settings {
+		if v != s.original[k] {
+			changes[k] = v
+		}
+	}
+	return changes
+}
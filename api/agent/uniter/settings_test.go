@@ -83,3 +83,23 @@ func (s *settingsSuite) TestDelete(c *gc.C) {
 		"abc": "123",
 	})
 }
+
+func (s *settingsSuite) TestChanges(c *gc.C) {
+	settings := uniter.NewSettings("blah", "foo", params.Settings{
+		"foo": "bar",
+		"abc": "tink",
+		"qaz": "same",
+	})
+	c.Assert(settings.Changes(), gc.HasLen, 0)
+
+	settings.Set("foo", "baz")
+	settings.Set("new", "value")
+	settings.Set("qaz", "same")
+	settings.Delete("abc")
+	settings.Delete("missing")
+	c.Assert(settings.Changes(), gc.DeepEquals, params.Settings{
+		"foo": "baz",
+		"new": "value",
+		"abc": "",
+	})
+}
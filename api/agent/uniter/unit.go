@@ -742,6 +742,52 @@ func (u *Unit) CommitHookChanges(ctx context.Context, req params.CommitHookChang
 	return apiservererrors.RestoreError(results.OneError())
 }
 
+// AddWorkloadMetrics sends the workload metrics published by the unit's charm
+// to the controller.
+func (u *Unit) AddWorkloadMetrics(ctx context.Context, metrics []params.WorkloadMetric) error {
+	if u.client.BestAPIVersion() < 22 {
+		// AddWorkloadMetrics() was introduced in UniterAPIV22.
+		return errors.NotImplementedf("AddWorkloadMetrics() (need V22+)")
+	}
+	args := params.WorkloadMetricsArgs{
+		Args: []params.WorkloadMetricsArg{{
+			Tag:     u.tag.String(),
+			Metrics: metrics,
+		}},
+	}
+	var results params.ErrorResults
+	err := u.client.facade.FacadeCall(ctx, "AddWorkloadMetrics", args, &results)
+	if err != nil {
+		return errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return apiservererrors.RestoreError(results.OneError())
+}
+
+// SetPebbleCheckStatus records the status of a Pebble check in one of the
+// unit's workload containers. The status is either "up" or "down".
+func (u *Unit) SetPebbleCheckStatus(ctx context.Context, container, check, status, message string, since time.Time) error {
+	if u.client.BestAPIVersion() < 23 {
+		// SetPebbleCheckStatuses() was introduced in UniterAPIV23.
+		return errors.NotImplementedf("SetPebbleCheckStatuses() (need V23+)")
+	}
+	args := params.SetPebbleCheckStatusArgs{
+		Args: []params.SetPebbleCheckStatusArg{{
+			Tag:       u.tag.String(),
+			Container: container,
+			Check:     check,
+			Status:    status,
+			Message:   message,
+			Since:     since,
+		}},
+	}
+	var results params.ErrorResults
+	err := u.client.facade.FacadeCall(ctx, "SetPebbleCheckStatuses", args, &results)
+	if err != nil {
+		return errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return apiservererrors.RestoreError(results.OneError())
+}
+
 // CommitHookParamsBuilder is a helper type for populating the set of
 // parameters used to perform a CommitHookChanges API call.
 type CommitHookParamsBuilder struct {
@@ -837,12 +883,19 @@ type SecretUpsertArg struct {
 	Value        secrets.SecretValue
 	ValueRef     *secrets.ValueRef
 	Checksum     string
+	// StageTimeout is only used when updating a secret.
+	StageTimeout *time.Duration
+	// RollbackOnTimeout is only used when updating a secret.
+	RollbackOnTimeout bool
+	// RollbackRevision is only used when updating a secret.
+	RollbackRevision *int
 }
 
 // SecretCreateArg holds parameters for creating a secret.
 type SecretCreateArg struct {
 	SecretUpsertArg
 	Owner secrets.Owner
+	Type  secrets.SecretType
 }
 
 // SecretUpdateArg holds parameters for updating a secret.
@@ -900,6 +953,7 @@ func (b *CommitHookParamsBuilder) AddSecretCreates(creates []SecretCreateArg) er
 			},
 			URI:      &uriStr,
 			OwnerTag: ownerTag.String(),
+			Type:     string(c.Type),
 		}
 	}
 	return nil
@@ -941,7 +995,10 @@ func (b *CommitHookParamsBuilder) AddSecretUpdates(updates []SecretUpsertArg) {
 					Checksum: u.Checksum,
 				},
 			},
-			URI: u.URI.String(),
+			URI:               u.URI.String(),
+			StageTimeout:      u.StageTimeout,
+			RollbackOnTimeout: u.RollbackOnTimeout,
+			RollbackRevision:  u.RollbackRevision,
 		}
 	}
 }
@@ -1027,6 +1027,88 @@ func (s *unitSuite) TestSetStateNotImplemented(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, errors.NotImplemented)
 }
 
+func (s *unitSuite) TestAddWorkloadMetrics(c *gc.C) {
+	sampled := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	metrics := []params.WorkloadMetric{{
+		Key:    "queue_depth",
+		Value:  12,
+		Labels: map[string]string{"queue": "inbound"},
+		Time:   sampled,
+	}}
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, gc.Equals, "Uniter")
+		c.Assert(request, gc.Equals, "AddWorkloadMetrics")
+		c.Assert(arg, gc.DeepEquals, params.WorkloadMetricsArgs{
+			Args: []params.WorkloadMetricsArg{{
+				Tag:     "unit-mysql-0",
+				Metrics: metrics,
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "biff"}}},
+		}
+		return nil
+	})
+	client := uniter.NewClient(basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 22}, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	err := unit.AddWorkloadMetrics(context.Background(), metrics)
+	c.Assert(err, gc.ErrorMatches, "biff")
+}
+
+func (s *unitSuite) TestAddWorkloadMetricsNotImplemented(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected API call %q", request)
+		return nil
+	})
+	client := uniter.NewClient(basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 21}, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	err := unit.AddWorkloadMetrics(context.Background(), nil)
+	c.Assert(err, jc.ErrorIs, errors.NotImplemented)
+}
+
+func (s *unitSuite) TestSetPebbleCheckStatus(c *gc.C) {
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, gc.Equals, "Uniter")
+		c.Assert(request, gc.Equals, "SetPebbleCheckStatuses")
+		c.Assert(arg, gc.DeepEquals, params.SetPebbleCheckStatusArgs{
+			Args: []params.SetPebbleCheckStatusArg{{
+				Tag:       "unit-mysql-0",
+				Container: "redis",
+				Check:     "online",
+				Status:    "down",
+				Message:   "connection refused",
+				Since:     since,
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "biff"}}},
+		}
+		return nil
+	})
+	client := uniter.NewClient(basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 23}, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	err := unit.SetPebbleCheckStatus(context.Background(), "redis", "online", "down", "connection refused", since)
+	c.Assert(err, gc.ErrorMatches, "biff")
+}
+
+func (s *unitSuite) TestSetPebbleCheckStatusNotImplemented(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected API call %q", request)
+		return nil
+	})
+	client := uniter.NewClient(basetesting.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 22}, names.NewUnitTag("mysql/0"))
+
+	unit := uniter.CreateUnit(client, names.NewUnitTag("mysql/0"))
+	err := unit.SetPebbleCheckStatus(context.Background(), "redis", "online", "up", "", time.Now())
+	c.Assert(err, jc.ErrorIs, errors.NotImplemented)
+}
+
 func (s *unitSuite) TestWatchInstanceData(c *gc.C) {
 	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
 		if objType == "NotifyWatcher" {
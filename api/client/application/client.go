@@ -911,12 +911,38 @@ type UnitInfo struct {
 	Leader          bool
 	Life            string
 	RelationData    []EndpointRelationData
+	Schedules       []UnitSchedule
+	DeferredHooks   []UnitDeferredHook
 
 	// The following are for CAAS models.
 	ProviderId string
 	Address    string
 }
 
+// UnitSchedule holds a schedule on which a unit's schedule hook is run.
+type UnitSchedule struct {
+	Name     string
+	Source   string
+	Cron     string
+	Interval string
+	Jitter   string
+	CatchUp  string
+	LastRun  *time.Time
+	NextRun  *time.Time
+}
+
+// UnitDeferredHook holds a hook deferred by a unit's charm.
+type UnitDeferredHook struct {
+	ID            string
+	Hook          string
+	Reason        string
+	DeferredAt    time.Time
+	Deferrals     int
+	RetryAt       *time.Time
+	Ready         bool
+	DropOnUpgrade bool
+}
+
 // RelationData holds information about a unit's relation.
 type RelationData struct {
 	InScope  bool
@@ -1003,6 +1029,30 @@ func unitInfoFromParams(in params.UnitInfoResult) UnitInfo {
 		}
 		info.RelationData = append(info.RelationData, erd)
 	}
+	for _, sched := range in.Result.Schedules {
+		info.Schedules = append(info.Schedules, UnitSchedule{
+			Name:     sched.Name,
+			Source:   sched.Source,
+			Cron:     sched.Cron,
+			Interval: sched.Interval,
+			Jitter:   sched.Jitter,
+			CatchUp:  sched.CatchUp,
+			LastRun:  sched.LastRun,
+			NextRun:  sched.NextRun,
+		})
+	}
+	for _, deferred := range in.Result.DeferredHooks {
+		info.DeferredHooks = append(info.DeferredHooks, UnitDeferredHook{
+			ID:            deferred.ID,
+			Hook:          deferred.Hook,
+			Reason:        deferred.Reason,
+			DeferredAt:    deferred.DeferredAt,
+			Deferrals:     deferred.Deferrals,
+			RetryAt:       deferred.RetryAt,
+			Ready:         deferred.Ready,
+			DropOnUpgrade: deferred.DropOnUpgrade,
+		})
+	}
 	return info
 }
 
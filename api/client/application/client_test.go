@@ -1224,6 +1224,8 @@ func (s *applicationSuite) TestUnitsInfo(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
 
+	nextRun := time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC)
+
 	args := params.Entities{
 		Entities: []params.Entity{
 			{Tag: "unit-foo-0"},
@@ -1253,6 +1255,21 @@ func (s *applicationSuite) TestUnitsInfo(c *gc.C) {
 						},
 					},
 				}},
+				Schedules: []params.UnitSchedule{{
+					Name:    "nightly",
+					Source:  "charm",
+					Cron:    "0 3 * * *",
+					CatchUp: "skip",
+					NextRun: &nextRun,
+				}},
+				DeferredHooks: []params.UnitDeferredHook{{
+					ID:         "1",
+					Hook:       "config-changed",
+					Reason:     "waiting for database",
+					DeferredAt: nextRun,
+					Deferrals:  1,
+					RetryAt:    &nextRun,
+				}},
 				ProviderId: "provider-id",
 				Address:    "192.168.1.1",
 			}},
@@ -1292,6 +1309,21 @@ func (s *applicationSuite) TestUnitsInfo(c *gc.C) {
 					},
 				},
 			}},
+			Schedules: []application.UnitSchedule{{
+				Name:    "nightly",
+				Source:  "charm",
+				Cron:    "0 3 * * *",
+				CatchUp: "skip",
+				NextRun: &nextRun,
+			}},
+			DeferredHooks: []application.UnitDeferredHook{{
+				ID:         "1",
+				Hook:       "config-changed",
+				Reason:     "waiting for database",
+				DeferredAt: nextRun,
+				Deferrals:  1,
+				RetryAt:    &nextRun,
+			}},
 			ProviderId: "provider-id",
 			Address:    "192.168.1.1",
 		},
@@ -0,0 +1,27 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// Restore requests that the controller restores the uploaded backup with
+// the given ID. The controller must have been freshly bootstrapped.
+func (c *Client) Restore(ctx context.Context, id string) error {
+	if c.facade.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("restoring backups on this controller")
+	}
+	args := params.BackupsRestoreArgs{
+		ID: id,
+	}
+	if err := c.facade.FacadeCall(ctx, "Restore", args, nil); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+type restoreSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&restoreSuite{})
+
+func (s *restoreSuite) TestRestore(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	arg := params.BackupsRestoreArgs{
+		ID: "juju-backup-upload-20250101-000000.tar.gz",
+	}
+	s.facade.EXPECT().BestAPIVersion().Return(4)
+	s.facade.EXPECT().FacadeCall(gomock.Any(), "Restore", arg, nil).Return(nil)
+
+	client := s.newClient()
+	err := client.Restore(context.Background(), "juju-backup-upload-20250101-000000.tar.gz")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *restoreSuite) TestRestoreNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.facade.EXPECT().BestAPIVersion().Return(3)
+
+	client := s.newClient()
+	err := client.Restore(context.Background(), "juju-backup-upload-20250101-000000.tar.gz")
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Upload sends a backup archive to the controller, so that it can be
+// restored. It returns the ID of the uploaded backup.
+func (c *Client) Upload(ctx context.Context, archive io.Reader) (string, error) {
+	req, err := http.NewRequest("PUT", "/backups", archive)
+	if err != nil {
+		return "", errors.Annotate(err, "cannot create upload request")
+	}
+	req.Header.Set("Content-Type", params.ContentTypeRaw)
+
+	// The returned httpClient sets the base url to /model/<uuid> if it can.
+	httpClient, err := c.st.HTTPClient()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	var result params.BackupsUploadResult
+	if err := httpClient.Do(ctx, req, &result); err != nil {
+		return "", errors.Trace(apiservererrors.RestoreError(err))
+	}
+	if result.Error != nil {
+		return "", errors.Trace(apiservererrors.RestoreError(result.Error))
+	}
+	return result.ID, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/httprequest.v1"
+)
+
+type uploadSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&uploadSuite{})
+
+func (s *uploadSuite) TestUpload(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, gc.Equals, "PUT")
+		c.Check(r.URL.String(), gc.Equals, "/backups")
+		data, err := io.ReadAll(r.Body)
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(string(data), gc.Equals, "archive")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err = w.Write([]byte(`{"id":"juju-backup-upload-20250101-000000.tar.gz"}`))
+		c.Check(err, jc.ErrorIsNil)
+	}))
+	defer srv.Close()
+	httpClient := &httprequest.Client{BaseURL: srv.URL}
+
+	s.apiCaller.EXPECT().HTTPClient().Return(httpClient, nil)
+
+	client := s.newClient()
+	id, err := client.Upload(context.Background(), strings.NewReader("archive"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(id, gc.Equals, "juju-backup-upload-20250101-000000.tar.gz")
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelchanges
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the model changes API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the model changes API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "ModelChanges", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// ReadArgs holds the arguments for reading model changes.
+type ReadArgs struct {
+	// Namespaces restricts the changes to those in the given namespaces.
+	// If empty, changes in all namespaces are returned.
+	Namespaces []string
+
+	// ResumeToken is the token returned by a previous read. If empty,
+	// reading starts from the latest change.
+	ResumeToken string
+
+	// Limit is the maximum number of changes to return.
+	Limit int
+
+	// Wait indicates that the call should block until at least one change
+	// is available, or the controller times out the wait.
+	Wait bool
+
+	// Consumer names a durable consumer, for which the controller retains
+	// the changes after the resume token of its last read.
+	Consumer string
+}
+
+// ReadChanges returns the changes made to the current model after the
+// resume token, along with the resume token to pass to the next read.
+// If the changes after the resume token have been pruned, the error
+// satisfies [params.IsCodeModelChangesPruned].
+func (c *Client) ReadChanges(ctx context.Context, args ReadArgs) ([]params.ModelChange, string, error) {
+	var result params.ModelChangesResult
+	if err := c.facade.FacadeCall(ctx, "ReadChanges", params.ModelChangesArgs{
+		Namespaces:  args.Namespaces,
+		ResumeToken: args.ResumeToken,
+		Limit:       args.Limit,
+		Wait:        args.Wait,
+		Consumer:    args.Consumer,
+	}, &result); err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, "", result.Error
+	}
+	return result.Changes, result.ResumeToken, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelchanges_test
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/modelchanges"
+	"github.com/juju/juju/rpc/params"
+)
+
+type modelChangesMockSuite struct{}
+
+var _ = gc.Suite(&modelChangesMockSuite{})
+
+func (s *modelChangesMockSuite) TestReadChanges(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModelChangesArgs{
+		Namespaces:  []string{"unit"},
+		ResumeToken: "41",
+		Wait:        true,
+		Consumer:    "audit",
+	}
+	result := new(params.ModelChangesResult)
+	results := params.ModelChangesResult{
+		Changes: []params.ModelChange{{
+			Token:     "42",
+			Namespace: "unit",
+			Entity:    "unit",
+			Type:      "create",
+			Changed:   "unit-uuid",
+		}},
+		ResumeToken: "42",
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ReadChanges", args, result).SetArg(3, results).Return(nil)
+
+	client := modelchanges.NewClientFromCaller(mockFacadeCaller)
+	changes, token, err := client.ReadChanges(context.Background(), modelchanges.ReadArgs{
+		Namespaces:  []string{"unit"},
+		ResumeToken: "41",
+		Wait:        true,
+		Consumer:    "audit",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changes, jc.DeepEquals, results.Changes)
+	c.Check(token, gc.Equals, "42")
+}
+
+func (s *modelChangesMockSuite) TestReadChangesPruned(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ModelChangesArgs{
+		ResumeToken: "1",
+	}
+	result := new(params.ModelChangesResult)
+	results := params.ModelChangesResult{
+		Error: &params.Error{
+			Message: "changes pruned from change log",
+			Code:    params.CodeModelChangesPruned,
+		},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ReadChanges", args, result).SetArg(3, results).Return(nil)
+
+	client := modelchanges.NewClientFromCaller(mockFacadeCaller)
+	_, _, err := client.ReadChanges(context.Background(), modelchanges.ReadArgs{
+		ResumeToken: "1",
+	})
+	c.Check(params.IsCodeModelChangesPruned(err), jc.IsTrue)
+}
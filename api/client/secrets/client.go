@@ -38,6 +38,7 @@ func NewClient(caller base.APICallCloser, options ...Option) *Client {
 type SecretDetails struct {
 	Metadata  secrets.SecretMetadata
 	Access    []secrets.AccessInfo
+	AccessLog []secrets.AccessRecord
 	Revisions []secrets.SecretRevisionMetadata
 	Value     secrets.SecretValue
 	Error     string
@@ -55,6 +56,21 @@ func toGrantInfo(grants []params.AccessInfo) []secrets.AccessInfo {
 	return result
 }
 
+func toAccessRecords(records []params.SecretAccessRecord) []secrets.AccessRecord {
+	if len(records) == 0 {
+		return nil
+	}
+	result := make([]secrets.AccessRecord, len(records))
+	for i, r := range records {
+		result[i] = secrets.AccessRecord{
+			Revision:   r.Revision,
+			Accessor:   r.AccessorTag,
+			AccessTime: r.AccessTime,
+		}
+	}
+	return result
+}
+
 // ListSecrets lists the available secrets.
 func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.Filter) ([]SecretDetails, error) {
 	var ownerTag names.Tag
@@ -67,7 +83,8 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 
 	}
 	arg := params.ListSecretsArgs{
-		ShowSecrets: reveal,
+		ShowSecrets:   reveal,
+		ShowAccessLog: filter.IncludeAccessLog,
 		Filter: params.SecretsFilter{
 			Revision: filter.Revision,
 			Label:    filter.Label,
@@ -91,6 +108,7 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 		details := SecretDetails{
 			Metadata: secrets.SecretMetadata{
 				Version:                r.Version,
+				Type:                   secrets.SecretType(r.Type),
 				RotatePolicy:           secrets.RotatePolicy(r.RotatePolicy),
 				NextRotateTime:         r.NextRotateTime,
 				LatestRevision:         r.LatestRevision,
@@ -101,7 +119,8 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 				CreateTime:             r.CreateTime,
 				UpdateTime:             r.UpdateTime,
 			},
-			Access: toGrantInfo(r.Access),
+			Access:    toGrantInfo(r.Access),
+			AccessLog: toAccessRecords(r.AccessLog),
 		}
 		uri, err := secrets.ParseURI(r.URI)
 		if err == nil {
@@ -124,6 +143,22 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 				UpdateTime:  r.UpdateTime,
 				ExpireTime:  r.ExpireTime,
 			}
+			if r.Stage != nil {
+				details.Revisions[i].Stage = &secrets.RevisionStage{
+					Status:            secrets.StageStatus(r.Stage.Status),
+					PreviousRevision:  r.Stage.PreviousRevision,
+					Deadline:          r.Stage.Deadline,
+					RollbackOnTimeout: r.Stage.RollbackOnTimeout,
+				}
+			}
+			if r.Rollback != nil {
+				details.Revisions[i].Rollback = &secrets.RevisionRollback{
+					RestoredFromRevision: r.Rollback.RestoredFromRevision,
+					ReplacedRevision:     r.Rollback.ReplacedRevision,
+					Time:                 r.Rollback.Time,
+				}
+			}
+			details.Revisions[i].PinnedApplications = r.PinnedApplications
 		}
 		if reveal && r.Value != nil {
 			if r.Value.Error == nil {
@@ -139,7 +174,9 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 	return result, err
 }
 
-func (c *Client) CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error) {
+func (c *Client) CreateSecret(
+	ctx context.Context, name, description string, secretType secrets.SecretType, data map[string]string,
+) (string, error) {
 	if c.BestAPIVersion() < 2 {
 		return "", errors.NotSupportedf("user secrets")
 	}
@@ -148,6 +185,7 @@ func (c *Client) CreateSecret(ctx context.Context, name, description string, dat
 		UpsertSecretArg: params.UpsertSecretArg{
 			Content: params.SecretContentParams{Data: data},
 		},
+		Type: string(secretType),
 	}
 	if name != "" {
 		arg.Label = &name
@@ -218,6 +256,41 @@ func (c *Client) UpdateSecret(
 	return nil
 }
 
+// RollbackSecret restores an existing revision of a secret
+// as its latest revision.
+func (c *Client) RollbackSecret(ctx context.Context, uri *secrets.URI, name string, revision int) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.NotSupportedf("user secrets")
+	}
+	if uri == nil && name == "" {
+		return errors.New("must specify either URI or name")
+	}
+	if uri != nil && name != "" {
+		return errors.New("must specify either URI or name but not both")
+	}
+	arg := params.UpdateUserSecretArg{
+		ExistingLabel:    name,
+		RollbackRevision: &revision,
+	}
+	if uri != nil {
+		arg.URI = uri.String()
+	}
+
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "UpdateSecrets", params.UpdateUserSecretArgs{Args: []params.UpdateUserSecretArg{arg}}, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.TranslateWellKnownError(result.Error)
+	}
+	return nil
+}
+
 func (c *Client) RemoveSecret(ctx context.Context, uri *secrets.URI, name string, revision *int) error {
 	if c.BestAPIVersion() < 2 {
 		return errors.NotSupportedf("user secrets")
@@ -309,3 +382,43 @@ func (c *Client) RevokeSecret(ctx context.Context, uri *secrets.URI, name string
 	}
 	return processErrors(results), nil
 }
+
+// PinSecretRevision pins the units of the specified consuming
+// application to a revision of a secret.
+func (c *Client) PinSecretRevision(ctx context.Context, uri *secrets.URI, name, app string, revision int) error {
+	return c.secretRevisionPin(ctx, "PinSecretRevisions", uri, name, app, revision)
+}
+
+// UnpinSecretRevision removes any pin of the units of the specified
+// consuming application to a revision of a secret.
+func (c *Client) UnpinSecretRevision(ctx context.Context, uri *secrets.URI, name, app string) error {
+	return c.secretRevisionPin(ctx, "UnpinSecretRevisions", uri, name, app, 0)
+}
+
+func (c *Client) secretRevisionPin(ctx context.Context, method string, uri *secrets.URI, name, app string, revision int) error {
+	if c.BestAPIVersion() < 2 {
+		return errors.NotSupportedf("user secrets")
+	}
+	arg := params.SecretRevisionPinArg{
+		Label:       name,
+		Application: app,
+		Revision:    revision,
+	}
+	if uri != nil {
+		arg.URI = uri.String()
+	}
+
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, method, params.SecretRevisionPinArgs{Args: []params.SecretRevisionPinArg{arg}}, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return params.TranslateWellKnownError(result.Error)
+	}
+	return nil
+}
@@ -57,6 +57,7 @@ func (s *SecretsSuite) TestListSecrets(c *gc.C) {
 			Results: []params.ListSecretResult{{
 				URI:                    uri.String(),
 				Version:                1,
+				Type:                   "tls",
 				OwnerTag:               "application-mysql",
 				RotatePolicy:           string(secrets.RotateHourly),
 				LatestExpireTime:       ptr(now),
@@ -78,6 +79,18 @@ func (s *SecretsSuite) TestListSecrets(c *gc.C) {
 					UpdateTime:  now.Add(time.Second),
 					ExpireTime:  ptr(now.Add(time.Hour)),
 					BackendName: ptr("some backend"),
+					Stage: &params.SecretRevisionStage{
+						Status:            "pending",
+						PreviousRevision:  666,
+						Deadline:          now.Add(time.Hour),
+						RollbackOnTimeout: true,
+					},
+					Rollback: &params.SecretRevisionRollback{
+						RestoredFromRevision: 664,
+						ReplacedRevision:     665,
+						Time:                 now,
+					},
+					PinnedApplications: []string{"gitlab"},
 				}},
 				Value: &params.SecretValueResult{Data: data},
 				Access: []params.AccessInfo{
@@ -100,6 +113,7 @@ func (s *SecretsSuite) TestListSecrets(c *gc.C) {
 		Metadata: secrets.SecretMetadata{
 			URI:                    uri,
 			Version:                1,
+			Type:                   secrets.TypeTLS,
 			Owner:                  owner,
 			RotatePolicy:           secrets.RotateHourly,
 			LatestRevision:         2,
@@ -122,6 +136,18 @@ func (s *SecretsSuite) TestListSecrets(c *gc.C) {
 			CreateTime:  now,
 			UpdateTime:  now.Add(time.Second),
 			ExpireTime:  ptr(now.Add(time.Hour)),
+			Stage: &secrets.RevisionStage{
+				Status:            secrets.StagePending,
+				PreviousRevision:  666,
+				Deadline:          now.Add(time.Hour),
+				RollbackOnTimeout: true,
+			},
+			Rollback: &secrets.RevisionRollback{
+				RestoredFromRevision: 664,
+				ReplacedRevision:     665,
+				Time:                 now,
+			},
+			PinnedApplications: []string{"gitlab"},
 		}},
 		Value: secrets.NewSecretValue(data),
 		Access: []secrets.AccessInfo{
@@ -153,13 +179,49 @@ func (s *SecretsSuite) TestListSecretsError(c *gc.C) {
 	c.Assert(result[0].Error, gc.Equals, "boom")
 }
 
+func (s *SecretsSuite) TestListSecretsAccessLog(c *gc.C) {
+	now := time.Now()
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(request, gc.Equals, "ListSecrets")
+		c.Check(arg, gc.DeepEquals, params.ListSecretsArgs{
+			ShowAccessLog: true,
+			Filter: params.SecretsFilter{
+				URI: ptr(uri.String()),
+			},
+		})
+		*(result.(*params.ListSecretResults)) = params.ListSecretResults{
+			Results: []params.ListSecretResult{{
+				URI:      uri.String(),
+				OwnerTag: "application-mysql",
+				AccessLog: []params.SecretAccessRecord{{
+					Revision:    1,
+					AccessorTag: "unit-gitlab-0",
+					AccessTime:  now,
+				}},
+			}},
+		}
+		return nil
+	})
+	client := apisecrets.NewClient(apiCaller)
+	result, err := client.ListSecrets(context.Background(), false, secrets.Filter{
+		URI: uri, IncludeAccessLog: true})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 1)
+	c.Assert(result[0].AccessLog, jc.DeepEquals, []secrets.AccessRecord{{
+		Revision:   1,
+		Accessor:   "unit-gitlab-0",
+		AccessTime: now,
+	}})
+}
+
 func (s *SecretsSuite) TestCreateSecretError(c *gc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
 		return nil
 	})
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 1}
 	client := apisecrets.NewClient(caller)
-	_, err := client.CreateSecret(context.Background(), "label", "this is a secret.", map[string]string{"foo": "bar"})
+	_, err := client.CreateSecret(context.Background(), "label", "this is a secret.", "", map[string]string{"foo": "bar"})
 	c.Assert(err, gc.ErrorMatches, "user secrets not supported")
 }
 
@@ -176,6 +238,7 @@ func (s *SecretsSuite) TestCreateSecret(c *gc.C) {
 						Description: ptr("this is a secret."),
 						Content:     params.SecretContentParams{Data: map[string]string{"foo": "bar"}},
 					},
+					Type: "password",
 				},
 			},
 		})
@@ -188,7 +251,7 @@ func (s *SecretsSuite) TestCreateSecret(c *gc.C) {
 	})
 	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
 	client := apisecrets.NewClient(caller)
-	result, err := client.CreateSecret(context.Background(), "my-secret", "this is a secret.", map[string]string{"foo": "bar"})
+	result, err := client.CreateSecret(context.Background(), "my-secret", "this is a secret.", secrets.TypePassword, map[string]string{"foo": "bar"})
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(result, gc.DeepEquals, uri.String())
 }
@@ -282,6 +345,79 @@ func (s *SecretsSuite) TestUpdateSecret(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *SecretsSuite) TestRollbackSecret(c *gc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, gc.Equals, "Secrets")
+		c.Assert(request, gc.Equals, "UpdateSecrets")
+		c.Assert(arg, gc.DeepEquals, params.UpdateUserSecretArgs{
+			Args: []params.UpdateUserSecretArg{{
+				URI:              uri.String(),
+				RollbackRevision: ptr(2),
+			}},
+		})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{Results: []params.ErrorResult{{}}}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	err := client.RollbackSecret(context.Background(), uri, "", 2)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *SecretsSuite) TestRollbackSecretError(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		*(result.(*params.ErrorResults)) = params.ErrorResults{Results: []params.ErrorResult{{
+			Error: &params.Error{Message: "secret revision is obsolete"},
+		}}}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	err := client.RollbackSecret(context.Background(), nil, "name", 2)
+	c.Assert(err, gc.ErrorMatches, "secret revision is obsolete")
+}
+
+func (s *SecretsSuite) TestPinSecretRevision(c *gc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, gc.Equals, "Secrets")
+		c.Assert(request, gc.Equals, "PinSecretRevisions")
+		c.Assert(arg, gc.DeepEquals, params.SecretRevisionPinArgs{
+			Args: []params.SecretRevisionPinArg{{
+				URI:         uri.String(),
+				Application: "gitlab",
+				Revision:    2,
+			}},
+		})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{Results: []params.ErrorResult{{}}}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	err := client.PinSecretRevision(context.Background(), uri, "", "gitlab", 2)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *SecretsSuite) TestUnpinSecretRevision(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(objType, gc.Equals, "Secrets")
+		c.Assert(request, gc.Equals, "UnpinSecretRevisions")
+		c.Assert(arg, gc.DeepEquals, params.SecretRevisionPinArgs{
+			Args: []params.SecretRevisionPinArg{{
+				Label:       "name",
+				Application: "gitlab",
+			}},
+		})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{Results: []params.ErrorResult{{}}}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	err := client.UnpinSecretRevision(context.Background(), nil, "name", "gitlab")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *SecretsSuite) TestRemoveSecretError(c *gc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
 		return nil
@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadmetrics
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the workload metrics API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the workload metrics API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "WorkloadMetrics", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// ApplicationMetrics returns the workload metrics published by the units of
+// the named application. Unless history is true, only the most recent sample
+// of each series is returned.
+func (c *Client) ApplicationMetrics(ctx context.Context, application string, history bool) ([]params.UnitWorkloadMetric, error) {
+	if !names.IsValidApplication(application) {
+		return nil, errors.NotValidf("application name %q", application)
+	}
+
+	var results params.WorkloadMetricsResults
+	if err := c.facade.FacadeCall(ctx, "ApplicationMetrics", params.WorkloadMetricsQueries{
+		Queries: []params.WorkloadMetricsQuery{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			History:        history,
+		}},
+	}, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, err
+	}
+	return results.Results[0].Metrics, nil
+}
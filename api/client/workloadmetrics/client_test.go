@@ -0,0 +1,85 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadmetrics_test
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/workloadmetrics"
+	"github.com/juju/juju/rpc/params"
+)
+
+type workloadMetricsMockSuite struct{}
+
+var _ = gc.Suite(&workloadMetricsMockSuite{})
+
+func (s *workloadMetricsMockSuite) TestApplicationMetrics(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.WorkloadMetricsQueries{
+		Queries: []params.WorkloadMetricsQuery{{
+			ApplicationTag: "application-app",
+			History:        true,
+		}},
+	}
+	result := new(params.WorkloadMetricsResults)
+	results := params.WorkloadMetricsResults{
+		Results: []params.WorkloadMetricsResult{{
+			Metrics: []params.UnitWorkloadMetric{{
+				Unit: "app/0",
+				Metric: params.WorkloadMetric{
+					Key:   "queue_depth",
+					Value: 42,
+					Time:  time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+				},
+			}},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApplicationMetrics", args, result).SetArg(3, results).Return(nil)
+
+	client := workloadmetrics.NewClientFromCaller(mockFacadeCaller)
+	metrics, err := client.ApplicationMetrics(context.Background(), "app", true)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metrics, jc.DeepEquals, results.Results[0].Metrics)
+}
+
+func (s *workloadMetricsMockSuite) TestApplicationMetricsError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.WorkloadMetricsQueries{
+		Queries: []params.WorkloadMetricsQuery{{
+			ApplicationTag: "application-app",
+		}},
+	}
+	result := new(params.WorkloadMetricsResults)
+	results := params.WorkloadMetricsResults{
+		Results: []params.WorkloadMetricsResult{{
+			Error: &params.Error{
+				Message: `application "app" not found`,
+				Code:    params.CodeNotFound,
+			},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApplicationMetrics", args, result).SetArg(3, results).Return(nil)
+
+	client := workloadmetrics.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.ApplicationMetrics(context.Background(), "app", false)
+	c.Check(err, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *workloadMetricsMockSuite) TestApplicationMetricsInvalidName(c *gc.C) {
+	client := workloadmetrics.NewClientFromCaller(nil)
+	_, err := client.ApplicationMetrics(context.Background(), "app/0", false)
+	c.Check(err, gc.ErrorMatches, `application name "app/0" not valid`)
+}
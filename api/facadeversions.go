@@ -28,7 +28,7 @@ var facadeVersions = facades.FacadeVersions{
 	"Annotations":                  {2},
 	"Application":                  {19, 20},
 	"ApplicationOffers":            {5},
-	"Backups":                      {3},
+	"Backups":                      {3, 4},
 	"Block":                        {2},
 	"Bundle":                       {8},
 	"CAASAgent":                    {2},
@@ -76,6 +76,7 @@ var facadeVersions = facades.FacadeVersions{
 	"MigrationMinion":              {1},
 	"MigrationStatusWatcher":       {1},
 	"MigrationTarget":              {3},
+	"ModelChanges":                 {1},
 	"ModelConfig":                  {3, 4},
 	"ModelManager":                 {9, 10},
 	"ModelSummaryWatcher":          {1},
@@ -112,11 +113,12 @@ var facadeVersions = facades.FacadeVersions{
 	"Subnets":                      {5},
 	"Undertaker":                   {1},
 	"UnitAssigner":                 {1},
-	"Uniter":                       {19, 20, 21},
+	"Uniter":                       {19, 20, 21, 22, 23},
 	"Upgrader":                     {1},
 	"UserManager":                  {3},
 	"VolumeAttachmentsWatcher":     {2},
 	"VolumeAttachmentPlansWatcher": {1},
+	"WorkloadMetrics":              {1},
 
 	// Technically we don't require this facade in the client, as it is only
 	// used by the agent. Yet the migration checks will use this to verify
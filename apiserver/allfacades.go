@@ -52,6 +52,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/imagemetadatamanager"
 	"github.com/juju/juju/apiserver/facades/client/keymanager"     // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/machinemanager" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/modelchanges"   // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/client/modelconfig"    // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelmanager"   // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/modelupgrader"
@@ -64,6 +65,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/storage"
 	"github.com/juju/juju/apiserver/facades/client/subnets"
 	"github.com/juju/juju/apiserver/facades/client/usermanager"
+	"github.com/juju/juju/apiserver/facades/client/workloadmetrics" // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/controller/agenttools"
 	"github.com/juju/juju/apiserver/facades/controller/caasapplicationprovisioner"
 	"github.com/juju/juju/apiserver/facades/controller/caasfirewaller"
@@ -214,6 +216,7 @@ func AllFacades() *facade.Registry {
 	migrationmaster.Register(registry)
 	migrationminion.Register(registry)
 	migrationtarget.Register(requiredMigrationFacadeVersions())(registry)
+	modelchanges.Register(registry)
 	modelconfig.Register(registry)
 	modelmanager.Register(registry)
 	modelupgrader.Register(registry)
@@ -244,6 +247,7 @@ func AllFacades() *facade.Registry {
 	uniter.Register(registry)
 	upgrader.Register(registry)
 	usermanager.Register(registry)
+	workloadmetrics.Register(registry)
 
 	registerWatchers(registry)
 
@@ -40,6 +40,7 @@ import (
 	handlerspubsub "github.com/juju/juju/apiserver/internal/handlers/pubsub"
 	handlersresources "github.com/juju/juju/apiserver/internal/handlers/resources"
 	resourcesdownload "github.com/juju/juju/apiserver/internal/handlers/resources/download"
+	handlersworkloadmetrics "github.com/juju/juju/apiserver/internal/handlers/workloadmetrics"
 	"github.com/juju/juju/apiserver/logsink"
 	"github.com/juju/juju/apiserver/observer"
 	"github.com/juju/juju/apiserver/stateauthenticator"
@@ -61,6 +62,7 @@ import (
 	controllermsg "github.com/juju/juju/internal/pubsub/controller"
 	"github.com/juju/juju/internal/resource"
 	resourcecharmhub "github.com/juju/juju/internal/resource/charmhub"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/trace"
 	"github.com/juju/juju/rpc"
@@ -232,6 +234,10 @@ type ServerConfig struct {
 	// DBDeleter is used to delete databases by namespace.
 	DBDeleter database.DBDeleter
 
+	// SecretContentKeys wrap the keys which encrypt secret content stored
+	// in model databases, used when migrating models.
+	SecretContentKeys envelope.KeyRing
+
 	// TracerGetter returns a tracer for the given namespace, this is used
 	// for opentelmetry tracing.
 	TracerGetter trace.TracerGetter
@@ -359,12 +365,14 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		charmhubHTTPClient:   cfg.CharmhubHTTPClient,
 		dbGetter:             cfg.DBGetter,
 		dbDeleter:            cfg.DBDeleter,
+		secretContentKeys:    cfg.SecretContentKeys,
 		domainServicesGetter: cfg.DomainServicesGetter,
 		tracerGetter:         cfg.TracerGetter,
 		objectStoreGetter:    cfg.ObjectStoreGetter,
 		machineTag:           cfg.Tag,
 		dataDir:              cfg.DataDir,
 		logDir:               cfg.LogDir,
+		getAuditConfig:       cfg.GetAuditConfig,
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -895,6 +903,15 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 	registerHandler := srv.monitoredHandler(&registerUserHandler{
 		ctxt: httpCtxt,
 	}, "register")
+	backupsHandler := srv.monitoredHandler(&backupsHandler{
+		ctxt: httpCtxt,
+	}, "backups")
+	modelWorkloadMetricsHandler := srv.monitoredHandler(workloadMetricsHandler{
+		ctx: httpCtxt,
+		handler: handlersworkloadmetrics.NewWorkloadMetricsHandler(
+			&workloadMetricsServiceGetter{ctxt: httpCtxt},
+		),
+	}, "workload-metrics")
 
 	// HTTP handler for application offer macaroon authentication.
 	addOfferAuthHandlers(srv.offerAuthCtxt, srv.mux)
@@ -945,6 +962,15 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 	}, {
 		pattern: modelRoutePrefix + "/units/:unit/resources/:resource",
 		handler: unitResourcesHandler,
+	}, {
+		pattern:    modelRoutePrefix + "/backups",
+		methods:    []string{"GET", "PUT"},
+		handler:    backupsHandler,
+		authorizer: controllerAdminAuthorizer,
+	}, {
+		pattern: modelRoutePrefix + "/workload-metrics",
+		methods: []string{"GET"},
+		handler: modelWorkloadMetricsHandler,
 	}, {
 		pattern:    "/migrate/charms/:object",
 		handler:    migrateObjectsCharmsHTTPHandler,
@@ -992,6 +1018,11 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		pattern:         "/register",
 		handler:         registerHandler,
 		unauthenticated: true,
+	}, {
+		pattern:    "/backups",
+		methods:    []string{"GET", "PUT"},
+		handler:    backupsHandler,
+		authorizer: controllerAdminAuthorizer,
 	}, {
 		pattern:    "/tools",
 		handler:    modelToolsUploadHandler,
@@ -0,0 +1,133 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/rpc/params"
+)
+
+// backupsHandler handles the download and upload of backup archives
+// through HTTPS in the API server.
+type backupsHandler struct {
+	ctxt httpContext
+}
+
+func (h *backupsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch r.Method {
+	case "GET":
+		err = h.download(w, r)
+	case "PUT":
+		err = h.upload(w, r)
+	default:
+		err = errors.MethodNotAllowedf("unsupported method: %q", r.Method)
+	}
+	if err != nil {
+		logger.Errorf(r.Context(), "%s(%s) failed: %v", r.Method, r.URL, err)
+		if err := sendError(w, err); err != nil {
+			logger.Errorf(r.Context(), "%v", err)
+		}
+	}
+}
+
+// download streams the backup archive named in the request body.
+func (h *backupsHandler) download(w http.ResponseWriter, r *http.Request) error {
+	var args params.BackupsDownloadArgs
+	if err := json.NewDecoder(r.Body).Decode(&args); err != nil {
+		return errors.NewBadRequest(err, "reading download request")
+	}
+
+	// The download is always served from the backup directory, the
+	// archive path of older clients is reduced to the archive filename.
+	filename := filepath.Base(args.ID)
+	if !corebackups.ValidFilename(filename) {
+		return errors.NewBadRequest(nil, "backup filename not valid")
+	}
+	dir, err := h.backupDir(r.Context())
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	f, err := os.Open(filepath.Join(dir, filename))
+	if os.IsNotExist(err) {
+		return errors.NotFoundf("backup %q", filename)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = f.Close() }()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	w.Header().Set("Content-Type", params.ContentTypeRaw)
+	w.Header().Set("Content-Length", strconv.FormatInt(fi.Size(), 10))
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, f); err != nil {
+		// The headers have already been sent, so the error can only be
+		// logged.
+		logger.Errorf(r.Context(), "sending backup %q: %v", filename, err)
+	}
+	return nil
+}
+
+// upload writes the backup archive in the request body to the backup
+// directory, so it can be restored.
+func (h *backupsHandler) upload(w http.ResponseWriter, r *http.Request) (err error) {
+	dir, err := h.backupDir(r.Context())
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	filename := time.Now().UTC().Format(corebackups.FilenamePrefix + "upload-20060102-150405.tar.gz")
+	archivePath := filepath.Join(dir, filename)
+	f, err := os.OpenFile(archivePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Annotate(err, "creating backup archive")
+	}
+	defer func() {
+		_ = f.Close()
+		if err != nil {
+			_ = os.Remove(archivePath)
+		}
+	}()
+
+	if _, err := io.Copy(f, r.Body); err != nil {
+		return errors.Annotate(err, "writing backup archive")
+	}
+	if err := f.Sync(); err != nil {
+		return errors.Annotate(err, "writing backup archive")
+	}
+
+	logger.Infof(r.Context(), "uploaded backup %q", filename)
+	return errors.Trace(internalhttp.SendStatusAndJSON(w, http.StatusOK, &params.BackupsUploadResult{
+		ID: filename,
+	}))
+}
+
+func (h *backupsHandler) backupDir(ctx context.Context) (string, error) {
+	domainServices, err := h.ctxt.domainServicesForRequest(ctx)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	cfg, err := domainServices.Config().ModelConfig(ctx)
+	if err != nil {
+		return "", errors.Annotate(err, "getting backup directory")
+	}
+	return corebackups.BackupDir(cfg.BackupDir()), nil
+}
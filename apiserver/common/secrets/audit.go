@@ -0,0 +1,42 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/auditlog"
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+// AuditSecretAccess records a read of the content of the specified secret
+// revision by reader in the controller audit log. It does nothing if audit
+// logging is disabled.
+//
+// Callers fail the read if an error is returned, so that no secret content
+// is handed out without an audit record. Each read appends an entry to the
+// audit log file, unbuffered, adding a file write to the cost of every read.
+func AuditSecretAccess(
+	getAuditLog func() auditlog.AuditLog,
+	when time.Time, modelUUID string, reader names.Tag, uri *coresecrets.URI, revision int,
+) error {
+	if getAuditLog == nil {
+		return nil
+	}
+	auditLog := getAuditLog()
+	if auditLog == nil {
+		return nil
+	}
+	err := auditLog.AddSecretAccess(auditlog.SecretAccess{
+		Who:       reader.String(),
+		When:      when.UTC().Format(time.RFC3339),
+		ModelUUID: modelUUID,
+		SecretURI: uri.String(),
+		Revision:  revision,
+	})
+	return errors.Annotatef(err, "recording access to secret %q in audit log", uri)
+}
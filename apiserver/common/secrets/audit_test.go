@@ -0,0 +1,81 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common/secrets"
+	"github.com/juju/juju/core/auditlog"
+	coresecrets "github.com/juju/juju/core/secrets"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type auditSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&auditSuite{})
+
+func (s *auditSuite) TestAuditSecretAccess(c *gc.C) {
+	var log fakeAuditLog
+	uri := coresecrets.NewURI()
+	when := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	err := secrets.AuditSecretAccess(
+		func() auditlog.AuditLog { return &log },
+		when, coretesting.ModelTag.Id(), names.NewUnitTag("mysql/0"), uri, 2,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	log.CheckCalls(c, []testing.StubCall{{
+		FuncName: "AddSecretAccess",
+		Args: []interface{}{auditlog.SecretAccess{
+			Who:       "unit-mysql-0",
+			When:      "2025-03-04T05:06:07Z",
+			ModelUUID: coretesting.ModelTag.Id(),
+			SecretURI: uri.String(),
+			Revision:  2,
+		}},
+	}})
+}
+
+func (s *auditSuite) TestAuditSecretAccessDisabled(c *gc.C) {
+	uri := coresecrets.NewURI()
+	err := secrets.AuditSecretAccess(nil, time.Now(), coretesting.ModelTag.Id(), names.NewUnitTag("mysql/0"), uri, 2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = secrets.AuditSecretAccess(
+		func() auditlog.AuditLog { return nil },
+		time.Now(), coretesting.ModelTag.Id(), names.NewUnitTag("mysql/0"), uri, 2,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *auditSuite) TestAuditSecretAccessError(c *gc.C) {
+	var log fakeAuditLog
+	log.SetErrors(errors.New("disk full"))
+	uri := coresecrets.NewURI()
+
+	err := secrets.AuditSecretAccess(
+		func() auditlog.AuditLog { return &log },
+		time.Now(), coretesting.ModelTag.Id(), names.NewUnitTag("mysql/0"), uri, 2,
+	)
+	c.Assert(err, gc.ErrorMatches, `recording access to secret ".*" in audit log: disk full`)
+}
+
+type fakeAuditLog struct {
+	testing.Stub
+	auditlog.AuditLog
+}
+
+func (l *fakeAuditLog) AddSecretAccess(m auditlog.SecretAccess) error {
+	l.AddCall("AddSecretAccess", m)
+	return l.NextErr()
+}
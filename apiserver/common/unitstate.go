@@ -112,6 +112,8 @@ func (u *UnitStateAPI) State(ctx context.Context, args params.Entities) (params.
 			RelationState: unitState.RelationState,
 			StorageState:  unitState.StorageState,
 			SecretState:   unitState.SecretState,
+			ScheduleState: unitState.ScheduleState,
+			DeferredState: unitState.DeferredState,
 		}
 	}
 
@@ -158,6 +160,8 @@ func (u *UnitStateAPI) SetState(ctx context.Context, args params.SetUnitStateArg
 			RelationState: arg.RelationState,
 			StorageState:  arg.StorageState,
 			SecretState:   arg.SecretState,
+			ScheduleState: arg.ScheduleState,
+			DeferredState: arg.DeferredState,
 		}); err != nil {
 			res[i].Error = apiservererrors.ServerError(err)
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/lease"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/upgrade"
+	changelogerrors "github.com/juju/juju/domain/changelog/errors"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
@@ -147,6 +148,8 @@ func ServerErrorAndStatus(err error) (*params.Error, int) {
 		status = http.StatusConflict
 	case params.CodeNotLeader:
 		status = http.StatusTemporaryRedirect
+	case params.CodeQuotaLimitExceeded:
+		status = http.StatusRequestEntityTooLarge
 	}
 	return err1, status
 }
@@ -247,6 +250,8 @@ func ServerError(err error) *params.Error {
 		code = params.CodeIncompatibleBase
 	case errors.Is(err, secreterrors.PermissionDenied):
 		code = params.CodeUnauthorized
+	case errors.Is(err, changelogerrors.ChangesPruned):
+		code = params.CodeModelChangesPruned
 	case errors.As(err, &dischargeRequiredError):
 		code = params.CodeDischargeRequired
 		info = params.DischargeRequiredErrorInfo{
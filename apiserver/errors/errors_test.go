@@ -258,7 +258,7 @@ var errorTransformTests = []struct {
 }, {
 	err:        errors.QuotaLimitExceeded,
 	code:       params.CodeQuotaLimitExceeded,
-	status:     http.StatusInternalServerError,
+	status:     http.StatusRequestEntityTooLarge,
 	helperFunc: params.IsCodeQuotaLimitExceeded,
 }, {
 	err:        errors.NotYetAvailable,
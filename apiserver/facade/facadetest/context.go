@@ -11,6 +11,7 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/auditlog"
 	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/lease"
@@ -49,6 +50,7 @@ type ModelContext struct {
 	ObjectStore_           objectstore.ObjectStore
 	ControllerObjectStore_ objectstore.ObjectStore
 	Logger_                logger.Logger
+	AuditLog_              auditlog.AuditLog
 
 	MachineTag_ names.Tag
 	DataDir_    string
@@ -239,3 +241,8 @@ func (c ModelContext) Clock() clock.Clock {
 func (c ModelContext) Logger() logger.Logger {
 	return c.Logger_
 }
+
+// AuditLog is part of the facade.ModelContext interface.
+func (c ModelContext) AuditLog() auditlog.AuditLog {
+	return c.AuditLog_
+}
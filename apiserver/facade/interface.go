@@ -13,6 +13,7 @@ import (
 	"github.com/juju/description/v8"
 	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/core/auditlog"
 	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/lease"
@@ -179,6 +180,11 @@ type ModelContext interface {
 
 	// Clock returns a instance of the clock.
 	Clock() clock.Clock
+
+	// AuditLog returns the controller audit log, or nil if audit
+	// logging is disabled. The audit log may be enabled or disabled
+	// at any time, so it should be requested each time it's needed.
+	AuditLog() auditlog.AuditLog
 }
 
 // ModelExporter defines a interface for exporting models.
@@ -29,6 +29,7 @@ import (
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/mongo"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 )
@@ -233,6 +234,19 @@ func (api *AgentAPI) StateServingInfo(ctx context.Context) (result params.StateS
 	if err != nil {
 		return params.StateServingInfo{}, errors.Trace(err)
 	}
+	if len(info.SecretContentKeys) == 0 {
+		// Controllers bootstrapped before secret content was encrypted
+		// have no secret content keys, so they are generated the first
+		// time a controller agent asks for them.
+		ring, err := envelope.GenerateKeyRing()
+		if err != nil {
+			return params.StateServingInfo{}, errors.Annotate(err, "generating secret content keys")
+		}
+		info.SecretContentKeys, err = api.st.EnsureSecretContentKeys(ring.Encode())
+		if err != nil {
+			return params.StateServingInfo{}, errors.Trace(err)
+		}
+	}
 	// ControllerAPIPort comes from the controller config.
 	config, err := api.controllerConfigService.ControllerConfig(ctx)
 	if err != nil {
@@ -248,6 +262,7 @@ func (api *AgentAPI) StateServingInfo(ctx context.Context) (result params.StateS
 		CAPrivateKey:      info.CAPrivateKey,
 		SharedSecret:      info.SharedSecret,
 		SystemIdentity:    info.SystemIdentity,
+		SecretContentKeys: info.SecretContentKeys,
 	}
 
 	return result, nil
@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/apiserver/facade/facadetest"
 	"github.com/juju/juju/apiserver/facades/agent/agent"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/credential"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
@@ -295,3 +296,46 @@ func (s *agentSuite) TestWatchAuthError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "permission denied")
 	c.Assert(s.resources.Count(), gc.Equals, 0)
 }
+
+func (s *agentSuite) TestStateServingInfoGeneratesSecretContentKeys(c *gc.C) {
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag:        names.NewMachineTag("0"),
+		Controller: true,
+	}
+
+	// A controller bootstrapped before secret content was encrypted has
+	// no secret content keys.
+	st := s.ControllerModel(c).State()
+	err := st.SetStateServingInfo(controller.StateServingInfo{
+		APIPort:      1234,
+		StatePort:    37017,
+		Cert:         "cert",
+		PrivateKey:   "key",
+		CAPrivateKey: "ca-key",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(ttesting.FakeControllerConfig(), nil).Times(2)
+
+	api, err := agent.NewAgentAPI(
+		authorizer, s.resources, st, controllerConfigService,
+		nil, nil, nil, nil, nil, nil, nil,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := api.StateServingInfo(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.SecretContentKeys, gc.HasLen, 1)
+
+	// The keys are stored, so every controller gets the same keys.
+	info, err := st.StateServingInfo()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.SecretContentKeys, jc.DeepEquals, result.SecretContentKeys)
+
+	again, err := api.StateServingInfo(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(again.SecretContentKeys, jc.DeepEquals, result.SecretContentKeys)
+}
@@ -9,7 +9,7 @@ import (
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package agent_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/agent CredentialService
+//go:generate go run go.uber.org/mock/mockgen -typed -package agent_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/agent CredentialService,ControllerConfigService
 
 func TestPackage(t *stdtesting.T) {
 	coretesting.MgoTestPackage(t)
@@ -14,6 +14,7 @@ import (
 
 	clock "github.com/juju/clock"
 	facade "github.com/juju/juju/apiserver/facade"
+	auditlog "github.com/juju/juju/core/auditlog"
 	http "github.com/juju/juju/core/http"
 	leadership "github.com/juju/juju/core/leadership"
 	lease "github.com/juju/juju/core/lease"
@@ -49,6 +50,44 @@ func (m *MockModelContext) EXPECT() *MockModelContextMockRecorder {
 	return m.recorder
 }
 
+// AuditLog mocks base method.
+func (m *MockModelContext) AuditLog() auditlog.AuditLog {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditLog")
+	ret0, _ := ret[0].(auditlog.AuditLog)
+	return ret0
+}
+
+// AuditLog indicates an expected call of AuditLog.
+func (mr *MockModelContextMockRecorder) AuditLog() *MockModelContextAuditLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditLog", reflect.TypeOf((*MockModelContext)(nil).AuditLog))
+	return &MockModelContextAuditLogCall{Call: call}
+}
+
+// MockModelContextAuditLogCall wrap *gomock.Call
+type MockModelContextAuditLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelContextAuditLogCall) Return(arg0 auditlog.AuditLog) *MockModelContextAuditLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelContextAuditLogCall) Do(f func() auditlog.AuditLog) *MockModelContextAuditLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelContextAuditLogCall) DoAndReturn(f func() auditlog.AuditLog) *MockModelContextAuditLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Auth mocks base method.
 func (m *MockModelContext) Auth() facade.Authorizer {
 	m.ctrl.T.Helper()
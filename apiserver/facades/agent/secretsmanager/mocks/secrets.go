@@ -726,6 +726,45 @@ func (c *MockSecretServiceGetSecretValueCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// IsSharedSecretValueRef mocks base method.
+func (m *MockSecretService) IsSharedSecretValueRef(ctx context.Context, ref *secrets.ValueRef) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSharedSecretValueRef", ctx, ref)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSharedSecretValueRef indicates an expected call of IsSharedSecretValueRef.
+func (mr *MockSecretServiceMockRecorder) IsSharedSecretValueRef(ctx, ref any) *MockSecretServiceIsSharedSecretValueRefCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSharedSecretValueRef", reflect.TypeOf((*MockSecretService)(nil).IsSharedSecretValueRef), ctx, ref)
+	return &MockSecretServiceIsSharedSecretValueRefCall{Call: call}
+}
+
+// MockSecretServiceIsSharedSecretValueRefCall wrap *gomock.Call
+type MockSecretServiceIsSharedSecretValueRefCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceIsSharedSecretValueRefCall) Return(arg0 bool, arg1 error) *MockSecretServiceIsSharedSecretValueRefCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceIsSharedSecretValueRefCall) Do(f func(context.Context, *secrets.ValueRef) (bool, error)) *MockSecretServiceIsSharedSecretValueRefCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceIsSharedSecretValueRefCall) DoAndReturn(f func(context.Context, *secrets.ValueRef) (bool, error)) *MockSecretServiceIsSharedSecretValueRefCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListCharmSecrets mocks base method.
 func (m *MockSecretService) ListCharmSecrets(arg0 context.Context, arg1 ...service.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
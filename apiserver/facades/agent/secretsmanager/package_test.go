@@ -13,6 +13,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/leadership"
 	coresecrets "github.com/juju/juju/core/secrets"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -64,3 +65,8 @@ func NewTestAPI(
 		logger:               loggertesting.WrapCheckLog(c),
 	}, nil
 }
+
+// SetAuditLog sets the audit log in which the facade records secret reads.
+func SetAuditLog(api *SecretsManagerAPI, log auditlog.AuditLog) {
+	api.auditLog = func() auditlog.AuditLog { return log }
+}
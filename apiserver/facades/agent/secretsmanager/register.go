@@ -93,6 +93,7 @@ func NewSecretManagerAPI(_ context.Context, ctx facade.ModelContext) (*SecretsMa
 		clock:                ctx.Clock(),
 		controllerUUID:       ctx.ControllerUUID(),
 		modelUUID:            ctx.ModelUUID().String(),
+		auditLog:             ctx.AuditLog,
 		remoteClientGetter:   remoteClientGetter,
 		crossModelState:      ctx.State().RemoteEntities(),
 		logger:               ctx.Logger().Child("secretsmanager", corelogger.SECRETS),
@@ -16,6 +16,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/internal"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
@@ -50,6 +51,10 @@ type SecretsManagerAPI struct {
 	controllerUUID       string
 	modelUUID            string
 
+	// auditLog returns the controller audit log
+	// in which secret reads are recorded.
+	auditLog func() auditlog.AuditLog
+
 	remoteClientGetter func(ctx context.Context, uri *coresecrets.URI) (CrossModelSecretsClient, error)
 
 	crossModelState CrossModelState
@@ -285,6 +290,7 @@ func (s *SecretsManagerAPI) GetSecretMetadata(ctx context.Context) (params.ListS
 		secretResult := params.ListSecretResult{
 			URI:                    md.URI.String(),
 			Version:                md.Version,
+			Type:                   string(md.Type),
 			OwnerTag:               ownerTag.String(),
 			RotatePolicy:           md.RotatePolicy.String(),
 			NextRotateTime:         md.NextRotateTime,
@@ -498,6 +504,18 @@ func (s *SecretsManagerAPI) GetSecretRevisionContentInfo(ctx context.Context, ar
 	for i, rev := range arg.Revisions {
 		// TODO(wallworld) - if pendingDelete is true, mark the revision for deletion
 		val, valueRef, err := s.secretService.GetSecretValue(ctx, uri, rev, accessor)
+		if err == nil {
+			err = commonsecrets.AuditSecretAccess(s.auditLog, s.clock.Now(), s.modelUUID, s.authTag, uri, rev)
+		}
+		if err == nil && valueRef != nil && arg.PendingDelete {
+			// Content shared with a revision restored by a rollback
+			// must be kept, so don't tell the agent where it is.
+			var shared bool
+			shared, err = s.secretService.IsSharedSecretValueRef(ctx, valueRef)
+			if shared {
+				valueRef = nil
+			}
+		}
 		if err != nil {
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
@@ -577,9 +595,15 @@ func (s *SecretsManagerAPI) getSecretContent(ctx context.Context, arg params.Get
 		ID:   s.authTag.Id(),
 	}
 	val, valueRef, err := s.secretService.GetSecretValue(ctx, uri, consumedRevision, accessor)
+	if err != nil {
+		return nil, nil, false, errors.Trace(err)
+	}
+	if err := commonsecrets.AuditSecretAccess(s.auditLog, s.clock.Now(), s.modelUUID, s.authTag, uri, consumedRevision); err != nil {
+		return nil, nil, false, errors.Trace(err)
+	}
 	content := &secrets.ContentParams{SecretValue: val, ValueRef: valueRef}
-	if err != nil || content.ValueRef == nil {
-		return content, nil, false, errors.Trace(err)
+	if content.ValueRef == nil {
+		return content, nil, false, nil
 	}
 
 	appName, _ := names.UnitApplication(unitName)
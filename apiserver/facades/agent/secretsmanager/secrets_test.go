@@ -20,6 +20,8 @@ import (
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
 	"github.com/juju/juju/apiserver/facades/agent/secretsmanager"
 	"github.com/juju/juju/apiserver/facades/agent/secretsmanager/mocks"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
 	corewatcher "github.com/juju/juju/core/watcher"
@@ -333,6 +335,7 @@ func (s *SecretsManagerSuite) TestGetSecretMetadata(c *gc.C) {
 	}}).Return([]*coresecrets.SecretMetadata{{
 		URI:                    uri,
 		Owner:                  coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+		Type:                   coresecrets.TypePassword,
 		Description:            "description",
 		Label:                  "label",
 		RotatePolicy:           coresecrets.RotateHourly,
@@ -371,6 +374,7 @@ func (s *SecretsManagerSuite) TestGetSecretMetadata(c *gc.C) {
 		Results: []params.ListSecretResult{{
 			URI:                    uri.String(),
 			OwnerTag:               "application-mariadb",
+			Type:                   "password",
 			Description:            "description",
 			Label:                  "label",
 			RotatePolicy:           coresecrets.RotateHourly.String(),
@@ -563,6 +567,85 @@ func (s *SecretsManagerSuite) TestGetSecretContentConsumerUnitAgent(c *gc.C) {
 	})
 }
 
+func (s *SecretsManagerSuite) TestGetSecretContentAudited(c *gc.C) {
+	s.authTag = names.NewUnitTag("mariadb/0")
+
+	defer s.setup(c).Finish()
+
+	var auditLog apiservertesting.FakeAuditLog
+	secretsmanager.SetAuditLog(s.facade, &auditLog)
+
+	data := map[string]string{"foo": "bar"}
+	val := coresecrets.NewSecretValue(data)
+	uri := coresecrets.NewURI()
+
+	s.secretService.EXPECT().ProcessCharmSecretConsumerLabel(gomock.Any(), "mariadb/0", uri, "").Return(uri, nil, nil)
+	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, "mariadb/0", false, false, nil).
+		Return(666, nil)
+	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(
+		val, nil, nil,
+	)
+
+	results, err := s.facade.GetSecretContentInfo(context.Background(), params.GetSecretContentArgs{
+		Args: []params.GetSecretContentArg{
+			{URI: uri.String()},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.SecretContentResults{
+		Results: []params.SecretContentResult{{
+			Content: params.SecretContentParams{Data: data},
+		}},
+	})
+	auditLog.CheckCalls(c, []testing.StubCall{{
+		FuncName: "AddSecretAccess",
+		Args: []interface{}{auditlog.SecretAccess{
+			Who:       "unit-mariadb-0",
+			When:      s.clock.Now().UTC().Format(time.RFC3339),
+			ModelUUID: coretesting.ModelTag.Id(),
+			SecretURI: uri.String(),
+			Revision:  666,
+		}},
+	}})
+}
+
+func (s *SecretsManagerSuite) TestGetSecretContentAuditFailure(c *gc.C) {
+	s.authTag = names.NewUnitTag("mariadb/0")
+
+	defer s.setup(c).Finish()
+
+	var auditLog apiservertesting.FakeAuditLog
+	auditLog.SetErrors(errors.New("disk full"))
+	secretsmanager.SetAuditLog(s.facade, &auditLog)
+
+	val := coresecrets.NewSecretValue(map[string]string{"foo": "bar"})
+	uri := coresecrets.NewURI()
+
+	s.secretService.EXPECT().ProcessCharmSecretConsumerLabel(gomock.Any(), "mariadb/0", uri, "").Return(uri, nil, nil)
+	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, "mariadb/0", false, false, nil).
+		Return(666, nil)
+	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(
+		val, nil, nil,
+	)
+
+	// The content isn't returned if the read can't be audited.
+	results, err := s.facade.GetSecretContentInfo(context.Background(), params.GetSecretContentArgs{
+		Args: []params.GetSecretContentArg{
+			{URI: uri.String()},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Content, jc.DeepEquals, params.SecretContentParams{})
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, `recording access to secret ".*" in audit log: disk full`)
+}
+
 func (s *SecretsManagerSuite) TestGetSecretContentConsumerLabelOnly(c *gc.C) {
 	defer s.setup(c).Finish()
 
@@ -1033,6 +1116,32 @@ func (s *SecretsManagerSuite) TestGetSecretRevisionContentInfo(c *gc.C) {
 	})
 }
 
+func (s *SecretsManagerSuite) TestGetSecretRevisionContentInfoPendingDeleteShared(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	valueRef := &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	}
+	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil, valueRef, nil)
+	s.secretService.EXPECT().IsSharedSecretValueRef(gomock.Any(), valueRef).Return(true, nil)
+	s.leadership.EXPECT().LeadershipCheck("mariadb", "mariadb/0").Return(s.token)
+
+	results, err := s.facade.GetSecretRevisionContentInfo(context.Background(), params.SecretRevisionArg{
+		URI:           uri.String(),
+		Revisions:     []int{666},
+		PendingDelete: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.SecretContentResults{
+		Results: []params.SecretContentResult{{}},
+	})
+}
+
 func (s *SecretsManagerSuite) TestWatchObsolete(c *gc.C) {
 	defer s.setup(c).Finish()
 
@@ -39,6 +39,7 @@ type SecretsConsumer interface {
 type SecretService interface {
 	CreateSecretURIs(ctx context.Context, count int) ([]*secrets.URI, error)
 	GetSecretValue(context.Context, *secrets.URI, int, secretservice.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error)
+	IsSharedSecretValueRef(ctx context.Context, ref *secrets.ValueRef) (bool, error)
 	ListCharmSecrets(context.Context, ...secretservice.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 	ProcessCharmSecretConsumerLabel(
 		ctx context.Context, unitName string, uri *secrets.URI, label string,
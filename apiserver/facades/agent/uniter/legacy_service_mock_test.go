@@ -19,8 +19,10 @@ import (
 	machine "github.com/juju/juju/core/machine"
 	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
+	status "github.com/juju/juju/core/status"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
+	application0 "github.com/juju/juju/domain/application"
 	charm "github.com/juju/juju/domain/application/charm"
 	config "github.com/juju/juju/environs/config"
 	charm0 "github.com/juju/juju/internal/charm"
@@ -957,6 +959,84 @@ func (c *MockApplicationServiceGetCharmLXDProfileCall) DoAndReturn(f func(contex
 	return c
 }
 
+// GetCharmLocatorByApplicationName mocks base method.
+func (m *MockApplicationService) GetCharmLocatorByApplicationName(arg0 context.Context, arg1 string) (charm.CharmLocator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmLocatorByApplicationName", arg0, arg1)
+	ret0, _ := ret[0].(charm.CharmLocator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmLocatorByApplicationName indicates an expected call of GetCharmLocatorByApplicationName.
+func (mr *MockApplicationServiceMockRecorder) GetCharmLocatorByApplicationName(arg0, arg1 any) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmLocatorByApplicationName", reflect.TypeOf((*MockApplicationService)(nil).GetCharmLocatorByApplicationName), arg0, arg1)
+	return &MockApplicationServiceGetCharmLocatorByApplicationNameCall{Call: call}
+}
+
+// MockApplicationServiceGetCharmLocatorByApplicationNameCall wrap *gomock.Call
+type MockApplicationServiceGetCharmLocatorByApplicationNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetCharmLocatorByApplicationNameCall) Return(arg0 charm.CharmLocator, arg1 error) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetCharmLocatorByApplicationNameCall) Do(f func(context.Context, string) (charm.CharmLocator, error)) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetCharmLocatorByApplicationNameCall) DoAndReturn(f func(context.Context, string) (charm.CharmLocator, error)) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCharmMetadata mocks base method.
+func (m *MockApplicationService) GetCharmMetadata(arg0 context.Context, arg1 charm.CharmLocator) (charm0.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmMetadata", arg0, arg1)
+	ret0, _ := ret[0].(charm0.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmMetadata indicates an expected call of GetCharmMetadata.
+func (mr *MockApplicationServiceMockRecorder) GetCharmMetadata(arg0, arg1 any) *MockApplicationServiceGetCharmMetadataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmMetadata", reflect.TypeOf((*MockApplicationService)(nil).GetCharmMetadata), arg0, arg1)
+	return &MockApplicationServiceGetCharmMetadataCall{Call: call}
+}
+
+// MockApplicationServiceGetCharmMetadataCall wrap *gomock.Call
+type MockApplicationServiceGetCharmMetadataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetCharmMetadataCall) Return(arg0 charm0.Meta, arg1 error) *MockApplicationServiceGetCharmMetadataCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetCharmMetadataCall) Do(f func(context.Context, charm.CharmLocator) (charm0.Meta, error)) *MockApplicationServiceGetCharmMetadataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetCharmMetadataCall) DoAndReturn(f func(context.Context, charm.CharmLocator) (charm0.Meta, error)) *MockApplicationServiceGetCharmMetadataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharmModifiedVersion mocks base method.
 func (m *MockApplicationService) GetCharmModifiedVersion(arg0 context.Context, arg1 application.ID) (int, error) {
 	m.ctrl.T.Helper()
@@ -1074,6 +1154,83 @@ func (c *MockApplicationServiceGetUnitUUIDCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// SetUnitPebbleCheckStatus mocks base method.
+func (m *MockApplicationService) SetUnitPebbleCheckStatus(arg0 context.Context, arg1 unit.Name, arg2 application0.PebbleCheckStatusInfo) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnitPebbleCheckStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUnitPebbleCheckStatus indicates an expected call of SetUnitPebbleCheckStatus.
+func (mr *MockApplicationServiceMockRecorder) SetUnitPebbleCheckStatus(arg0, arg1, arg2 any) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitPebbleCheckStatus", reflect.TypeOf((*MockApplicationService)(nil).SetUnitPebbleCheckStatus), arg0, arg1, arg2)
+	return &MockApplicationServiceSetUnitPebbleCheckStatusCall{Call: call}
+}
+
+// MockApplicationServiceSetUnitPebbleCheckStatusCall wrap *gomock.Call
+type MockApplicationServiceSetUnitPebbleCheckStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetUnitPebbleCheckStatusCall) Return(arg0 bool, arg1 error) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetUnitPebbleCheckStatusCall) Do(f func(context.Context, unit.Name, application0.PebbleCheckStatusInfo) (bool, error)) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetUnitPebbleCheckStatusCall) DoAndReturn(f func(context.Context, unit.Name, application0.PebbleCheckStatusInfo) (bool, error)) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetUnitWorkloadStatus mocks base method.
+func (m *MockApplicationService) SetUnitWorkloadStatus(arg0 context.Context, arg1 unit.Name, arg2 *status.StatusInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnitWorkloadStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUnitWorkloadStatus indicates an expected call of SetUnitWorkloadStatus.
+func (mr *MockApplicationServiceMockRecorder) SetUnitWorkloadStatus(arg0, arg1, arg2 any) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitWorkloadStatus", reflect.TypeOf((*MockApplicationService)(nil).SetUnitWorkloadStatus), arg0, arg1, arg2)
+	return &MockApplicationServiceSetUnitWorkloadStatusCall{Call: call}
+}
+
+// MockApplicationServiceSetUnitWorkloadStatusCall wrap *gomock.Call
+type MockApplicationServiceSetUnitWorkloadStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetUnitWorkloadStatusCall) Return(arg0 error) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetUnitWorkloadStatusCall) Do(f func(context.Context, unit.Name, *status.StatusInfo) error) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetUnitWorkloadStatusCall) DoAndReturn(f func(context.Context, unit.Name, *status.StatusInfo) error) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchApplication mocks base method.
 func (m *MockApplicationService) WatchApplication(arg0 context.Context, arg1 string) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -75,6 +75,7 @@ func (u *UniterAPI) createSecret(ctx context.Context, arg params.CreateSecretArg
 
 	params := secretservice.CreateCharmSecretParams{
 		Version: secrets.Version,
+		Type:    coresecrets.SecretType(arg.Type),
 		UpdateCharmSecretParams: fromUpsertParams(arg.UpsertSecretArg, secretservice.SecretAccessor{
 			Kind: secretservice.UnitAccessor,
 			ID:   authTag.Id(),
@@ -137,7 +138,8 @@ func (u *UniterAPI) updateSecret(ctx context.Context, arg params.UpdateSecretArg
 		return errors.Trace(err)
 	}
 	if arg.RotatePolicy == nil && arg.Description == nil && arg.ExpireTime == nil &&
-		arg.Label == nil && len(arg.Params) == 0 && len(arg.Content.Data) == 0 && arg.Content.ValueRef == nil {
+		arg.Label == nil && len(arg.Params) == 0 && len(arg.Content.Data) == 0 && arg.Content.ValueRef == nil &&
+		arg.RollbackRevision == nil {
 		return errors.New("at least one attribute to update must be specified")
 	}
 
@@ -145,7 +147,11 @@ func (u *UniterAPI) updateSecret(ctx context.Context, arg params.UpdateSecretArg
 		Kind: secretservice.UnitAccessor,
 		ID:   u.auth.GetAuthTag().Id(),
 	}
-	err = u.secretService.UpdateCharmSecret(ctx, uri, fromUpsertParams(arg.UpsertSecretArg, accessor))
+	p := fromUpsertParams(arg.UpsertSecretArg, accessor)
+	p.StageTimeout = arg.StageTimeout
+	p.RollbackOnTimeout = arg.RollbackOnTimeout
+	p.RollbackRevision = arg.RollbackRevision
+	err = u.secretService.UpdateCharmSecret(ctx, uri, p)
 	return errors.Trace(err)
 }
 
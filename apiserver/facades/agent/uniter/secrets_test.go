@@ -78,6 +78,7 @@ func (s *UniterSecretsSuite) TestCreateCharmSecrets(c *gc.C) {
 
 	p := secretservice.CreateCharmSecretParams{
 		Version:    secrets.Version,
+		Type:       coresecrets.TypePassword,
 		CharmOwner: secretservice.CharmSecretOwner{Kind: secretservice.ApplicationOwner, ID: "mariadb"},
 		UpdateCharmSecretParams: secretservice.UpdateCharmSecretParams{
 			Accessor: secretservice.SecretAccessor{
@@ -104,6 +105,7 @@ func (s *UniterSecretsSuite) TestCreateCharmSecrets(c *gc.C) {
 	results, err := s.facade.createSecrets(context.Background(), params.CreateSecretArgs{
 		Args: []params.CreateSecretArg{{
 			OwnerTag: "application-mariadb",
+			Type:     "password",
 			UpsertSecretArg: params.UpsertSecretArg{
 				RotatePolicy: ptr(coresecrets.RotateDaily),
 				ExpireTime:   ptr(s.clock.Now()),
@@ -237,6 +239,65 @@ func (s *UniterSecretsSuite) TestUpdateSecrets(c *gc.C) {
 	})
 }
 
+func (s *UniterSecretsSuite) TestUpdateSecretsStaged(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	data := map[string]string{"foo": "bar"}
+	p := secretservice.UpdateCharmSecretParams{
+		Accessor: secretservice.SecretAccessor{
+			Kind: secretservice.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data:              data,
+		StageTimeout:      ptr(30 * time.Minute),
+		RollbackOnTimeout: true,
+	}
+	uri := coresecrets.NewURI()
+	expectURI := *uri
+	s.secretService.EXPECT().UpdateCharmSecret(gomock.Any(), &expectURI, p).Return(nil)
+
+	results, err := s.facade.updateSecrets(context.Background(), params.UpdateSecretArgs{
+		Args: []params.UpdateSecretArg{{
+			URI: uri.String(),
+			UpsertSecretArg: params.UpsertSecretArg{
+				Content: params.SecretContentParams{Data: data},
+			},
+			StageTimeout:      ptr(30 * time.Minute),
+			RollbackOnTimeout: true,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+}
+
+func (s *UniterSecretsSuite) TestUpdateSecretsRollback(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	p := secretservice.UpdateCharmSecretParams{
+		Accessor: secretservice.SecretAccessor{
+			Kind: secretservice.UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		RollbackRevision: ptr(665),
+	}
+	uri := coresecrets.NewURI()
+	expectURI := *uri
+	s.secretService.EXPECT().UpdateCharmSecret(gomock.Any(), &expectURI, p).Return(nil)
+
+	results, err := s.facade.updateSecrets(context.Background(), params.UpdateSecretArgs{
+		Args: []params.UpdateSecretArg{{
+			URI:              uri.String(),
+			RollbackRevision: ptr(665),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+}
+
 func (s *UniterSecretsSuite) TestRemoveSecrets(c *gc.C) {
 	defer s.setup(c).Finish()
 
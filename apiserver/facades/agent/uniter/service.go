@@ -5,6 +5,7 @@ package uniter
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/controller"
@@ -15,10 +16,13 @@ import (
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/unitstate"
+	"github.com/juju/juju/domain/workloadmetrics"
 	"github.com/juju/juju/environs/config"
 	internalcharm "github.com/juju/juju/internal/charm"
 )
@@ -104,6 +108,23 @@ type ApplicationService interface {
 	// GetCharmLXDProfile returns the LXD profile along with the revision of the
 	// charm using the charm name, source and revision.
 	GetCharmLXDProfile(ctx context.Context, locator charm.CharmLocator) (internalcharm.LXDProfile, charm.Revision, error)
+
+	// GetCharmLocatorByApplicationName returns a CharmLocator by application
+	// name. It returns an error if the charm can not be found by the name.
+	GetCharmLocatorByApplicationName(ctx context.Context, name string) (charm.CharmLocator, error)
+
+	// GetCharmMetadata returns the metadata for the charm using the charm name,
+	// source and revision.
+	GetCharmMetadata(ctx context.Context, locator charm.CharmLocator) (internalcharm.Meta, error)
+
+	// SetUnitPebbleCheckStatus records the status of a Pebble check in one of
+	// the specified unit's workload containers, returning whether the check
+	// changed status.
+	SetUnitPebbleCheckStatus(ctx context.Context, unitName coreunit.Name, check application.PebbleCheckStatusInfo) (bool, error)
+
+	// SetUnitWorkloadStatus sets the workload status of the specified unit,
+	// recording it in the unit's workload status history.
+	SetUnitWorkloadStatus(ctx context.Context, unitName coreunit.Name, status *status.StatusInfo) error
 }
 
 // UnitStateService describes the ability to retrieve and persist
@@ -131,6 +152,14 @@ type PortService interface {
 	GetUnitOpenedPorts(ctx context.Context, unitUUID coreunit.UUID) (network.GroupedPortRanges, error)
 }
 
+// WorkloadMetricsService describes the ability to record the workload
+// metrics published by charms.
+type WorkloadMetricsService interface {
+	// AddUnitMetrics records the metric samples published by the named unit,
+	// removing samples older than the retention period.
+	AddUnitMetrics(ctx context.Context, unitName coreunit.Name, metrics []workloadmetrics.Metric, retention time.Duration) error
+}
+
 // NetworkService is the interface that is used to interact with the
 // network spaces/subnets.
 type NetworkService interface {
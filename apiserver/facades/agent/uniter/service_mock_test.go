@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/uniter (interfaces: ApplicationService)
+// Source: github.com/juju/juju/apiserver/facades/agent/uniter (interfaces: ApplicationService,ModelConfigService,WorkloadMetricsService,UnitStatusBackend)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package uniter -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/uniter ApplicationService
+//	mockgen -typed -package uniter -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/uniter ApplicationService,ModelConfigService,WorkloadMetricsService,UnitStatusBackend
 //
 
 // Package uniter is a generated GoMock package.
@@ -12,13 +12,18 @@ package uniter
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	application "github.com/juju/juju/core/application"
 	leadership "github.com/juju/juju/core/leadership"
 	life "github.com/juju/juju/core/life"
+	status "github.com/juju/juju/core/status"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
+	application0 "github.com/juju/juju/domain/application"
 	charm "github.com/juju/juju/domain/application/charm"
+	workloadmetrics "github.com/juju/juju/domain/workloadmetrics"
+	config "github.com/juju/juju/environs/config"
 	charm0 "github.com/juju/juju/internal/charm"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -356,6 +361,84 @@ func (c *MockApplicationServiceGetCharmLXDProfileCall) DoAndReturn(f func(contex
 	return c
 }
 
+// GetCharmLocatorByApplicationName mocks base method.
+func (m *MockApplicationService) GetCharmLocatorByApplicationName(arg0 context.Context, arg1 string) (charm.CharmLocator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmLocatorByApplicationName", arg0, arg1)
+	ret0, _ := ret[0].(charm.CharmLocator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmLocatorByApplicationName indicates an expected call of GetCharmLocatorByApplicationName.
+func (mr *MockApplicationServiceMockRecorder) GetCharmLocatorByApplicationName(arg0, arg1 any) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmLocatorByApplicationName", reflect.TypeOf((*MockApplicationService)(nil).GetCharmLocatorByApplicationName), arg0, arg1)
+	return &MockApplicationServiceGetCharmLocatorByApplicationNameCall{Call: call}
+}
+
+// MockApplicationServiceGetCharmLocatorByApplicationNameCall wrap *gomock.Call
+type MockApplicationServiceGetCharmLocatorByApplicationNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetCharmLocatorByApplicationNameCall) Return(arg0 charm.CharmLocator, arg1 error) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetCharmLocatorByApplicationNameCall) Do(f func(context.Context, string) (charm.CharmLocator, error)) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetCharmLocatorByApplicationNameCall) DoAndReturn(f func(context.Context, string) (charm.CharmLocator, error)) *MockApplicationServiceGetCharmLocatorByApplicationNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCharmMetadata mocks base method.
+func (m *MockApplicationService) GetCharmMetadata(arg0 context.Context, arg1 charm.CharmLocator) (charm0.Meta, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmMetadata", arg0, arg1)
+	ret0, _ := ret[0].(charm0.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmMetadata indicates an expected call of GetCharmMetadata.
+func (mr *MockApplicationServiceMockRecorder) GetCharmMetadata(arg0, arg1 any) *MockApplicationServiceGetCharmMetadataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmMetadata", reflect.TypeOf((*MockApplicationService)(nil).GetCharmMetadata), arg0, arg1)
+	return &MockApplicationServiceGetCharmMetadataCall{Call: call}
+}
+
+// MockApplicationServiceGetCharmMetadataCall wrap *gomock.Call
+type MockApplicationServiceGetCharmMetadataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetCharmMetadataCall) Return(arg0 charm0.Meta, arg1 error) *MockApplicationServiceGetCharmMetadataCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetCharmMetadataCall) Do(f func(context.Context, charm.CharmLocator) (charm0.Meta, error)) *MockApplicationServiceGetCharmMetadataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetCharmMetadataCall) DoAndReturn(f func(context.Context, charm.CharmLocator) (charm0.Meta, error)) *MockApplicationServiceGetCharmMetadataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharmModifiedVersion mocks base method.
 func (m *MockApplicationService) GetCharmModifiedVersion(arg0 context.Context, arg1 application.ID) (int, error) {
 	m.ctrl.T.Helper()
@@ -473,6 +556,83 @@ func (c *MockApplicationServiceGetUnitUUIDCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// SetUnitPebbleCheckStatus mocks base method.
+func (m *MockApplicationService) SetUnitPebbleCheckStatus(arg0 context.Context, arg1 unit.Name, arg2 application0.PebbleCheckStatusInfo) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnitPebbleCheckStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUnitPebbleCheckStatus indicates an expected call of SetUnitPebbleCheckStatus.
+func (mr *MockApplicationServiceMockRecorder) SetUnitPebbleCheckStatus(arg0, arg1, arg2 any) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitPebbleCheckStatus", reflect.TypeOf((*MockApplicationService)(nil).SetUnitPebbleCheckStatus), arg0, arg1, arg2)
+	return &MockApplicationServiceSetUnitPebbleCheckStatusCall{Call: call}
+}
+
+// MockApplicationServiceSetUnitPebbleCheckStatusCall wrap *gomock.Call
+type MockApplicationServiceSetUnitPebbleCheckStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetUnitPebbleCheckStatusCall) Return(arg0 bool, arg1 error) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetUnitPebbleCheckStatusCall) Do(f func(context.Context, unit.Name, application0.PebbleCheckStatusInfo) (bool, error)) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetUnitPebbleCheckStatusCall) DoAndReturn(f func(context.Context, unit.Name, application0.PebbleCheckStatusInfo) (bool, error)) *MockApplicationServiceSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetUnitWorkloadStatus mocks base method.
+func (m *MockApplicationService) SetUnitWorkloadStatus(arg0 context.Context, arg1 unit.Name, arg2 *status.StatusInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnitWorkloadStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUnitWorkloadStatus indicates an expected call of SetUnitWorkloadStatus.
+func (mr *MockApplicationServiceMockRecorder) SetUnitWorkloadStatus(arg0, arg1, arg2 any) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitWorkloadStatus", reflect.TypeOf((*MockApplicationService)(nil).SetUnitWorkloadStatus), arg0, arg1, arg2)
+	return &MockApplicationServiceSetUnitWorkloadStatusCall{Call: call}
+}
+
+// MockApplicationServiceSetUnitWorkloadStatusCall wrap *gomock.Call
+type MockApplicationServiceSetUnitWorkloadStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetUnitWorkloadStatusCall) Return(arg0 error) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetUnitWorkloadStatusCall) Do(f func(context.Context, unit.Name, *status.StatusInfo) error) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetUnitWorkloadStatusCall) DoAndReturn(f func(context.Context, unit.Name, *status.StatusInfo) error) *MockApplicationServiceSetUnitWorkloadStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchApplication mocks base method.
 func (m *MockApplicationService) WatchApplication(arg0 context.Context, arg1 string) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -511,3 +671,265 @@ func (c *MockApplicationServiceWatchApplicationCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Watch mocks base method.
+func (m *MockModelConfigService) Watch() (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch")
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockModelConfigServiceMockRecorder) Watch() *MockModelConfigServiceWatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockModelConfigService)(nil).Watch))
+	return &MockModelConfigServiceWatchCall{Call: call}
+}
+
+// MockModelConfigServiceWatchCall wrap *gomock.Call
+type MockModelConfigServiceWatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceWatchCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceWatchCall) Do(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceWatchCall) DoAndReturn(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockWorkloadMetricsService is a mock of WorkloadMetricsService interface.
+type MockWorkloadMetricsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkloadMetricsServiceMockRecorder
+}
+
+// MockWorkloadMetricsServiceMockRecorder is the mock recorder for MockWorkloadMetricsService.
+type MockWorkloadMetricsServiceMockRecorder struct {
+	mock *MockWorkloadMetricsService
+}
+
+// NewMockWorkloadMetricsService creates a new mock instance.
+func NewMockWorkloadMetricsService(ctrl *gomock.Controller) *MockWorkloadMetricsService {
+	mock := &MockWorkloadMetricsService{ctrl: ctrl}
+	mock.recorder = &MockWorkloadMetricsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkloadMetricsService) EXPECT() *MockWorkloadMetricsServiceMockRecorder {
+	return m.recorder
+}
+
+// AddUnitMetrics mocks base method.
+func (m *MockWorkloadMetricsService) AddUnitMetrics(arg0 context.Context, arg1 unit.Name, arg2 []workloadmetrics.Metric, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUnitMetrics", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUnitMetrics indicates an expected call of AddUnitMetrics.
+func (mr *MockWorkloadMetricsServiceMockRecorder) AddUnitMetrics(arg0, arg1, arg2, arg3 any) *MockWorkloadMetricsServiceAddUnitMetricsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUnitMetrics", reflect.TypeOf((*MockWorkloadMetricsService)(nil).AddUnitMetrics), arg0, arg1, arg2, arg3)
+	return &MockWorkloadMetricsServiceAddUnitMetricsCall{Call: call}
+}
+
+// MockWorkloadMetricsServiceAddUnitMetricsCall wrap *gomock.Call
+type MockWorkloadMetricsServiceAddUnitMetricsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWorkloadMetricsServiceAddUnitMetricsCall) Return(arg0 error) *MockWorkloadMetricsServiceAddUnitMetricsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWorkloadMetricsServiceAddUnitMetricsCall) Do(f func(context.Context, unit.Name, []workloadmetrics.Metric, time.Duration) error) *MockWorkloadMetricsServiceAddUnitMetricsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWorkloadMetricsServiceAddUnitMetricsCall) DoAndReturn(f func(context.Context, unit.Name, []workloadmetrics.Metric, time.Duration) error) *MockWorkloadMetricsServiceAddUnitMetricsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockUnitStatusBackend is a mock of UnitStatusBackend interface.
+type MockUnitStatusBackend struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitStatusBackendMockRecorder
+}
+
+// MockUnitStatusBackendMockRecorder is the mock recorder for MockUnitStatusBackend.
+type MockUnitStatusBackendMockRecorder struct {
+	mock *MockUnitStatusBackend
+}
+
+// NewMockUnitStatusBackend creates a new mock instance.
+func NewMockUnitStatusBackend(ctrl *gomock.Controller) *MockUnitStatusBackend {
+	mock := &MockUnitStatusBackend{ctrl: ctrl}
+	mock.recorder = &MockUnitStatusBackendMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitStatusBackend) EXPECT() *MockUnitStatusBackendMockRecorder {
+	return m.recorder
+}
+
+// SetUnitStatus mocks base method.
+func (m *MockUnitStatusBackend) SetUnitStatus(arg0 string, arg1 status.StatusInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnitStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUnitStatus indicates an expected call of SetUnitStatus.
+func (mr *MockUnitStatusBackendMockRecorder) SetUnitStatus(arg0, arg1 any) *MockUnitStatusBackendSetUnitStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitStatus", reflect.TypeOf((*MockUnitStatusBackend)(nil).SetUnitStatus), arg0, arg1)
+	return &MockUnitStatusBackendSetUnitStatusCall{Call: call}
+}
+
+// MockUnitStatusBackendSetUnitStatusCall wrap *gomock.Call
+type MockUnitStatusBackendSetUnitStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStatusBackendSetUnitStatusCall) Return(arg0 error) *MockUnitStatusBackendSetUnitStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStatusBackendSetUnitStatusCall) Do(f func(string, status.StatusInfo) error) *MockUnitStatusBackendSetUnitStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStatusBackendSetUnitStatusCall) DoAndReturn(f func(string, status.StatusInfo) error) *MockUnitStatusBackendSetUnitStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnitStatus mocks base method.
+func (m *MockUnitStatusBackend) UnitStatus(arg0 string) (status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnitStatus", arg0)
+	ret0, _ := ret[0].(status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnitStatus indicates an expected call of UnitStatus.
+func (mr *MockUnitStatusBackendMockRecorder) UnitStatus(arg0 any) *MockUnitStatusBackendUnitStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnitStatus", reflect.TypeOf((*MockUnitStatusBackend)(nil).UnitStatus), arg0)
+	return &MockUnitStatusBackendUnitStatusCall{Call: call}
+}
+
+// MockUnitStatusBackendUnitStatusCall wrap *gomock.Call
+type MockUnitStatusBackendUnitStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStatusBackendUnitStatusCall) Return(arg0 status.StatusInfo, arg1 error) *MockUnitStatusBackendUnitStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStatusBackendUnitStatusCall) Do(f func(string) (status.StatusInfo, error)) *MockUnitStatusBackendUnitStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStatusBackendUnitStatusCall) DoAndReturn(f func(string) (status.StatusInfo, error)) *MockUnitStatusBackendUnitStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
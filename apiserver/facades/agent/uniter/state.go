@@ -10,6 +10,7 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/status"
 	corewatcher "github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/state"
 )
@@ -97,6 +98,40 @@ type Unit interface {
 	StorageConstraints() (map[string]state.StorageConstraints, error)
 }
 
+// UnitStatusBackend provides access to the workload status of units as seen
+// by charms and clients.
+type UnitStatusBackend interface {
+	// UnitStatus returns the workload status of the named unit.
+	UnitStatus(unitName string) (status.StatusInfo, error)
+
+	// SetUnitStatus sets the workload status of the named unit. Unlike the
+	// statuses charms can set, it may be error.
+	SetUnitStatus(unitName string, info status.StatusInfo) error
+}
+
+type unitStatusShim struct {
+	st *state.State
+}
+
+func (s unitStatusShim) UnitStatus(unitName string) (status.StatusInfo, error) {
+	unit, err := s.st.Unit(unitName)
+	if err != nil {
+		return status.StatusInfo{}, errors.Trace(err)
+	}
+	return unit.Status()
+}
+
+func (s unitStatusShim) SetUnitStatus(unitName string, info status.StatusInfo) error {
+	unit, err := s.st.Unit(unitName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if info.Status == status.Error {
+		return unit.SetErrorStatus(info)
+	}
+	return unit.SetStatus(info)
+}
+
 type stateShim struct {
 	*state.State
 }
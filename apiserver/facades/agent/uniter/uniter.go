@@ -32,9 +32,12 @@ import (
 	"github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
+	domainapplication "github.com/juju/juju/domain/application"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/domain/unitstate"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
 	"github.com/juju/juju/internal/charm"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
@@ -79,6 +82,8 @@ type UniterAPI struct {
 	applicationService      ApplicationService
 	unitStateService        UnitStateService
 	portService             PortService
+	workloadMetricsService  WorkloadMetricsService
+	unitStatusBackend       UnitStatusBackend
 	store                   objectstore.ObjectStore
 
 	// A cloud spec can only be accessed for the model of the unit or
@@ -95,9 +100,23 @@ type UniterAPIv19 struct {
 }
 
 type UniterAPIv20 struct {
+	*UniterAPIv21
+}
+
+type UniterAPIv21 struct {
+	*UniterAPIv22
+}
+
+// AddWorkloadMetrics isn't on the v21 API.
+func (*UniterAPIv21) AddWorkloadMetrics(_, _ struct{}) {}
+
+type UniterAPIv22 struct {
 	*UniterAPI
 }
 
+// SetPebbleCheckStatuses isn't on the v22 API.
+func (*UniterAPIv22) SetPebbleCheckStatuses(_, _ struct{}) {}
+
 // EnsureDead calls EnsureDead on each given unit from state.
 // If it's Alive, nothing will happen.
 func (u *UniterAPI) EnsureDead(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
@@ -1616,7 +1635,7 @@ func (u *UniterAPI) ReadRemoteSettings(ctx context.Context, args params.Relation
 	return result, nil
 }
 
-func (u *UniterAPI) updateUnitAndApplicationSettingsOp(arg params.RelationUnitSettings, canAccess common.AuthFunc) (state.ModelOperation, error) {
+func (u *UniterAPI) updateUnitAndApplicationSettingsOp(ctx context.Context, arg params.RelationUnitSettings, canAccess common.AuthFunc) (state.ModelOperation, error) {
 	unitTag, err := names.ParseUnitTag(arg.Unit)
 	if err != nil {
 		return nil, apiservererrors.ErrPerm
@@ -1629,11 +1648,18 @@ func (u *UniterAPI) updateUnitAndApplicationSettingsOp(arg params.RelationUnitSe
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	appSettingsUpdateOp, err := u.updateApplicationSettingsOp(rel, unit, arg.ApplicationSettings)
+	var schema *charm.RelationSchema
+	if len(arg.Settings)+len(arg.ApplicationSettings) > 0 {
+		schema, err = u.relationSchema(ctx, rel, unit.ApplicationName())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	appSettingsUpdateOp, err := u.updateApplicationSettingsOp(rel, unit, arg.ApplicationSettings, schema)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	unitSettingsUpdateOp, err := u.updateUnitSettingsOp(relUnit, arg.Settings)
+	unitSettingsUpdateOp, err := u.updateUnitSettingsOp(relUnit, arg.Settings, schema)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1641,7 +1667,30 @@ func (u *UniterAPI) updateUnitAndApplicationSettingsOp(arg params.RelationUnitSe
 	return state.ComposeModelOperations(appSettingsUpdateOp, unitSettingsUpdateOp), nil
 }
 
-func (u *UniterAPI) updateUnitSettingsOp(relUnit *state.RelationUnit, newSettings params.Settings) (state.ModelOperation, error) {
+// relationSchema returns the schema declared by the charm of the named
+// application for its endpoint in the relation, or nil if the charm doesn't
+// declare one.
+func (u *UniterAPI) relationSchema(ctx context.Context, rel *state.Relation, appName string) (*charm.RelationSchema, error) {
+	ep, err := rel.Endpoint(appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	locator, err := u.applicationService.GetCharmLocatorByApplicationName(ctx, appName)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %s", appName)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta, err := u.applicationService.GetCharmMetadata(ctx, locator)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Implicit endpoints, such as juju-info, are not in the metadata and
+	// never have a schema.
+	return meta.CombinedRelations()[ep.Name].Schema, nil
+}
+
+func (u *UniterAPI) updateUnitSettingsOp(relUnit *state.RelationUnit, newSettings params.Settings, schema *charm.RelationSchema) (state.ModelOperation, error) {
 	if len(newSettings) == 0 {
 		return nil, nil
 	}
@@ -1656,13 +1705,29 @@ func (u *UniterAPI) updateUnitSettingsOp(relUnit *state.RelationUnit, newSetting
 			settings.Set(k, v)
 		}
 	}
+	if schema != nil {
+		if err := schema.ValidateUnitSettings(charmUnitSettings(settings.Map())); err != nil {
+			return nil, errors.Annotatef(err, "invalid unit settings for relation %q endpoint %q",
+				relUnit.Relation(), relUnit.Endpoint().Name)
+		}
+	}
 	return settings.WriteOperation(), nil
 }
 
-func (u *UniterAPI) updateApplicationSettingsOp(rel *state.Relation, unit *state.Unit, settings params.Settings) (state.ModelOperation, error) {
+func (u *UniterAPI) updateApplicationSettingsOp(rel *state.Relation, unit *state.Unit, settings params.Settings, schema *charm.RelationSchema) (state.ModelOperation, error) {
 	if len(settings) == 0 {
 		return nil, nil
 	}
+	if schema != nil && schema.Application != nil {
+		current, err := rel.ApplicationSettings(unit.ApplicationName())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err := schema.ValidateApplicationSettings(relationSettingsStrings(current, settings)); err != nil {
+			ep, _ := rel.Endpoint(unit.ApplicationName())
+			return nil, errors.Annotatef(err, "invalid application settings for relation %q endpoint %q", rel, ep.Name)
+		}
+	}
 	token := u.leadershipChecker.LeadershipCheck(unit.ApplicationName(), unit.Name())
 	settingsMap := make(map[string]interface{}, len(settings))
 	for k, v := range settings {
@@ -1672,6 +1737,41 @@ func (u *UniterAPI) updateApplicationSettingsOp(rel *state.Relation, unit *state
 	return rel.UpdateApplicationSettingsOperation(unit.ApplicationName(), token, settingsMap)
 }
 
+// jujuManagedUnitSettings holds the unit relation settings which are
+// written by Juju rather than by the charm.
+var jujuManagedUnitSettings = []string{"private-address", "ingress-address", "egress-subnets"}
+
+// charmUnitSettings returns the unit relation settings written by the
+// charm. The addresses Juju writes to each unit's databag aren't the
+// charm's to declare, so they aren't validated against its schema.
+func charmUnitSettings(current map[string]interface{}) map[string]string {
+	result := relationSettingsStrings(current, nil)
+	for _, k := range jujuManagedUnitSettings {
+		delete(result, k)
+	}
+	return result
+}
+
+// relationSettingsStrings returns the relation settings which result from
+// applying the changes to the current settings. As with relation-set, an
+// empty value removes the setting.
+func relationSettingsStrings(current map[string]interface{}, changes params.Settings) map[string]string {
+	result := make(map[string]string, len(current)+len(changes))
+	for k, v := range current {
+		if s, ok := v.(string); ok {
+			result[k] = s
+		}
+	}
+	for k, v := range changes {
+		if v == "" {
+			delete(result, k)
+		} else {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 // WatchRelationUnits returns a RelationUnitsWatcher for observing
 // changes to every unit in the supplied relation that is visible to
 // the supplied unit. See also state/watcher.go:RelationUnit.Watch().
@@ -2636,6 +2736,172 @@ func (u *UniterAPI) CommitHookChanges(ctx context.Context, args params.CommitHoo
 	return params.ErrorResults{Results: res}, nil
 }
 
+// AddWorkloadMetrics records the workload metrics published by the charms of
+// the given units. Samples older than the model's workload metrics retention
+// period are removed.
+func (u *UniterAPI) AddWorkloadMetrics(ctx context.Context, args params.WorkloadMetricsArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	if len(args.Args) == 0 {
+		return result, nil
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	cfg, err := u.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	retention := cfg.WorkloadMetricsRetention()
+
+	for i, arg := range args.Args {
+		tag, err := names.ParseUnitTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		metrics := make([]workloadmetrics.Metric, len(arg.Metrics))
+		for j, m := range arg.Metrics {
+			metrics[j] = workloadmetrics.Metric{
+				Key:    m.Key,
+				Value:  m.Value,
+				Labels: m.Labels,
+				Time:   m.Time,
+			}
+		}
+		err = u.workloadMetricsService.AddUnitMetrics(ctx, coreunit.Name(tag.Id()), metrics, retention)
+		if errors.Is(err, workloadmetricserrors.UnitNotFound) {
+			err = errors.NotFoundf("unit %q", tag.Id())
+		} else if errors.Is(err, workloadmetricserrors.MetricNotValid) {
+			err = errors.NewNotValid(err, "")
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// SetPebbleCheckStatuses records the statuses of Pebble checks in the
+// workload containers of the given units. Checks named by the model's
+// pebble-critical-checks configuration are recorded as critical: while one is
+// down, the unit's workload status is set to error, and it's restored when
+// the check is up again.
+func (u *UniterAPI) SetPebbleCheckStatuses(ctx context.Context, args params.SetPebbleCheckStatusArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	if len(args.Args) == 0 {
+		return result, nil
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	cfg, err := u.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	criticalChecks := cfg.PebbleCriticalChecks()
+
+	for i, arg := range args.Args {
+		tag, err := names.ParseUnitTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		checkStatus, err := domainapplication.ParsePebbleCheckStatus(arg.Status)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(errors.NewNotValid(err, ""))
+			continue
+		}
+		appName, _ := names.UnitApplication(tag.Id())
+		critical := criticalChecks.IsCritical(appName, arg.Check)
+		changed, err := u.applicationService.SetUnitPebbleCheckStatus(ctx, coreunit.Name(tag.Id()), domainapplication.PebbleCheckStatusInfo{
+			ContainerName: arg.Container,
+			CheckName:     arg.Check,
+			Status:        checkStatus,
+			Message:       arg.Message,
+			Critical:      critical,
+			Since:         arg.Since,
+		})
+		if errors.Is(err, applicationerrors.UnitNotFound) {
+			err = errors.NotFoundf("unit %q", tag.Id())
+		} else if errors.Is(err, applicationerrors.PebbleCheckNotValid) {
+			err = errors.NewNotValid(err, "")
+		} else if err == nil && changed && critical {
+			err = u.setCriticalPebbleCheckWorkloadStatus(ctx, coreunit.Name(tag.Id()), arg, checkStatus)
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+// setCriticalPebbleCheckWorkloadStatus sets the workload status of the unit
+// to error when the critical Pebble check goes down, keeping the status it
+// replaces in the status data. When the check comes back up, the replaced
+// status is restored, unless the workload status has changed since.
+func (u *UniterAPI) setCriticalPebbleCheckWorkloadStatus(
+	ctx context.Context, unitName coreunit.Name, arg params.SetPebbleCheckStatusArg, checkStatus domainapplication.PebbleCheckStatusType,
+) error {
+	current, err := u.unitStatusBackend.UnitStatus(unitName.String())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	since := arg.Since
+	if since.IsZero() {
+		since = u.clock.Now()
+	}
+
+	var workloadStatus status.StatusInfo
+	switch checkStatus {
+	case domainapplication.PebbleCheckStatusDown:
+		if current.Status == status.Error {
+			// Either a hook or another critical check has already failed.
+			return nil
+		}
+		message := fmt.Sprintf("container %q check %q failed", arg.Container, arg.Check)
+		if arg.Message != "" {
+			message += ": " + arg.Message
+		}
+		workloadStatus = status.StatusInfo{
+			Status:  status.Error,
+			Message: message,
+			Data: map[string]interface{}{
+				"pebble-container": arg.Container,
+				"pebble-check":     arg.Check,
+				"previous-status":  current.Status.String(),
+				"previous-message": current.Message,
+			},
+			Since: &since,
+		}
+	case domainapplication.PebbleCheckStatusUp:
+		if current.Status != status.Error ||
+			current.Data["pebble-container"] != arg.Container || current.Data["pebble-check"] != arg.Check {
+			return nil
+		}
+		previousStatus, _ := current.Data["previous-status"].(string)
+		previousMessage, _ := current.Data["previous-message"].(string)
+		workloadStatus = status.StatusInfo{
+			Status:  status.Status(previousStatus),
+			Message: previousMessage,
+			Since:   &since,
+		}
+	default:
+		return nil
+	}
+
+	if err := u.unitStatusBackend.SetUnitStatus(unitName.String(), workloadStatus); err != nil {
+		return errors.Annotatef(err, "setting workload status of unit %q", unitName)
+	}
+	if err := u.applicationService.SetUnitWorkloadStatus(ctx, unitName, &workloadStatus); err != nil {
+		return errors.Annotatef(err, "recording workload status of unit %q", unitName)
+	}
+	return nil
+}
+
 func (u *UniterAPI) commitHookChangesForOneUnit(ctx context.Context, unitTag names.UnitTag, changes params.CommitHookChangesArg, canAccessUnit, canAccessApp common.AuthFunc) error {
 	unit, err := u.getUnit(unitTag)
 	if err != nil {
@@ -2657,7 +2923,7 @@ func (u *UniterAPI) commitHookChangesForOneUnit(ctx context.Context, unitTag nam
 		if rus.Unit != changes.Tag {
 			return apiservererrors.ErrPerm
 		}
-		modelOp, err := u.updateUnitAndApplicationSettingsOp(rus, canAccessUnit)
+		modelOp, err := u.updateUnitAndApplicationSettingsOp(ctx, rus, canAccessUnit)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -71,6 +71,7 @@ func (s *uniterAPIErrorSuite) TestGetStorageStateError(c *gc.C) {
 		applicationService,
 		domainServices.UnitState(),
 		domainServices.Port(),
+		domainServices.WorkloadMetrics(),
 	)
 	c.Assert(err, gc.ErrorMatches, "kaboom")
 }
@@ -58,6 +58,7 @@ func (s *cloudSpecUniterSuite) TestGetCloudSpecReturnsSpecWhenTrusted(c *gc.C) {
 		applicationService,
 		domainServices.UnitState(),
 		domainServices.Port(),
+		domainServices.WorkloadMetrics(),
 	)
 	c.Assert(err, jc.ErrorIsNil)
 	result, err := uniterAPI.CloudSpec(context.Background())
@@ -98,6 +99,7 @@ func (s *cloudSpecUniterSuite) TestCloudAPIVersion(c *gc.C) {
 		applicationService,
 		domainServices.UnitState(),
 		domainServices.Port(),
+		domainServices.WorkloadMetrics(),
 	)
 	c.Assert(err, jc.ErrorIsNil)
 	uniter.SetNewContainerBrokerFunc(uniterAPI, func(context.Context, environs.OpenParams, environs.CredentialInvalidator) (caas.Broker, error) {
@@ -5,21 +5,36 @@ package uniter
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	domainapplication "github.com/juju/juju/domain/application"
 	domaincharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/charm"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
 type uniterSuite struct {
 	testing.IsolationSuite
 
-	applicationService *MockApplicationService
+	applicationService     *MockApplicationService
+	modelConfigService     *MockModelConfigService
+	workloadMetricsService *MockWorkloadMetricsService
+	unitStatusBackend      *MockUnitStatusBackend
 
 	uniter *UniterAPI
 }
@@ -114,13 +129,257 @@ func (s *uniterSuite) TestLeadershipSettings(c *gc.C) {
 	s.uniter.WatchLeadershipSettings(context.Background(), struct{}{}, struct{}{})
 }
 
+func (s *uniterSuite) TestRelationSettingsStrings(c *gc.C) {
+	settings := relationSettingsStrings(map[string]interface{}{
+		"host": "10.0.0.1",
+		"port": "80",
+	}, params.Settings{
+		"port": "",
+		"tls":  "true",
+	})
+	c.Check(settings, jc.DeepEquals, map[string]string{
+		"host": "10.0.0.1",
+		"tls":  "true",
+	})
+}
+
+func (s *uniterSuite) TestRelationSettingsValidatedAgainstSchema(c *gc.C) {
+	schema := &charm.RelationSchema{
+		Application: map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"database"},
+		},
+	}
+	current := map[string]interface{}{"database": "wordpress"}
+
+	err := schema.ValidateApplicationSettings(relationSettingsStrings(current, params.Settings{"user": "admin"}))
+	c.Check(err, jc.ErrorIsNil)
+
+	err = schema.ValidateApplicationSettings(relationSettingsStrings(current, params.Settings{"database": ""}))
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+	c.Check(err, gc.ErrorMatches, `validation failed: \(root\) : "database" property is missing and required, .*`)
+}
+
+func (s *uniterSuite) TestUnitSettingsSkipJujuManagedKeys(c *gc.C) {
+	schema := &charm.RelationSchema{
+		Unit: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"port": map[string]interface{}{"type": "integer"},
+			},
+			"additionalProperties": false,
+		},
+	}
+	current := map[string]interface{}{
+		"port":            "3306",
+		"private-address": "10.0.0.1",
+		"ingress-address": "10.0.0.1",
+		"egress-subnets":  "10.0.0.1/32",
+	}
+
+	settings := charmUnitSettings(current)
+	c.Check(settings, jc.DeepEquals, map[string]string{"port": "3306"})
+	c.Check(schema.ValidateUnitSettings(settings), jc.ErrorIsNil)
+}
+
+func (s *uniterSuite) TestAddWorkloadMetrics(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"workload-metrics-retention": "1h",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	sampled := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.workloadMetricsService.EXPECT().AddUnitMetrics(gomock.Any(), coreunit.Name("app/0"), []workloadmetrics.Metric{{
+		Key:    "queue_depth",
+		Value:  12,
+		Labels: map[string]string{"queue": "inbound"},
+		Time:   sampled,
+	}}, time.Hour).Return(nil)
+	s.workloadMetricsService.EXPECT().AddUnitMetrics(gomock.Any(), coreunit.Name("app/0"), []workloadmetrics.Metric{{
+		Key:  "queue-depth",
+		Time: sampled,
+	}}, time.Hour).Return(errors.Annotate(workloadmetricserrors.MetricNotValid, "bad key"))
+
+	results, err := s.uniter.AddWorkloadMetrics(context.Background(), params.WorkloadMetricsArgs{
+		Args: []params.WorkloadMetricsArg{{
+			Tag: "unit-app-0",
+			Metrics: []params.WorkloadMetric{{
+				Key:    "queue_depth",
+				Value:  12,
+				Labels: map[string]string{"queue": "inbound"},
+				Time:   sampled,
+			}},
+		}, {
+			Tag: "unit-app-0",
+			Metrics: []params.WorkloadMetric{{
+				Key:  "queue-depth",
+				Time: sampled,
+			}},
+		}, {
+			Tag: "unit-app-1",
+		}, {
+			Tag: "application-app",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 4)
+	c.Check(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[1].Error.Code, gc.Equals, params.CodeNotValid)
+	c.Check(results.Results[1].Error, gc.ErrorMatches, "bad key: metric not valid")
+	c.Check(results.Results[2].Error, jc.Satisfies, params.IsCodeUnauthorized)
+	c.Check(results.Results[3].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *uniterSuite) TestSetPebbleCheckStatuses(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"pebble-critical-checks": "app:online",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.applicationService.EXPECT().SetUnitPebbleCheckStatus(gomock.Any(), coreunit.Name("app/0"), domainapplication.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        domainapplication.PebbleCheckStatusDown,
+		Message:       "connection refused",
+		Critical:      true,
+		Since:         since,
+	}).Return(true, nil)
+	s.unitStatusBackend.EXPECT().UnitStatus("app/0").Return(status.StatusInfo{
+		Status:  status.Active,
+		Message: "serving",
+	}, nil)
+	errorStatus := status.StatusInfo{
+		Status:  status.Error,
+		Message: `container "redis" check "online" failed: connection refused`,
+		Data: map[string]interface{}{
+			"pebble-container": "redis",
+			"pebble-check":     "online",
+			"previous-status":  "active",
+			"previous-message": "serving",
+		},
+		Since: &since,
+	}
+	s.unitStatusBackend.EXPECT().SetUnitStatus("app/0", errorStatus).Return(nil)
+	s.applicationService.EXPECT().SetUnitWorkloadStatus(gomock.Any(), coreunit.Name("app/0"), &errorStatus).Return(nil)
+	s.applicationService.EXPECT().SetUnitPebbleCheckStatus(gomock.Any(), coreunit.Name("app/0"), domainapplication.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "ready",
+		Status:        domainapplication.PebbleCheckStatusUp,
+		Since:         since,
+	}).Return(false, applicationerrors.UnitNotFound)
+
+	results, err := s.uniter.SetPebbleCheckStatuses(context.Background(), params.SetPebbleCheckStatusArgs{
+		Args: []params.SetPebbleCheckStatusArg{{
+			Tag:       "unit-app-0",
+			Container: "redis",
+			Check:     "online",
+			Status:    "down",
+			Message:   "connection refused",
+			Since:     since,
+		}, {
+			Tag:       "unit-app-0",
+			Container: "redis",
+			Check:     "ready",
+			Status:    "up",
+			Since:     since,
+		}, {
+			Tag:       "unit-app-0",
+			Container: "redis",
+			Check:     "ready",
+			Status:    "unknown",
+		}, {
+			Tag: "unit-app-1",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 4)
+	c.Check(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(results.Results[2].Error.Code, gc.Equals, params.CodeNotValid)
+	c.Check(results.Results[3].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *uniterSuite) TestSetPebbleCheckStatusesCriticalCheckUp(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"pebble-critical-checks": "app:online",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	up := domainapplication.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        domainapplication.PebbleCheckStatusUp,
+		Critical:      true,
+		Since:         since,
+	}
+	s.applicationService.EXPECT().SetUnitPebbleCheckStatus(gomock.Any(), coreunit.Name("app/0"), up).Return(true, nil)
+	s.unitStatusBackend.EXPECT().UnitStatus("app/0").Return(status.StatusInfo{
+		Status:  status.Error,
+		Message: `container "redis" check "online" failed`,
+		Data: map[string]interface{}{
+			"pebble-container": "redis",
+			"pebble-check":     "online",
+			"previous-status":  "active",
+			"previous-message": "serving",
+		},
+	}, nil)
+	restored := status.StatusInfo{
+		Status:  status.Active,
+		Message: "serving",
+		Since:   &since,
+	}
+	s.unitStatusBackend.EXPECT().SetUnitStatus("app/0", restored).Return(nil)
+	s.applicationService.EXPECT().SetUnitWorkloadStatus(gomock.Any(), coreunit.Name("app/0"), &restored).Return(nil)
+
+	// A status which doesn't change the check's status leaves the workload
+	// status alone.
+	s.applicationService.EXPECT().SetUnitPebbleCheckStatus(gomock.Any(), coreunit.Name("app/0"), up).Return(false, nil)
+
+	arg := params.SetPebbleCheckStatusArg{
+		Tag:       "unit-app-0",
+		Container: "redis",
+		Check:     "online",
+		Status:    "up",
+		Since:     since,
+	}
+	results, err := s.uniter.SetPebbleCheckStatuses(context.Background(), params.SetPebbleCheckStatusArgs{
+		Args: []params.SetPebbleCheckStatusArg{arg, arg},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Check(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[1].Error, gc.IsNil)
+}
+
 func (s *uniterSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.applicationService = NewMockApplicationService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.workloadMetricsService = NewMockWorkloadMetricsService(ctrl)
+	s.unitStatusBackend = NewMockUnitStatusBackend(ctrl)
 
 	s.uniter = &UniterAPI{
-		applicationService: s.applicationService,
+		applicationService:     s.applicationService,
+		modelConfigService:     s.modelConfigService,
+		workloadMetricsService: s.workloadMetricsService,
+		unitStatusBackend:      s.unitStatusBackend,
+		accessUnit: func() (common.AuthFunc, error) {
+			return func(tag names.Tag) bool {
+				return tag == names.NewUnitTag("app/0")
+			}, nil
+		},
 	}
 
 	return ctrl
@@ -218,8 +477,12 @@ func (s *uniterv19Suite) SetUpTest(c *gc.C) {
 
 		s.uniter = &UniterAPIv19{
 			UniterAPIv20: &UniterAPIv20{
-				UniterAPI: &UniterAPI{
-					watcherRegistry: s.watcherRegistry,
+				UniterAPIv21: &UniterAPIv21{
+					UniterAPIv22: &UniterAPIv22{
+						UniterAPI: &UniterAPI{
+							watcherRegistry: s.watcherRegistry,
+						},
+					},
 				},
 			},
 		}
@@ -242,8 +505,12 @@ func (s *uniterv20Suite) SetUpTest(c *gc.C) {
 		s.watcherRegistry.EXPECT().Register(gomock.Any()).Return("watcher1", nil).AnyTimes()
 
 		s.uniter = &UniterAPIv20{
-			UniterAPI: &UniterAPI{
-				watcherRegistry: s.watcherRegistry,
+			UniterAPIv21: &UniterAPIv21{
+				UniterAPIv22: &UniterAPIv22{
+					UniterAPI: &UniterAPI{
+						watcherRegistry: s.watcherRegistry,
+					},
+				},
 			},
 		}
 
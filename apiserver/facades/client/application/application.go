@@ -29,6 +29,7 @@ import (
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/crossmodel"
+	coredeferred "github.com/juju/juju/core/deferred"
 	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/leadership"
@@ -37,6 +38,7 @@ import (
 	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/permission"
+	coreschedule "github.com/juju/juju/core/schedule"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
@@ -89,6 +91,7 @@ type APIBase struct {
 	portService        PortService
 	stubService        StubService
 	storageService     StorageService
+	unitStateService   UnitStateService
 
 	resources        facade.Resources
 	leadershipReader leadership.Reader
@@ -192,6 +195,7 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 			PortService:               domainServices.Port(),
 			StorageService:            storageService,
 			StubService:               domainServices.Stub(),
+			UnitStateService:          domainServices.UnitState(),
 		},
 		storageAccess,
 		ctx.Auth(),
@@ -270,6 +274,7 @@ func NewAPIBase(
 		portService:               services.PortService,
 		storageService:            services.StorageService,
 		stubService:               services.StubService,
+		unitStateService:          services.UnitStateService,
 
 		logger: logger,
 	}, nil
@@ -2610,10 +2615,83 @@ func (api *APIBase) unitResultForUnit(ctx context.Context, unit Unit) (*params.U
 			result.OpenedPorts = container.Ports()
 		}
 	}
-	result.RelationData, err = api.relationData(app, unit)
+	result.RelationData, err = api.relationData(ctx, app, unit)
 	if err != nil {
 		return nil, err
 	}
+	unitState, err := api.unitStateService.GetState(ctx, unitUUID.String())
+	if err != nil {
+		return nil, errors.Annotate(err, "getting unit state")
+	}
+	result.Schedules, err = unitSchedules(unitState.ScheduleState)
+	if err != nil {
+		return nil, err
+	}
+	result.DeferredHooks, err = unitDeferredHooks(unitState.DeferredState)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// unitSchedules returns the schedules on which the unit's schedule hooks
+// are run, as last recorded by the unit agent.
+func unitSchedules(scheduleState string) ([]params.UnitSchedule, error) {
+	st, err := coreschedule.ParseState(scheduleState)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []params.UnitSchedule
+	for _, entry := range st.Entries() {
+		schedule := params.UnitSchedule{
+			Name:    entry.Name,
+			Source:  string(entry.Source),
+			Cron:    entry.Cron,
+			CatchUp: string(entry.CatchUp),
+		}
+		if entry.Interval > 0 {
+			schedule.Interval = entry.Interval.String()
+		}
+		if entry.Jitter > 0 {
+			schedule.Jitter = entry.Jitter.String()
+		}
+		if !entry.LastRun.IsZero() {
+			lastRun := entry.LastRun
+			schedule.LastRun = &lastRun
+		}
+		if !entry.NextRun.IsZero() {
+			nextRun := entry.NextRun
+			schedule.NextRun = &nextRun
+		}
+		result = append(result, schedule)
+	}
+	return result, nil
+}
+
+// unitDeferredHooks returns the hooks deferred by the unit's charm, as
+// last recorded by the unit agent.
+func unitDeferredHooks(deferredState string) ([]params.UnitDeferredHook, error) {
+	st, err := coredeferred.ParseState(deferredState)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []params.UnitDeferredHook
+	for _, entry := range st.Hooks {
+		deferred := params.UnitDeferredHook{
+			ID:            entry.ID,
+			Hook:          entry.Hook,
+			Reason:        entry.Reason,
+			DeferredAt:    entry.DeferredAt,
+			Deferrals:     entry.Deferrals,
+			Ready:         entry.Ready,
+			DropOnUpgrade: entry.DropOnUpgrade,
+		}
+		if !entry.RetryAt.IsZero() {
+			retryAt := entry.RetryAt
+			deferred.RetryAt = &retryAt
+		}
+		result = append(result, deferred)
+	}
 	return result, nil
 }
 
@@ -2634,7 +2712,7 @@ func (api *APIBase) openPortsOnUnit(ctx context.Context, unitUUID coreunit.UUID)
 	return result, nil
 }
 
-func (api *APIBase) relationData(app Application, myUnit Unit) ([]params.EndpointRelationData, error) {
+func (api *APIBase) relationData(ctx context.Context, app Application, myUnit Unit) ([]params.EndpointRelationData, error) {
 	rels, err := app.Relations()
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -2680,6 +2758,16 @@ func (api *APIBase) relationData(app Application, myUnit Unit) ([]params.Endpoin
 			return nil, errors.Trace(err)
 		}
 
+		// If the related charm declares a schema for its databags, the
+		// data is shown typed rather than as the raw strings.
+		schema, err := api.relationSchema(ctx, related.ApplicationName, related.Name)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if schema != nil && schema.Application != nil {
+			erd.ApplicationData = typedRelationData(schema.Application, appSettings)
+		}
+
 		otherUnits, err := otherApp.AllUnits()
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -2701,7 +2789,9 @@ func (api *APIBase) relationData(app Application, myUnit Unit) ([]params.Endpoin
 				if err != nil && !errors.Is(err, errors.NotFound) {
 					return nil, errors.Trace(err)
 				}
-				if err == nil {
+				if err == nil && schema != nil && schema.Unit != nil {
+					urd.UnitData = typedRelationData(schema.Unit, settings)
+				} else if err == nil {
 					urd.UnitData = make(map[string]interface{})
 					for k, v := range settings {
 						urd.UnitData[k] = v
@@ -2716,6 +2806,40 @@ func (api *APIBase) relationData(app Application, myUnit Unit) ([]params.Endpoin
 	return result, nil
 }
 
+// relationSchema returns the schema declared by the charm of the named
+// application for the endpoint, or nil if the charm doesn't declare one.
+func (api *APIBase) relationSchema(ctx context.Context, appName, endpoint string) (*charm.RelationSchema, error) {
+	locator, err := api.applicationService.GetCharmLocatorByApplicationName(ctx, appName)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %q", appName)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta, err := api.applicationService.GetCharmMetadata(ctx, locator)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return meta.CombinedRelations()[endpoint].Schema, nil
+}
+
+// typedRelationData returns the relation settings decoded according to the
+// databag schema, so that data conforming to the schema is shown typed.
+func typedRelationData(jsonSchema, settings map[string]interface{}) map[string]interface{} {
+	raw := make(map[string]string, len(settings))
+	result := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		if s, ok := v.(string); ok {
+			raw[k] = s
+		} else {
+			result[k] = v
+		}
+	}
+	for k, v := range charm.DecodeSettings(jsonSchema, raw) {
+		result[k] = v
+	}
+	return result
+}
+
 func (api *APIBase) crossModelRelationData(rel Relation, appName string, erd *params.EndpointRelationData) error {
 	rus, err := rel.AllRemoteUnits(appName)
 	if err != nil {
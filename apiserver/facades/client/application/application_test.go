@@ -6,6 +6,7 @@ package application
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
+	coreschedule "github.com/juju/juju/core/schedule"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	applicationservice "github.com/juju/juju/domain/application/service"
@@ -540,6 +542,158 @@ func (s *applicationSuite) TestSetApplicationConstraints(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *applicationSuite) TestRelationSchema(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	// The schema is looked up on behalf of a facade method, which has
+	// already checked the permissions.
+	s.expectAuthClient(c)
+	s.newIAASAPI(c)
+
+	locator := applicationcharm.CharmLocator{
+		Name:     "mysql",
+		Revision: 42,
+		Source:   applicationcharm.CharmHubSource,
+	}
+	schema := &internalcharm.RelationSchema{
+		Unit: map[string]interface{}{"type": "object"},
+	}
+	s.applicationService.EXPECT().GetCharmLocatorByApplicationName(gomock.Any(), "mysql").Return(locator, nil).Times(2)
+	s.applicationService.EXPECT().GetCharmMetadata(gomock.Any(), locator).Return(internalcharm.Meta{
+		Provides: map[string]internalcharm.Relation{
+			"db": {Name: "db", Role: internalcharm.RoleProvider, Interface: "mysql", Schema: schema},
+		},
+	}, nil).Times(2)
+
+	result, err := s.api.relationSchema(context.Background(), "mysql", "db")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.Equals, schema)
+
+	result, err = s.api.relationSchema(context.Background(), "mysql", "juju-info")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.IsNil)
+}
+
+func (s *applicationSuite) TestRelationSchemaApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	// The schema is looked up on behalf of a facade method, which has
+	// already checked the permissions.
+	s.expectAuthClient(c)
+	s.newIAASAPI(c)
+
+	s.applicationService.EXPECT().GetCharmLocatorByApplicationName(gomock.Any(), "mysql").Return(applicationcharm.CharmLocator{}, applicationerrors.ApplicationNotFound)
+
+	_, err := s.api.relationSchema(context.Background(), "mysql", "db")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *applicationSuite) TestTypedRelationData(c *gc.C) {
+	jsonSchema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"version": map[string]interface{}{"type": "string"},
+		},
+	}
+	data := typedRelationData(jsonSchema, map[string]interface{}{
+		"port":    "3306",
+		"tls":     "false",
+		"tags":    `{"env": "prod"}`,
+		"address": "10.0.0.1",
+		"version": "8.0",
+	})
+	c.Check(data, jc.DeepEquals, map[string]interface{}{
+		"port":    float64(3306),
+		"tls":     false,
+		"tags":    map[string]interface{}{"env": "prod"},
+		"address": "10.0.0.1",
+		"version": "8.0",
+	})
+}
+
+func (s *applicationSuite) TestUnitSchedules(c *gc.C) {
+	lastRun := time.Date(2025, 3, 14, 3, 2, 0, 0, time.UTC)
+	nextRun := time.Date(2025, 3, 15, 3, 7, 0, 0, time.UTC)
+	st := &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"nightly": {
+			Schedule: internalcharm.Schedule{Name: "nightly", Cron: "0 3 * * *", Jitter: 10 * time.Minute, CatchUp: internalcharm.CatchUpSkip},
+			Source:   coreschedule.SourceCharm,
+			LastRun:  lastRun,
+			NextRun:  nextRun,
+		},
+		"hourly": {
+			Schedule: internalcharm.Schedule{Name: "hourly", Interval: time.Hour, CatchUp: internalcharm.CatchUpOnce},
+			Source:   coreschedule.SourceRuntime,
+			NextRun:  lastRun,
+		},
+	}}
+	scheduleState, err := st.Serialise()
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := unitSchedules(scheduleState)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, []params.UnitSchedule{{
+		Name:     "hourly",
+		Source:   "runtime",
+		Interval: "1h0m0s",
+		CatchUp:  "once",
+		NextRun:  &lastRun,
+	}, {
+		Name:    "nightly",
+		Source:  "charm",
+		Cron:    "0 3 * * *",
+		Jitter:  "10m0s",
+		CatchUp: "skip",
+		LastRun: &lastRun,
+		NextRun: &nextRun,
+	}})
+}
+
+func (s *applicationSuite) TestUnitSchedulesNone(c *gc.C) {
+	result, err := unitSchedules("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.HasLen, 0)
+}
+
+func (s *applicationSuite) TestUnitDeferredHooks(c *gc.C) {
+	deferredAt := time.Date(2025, 3, 14, 3, 2, 0, 0, time.UTC)
+	retryAt := deferredAt.Add(5 * time.Minute)
+	result, err := unitDeferredHooks(`
+hooks:
+- id: "1"
+  hook: config-changed
+  reason: waiting for database
+  deferred-at: 2025-03-14T03:02:00Z
+  deferrals: 2
+  retry-at: 2025-03-14T03:07:00Z
+  drop-on-upgrade: true
+- id: "3"
+  hook: db-relation-changed
+  deferred-at: 2025-03-14T03:02:00Z
+  deferrals: 1
+  ready: true
+`[1:])
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, []params.UnitDeferredHook{{
+		ID:            "1",
+		Hook:          "config-changed",
+		Reason:        "waiting for database",
+		DeferredAt:    deferredAt,
+		Deferrals:     2,
+		RetryAt:       &retryAt,
+		DropOnUpgrade: true,
+	}, {
+		ID:         "3",
+		Hook:       "db-relation-changed",
+		DeferredAt: deferredAt,
+		Deferrals:  1,
+		Ready:      true,
+	}})
+}
+
+func (s *applicationSuite) TestUnitDeferredHooksInvalid(c *gc.C) {
+	_, err := unitDeferredHooks("hooks: {")
+	c.Assert(err, gc.ErrorMatches, "parsing deferred hook state: .*")
+}
+
 func (s *applicationSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := s.baseSuite.setupMocks(c)
 
@@ -20,7 +20,7 @@ import (
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application ExternalControllerService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,PortService,StubService,Leadership,StorageService
+//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application ExternalControllerService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,PortService,StubService,Leadership,StorageService,UnitStateService
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination legacy_mock_test.go github.com/juju/juju/apiserver/facades/client/application Backend,Application,Model,CaasBrokerInterface
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStore
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry
@@ -45,6 +45,7 @@ type baseSuite struct {
 	portService               *MockPortService
 	storageService            *MockStorageService
 	stubService               *MockStubService
+	unitStateService          *MockUnitStateService
 
 	storageAccess    *MockStorageInterface
 	authorizer       *MockAuthorizer
@@ -79,6 +80,7 @@ func (s *baseSuite) setupMocks(c *gc.C) *gomock.Controller {
 	s.portService = NewMockPortService(ctrl)
 	s.storageService = NewMockStorageService(ctrl)
 	s.stubService = NewMockStubService(ctrl)
+	s.unitStateService = NewMockUnitStateService(ctrl)
 
 	s.storageAccess = NewMockStorageInterface(ctrl)
 	s.authorizer = NewMockAuthorizer(ctrl)
@@ -158,6 +160,7 @@ func (s *baseSuite) newAPI(c *gc.C, modelType model.ModelType) {
 			PortService:               s.portService,
 			StorageService:            s.storageService,
 			StubService:               s.stubService,
+			UnitStateService:          s.unitStateService,
 		},
 		s.storageAccess,
 		s.authorizer,
@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/core/watcher"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/unitstate"
 	"github.com/juju/juju/environs/config"
 	internalcharm "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/storage"
@@ -38,6 +39,7 @@ type Services struct {
 	PortService               PortService
 	StorageService            StorageService
 	StubService               StubService
+	UnitStateService          UnitStateService
 }
 
 // Validate checks that all the services are set.
@@ -66,6 +68,9 @@ func (s Services) Validate() error {
 	if s.StubService == nil {
 		return errors.NotValidf("empty StubService")
 	}
+	if s.UnitStateService == nil {
+		return errors.NotValidf("empty UnitStateService")
+	}
 	return nil
 }
 
@@ -246,6 +251,12 @@ type StorageService interface {
 	GetStoragePoolByName(ctx context.Context, name string) (*storage.Config, error)
 }
 
+// UnitStateService provides access to the state persisted by unit agents.
+type UnitStateService interface {
+	// GetState returns the full unit state. The state may be empty.
+	GetState(ctx context.Context, uuid string) (unitstate.RetrievedUnitState, error)
+}
+
 // BlockChecker defines the block-checking functionality required by
 // the application facade. This is implemented by
 // apiserver/common.BlockChecker.
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: ExternalControllerService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,PortService,StubService,Leadership,StorageService)
+// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: ExternalControllerService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,PortService,StubService,Leadership,StorageService,UnitStateService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application ExternalControllerService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,PortService,StubService,Leadership,StorageService
+//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application ExternalControllerService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,PortService,StubService,Leadership,StorageService,UnitStateService
 //
 
 // Package application is a generated GoMock package.
@@ -26,6 +26,7 @@ import (
 	unit "github.com/juju/juju/core/unit"
 	charm0 "github.com/juju/juju/domain/application/charm"
 	service "github.com/juju/juju/domain/application/service"
+	unitstate "github.com/juju/juju/domain/unitstate"
 	config "github.com/juju/juju/environs/config"
 	charm1 "github.com/juju/juju/internal/charm"
 	storage "github.com/juju/juju/internal/storage"
@@ -1909,3 +1910,65 @@ func (c *MockStorageServiceGetStoragePoolByNameCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockUnitStateService is a mock of UnitStateService interface.
+type MockUnitStateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitStateServiceMockRecorder
+}
+
+// MockUnitStateServiceMockRecorder is the mock recorder for MockUnitStateService.
+type MockUnitStateServiceMockRecorder struct {
+	mock *MockUnitStateService
+}
+
+// NewMockUnitStateService creates a new mock instance.
+func NewMockUnitStateService(ctrl *gomock.Controller) *MockUnitStateService {
+	mock := &MockUnitStateService{ctrl: ctrl}
+	mock.recorder = &MockUnitStateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitStateService) EXPECT() *MockUnitStateServiceMockRecorder {
+	return m.recorder
+}
+
+// GetState mocks base method.
+func (m *MockUnitStateService) GetState(arg0 context.Context, arg1 string) (unitstate.RetrievedUnitState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetState", arg0, arg1)
+	ret0, _ := ret[0].(unitstate.RetrievedUnitState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetState indicates an expected call of GetState.
+func (mr *MockUnitStateServiceMockRecorder) GetState(arg0, arg1 any) *MockUnitStateServiceGetStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockUnitStateService)(nil).GetState), arg0, arg1)
+	return &MockUnitStateServiceGetStateCall{Call: call}
+}
+
+// MockUnitStateServiceGetStateCall wrap *gomock.Call
+type MockUnitStateServiceGetStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStateServiceGetStateCall) Return(arg0 unitstate.RetrievedUnitState, arg1 error) *MockUnitStateServiceGetStateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStateServiceGetStateCall) Do(f func(context.Context, string) (unitstate.RetrievedUnitState, error)) *MockUnitStateServiceGetStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStateServiceGetStateCall) DoAndReturn(f func(context.Context, string) (unitstate.RetrievedUnitState, error)) *MockUnitStateServiceGetStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,362 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"archive/tar"
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/juju/agent"
+	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+const (
+	// controllerNamespace is the name used for the controller database in
+	// a backup archive.
+	controllerNamespace = "controller"
+
+	// restoreObjectPath is the path that objects are put at in the object
+	// store when they are restored. Objects are referenced by their hash
+	// in the restored database, so the path is only used until the
+	// database is restored.
+	restoreObjectPath = "backups/restore"
+)
+
+// namespaceSource is a database, and the object store that goes with it,
+// to write to a backup archive.
+type namespaceSource struct {
+	namespace   string
+	backup      BackupService
+	objectStore objectstore.ReadObjectStore
+}
+
+// archiveWriter writes the contents of a backup archive, recording the
+// checksum of every file that is written.
+type archiveWriter struct {
+	paths     corebackups.ArchivePaths
+	tw        *tar.Writer
+	workDir   string
+	checksums []string
+	logger    logger.Logger
+}
+
+func newArchiveWriter(w io.Writer, workDir string, logger logger.Logger) *archiveWriter {
+	return &archiveWriter{
+		paths:   corebackups.NewCanonicalArchivePaths(),
+		tw:      tar.NewWriter(w),
+		workDir: workDir,
+		logger:  logger,
+	}
+}
+
+// addNamespace writes a snapshot of the database, and every object it
+// references, to the archive.
+func (w *archiveWriter) addNamespace(ctx context.Context, src namespaceSource) error {
+	// The snapshot is written to a file first, as the size of each file in
+	// the archive must be known before it is written.
+	f, err := os.CreateTemp(w.workDir, "snapshot-")
+	if err != nil {
+		return errors.Errorf("creating snapshot file: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	info, err := src.backup.Snapshot(ctx, f)
+	if err != nil {
+		return errors.Errorf("taking snapshot of %q: %w", src.namespace, err)
+	}
+
+	// Objects are written before the snapshot, so that they can be put
+	// back into the object store before the database that references them
+	// is restored.
+	for _, hash := range info.Objects {
+		if err := w.addObject(ctx, src, hash); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errors.Errorf("reading snapshot of %q: %w", src.namespace, err)
+	}
+	sum, err := w.addFile(path.Join(w.paths.DBDumpDir, src.namespace+".sql"), info.Size, f)
+	if err != nil {
+		return errors.Errorf("writing snapshot of %q: %w", src.namespace, err)
+	}
+	if sum != info.SHA256 {
+		return errors.Errorf("snapshot of %q changed while being written", src.namespace)
+	}
+
+	w.logger.Debugf(ctx, "added snapshot of %q with %d rows and %d objects", src.namespace, info.Rows, len(info.Objects))
+	return nil
+}
+
+func (w *archiveWriter) addObject(ctx context.Context, src namespaceSource, hash string) error {
+	r, size, err := src.objectStore.GetBySHA256(ctx, hash)
+	if errors.Is(err, objectstoreerrors.ObjectNotFound) {
+		// The metadata may reference an object that is still being
+		// uploaded, or has since been removed.
+		w.logger.Warningf(ctx, "object %q in %q not found, skipping", hash, src.namespace)
+		return nil
+	} else if err != nil {
+		return errors.Errorf("getting object %q in %q: %w", hash, src.namespace, err)
+	}
+	defer func() { _ = r.Close() }()
+
+	sum, err := w.addFile(path.Join(w.paths.ObjectsDir, src.namespace, hash), size, r)
+	if err != nil {
+		return errors.Errorf("writing object %q in %q: %w", hash, src.namespace, err)
+	}
+	if sum != hash {
+		return errors.Errorf("object %q in %q is corrupt, has checksum %q", hash, src.namespace, sum)
+	}
+	return nil
+}
+
+// addAgentConfig writes a files bundle containing the agent config to the
+// archive. The secret content keys are removed from the agent config, as
+// they wrap the keys that encrypt the secret content in the databases, and
+// must not be stored alongside it.
+func (w *archiveWriter) addAgentConfig(configPath string) error {
+	fi, err := os.Stat(configPath)
+	if err != nil {
+		return errors.Errorf("reading agent config: %w", err)
+	}
+	config, err := agent.ReadConfig(configPath)
+	if err != nil {
+		return errors.Errorf("reading agent config: %w", err)
+	}
+	if info, ok := config.StateServingInfo(); ok {
+		info.SecretContentKeys = nil
+		config.SetStateServingInfo(info)
+	}
+	data, err := config.Render()
+	if err != nil {
+		return errors.Errorf("rendering agent config: %w", err)
+	}
+
+	// The files bundle is a tar file inside the archive, with the paths
+	// relative to the root of the file system.
+	bundle, err := os.CreateTemp(w.workDir, "root-")
+	if err != nil {
+		return errors.Errorf("creating files bundle: %w", err)
+	}
+	defer func() {
+		_ = bundle.Close()
+		_ = os.Remove(bundle.Name())
+	}()
+
+	tw := tar.NewWriter(bundle)
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     strings.TrimPrefix(configPath, "/"),
+		Mode:     int64(fi.Mode().Perm()),
+		Size:     int64(len(data)),
+		ModTime:  fi.ModTime(),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return errors.Errorf("writing files bundle: %w", err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Errorf("writing files bundle: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Errorf("writing files bundle: %w", err)
+	}
+
+	size, err := bundle.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errors.Errorf("reading files bundle: %w", err)
+	}
+	if _, err := bundle.Seek(0, io.SeekStart); err != nil {
+		return errors.Errorf("reading files bundle: %w", err)
+	}
+	if _, err := w.addFile(w.paths.FilesBundle, size, bundle); err != nil {
+		return errors.Errorf("writing files bundle: %w", err)
+	}
+	return nil
+}
+
+// addMetadata writes the backup metadata to the archive.
+func (w *archiveWriter) addMetadata(meta *corebackups.Metadata) error {
+	r, err := meta.AsJSONBuffer()
+	if err != nil {
+		return errors.Errorf("encoding metadata: %w", err)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return errors.Errorf("encoding metadata: %w", err)
+	}
+	if _, err := w.addFile(w.paths.MetadataFile, int64(len(data)), strings.NewReader(string(data))); err != nil {
+		return errors.Errorf("writing metadata: %w", err)
+	}
+	return nil
+}
+
+// Close writes the checksums of all the files in the archive, and closes
+// the archive.
+func (w *archiveWriter) Close() error {
+	var b strings.Builder
+	for _, line := range w.checksums {
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	if err := w.writeFile(w.paths.ChecksumsFile, int64(b.Len()), strings.NewReader(b.String())); err != nil {
+		return errors.Errorf("writing checksums: %w", err)
+	}
+	return w.tw.Close()
+}
+
+// addFile writes a file to the archive, and records its checksum. The
+// checksum is returned.
+func (w *archiveWriter) addFile(name string, size int64, r io.Reader) (string, error) {
+	hasher := sha256.New()
+	if err := w.writeFile(name, size, io.TeeReader(r, hasher)); err != nil {
+		return "", err
+	}
+	sum := hex.EncodeToString(hasher.Sum(nil))
+	w.checksums = append(w.checksums, fmt.Sprintf("%s  %s", sum, name))
+	return sum, nil
+}
+
+func (w *archiveWriter) writeFile(name string, size int64, r io.Reader) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     size,
+		ModTime:  time.Now(),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	n, err := io.Copy(w.tw, r)
+	if err != nil {
+		return err
+	} else if n != size {
+		return errors.Errorf("expected %d bytes, wrote %d", size, n)
+	}
+	return nil
+}
+
+// readChecksums reads the checksums of the files in an archive, keyed on
+// the path of the file.
+func readChecksums(r io.Reader) (map[string]string, error) {
+	checksums := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		sum, name, ok := strings.Cut(line, "  ")
+		if !ok {
+			return nil, errors.Errorf("unexpected checksum line %q", line)
+		}
+		checksums[name] = sum
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return checksums, nil
+}
+
+// archiveFileFunc is called for each file in a backup archive.
+type archiveFileFunc func(name string, size int64, r io.Reader) error
+
+// walkArchive calls fn for each file in the archive, in the order they
+// were written, verifying the checksum of each file once it has been
+// read. The checksums file is read first, so an archive is read twice.
+func walkArchive(open func() (io.ReadCloser, error), fn archiveFileFunc) error {
+	paths := corebackups.NewCanonicalArchivePaths()
+
+	checksums, err := readArchiveChecksums(open, paths.ChecksumsFile)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	r, err := open()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() { _ = r.Close() }()
+
+	seen := make(map[string]bool)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return errors.Errorf("reading archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg || hdr.Name == paths.ChecksumsFile {
+			continue
+		}
+
+		expected, ok := checksums[hdr.Name]
+		if !ok {
+			return errors.Errorf("file %q has no checksum", hdr.Name)
+		}
+
+		hasher := sha256.New()
+		if err := fn(hdr.Name, hdr.Size, io.TeeReader(tr, hasher)); err != nil {
+			return errors.Capture(err)
+		}
+		// Ensure the whole file is hashed, even if fn didn't read it all.
+		if _, err := io.Copy(hasher, tr); err != nil {
+			return errors.Errorf("reading %q: %w", hdr.Name, err)
+		}
+		if sum := hex.EncodeToString(hasher.Sum(nil)); sum != expected {
+			return errors.Errorf("file %q has checksum %q, expected %q", hdr.Name, sum, expected)
+		}
+		seen[hdr.Name] = true
+	}
+
+	var missing []string
+	for name := range checksums {
+		if !seen[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return errors.Errorf("archive is missing files %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func readArchiveChecksums(open func() (io.ReadCloser, error), name string) (map[string]string, error) {
+	r, err := open()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	defer func() { _ = r.Close() }()
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.Errorf("archive has no checksums file")
+		} else if err != nil {
+			return nil, errors.Errorf("reading archive: %w", err)
+		}
+		if hdr.Name == name {
+			return readChecksums(tr)
+		}
+	}
+}
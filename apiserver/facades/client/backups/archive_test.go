@@ -0,0 +1,245 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/controller"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/domain/backup"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type archiveSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&archiveSuite{})
+
+func (s *archiveSuite) TestWriteAndWalkArchive(c *gc.C) {
+	object := "object data"
+	objectHash := sha256Hex(object)
+	missingHash := sha256Hex("missing")
+
+	archivePath := s.writeArchive(c, fakeBackupService{
+		snapshot: "-- snapshot\n",
+		objects:  []string{objectHash, missingHash},
+	}, fakeObjectStore{objectHash: object})
+
+	files := make(map[string]string)
+	var order []string
+	err := walkArchive(s.opener(archivePath), func(name string, size int64, r io.Reader) error {
+		data, err := io.ReadAll(r)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(int64(len(data)), gc.Equals, size)
+		files[name] = string(data)
+		order = append(order, name)
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Objects are written before the snapshot that references them, and
+	// missing objects are skipped.
+	c.Check(order, jc.DeepEquals, []string{
+		"juju-backup/objects/controller/" + objectHash,
+		"juju-backup/dump/controller.sql",
+		"juju-backup/root.tar",
+	})
+	c.Check(files["juju-backup/objects/controller/"+objectHash], gc.Equals, object)
+	c.Check(files["juju-backup/dump/controller.sql"], gc.Equals, "-- snapshot\n")
+}
+
+func (s *archiveSuite) TestWalkArchiveChecksumMismatch(c *gc.C) {
+	archivePath := s.writeArchive(c, fakeBackupService{
+		snapshot: "-- snapshot\n",
+	}, fakeObjectStore{})
+
+	// Rewrite the archive, with the snapshot changed.
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err := walkRaw(c, archivePath, func(hdr *tar.Header, data []byte) {
+		if strings.HasSuffix(hdr.Name, "controller.sql") {
+			data = []byte("-- changed!\n")
+		}
+		err := tw.WriteHeader(hdr)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = tw.Write(data)
+		c.Assert(err, jc.ErrorIsNil)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tw.Close(), jc.ErrorIsNil)
+	err = os.WriteFile(archivePath, buf.Bytes(), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = walkArchive(s.opener(archivePath), func(string, int64, io.Reader) error {
+		return nil
+	})
+	c.Assert(err, gc.ErrorMatches, `file "juju-backup/dump/controller.sql" has checksum .*`)
+}
+
+func (s *archiveSuite) TestAgentConfigSecretContentKeysRemoved(c *gc.C) {
+	archivePath := s.writeArchive(c, fakeBackupService{
+		snapshot: "-- snapshot\n",
+	}, fakeObjectStore{})
+
+	var bundle []byte
+	err := walkArchive(s.opener(archivePath), func(name string, _ int64, r io.Reader) error {
+		if name != "juju-backup/root.tar" {
+			return nil
+		}
+		var err error
+		bundle, err = io.ReadAll(r)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	tr := tar.NewReader(bytes.NewReader(bundle))
+	hdr, err := tr.Next()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(hdr.Name, gc.Matches, `.*/agent\.conf`)
+	data, err := io.ReadAll(tr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The rest of the controller agent config is kept.
+	c.Check(string(data), jc.Contains, "controllercert")
+	c.Check(string(data), gc.Not(jc.Contains), "secretcontentkeys")
+	c.Check(string(data), gc.Not(jc.Contains), "secret-content-key")
+}
+
+func (s *archiveSuite) writeArchive(c *gc.C, backupService fakeBackupService, objectStore fakeObjectStore) string {
+	dir := c.MkDir()
+	configPath := writeAgentConfig(c, dir)
+
+	var buf bytes.Buffer
+	w := newArchiveWriter(&buf, dir, loggertesting.WrapCheckLog(c))
+	err := w.addNamespace(context.Background(), namespaceSource{
+		namespace:   controllerNamespace,
+		backup:      backupService,
+		objectStore: objectStore,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = w.addAgentConfig(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	err = w.Close()
+	c.Assert(err, jc.ErrorIsNil)
+
+	archivePath := filepath.Join(dir, "backup.tar")
+	err = os.WriteFile(archivePath, buf.Bytes(), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	return archivePath
+}
+
+func (s *archiveSuite) opener(archivePath string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		return os.Open(archivePath)
+	}
+}
+
+// writeAgentConfig writes a controller agent config, with secret content
+// keys, to the data directory and returns its path.
+func writeAgentConfig(c *gc.C, dataDir string) string {
+	config, err := agent.NewStateMachineConfig(agent.AgentConfigParams{
+		Paths:             agent.Paths{DataDir: dataDir},
+		Tag:               names.NewMachineTag("0"),
+		UpgradedToVersion: jujuversion.Current,
+		APIAddresses:      []string{"localhost:17070"},
+		CACert:            coretesting.CACert,
+		Password:          "sekrit",
+		Controller:        coretesting.ControllerTag,
+		Model:             coretesting.ModelTag,
+	}, controller.StateServingInfo{
+		Cert:              coretesting.ServerCert,
+		PrivateKey:        coretesting.ServerKey,
+		CAPrivateKey:      coretesting.CAKey,
+		APIPort:           17070,
+		StatePort:         37017,
+		SecretContentKeys: []string{"secret-content-key"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(config.Write(), jc.ErrorIsNil)
+
+	configPath := agent.ConfigPath(dataDir, names.NewMachineTag("0"))
+	data, err := os.ReadFile(configPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), jc.Contains, "secret-content-key")
+	return configPath
+}
+
+func walkRaw(c *gc.C, archivePath string, fn func(*tar.Header, []byte)) error {
+	f, err := os.Open(archivePath)
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = f.Close() }()
+
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		fn(hdr, data)
+	}
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+type fakeBackupService struct {
+	BackupService
+	snapshot string
+	objects  []string
+}
+
+func (f fakeBackupService) Snapshot(_ context.Context, w io.Writer) (backup.SnapshotInfo, error) {
+	if _, err := io.WriteString(w, f.snapshot); err != nil {
+		return backup.SnapshotInfo{}, err
+	}
+	return backup.SnapshotInfo{
+		Objects: f.objects,
+		Size:    int64(len(f.snapshot)),
+		SHA256:  sha256Hex(f.snapshot),
+	}, nil
+}
+
+type fakeObjectStore map[string]string
+
+func (f fakeObjectStore) Get(context.Context, string) (io.ReadCloser, int64, error) {
+	return nil, -1, objectstoreerrors.ObjectNotFound
+}
+
+func (f fakeObjectStore) GetBySHA256(_ context.Context, hash string) (io.ReadCloser, int64, error) {
+	data, ok := f[hash]
+	if !ok {
+		return nil, -1, objectstoreerrors.ObjectNotFound
+	}
+	return io.NopCloser(strings.NewReader(data)), int64(len(data)), nil
+}
+
+func (f fakeObjectStore) GetBySHA256Prefix(context.Context, string) (io.ReadCloser, int64, error) {
+	return nil, -1, objectstoreerrors.ObjectNotFound
+}
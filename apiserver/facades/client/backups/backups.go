@@ -5,6 +5,7 @@ package backups
 
 import (
 	"context"
+	"io"
 
 	"github.com/juju/names/v6"
 
@@ -12,6 +13,12 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
 	corebackups "github.com/juju/juju/core/backups"
+	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/backup"
+	"github.com/juju/juju/environs/config"
 )
 
 // ControllerConfigService is an interface that provides the controller config.
@@ -20,10 +27,56 @@ type ControllerConfigService interface {
 	ControllerConfig(context.Context) (controller.Config, error)
 }
 
+// ModelConfigService is an interface that provides the model config.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
+}
+
+// ModelService is an interface that provides the models on the controller.
+type ModelService interface {
+	// ControllerModel returns the model used for housing the Juju controller.
+	ControllerModel(context.Context) (coremodel.Model, error)
+
+	// ListModelIDs returns a list of all model UUIDs.
+	ListModelIDs(context.Context) ([]coremodel.UUID, error)
+}
+
+// BackupService is an interface that takes and restores snapshots of a
+// database.
+type BackupService interface {
+	// Snapshot writes a consistent point-in-time snapshot of all the data in
+	// the database to w.
+	Snapshot(ctx context.Context, w io.Writer) (backup.SnapshotInfo, error)
+
+	// Restore replaces all the data in the database with the data from the
+	// snapshot read from r, except for the data in the preserved tables.
+	Restore(ctx context.Context, r io.Reader, preserve ...string) error
+
+	// EnsureDatabase ensures that the database exists, so that a snapshot
+	// can be restored into it.
+	EnsureDatabase(ctx context.Context) error
+}
+
+// ObjectStoreGetter returns the object store for a model.
+type ObjectStoreGetter func(context.Context, coremodel.UUID) (objectstore.ObjectStore, error)
+
+// BackupServiceGetter returns the backup service for a model database.
+type BackupServiceGetter func(coremodel.UUID) BackupService
+
 // API provides backup-specific API methods.
 type API struct {
 	controllerConfigService ControllerConfigService
+	modelConfigService      ModelConfigService
+	modelService            ModelService
+	backupService           BackupService
+	modelBackupService      BackupServiceGetter
+	controllerObjectStore   objectstore.ObjectStore
+	objectStoreForModel     ObjectStoreGetter
+	authorizer              facade.Authorizer
 	paths                   *corebackups.Paths
+	controllerUUID          string
+	logger                  logger.Logger
 
 	// machineID is the ID of the machine where the API server is running.
 	machineID string
@@ -32,15 +85,19 @@ type API struct {
 // NewAPI creates a new instance of the Backups API facade.
 func NewAPI(
 	controllerConfigService ControllerConfigService,
+	modelConfigService ModelConfigService,
+	modelService ModelService,
+	backupService BackupService,
+	modelBackupService BackupServiceGetter,
+	controllerObjectStore objectstore.ObjectStore,
+	objectStoreForModel ObjectStoreGetter,
 	authorizer facade.Authorizer,
 	machineTag names.Tag,
+	controllerUUID string,
 	dataDir, logDir string,
+	logger logger.Logger,
 ) (*API, error) {
-	// TODO (manadart 2023-10-11) Restore this assignment based on super-user
-	// access to the controller when re-implemented for Dqlite.
-	isControllerAdmin := true
-
-	if !authorizer.AuthClient() || !isControllerAdmin {
+	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
 
@@ -51,8 +108,45 @@ func NewAPI(
 
 	b := API{
 		controllerConfigService: controllerConfigService,
+		modelConfigService:      modelConfigService,
+		modelService:            modelService,
+		backupService:           backupService,
+		modelBackupService:      modelBackupService,
+		controllerObjectStore:   controllerObjectStore,
+		objectStoreForModel:     objectStoreForModel,
+		authorizer:              authorizer,
 		paths:                   &paths,
+		controllerUUID:          controllerUUID,
+		logger:                  logger,
 		machineID:               machineTag.Id(),
 	}
 	return &b, nil
 }
+
+// APIv3 provides the Backups API facade for version 3, which doesn't
+// support restoring a backup.
+type APIv3 struct {
+	*API
+}
+
+// Restore isn't supported on version 3 of the facade.
+func (*APIv3) Restore(_, _ struct{}) {}
+
+// checkCanAdmin checks that the authenticated user is a superuser of the
+// controller, as a backup holds the data of every model.
+func (a *API) checkCanAdmin(ctx context.Context) error {
+	err := a.authorizer.HasPermission(ctx, permission.SuperuserAccess, names.NewControllerTag(a.controllerUUID))
+	if err != nil {
+		return apiservererrors.ErrPerm
+	}
+	return nil
+}
+
+// backupDir returns the directory that backup archives are written to.
+func (a *API) backupDir(ctx context.Context) (string, error) {
+	cfg, err := a.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	return corebackups.BackupDir(cfg.BackupDir()), nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type backupsSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&backupsSuite{})
+
+func (s *backupsSuite) TestNewAPINotClient(c *gc.C) {
+	_, err := s.newAPI(c, names.NewMachineTag("0"))
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *backupsSuite) TestCreateNotControllerAdmin(c *gc.C) {
+	api, err := s.newAPI(c, names.NewUserTag("bob"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = api.Create(context.Background(), params.BackupsCreateArgs{})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *backupsSuite) TestRestoreNotControllerAdmin(c *gc.C) {
+	api, err := s.newAPI(c, names.NewUserTag("bob"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = api.Restore(context.Background(), params.BackupsRestoreArgs{
+		ID: "juju-backup-20250101-000000.tar.gz",
+	})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *backupsSuite) TestCheckCanAdminSuperuser(c *gc.C) {
+	api, err := s.newAPI(c, names.NewUserTag("superuser-alice"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = api.checkCanAdmin(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// newAPI returns the facade for the authenticated entity. The services are
+// never used, as the permission checks happen before anything else.
+func (s *backupsSuite) newAPI(c *gc.C, tag names.Tag) (*API, error) {
+	return NewAPI(
+		nil, nil, nil, nil, nil, nil, nil,
+		apiservertesting.FakeAuthorizer{Tag: tag},
+		names.NewMachineTag("0"),
+		coretesting.ControllerTag.Id(),
+		c.MkDir(), c.MkDir(),
+		loggertesting.WrapCheckLog(c),
+	)
+}
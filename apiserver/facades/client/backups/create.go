@@ -4,16 +4,150 @@
 package backups
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
 
-	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/agent"
+	corebackups "github.com/juju/juju/core/backups"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
 )
 
 // Create is the API method that requests juju to create a new backup
-// of its state.
-func (a *API) Create(context.Context, params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
-	result := params.BackupsMetadataResult{}
-	return result, errors.NotImplementedf("Dqlite-based backups")
+// of its state. The controller database, and the database of every model,
+// is snapshotted along with the objects in their object stores, and
+// written to an archive in the backup directory on the controller.
+func (a *API) Create(ctx context.Context, args params.BackupsCreateArgs) (params.BackupsMetadataResult, error) {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return params.BackupsMetadataResult{}, err
+	}
+
+	dir, err := a.backupDir(ctx)
+	if err != nil {
+		return params.BackupsMetadataResult{}, errors.Errorf("getting backup directory: %w", err)
+	}
+
+	controllerModel, err := a.modelService.ControllerModel(ctx)
+	if err != nil {
+		return params.BackupsMetadataResult{}, errors.Errorf("getting controller model: %w", err)
+	}
+
+	meta := corebackups.NewMetadata()
+	meta.Notes = args.Notes
+	meta.Origin = corebackups.Origin{
+		Model:    controllerModel.UUID.String(),
+		Machine:  a.machineID,
+		Hostname: hostname(),
+		Version:  jujuversion.Current,
+	}
+	meta.Controller = corebackups.ControllerMetadata{
+		UUID:      a.controllerUUID,
+		MachineID: a.machineID,
+	}
+
+	filename := meta.Started.Format(corebackups.FilenameTemplate)
+	if err := a.writeArchive(ctx, filepath.Join(dir, filename), meta); err != nil {
+		return params.BackupsMetadataResult{}, errors.Capture(err)
+	}
+
+	a.logger.Infof(ctx, "created backup %q", filename)
+	return params.CreateResult(meta, filename), nil
+}
+
+// writeArchive writes a backup archive to the file at archivePath. If the
+// backup fails, the file is removed.
+func (a *API) writeArchive(ctx context.Context, archivePath string, meta *corebackups.Metadata) (err error) {
+	f, err := os.OpenFile(archivePath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Errorf("creating backup archive: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+		if err != nil {
+			_ = os.Remove(archivePath)
+		}
+	}()
+
+	hasher := sha1.New()
+	counter := &countingWriter{w: io.MultiWriter(f, hasher)}
+	gz := gzip.NewWriter(counter)
+
+	// Each database is a separate dqlite database, so there's no read that is
+	// consistent across them. Every namespace is a consistent snapshot, but
+	// the namespaces are snapshotted one after the other, so the backup isn't
+	// a point-in-time copy of the controller as a whole.
+	aw := newArchiveWriter(gz, filepath.Dir(archivePath), a.logger)
+	if err := aw.addNamespace(ctx, namespaceSource{
+		namespace:   controllerNamespace,
+		backup:      a.backupService,
+		objectStore: a.controllerObjectStore,
+	}); err != nil {
+		return errors.Capture(err)
+	}
+
+	modelUUIDs, err := a.modelService.ListModelIDs(ctx)
+	if err != nil {
+		return errors.Errorf("listing models: %w", err)
+	}
+	for _, modelUUID := range modelUUIDs {
+		objectStore, err := a.objectStoreForModel(ctx, modelUUID)
+		if err != nil {
+			return errors.Errorf("getting object store for model %q: %w", modelUUID, err)
+		}
+		if err := aw.addNamespace(ctx, namespaceSource{
+			namespace:   modelUUID.String(),
+			backup:      a.modelBackupService(modelUUID),
+			objectStore: objectStore,
+		}); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	configPath := agent.ConfigPath(a.paths.DataDir, names.NewMachineTag(a.machineID))
+	if err := aw.addAgentConfig(configPath); err != nil {
+		return errors.Capture(err)
+	}
+	if err := aw.addMetadata(meta); err != nil {
+		return errors.Capture(err)
+	}
+	if err := aw.Close(); err != nil {
+		return errors.Errorf("writing backup archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return errors.Errorf("writing backup archive: %w", err)
+	}
+
+	checksum := base64.StdEncoding.EncodeToString(hasher.Sum(nil))
+	if err := meta.MarkComplete(counter.n, checksum); err != nil {
+		return errors.Errorf("completing backup metadata: %w", err)
+	}
+	return nil
+}
+
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return corebackups.UnknownString
+	}
+	return name
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
 }
@@ -8,22 +8,49 @@ import (
 	"reflect"
 
 	"github.com/juju/juju/apiserver/facade"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
-	registry.MustRegister("Backups", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newFacade(ctx)
+	registry.MustRegisterForMultiModel("Backups", 3, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		api, err := newFacade(ctx)
+		if err != nil {
+			return nil, errors.Errorf("creating Backups facade v3: %w", err)
+		}
+		return &APIv3{API: api}, nil
+	}, reflect.TypeOf((*APIv3)(nil)))
+	registry.MustRegisterForMultiModel("Backups", 4, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		api, err := newFacade(ctx)
+		if err != nil {
+			return nil, errors.Errorf("creating Backups facade v4: %w", err)
+		}
+		return api, nil
 	}, reflect.TypeOf((*API)(nil)))
 }
 
 // newFacade provides the required signature for facade registration.
-func newFacade(ctx facade.ModelContext) (*API, error) {
+func newFacade(ctx facade.MultiModelContext) (*API, error) {
+	domainServices := ctx.DomainServices()
 	return NewAPI(
-		ctx.DomainServices().ControllerConfig(),
+		domainServices.ControllerConfig(),
+		domainServices.Config(),
+		domainServices.Model(),
+		domainServices.Backup(),
+		func(modelUUID coremodel.UUID) BackupService {
+			return ctx.DomainServicesForModel(modelUUID).ModelBackup()
+		},
+		ctx.ControllerObjectStore(),
+		func(stdCtx context.Context, modelUUID coremodel.UUID) (objectstore.ObjectStore, error) {
+			return ctx.ObjectStoreForModel(stdCtx, modelUUID.String())
+		},
 		ctx.Auth(),
 		ctx.MachineTag(),
+		ctx.ControllerUUID(),
 		ctx.DataDir(),
 		ctx.LogDir(),
+		ctx.Logger().Child("backups"),
 	)
 }
@@ -0,0 +1,217 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	corebackups "github.com/juju/juju/core/backups"
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// preservedControllerTables are the tables of the controller database that
+// are never restored. They hold the identity, config and dqlite nodes of the
+// controller being restored into, rather than the one that was backed up,
+// which must match the agent config of the controller.
+var preservedControllerTables = []string{
+	"controller",
+	"controller_config",
+	"controller_node",
+}
+
+// Restore is the API method that restores a backup archive, that has been
+// uploaded to the backup directory on the controller, into a freshly
+// bootstrapped controller. Every file in the archive is verified against
+// its checksum before anything is restored. Once the restore is complete
+// the controller agents must be restarted.
+func (a *API) Restore(ctx context.Context, args params.BackupsRestoreArgs) error {
+	if err := a.checkCanAdmin(ctx); err != nil {
+		return err
+	}
+
+	if !corebackups.ValidFilename(args.ID) {
+		return errors.Errorf("backup %q not valid", args.ID).Add(coreerrors.NotValid)
+	}
+	dir, err := a.backupDir(ctx)
+	if err != nil {
+		return errors.Errorf("getting backup directory: %w", err)
+	}
+	archivePath := filepath.Join(dir, args.ID)
+	if _, err := os.Stat(archivePath); os.IsNotExist(err) {
+		return errors.Errorf("backup %q not found", args.ID).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return errors.Errorf("reading backup %q: %w", args.ID, err)
+	}
+
+	// Only a controller without any models of its own can be restored
+	// into, otherwise the models would be lost.
+	modelUUIDs, err := a.modelService.ListModelIDs(ctx)
+	if err != nil {
+		return errors.Errorf("listing models: %w", err)
+	}
+	if len(modelUUIDs) > 1 {
+		return errors.Errorf("restoring backup into a controller with %d models: %w", len(modelUUIDs), coreerrors.NotSupported)
+	}
+
+	open := func() (io.ReadCloser, error) {
+		return openArchive(archivePath)
+	}
+	if err := walkArchive(open, func(string, int64, io.Reader) error {
+		return nil
+	}); err != nil {
+		return errors.Errorf("verifying backup %q: %w", args.ID, err)
+	}
+
+	// The databases of the models are created as the models are restored,
+	// so track the ones that have been created.
+	created := make(map[coremodel.UUID]bool)
+	if err := walkArchive(open, func(name string, size int64, r io.Reader) error {
+		return a.restoreFile(ctx, name, size, r, created)
+	}); err != nil {
+		return errors.Errorf("restoring backup %q: %w", args.ID, err)
+	}
+
+	a.logger.Infof(ctx, "restored backup %q, controller agents must be restarted", args.ID)
+	return nil
+}
+
+// restoreFile restores a single file from a backup archive. The files are
+// restored in the order they were written, so the objects for a database
+// are always put into the object store before the database that
+// references them is restored, and the controller database is restored
+// before any of the models it registers.
+func (a *API) restoreFile(ctx context.Context, name string, size int64, r io.Reader, created map[coremodel.UUID]bool) error {
+	paths := corebackups.NewCanonicalArchivePaths()
+
+	switch dir, file := path.Split(name); {
+	case strings.HasPrefix(name, paths.ObjectsDir+"/"):
+		namespace := path.Base(dir)
+		if err := a.ensureModelDB(ctx, namespace, created); err != nil {
+			return errors.Capture(err)
+		}
+		objectStore, err := a.objectStore(ctx, namespace)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if _, err := objectStore.Put(ctx, path.Join(restoreObjectPath, file), r, size); err != nil {
+			return errors.Errorf("restoring object %q in %q: %w", file, namespace, err)
+		}
+
+	case path.Clean(dir) == paths.DBDumpDir:
+		namespace := strings.TrimSuffix(file, ".sql")
+		if namespace == controllerNamespace {
+			if err := a.backupService.Restore(ctx, r, preservedControllerTables...); err != nil {
+				return errors.Errorf("restoring controller database: %w", err)
+			}
+			break
+		}
+		if err := a.ensureModelDB(ctx, namespace, created); err != nil {
+			return errors.Capture(err)
+		}
+		modelUUID := coremodel.UUID(namespace)
+		if err := a.modelBackupService(modelUUID).Restore(ctx, r); err != nil {
+			return errors.Errorf("restoring model %q database: %w", modelUUID, err)
+		}
+
+	default:
+		// The files bundle and metadata aren't restored, the agent
+		// config of the backed up controller can be recovered from the
+		// files bundle if needed.
+		return nil
+	}
+
+	a.logger.Debugf(ctx, "restored %q", name)
+	return nil
+}
+
+// ensureModelDB creates the database of a model in a backup archive, if it
+// hasn't been already. On a freshly bootstrapped controller the database
+// doesn't exist until the model has been restored into the controller
+// database, which registers the namespace of the model database.
+func (a *API) ensureModelDB(ctx context.Context, namespace string, created map[coremodel.UUID]bool) error {
+	if namespace == controllerNamespace {
+		return nil
+	}
+	modelUUID, err := parseModelUUID(namespace)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if created[modelUUID] {
+		return nil
+	}
+
+	modelUUIDs, err := a.modelService.ListModelIDs(ctx)
+	if err != nil {
+		return errors.Errorf("listing models: %w", err)
+	}
+	if !slices.Contains(modelUUIDs, modelUUID) {
+		return errors.Errorf("model %q not found in restored controller database", modelUUID).Add(coreerrors.NotFound)
+	}
+	if err := a.modelBackupService(modelUUID).EnsureDatabase(ctx); err != nil {
+		return errors.Errorf("creating model %q database: %w", modelUUID, err)
+	}
+	created[modelUUID] = true
+	return nil
+}
+
+// objectStore returns the object store for a namespace in a backup archive.
+func (a *API) objectStore(ctx context.Context, namespace string) (objectstore.WriteObjectStore, error) {
+	if namespace == controllerNamespace {
+		return a.controllerObjectStore, nil
+	}
+	modelUUID, err := parseModelUUID(namespace)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	objectStore, err := a.objectStoreForModel(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting object store for model %q: %w", modelUUID, err)
+	}
+	return objectStore, nil
+}
+
+func parseModelUUID(namespace string) (coremodel.UUID, error) {
+	modelUUID := coremodel.UUID(namespace)
+	if err := modelUUID.Validate(); err != nil {
+		return "", errors.Errorf("unexpected namespace %q in backup: %w", namespace, err)
+	}
+	return modelUUID, nil
+}
+
+// openArchive opens a compressed backup archive for reading.
+func openArchive(archivePath string) (io.ReadCloser, error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return nil, errors.Errorf("opening backup archive: %w", err)
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Errorf("reading backup archive: %w", err)
+	}
+	return &archiveReader{Reader: gz, f: f}, nil
+}
+
+// archiveReader closes the file of a compressed archive along with the
+// decompressing reader.
+type archiveReader struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (r *archiveReader) Close() error {
+	_ = r.Reader.Close()
+	return r.f.Close()
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/charm"
 	domainmodel "github.com/juju/juju/domain/model"
 	"github.com/juju/juju/domain/port"
@@ -70,6 +72,20 @@ type ApplicationService interface {
 	// [applicationerrors.CharmNotFound]. If there are multiple charms, then the
 	// latest created at date is returned first.
 	GetLatestPendingCharmhubCharm(ctx context.Context, name string, arch arch.Arch) (charm.CharmLocator, error)
+
+	// GetAllUnitPebbleCheckStatuses returns the latest status of the Pebble
+	// checks in the workload containers of every unit in the model, keyed by
+	// unit name.
+	GetAllUnitPebbleCheckStatuses(ctx context.Context) (map[unit.Name][]application.PebbleCheckStatusInfo, error)
+
+	// GetUnitPebbleCheckStatusHistory returns the transitions between
+	// statuses of the Pebble checks in the named unit's workload containers
+	// which match the filter, oldest first.
+	GetUnitPebbleCheckStatusHistory(ctx context.Context, unitName unit.Name, filter status.StatusHistoryFilter) ([]application.PebbleCheckStatusInfo, error)
+
+	// GetUnitWorkloadStatusHistory returns the changes to the workload status
+	// of the named unit which match the filter, oldest first.
+	GetUnitWorkloadStatusHistory(ctx context.Context, unitName unit.Name, filter status.StatusHistoryFilter) ([]status.StatusInfo, error)
 }
 
 // PortService defines the methods that the facade assumes from the Port
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/client (interfaces: BlockDeviceService,NetworkService,ModelInfoService)
+// Source: github.com/juju/juju/apiserver/facades/client/client (interfaces: BlockDeviceService,NetworkService,ModelInfoService,ApplicationService)
 //
 // Generated by this command:
 //
-//	mockgen -package client -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/client BlockDeviceService,NetworkService,ModelInfoService
+//	mockgen -package client -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/client BlockDeviceService,NetworkService,ModelInfoService,ApplicationService
 //
 
 // Package client is a generated GoMock package.
@@ -16,6 +16,10 @@ import (
 	blockdevice "github.com/juju/juju/core/blockdevice"
 	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
+	status "github.com/juju/juju/core/status"
+	unit "github.com/juju/juju/core/unit"
+	application "github.com/juju/juju/domain/application"
+	charm "github.com/juju/juju/domain/application/charm"
 	model0 "github.com/juju/juju/domain/model"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -163,3 +167,101 @@ func (mr *MockModelInfoServiceMockRecorder) GetStatus(arg0 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockModelInfoService)(nil).GetStatus), arg0)
 }
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllUnitPebbleCheckStatuses mocks base method.
+func (m *MockApplicationService) GetAllUnitPebbleCheckStatuses(arg0 context.Context) (map[unit.Name][]application.PebbleCheckStatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUnitPebbleCheckStatuses", arg0)
+	ret0, _ := ret[0].(map[unit.Name][]application.PebbleCheckStatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUnitPebbleCheckStatuses indicates an expected call of GetAllUnitPebbleCheckStatuses.
+func (mr *MockApplicationServiceMockRecorder) GetAllUnitPebbleCheckStatuses(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUnitPebbleCheckStatuses", reflect.TypeOf((*MockApplicationService)(nil).GetAllUnitPebbleCheckStatuses), arg0)
+}
+
+// GetLatestPendingCharmhubCharm mocks base method.
+func (m *MockApplicationService) GetLatestPendingCharmhubCharm(arg0 context.Context, arg1, arg2 string) (charm.CharmLocator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestPendingCharmhubCharm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(charm.CharmLocator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestPendingCharmhubCharm indicates an expected call of GetLatestPendingCharmhubCharm.
+func (mr *MockApplicationServiceMockRecorder) GetLatestPendingCharmhubCharm(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestPendingCharmhubCharm", reflect.TypeOf((*MockApplicationService)(nil).GetLatestPendingCharmhubCharm), arg0, arg1, arg2)
+}
+
+// GetUnitPebbleCheckStatusHistory mocks base method.
+func (m *MockApplicationService) GetUnitPebbleCheckStatusHistory(arg0 context.Context, arg1 unit.Name, arg2 status.StatusHistoryFilter) ([]application.PebbleCheckStatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitPebbleCheckStatusHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]application.PebbleCheckStatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitPebbleCheckStatusHistory indicates an expected call of GetUnitPebbleCheckStatusHistory.
+func (mr *MockApplicationServiceMockRecorder) GetUnitPebbleCheckStatusHistory(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitPebbleCheckStatusHistory", reflect.TypeOf((*MockApplicationService)(nil).GetUnitPebbleCheckStatusHistory), arg0, arg1, arg2)
+}
+
+// GetUnitUUID mocks base method.
+func (m *MockApplicationService) GetUnitUUID(arg0 context.Context, arg1 unit.Name) (unit.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitUUID", arg0, arg1)
+	ret0, _ := ret[0].(unit.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitUUID indicates an expected call of GetUnitUUID.
+func (mr *MockApplicationServiceMockRecorder) GetUnitUUID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitUUID", reflect.TypeOf((*MockApplicationService)(nil).GetUnitUUID), arg0, arg1)
+}
+
+// GetUnitWorkloadStatusHistory mocks base method.
+func (m *MockApplicationService) GetUnitWorkloadStatusHistory(arg0 context.Context, arg1 unit.Name, arg2 status.StatusHistoryFilter) ([]status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitWorkloadStatusHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitWorkloadStatusHistory indicates an expected call of GetUnitWorkloadStatusHistory.
+func (mr *MockApplicationServiceMockRecorder) GetUnitWorkloadStatusHistory(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitWorkloadStatusHistory", reflect.TypeOf((*MockApplicationService)(nil).GetUnitWorkloadStatusHistory), arg0, arg1, arg2)
+}
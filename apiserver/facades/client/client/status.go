@@ -45,8 +45,105 @@ import (
 )
 
 // StatusHistory returns a slice of past statuses for several entities.
+// Only the history of the Pebble checks in units' workload containers is
+// currently available.
 func (c *Client) StatusHistory(ctx context.Context, request params.StatusHistoryRequests) params.StatusHistoryResults {
-	return params.StatusHistoryResults{}
+	results := params.StatusHistoryResults{
+		Results: make([]params.StatusHistoryResult, len(request.Requests)),
+	}
+	if err := c.checkCanRead(ctx); err != nil {
+		for i := range results.Results {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+		return results
+	}
+
+	for i, req := range request.Requests {
+		filter := status.StatusHistoryFilter{
+			Size:     req.Filter.Size,
+			FromDate: req.Filter.Date,
+			Delta:    req.Filter.Delta,
+			Exclude:  set.NewStrings(req.Filter.Exclude...),
+		}
+		var (
+			hist []params.DetailedStatus
+			err  error
+		)
+		switch kind := status.HistoryKind(req.Kind); kind {
+		case status.KindPebbleCheck:
+			hist, err = c.pebbleCheckStatusHistory(ctx, req.Tag, filter)
+		case status.KindUnit, status.KindWorkload:
+			hist, err = c.workloadStatusHistory(ctx, req.Tag, filter)
+		default:
+			if !kind.Valid() {
+				err = errors.NotValidf("status history kind %q", kind)
+			}
+		}
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(errors.Annotatef(err, "fetching status history for %q", req.Tag))
+			continue
+		}
+		results.Results[i].History = params.History{Statuses: hist}
+	}
+	return results
+}
+
+func (c *Client) pebbleCheckStatusHistory(ctx context.Context, tag string, filter status.StatusHistoryFilter) ([]params.DetailedStatus, error) {
+	unitTag, err := names.ParseUnitTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	history, err := c.applicationService.GetUnitPebbleCheckStatusHistory(ctx, coreunit.Name(unitTag.Id()), filter)
+	if errors.Is(err, applicationerrors.UnitNotFound) {
+		return nil, errors.NotFoundf("unit %q", unitTag.Id())
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]params.DetailedStatus, len(history))
+	for i, h := range history {
+		info := fmt.Sprintf("container %q check %q", h.ContainerName, h.CheckName)
+		if h.Message != "" {
+			info += ": " + h.Message
+		}
+		since := h.Since
+		result[i] = params.DetailedStatus{
+			Status: h.Status.String(),
+			Info:   info,
+			Data: map[string]interface{}{
+				"container": h.ContainerName,
+				"check":     h.CheckName,
+			},
+			Since: &since,
+			Kind:  status.KindPebbleCheck.String(),
+		}
+	}
+	return result, nil
+}
+
+func (c *Client) workloadStatusHistory(ctx context.Context, tag string, filter status.StatusHistoryFilter) ([]params.DetailedStatus, error) {
+	unitTag, err := names.ParseUnitTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	history, err := c.applicationService.GetUnitWorkloadStatusHistory(ctx, coreunit.Name(unitTag.Id()), filter)
+	if errors.Is(err, applicationerrors.UnitNotFound) {
+		return nil, errors.NotFoundf("unit %q", unitTag.Id())
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]params.DetailedStatus, len(history))
+	for i, h := range history {
+		result[i] = params.DetailedStatus{
+			Status: h.Status.String(),
+			Info:   h.Message,
+			Data:   h.Data,
+			Since:  h.Since,
+			Kind:   status.KindWorkload.String(),
+		}
+	}
+	return result, nil
 }
 
 // FullStatus gives the information needed for juju status over the api
@@ -95,6 +192,9 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 	if err = context.fetchAllOpenPortRanges(ctx, c.portService); err != nil {
 		return noStatus, errors.Annotate(err, "could not fetch open port ranges")
 	}
+	if context.pebbleChecks, err = c.applicationService.GetAllUnitPebbleCheckStatuses(ctx); err != nil {
+		return noStatus, errors.Annotate(err, "could not fetch pebble check statuses")
+	}
 	if context.controllerNodes, err = fetchControllerNodes(c.stateAccessor); err != nil {
 		return noStatus, errors.Annotate(err, "could not fetch controller nodes")
 	}
@@ -492,6 +592,10 @@ type statusContext struct {
 	// allOpenPortRanges: all open port ranges in the model, grouped by unit name.
 	allOpenPortRanges port.UnitGroupedPortRanges
 
+	// pebbleChecks: unit name -> statuses of the Pebble checks in the
+	// unit's workload containers.
+	pebbleChecks map[coreunit.Name][]application.PebbleCheckStatusInfo
+
 	// offers: offer name -> offer
 	offers map[string]offerStatus
 
@@ -1392,6 +1496,7 @@ func (context *statusContext) processUnit(ctx context.Context, unit *state.Unit,
 	}
 
 	result.AgentStatus, result.WorkloadStatus = context.processUnitAndAgentStatus(ctx, unit)
+	result.PebbleChecks = context.processUnitPebbleChecks(unitName)
 
 	if subUnits := unit.SubordinateNames(); len(subUnits) > 0 {
 		result.Subordinates = make(map[string]params.UnitStatus)
@@ -1573,6 +1678,28 @@ func (c *statusContext) processUnitAndAgentStatus(ctx context.Context, unit *sta
 	return
 }
 
+// processUnitPebbleChecks returns the statuses of the Pebble checks in the
+// unit's workload containers.
+func (c *statusContext) processUnitPebbleChecks(unitName coreunit.Name) []params.PebbleCheckStatus {
+	checks := c.pebbleChecks[unitName]
+	if len(checks) == 0 {
+		return nil
+	}
+	result := make([]params.PebbleCheckStatus, len(checks))
+	for i, check := range checks {
+		since := check.Since
+		result[i] = params.PebbleCheckStatus{
+			Container: check.ContainerName,
+			Check:     check.CheckName,
+			Status:    check.Status.String(),
+			Message:   check.Message,
+			Critical:  check.Critical,
+			Since:     &since,
+		}
+	}
+	return result
+}
+
 // populateStatusFromStatusInfoAndErr creates AgentStatus from the typical output
 // of a status getter.
 // TODO: make this a function that just returns a type.
@@ -17,6 +17,9 @@ import (
 	"github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	domainmodel "github.com/juju/juju/domain/model"
 	domainmodelerrors "github.com/juju/juju/domain/model/errors"
 	"github.com/juju/juju/rpc/params"
@@ -25,8 +28,9 @@ import (
 type statusSuite struct {
 	testing.IsolationSuite
 
-	modelUUID        model.UUID
-	modelInfoService *MockModelInfoService
+	modelUUID          model.UUID
+	modelInfoService   *MockModelInfoService
+	applicationService *MockApplicationService
 }
 
 var _ = gc.Suite(&statusSuite{})
@@ -34,6 +38,7 @@ var _ = gc.Suite(&statusSuite{})
 func (s *statusSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.modelInfoService = NewMockModelInfoService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
 	s.modelUUID = modeltesting.GenModelUUID(c)
 	return ctrl
 }
@@ -90,3 +95,148 @@ func (s *statusSuite) TestModelStatusModelNotFound(c *gc.C) {
 	_, err := client.modelStatus(context.Background())
 	c.Assert(err, jc.ErrorIs, errors.NotFound)
 }
+
+func (s *statusSuite) TestPebbleCheckStatusHistory(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	filter := status.StatusHistoryFilter{Size: 10}
+	s.applicationService.EXPECT().GetUnitPebbleCheckStatusHistory(gomock.Any(), coreunit.Name("app/0"), filter).Return([]application.PebbleCheckStatusInfo{{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusDown,
+		Message:       "connection refused",
+		Since:         now,
+	}, {
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusUp,
+		Since:         now.Add(time.Minute),
+	}}, nil)
+
+	client := &Client{applicationService: s.applicationService}
+	history, err := client.pebbleCheckStatusHistory(context.Background(), "unit-app-0", filter)
+	c.Assert(err, jc.ErrorIsNil)
+	later := now.Add(time.Minute)
+	c.Check(history, jc.DeepEquals, []params.DetailedStatus{{
+		Status: "down",
+		Info:   `container "redis" check "online": connection refused`,
+		Data:   map[string]interface{}{"container": "redis", "check": "online"},
+		Since:  &now,
+		Kind:   "pebble-check",
+	}, {
+		Status: "up",
+		Info:   `container "redis" check "online"`,
+		Data:   map[string]interface{}{"container": "redis", "check": "online"},
+		Since:  &later,
+		Kind:   "pebble-check",
+	}})
+}
+
+func (s *statusSuite) TestPebbleCheckStatusHistoryUnitNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	filter := status.StatusHistoryFilter{Size: 10}
+	s.applicationService.EXPECT().GetUnitPebbleCheckStatusHistory(gomock.Any(), coreunit.Name("app/0"), filter).Return(nil, applicationerrors.UnitNotFound)
+
+	client := &Client{applicationService: s.applicationService}
+	_, err := client.pebbleCheckStatusHistory(context.Background(), "unit-app-0", filter)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+
+	_, err = client.pebbleCheckStatusHistory(context.Background(), "application-app", filter)
+	c.Assert(err, gc.ErrorMatches, `"application-app" is not a valid unit tag`)
+}
+
+func (s *statusSuite) TestWorkloadStatusHistory(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	later := now.Add(time.Minute)
+	filter := status.StatusHistoryFilter{Size: 10}
+	s.applicationService.EXPECT().GetUnitWorkloadStatusHistory(gomock.Any(), coreunit.Name("app/0"), filter).Return([]status.StatusInfo{{
+		Status:  status.Error,
+		Message: `container "redis" check "online" failed`,
+		Data:    map[string]interface{}{"pebble-container": "redis", "pebble-check": "online"},
+		Since:   &now,
+	}, {
+		Status:  status.Active,
+		Message: "serving",
+		Since:   &later,
+	}}, nil)
+
+	client := &Client{applicationService: s.applicationService}
+	history, err := client.workloadStatusHistory(context.Background(), "unit-app-0", filter)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, jc.DeepEquals, []params.DetailedStatus{{
+		Status: "error",
+		Info:   `container "redis" check "online" failed`,
+		Data:   map[string]interface{}{"pebble-container": "redis", "pebble-check": "online"},
+		Since:  &now,
+		Kind:   "workload",
+	}, {
+		Status: "active",
+		Info:   "serving",
+		Since:  &later,
+		Kind:   "workload",
+	}})
+}
+
+func (s *statusSuite) TestWorkloadStatusHistoryUnitNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	filter := status.StatusHistoryFilter{Size: 10}
+	s.applicationService.EXPECT().GetUnitWorkloadStatusHistory(gomock.Any(), coreunit.Name("app/0"), filter).Return(nil, applicationerrors.UnitNotFound)
+
+	client := &Client{applicationService: s.applicationService}
+	_, err := client.workloadStatusHistory(context.Background(), "unit-app-0", filter)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *statusSuite) TestProcessUnitPebbleChecks(c *gc.C) {
+	now := time.Now()
+	context := &statusContext{
+		pebbleChecks: map[coreunit.Name][]application.PebbleCheckStatusInfo{
+			"app/0": {{
+				ContainerName: "redis",
+				CheckName:     "online",
+				Status:        application.PebbleCheckStatusDown,
+				Message:       "connection refused",
+				Critical:      true,
+				Since:         now,
+			}, {
+				ContainerName: "redis",
+				CheckName:     "ready",
+				Status:        application.PebbleCheckStatusDown,
+				Since:         now,
+			}},
+			"app/1": {{
+				ContainerName: "redis",
+				CheckName:     "online",
+				Status:        application.PebbleCheckStatusUp,
+				Critical:      true,
+				Since:         now,
+			}},
+		},
+	}
+
+	checks := context.processUnitPebbleChecks("app/0")
+	c.Check(checks, jc.DeepEquals, []params.PebbleCheckStatus{{
+		Container: "redis",
+		Check:     "online",
+		Status:    "down",
+		Message:   "connection refused",
+		Critical:  true,
+		Since:     &now,
+	}, {
+		Container: "redis",
+		Check:     "ready",
+		Status:    "down",
+		Since:     &now,
+	}})
+
+	checks = context.processUnitPebbleChecks("app/1")
+	c.Check(checks, gc.HasLen, 1)
+
+	checks = context.processUnitPebbleChecks("app/2")
+	c.Check(checks, gc.IsNil)
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelchanges
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/changelog"
+	"github.com/juju/juju/rpc/params"
+)
+
+// consumerPrefix is prepended to the names of durable consumers created by
+// the facade, so that they can't collide with internal durable consumers.
+const consumerPrefix = "model-changes:"
+
+// defaultMaxWait is the maximum amount of time that a read will block
+// waiting for changes, before returning an empty result.
+const defaultMaxWait = 30 * time.Second
+
+// ChangeLogService defines the methods that the ModelChanges facade requires
+// from the domain service.
+type ChangeLogService interface {
+	// GetLatestChangeID returns the ID of the latest change written to the
+	// model change log.
+	GetLatestChangeID(ctx context.Context) (int64, error)
+
+	// GetChanges returns the changes to the given namespaces that were
+	// written to the model change log after the change with the given ID.
+	GetChanges(ctx context.Context, after int64, namespaces []changelog.Namespace, limit int) (changelog.Changes, error)
+
+	// WatchChanges returns a notify watcher that fires whenever a change is
+	// written to the model change log for any of the given namespaces.
+	WatchChanges(ctx context.Context, namespaces []changelog.Namespace) (watcher.NotifyWatcher, error)
+
+	// StartConsumer registers the durable consumer at the latest change
+	// written to the model change log, and returns the ID of the latest
+	// change.
+	StartConsumer(ctx context.Context, consumerID string) (int64, error)
+
+	// CheckpointConsumer records that the durable consumer has seen the
+	// changes up to and including the change with the given ID.
+	CheckpointConsumer(ctx context.Context, consumerID string, id int64) error
+}
+
+// Authorizer defines the methods that the ModelChanges facade requires to
+// check permissions.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// API implements the ModelChanges facade, allowing clients to read the
+// changes made to a model, resuming from where they left off.
+type API struct {
+	modelTag   names.ModelTag
+	service    ChangeLogService
+	authorizer Authorizer
+	clock      clock.Clock
+	maxWait    time.Duration
+}
+
+// ReadChanges returns the changes made to the model after the given resume
+// token. If no resume token is given, reading starts from the latest change.
+// If wait is requested and there are no changes, the call blocks until there
+// are changes, or the maximum wait time has elapsed. The returned resume
+// token should be passed to the next call to continue reading changes.
+// If the changes after the resume token have been pruned, an error with code
+// [params.CodeModelChangesPruned] is returned, in which case the client must
+// perform a full resync and read changes from the latest change.
+// If a consumer is named, it is registered as a durable consumer of the
+// model change log, and every resume token it passes is checkpointed, so
+// that the changes after it are retained until the consumer reads them.
+// Reading from the latest change restarts the consumer.
+func (a *API) ReadChanges(ctx context.Context, args params.ModelChangesArgs) (params.ModelChangesResult, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.ReadAccess, a.modelTag); err != nil {
+		return params.ModelChangesResult{}, err
+	}
+
+	result, err := a.readChanges(ctx, args)
+	if err != nil {
+		return params.ModelChangesResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return result, nil
+}
+
+func (a *API) readChanges(ctx context.Context, args params.ModelChangesArgs) (params.ModelChangesResult, error) {
+	namespaces := make([]changelog.Namespace, len(args.Namespaces))
+	for i, namespace := range args.Namespaces {
+		namespaces[i] = changelog.Namespace(namespace)
+		if err := namespaces[i].Validate(); err != nil {
+			return params.ModelChangesResult{}, errors.NotValidf("namespace %q", namespace)
+		}
+	}
+
+	after, err := a.resumeFrom(ctx, args)
+	if err != nil {
+		return params.ModelChangesResult{}, errors.Trace(err)
+	}
+
+	if !args.Wait {
+		changes, err := a.service.GetChanges(ctx, after, namespaces, args.Limit)
+		if err != nil {
+			return params.ModelChangesResult{}, errors.Trace(err)
+		}
+		return convertChanges(changes), nil
+	}
+
+	// Start watching before reading the changes, so that we can't miss a
+	// change written between reading and starting to wait.
+	w, err := a.service.WatchChanges(ctx, namespaces)
+	if err != nil {
+		return params.ModelChangesResult{}, errors.Trace(err)
+	}
+	defer w.Kill()
+
+	timeout := a.clock.After(a.maxWait)
+
+	// Consume the initial event.
+	if ok, err := a.waitForChange(ctx, w, timeout); err != nil || !ok {
+		return params.ModelChangesResult{ResumeToken: formatToken(after)}, errors.Trace(err)
+	}
+
+	changes, err := a.service.GetChanges(ctx, after, namespaces, args.Limit)
+	if err != nil {
+		return params.ModelChangesResult{}, errors.Trace(err)
+	}
+	if len(changes.Changes) > 0 {
+		return convertChanges(changes), nil
+	}
+
+	if ok, err := a.waitForChange(ctx, w, timeout); err != nil || !ok {
+		return convertChanges(changes), errors.Trace(err)
+	}
+	if changes, err = a.service.GetChanges(ctx, changes.ResumeID, namespaces, args.Limit); err != nil {
+		return params.ModelChangesResult{}, errors.Trace(err)
+	}
+	return convertChanges(changes), nil
+}
+
+// resumeFrom returns the ID of the change to read the changes after. If the
+// read is for a durable consumer, the consumer is started or checkpointed at
+// that change.
+func (a *API) resumeFrom(ctx context.Context, args params.ModelChangesArgs) (int64, error) {
+	var consumerID string
+	if args.Consumer != "" {
+		consumerID = consumerPrefix + args.Consumer
+	}
+
+	if args.ResumeToken == "" {
+		if consumerID != "" {
+			return a.service.StartConsumer(ctx, consumerID)
+		}
+		return a.service.GetLatestChangeID(ctx)
+	}
+
+	after, err := strconv.ParseInt(args.ResumeToken, 10, 64)
+	if err != nil || after < 0 {
+		return -1, errors.NotValidf("resume token %q", args.ResumeToken)
+	}
+	if consumerID != "" {
+		// The consumer passes the resume token of its last read once it has
+		// handled the changes, so the changes up to it have been delivered.
+		if err := a.service.CheckpointConsumer(ctx, consumerID, after); err != nil {
+			return -1, errors.Trace(err)
+		}
+	}
+	return after, nil
+}
+
+// waitForChange waits for the watcher to fire, returning false if the
+// timeout expires first.
+func (a *API) waitForChange(ctx context.Context, w watcher.NotifyWatcher, timeout <-chan time.Time) (bool, error) {
+	select {
+	case <-ctx.Done():
+		return false, ctx.Err()
+	case <-timeout:
+		return false, nil
+	case _, ok := <-w.Changes():
+		if !ok {
+			return false, apiservererrors.ErrStoppedWatcher
+		}
+		return true, nil
+	}
+}
+
+func convertChanges(changes changelog.Changes) params.ModelChangesResult {
+	result := params.ModelChangesResult{
+		Changes:     make([]params.ModelChange, len(changes.Changes)),
+		ResumeToken: formatToken(changes.ResumeID),
+	}
+	for i, change := range changes.Changes {
+		result.Changes[i] = params.ModelChange{
+			Token:          formatToken(change.ID),
+			Namespace:      string(change.Namespace),
+			Entity:         change.Table,
+			Type:           string(change.Type),
+			Changed:        change.Changed,
+			ChangedColumns: change.ChangedColumns,
+			Created:        change.CreatedAt,
+		}
+	}
+	return result
+}
+
+func formatToken(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
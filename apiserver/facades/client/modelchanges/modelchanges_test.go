@@ -0,0 +1,240 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelchanges
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gomock "go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain/changelog"
+	changelogerrors "github.com/juju/juju/domain/changelog/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type modelChangesSuite struct {
+	api *API
+
+	service    *MockChangeLogService
+	authorizer *MockAuthorizer
+	clock      *testclock.Clock
+}
+
+var _ = gc.Suite(&modelChangesSuite{})
+
+func (s *modelChangesSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.service = NewMockChangeLogService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+
+	s.api = &API{
+		modelTag:   names.NewModelTag("beef1beef1-0000-0000-000011112222"),
+		service:    s.service,
+		authorizer: s.authorizer,
+		clock:      s.clock,
+		maxWait:    time.Minute,
+	}
+
+	return ctrl
+}
+
+func (s *modelChangesSuite) TestReadChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	created := time.Now()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().GetChanges(gomock.Any(), int64(41), []changelog.Namespace{changelog.Unit}, 10).Return(changelog.Changes{
+		Changes: []changelog.Change{{
+			ID:             42,
+			Namespace:      changelog.Unit,
+			Table:          "unit",
+			Type:           changelog.Updated,
+			Changed:        "unit-uuid",
+			ChangedColumns: []string{"life_id"},
+			CreatedAt:      created,
+		}},
+		ResumeID: 44,
+	}, nil)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		Namespaces:  []string{"unit"},
+		ResumeToken: "41",
+		Limit:       10,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.ModelChangesResult{
+		Changes: []params.ModelChange{{
+			Token:          "42",
+			Namespace:      "unit",
+			Entity:         "unit",
+			Type:           "update",
+			Changed:        "unit-uuid",
+			ChangedColumns: []string{"life_id"},
+			Created:        created,
+		}},
+		ResumeToken: "44",
+	})
+}
+
+func (s *modelChangesSuite) TestReadChangesFromLatest(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().GetLatestChangeID(gomock.Any()).Return(int64(100), nil)
+	s.service.EXPECT().GetChanges(gomock.Any(), int64(100), []changelog.Namespace{}, 0).Return(changelog.Changes{
+		ResumeID: 100,
+	}, nil)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.IsNil)
+	c.Check(result.Changes, gc.HasLen, 0)
+	c.Check(result.ResumeToken, gc.Equals, "100")
+}
+
+func (s *modelChangesSuite) TestReadChangesWait(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan struct{}, 2)
+	ch <- struct{}{}
+	ch <- struct{}{}
+	w := watchertest.NewMockNotifyWatcher(ch)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().WatchChanges(gomock.Any(), []changelog.Namespace{changelog.Machine}).Return(w, nil)
+	gomock.InOrder(
+		s.service.EXPECT().GetChanges(gomock.Any(), int64(5), []changelog.Namespace{changelog.Machine}, 0).Return(changelog.Changes{
+			ResumeID: 7,
+		}, nil),
+		s.service.EXPECT().GetChanges(gomock.Any(), int64(7), []changelog.Namespace{changelog.Machine}, 0).Return(changelog.Changes{
+			Changes: []changelog.Change{{
+				ID:        8,
+				Namespace: changelog.Machine,
+				Table:     "machine",
+				Type:      changelog.Created,
+				Changed:   "machine-uuid",
+			}},
+			ResumeID: 8,
+		}, nil),
+	)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		Namespaces:  []string{"machine"},
+		ResumeToken: "5",
+		Wait:        true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.IsNil)
+	c.Assert(result.Changes, gc.HasLen, 1)
+	c.Check(result.Changes[0].Token, gc.Equals, "8")
+	c.Check(result.ResumeToken, gc.Equals, "8")
+}
+
+func (s *modelChangesSuite) TestReadChangesInvalidNamespace(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		Namespaces: []string{"foo"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.ErrorMatches, `namespace "foo" not valid`)
+	c.Check(result.Error.Code, gc.Equals, params.CodeNotValid)
+}
+
+func (s *modelChangesSuite) TestReadChangesInvalidResumeToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		ResumeToken: "foo",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.ErrorMatches, `resume token "foo" not valid`)
+}
+
+func (s *modelChangesSuite) TestReadChangesPruned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().GetChanges(gomock.Any(), int64(1), []changelog.Namespace{}, 0).Return(changelog.Changes{}, changelogerrors.ChangesPruned)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		ResumeToken: "1",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(params.IsCodeModelChangesPruned(result.Error), jc.IsTrue)
+}
+
+func (s *modelChangesSuite) TestReadChangesConsumerFromLatest(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().StartConsumer(gomock.Any(), "model-changes:audit").Return(int64(100), nil)
+	s.service.EXPECT().GetChanges(gomock.Any(), int64(100), []changelog.Namespace{}, 0).Return(changelog.Changes{
+		ResumeID: 100,
+	}, nil)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		Consumer: "audit",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.IsNil)
+	c.Check(result.ResumeToken, gc.Equals, "100")
+}
+
+func (s *modelChangesSuite) TestReadChangesConsumerCheckpoints(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	gomock.InOrder(
+		s.service.EXPECT().CheckpointConsumer(gomock.Any(), "model-changes:audit", int64(41)).Return(nil),
+		s.service.EXPECT().GetChanges(gomock.Any(), int64(41), []changelog.Namespace{}, 0).Return(changelog.Changes{
+			ResumeID: 44,
+		}, nil),
+	)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		ResumeToken: "41",
+		Consumer:    "audit",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.IsNil)
+	c.Check(result.ResumeToken, gc.Equals, "44")
+}
+
+func (s *modelChangesSuite) TestReadChangesConsumerEvicted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().CheckpointConsumer(gomock.Any(), "model-changes:audit", int64(41)).Return(changelogerrors.ChangesPruned)
+
+	result, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{
+		ResumeToken: "41",
+		Consumer:    "audit",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(params.IsCodeModelChangesPruned(result.Error), jc.IsTrue)
+}
+
+func (s *modelChangesSuite) TestReadChangesPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.api.ReadChanges(context.Background(), params.ModelChangesArgs{})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
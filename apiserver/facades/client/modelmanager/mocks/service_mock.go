@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService)
+// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,ObjectStoreUsageService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/service_mock.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService
+//	mockgen -typed -package mocks -destination mocks/service_mock.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,ObjectStoreUsageService
 //
 
 // Package mocks is a generated GoMock package.
@@ -20,6 +20,7 @@ import (
 	instance "github.com/juju/juju/core/instance"
 	machine "github.com/juju/juju/core/machine"
 	model "github.com/juju/juju/core/model"
+	objectstore "github.com/juju/juju/core/objectstore"
 	permission "github.com/juju/juju/core/permission"
 	user "github.com/juju/juju/core/user"
 	access "github.com/juju/juju/domain/access"
@@ -1617,6 +1618,44 @@ func (c *MockModelDomainServicesNetworkCall) DoAndReturn(f func() modelmanager.N
 	return c
 }
 
+// ObjectStoreUsage mocks base method.
+func (m *MockModelDomainServices) ObjectStoreUsage() modelmanager.ObjectStoreUsageService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStoreUsage")
+	ret0, _ := ret[0].(modelmanager.ObjectStoreUsageService)
+	return ret0
+}
+
+// ObjectStoreUsage indicates an expected call of ObjectStoreUsage.
+func (mr *MockModelDomainServicesMockRecorder) ObjectStoreUsage() *MockModelDomainServicesObjectStoreUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStoreUsage", reflect.TypeOf((*MockModelDomainServices)(nil).ObjectStoreUsage))
+	return &MockModelDomainServicesObjectStoreUsageCall{Call: call}
+}
+
+// MockModelDomainServicesObjectStoreUsageCall wrap *gomock.Call
+type MockModelDomainServicesObjectStoreUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesObjectStoreUsageCall) Return(arg0 modelmanager.ObjectStoreUsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesObjectStoreUsageCall) Do(f func() modelmanager.ObjectStoreUsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesObjectStoreUsageCall) DoAndReturn(f func() modelmanager.ObjectStoreUsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockMachineService is a mock of MachineService interface.
 type MockMachineService struct {
 	ctrl     *gomock.Controller
@@ -1896,3 +1935,65 @@ func (c *MockModelAgentServiceGetModelTargetAgentVersionCall) DoAndReturn(f func
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockObjectStoreUsageService is a mock of ObjectStoreUsageService interface.
+type MockObjectStoreUsageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStoreUsageServiceMockRecorder
+}
+
+// MockObjectStoreUsageServiceMockRecorder is the mock recorder for MockObjectStoreUsageService.
+type MockObjectStoreUsageServiceMockRecorder struct {
+	mock *MockObjectStoreUsageService
+}
+
+// NewMockObjectStoreUsageService creates a new mock instance.
+func NewMockObjectStoreUsageService(ctrl *gomock.Controller) *MockObjectStoreUsageService {
+	mock := &MockObjectStoreUsageService{ctrl: ctrl}
+	mock.recorder = &MockObjectStoreUsageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStoreUsageService) EXPECT() *MockObjectStoreUsageServiceMockRecorder {
+	return m.recorder
+}
+
+// GetUsage mocks base method.
+func (m *MockObjectStoreUsageService) GetUsage(arg0 context.Context) (objectstore.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", arg0)
+	ret0, _ := ret[0].(objectstore.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockObjectStoreUsageServiceMockRecorder) GetUsage(arg0 any) *MockObjectStoreUsageServiceGetUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockObjectStoreUsageService)(nil).GetUsage), arg0)
+	return &MockObjectStoreUsageServiceGetUsageCall{Call: call}
+}
+
+// MockObjectStoreUsageServiceGetUsageCall wrap *gomock.Call
+type MockObjectStoreUsageServiceGetUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreUsageServiceGetUsageCall) Return(arg0 objectstore.Usage, arg1 error) *MockObjectStoreUsageServiceGetUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreUsageServiceGetUsageCall) Do(f func(context.Context) (objectstore.Usage, error)) *MockObjectStoreUsageServiceGetUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreUsageServiceGetUsageCall) DoAndReturn(f func(context.Context) (objectstore.Usage, error)) *MockObjectStoreUsageServiceGetUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -205,6 +205,7 @@ func (s *modelInfoSuite) getAPI(c *gc.C) (*modelmanager.ModelManagerAPI, *gomock
 
 	mockModelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(mockModelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, mockModelDomainServices)
 
 	modelAgentService := mocks.NewMockModelAgentService(ctrl)
 	mockModelDomainServices.EXPECT().Agent().Return(modelAgentService).AnyTimes()
@@ -279,6 +280,7 @@ func (s *modelInfoSuite) getAPIWithUser(c *gc.C, user names.UserTag) (*modelmana
 	s.mockModelDomainServices = mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter = mocks.NewMockDomainServicesGetter(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(s.mockModelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, s.mockModelDomainServices)
 	s.mockBlockCommandService = mocks.NewMockBlockCommandService(ctrl)
 	s.authorizer.Tag = user
 	cred := cloud.NewEmptyCredential()
@@ -375,6 +377,10 @@ func (s *modelInfoSuite) expectedModelInfo(c *gc.C, credentialValidity *bool) pa
 		SupportedFeatures: []params.SupportedFeature{
 			{Name: "example"},
 		},
+		ObjectStoreUsage: &params.ObjectStoreUsage{
+			Objects: 2,
+			Size:    1024,
+		},
 	}
 	info.CloudCredentialValidity = credentialValidity
 	return info
@@ -680,6 +686,7 @@ func (s *modelInfoSuite) TestAliveModelWithGetModelInfoFailure(c *gc.C) {
 	defer ctrl.Finish()
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, errors.NotFoundf("model info"))
@@ -693,6 +700,7 @@ func (s *modelInfoSuite) TestAliveModelWithGetModelTargetAgentVersionFailure(c *
 	defer ctrl.Finish()
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, nil)
@@ -735,6 +743,25 @@ func (s *modelInfoSuite) TestDeadModelGetsAllInfo(c *gc.C) {
 	s.assertSuccess(c, api, s.st.model.cfg.UUID(), state.Dead, life.Dead)
 }
 
+func (s *modelInfoSuite) TestModelInfoObjectStoreUsage(c *gc.C) {
+	api, ctrl := s.getAPI(c)
+	defer ctrl.Finish()
+	s.mockSecretBackendService.EXPECT().BackendSummaryInfoForModel(gomock.Any(), coremodel.UUID(s.st.model.cfg.UUID())).Return(nil, nil)
+	s.mockModelService.EXPECT().GetModelUsers(gomock.Any(), coremodel.UUID(s.st.model.cfg.UUID())).Return(s.modelUserInfo, nil)
+	s.mockMachineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("1")).Return("deadbeef1", nil)
+	s.mockMachineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("2")).Return("deadbeef2", nil)
+	s.mockMachineService.EXPECT().InstanceIDAndName(gomock.Any(), "deadbeef1").Return("inst-deadbeef1", "", nil)
+	s.mockMachineService.EXPECT().InstanceIDAndName(gomock.Any(), "deadbeef2").Return("inst-deadbeef2", "", nil)
+	s.mockMachineService.EXPECT().HardwareCharacteristics(gomock.Any(), "deadbeef1").Return(&instance.HardwareCharacteristics{}, nil)
+
+	s.st.model.life = state.Alive
+	info := s.getModelInfo(c, api, s.st.model.cfg.UUID())
+	c.Check(info.ObjectStoreUsage, jc.DeepEquals, &params.ObjectStoreUsage{
+		Objects: 2,
+		Size:    1024,
+	})
+}
+
 func (s *modelInfoSuite) TestDeadModelWithGetModelInfoFailure(c *gc.C) {
 	c.Skip("TODO tlm: Fix when refactoring the api into the domain services layer")
 	api, ctrl := s.getAPIWithoutModelInfo(c)
@@ -749,6 +776,7 @@ func (s *modelInfoSuite) TestDeadModelWithGetModelInfoFailure(c *gc.C) {
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, errors.NotFoundf("model info"))
@@ -776,6 +804,7 @@ func (s *modelInfoSuite) TestDeadModelWithGetModelTargetAgentVersionFailure(c *g
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, nil)
@@ -840,6 +869,7 @@ func (s *modelInfoSuite) TestDyingModelWithGetModelInfoFailure(c *gc.C) {
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, errors.NotFoundf("model info"))
@@ -867,6 +897,7 @@ func (s *modelInfoSuite) TestDyingModelWithGetModelTargetAgentVersionFailure(c *
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, nil)
@@ -947,6 +978,7 @@ func (s *modelInfoSuite) TestImportingModelWithGetModelInfoFailure(c *gc.C) {
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, errors.NotFoundf("model info"))
@@ -975,6 +1007,7 @@ func (s *modelInfoSuite) TestImportingModelWithGetModelTargetAgentVersionFailure
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
+	s.expectObjectStoreUsage(ctrl, modelDomainServices)
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
 	modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(coremodel.ModelInfo{}, nil)
@@ -1048,6 +1081,15 @@ func (s *modelInfoSuite) setModelUsersError(c *gc.C) {
 	)
 }
 
+func (s *modelInfoSuite) expectObjectStoreUsage(ctrl *gomock.Controller, modelDomainServices *mocks.MockModelDomainServices) {
+	usageService := mocks.NewMockObjectStoreUsageService(ctrl)
+	modelDomainServices.EXPECT().ObjectStoreUsage().Return(usageService).AnyTimes()
+	usageService.EXPECT().GetUsage(gomock.Any()).Return(objectstore.Usage{
+		Objects: 2,
+		Size:    1024,
+	}, nil).AnyTimes()
+}
+
 func (s *modelInfoSuite) assertSuccessWithMissingData(c *gc.C, api *modelmanager.ModelManagerAPI, test incompleteModelInfoTest) {
 	test.failModel(c)
 	// We do not expect any errors to surface and still want to get basic model info.
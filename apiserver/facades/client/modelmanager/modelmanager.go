@@ -1242,6 +1242,17 @@ func (m *ModelManagerAPI) getModelInfo(ctx context.Context, tag names.ModelTag,
 		}
 	}
 
+	usage, err := modelDomainServices.ObjectStoreUsage().GetUsage(ctx)
+	if shouldErr(err) {
+		return params.ModelInfo{}, errors.Annotate(err, "getting object store usage")
+	}
+	if err == nil {
+		info.ObjectStoreUsage = &params.ObjectStoreUsage{
+			Objects: usage.Objects,
+			Size:    usage.Size,
+		}
+	}
+
 	migration, err := st.LatestMigration()
 	if err != nil && !errors.Is(err, errors.NotFound) {
 		return params.ModelInfo{}, errors.Trace(err)
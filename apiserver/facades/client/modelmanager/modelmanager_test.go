@@ -380,6 +380,10 @@ func (s *modelManagerSuite) expectCreateModelOnModelDB(
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 
+	objectStoreUsageService := mocks.NewMockObjectStoreUsageService(ctrl)
+	modelDomainServices.EXPECT().ObjectStoreUsage().Return(objectStoreUsageService).AnyTimes()
+	objectStoreUsageService.EXPECT().GetUsage(gomock.Any()).Return(objectstore.Usage{}, nil).AnyTimes()
+
 	// Expect calls to get various model services.
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	networkService := mocks.NewMockNetworkService(ctrl)
@@ -1139,6 +1143,10 @@ func (s *modelManagerStateSuite) expectCreateModelStateSuite(
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 
+	objectStoreUsageService := mocks.NewMockObjectStoreUsageService(ctrl)
+	modelDomainServices.EXPECT().ObjectStoreUsage().Return(objectStoreUsageService).AnyTimes()
+	objectStoreUsageService.EXPECT().GetUsage(gomock.Any()).Return(objectstore.Usage{}, nil).AnyTimes()
+
 	// Expect calls to get various model services.
 	modelAgentService := mocks.NewMockModelAgentService(ctrl)
 	modelConfigService := mocks.NewMockModelConfigService(ctrl)
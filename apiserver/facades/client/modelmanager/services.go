@@ -53,6 +53,9 @@ type ModelDomainServices interface {
 
 	// Machine returns the machine service.
 	Machine() MachineService
+
+	// ObjectStoreUsage returns the object store usage service.
+	ObjectStoreUsage() ObjectStoreUsageService
 }
 
 // DomainServicesGetter is a factory for creating model services.
@@ -254,6 +257,13 @@ type SecretBackendService interface {
 	BackendSummaryInfoForModel(ctx context.Context, modelUUID coremodel.UUID) ([]*secretbackendservice.SecretBackendInfo, error)
 }
 
+// ObjectStoreUsageService provides the storage used by a model's object store.
+type ObjectStoreUsageService interface {
+	// GetUsage returns the number and total size of the distinct objects
+	// stored in the model's object store.
+	GetUsage(ctx context.Context) (objectstore.Usage, error)
+}
+
 // ApplicationService instances save an application to dqlite state.
 type ApplicationService interface {
 	// GetSupportedFeatures returns the set of features supported by the service.
@@ -332,6 +342,10 @@ func (s domainServices) Machine() MachineService {
 	return s.domainServices.Machine()
 }
 
+func (s domainServices) ObjectStoreUsage() ObjectStoreUsageService {
+	return s.domainServices.ObjectStoreUsage()
+}
+
 func (s domainServices) BlockCommand() BlockCommandService {
 	return s.domainServices.BlockCommand()
 }
@@ -6,11 +6,13 @@ package secrets
 import (
 	"testing"
 
+	"github.com/juju/clock"
 	"github.com/juju/names/v6"
 	gc "gopkg.in/check.v1"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/auditlog"
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
@@ -36,5 +38,11 @@ func NewTestAPI(
 		modelUUID:            coretesting.ModelTag.Id(),
 		secretService:        secretService,
 		secretBackendService: secretBackendService,
+		clock:                clock.WallClock,
 	}, nil
 }
+
+// SetAuditLog sets the audit log in which the facade records secret reads.
+func SetAuditLog(api *SecretsAPI, log auditlog.AuditLog) {
+	api.auditLog = func() auditlog.AuditLog { return log }
+}
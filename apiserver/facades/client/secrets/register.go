@@ -64,5 +64,7 @@ func newSecretsAPI(stdCtx context.Context, ctx facade.ModelContext) (*SecretsAPI
 		modelName:            modelInfo.Name,
 		secretService:        secretService,
 		secretBackendService: backendService,
+		auditLog:             ctx.AuditLog,
+		clock:                ctx.Clock(),
 	}, nil
 }
@@ -6,6 +6,7 @@ package secrets
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
@@ -13,6 +14,7 @@ import (
 	commonsecrets "github.com/juju/juju/apiserver/common/secrets"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/permission"
 	coresecrets "github.com/juju/juju/core/secrets"
 	domainsecret "github.com/juju/juju/domain/secret"
@@ -34,6 +36,9 @@ type SecretsAPI struct {
 
 	secretBackendService SecretBackendService
 	secretService        SecretService
+
+	auditLog func() auditlog.AuditLog
+	clock    clock.Clock
 }
 
 // SecretsAPIV1 is the backend for the Secrets facade v1.
@@ -65,7 +70,7 @@ func (s *SecretsAPI) checkCanAdmin(ctx context.Context) error {
 // If no owners are specified, we use the more generic list method when returns all types of secret.
 func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs) (params.ListSecretResults, error) {
 	result := params.ListSecretResults{}
-	if arg.ShowSecrets {
+	if arg.ShowSecrets || arg.ShowAccessLog {
 		if err := s.checkCanAdmin(ctx); err != nil {
 			return result, errors.Trace(err)
 		}
@@ -131,6 +136,7 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 		secretResult := params.ListSecretResult{
 			URI:                    m.URI.String(),
 			Version:                m.Version,
+			Type:                   string(m.Type),
 			OwnerTag:               ownerTag.String(),
 			Description:            m.Description,
 			Label:                  m.Label,
@@ -172,20 +178,37 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 					backendName = &name
 				}
 			}
-			secretResult.Revisions = append(secretResult.Revisions, params.SecretRevision{
+			rev := params.SecretRevision{
 				Revision:    r.Revision,
 				CreateTime:  r.CreateTime,
 				UpdateTime:  r.UpdateTime,
 				ExpireTime:  r.ExpireTime,
 				BackendName: backendName,
-			})
+			}
+			if r.Stage != nil {
+				rev.Stage = &params.SecretRevisionStage{
+					Status:            string(r.Stage.Status),
+					PreviousRevision:  r.Stage.PreviousRevision,
+					Deadline:          r.Stage.Deadline,
+					RollbackOnTimeout: r.Stage.RollbackOnTimeout,
+				}
+			}
+			if r.Rollback != nil {
+				rev.Rollback = &params.SecretRevisionRollback{
+					RestoredFromRevision: r.Rollback.RestoredFromRevision,
+					ReplacedRevision:     r.Rollback.ReplacedRevision,
+					Time:                 r.Rollback.Time,
+				}
+			}
+			rev.PinnedApplications = r.PinnedApplications
+			secretResult.Revisions = append(secretResult.Revisions, rev)
 		}
 		if arg.ShowSecrets {
 			rev := m.LatestRevision
 			if arg.Filter.Revision != nil {
 				rev = *arg.Filter.Revision
 			}
-			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev)
+			val, err := s.getSecretContent(ctx, m.URI, rev)
 			valueResult := &params.SecretValueResult{
 				Error: apiservererrors.ServerError(err),
 			}
@@ -194,19 +217,54 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 			}
 			secretResult.Value = valueResult
 		}
+		if arg.ShowAccessLog {
+			accessLog, err := s.secretService.GetSecretAccessLog(ctx, m.URI)
+			if err != nil {
+				return result, errors.Trace(err)
+			}
+			for _, r := range accessLog {
+				accessorTag, err := tagFromSubject(r.Accessor)
+				if err != nil {
+					return result, errors.Trace(err)
+				}
+				secretResult.AccessLog = append(secretResult.AccessLog, params.SecretAccessRecord{
+					Revision:    r.Revision,
+					AccessorTag: accessorTag.String(),
+					AccessTime:  r.AccessTime,
+				})
+			}
+		}
 		result.Results[i] = secretResult
 	}
 	return result, nil
 }
 
+// getSecretContent reads the content of the specified secret revision on
+// behalf of the authenticated user, recording the read in the audit log.
+func (s *SecretsAPI) getSecretContent(ctx context.Context, uri *coresecrets.URI, rev int) (coresecrets.SecretValue, error) {
+	val, err := s.secretService.GetSecretContentFromBackend(ctx, uri, rev, secretservice.SecretAccessor{
+		Kind: secretservice.UserAccessor,
+		ID:   s.authTag.Id(),
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := commonsecrets.AuditSecretAccess(s.auditLog, s.clock.Now(), s.modelUUID, s.authTag, uri, rev); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return val, nil
+}
+
 func tagFromSubject(access secretservice.SecretAccessor) (names.Tag, error) {
 	switch kind := access.Kind; kind {
 	case secretservice.UnitAccessor:
 		return names.NewUnitTag(access.ID), nil
-	case secretservice.ApplicationAccessor:
+	case secretservice.ApplicationAccessor, secretservice.RemoteApplicationAccessor:
 		return names.NewApplicationTag(access.ID), nil
 	case secretservice.ModelAccessor:
 		return names.NewModelTag(access.ID), nil
+	case secretservice.UserAccessor:
+		return names.NewUserTag(access.ID), nil
 	default:
 		return nil, errors.NotValidf("subject kind %q", kind)
 	}
@@ -279,6 +337,7 @@ func (s *SecretsAPI) createSecret(ctx context.Context, arg params.CreateSecretAr
 	arg.UpsertSecretArg.Content.Checksum = checksum
 	err = s.secretService.CreateUserSecret(ctx, uri, secretservice.CreateUserSecretParams{
 		Version:                secrets.Version,
+		Type:                   coresecrets.SecretType(arg.Type),
 		UpdateUserSecretParams: fromUpsertParams(s.modelUUID, nil, arg.UpsertSecretArg),
 	})
 	if err != nil {
@@ -311,6 +370,13 @@ func (s *SecretsAPI) UpdateSecrets(ctx context.Context, args params.UpdateUserSe
 		return result, errors.Trace(err)
 	}
 	for i, arg := range args.Args {
+		if arg.RollbackRevision != nil {
+			// Rolling back is an operator action.
+			if err := s.checkCanAdmin(ctx); err != nil {
+				result.Results[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+		}
 		err := s.updateSecret(ctx, arg)
 		if errors.Is(err, secreterrors.SecretLabelAlreadyExists) {
 			err = errors.AlreadyExistsf("secret with name %q", *arg.Label)
@@ -336,7 +402,9 @@ func (s *SecretsAPI) updateSecret(ctx context.Context, arg params.UpdateUserSecr
 		}
 		arg.Content.Checksum = checksum
 	}
-	err = s.secretService.UpdateUserSecret(ctx, uri, fromUpsertParams(s.modelUUID, arg.AutoPrune, arg.UpsertSecretArg))
+	p := fromUpsertParams(s.modelUUID, arg.AutoPrune, arg.UpsertSecretArg)
+	p.RollbackRevision = arg.RollbackRevision
+	err = s.secretService.UpdateUserSecret(ctx, uri, p)
 	return errors.Trace(err)
 }
 
@@ -441,3 +509,49 @@ func (s *SecretsAPI) secretsGrantRevoke(ctx context.Context, arg params.GrantRev
 	}
 	return results, nil
 }
+
+// PinSecretRevisions isn't on the v1 API.
+func (s *SecretsAPIV1) PinSecretRevisions(ctx context.Context, _ struct{}) {}
+
+// PinSecretRevisions pins the units of consuming applications to secret
+// revisions, so that they keep using them when later revisions are created.
+func (s *SecretsAPI) PinSecretRevisions(ctx context.Context, args params.SecretRevisionPinArgs) (params.ErrorResults, error) {
+	return s.secretRevisionPins(ctx, args, func(ctx context.Context, uri *coresecrets.URI, arg params.SecretRevisionPinArg) error {
+		return s.secretService.PinSecretRevision(ctx, uri, arg.Application, arg.Revision)
+	})
+}
+
+// UnpinSecretRevisions isn't on the v1 API.
+func (s *SecretsAPIV1) UnpinSecretRevisions(ctx context.Context, _ struct{}) {}
+
+// UnpinSecretRevisions removes the pins of the units of consuming
+// applications to secret revisions.
+func (s *SecretsAPI) UnpinSecretRevisions(ctx context.Context, args params.SecretRevisionPinArgs) (params.ErrorResults, error) {
+	return s.secretRevisionPins(ctx, args, func(ctx context.Context, uri *coresecrets.URI, arg params.SecretRevisionPinArg) error {
+		return s.secretService.UnpinSecretRevision(ctx, uri, arg.Application)
+	})
+}
+
+type secretRevisionPinFunc func(context.Context, *coresecrets.URI, params.SecretRevisionPinArg) error
+
+func (s *SecretsAPI) secretRevisionPins(ctx context.Context, args params.SecretRevisionPinArgs, op secretRevisionPinFunc) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		if arg.Application == "" {
+			result.Results[i].Error = apiservererrors.ServerError(errors.New("must specify an application"))
+			continue
+		}
+		uri, err := s.secretURI(ctx, arg.URI, arg.Label)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Error = apiservererrors.ServerError(op(ctx, uri, arg))
+	}
+	return result, nil
+}
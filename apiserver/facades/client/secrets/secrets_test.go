@@ -19,6 +19,8 @@ import (
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
 	apisecrets "github.com/juju/juju/apiserver/facades/client/secrets"
 	"github.com/juju/juju/apiserver/facades/client/secrets/mocks"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/permission"
 	coresecrets "github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secret"
@@ -82,6 +84,8 @@ func (s *SecretsSuite) assertListSecrets(c *gc.C, reveal bool) {
 
 	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
 	c.Assert(err, jc.ErrorIsNil)
+	var auditLog apiservertesting.FakeAuditLog
+	apisecrets.SetAuditLog(facade, &auditLog)
 
 	now := time.Now()
 	uri := coresecrets.NewURI()
@@ -111,6 +115,12 @@ func (s *SecretsSuite) assertListSecrets(c *gc.C, reveal bool) {
 			CreateTime:  now,
 			UpdateTime:  now.Add(2 * time.Second),
 			ExpireTime:  ptr(now.Add(2 * time.Hour)),
+			Rollback: &coresecrets.RevisionRollback{
+				RestoredFromRevision: 665,
+				ReplacedRevision:     666,
+				Time:                 now,
+			},
+			PinnedApplications: []string{"gitlab"},
 		}},
 	}
 
@@ -136,7 +146,10 @@ func (s *SecretsSuite) assertListSecrets(c *gc.C, reveal bool) {
 		valueResult = &params.SecretValueResult{
 			Data: map[string]string{"foo": "bar"},
 		}
-		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2).Return(
+		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2, secretservice.SecretAccessor{
+			Kind: secretservice.UserAccessor,
+			ID:   "foo",
+		}).Return(
 			coresecrets.NewSecretValue(valueResult.Data), nil,
 		)
 	}
@@ -170,12 +183,96 @@ func (s *SecretsSuite) assertListSecrets(c *gc.C, reveal bool) {
 				CreateTime:  now,
 				UpdateTime:  now.Add(2 * time.Second),
 				ExpireTime:  ptr(now.Add(2 * time.Hour)),
+				Rollback: &params.SecretRevisionRollback{
+					RestoredFromRevision: 665,
+					ReplacedRevision:     666,
+					Time:                 now,
+				},
+				PinnedApplications: []string{"gitlab"},
 			}},
 			Access: []params.AccessInfo{
 				{TargetTag: "application-gitlab", ScopeTag: "relation-gitlab.server#mysql.db", Role: "view"},
 			},
 		}},
 	})
+	if !reveal {
+		auditLog.CheckNoCalls(c)
+		return
+	}
+	c.Assert(auditLog.Calls(), gc.HasLen, 1)
+	access, ok := auditLog.Calls()[0].Args[0].(auditlog.SecretAccess)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(access.Who, gc.Equals, "user-foo")
+	c.Check(access.ModelUUID, gc.Equals, coretesting.ModelTag.Id())
+	c.Check(access.SecretURI, gc.Equals, uri.String())
+	c.Check(access.Revision, gc.Equals, 2)
+}
+
+func (s *SecretsSuite) TestListSecretsAccessLog(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+
+	now := time.Now()
+	uri := coresecrets.NewURI()
+	metadata := []*coresecrets.SecretMetadata{{
+		URI:                    uri,
+		Version:                1,
+		Owner:                  coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: coretesting.ModelTag.Id()},
+		LatestRevision:         2,
+		LatestRevisionChecksum: "checksum",
+		CreateTime:             now,
+		UpdateTime:             now,
+	}}
+	revisions := [][]*coresecrets.SecretRevisionMetadata{
+		{{
+			Revision:   2,
+			CreateTime: now,
+			UpdateTime: now,
+		}},
+	}
+
+	s.secretService.EXPECT().ListSecrets(gomock.Any(), uri, secret.NilRevision, secret.NilLabels).Return(
+		metadata, revisions, nil,
+	)
+	s.secretService.EXPECT().GetSecretGrants(gomock.Any(), uri, coresecrets.RoleView).Return(nil, nil)
+	s.secretService.EXPECT().GetSecretAccessLog(gomock.Any(), uri).Return([]secretservice.SecretAccessRecord{{
+		Revision:   1,
+		Accessor:   secretservice.SecretAccessor{Kind: secretservice.UnitAccessor, ID: "gitlab/0"},
+		AccessTime: now,
+	}, {
+		Revision:   2,
+		Accessor:   secretservice.SecretAccessor{Kind: secretservice.RemoteApplicationAccessor, ID: "remote-gitlab"},
+		AccessTime: now.Add(time.Second),
+	}, {
+		Revision:   2,
+		Accessor:   secretservice.SecretAccessor{Kind: secretservice.UserAccessor, ID: "admin"},
+		AccessTime: now.Add(2 * time.Second),
+	}}, nil)
+
+	results, err := facade.ListSecrets(context.Background(), params.ListSecretsArgs{
+		ShowAccessLog: true,
+		Filter:        params.SecretsFilter{URI: ptr(uri.String())},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].AccessLog, jc.DeepEquals, []params.SecretAccessRecord{{
+		Revision:    1,
+		AccessorTag: "unit-gitlab-0",
+		AccessTime:  now,
+	}, {
+		Revision:    2,
+		AccessorTag: "application-remote-gitlab",
+		AccessTime:  now.Add(time.Second),
+	}, {
+		Revision:    2,
+		AccessorTag: "user-admin",
+		AccessTime:  now.Add(2 * time.Second),
+	}})
 }
 
 func (s *SecretsSuite) TestListSecretsPermissionDenied(c *gc.C) {
@@ -285,6 +382,37 @@ func (s *SecretsSuite) TestCreateSecrets(c *gc.C) {
 	c.Assert(result.Results[0], gc.DeepEquals, params.StringResult{Result: uri.String()})
 }
 
+func (s *SecretsSuite) TestCreateSecretsTyped(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().CreateUserSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(func(_ context.Context, _ *coresecrets.URI, params secretservice.CreateUserSecretParams) error {
+		c.Assert(params.Type, gc.Equals, coresecrets.TypePassword)
+		c.Assert(params.UpdateUserSecretParams.Data, gc.DeepEquals, coresecrets.SecretData{"password": "czNjcmV0"})
+		return nil
+	})
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := facade.CreateSecrets(context.Background(), params.CreateSecretArgs{
+		Args: []params.CreateSecretArg{{
+			OwnerTag: coretesting.ModelTag.Id(),
+			URI:      ptr(uri.String()),
+			Type:     "password",
+			UpsertSecretArg: params.UpsertSecretArg{
+				Content: params.SecretContentParams{
+					Data: map[string]string{"password": "czNjcmV0"},
+				},
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results[0], gc.DeepEquals, params.StringResult{Result: uri.String()})
+}
+
 func (s *SecretsSuite) assertUpdateSecrets(c *gc.C, uri *coresecrets.URI) {
 	defer s.setup(c).Finish()
 
@@ -341,6 +469,132 @@ func (s *SecretsSuite) TestUpdateSecretsByName(c *gc.C) {
 	s.assertUpdateSecrets(c, nil)
 }
 
+func (s *SecretsSuite) TestUpdateSecretsRollback(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().UpdateUserSecret(gomock.Any(), uri, secretservice.UpdateUserSecretParams{
+		Accessor:         secretservice.SecretAccessor{Kind: secretservice.ModelAccessor, ID: coretesting.ModelTag.Id()},
+		RollbackRevision: ptr(1),
+	}).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := facade.UpdateSecrets(context.Background(), params.UpdateUserSecretArgs{
+		Args: []params.UpdateUserSecretArg{{
+			URI:              uri.String(),
+			RollbackRevision: ptr(1),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+}
+
+func (s *SecretsSuite) TestUpdateSecretsRollbackPermissionDenied(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := facade.UpdateSecrets(context.Background(), params.UpdateUserSecretArgs{
+		Args: []params.UpdateUserSecretArg{{
+			URI:              coresecrets.NewURI().String(),
+			RollbackRevision: ptr(1),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results[0].Error, gc.ErrorMatches, "permission denied")
+}
+
+func (s *SecretsSuite) TestPinSecretRevisions(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), "my-secret").Return(uri, nil)
+	s.secretService.EXPECT().PinSecretRevision(gomock.Any(), uri, "gitlab", 2).Return(nil)
+	s.secretService.EXPECT().PinSecretRevision(gomock.Any(), uri, "mediawiki", 2).Return(secreterrors.SecretRevisionObsolete)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := facade.PinSecretRevisions(context.Background(), params.SecretRevisionPinArgs{
+		Args: []params.SecretRevisionPinArg{{
+			Label:       "my-secret",
+			Application: "gitlab",
+			Revision:    2,
+		}, {
+			URI:         uri.String(),
+			Application: "mediawiki",
+			Revision:    2,
+		}, {
+			URI: uri.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, gc.ErrorMatches, "secret revision is obsolete")
+	c.Check(result.Results[2].Error, gc.ErrorMatches, "must specify an application")
+}
+
+func (s *SecretsSuite) TestUnpinSecretRevisions(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().UnpinSecretRevision(gomock.Any(), uri, "gitlab").Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := facade.UnpinSecretRevisions(context.Background(), params.SecretRevisionPinArgs{
+		Args: []params.SecretRevisionPinArg{{
+			URI:         uri.String(),
+			Application: "gitlab",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+}
+
+func (s *SecretsSuite) TestPinSecretRevisionsPermissionDenied(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = facade.PinSecretRevisions(context.Background(), params.SecretRevisionPinArgs{
+		Args: []params.SecretRevisionPinArg{{
+			URI:         coresecrets.NewURI().String(),
+			Application: "gitlab",
+			Revision:    2,
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
 func (s *SecretsSuite) TestRemoveSecrets(c *gc.C) {
 	defer s.setup(c).Finish()
 	s.expectAuthClient()
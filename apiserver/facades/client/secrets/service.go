@@ -23,7 +23,8 @@ type SecretService interface {
 	// View and fetch secrets.
 
 	GetUserSecretURIByLabel(ctx context.Context, label string) (*secrets.URI, error)
-	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error)
+	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int, accessor secretservice.SecretAccessor) (secrets.SecretValue, error)
+	GetSecretAccessLog(ctx context.Context, uri *secrets.URI) ([]secretservice.SecretAccessRecord, error)
 	ListSecrets(ctx context.Context, uri *secrets.URI,
 		revision *int,
 		labels domainsecret.Labels,
@@ -39,6 +40,11 @@ type SecretService interface {
 	GetSecretGrants(ctx context.Context, uri *secrets.URI, role secrets.SecretRole) ([]secretservice.SecretAccess, error)
 	GrantSecretAccess(ctx context.Context, uri *secrets.URI, p secretservice.SecretAccessParams) error
 	RevokeSecretAccess(ctx context.Context, uri *secrets.URI, p secretservice.SecretAccessParams) error
+
+	// Pin consumers to secret revisions.
+
+	PinSecretRevision(ctx context.Context, uri *secrets.URI, appName string, revision int) error
+	UnpinSecretRevision(ctx context.Context, uri *secrets.URI, appName string) error
 }
 
 // SecretBackendService provides access to the secret backend service,
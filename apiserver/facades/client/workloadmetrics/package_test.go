@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadmetrics
+
+import (
+	"testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package workloadmetrics -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/workloadmetrics WorkloadMetricsService,Authorizer
+
+func TestAll(t *testing.T) {
+	gc.TestingT(t)
+}
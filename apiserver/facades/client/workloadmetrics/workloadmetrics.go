@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadmetrics
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// WorkloadMetricsService defines the methods that the WorkloadMetrics facade
+// requires from the domain service.
+type WorkloadMetricsService interface {
+	// GetApplicationMetrics returns the metric samples published by the
+	// units of the named application. Unless history is true, only the most
+	// recent sample of each series is returned.
+	GetApplicationMetrics(ctx context.Context, appName string, history bool) ([]workloadmetrics.UnitMetric, error)
+}
+
+// Authorizer defines the methods that the WorkloadMetrics facade requires to
+// check permissions.
+type Authorizer interface {
+	// HasPermission reports whether the given access is allowed for the given
+	// target by the authenticated entity.
+	HasPermission(ctx context.Context, operation permission.Access, target names.Tag) error
+}
+
+// API implements the WorkloadMetrics facade, allowing clients to read the
+// workload metrics published by charms with the metric-add hook tool.
+type API struct {
+	modelTag   names.ModelTag
+	service    WorkloadMetricsService
+	authorizer Authorizer
+}
+
+// ApplicationMetrics returns the workload metrics published by the units of
+// the requested applications.
+func (a *API) ApplicationMetrics(ctx context.Context, args params.WorkloadMetricsQueries) (params.WorkloadMetricsResults, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.ReadAccess, a.modelTag); err != nil {
+		return params.WorkloadMetricsResults{}, err
+	}
+
+	results := params.WorkloadMetricsResults{
+		Results: make([]params.WorkloadMetricsResult, len(args.Queries)),
+	}
+	for i, query := range args.Queries {
+		metrics, err := a.applicationMetrics(ctx, query)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Metrics = metrics
+	}
+	return results, nil
+}
+
+func (a *API) applicationMetrics(ctx context.Context, query params.WorkloadMetricsQuery) ([]params.UnitWorkloadMetric, error) {
+	tag, err := names.ParseApplicationTag(query.ApplicationTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	metrics, err := a.service.GetApplicationMetrics(ctx, tag.Id(), query.History)
+	if errors.Is(err, workloadmetricserrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %q", tag.Id())
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]params.UnitWorkloadMetric, len(metrics))
+	for i, m := range metrics {
+		result[i] = params.UnitWorkloadMetric{
+			Unit: m.UnitName.String(),
+			Metric: params.WorkloadMetric{
+				Key:    m.Key,
+				Value:  m.Value,
+				Labels: m.Labels,
+				Time:   m.Time,
+			},
+		}
+	}
+	return result, nil
+}
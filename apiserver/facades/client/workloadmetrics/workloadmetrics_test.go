@@ -0,0 +1,101 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadmetrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gomock "go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type workloadMetricsSuite struct {
+	api *API
+
+	service    *MockWorkloadMetricsService
+	authorizer *MockAuthorizer
+}
+
+var _ = gc.Suite(&workloadMetricsSuite{})
+
+func (s *workloadMetricsSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.service = NewMockWorkloadMetricsService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+
+	s.api = &API{
+		modelTag:   names.NewModelTag("beef1beef1-0000-0000-000011112222"),
+		service:    s.service,
+		authorizer: s.authorizer,
+	}
+
+	return ctrl
+}
+
+func (s *workloadMetricsSuite) TestApplicationMetrics(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sampled := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(nil)
+	s.service.EXPECT().GetApplicationMetrics(gomock.Any(), "app", false).Return([]workloadmetrics.UnitMetric{{
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:    "queue_depth",
+			Value:  42,
+			Labels: map[string]string{"queue": "orders"},
+			Time:   sampled,
+		},
+	}}, nil)
+	s.service.EXPECT().GetApplicationMetrics(gomock.Any(), "other", true).Return(nil, nil)
+	s.service.EXPECT().GetApplicationMetrics(gomock.Any(), "missing", false).Return(nil, workloadmetricserrors.ApplicationNotFound)
+
+	results, err := s.api.ApplicationMetrics(context.Background(), params.WorkloadMetricsQueries{
+		Queries: []params.WorkloadMetricsQuery{
+			{ApplicationTag: "application-app"},
+			{ApplicationTag: "application-other", History: true},
+			{ApplicationTag: "application-missing"},
+			{ApplicationTag: "unit-app-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 4)
+	c.Check(results.Results[0], jc.DeepEquals, params.WorkloadMetricsResult{
+		Metrics: []params.UnitWorkloadMetric{{
+			Unit: "app/0",
+			Metric: params.WorkloadMetric{
+				Key:    "queue_depth",
+				Value:  42,
+				Labels: map[string]string{"queue": "orders"},
+				Time:   sampled,
+			},
+		}},
+	})
+	c.Check(results.Results[1], jc.DeepEquals, params.WorkloadMetricsResult{
+		Metrics: []params.UnitWorkloadMetric{},
+	})
+	c.Check(results.Results[2].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(results.Results[2].Error, gc.ErrorMatches, `application "missing" not found`)
+	c.Check(results.Results[3].Error, gc.ErrorMatches, `"unit-app-0" is not a valid application tag`)
+}
+
+func (s *workloadMetricsSuite) TestApplicationMetricsPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, s.api.modelTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.api.ApplicationMetrics(context.Background(), params.WorkloadMetricsQueries{
+		Queries: []params.WorkloadMetricsQuery{{ApplicationTag: "application-app"}},
+	})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
@@ -11,15 +11,18 @@ import (
 	"sync"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"gopkg.in/macaroon.v2"
 
 	"github.com/juju/juju/apiserver/common/crossmodel"
+	commonsecrets "github.com/juju/juju/apiserver/common/secrets"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
 	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/auditlog"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
@@ -51,6 +54,8 @@ type CrossModelSecretsAPI struct {
 	secretBackendService SecretBackendService
 	crossModelState      CrossModelState
 	stateBackend         StateBackend
+	auditLog             func() auditlog.AuditLog
+	clock                clock.Clock
 	logger               corelogger.Logger
 }
 
@@ -64,6 +69,8 @@ func NewCrossModelSecretsAPI(
 	secretBackendService SecretBackendService,
 	crossModelState CrossModelState,
 	stateBackend StateBackend,
+	auditLog func() auditlog.AuditLog,
+	clock clock.Clock,
 	logger corelogger.Logger,
 ) (*CrossModelSecretsAPI, error) {
 	return &CrossModelSecretsAPI{
@@ -75,6 +82,8 @@ func NewCrossModelSecretsAPI(
 		secretBackendService: secretBackendService,
 		crossModelState:      crossModelState,
 		stateBackend:         stateBackend,
+		auditLog:             auditLog,
+		clock:                clock,
 		logger:               logger,
 	}, nil
 }
@@ -274,9 +283,15 @@ func (s *CrossModelSecretsAPI) getSecretContent(ctx context.Context, arg params.
 		ID:   consumer.Id(),
 	}
 	val, valueRef, err := secretService.GetSecretValue(ctx, uri, wantRevision, accessor)
+	if err != nil {
+		return nil, nil, latestRevision, errors.Trace(err)
+	}
+	if err := commonsecrets.AuditSecretAccess(s.auditLog, s.clock.Now(), s.modelID.String(), consumer, uri, wantRevision); err != nil {
+		return nil, nil, latestRevision, errors.Trace(err)
+	}
 	content := &secrets.ContentParams{SecretValue: val, ValueRef: valueRef}
-	if err != nil || content.ValueRef == nil {
-		return content, nil, latestRevision, errors.Trace(err)
+	if content.ValueRef == nil {
+		return content, nil, latestRevision, nil
 	}
 
 	// Older controllers will not set the controller UUID in the arg, which means
@@ -10,8 +10,10 @@ import (
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery/checkers"
 	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
@@ -22,6 +24,8 @@ import (
 	"github.com/juju/juju/apiserver/common/crossmodel"
 	"github.com/juju/juju/apiserver/facades/controller/crossmodelsecrets"
 	"github.com/juju/juju/apiserver/facades/controller/crossmodelsecrets/mocks"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
 	secretservice "github.com/juju/juju/domain/secret/service"
@@ -42,6 +46,8 @@ type CrossModelSecretsSuite struct {
 	secretBackendService *mocks.MockSecretBackendService
 	crossModelState      *mocks.MockCrossModelState
 	stateBackend         *mocks.MockStateBackend
+	auditLog             *apiservertesting.FakeAuditLog
+	clock                *testclock.Clock
 
 	facade *crossmodelsecrets.CrossModelSecretsAPI
 
@@ -111,6 +117,12 @@ func (s *CrossModelSecretsSuite) setup(c *gc.C) *gomock.Controller {
 		return s.secretService
 	}
 
+	s.auditLog = &apiservertesting.FakeAuditLog{}
+	s.clock = testclock.NewClock(time.Now())
+	auditLog := func() auditlog.AuditLog {
+		return s.auditLog
+	}
+
 	var err error
 	s.facade, err = crossmodelsecrets.NewCrossModelSecretsAPI(
 		s.resources,
@@ -121,6 +133,8 @@ func (s *CrossModelSecretsSuite) setup(c *gc.C) *gomock.Controller {
 		s.secretBackendService,
 		s.crossModelState,
 		s.stateBackend,
+		auditLog,
+		s.clock,
 		loggertesting.WrapCheckLog(c),
 	)
 	c.Assert(err, jc.ErrorIsNil)
@@ -278,4 +292,14 @@ func (s *CrossModelSecretsSuite) assertGetSecretContentInfo(c *gc.C, newConsumer
 			},
 		}},
 	})
+	s.auditLog.CheckCalls(c, []testing.StubCall{{
+		FuncName: "AddSecretAccess",
+		Args: []interface{}{auditlog.SecretAccess{
+			Who:       "unit-remote-app-666",
+			When:      s.clock.Now().UTC().Format(time.RFC3339),
+			ModelUUID: coretesting.ModelTag.Id(),
+			SecretURI: uri.String(),
+			Revision:  667,
+		}},
+	}})
 }
@@ -69,6 +69,8 @@ func makeStateCrossModelSecretsAPI(stdCtx context.Context, ctx facade.MultiModel
 		backendService,
 		&crossModelShim{st.RemoteEntities()},
 		&stateBackendShim{st},
+		ctx.AuditLog,
+		ctx.Clock(),
 		ctx.Logger().Child("crossmodelsecrets", corelogger.SECRETS),
 	)
 }
@@ -18,6 +18,7 @@ import (
 	internalhttp "github.com/juju/juju/apiserver/internal/http"
 	"github.com/juju/juju/core/arch"
 	corecharm "github.com/juju/juju/core/charm"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/architecture"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
@@ -184,7 +185,11 @@ func (h *ObjectsCharmHTTPHandler) ServePut(w http.ResponseWriter, r *http.Reques
 
 	// Add a charm to the store provider.
 	charmURL, err := h.processPut(ctx, r, st, applicationService)
-	if err != nil {
+	if errors.Is(err, coreerrors.QuotaLimitExceeded) {
+		// The charm is valid, but there is no room for it in the object
+		// store, so don't report it as a bad request.
+		return errors.Capture(err)
+	} else if err != nil {
 		return jujuerrors.NewBadRequest(err, "")
 	}
 	headers := map[string]string{
@@ -0,0 +1,142 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package workloadmetrics provides a handler serving the workload metrics
+// published by charms in the Prometheus text format.
+package workloadmetrics
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/domain/workloadmetrics"
+	"github.com/juju/juju/internal/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+)
+
+var logger = internallogger.GetLogger("juju.apiserver.workloadmetrics")
+
+const (
+	// metricPrefix is prepended to the keys of the workload metrics to
+	// give the names of the exposed metrics.
+	metricPrefix = "juju_workload_"
+
+	// contentType is the content type of the Prometheus text format.
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// WorkloadMetricsService provides the workload metrics published by the
+// units of a model.
+type WorkloadMetricsService interface {
+	// GetLatestMetrics returns the most recent sample of each
+	// series published by the units in the model.
+	GetLatestMetrics(ctx context.Context) ([]workloadmetrics.UnitMetric, error)
+}
+
+// WorkloadMetricsServiceGetter provides the workload metrics service for
+// the model of a request.
+type WorkloadMetricsServiceGetter interface {
+	WorkloadMetrics(*http.Request) (WorkloadMetricsService, error)
+}
+
+// WorkloadMetricsHandler serves the latest workload metrics of a model in
+// the Prometheus text format, so the controller can be scraped for them.
+type WorkloadMetricsHandler struct {
+	serviceGetter WorkloadMetricsServiceGetter
+}
+
+// NewWorkloadMetricsHandler returns a new WorkloadMetricsHandler.
+func NewWorkloadMetricsHandler(serviceGetter WorkloadMetricsServiceGetter) *WorkloadMetricsHandler {
+	return &WorkloadMetricsHandler{
+		serviceGetter: serviceGetter,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *WorkloadMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+		return
+	}
+	if err := h.serveGet(w, r); err != nil {
+		perr, status := apiservererrors.ServerErrorAndStatus(err)
+		http.Error(w, perr.Message, status)
+	}
+}
+
+func (h *WorkloadMetricsHandler) serveGet(w http.ResponseWriter, r *http.Request) error {
+	service, err := h.serviceGetter.WorkloadMetrics(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	metrics, err := service.GetLatestMetrics(r.Context())
+	if err != nil {
+		return errors.Errorf("getting workload metrics: %w", err)
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+
+	buf := bufio.NewWriter(w)
+	writeMetrics(buf, r.URL.Query().Get(":modeluuid"), metrics)
+	if err := buf.Flush(); err != nil {
+		logger.Debugf(r.Context(), "writing workload metrics: %v", err)
+	}
+	return nil
+}
+
+// writeMetrics writes the metrics in the Prometheus text format. All the
+// series of a metric are written together, after a single TYPE line.
+func writeMetrics(w *bufio.Writer, modelUUID string, metrics []workloadmetrics.UnitMetric) {
+	sorted := make([]workloadmetrics.UnitMetric, len(metrics))
+	copy(sorted, metrics)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Key != sorted[j].Key {
+			return sorted[i].Key < sorted[j].Key
+		}
+		return sorted[i].UnitName < sorted[j].UnitName
+	})
+
+	var lastKey string
+	for _, m := range sorted {
+		name := metricPrefix + m.Key
+		if m.Key != lastKey {
+			fmt.Fprintf(w, "# TYPE %s gauge\n", name)
+			lastKey = m.Key
+		}
+
+		application, _, _ := strings.Cut(m.UnitName.String(), "/")
+		labels := []string{
+			formatLabel("juju_model_uuid", modelUUID),
+			formatLabel("juju_application", application),
+			formatLabel("juju_unit", m.UnitName.String()),
+		}
+		names := make([]string, 0, len(m.Labels))
+		for name := range m.Labels {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			labels = append(labels, formatLabel(name, m.Labels[name]))
+		}
+
+		fmt.Fprintf(w, "%s{%s} %s %d\n",
+			name,
+			strings.Join(labels, ","),
+			strconv.FormatFloat(m.Value, 'g', -1, 64),
+			m.Time.UnixMilli(),
+		)
+	}
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatLabel(name, value string) string {
+	return name + `="` + labelValueReplacer.Replace(value) + `"`
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadmetrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gomock "go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/domain/workloadmetrics"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/testing"
+)
+
+const workloadMetricsRoute = "/model/:modeluuid/workload-metrics"
+
+type handlerSuite struct {
+	serviceGetter *MockWorkloadMetricsServiceGetter
+	service       *MockWorkloadMetricsService
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+var _ = gc.Suite(&handlerSuite{})
+
+func (s *handlerSuite) SetUpTest(c *gc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *handlerSuite) TearDownTest(c *gc.C) {
+	s.srv.Close()
+}
+
+func (s *handlerSuite) TestServeGet(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sampled := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.serviceGetter.EXPECT().WorkloadMetrics(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().GetLatestMetrics(gomock.Any()).Return([]workloadmetrics.UnitMetric{{
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:    "queue_depth",
+			Value:  42,
+			Labels: map[string]string{"queue": "orders", "region": "eu"},
+			Time:   sampled,
+		},
+	}, {
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:   "lag_seconds",
+			Value: 0.5,
+			Time:  sampled,
+		},
+	}, {
+		UnitName: "app/1",
+		Metric: workloadmetrics.Metric{
+			Key:    "queue_depth",
+			Value:  7,
+			Labels: map[string]string{"queue": "say \"hi\"\\\n"},
+			Time:   sampled,
+		},
+	}}, nil)
+
+	s.mux.AddHandler("GET", workloadMetricsRoute, NewWorkloadMetricsHandler(s.serviceGetter))
+	defer s.mux.RemoveHandler("GET", workloadMetricsRoute)
+
+	modelUUID := testing.ModelTag.Id()
+	resp, err := http.Get(fmt.Sprintf("%s/model/%s/workload-metrics", s.srv.URL, modelUUID))
+	c.Assert(err, jc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), gc.Equals, "text/plain; version=0.0.4; charset=utf-8")
+
+	body, err := io.ReadAll(resp.Body)
+	c.Assert(err, jc.ErrorIsNil)
+	labels := fmt.Sprintf(`juju_model_uuid="%s",juju_application="app"`, modelUUID)
+	c.Check(string(body), gc.Equals, strings.Join([]string{
+		"# TYPE juju_workload_lag_seconds gauge",
+		`juju_workload_lag_seconds{` + labels + `,juju_unit="app/0"} 0.5 1740830400000`,
+		"# TYPE juju_workload_queue_depth gauge",
+		`juju_workload_queue_depth{` + labels + `,juju_unit="app/0",queue="orders",region="eu"} 42 1740830400000`,
+		`juju_workload_queue_depth{` + labels + `,juju_unit="app/1",queue="say \"hi\"\\\n"} 7 1740830400000`,
+	}, "\n")+"\n")
+}
+
+func (s *handlerSuite) TestServeGetNoMetrics(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.serviceGetter.EXPECT().WorkloadMetrics(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().GetLatestMetrics(gomock.Any()).Return(nil, nil)
+
+	s.mux.AddHandler("GET", workloadMetricsRoute, NewWorkloadMetricsHandler(s.serviceGetter))
+	defer s.mux.RemoveHandler("GET", workloadMetricsRoute)
+
+	resp, err := http.Get(fmt.Sprintf("%s/model/%s/workload-metrics", s.srv.URL, testing.ModelTag.Id()))
+	c.Assert(err, jc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusOK)
+
+	body, err := io.ReadAll(resp.Body)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(body), gc.Equals, "")
+}
+
+func (s *handlerSuite) TestServeGetError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.serviceGetter.EXPECT().WorkloadMetrics(gomock.Any()).Return(s.service, nil)
+	s.service.EXPECT().GetLatestMetrics(gomock.Any()).Return(nil, errors.New("boom"))
+
+	s.mux.AddHandler("GET", workloadMetricsRoute, NewWorkloadMetricsHandler(s.serviceGetter))
+	defer s.mux.RemoveHandler("GET", workloadMetricsRoute)
+
+	resp, err := http.Get(fmt.Sprintf("%s/model/%s/workload-metrics", s.srv.URL, testing.ModelTag.Id()))
+	c.Assert(err, jc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, gc.Equals, http.StatusInternalServerError)
+}
+
+func (s *handlerSuite) TestServeMethodNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mux.AddHandler("POST", workloadMetricsRoute, NewWorkloadMetricsHandler(s.serviceGetter))
+	defer s.mux.RemoveHandler("POST", workloadMetricsRoute)
+
+	url := fmt.Sprintf("%s/model/%s/workload-metrics", s.srv.URL, testing.ModelTag.Id())
+	resp, err := http.Post(url, "text/plain", strings.NewReader(""))
+	c.Assert(err, jc.ErrorIsNil)
+	defer resp.Body.Close()
+	c.Check(resp.StatusCode, gc.Equals, http.StatusNotImplemented)
+}
+
+func (s *handlerSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.serviceGetter = NewMockWorkloadMetricsServiceGetter(ctrl)
+	s.service = NewMockWorkloadMetricsService(ctrl)
+	return ctrl
+}
@@ -35,17 +35,20 @@ func (h introspectionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
 func (h introspectionHandler) checkAuth(r *http.Request) error {
-	st, entity, err := h.ctx.stateAndEntityForRequestAuthenticatedUser(r)
+	return checkReadAccess(h.ctx, r)
+}
+
+// checkReadAccess checks that the user making the request has "superuser"
+// access on the controller, or "read" access on the controller model or on
+// one of the given models.
+func checkReadAccess(ctxt httpContext, r *http.Request, models ...names.ModelTag) error {
+	st, entity, err := ctxt.stateAndEntityForRequestAuthenticatedUser(r)
 	if err != nil {
 		return err
 	}
 	defer st.Release()
 
-	// Users with "superuser" access on the controller,
-	// or "read" access on the controller model, can
-	// access these endpoints.
-
-	accessService := h.ctx.srv.shared.domainServicesGetter.ServicesForModel(h.ctx.srv.shared.controllerModelUUID).Access()
+	accessService := ctxt.srv.shared.domainServicesGetter.ServicesForModel(ctxt.srv.shared.controllerModelUUID).Access()
 
 	userPermission := func(ctx context.Context, userName coreuser.Name, target permission.ID) (permission.Access, error) {
 		if objectType := target.ObjectType; !(objectType == permission.Controller || objectType == permission.Model) {
@@ -70,18 +73,21 @@ func (h introspectionHandler) checkAuth(r *http.Request) error {
 		return nil
 	}
 
-	ok, err = common.HasPermission(
-		r.Context(),
-		userPermission,
-		entity.Tag(),
-		permission.ReadAccess,
-		names.NewModelTag(st.ControllerModelUUID()),
-	)
-	if err != nil {
-		return err
-	}
-	if ok {
-		return nil
+	models = append([]names.ModelTag{names.NewModelTag(st.ControllerModelUUID())}, models...)
+	for _, model := range models {
+		ok, err = common.HasPermission(
+			r.Context(),
+			userPermission,
+			entity.Tag(),
+			permission.ReadAccess,
+			model,
+		)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
 	}
 
 	return &params.Error{
@@ -76,6 +76,12 @@ func (l *bufferedLog) AddResponse(r auditlog.ResponseErrors) error {
 	return l.dest.AddResponse(r)
 }
 
+// AddSecretAccess implements auditlog.AuditLog. Secret reads
+// are always interesting, so they are forwarded immediately.
+func (l *bufferedLog) AddSecretAccess(a auditlog.SecretAccess) error {
+	return l.dest.AddSecretAccess(a)
+}
+
 // Close implements auditlog.AuditLog.
 func (l *bufferedLog) Close() error {
 	return errors.Trace(l.dest.Close())
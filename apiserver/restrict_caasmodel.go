@@ -42,6 +42,7 @@ var commonModelFacadeNames = set.NewStrings(
 	"MigrationMinion",
 	"MigrationStatusWatcher",
 	"MigrationTarget",
+	"ModelChanges",
 	"ModelConfig",
 	"NotifyWatcher",
 	"OfferStatusWatcher",
@@ -76,6 +77,7 @@ var commonModelFacadeNames = set.NewStrings(
 	"Uniter",
 	"Upgrader",
 	"VolumeAttachmentsWatcher",
+	"WorkloadMetrics",
 	"RemoteRelationWatcher",
 	"SSHClient",
 )
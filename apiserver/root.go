@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
 	corehttp "github.com/juju/juju/core/http"
@@ -1027,6 +1028,12 @@ func (ctx *facadeContext) Logger() corelogger.Logger {
 	return ctx.r.shared.logger
 }
 
+// AuditLog returns the controller audit log, or nil if audit
+// logging is disabled.
+func (ctx *facadeContext) AuditLog() auditlog.AuditLog {
+	return ctx.r.shared.auditLog()
+}
+
 // Clock returns the clock instance.
 func (ctx *facadeContext) Clock() clock.Clock {
 	return ctx.r.clock
@@ -1058,7 +1065,7 @@ func (ctx *facadeContext) migrationScope(modelUUID model.UUID) modelmigration.Sc
 			return ctx.modelDB(modelUUID)
 		}),
 		ctx.r.shared.dbDeleter,
-	)
+	).WithSecretContentKeys(ctx.r.shared.secretContentKeys.Encode())
 }
 
 // DomainServicesForModel returns the services factory for a given
@@ -14,6 +14,7 @@ import (
 
 	"github.com/juju/juju/apiserver/facade"
 	jujucontroller "github.com/juju/juju/controller"
+	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/lease"
@@ -22,6 +23,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/internal/pubsub/controller"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/trace"
 	"github.com/juju/juju/state"
@@ -58,6 +60,10 @@ type sharedServerContext struct {
 	// and the model is being removed.
 	dbDeleter database.DBDeleter
 
+	// secretContentKeys wrap the keys which encrypt secret content stored
+	// in model databases, used when migrating models.
+	secretContentKeys envelope.KeyRing
+
 	// DomainServicesGetter is used to get the domain services for controllers
 	// and models.
 	domainServicesGetter services.DomainServicesGetter
@@ -83,6 +89,9 @@ type sharedServerContext struct {
 	dataDir    string
 	logDir     string
 
+	// getAuditConfig returns the current audit logging config.
+	getAuditConfig func() auditlog.Config
+
 	unsubscribe func()
 }
 
@@ -99,12 +108,14 @@ type sharedServerConfig struct {
 
 	dbGetter             changestream.WatchableDBGetter
 	dbDeleter            database.DBDeleter
+	secretContentKeys    envelope.KeyRing
 	domainServicesGetter services.DomainServicesGetter
 	tracerGetter         trace.TracerGetter
 	objectStoreGetter    objectstore.ObjectStoreGetter
 	machineTag           names.Tag
 	dataDir              string
 	logDir               string
+	getAuditConfig       func() auditlog.Config
 }
 
 func (c *sharedServerConfig) validate() error {
@@ -163,12 +174,14 @@ func newSharedServerContext(config sharedServerConfig) (*sharedServerContext, er
 		charmhubHTTPClient:   config.charmhubHTTPClient,
 		dbGetter:             config.dbGetter,
 		dbDeleter:            config.dbDeleter,
+		secretContentKeys:    config.secretContentKeys,
 		domainServicesGetter: config.domainServicesGetter,
 		tracerGetter:         config.tracerGetter,
 		objectStoreGetter:    config.objectStoreGetter,
 		machineTag:           config.machineTag,
 		dataDir:              config.dataDir,
 		logDir:               config.logDir,
+		getAuditConfig:       config.getAuditConfig,
 	}
 	ctx.features = config.controllerConfig.Features()
 	// We are able to get the current controller config before subscribing to changes
@@ -184,6 +197,18 @@ func newSharedServerContext(config sharedServerConfig) (*sharedServerContext, er
 	return ctx, nil
 }
 
+// auditLog returns the audit log, or nil if audit logging is disabled.
+func (c *sharedServerContext) auditLog() auditlog.AuditLog {
+	if c == nil || c.getAuditConfig == nil {
+		return nil
+	}
+	cfg := c.getAuditConfig()
+	if !cfg.Enabled {
+		return nil
+	}
+	return cfg.Target
+}
+
 func (c *sharedServerContext) Close() {
 	c.unsubscribe()
 }
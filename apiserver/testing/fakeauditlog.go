@@ -29,6 +29,11 @@ func (l *FakeAuditLog) AddResponse(m auditlog.ResponseErrors) error {
 	return l.Stub.NextErr()
 }
 
+func (l *FakeAuditLog) AddSecretAccess(m auditlog.SecretAccess) error {
+	l.Stub.AddCall("AddSecretAccess", m)
+	return l.Stub.NextErr()
+}
+
 func (l *FakeAuditLog) Close() error {
 	l.Stub.AddCall("Close")
 	return l.Stub.NextErr()
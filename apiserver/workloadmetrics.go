@@ -0,0 +1,49 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/httpcontext"
+	handlersworkloadmetrics "github.com/juju/juju/apiserver/internal/handlers/workloadmetrics"
+	internalerrors "github.com/juju/juju/internal/errors"
+)
+
+// workloadMetricsHandler is an http.Handler that wraps the workload metrics
+// handler, adding authorization. The users allowed to access the
+// introspection endpoints, such as the metrics users created through the
+// controller socket, can scrape the workload metrics of any model. Other
+// users need "read" access on the model.
+type workloadMetricsHandler struct {
+	ctx     httpContext
+	handler http.Handler
+}
+
+// ServeHTTP is part of the http.Handler interface.
+func (h workloadMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	modelUUID, _ := httpcontext.RequestModelUUID(r.Context())
+	if err := checkReadAccess(h.ctx, r, names.NewModelTag(modelUUID)); err != nil {
+		if err := sendError(w, err); err != nil {
+			logger.Debugf(context.TODO(), "%v", err)
+		}
+		return
+	}
+	h.handler.ServeHTTP(w, r)
+}
+
+type workloadMetricsServiceGetter struct {
+	ctxt httpContext
+}
+
+func (a *workloadMetricsServiceGetter) WorkloadMetrics(r *http.Request) (handlersworkloadmetrics.WorkloadMetricsService, error) {
+	domainServices, err := a.ctxt.domainServicesForRequest(r.Context())
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+	return domainServices.WorkloadMetrics(), nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apitesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access/service"
+	"github.com/juju/juju/internal/auth"
+	"github.com/juju/juju/juju/testing"
+)
+
+type workloadMetricsSuite struct {
+	testing.ApiServerSuite
+	url string
+}
+
+var _ = gc.Suite(&workloadMetricsSuite{})
+
+func (s *workloadMetricsSuite) SetUpTest(c *gc.C) {
+	s.ApiServerSuite.SetUpTest(c)
+	s.url = s.URL("/model/"+s.ControllerModelUUID()+"/workload-metrics", url.Values{}).String()
+}
+
+func (s *workloadMetricsSuite) TestAccess(c *gc.C) {
+	s.testAccess(c, testing.AdminUser.String(), testing.AdminSecret)
+
+	// Metrics users only have read access on the controller model.
+	userTag := s.addUser(c)
+	_, err := s.ControllerDomainServices(c).Access().CreatePermission(context.Background(), permission.UserAccessSpec{
+		AccessSpec: permission.AccessSpec{
+			Target: permission.ID{
+				ObjectType: permission.Model,
+				Key:        s.ControllerModelUUID(),
+			},
+			Access: permission.ReadAccess,
+		},
+		User: user.NameFromTag(userTag),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.testAccess(c, userTag.String(), "hunter2")
+}
+
+func (s *workloadMetricsSuite) TestAccessDenied(c *gc.C) {
+	userTag := s.addUser(c)
+
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:   "GET",
+		URL:      s.url,
+		Tag:      userTag.String(),
+		Password: "hunter2",
+	})
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusForbidden)
+}
+
+func (s *workloadMetricsSuite) addUser(c *gc.C) names.UserTag {
+	userTag := names.NewUserTag("bobbrown")
+	_, _, err := s.ControllerDomainServices(c).Access().AddUser(context.Background(), service.AddUserArg{
+		Name:        user.NameFromTag(userTag),
+		DisplayName: "Bob Brown",
+		CreatorUUID: s.AdminUserUUID,
+		Password:    ptr(auth.NewPassword("hunter2")),
+		Permission: permission.AccessSpec{
+			Access: permission.LoginAccess,
+			Target: permission.ID{
+				ObjectType: permission.Controller,
+				Key:        s.ControllerUUID,
+			},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return userTag
+}
+
+func (s *workloadMetricsSuite) testAccess(c *gc.C, tag, password string) {
+	resp := apitesting.SendHTTPRequest(c, apitesting.HTTPRequestParams{
+		Method:   "GET",
+		URL:      s.url,
+		Tag:      tag,
+		Password: password,
+	})
+	defer resp.Body.Close()
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), gc.Equals, "text/plain; version=0.0.4; charset=utf-8")
+	content, err := io.ReadAll(resp.Body)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(content), gc.Equals, "")
+}
@@ -179,3 +179,26 @@ func NewListOperationsCommandForTest(store jujuclient.ClientStore) (cmd.Command,
 	c.SetClientStore(store)
 	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel), &ListOperationsCommand{c}
 }
+
+type ReplayHookCommand struct {
+	*replayHookCommand
+}
+
+func (c *ReplayHookCommand) UnitName() string {
+	return c.unitReceiver
+}
+
+func (c *ReplayHookCommand) SnapshotID() string {
+	return c.snapshotID
+}
+
+func NewReplayHookCommandForTest(store jujuclient.ClientStore, clock clock.Clock) (cmd.Command, *ReplayHookCommand) {
+	c := &replayHookCommand{
+		runCommandBase: runCommandBase{
+			logMessageHandler: func(*cmd.Context, string) {},
+			clock:             clock,
+		},
+	}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel), &ReplayHookCommand{c}
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	actionapi "github.com/juju/juju/api/client/action"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/actions"
+	"github.com/juju/juju/internal/cmd"
+)
+
+func NewReplayHookCommand() cmd.Command {
+	return modelcmd.Wrap(&replayHookCommand{
+		runCommandBase: runCommandBase{
+			logMessageHandler: func(ctx *cmd.Context, msg string) {
+				ctx.Infof("%s", msg)
+			},
+			clock: clock.WallClock,
+		},
+	})
+}
+
+// replayHookCommand replays a hook recorded in a snapshot on a unit, or
+// lists the snapshots the unit has recorded.
+type replayHookCommand struct {
+	runCommandBase
+	unitReceiver string
+	snapshotID   string
+}
+
+const replayHookDoc = `
+Replay a hook on a unit, using the hook snapshot recorded when the hook was run.
+
+When the hook-snapshots model config is true, units record a snapshot of each
+hook they run: its environment, and the config, relation settings and secret
+metadata it read. The most recent snapshots are kept.
+
+A replayed hook is run with the data recorded in the snapshot. Nothing the
+replayed hook changes is committed; instead the changes it requested, such as
+relation-set or status-set calls, are listed along with the hook's output. This
+allows a failing hook to be debugged, and a fixed charm to be tried against it,
+without affecting the model.
+
+If no snapshot id is given, the snapshots recorded by the unit are listed.
+
+The hook is replayed by the juju-replay-hook action, so the operation can be
+inspected with 'juju show-operation <ID>' and 'juju show-task <ID>'.
+`
+
+const replayHookExamples = `
+    juju replay-hook mysql/0
+    juju replay-hook mysql/0 12
+    juju replay-hook mysql/leader 12 --format yaml
+    juju replay-hook mysql/0 12 --background
+`
+
+func (c *replayHookCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "replay-hook",
+		Args:     "<unit> [<snapshot-id>]",
+		Purpose:  "Replay a recorded hook on a unit.",
+		Doc:      replayHookDoc,
+		Examples: replayHookExamples,
+		SeeAlso: []string{
+			"model-config",
+			"run",
+			"show-operation",
+			"show-task",
+		},
+	})
+}
+
+// Init gets the unit and the snapshot id.
+func (c *replayHookCommand) Init(args []string) error {
+	if err := c.runCommandBase.Init(args); err != nil {
+		return errors.Trace(err)
+	}
+	if len(args) == 0 {
+		return errors.New("no unit specified")
+	}
+	if !validUnitOrLeader.MatchString(args[0]) {
+		return errors.Errorf("invalid unit name %q", args[0])
+	}
+	c.unitReceiver = args[0]
+	if len(args) == 1 {
+		return nil
+	}
+	if id, err := strconv.Atoi(args[1]); err != nil || id <= 0 {
+		return errors.Errorf("invalid snapshot id %q", args[1])
+	}
+	c.snapshotID = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+func (c *replayHookCommand) Run(ctx *cmd.Context) error {
+	if err := c.ensureAPI(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	defer c.api.Close()
+
+	action := actionapi.Action{
+		Name: actions.JujuReplayHookActionName,
+		Parameters: map[string]interface{}{
+			"snapshot": c.snapshotID,
+		},
+	}
+	if strings.HasSuffix(c.unitReceiver, "leader") {
+		action.Receiver = c.unitReceiver
+	} else {
+		action.Receiver = names.NewUnitTag(c.unitReceiver).String()
+	}
+	results, err := c.api.EnqueueOperation(ctx, []actionapi.Action{action})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(results.Actions) != 1 {
+		return errors.New("illegal number of results returned")
+	}
+	return c.operationResults(ctx, &results)
+}
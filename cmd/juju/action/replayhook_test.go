@@ -0,0 +1,107 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	actionapi "github.com/juju/juju/api/client/action"
+	"github.com/juju/juju/cmd/juju/action"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+)
+
+type ReplayHookSuite struct {
+	BaseActionSuite
+}
+
+var _ = gc.Suite(&ReplayHookSuite{})
+
+func (s *ReplayHookSuite) TestInit(c *gc.C) {
+	tests := []struct {
+		args           []string
+		expectUnit     string
+		expectSnapshot string
+		expectError    string
+	}{{
+		args:        []string{},
+		expectError: "no unit specified",
+	}, {
+		args:        []string{invalidUnitId},
+		expectError: `invalid unit name "something-strange-"`,
+	}, {
+		args:        []string{validUnitId, "latest"},
+		expectError: `invalid snapshot id "latest"`,
+	}, {
+		args:        []string{validUnitId, "0"},
+		expectError: `invalid snapshot id "0"`,
+	}, {
+		args:        []string{validUnitId, "1", "2"},
+		expectError: `unrecognized args: \["2"\]`,
+	}, {
+		args:        []string{"--background", "--wait=60s", validUnitId},
+		expectError: "cannot specify both --wait and --background",
+	}, {
+		args:       []string{validUnitId},
+		expectUnit: validUnitId,
+	}, {
+		args:           []string{"mysql/leader", "12"},
+		expectUnit:     "mysql/leader",
+		expectSnapshot: "12",
+	}}
+
+	for i, t := range tests {
+		c.Logf("test %d: juju replay-hook %v", i, t.args)
+		wrappedCommand, command := action.NewReplayHookCommandForTest(s.store, s.clock)
+		err := cmdtesting.InitCommand(wrappedCommand, append([]string{"-m", "admin"}, t.args...))
+		if t.expectError != "" {
+			c.Check(err, gc.ErrorMatches, t.expectError)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(command.UnitName(), gc.Equals, t.expectUnit)
+		c.Check(command.SnapshotID(), gc.Equals, t.expectSnapshot)
+	}
+}
+
+func (s *ReplayHookSuite) TestRun(c *gc.C) {
+	tests := []struct {
+		args                   []string
+		expectedActionEnqueued actionapi.Action
+	}{{
+		args: []string{validUnitId, "--background"},
+		expectedActionEnqueued: actionapi.Action{
+			Name:       "juju-replay-hook",
+			Receiver:   names.NewUnitTag(validUnitId).String(),
+			Parameters: map[string]interface{}{"snapshot": ""},
+		},
+	}, {
+		args: []string{"mysql/leader", "3", "--background"},
+		expectedActionEnqueued: actionapi.Action{
+			Name:       "juju-replay-hook",
+			Receiver:   "mysql/leader",
+			Parameters: map[string]interface{}{"snapshot": "3"},
+		},
+	}}
+
+	for i, t := range tests {
+		c.Logf("test %d: juju replay-hook %v", i, t.args)
+		fakeClient := &fakeAPIClient{
+			actionResults: []actionapi.ActionResult{{
+				Action: &actionapi.Action{
+					ID:       validActionId,
+					Receiver: t.expectedActionEnqueued.Receiver,
+				},
+			}},
+		}
+		restore := s.patchAPIClient(fakeClient)
+
+		wrappedCommand, _ := action.NewReplayHookCommandForTest(s.store, s.clock)
+		_, err := cmdtesting.RunCommand(c, wrappedCommand, append([]string{"-m", "admin"}, t.args...)...)
+		restore()
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(fakeClient.enqueuedActions, jc.DeepEquals, []actionapi.Action{t.expectedActionEnqueued})
+	}
+}
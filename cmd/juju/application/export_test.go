@@ -192,6 +192,16 @@ func NewShowCommandForTest(api ApplicationsInfoAPI, store jujuclient.ClientStore
 	return modelcmd.Wrap(cmd)
 }
 
+// NewMetricsCommandForTest returns a metrics command with the api provided as
+// specified.
+func NewMetricsCommandForTest(api WorkloadMetricsAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &metricsCommand{newAPIFunc: func(ctx context.Context) (WorkloadMetricsAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
 func NewShowUnitCommandForTest(api UnitsInfoAPI, store jujuclient.ClientStore) cmd.Command {
 	cmd := &showUnitCommand{newAPIFunc: func(ctx context.Context) (UnitsInfoAPI, error) {
 		return api, nil
@@ -0,0 +1,184 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/workloadmetrics"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+const metricsDoc = `
+Displays the workload metrics published by the units of an application with
+the metric-add hook tool, such as queue depths or replication lag.
+
+By default only the most recent sample of each metric series is shown. With
+--history, every sample kept by the controller is shown. How long samples are
+kept for is set by the workload-metrics-retention model configuration key.
+
+The latest samples are also served in the Prometheus text format by the
+controller, at /model/<model-uuid>/workload-metrics.
+`
+
+const metricsExamples = `
+    juju metrics mysql
+    juju metrics mysql --history
+    juju metrics mysql --format yaml
+`
+
+// NewMetricsCommand returns a command that displays the workload metrics
+// published by the units of an application.
+func NewMetricsCommand() cmd.Command {
+	c := &metricsCommand{}
+	c.newAPIFunc = func(ctx context.Context) (WorkloadMetricsAPI, error) {
+		root, err := c.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return workloadmetrics.NewClient(root), nil
+	}
+	return modelcmd.Wrap(c)
+}
+
+// WorkloadMetricsAPI defines the API methods that the metrics command uses.
+type WorkloadMetricsAPI interface {
+	Close() error
+	ApplicationMetrics(ctx context.Context, application string, history bool) ([]params.UnitWorkloadMetric, error)
+}
+
+// metricsCommand displays the workload metrics published by the units of
+// an application.
+type metricsCommand struct {
+	modelcmd.ModelCommandBase
+
+	out         cmd.Output
+	application string
+	history     bool
+	utc         bool
+
+	newAPIFunc func(ctx context.Context) (WorkloadMetricsAPI, error)
+}
+
+// Info implements Command.Info.
+func (c *metricsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "metrics",
+		Args:     "<application name>",
+		Purpose:  "Displays the workload metrics published by an application.",
+		Doc:      metricsDoc,
+		Examples: metricsExamples,
+		SeeAlso: []string{
+			"show-application",
+			"status",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *metricsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": c.formatTabular,
+	})
+	f.BoolVar(&c.history, "history", false, "Show every retained sample, not only the most recent")
+	f.BoolVar(&c.utc, "utc", false, "Show times in UTC")
+}
+
+// Init implements Command.Init.
+func (c *metricsCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("an application name must be supplied")
+	}
+	c.application = args[0]
+	if !names.IsValidApplication(c.application) {
+		return errors.NotValidf("application name %q", c.application)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *metricsCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	metrics, err := client.ApplicationMetrics(ctx, c.application, c.history)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(metrics) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No workload metrics to display.")
+		return nil
+	}
+
+	result := make([]metricOutput, len(metrics))
+	for i, m := range metrics {
+		result[i] = metricOutput{
+			Unit:   m.Unit,
+			Key:    m.Metric.Key,
+			Labels: m.Metric.Labels,
+			Value:  m.Metric.Value,
+			Time:   m.Metric.Time,
+		}
+	}
+	return c.out.Write(ctx, result)
+}
+
+// metricOutput is the serialisable form of a workload metric sample.
+type metricOutput struct {
+	Unit   string            `json:"unit" yaml:"unit"`
+	Key    string            `json:"key" yaml:"key"`
+	Labels map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Value  float64           `json:"value" yaml:"value"`
+	Time   time.Time         `json:"time" yaml:"time"`
+}
+
+func (c *metricsCommand) formatTabular(writer io.Writer, value interface{}) error {
+	metrics, ok := value.([]metricOutput)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", metrics, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+	w.SetColumnAlignRight(3)
+
+	w.Println("Unit", "Metric", "Labels", "Value", "Time")
+	for _, m := range metrics {
+		w.Print(m.Unit, m.Key, formatLabels(m.Labels), strconv.FormatFloat(m.Value, 'g', -1, 64))
+		w.Println(common.FormatTime(&m.Time, c.utc))
+	}
+	return tw.Flush()
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "-"
+	}
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/application"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	jujutesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/rpc/params"
+)
+
+type MetricsSuite struct {
+	jujutesting.FakeJujuXDGDataHomeSuite
+	store *jujuclient.MemStore
+
+	mockAPI *mockWorkloadMetricsAPI
+}
+
+var _ = gc.Suite(&MetricsSuite{})
+
+func (s *MetricsSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+
+	s.store = jujuclient.NewMemStore()
+	s.store.CurrentControllerName = "testing"
+	s.store.Controllers["testing"] = jujuclient.ControllerDetails{}
+	s.store.Models["testing"] = &jujuclient.ControllerModels{
+		Models: map[string]jujuclient.ModelDetails{
+			"admin/controller": {},
+		},
+		CurrentModel: "admin/controller",
+	}
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User: "admin",
+	}
+
+	sampled := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.mockAPI = &mockWorkloadMetricsAPI{
+		metrics: []params.UnitWorkloadMetric{{
+			Unit: "mysql/0",
+			Metric: params.WorkloadMetric{
+				Key:   "lag_seconds",
+				Value: 0.5,
+				Time:  sampled,
+			},
+		}, {
+			Unit: "mysql/0",
+			Metric: params.WorkloadMetric{
+				Key:    "queue_depth",
+				Value:  42,
+				Labels: map[string]string{"region": "eu", "queue": "orders"},
+				Time:   sampled,
+			},
+		}},
+	}
+}
+
+func (s *MetricsSuite) runMetrics(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, application.NewMetricsCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *MetricsSuite) TestInit(c *gc.C) {
+	_, err := s.runMetrics(c)
+	c.Check(err, gc.ErrorMatches, "an application name must be supplied")
+	_, err = s.runMetrics(c, "mysql/0")
+	c.Check(err, gc.ErrorMatches, `application name "mysql/0" not valid`)
+	_, err = s.runMetrics(c, "mysql", "extra")
+	c.Check(err, gc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *MetricsSuite) TestMetricsTabular(c *gc.C) {
+	ctx, err := s.runMetrics(c, "mysql", "--utc")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.mockAPI.application, gc.Equals, "mysql")
+	c.Check(s.mockAPI.history, jc.IsFalse)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Unit     Metric       Labels                  Value  Time
+mysql/0  lag_seconds  -                         0.5  2025-03-01 12:00:00Z
+mysql/0  queue_depth  queue=orders,region=eu     42  2025-03-01 12:00:00Z
+`[1:])
+}
+
+func (s *MetricsSuite) TestMetricsHistoryYAML(c *gc.C) {
+	ctx, err := s.runMetrics(c, "mysql", "--history", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.mockAPI.history, jc.IsTrue)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- unit: mysql/0
+  key: lag_seconds
+  value: 0.5
+  time: 2025-03-01T12:00:00Z
+- unit: mysql/0
+  key: queue_depth
+  labels:
+    queue: orders
+    region: eu
+  value: 42
+  time: 2025-03-01T12:00:00Z
+`[1:])
+}
+
+func (s *MetricsSuite) TestNoMetrics(c *gc.C) {
+	s.mockAPI.metrics = nil
+	ctx, err := s.runMetrics(c, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No workload metrics to display.\n")
+}
+
+func (s *MetricsSuite) TestMetricsError(c *gc.C) {
+	s.mockAPI.err = errors.New("boom")
+	_, err := s.runMetrics(c, "mysql")
+	c.Check(err, gc.ErrorMatches, "boom")
+}
+
+type mockWorkloadMetricsAPI struct {
+	metrics []params.UnitWorkloadMetric
+	err     error
+
+	application string
+	history     bool
+}
+
+func (m *mockWorkloadMetricsAPI) Close() error {
+	return nil
+}
+
+func (m *mockWorkloadMetricsAPI) ApplicationMetrics(_ context.Context, application string, history bool) ([]params.UnitWorkloadMetric, error) {
+	m.application = application
+	m.history = history
+	return m.metrics, m.err
+}
@@ -6,6 +6,7 @@ package application
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
@@ -45,6 +46,10 @@ To show only the relation data for a specific endpoint:
 To show only the relation data for a specific related unit:
 
     juju show-unit mysql/0 --related-unit wordpress/2
+
+To also show the hooks deferred by the unit's charm:
+
+    juju show-unit mysql/0 --deferred
 `
 
 // NewShowUnitCommand returns a command that displays unit info.
@@ -64,6 +69,7 @@ type showUnitCommand struct {
 	endpoint    string
 	relatedUnit string
 	appOnly     bool
+	deferred    bool
 
 	newAPIFunc func(ctx context.Context) (UnitsInfoAPI, error)
 }
@@ -116,6 +122,7 @@ func (c *showUnitCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.endpoint, "endpoint", "", "only show relation data for the specified endpoint")
 	f.StringVar(&c.relatedUnit, "related-unit", "", "only show relation data for the specified unit")
 	f.BoolVar(&c.appOnly, "app", false, "only show application relation data")
+	f.BoolVar(&c.deferred, "deferred", false, "also show the hooks deferred by the charm")
 }
 
 // UnitsInfoAPI defines the API methods that show-unit command uses.
@@ -215,6 +222,31 @@ type RelationData struct {
 	Data                    map[string]UnitRelationData `yaml:"related-units,omitempty" json:"related-units,omitempty"`
 }
 
+// ScheduleInfo defines the serialization behaviour of a schedule on which
+// the unit's schedule-<name> hook is run.
+type ScheduleInfo struct {
+	Source   string     `yaml:"source" json:"source"`
+	Cron     string     `yaml:"cron,omitempty" json:"cron,omitempty"`
+	Interval string     `yaml:"interval,omitempty" json:"interval,omitempty"`
+	Jitter   string     `yaml:"jitter,omitempty" json:"jitter,omitempty"`
+	CatchUp  string     `yaml:"catch-up,omitempty" json:"catch-up,omitempty"`
+	LastRun  *time.Time `yaml:"last-run,omitempty" json:"last-run,omitempty"`
+	NextRun  *time.Time `yaml:"next-run,omitempty" json:"next-run,omitempty"`
+}
+
+// DeferredHookInfo defines the serialization behaviour of a hook deferred
+// by the unit's charm.
+type DeferredHookInfo struct {
+	ID            string     `yaml:"id" json:"id"`
+	Hook          string     `yaml:"hook" json:"hook"`
+	Reason        string     `yaml:"reason,omitempty" json:"reason,omitempty"`
+	DeferredAt    time.Time  `yaml:"deferred-at" json:"deferred-at"`
+	Deferrals     int        `yaml:"deferrals" json:"deferrals"`
+	RetryAt       *time.Time `yaml:"retry-at,omitempty" json:"retry-at,omitempty"`
+	Ready         bool       `yaml:"ready,omitempty" json:"ready,omitempty"`
+	DropOnUpgrade bool       `yaml:"drop-on-upgrade,omitempty" json:"drop-on-upgrade,omitempty"`
+}
+
 // UnitInfo defines the serialization behaviour of the unit information.
 type UnitInfo struct {
 	WorkloadVersion string         `yaml:"workload-version,omitempty" json:"workload-version,omitempty"`
@@ -226,6 +258,9 @@ type UnitInfo struct {
 	Life            string         `yaml:"life,omitempty" json:"life,omitempty"`
 	RelationData    []RelationData `yaml:"relation-info,omitempty" json:"relation-info,omitempty"`
 
+	Schedules     map[string]ScheduleInfo `yaml:"schedules,omitempty" json:"schedules,omitempty"`
+	DeferredHooks []DeferredHookInfo      `yaml:"deferred-hooks,omitempty" json:"deferred-hooks,omitempty"`
+
 	// The following are for CAAS models.
 	ProviderId string `yaml:"provider-id,omitempty" json:"provider-id,omitempty"`
 	Address    string `yaml:"address,omitempty" json:"address,omitempty"`
@@ -303,6 +338,34 @@ func (c *showUnitCommand) createUnitInfo(details application.UnitInfo) (names.Un
 			info.RelationData = append(info.RelationData, rd)
 		}
 	}
+	if len(details.Schedules) > 0 {
+		info.Schedules = make(map[string]ScheduleInfo)
+		for _, sched := range details.Schedules {
+			info.Schedules[sched.Name] = ScheduleInfo{
+				Source:   sched.Source,
+				Cron:     sched.Cron,
+				Interval: sched.Interval,
+				Jitter:   sched.Jitter,
+				CatchUp:  sched.CatchUp,
+				LastRun:  sched.LastRun,
+				NextRun:  sched.NextRun,
+			}
+		}
+	}
+	if c.deferred {
+		for _, deferred := range details.DeferredHooks {
+			info.DeferredHooks = append(info.DeferredHooks, DeferredHookInfo{
+				ID:            deferred.ID,
+				Hook:          deferred.Hook,
+				Reason:        deferred.Reason,
+				DeferredAt:    deferred.DeferredAt,
+				Deferrals:     deferred.Deferrals,
+				RetryAt:       deferred.RetryAt,
+				Ready:         deferred.Ready,
+				DropOnUpgrade: deferred.DropOnUpgrade,
+			})
+		}
+	}
 
 	return tag, info, nil
 }
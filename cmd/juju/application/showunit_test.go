@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
@@ -213,6 +214,132 @@ wordpress/0:
 	})
 }
 
+func (s *ShowUnitSuite) TestShowSchedules(c *gc.C) {
+	lastRun := time.Date(2025, 3, 14, 3, 2, 0, 0, time.UTC)
+	nextRun := time.Date(2025, 3, 15, 3, 7, 0, 0, time.UTC)
+	s.mockAPI.unitsInfoFunc = func([]names.UnitTag) ([]apiapplication.UnitInfo, error) {
+		info := s.createTestUnitInfo("wordpress", "")
+		info.RelationData = nil
+		info.Schedules = []apiapplication.UnitSchedule{{
+			Name:     "hourly",
+			Source:   "runtime",
+			Interval: "1h0m0s",
+			CatchUp:  "once",
+			NextRun:  &lastRun,
+		}, {
+			Name:    "nightly",
+			Source:  "charm",
+			Cron:    "0 3 * * *",
+			Jitter:  "10m0s",
+			CatchUp: "skip",
+			LastRun: &lastRun,
+			NextRun: &nextRun,
+		}}
+		return []apiapplication.UnitInfo{info}, nil
+	}
+	s.assertRunShow(c, showUnitTest{
+		args: []string{"wordpress/0"},
+		stdout: `
+wordpress/0:
+  workload-version: "666"
+  machine: "0"
+  opened-ports:
+  - 100-102/ip
+  public-address: 10.0.0.1
+  charm: charm-wordpress
+  leader: true
+  life: alive
+  schedules:
+    hourly:
+      source: runtime
+      interval: 1h0m0s
+      catch-up: once
+      next-run: 2025-03-14T03:02:00Z
+    nightly:
+      source: charm
+      cron: 0 3 * * *
+      jitter: 10m0s
+      catch-up: skip
+      last-run: 2025-03-14T03:02:00Z
+      next-run: 2025-03-15T03:07:00Z
+  provider-id: provider-id
+  address: 192.168.1.1
+`[1:],
+	})
+}
+
+func (s *ShowUnitSuite) TestShowDeferredHooks(c *gc.C) {
+	deferredAt := time.Date(2025, 3, 14, 3, 2, 0, 0, time.UTC)
+	retryAt := deferredAt.Add(5 * time.Minute)
+	s.mockAPI.unitsInfoFunc = func([]names.UnitTag) ([]apiapplication.UnitInfo, error) {
+		info := s.createTestUnitInfo("wordpress", "")
+		info.RelationData = nil
+		info.DeferredHooks = []apiapplication.UnitDeferredHook{{
+			ID:            "1",
+			Hook:          "config-changed",
+			Reason:        "waiting for database",
+			DeferredAt:    deferredAt,
+			Deferrals:     2,
+			RetryAt:       &retryAt,
+			DropOnUpgrade: true,
+		}, {
+			ID:         "3",
+			Hook:       "db-relation-changed",
+			DeferredAt: deferredAt,
+			Deferrals:  1,
+			Ready:      true,
+		}}
+		return []apiapplication.UnitInfo{info}, nil
+	}
+	s.assertRunShow(c, showUnitTest{
+		args: []string{"wordpress/0", "--deferred"},
+		stdout: `
+wordpress/0:
+  workload-version: "666"
+  machine: "0"
+  opened-ports:
+  - 100-102/ip
+  public-address: 10.0.0.1
+  charm: charm-wordpress
+  leader: true
+  life: alive
+  deferred-hooks:
+  - id: "1"
+    hook: config-changed
+    reason: waiting for database
+    deferred-at: 2025-03-14T03:02:00Z
+    deferrals: 2
+    retry-at: 2025-03-14T03:07:00Z
+    drop-on-upgrade: true
+  - id: "3"
+    hook: db-relation-changed
+    deferred-at: 2025-03-14T03:02:00Z
+    deferrals: 1
+    ready: true
+  provider-id: provider-id
+  address: 192.168.1.1
+`[1:],
+	})
+
+	// Deferred hooks are only shown when asked for.
+	s.assertRunShow(c, showUnitTest{
+		args: []string{"wordpress/0"},
+		stdout: `
+wordpress/0:
+  workload-version: "666"
+  machine: "0"
+  opened-ports:
+  - 100-102/ip
+  public-address: 10.0.0.1
+  charm: charm-wordpress
+  leader: true
+  life: alive
+  provider-id: provider-id
+  address: 192.168.1.1
+`[1:],
+	})
+}
+
 func (s *ShowUnitSuite) TestShowAppOnly(c *gc.C) {
 	s.mockAPI.unitsInfoFunc = func([]names.UnitTag) ([]apiapplication.UnitInfo, error) {
 		return []apiapplication.UnitInfo{
@@ -28,6 +28,10 @@ type APIClient interface {
 	Create(nctx context.Context, otes string, noDownload bool) (*params.BackupsMetadataResult, error)
 	// Download pulls the backup archive file.
 	Download(ctx context.Context, filename string) (io.ReadCloser, error)
+	// Upload pushes a backup archive file to the controller.
+	Upload(ctx context.Context, archive io.Reader) (string, error)
+	// Restore sends an RPC request to restore an uploaded backup.
+	Restore(ctx context.Context, id string) error
 }
 
 // CommandBase is the base type for backups sub-commands.
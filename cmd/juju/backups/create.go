@@ -28,6 +28,12 @@ const createDoc = `
 This command requests that Juju creates a backup of its state.
 You may provide a note to associate with the backup.
 
+The controller database and each model database are snapshotted one after
+the other. Each database is consistent on its own, but the backup isn't a
+point-in-time copy of the whole controller: a change made to one model while
+the backup is running may be included, while a related change in another
+model isn't.
+
 By default, the backup archive and associated metadata are downloaded.
 
 Use --no-download to avoid getting a local copy of the backup downloaded 
@@ -5,28 +5,27 @@
 // Backup of juju's state is a critical feature, not only for juju users
 // but for use inside juju itself.
 
-// Backing up juju state involves dumping the state database and copying all
-// files that are critical to juju's operation. All the files are bundled up
-// into an archive file. Effectively the archive represents a snapshot of juju state.
+// Backing up juju state involves taking a snapshot of the controller database,
+// and the database of each model, along with the objects in their object stores
+// and the files that are critical to juju's operation. All the files are bundled
+// up into an archive file. Effectively the archive represents a snapshot of juju
+// state.
 
 // The controller creates the backup file in a gzipped tar file with the following structure, then streams it to the local user's disk:
 // juju-backup/
-//     metadata.json - the backup metadata for the archive.
-//     root.tar      - the bundle of state-related files (exluding mongo).
-//     dump/         - all the files dumped from the DB (using mongodump).
-
-// At present we do not include any sort of manifest/index file in the
-// archive.
-
-// For more information, see:
-//   - state/backups/db/dump.go     - how the DB is dumped;
-//   - state/backups/files/files.go - which files are included in root.tar.
-
-// The current backup process doesn't block state changes, meaning the database dump
-// might be slightly outdated by the time all state-related files are gathered,
-// though the risk is minimal.
-
-// In terms of the restore process, please see "[juju-restore tool]".
-// [juju-restore tool]: https://github.com/juju/juju-restore
+//     objects/<namespace>/ - the objects referenced by each database, named by their SHA256.
+//     dump/<namespace>.sql - the snapshot of each database, as SQL insert statements.
+//     root.tar             - the bundle of state-related files, such as the agent config.
+//     metadata.json        - the backup metadata for the archive.
+//     checksums.sha256     - the SHA256 checksum of every other file in the archive.
+
+// The snapshot of each database is taken in a single transaction, so it is
+// consistent, but the databases are snapshotted one after the other, so
+// changes made across databases while the backup is running may be missed.
+
+// A backup can be restored into a freshly bootstrapped controller with the
+// restore-backup command. The archive is uploaded to the controller, every file
+// is verified against its checksum, and then the objects and databases are
+// restored. The controller agents must be restarted once the restore completes.
 
 package backups
@@ -26,6 +26,10 @@ type DownloadCommand struct {
 	*downloadCommand
 }
 
+type RestoreCommand struct {
+	*restoreCommand
+}
+
 func NewCreateCommandForTest(store jujuclient.ClientStore) (cmd.Command, *CreateCommand) {
 	c := &createCommand{}
 	c.SetClientStore(store)
@@ -37,3 +41,9 @@ func NewDownloadCommandForTest(store jujuclient.ClientStore) (cmd.Command, *Down
 	c.SetClientStore(store)
 	return modelcmd.Wrap(c), &DownloadCommand{c}
 }
+
+func NewRestoreCommandForTest(store jujuclient.ClientStore) (cmd.Command, *RestoreCommand) {
+	c := &restoreCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c), &RestoreCommand{c}
+}
@@ -207,6 +207,26 @@ func (c *fakeAPIClient) Download(_ context.Context, id string) (io.ReadCloser, e
 	return c.archive, nil
 }
 
+func (c *fakeAPIClient) Upload(_ context.Context, archive io.Reader) (string, error) {
+	c.calls = append(c.calls, "Upload")
+	if c.err != nil {
+		return "", c.err
+	}
+	data, err := io.ReadAll(archive)
+	if err != nil {
+		return "", err
+	}
+	c.args = append(c.args, string(data))
+	return c.metaresult.ID, nil
+}
+
+func (c *fakeAPIClient) Restore(_ context.Context, id string) error {
+	c.calls = append(c.calls, "Restore")
+	c.args = append(c.args, id)
+	c.idArg = id
+	return c.err
+}
+
 func (c *fakeAPIClient) Close() error {
 	return nil
 }
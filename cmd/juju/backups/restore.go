@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"fmt"
+
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const restoreDoc = `
+restore-backup restores a backup archive file into a controller.
+
+The archive is uploaded to the controller, and every file in it is verified
+against its checksum before anything is restored. The controller databases,
+and the objects in their object stores, are then replaced with those from
+the backup. The controller keeps its own identity and controller config,
+so that they continue to match its agent config.
+
+The controller must have been freshly bootstrapped, with no models other
+than the controller model. Once the restore is complete, the controller
+agents must be restarted to pick up the restored state. The agent config of
+the backed up controller is included in the root.tar file of the archive,
+should it be needed.
+
+The secret content keys of the backed up controller are removed from the
+archived agent config, so that the archive alone can't decrypt the secret
+content stored in the controller. Before the controller agents are
+restarted, copy the secretcontentkeys from the agent config of the backed up
+controller into the agent config of each restored controller machine;
+otherwise secrets stored in the controller can't be read.
+`
+
+const restoreExamples = `
+    juju restore-backup juju-backup-20250101-000000.tar.gz
+`
+
+// NewRestoreCommand returns a command used to restore backups.
+func NewRestoreCommand() cmd.Command {
+	return modelcmd.Wrap(&restoreCommand{})
+}
+
+// restoreCommand is the sub-command for restoring a backup archive.
+type restoreCommand struct {
+	CommandBase
+	// Filename is the local backup archive to restore.
+	Filename string
+}
+
+// Info implements Command.Info.
+func (c *restoreCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "restore-backup",
+		Args:     "<backup file>",
+		Purpose:  "Restore a backup archive file into a controller.",
+		Doc:      restoreDoc,
+		Examples: restoreExamples,
+		SeeAlso: []string{
+			"create-backup",
+			"download-backup",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *restoreCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing filename")
+	}
+	filename, args := args[0], args[1:]
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	c.Filename = filename
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *restoreCommand) Run(ctx *cmd.Context) error {
+	if err := c.validateIaasController(ctx, c.Info().Name); err != nil {
+		return errors.Trace(err)
+	}
+
+	archive, err := c.Filesystem().Open(c.Filename)
+	if err != nil {
+		return errors.Annotate(err, "while opening local archive file")
+	}
+	defer func() { _ = archive.Close() }()
+
+	client, err := c.NewAPIClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	id, err := client.Upload(ctx, archive)
+	if err != nil {
+		return errors.Annotate(err, "while uploading backup archive")
+	}
+	ctx.Infof("Uploaded backup archive as %v", id)
+
+	if err := client.Restore(ctx, id); err != nil {
+		return errors.Trace(err)
+	}
+
+	fmt.Fprintln(ctx.Stdout, "Backup restored, restart the controller agents to complete the restore.")
+	return nil
+}
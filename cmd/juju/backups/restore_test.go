@@ -0,0 +1,63 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/backups"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+)
+
+type restoreSuite struct {
+	BaseBackupsSuite
+	wrappedCommand cmd.Command
+	command        *backups.RestoreCommand
+}
+
+var _ = gc.Suite(&restoreSuite{})
+
+func (s *restoreSuite) SetUpTest(c *gc.C) {
+	s.BaseBackupsSuite.SetUpTest(c)
+	s.wrappedCommand, s.command = backups.NewRestoreCommandForTest(s.store)
+}
+
+func (s *restoreSuite) writeArchive(c *gc.C) string {
+	filename := filepath.Join(c.MkDir(), "backup.tar.gz")
+	err := os.WriteFile(filename, []byte(s.data), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	return filename
+}
+
+func (s *restoreSuite) TestOkay(c *gc.C) {
+	client := s.setSuccess()
+	filename := s.writeArchive(c)
+
+	ctx, err := cmdtesting.RunCommand(c, s.wrappedCommand, filename)
+	c.Assert(err, jc.ErrorIsNil)
+
+	client.CheckCalls(c, "Upload", "Restore")
+	client.CheckArgs(c, s.data, s.metaresult.ID)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Uploaded backup archive as backup-id\n")
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "Backup restored, restart the controller agents to complete the restore.\n")
+}
+
+func (s *restoreSuite) TestMissingFilename(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.wrappedCommand)
+	c.Check(err, gc.ErrorMatches, "missing filename")
+}
+
+func (s *restoreSuite) TestError(c *gc.C) {
+	s.setFailure("failed!")
+	filename := s.writeArchive(c)
+
+	_, err := cmdtesting.RunCommand(c, s.wrappedCommand, filename)
+	c.Check(errors.Cause(err), gc.ErrorMatches, "failed!")
+}
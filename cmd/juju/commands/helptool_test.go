@@ -57,9 +57,11 @@ Currently available charm hook tools are:
     config-get               Print application configuration.
     credential-get           Access cloud credentials.
     goal-state               Print the status of the charm's peers and related units.
+    hook-defer               Defer the running hook until later.
     is-leader                Print application leadership status.
     juju-log                 Write a message to the juju log.
     juju-reboot              Reboot the host machine.
+    metric-add               Publish workload metrics to the controller.
     network-get              Get network config.
     open-port                Register a request to open a port or port range.
     opened-ports             List all ports or port ranges opened by the unit.
@@ -68,7 +70,10 @@ Currently available charm hook tools are:
     relation-list            List relation units.
     relation-model-get       Get details about the model hosing a related application.
     relation-set             Set relation settings.
+    relation-set-many        Set relation settings in several relations.
     resource-get             Get the path to the locally cached resource file.
+    schedule-remove          Remove a schedule registered by the charm.
+    schedule-set             Register a schedule for the schedule-<name> hook.
     secret-add               Add a new secret.
     secret-get               Get the content of a secret.
     secret-grant             Grant access to a secret.
@@ -108,9 +113,11 @@ var expectedCommands = []string{
 	"config-get",
 	"credential-get",
 	"goal-state",
+	"hook-defer",
 	"is-leader",
 	"juju-log",
 	"juju-reboot",
+	"metric-add",
 	"network-get",
 	"open-port",
 	"opened-ports",
@@ -119,7 +126,10 @@ var expectedCommands = []string{
 	"relation-list",
 	"relation-model-get",
 	"relation-set",
+	"relation-set-many",
 	"resource-get",
+	"schedule-remove",
+	"schedule-set",
 	"secret-add",
 	"secret-get",
 	"secret-grant",
@@ -389,6 +389,7 @@ func registerCommands(r commandRegistry) {
 	// Manage backups.
 	r.Register(backups.NewCreateCommand())
 	r.Register(backups.NewDownloadCommand())
+	r.Register(backups.NewRestoreCommand())
 
 	// Manage authorized ssh keys.
 	r.Register(sshkeys.NewAddKeysCommand())
@@ -423,6 +424,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(model.NewRevokeCommand())
 	r.Register(model.NewShowCommand())
 	r.Register(model.NewModelCredentialCommand())
+	r.Register(model.NewWatchChangesCommand())
 
 	r.Register(newMigrateCommand())
 	r.Register(model.NewExportBundleCommand())
@@ -437,6 +439,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(action.NewShowCommand())
 	r.Register(action.NewCancelCommand())
 	r.Register(action.NewRunCommand())
+	r.Register(action.NewReplayHookCommand())
 	r.Register(action.NewListOperationsCommand())
 	r.Register(action.NewShowOperationCommand())
 	r.Register(action.NewShowTaskCommand())
@@ -455,6 +458,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(application.NewDiffBundleCommand())
 	r.Register(application.NewShowApplicationCommand())
 	r.Register(application.NewShowUnitCommand())
+	r.Register(application.NewMetricsCommand())
 
 	// Operation protection commands
 	r.Register(block.NewDisableCommand())
@@ -547,6 +551,8 @@ func registerCommands(r commandRegistry) {
 	r.Register(secrets.NewRemoveSecretCommand())
 	r.Register(secrets.NewGrantSecretCommand())
 	r.Register(secrets.NewRevokeSecretCommand())
+	r.Register(secrets.NewPinSecretCommand())
+	r.Register(secrets.NewUnpinSecretCommand())
 
 	// Secret backends.
 	r.Register(secretbackends.NewListSecretBackendsCommand())
@@ -399,6 +399,7 @@ var commandNames = []string{
 	"login",
 	"logout",
 	"machines",
+	"metrics",
 	"migrate",
 	"model-config",
 	"model-constraints",
@@ -410,6 +411,7 @@ var commandNames = []string{
 	"offer",
 	"offers",
 	"operations",
+	"pin-secret",
 	"refresh",
 	"regions",
 	"register",
@@ -432,9 +434,11 @@ var commandNames = []string{
 	"remove-unit",
 	"remove-user",
 	"rename-space",
+	"replay-hook",
 	"resolve",
 	"resolved",
 	"resources",
+	"restore-backup",
 	"resume-relation",
 	"retry-provisioning",
 	"revoke-cloud",
@@ -481,6 +485,7 @@ var commandNames = []string{
 	"sync-agent-binary",
 	"trust",
 	"unexpose",
+	"unpin-secret",
 	"unregister",
 	"update-cloud",
 	"update-credential",
@@ -494,6 +499,7 @@ var commandNames = []string{
 	"upgrade-model",
 	"users",
 	"version",
+	"watch-changes",
 	"whoami",
 }
 
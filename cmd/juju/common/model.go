@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
@@ -40,6 +41,7 @@ type ModelInfo struct {
 	SecretBackends map[string]SecretBackendInfo `json:"secret-backends,omitempty" yaml:"secret-backends,omitempty"`
 	AgentVersion   string                       `json:"agent-version,omitempty" yaml:"agent-version,omitempty"`
 	Credential     *ModelCredential             `json:"credential,omitempty" yaml:"credential,omitempty"`
+	ObjectStore    *ModelObjectStoreUsage       `json:"object-store,omitempty" yaml:"object-store,omitempty"`
 
 	SupportedFeatures []SupportedFeature `json:"supported-features,omitempty" yaml:"supported-features,omitempty"`
 }
@@ -61,6 +63,12 @@ type ModelMachineInfo struct {
 	Cores uint64 `json:"cores" yaml:"cores"`
 }
 
+// ModelObjectStoreUsage contains the storage used by a model's object store.
+type ModelObjectStoreUsage struct {
+	Objects int64  `json:"objects" yaml:"objects"`
+	Size    string `json:"size" yaml:"size"`
+}
+
 // ModelStatus contains the current status of a model.
 type ModelStatus struct {
 	Current        status.Status `json:"current,omitempty" yaml:"current,omitempty"`
@@ -184,6 +192,13 @@ func ModelInfoFromParams(info params.ModelInfo, now time.Time) (ModelInfo, error
 		}
 	}
 
+	if info.ObjectStoreUsage != nil {
+		modelInfo.ObjectStore = &ModelObjectStoreUsage{
+			Objects: info.ObjectStoreUsage.Objects,
+			Size:    humanize.IBytes(uint64(info.ObjectStoreUsage.Size)),
+		}
+	}
+
 	for _, feat := range info.SupportedFeatures {
 		modelInfo.SupportedFeatures = append(modelInfo.SupportedFeatures,
 			SupportedFeature{
@@ -48,6 +48,16 @@ func NewRetryProvisioningCommandForTest(api RetryProvisioningAPI) cmd.Command {
 	return modelcmd.Wrap(cmd)
 }
 
+// NewWatchChangesCommandForTest returns a watchChangesCommand with the api
+// provided as specified.
+func NewWatchChangesCommandForTest(api WatchChangesAPI) cmd.Command {
+	cmd := &watchChangesCommand{
+		api: api,
+	}
+	cmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(cmd)
+}
+
 // NewShowCommandForTest returns a ShowCommand with the api provided as specified.
 func NewShowCommandForTest(api ShowModelAPI, refreshFunc func(context.Context, jujuclient.ClientStore, string) error, store jujuclient.ClientStore) cmd.Command {
 	cmd := &showModelCommand{api: api}
@@ -238,6 +238,33 @@ func (s *ShowCommandSuite) TestShowWithSecretBackendFormatJson(c *gc.C) {
 	c.Assert(cmdtesting.Stdout(ctx), jc.JSONEquals, s.expectedOutput)
 }
 
+func (s *ShowCommandSuite) addObjectStoreUsageTestData() {
+	s.fake.info.ObjectStoreUsage = &params.ObjectStoreUsage{
+		Objects: 3,
+		Size:    5 * 1024 * 1024,
+	}
+
+	modelOutput := s.expectedOutput["mymodel"].(attrs)
+	modelOutput["object-store"] = attrs{
+		"objects": 3,
+		"size":    "5.0 MiB",
+	}
+}
+
+func (s *ShowCommandSuite) TestShowWithObjectStoreUsageFormatYaml(c *gc.C) {
+	s.addObjectStoreUsageTestData()
+	ctx, err := cmdtesting.RunCommand(c, s.newShowCommand(), "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), jc.YAMLEquals, s.expectedOutput)
+}
+
+func (s *ShowCommandSuite) TestShowWithObjectStoreUsageFormatJson(c *gc.C) {
+	s.addObjectStoreUsageTestData()
+	ctx, err := cmdtesting.RunCommand(c, s.newShowCommand(), "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), jc.JSONEquals, s.expectedOutput)
+}
+
 func (s *ShowCommandSuite) TestShowBasicIncompleteModelsYaml(c *gc.C) {
 	s.fake.infos = []params.ModelInfoResult{
 		{Result: createBasicModelInfo()},
@@ -0,0 +1,229 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/modelchanges"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewWatchChangesCommand returns a command that streams the changes made to
+// a model.
+func NewWatchChangesCommand() cmd.Command {
+	return modelcmd.Wrap(&watchChangesCommand{})
+}
+
+// WatchChangesAPI defines the methods on the model changes API that the
+// watch-changes command calls.
+type WatchChangesAPI interface {
+	Close() error
+	ReadChanges(ctx context.Context, args modelchanges.ReadArgs) ([]params.ModelChange, string, error)
+}
+
+// watchChangesCommand streams the changes made to a model.
+type watchChangesCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+	api WatchChangesAPI
+
+	namespaces []string
+	from       string
+	limit      int
+	once       bool
+	consumer   string
+}
+
+const watchChangesCommandDoc = `
+Streams the changes made to the current model, or the model specified by -m.
+
+Each change identifies the namespace and entity that changed, the type of
+change (create, update or delete), the identifier of the changed entity and,
+for updates, the columns that changed where they are known.
+
+Changes can be restricted to one or more namespaces with --namespace. The
+supported namespaces are: application, unit, machine, relation, secret and
+model-config.
+
+Every change carries a resume token. Passing a token to --from streams the
+changes that were made after it, allowing a consumer to resume where it left
+off. Without --from, streaming starts from the latest change. When the command
+exits, the token to resume from is written to stderr.
+
+The controller only retains changes for a limited time. If the changes after
+the given token are no longer available, the command fails and the consumer
+must resync the model state before streaming from the latest change.
+
+Naming the consumer with --consumer asks the controller to retain the changes
+that the consumer hasn't read, so that it can resume with --from even after a
+longer absence. The changes are retained from the last resume token passed
+by the consumer. Streaming without --from restarts the consumer from the
+latest change. A consumer that falls too far behind is no longer retained for,
+and must resync.
+`
+
+const watchChangesCommandExamples = `
+    juju watch-changes
+    juju watch-changes --namespace application,unit
+    juju watch-changes --from 1234 --once
+    juju watch-changes --consumer audit --from 1234
+    juju watch-changes --format json
+`
+
+// Info implements Command.Info.
+func (c *watchChangesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "watch-changes",
+		Purpose:  "Streams the changes made to a model.",
+		Doc:      watchChangesCommandDoc,
+		Examples: watchChangesCommandExamples,
+		SeeAlso: []string{
+			"status",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *watchChangesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"tabular": formatChangeTabular,
+		"json":    cmd.FormatJson,
+	})
+	f.Var(cmd.NewStringsValue(nil, &c.namespaces), "namespace", "Only show changes in the given comma separated namespaces")
+	f.StringVar(&c.from, "from", "", "Show the changes after the given resume token")
+	f.IntVar(&c.limit, "limit", 0, "Maximum number of changes to read from the controller at once")
+	f.BoolVar(&c.once, "once", false, "Show the changes that are available and exit, instead of waiting for more")
+	f.StringVar(&c.consumer, "consumer", "", "Retain the changes that the named consumer hasn't read yet")
+}
+
+// Init implements Command.Init.
+func (c *watchChangesCommand) Init(args []string) error {
+	if c.limit < 0 {
+		return errors.Errorf("--limit must not be negative")
+	}
+	return cmd.CheckEmpty(args)
+}
+
+func (c *watchChangesCommand) getAPI(ctx context.Context) (WatchChangesAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return modelchanges.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *watchChangesCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	// Stop streaming when the command is interrupted, this also cancels
+	// any read that is waiting for changes.
+	stdCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	interrupted := make(chan os.Signal, 1)
+	ctx.InterruptNotify(interrupted)
+	defer ctx.StopInterruptNotify(interrupted)
+	go func() {
+		select {
+		case <-interrupted:
+			cancel()
+		case <-stdCtx.Done():
+		}
+	}()
+
+	token := c.from
+	defer func() {
+		if token != "" {
+			ctx.Infof("resume token: %s", token)
+		}
+	}()
+
+	for {
+		changes, next, err := client.ReadChanges(stdCtx, modelchanges.ReadArgs{
+			Namespaces:  c.namespaces,
+			ResumeToken: token,
+			Limit:       c.limit,
+			Wait:        !c.once,
+			Consumer:    c.consumer,
+		})
+		if stdCtx.Err() != nil {
+			return nil
+		} else if params.IsCodeModelChangesPruned(err) {
+			return errors.Errorf("changes after resume token %q are no longer available, "+
+				"resync and restart without --from", token)
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		for _, change := range changes {
+			if err := c.out.Write(ctx, toChangeOutput(change)); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		token = next
+
+		// With --once, keep reading until all the changes that are available
+		// have been shown.
+		if c.once && len(changes) == 0 {
+			return nil
+		}
+	}
+}
+
+// changeOutput is the serialisable form of a model change.
+type changeOutput struct {
+	Token          string    `json:"token" yaml:"token"`
+	Namespace      string    `json:"namespace" yaml:"namespace"`
+	Entity         string    `json:"entity" yaml:"entity"`
+	Type           string    `json:"type" yaml:"type"`
+	Changed        string    `json:"changed" yaml:"changed"`
+	ChangedColumns []string  `json:"changed-columns,omitempty" yaml:"changed-columns,omitempty"`
+	Created        time.Time `json:"created" yaml:"created"`
+}
+
+func toChangeOutput(change params.ModelChange) changeOutput {
+	return changeOutput{
+		Token:          change.Token,
+		Namespace:      change.Namespace,
+		Entity:         change.Entity,
+		Type:           change.Type,
+		Changed:        change.Changed,
+		ChangedColumns: change.ChangedColumns,
+		Created:        change.Created,
+	}
+}
+
+func formatChangeTabular(writer io.Writer, value interface{}) error {
+	change, ok := value.(changeOutput)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", change, value)
+	}
+	line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s",
+		change.Token, change.Namespace, change.Entity, change.Type, change.Changed)
+	if len(change.ChangedColumns) > 0 {
+		line += "\t" + strings.Join(change.ChangedColumns, ",")
+	}
+	_, err := fmt.Fprintln(writer, line)
+	return err
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model_test
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/client/modelchanges"
+	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type watchChangesSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+	fake *fakeWatchChangesClient
+}
+
+var _ = gc.Suite(&watchChangesSuite{})
+
+type fakeWatchChangesClient struct {
+	reads   []modelchanges.ReadArgs
+	results [][]params.ModelChange
+	err     error
+}
+
+func (f *fakeWatchChangesClient) Close() error {
+	return nil
+}
+
+func (f *fakeWatchChangesClient) ReadChanges(ctx context.Context, args modelchanges.ReadArgs) ([]params.ModelChange, string, error) {
+	f.reads = append(f.reads, args)
+	if f.err != nil {
+		return nil, "", f.err
+	}
+	if len(f.results) == 0 {
+		return nil, args.ResumeToken, nil
+	}
+	changes := f.results[0]
+	f.results = f.results[1:]
+	return changes, changes[len(changes)-1].Token, nil
+}
+
+func (s *watchChangesSuite) SetUpTest(c *gc.C) {
+	s.FakeJujuXDGDataHomeSuite.SetUpTest(c)
+
+	s.fake = &fakeWatchChangesClient{
+		results: [][]params.ModelChange{{{
+			Token:     "11",
+			Namespace: "application",
+			Entity:    "application",
+			Type:      "create",
+			Changed:   "app-uuid",
+		}, {
+			Token:          "12",
+			Namespace:      "unit",
+			Entity:         "unit",
+			Type:           "update",
+			Changed:        "unit-uuid",
+			ChangedColumns: []string{"life_id", "charm_uuid"},
+		}}},
+	}
+}
+
+func (s *watchChangesSuite) TestWatchChangesOnce(c *gc.C) {
+	command := model.NewWatchChangesCommandForTest(s.fake)
+	ctx, err := cmdtesting.RunCommand(c, command, "--from", "10", "--once", "--namespace", "application,unit")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, ""+
+		"11\tapplication\tapplication\tcreate\tapp-uuid\n"+
+		"12\tunit\tunit\tupdate\tunit-uuid\tlife_id,charm_uuid\n")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "resume token: 12\n")
+
+	c.Assert(s.fake.reads, gc.HasLen, 2)
+	c.Check(s.fake.reads[0], jc.DeepEquals, modelchanges.ReadArgs{
+		Namespaces:  []string{"application", "unit"},
+		ResumeToken: "10",
+	})
+	c.Check(s.fake.reads[1].ResumeToken, gc.Equals, "12")
+}
+
+func (s *watchChangesSuite) TestWatchChangesJSON(c *gc.C) {
+	command := model.NewWatchChangesCommandForTest(s.fake)
+	ctx, err := cmdtesting.RunCommand(c, command, "--from", "10", "--once", "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, ""+
+		`{"token":"11","namespace":"application","entity":"application","type":"create","changed":"app-uuid","created":"0001-01-01T00:00:00Z"}`+"\n"+
+		`{"token":"12","namespace":"unit","entity":"unit","type":"update","changed":"unit-uuid","changed-columns":["life_id","charm_uuid"],"created":"0001-01-01T00:00:00Z"}`+"\n")
+}
+
+func (s *watchChangesSuite) TestWatchChangesPruned(c *gc.C) {
+	s.fake.err = &params.Error{
+		Message: "changes pruned from change log",
+		Code:    params.CodeModelChangesPruned,
+	}
+
+	command := model.NewWatchChangesCommandForTest(s.fake)
+	_, err := cmdtesting.RunCommand(c, command, "--from", "10", "--once")
+	c.Assert(err, gc.ErrorMatches, `changes after resume token "10" are no longer available, resync and restart without --from`)
+}
+
+func (s *watchChangesSuite) TestWatchChangesConsumer(c *gc.C) {
+	command := model.NewWatchChangesCommandForTest(s.fake)
+	_, err := cmdtesting.RunCommand(c, command, "--from", "10", "--once", "--consumer", "audit")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Every read passes the consumer, so that each resume token is
+	// checkpointed.
+	c.Assert(s.fake.reads, gc.HasLen, 2)
+	c.Check(s.fake.reads[0], jc.DeepEquals, modelchanges.ReadArgs{
+		ResumeToken: "10",
+		Consumer:    "audit",
+	})
+	c.Check(s.fake.reads[1], jc.DeepEquals, modelchanges.ReadArgs{
+		ResumeToken: "12",
+		Consumer:    "audit",
+	})
+}
+
+func (s *watchChangesSuite) TestWatchChangesInvalidLimit(c *gc.C) {
+	command := model.NewWatchChangesCommandForTest(s.fake)
+	_, err := cmdtesting.RunCommand(c, command, "--limit", "-1")
+	c.Assert(err, gc.ErrorMatches, `--limit must not be negative`)
+}
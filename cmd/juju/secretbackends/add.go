@@ -48,7 +48,18 @@ Config may be specified as key values ot read from a file.
 Any key values override file content if both are specified.
 
 To rotate the backend access credential/token (if specified), use
-the "token-rotate" config and supply a duration.
+the "token-rotate" config and supply a duration. For the "file" backend,
+this rotates the key used to encrypt secret content at rest.
+
+A "vault" backend authenticates with a static token by default. Instead,
+set "auth-method" to "approle" (with "role-id" and "secret-id"), or to
+"kubernetes" or "jwt" (with "role", and "jwt" or "jwt-path"), to have the
+controller log in to Vault. These auth methods require "token-rotate", so
+that the controller keeps the token, renews it before its TTL runs out and
+logs in again when it can no longer be renewed or is rejected. The per model
+kv stores may be mounted under a common path using "mount-prefix", and each
+model may be given its own Vault namespace, created under "namespace", using
+"namespace-per-model=true".
 
 `
 
@@ -56,6 +67,13 @@ const addSecretBackendsExamples = `
     juju add-secret-backend myvault vault --config /path/to/cfg.yaml
     juju add-secret-backend myvault vault token-rotate=10m --config /path/to/cfg.yaml
     juju add-secret-backend myvault vault endpoint=https://vault.io:8200 token=s.1wshwhw
+    juju add-secret-backend myvault vault endpoint=https://vault.io:8200 \
+        auth-method=approle role-id=$ROLE_ID secret-id=$SECRET_ID \
+        mount-prefix=juju token-rotate=1h
+    juju add-secret-backend myvault vault endpoint=https://vault.io:8200 \
+        auth-method=kubernetes role=juju-controller token-rotate=1h
+    juju add-secret-backend myfiles file path=/srv/juju-secrets
+    juju add-secret-backend myfiles file path=/srv/juju-secrets key=$(head -c 32 /dev/urandom | base64)
 `
 
 // AddSecretBackendsAPI is the secrets client API.
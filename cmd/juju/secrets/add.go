@@ -8,10 +8,12 @@ import (
 	"fmt"
 
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
 	apisecrets "github.com/juju/juju/api/client/secrets"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/internal/cmd"
 )
 
@@ -20,12 +22,13 @@ type addSecretCommand struct {
 
 	SecretUpsertContentCommand
 	name           string
+	secretType     string
 	secretsAPIFunc func(context.Context) (AddSecretsAPI, error)
 }
 
 // AddSecretsAPI is the secrets client API.
 type AddSecretsAPI interface {
-	CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error)
+	CreateSecret(ctx context.Context, name, description string, secretType secrets.SecretType, data map[string]string) (string, error)
 	Close() error
 }
 
@@ -54,6 +57,17 @@ prior to being stored.
 
 If a key has the '#file' suffix, the value is read from the corresponding file.
 
+The --type option declares the structure of the secret content, which
+is validated when the secret is created and each time it is updated.
+Supported types are:
+    generic:         any keys (the default)
+    tls:             certificate and private-key, with optional ca;
+                     the certificate must match the key and not be expired,
+                     and the secret expires when the certificate does
+    password:        password, with optional username
+    ssh-key:         private-key, with optional matching public-key
+    docker-registry: registry, username and password
+
 A secret is owned by the model, meaning only the model admin
 can manage it, ie grant/revoke access, update, remove etc.
 `
@@ -67,6 +81,10 @@ can manage it, ie grant/revoke access, update, remove etc.
     juju add-secret db-password \
         --info "my database password" \
         --file=/path/to/file
+    juju add-secret db-credentials --type password \
+        username=admin password=s3cret
+    juju add-secret my-cert --type tls \
+        certificate#file=/path/to/cert.pem private-key#file=/path/to/key.pem
 `
 )
 
@@ -81,6 +99,12 @@ func (c *addSecretCommand) Info() *cmd.Info {
 	})
 }
 
+// SetFlags implements cmd.Command.
+func (c *addSecretCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.SecretUpsertContentCommand.SetFlags(f)
+	f.StringVar(&c.secretType, "type", "", "the secret type, one of generic, tls, password, ssh-key or docker-registry")
+}
+
 // Init implements cmd.Command.
 func (c *addSecretCommand) Init(args []string) error {
 	if len(args) < 1 {
@@ -94,6 +118,9 @@ func (c *addSecretCommand) Init(args []string) error {
 	if len(c.Data) == 0 {
 		return errors.New("missing secret value or filename")
 	}
+	if c.secretType != "" && !secrets.SecretType(c.secretType).IsValid() {
+		return errors.NotValidf("secret type %q", c.secretType)
+	}
 	return nil
 }
 
@@ -105,7 +132,7 @@ func (c *addSecretCommand) Run(ctx *cmd.Context) error {
 	}
 	defer secretsAPI.Close()
 
-	uri, err := secretsAPI.CreateSecret(ctx, c.name, c.Description, c.Data)
+	uri, err := secretsAPI.CreateSecret(ctx, c.name, c.Description, secrets.SecretType(c.secretType), c.Data)
 	if err != nil {
 		return err
 	}
@@ -45,7 +45,7 @@ func (s *addSuite) TestAddDataFromArg(c *gc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", map[string]string{"foo": "YmFy"}).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", coresecrets.SecretType(""), map[string]string{"foo": "YmFy"}).Return(uri.String(), nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	ctx, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "foo=bar", "--info", "this is a secret.")
@@ -58,7 +58,7 @@ func (s *addSuite) TestAddDataFromFile(c *gc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
-	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", map[string]string{"foo": "YmFy"}).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "this is a secret.", coresecrets.SecretType(""), map[string]string{"foo": "YmFy"}).Return(uri.String(), nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
 
 	dir := c.MkDir()
@@ -81,3 +81,23 @@ func (s *addSuite) TestAddEmptyData(c *gc.C) {
 	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "--info", "this is a secret.")
 	c.Assert(err, gc.ErrorMatches, `missing secret value or filename`)
 }
+
+func (s *addSuite) TestAddTyped(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().CreateSecret(gomock.Any(), "my-secret", "", coresecrets.TypePassword, map[string]string{"password": "czNjcmV0"}).Return(uri.String(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "password=s3cret", "--type", "password")
+	c.Assert(err, jc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, gc.Equals, uri.String()+"\n")
+}
+
+func (s *addSuite) TestAddInvalidType(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewAddCommandForTest(s.store, s.secretsAPI), "my-secret", "foo=bar", "--type", "foo")
+	c.Assert(err, gc.ErrorMatches, `secret type "foo" not valid`)
+}
@@ -98,11 +98,27 @@ type secretValueDetails struct {
 }
 
 type secretRevisionDetails struct {
-	Revision   int        `json:"revision" yaml:"revision"`
-	Backend    string     `json:"backend,omitempty" yaml:"backend,omitempty"`
-	CreateTime time.Time  `json:"created" yaml:"created"`
-	UpdateTime time.Time  `json:"updated" yaml:"updated"`
-	ExpireTime *time.Time `json:"expires,omitempty" yaml:"expires,omitempty"`
+	Revision   int                    `json:"revision" yaml:"revision"`
+	Backend    string                 `json:"backend,omitempty" yaml:"backend,omitempty"`
+	CreateTime time.Time              `json:"created" yaml:"created"`
+	UpdateTime time.Time              `json:"updated" yaml:"updated"`
+	ExpireTime *time.Time             `json:"expires,omitempty" yaml:"expires,omitempty"`
+	Stage      *secretStageDetails    `json:"staged,omitempty" yaml:"staged,omitempty"`
+	Rollback   *secretRollbackDetails `json:"rollback,omitempty" yaml:"rollback,omitempty"`
+	Pinned     []string               `json:"pinned,omitempty" yaml:"pinned,omitempty"`
+}
+
+type secretStageDetails struct {
+	Status            secrets.StageStatus `json:"status" yaml:"status"`
+	PreviousRevision  int                 `json:"previous-revision" yaml:"previous-revision"`
+	Deadline          time.Time           `json:"deadline" yaml:"deadline"`
+	RollbackOnTimeout bool                `json:"rollback-on-timeout,omitempty" yaml:"rollback-on-timeout,omitempty"`
+}
+
+type secretRollbackDetails struct {
+	RestoredFrom int       `json:"restored-from" yaml:"restored-from"`
+	Replaced     int       `json:"replaced" yaml:"replaced"`
+	Time         time.Time `json:"time" yaml:"time"`
 }
 
 type secretDetailsByID map[string]secretDisplayDetails
@@ -111,6 +127,7 @@ type secretDisplayDetails struct {
 	URI                    *secrets.URI            `json:"-" yaml:"-"`
 	LatestRevision         int                     `json:"revision" yaml:"revision"`
 	LatestRevisionChecksum string                  `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Type                   secrets.SecretType      `json:"type,omitempty" yaml:"type,omitempty"`
 	LatestExpireTime       *time.Time              `json:"expires,omitempty" yaml:"expires,omitempty"`
 	RotatePolicy           secrets.RotatePolicy    `json:"rotation,omitempty" yaml:"rotation,omitempty"`
 	NextRotateTime         *time.Time              `json:"rotates,omitempty" yaml:"rotates,omitempty"`
@@ -124,6 +141,13 @@ type secretDisplayDetails struct {
 	Value                  *secretValueDetails     `json:"content,omitempty" yaml:"content,omitempty"`
 	Revisions              []secretRevisionDetails `json:"revisions,omitempty" yaml:"revisions,omitempty"`
 	Access                 []AccessInfo            `yaml:"access,omitempty" json:"access,omitempty"`
+	AccessLog              []accessRecordDetails   `yaml:"access-log,omitempty" json:"access-log,omitempty"`
+}
+
+type accessRecordDetails struct {
+	Revision   int       `json:"revision" yaml:"revision"`
+	Accessor   string    `json:"accessor" yaml:"accessor"`
+	AccessTime time.Time `json:"time" yaml:"time"`
 }
 
 // AccessInfo holds info about a secret access information.
@@ -145,6 +169,21 @@ func toGrantInfo(grants []secrets.AccessInfo) []AccessInfo {
 	return result
 }
 
+func toAccessRecordDetails(records []secrets.AccessRecord) []accessRecordDetails {
+	if len(records) == 0 {
+		return nil
+	}
+	result := make([]accessRecordDetails, len(records))
+	for i, r := range records {
+		result[i] = accessRecordDetails{
+			Revision:   r.Revision,
+			Accessor:   r.Accessor,
+			AccessTime: r.AccessTime,
+		}
+	}
+	return result
+}
+
 // Run implements cmd.Run.
 func (c *listSecretsCommand) Run(ctxt *cmd.Context) error {
 	if c.revealSecrets && c.out.Name() == "tabular" {
@@ -212,6 +251,7 @@ func gatherSecretInfo(
 		}
 		info := secretDisplayDetails{
 			URI:              m.Metadata.URI,
+			Type:             m.Metadata.Type,
 			Owner:            ownerId,
 			LatestRevision:   m.Metadata.LatestRevision,
 			LatestExpireTime: m.Metadata.LatestExpireTime,
@@ -227,6 +267,7 @@ func gatherSecretInfo(
 		if includeGrants {
 			info.Access = toGrantInfo(m.Access)
 		}
+		info.AccessLog = toAccessRecordDetails(m.AccessLog)
 		if includeRevisions {
 			info.Revisions = make([]secretRevisionDetails, len(m.Revisions))
 			for i, r := range m.Revisions {
@@ -239,6 +280,22 @@ func gatherSecretInfo(
 				if r.BackendName != nil {
 					rev.Backend = *r.BackendName
 				}
+				if r.Stage != nil {
+					rev.Stage = &secretStageDetails{
+						Status:            r.Stage.Status,
+						PreviousRevision:  r.Stage.PreviousRevision,
+						Deadline:          r.Stage.Deadline,
+						RollbackOnTimeout: r.Stage.RollbackOnTimeout,
+					}
+				}
+				if r.Rollback != nil {
+					rev.Rollback = &secretRollbackDetails{
+						RestoredFrom: r.Rollback.RestoredFromRevision,
+						Replaced:     r.Rollback.ReplacedRevision,
+						Time:         r.Rollback.Time,
+					}
+				}
+				rev.Pinned = r.PinnedApplications
 				info.Revisions[i] = rev
 			}
 		}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
+// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,PinSecretsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,PinSecretsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -180,18 +180,18 @@ func (c *MockAddSecretsAPICloseCall) DoAndReturn(f func() error) *MockAddSecrets
 }
 
 // CreateSecret mocks base method.
-func (m *MockAddSecretsAPI) CreateSecret(arg0 context.Context, arg1, arg2 string, arg3 map[string]string) (string, error) {
+func (m *MockAddSecretsAPI) CreateSecret(arg0 context.Context, arg1, arg2 string, arg3 secrets0.SecretType, arg4 map[string]string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "CreateSecret", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSecret indicates an expected call of CreateSecret.
-func (mr *MockAddSecretsAPIMockRecorder) CreateSecret(arg0, arg1, arg2, arg3 any) *MockAddSecretsAPICreateSecretCall {
+func (mr *MockAddSecretsAPIMockRecorder) CreateSecret(arg0, arg1, arg2, arg3, arg4 any) *MockAddSecretsAPICreateSecretCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockAddSecretsAPI)(nil).CreateSecret), arg0, arg1, arg2, arg3)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockAddSecretsAPI)(nil).CreateSecret), arg0, arg1, arg2, arg3, arg4)
 	return &MockAddSecretsAPICreateSecretCall{Call: call}
 }
 
@@ -207,13 +207,13 @@ func (c *MockAddSecretsAPICreateSecretCall) Return(arg0 string, arg1 error) *Moc
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockAddSecretsAPICreateSecretCall) Do(f func(context.Context, string, string, map[string]string) (string, error)) *MockAddSecretsAPICreateSecretCall {
+func (c *MockAddSecretsAPICreateSecretCall) Do(f func(context.Context, string, string, secrets0.SecretType, map[string]string) (string, error)) *MockAddSecretsAPICreateSecretCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockAddSecretsAPICreateSecretCall) DoAndReturn(f func(context.Context, string, string, map[string]string) (string, error)) *MockAddSecretsAPICreateSecretCall {
+func (c *MockAddSecretsAPICreateSecretCall) DoAndReturn(f func(context.Context, string, string, secrets0.SecretType, map[string]string) (string, error)) *MockAddSecretsAPICreateSecretCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -418,6 +418,44 @@ func (c *MockUpdateSecretsAPICloseCall) DoAndReturn(f func() error) *MockUpdateS
 	return c
 }
 
+// RollbackSecret mocks base method.
+func (m *MockUpdateSecretsAPI) RollbackSecret(arg0 context.Context, arg1 *secrets0.URI, arg2 string, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSecret", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackSecret indicates an expected call of RollbackSecret.
+func (mr *MockUpdateSecretsAPIMockRecorder) RollbackSecret(arg0, arg1, arg2, arg3 any) *MockUpdateSecretsAPIRollbackSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSecret", reflect.TypeOf((*MockUpdateSecretsAPI)(nil).RollbackSecret), arg0, arg1, arg2, arg3)
+	return &MockUpdateSecretsAPIRollbackSecretCall{Call: call}
+}
+
+// MockUpdateSecretsAPIRollbackSecretCall wrap *gomock.Call
+type MockUpdateSecretsAPIRollbackSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUpdateSecretsAPIRollbackSecretCall) Return(arg0 error) *MockUpdateSecretsAPIRollbackSecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUpdateSecretsAPIRollbackSecretCall) Do(f func(context.Context, *secrets0.URI, string, int) error) *MockUpdateSecretsAPIRollbackSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUpdateSecretsAPIRollbackSecretCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, int) error) *MockUpdateSecretsAPIRollbackSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSecret mocks base method.
 func (m *MockUpdateSecretsAPI) UpdateSecret(arg0 context.Context, arg1 *secrets0.URI, arg2 string, arg3 *bool, arg4, arg5 string, arg6 map[string]string) error {
 	m.ctrl.T.Helper()
@@ -554,3 +592,140 @@ func (c *MockRemoveSecretsAPIRemoveSecretCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockPinSecretsAPI is a mock of PinSecretsAPI interface.
+type MockPinSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockPinSecretsAPIMockRecorder
+}
+
+// MockPinSecretsAPIMockRecorder is the mock recorder for MockPinSecretsAPI.
+type MockPinSecretsAPIMockRecorder struct {
+	mock *MockPinSecretsAPI
+}
+
+// NewMockPinSecretsAPI creates a new mock instance.
+func NewMockPinSecretsAPI(ctrl *gomock.Controller) *MockPinSecretsAPI {
+	mock := &MockPinSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockPinSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPinSecretsAPI) EXPECT() *MockPinSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockPinSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPinSecretsAPIMockRecorder) Close() *MockPinSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPinSecretsAPI)(nil).Close))
+	return &MockPinSecretsAPICloseCall{Call: call}
+}
+
+// MockPinSecretsAPICloseCall wrap *gomock.Call
+type MockPinSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPinSecretsAPICloseCall) Return(arg0 error) *MockPinSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPinSecretsAPICloseCall) Do(f func() error) *MockPinSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPinSecretsAPICloseCall) DoAndReturn(f func() error) *MockPinSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PinSecretRevision mocks base method.
+func (m *MockPinSecretsAPI) PinSecretRevision(arg0 context.Context, arg1 *secrets0.URI, arg2, arg3 string, arg4 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinSecretRevision", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinSecretRevision indicates an expected call of PinSecretRevision.
+func (mr *MockPinSecretsAPIMockRecorder) PinSecretRevision(arg0, arg1, arg2, arg3, arg4 any) *MockPinSecretsAPIPinSecretRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinSecretRevision", reflect.TypeOf((*MockPinSecretsAPI)(nil).PinSecretRevision), arg0, arg1, arg2, arg3, arg4)
+	return &MockPinSecretsAPIPinSecretRevisionCall{Call: call}
+}
+
+// MockPinSecretsAPIPinSecretRevisionCall wrap *gomock.Call
+type MockPinSecretsAPIPinSecretRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPinSecretsAPIPinSecretRevisionCall) Return(arg0 error) *MockPinSecretsAPIPinSecretRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPinSecretsAPIPinSecretRevisionCall) Do(f func(context.Context, *secrets0.URI, string, string, int) error) *MockPinSecretsAPIPinSecretRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPinSecretsAPIPinSecretRevisionCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, string, int) error) *MockPinSecretsAPIPinSecretRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UnpinSecretRevision mocks base method.
+func (m *MockPinSecretsAPI) UnpinSecretRevision(arg0 context.Context, arg1 *secrets0.URI, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinSecretRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinSecretRevision indicates an expected call of UnpinSecretRevision.
+func (mr *MockPinSecretsAPIMockRecorder) UnpinSecretRevision(arg0, arg1, arg2, arg3 any) *MockPinSecretsAPIUnpinSecretRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinSecretRevision", reflect.TypeOf((*MockPinSecretsAPI)(nil).UnpinSecretRevision), arg0, arg1, arg2, arg3)
+	return &MockPinSecretsAPIUnpinSecretRevisionCall{Call: call}
+}
+
+// MockPinSecretsAPIUnpinSecretRevisionCall wrap *gomock.Call
+type MockPinSecretsAPIUnpinSecretRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPinSecretsAPIUnpinSecretRevisionCall) Return(arg0 error) *MockPinSecretsAPIUnpinSecretRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPinSecretsAPIUnpinSecretRevisionCall) Do(f func(context.Context, *secrets0.URI, string, string) error) *MockPinSecretsAPIUnpinSecretRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPinSecretsAPIUnpinSecretRevisionCall) DoAndReturn(f func(context.Context, *secrets0.URI, string, string) error) *MockPinSecretsAPIUnpinSecretRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
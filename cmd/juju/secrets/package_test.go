@@ -12,7 +12,7 @@ import (
 	"github.com/juju/juju/jujuclient"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,PinSecretsAPI
 
 func TestPackage(t *stdtesting.T) {
 	gc.TestingT(t)
@@ -63,6 +63,24 @@ func NewRevokeCommandForTest(store jujuclient.ClientStore, api GrantRevokeSecret
 	return c
 }
 
+// NewPinCommandForTest returns a secrets command for testing.
+func NewPinCommandForTest(store jujuclient.ClientStore, api PinSecretsAPI) *pinSecretCommand {
+	c := &pinSecretCommand{
+		secretsAPIFunc: func(ctx context.Context) (PinSecretsAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
+
+// NewUnpinCommandForTest returns a secrets command for testing.
+func NewUnpinCommandForTest(store jujuclient.ClientStore, api PinSecretsAPI) *unpinSecretCommand {
+	c := &unpinSecretCommand{
+		secretsAPIFunc: func(ctx context.Context) (PinSecretsAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
+
 // NewListCommandForTest returns a secrets command for testing.
 func NewListCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecretsAPI) *listSecretsCommand {
 	c := &listSecretsCommand{
@@ -0,0 +1,190 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// PinSecretsAPI is the secrets client API.
+type PinSecretsAPI interface {
+	PinSecretRevision(ctx context.Context, uri *secrets.URI, name, app string, revision int) error
+	UnpinSecretRevision(ctx context.Context, uri *secrets.URI, name, app string) error
+	Close() error
+}
+
+type pinSecretCommand struct {
+	modelcmd.ModelCommandBase
+
+	secretURI *secrets.URI
+	name      string
+	app       string
+	revision  int
+
+	secretsAPIFunc func(ctx context.Context) (PinSecretsAPI, error)
+}
+
+// NewPinSecretCommand returns a command to pin the units of an
+// application to a revision of a secret.
+func NewPinSecretCommand() cmd.Command {
+	c := &pinSecretCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+	return modelcmd.Wrap(c)
+}
+
+func (c *pinSecretCommand) secretsAPI(ctx context.Context) (PinSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+const (
+	pinSecretDoc = `
+Pin the units of a consuming application to a revision of a secret.
+
+While pinned, the units are given the content of the pinned revision,
+even when they ask for the latest revision, and the pinned revision is
+retained. This is intended for use during an incident, eg to keep an
+application on known good content while a bad rotation is dealt with.
+Pins are shown by 'juju show-secret --revisions' and are removed with
+'juju unpin-secret'.
+
+Pinning requires model admin access.
+`
+	pinSecretExamples = `
+    juju pin-secret my-secret ubuntu-k8s 2
+    juju pin-secret 9m4e2mr0ui3e8a215n4g ubuntu-k8s 2
+`
+)
+
+// Info implements cmd.Command.
+func (c *pinSecretCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "pin-secret",
+		Args:     "<ID>|<name> <application> <revision>",
+		Purpose:  "Pin an application to a secret revision.",
+		Doc:      pinSecretDoc,
+		Examples: pinSecretExamples,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *pinSecretCommand) Init(args []string) error {
+	if len(args) < 3 {
+		return errors.New("missing secret URI, application name or revision")
+	}
+
+	var err error
+	if c.secretURI, err = secrets.ParseURI(args[0]); err != nil {
+		c.name = args[0]
+	}
+	if !names.IsValidApplication(args[1]) {
+		return errors.NotValidf("application name %q", args[1])
+	}
+	c.app = args[1]
+	if c.revision, err = strconv.Atoi(args[2]); err != nil || c.revision <= 0 {
+		return errors.NotValidf("secret revision %q", args[2])
+	}
+	return cmd.CheckEmpty(args[3:])
+}
+
+// Run implements cmd.Command.
+func (c *pinSecretCommand) Run(ctx *cmd.Context) error {
+	secretsAPI, err := c.secretsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer secretsAPI.Close()
+	return secretsAPI.PinSecretRevision(ctx, c.secretURI, c.name, c.app, c.revision)
+}
+
+type unpinSecretCommand struct {
+	modelcmd.ModelCommandBase
+
+	secretURI *secrets.URI
+	name      string
+	app       string
+
+	secretsAPIFunc func(ctx context.Context) (PinSecretsAPI, error)
+}
+
+// NewUnpinSecretCommand returns a command to remove the pin of the units
+// of an application to a revision of a secret.
+func NewUnpinSecretCommand() cmd.Command {
+	c := &unpinSecretCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+	return modelcmd.Wrap(c)
+}
+
+func (c *unpinSecretCommand) secretsAPI(ctx context.Context) (PinSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+const (
+	unpinSecretDoc = `
+Remove the pin of the units of a consuming application to a revision of
+a secret. Units using the previously pinned revision are moved to the
+latest revision.
+
+Unpinning requires model admin access.
+`
+	unpinSecretExamples = `
+    juju unpin-secret my-secret ubuntu-k8s
+    juju unpin-secret 9m4e2mr0ui3e8a215n4g ubuntu-k8s
+`
+)
+
+// Info implements cmd.Command.
+func (c *unpinSecretCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "unpin-secret",
+		Args:     "<ID>|<name> <application>",
+		Purpose:  "Unpin an application from a secret revision.",
+		Doc:      unpinSecretDoc,
+		Examples: unpinSecretExamples,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *unpinSecretCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("missing secret URI or application name")
+	}
+
+	var err error
+	if c.secretURI, err = secrets.ParseURI(args[0]); err != nil {
+		c.name = args[0]
+	}
+	if !names.IsValidApplication(args[1]) {
+		return errors.NotValidf("application name %q", args[1])
+	}
+	c.app = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run implements cmd.Command.
+func (c *unpinSecretCommand) Run(ctx *cmd.Context) error {
+	secretsAPI, err := c.secretsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer secretsAPI.Close()
+	return secretsAPI.UnpinSecretRevision(ctx, c.secretURI, c.name, c.app)
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/jujuclient"
+)
+
+type pinSuite struct {
+	jujutesting.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockPinSecretsAPI
+}
+
+var _ = gc.Suite(&pinSuite{})
+
+func (s *pinSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *pinSuite) setup(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.secretsAPI = mocks.NewMockPinSecretsAPI(ctrl)
+	return ctrl
+}
+
+func (s *pinSuite) TestPin(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().PinSecretRevision(gomock.Any(), uri, "", "gitlab", 2).Return(nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewPinCommandForTest(s.store, s.secretsAPI), uri.String(), "gitlab", "2")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *pinSuite) TestPinByName(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.secretsAPI.EXPECT().PinSecretRevision(gomock.Any(), nil, "my-secret", "gitlab", 2).Return(nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewPinCommandForTest(s.store, s.secretsAPI), "my-secret", "gitlab", "2")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *pinSuite) TestPinInvalidArgs(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewPinCommandForTest(s.store, s.secretsAPI), "my-secret", "gitlab")
+	c.Assert(err, gc.ErrorMatches, `missing secret URI, application name or revision`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewPinCommandForTest(s.store, s.secretsAPI), "my-secret", "gitlab", "latest")
+	c.Assert(err, gc.ErrorMatches, `secret revision "latest" not valid`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewPinCommandForTest(s.store, s.secretsAPI), "my-secret", "gitlab/0", "2")
+	c.Assert(err, gc.ErrorMatches, `application name "gitlab/0" not valid`)
+}
+
+func (s *pinSuite) TestUnpin(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().UnpinSecretRevision(gomock.Any(), uri, "", "gitlab").Return(nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewUnpinCommandForTest(s.store, s.secretsAPI), uri.String(), "gitlab")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *pinSuite) TestUnpinMissingArgs(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewUnpinCommandForTest(s.store, s.secretsAPI), "my-secret")
+	c.Assert(err, gc.ErrorMatches, `missing secret URI or application name`)
+}
@@ -26,6 +26,7 @@ type showSecretsCommand struct {
 	revealSecrets      bool
 	revisions          bool
 	revision           int
+	accessLog          bool
 }
 
 var showSecretsDoc = `
@@ -35,7 +36,17 @@ For controller/model admins, the actual secret content is exposed
 with the '--reveal' option in json or yaml formats.
 
 Use --revision to inspect a particular revision, else latest is used.
-Use --revisions to see the metadata for each revision.
+Use --revisions to see the metadata for each revision. For a revision
+created by a staged rotation, this includes whether the rotation is
+pending, committed, expired or rolled back, the deadline by which
+consumers need to track the revision, and whether the previous revision
+is restored should the deadline pass. For a revision restored by a rollback, this
+includes which revision it was restored from and which it replaced, and
+any applications pinned to a revision are listed against it.
+
+Use --access-log to see the most recent reads of the secret content,
+including which revision was read, by which unit, application or user,
+and when. Only controller/model admins can see the access log.
 `
 
 const showSecretsExamples = `
@@ -45,6 +56,7 @@ const showSecretsExamples = `
     juju show-secret 9m4e2mr0ui3e8a215n4g --revision 2 --reveal
     juju show-secret 9m4e2mr0ui3e8a215n4g --revisions
     juju show-secret 9m4e2mr0ui3e8a215n4g --reveal
+    juju show-secret 9m4e2mr0ui3e8a215n4g --access-log
 `
 
 // NewShowSecretsCommand returns a command to list secrets metadata.
@@ -84,6 +96,7 @@ func (c *showSecretsCommand) Info() *cmd.Info {
 func (c *showSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.revealSecrets, "reveal", false, "Reveal secret values, applicable to yaml or json formats only")
 	f.BoolVar(&c.revisions, "revisions", false, "Show the secret revisions metadata")
+	f.BoolVar(&c.accessLog, "access-log", false, "Show the recent reads of the secret content")
 	f.IntVar(&c.revision, "revision", 0, "Show a specific revision (defaults to latest)")
 	f.IntVar(&c.revision, "r", 0, "")
 	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
@@ -130,7 +143,8 @@ func (c *showSecretsCommand) Run(ctxt *cmd.Context) error {
 	defer api.Close()
 
 	filter := coresecrets.Filter{
-		URI: c.uri,
+		URI:              c.uri,
+		IncludeAccessLog: c.accessLog,
 	}
 	if c.revision > 0 {
 		filter.Revision = &c.revision
@@ -5,6 +5,7 @@ package secrets_test
 
 import (
 	"fmt"
+	"time"
 
 	jujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -69,7 +70,7 @@ func (s *ShowSuite) TestShow(c *gc.C) {
 		[]apisecrets.SecretDetails{{
 			Metadata: coresecrets.SecretMetadata{
 				URI: uri, RotatePolicy: coresecrets.RotateHourly,
-				Version: 1, LatestRevision: 2,
+				Version: 1, LatestRevision: 2, Type: coresecrets.TypePassword,
 				Description:            "my secret",
 				Owner:                  coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
 				Label:                  "foobar",
@@ -93,6 +94,7 @@ func (s *ShowSuite) TestShow(c *gc.C) {
 	c.Assert(out, gc.Equals, fmt.Sprintf(`
 %s:
   revision: 2
+  type: password
   expires: 1970-01-01T00:00:00.000000001Z
   rotation: hourly
   owner: mysql
@@ -203,6 +205,24 @@ func (s *ShowSuite) TestShowRevisions(c *gc.C) {
 			Revisions: []coresecrets.SecretRevisionMetadata{{
 				Revision:    666,
 				BackendName: ptr("some backend"),
+			}, {
+				Revision:    667,
+				BackendName: ptr("some backend"),
+				Stage: &coresecrets.RevisionStage{
+					Status:            coresecrets.StagePending,
+					PreviousRevision:  666,
+					Deadline:          time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC),
+					RollbackOnTimeout: true,
+				},
+			}, {
+				Revision:    668,
+				BackendName: ptr("some backend"),
+				Rollback: &coresecrets.RevisionRollback{
+					RestoredFromRevision: 665,
+					ReplacedRevision:     667,
+					Time:                 time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC),
+				},
+				PinnedApplications: []string{"gitlab", "mediawiki"},
 			}},
 		}}, nil)
 	s.secretsAPI.EXPECT().Close().Return(nil)
@@ -224,5 +244,75 @@ func (s *ShowSuite) TestShowRevisions(c *gc.C) {
     backend: some backend
     created: 0001-01-01T00:00:00Z
     updated: 0001-01-01T00:00:00Z
+  - revision: 667
+    backend: some backend
+    created: 0001-01-01T00:00:00Z
+    updated: 0001-01-01T00:00:00Z
+    staged:
+      status: pending
+      previous-revision: 666
+      deadline: 2025-01-01T06:00:00Z
+      rollback-on-timeout: true
+  - revision: 668
+    backend: some backend
+    created: 0001-01-01T00:00:00Z
+    updated: 0001-01-01T00:00:00Z
+    rollback:
+      restored-from: 665
+      replaced: 667
+      time: 2025-01-01T07:00:00Z
+    pinned:
+    - gitlab
+    - mediawiki
+`[1:], uri.ID))
+}
+
+func (s *ShowSuite) TestShowAccessLog(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().ListSecrets(gomock.Any(), false, coresecrets.Filter{
+		URI:              uri,
+		IncludeAccessLog: true,
+	}).Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI: uri, RotatePolicy: coresecrets.RotateHourly,
+				Version: 1, LatestRevision: 2,
+				Description: "my secret",
+				Owner:       coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+				Label:       "foobar",
+			},
+			AccessLog: []coresecrets.AccessRecord{{
+				Revision:   1,
+				Accessor:   "unit-gitlab-0",
+				AccessTime: time.Date(2025, 1, 1, 6, 0, 0, 0, time.UTC),
+			}, {
+				Revision:   2,
+				Accessor:   "user-admin",
+				AccessTime: time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC),
+			}},
+		}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--access-log")
+	c.Assert(err, jc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, gc.Equals, fmt.Sprintf(`
+%s:
+  revision: 2
+  rotation: hourly
+  owner: mysql
+  description: my secret
+  label: foobar
+  created: 0001-01-01T00:00:00Z
+  updated: 0001-01-01T00:00:00Z
+  access-log:
+  - revision: 1
+    accessor: unit-gitlab-0
+    time: 2025-01-01T06:00:00Z
+  - revision: 2
+    accessor: user-admin
+    time: 2025-01-01T07:00:00Z
 `[1:], uri.ID))
 }
@@ -26,8 +26,9 @@ type updateSecretCommand struct {
 	secretURI *secrets.URI
 	autoPrune common.AutoBoolValue
 
-	name    string
-	newName string
+	name       string
+	newName    string
+	rollbackTo int
 }
 
 // UpdateSecretsAPI is the secrets client API.
@@ -37,6 +38,7 @@ type UpdateSecretsAPI interface {
 		uri *secrets.URI, name string, autoPrune *bool,
 		newName, description string, data map[string]string,
 	) error
+	RollbackSecret(ctx context.Context, uri *secrets.URI, name string, revision int) error
 	Close() error
 }
 
@@ -66,6 +68,13 @@ which are no longer being tracked by any observers (see Rotation and Expiry).
 This is configured per revision. This feature is opt-in because Juju 
 automatically removing secret content might result in data loss.
 
+The --rollback-to option restores an earlier revision of the secret as its
+latest revision, for example to revert a bad rotation. The revision must
+still be retained, ie not yet obsolete; the restored content is given a new
+revision number and the rollback is shown by 'juju show-secret --revisions'.
+Rolling back requires model admin access and cannot be combined with new
+content or other updates.
+
 `
 	updateSecretExamples = `
     juju update-secret secret:9m4e2mr0ui3e8a215n4g token=34ae35facd4
@@ -79,6 +88,7 @@ automatically removing secret content might result in data loss.
     juju update-secret secret:9m4e2mr0ui3e8a215n4g --name db-password \
         --info "my database password" \
         --file=/path/to/file
+    juju update-secret secret:9m4e2mr0ui3e8a215n4g --rollback-to 3
 `
 )
 
@@ -102,6 +112,15 @@ func (c *updateSecretCommand) Init(args []string) error {
 	if c.secretURI, err = secrets.ParseURI(args[0]); err != nil {
 		c.name = args[0]
 	}
+	if c.rollbackTo != 0 {
+		if c.rollbackTo < 0 {
+			return errors.NotValidf("rollback revision %d", c.rollbackTo)
+		}
+		if len(args) > 1 || c.FileName != "" || c.Description != "" || c.newName != "" || c.autoPrune.Get() != nil {
+			return errors.New("--rollback-to cannot be used with other updates")
+		}
+		return nil
+	}
 	return c.SecretUpsertContentCommand.Init(args[1:])
 }
 
@@ -109,6 +128,7 @@ func (c *updateSecretCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.SecretUpsertContentCommand.SetFlags(f)
 	f.StringVar(&c.newName, "name", "", "the new secret name")
 	f.Var(&c.autoPrune, "auto-prune", "used to allow Juju to automatically remove revisions which are no longer being tracked by any observers")
+	f.IntVar(&c.rollbackTo, "rollback-to", 0, "restore the specified revision as the latest revision")
 }
 
 // Run implements cmd.Command.
@@ -118,5 +138,8 @@ func (c *updateSecretCommand) Run(ctx *cmd.Context) error {
 		return errors.Trace(err)
 	}
 	defer func() { _ = secretsAPI.Close() }()
+	if c.rollbackTo > 0 {
+		return secretsAPI.RollbackSecret(ctx, c.secretURI, c.name, c.rollbackTo)
+	}
 	return secretsAPI.UpdateSecret(ctx, c.secretURI, c.name, c.autoPrune.Get(), c.newName, c.Description, c.Data)
 }
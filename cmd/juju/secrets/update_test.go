@@ -122,3 +122,31 @@ foo: bar
 	)
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *updateSuite) TestUpdateRollback(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().RollbackSecret(gomock.Any(), uri, "", 3).Return(nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
+		s.store, s.secretsAPI), uri.String(), "--rollback-to", "3",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *updateSuite) TestUpdateRollbackWithOtherUpdates(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	_, err := cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
+		s.store, s.secretsAPI), uri.String(), "foo=bar", "--rollback-to", "3",
+	)
+	c.Assert(err, gc.ErrorMatches, `--rollback-to cannot be used with other updates`)
+
+	_, err = cmdtesting.RunCommand(c, secrets.NewUpdateCommandForTest(
+		s.store, s.secretsAPI), uri.String(), "--info", "this is a secret.", "--rollback-to", "3",
+	)
+	c.Assert(err, gc.ErrorMatches, `--rollback-to cannot be used with other updates`)
+}
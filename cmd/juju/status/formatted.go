@@ -234,6 +234,17 @@ type unitStatus struct {
 	Address       string                `json:"address,omitempty" yaml:"address,omitempty"`
 	ProviderId    string                `json:"provider-id,omitempty" yaml:"provider-id,omitempty"`
 	Subordinates  map[string]unitStatus `json:"subordinates,omitempty" yaml:"subordinates,omitempty"`
+
+	// PebbleChecks holds the statuses of the Pebble checks in the unit's
+	// workload containers, keyed by container and then check name.
+	PebbleChecks map[string]map[string]pebbleCheckStatus `json:"pebble-checks,omitempty" yaml:"pebble-checks,omitempty"`
+}
+
+type pebbleCheckStatus struct {
+	Status   string `json:"status" yaml:"status"`
+	Message  string `json:"message,omitempty" yaml:"message,omitempty"`
+	Critical bool   `json:"critical,omitempty" yaml:"critical,omitempty"`
+	Since    string `json:"since,omitempty" yaml:"since,omitempty"`
 }
 
 func (s *formattedStatus) applicationScale(name string) (string, bool) {
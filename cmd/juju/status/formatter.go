@@ -450,6 +450,7 @@ func (sf *statusFormatter) formatUnit(info unitFormatInfo) unitStatus {
 		Charm:              info.unit.Charm,
 		Subordinates:       make(map[string]unitStatus),
 		Leader:             info.unit.Leader,
+		PebbleChecks:       sf.formatPebbleChecks(info.unit.PebbleChecks),
 	}
 
 	for k, m := range info.unit.Subordinates {
@@ -462,6 +463,28 @@ func (sf *statusFormatter) formatUnit(info unitFormatInfo) unitStatus {
 	return out
 }
 
+func (sf *statusFormatter) formatPebbleChecks(checks []params.PebbleCheckStatus) map[string]map[string]pebbleCheckStatus {
+	if len(checks) == 0 {
+		return nil
+	}
+	out := make(map[string]map[string]pebbleCheckStatus)
+	for _, check := range checks {
+		if out[check.Container] == nil {
+			out[check.Container] = make(map[string]pebbleCheckStatus)
+		}
+		formatted := pebbleCheckStatus{
+			Status:   check.Status,
+			Message:  check.Message,
+			Critical: check.Critical,
+		}
+		if check.Since != nil {
+			formatted.Since = common.FormatTime(check.Since, sf.isoTime)
+		}
+		out[check.Container][check.Check] = formatted
+	}
+	return out
+}
+
 func (sf *statusFormatter) getStatusInfoContents(inst params.DetailedStatus) statusInfoContents {
 	// TODO(perrito66) add status validation.
 	info := statusInfoContents{
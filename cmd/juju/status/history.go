@@ -80,6 +80,11 @@ Show the status history for the specified unit with the logs for any date after
 
     juju show-status-log mysql/0 --from-date 2020-01-01
 
+Show the history of the Pebble checks in the workload containers of the
+specified unit:
+
+    juju show-status-log -type pebble-check mysql/0
+
 Show the status history for the specified application:
 
     juju show-status-log -type application wordpress
@@ -236,7 +241,7 @@ func (c *statusHistoryCommand) Run(ctx *cmd.Context) error {
 			return errors.Trace(err)
 		}
 		tag = names.NewModelTag(details.ModelUUID)
-	case status.KindUnit, status.KindWorkload, status.KindUnitAgent:
+	case status.KindUnit, status.KindWorkload, status.KindUnitAgent, status.KindPebbleCheck:
 		if !names.IsValidUnit(c.entityName) {
 			return errors.Errorf("%q is not a valid name for a %s", c.entityName, kind)
 		}
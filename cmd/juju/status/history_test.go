@@ -158,9 +158,38 @@ func (s *StatusHistorySuite) TestYaml(c *gc.C) {
 	c.Check(cmdtesting.Stdout(ctx), gc.Equals, expected)
 }
 
+func (s *StatusHistorySuite) TestPebbleCheck(c *gc.C) {
+	s.now = time.Date(2017, 11, 28, 12, 34, 56, 0, time.UTC)
+	api := &fakeHistoryAPI{
+		history: status.History{{
+			Kind:   status.KindPebbleCheck,
+			Status: "down",
+			Info:   `container "mysql" check "online": connection refused`,
+			Since:  s.next(),
+		}},
+	}
+	s.api = api
+	ctx, err := cmdtesting.RunCommand(c, s.newCommand(), "mysql/0", "--type", "pebble-check", "--utc")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(api.kind, gc.Equals, status.KindPebbleCheck)
+	c.Check(api.tag, gc.Equals, names.NewUnitTag("mysql/0"))
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Time                  Type          Status  Message
+2017-11-28 12:34:56Z  pebble-check  down    container "mysql" check "online": connection refused
+`[1:])
+}
+
+func (s *StatusHistorySuite) TestPebbleCheckInvalidUnit(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newCommand(), "mysql", "--type", "pebble-check")
+	c.Assert(err, gc.ErrorMatches, `"mysql" is not a valid name for a pebble-check`)
+}
+
 type fakeHistoryAPI struct {
 	err     error
 	history status.History
+
+	kind status.HistoryKind
+	tag  names.Tag
 }
 
 func (*fakeHistoryAPI) Close() error {
@@ -168,5 +197,7 @@ func (*fakeHistoryAPI) Close() error {
 }
 
 func (f *fakeHistoryAPI) StatusHistory(ctx context.Context, kind status.HistoryKind, tag names.Tag, filter status.StatusHistoryFilter) (status.History, error) {
+	f.kind = kind
+	f.tag = tag
 	return f.history, f.err
 }
@@ -232,6 +232,16 @@ func printApplications(tw *ansiterm.TabWriter, fs formattedStatus) {
 	}
 	endSection(tw)
 
+	// The health of workload containers is only shown when Pebble checks
+	// have been reported for at least one unit.
+	var showHealth bool
+	for _, u := range units {
+		showHealth = showHealth || len(u.PebbleChecks) > 0
+		recurseUnits(u, 0, func(_ string, sub unitStatus, _ int) {
+			showHealth = showHealth || len(sub.PebbleChecks) > 0
+		})
+	}
+
 	pUnit := func(name string, u unitStatus, level int) {
 		message := u.WorkloadStatusInfo.Message
 		// If we're still allocating and there's a message, show that.
@@ -252,6 +262,9 @@ func printApplications(tw *ansiterm.TabWriter, fs formattedStatus) {
 		if fs.Model.Type == caasModelType {
 			w.PrintColor(output.InfoHighlight, u.Address)
 			printPorts(w, u.OpenedPorts)
+			if showHealth {
+				printContainerHealth(w, u.PebbleChecks)
+			}
 			w.PrintColorNoTab(output.EmphasisHighlight.Gray, truncateMessage(message))
 			w.Println()
 			return
@@ -259,16 +272,22 @@ func printApplications(tw *ansiterm.TabWriter, fs formattedStatus) {
 		w.Print(u.Machine)
 		w.PrintColor(output.InfoHighlight, u.PublicAddress)
 		printPorts(w, u.OpenedPorts)
+		if showHealth {
+			printContainerHealth(w, u.PebbleChecks)
+		}
 		w.PrintColorNoTab(output.EmphasisHighlight.Gray, truncateMessage(message))
 		w.Println()
 	}
 
 	if len(units) > 0 {
+		headers := []interface{}{"Unit", "Workload", "Agent", "Machine", "Public address", "Ports"}
 		if fs.Model.Type == caasModelType {
-			startSection(tw, false, "Unit", "Workload", "Agent", "Address", "Ports", "Message")
-		} else {
-			startSection(tw, false, "Unit", "Workload", "Agent", "Machine", "Public address", "Ports", "Message")
+			headers = []interface{}{"Unit", "Workload", "Agent", "Address", "Ports"}
+		}
+		if showHealth {
+			headers = append(headers, "Health")
 		}
+		startSection(tw, false, append(headers, "Message")...)
 		for _, name := range naturalsort.Sort(stringKeysFromMap(units)) {
 			u := units[name]
 			pUnit(name, u, 0)
@@ -316,6 +335,33 @@ type OutputWriter interface {
 	PrintColorNoTab(ctx *ansiterm.Context, value interface{})
 }
 
+// printContainerHealth prints whether the Pebble checks in each of a unit's
+// workload containers are up, for example "db:up,redis:down". A container
+// is down when any of its checks is down.
+func printContainerHealth(w *output.Wrapper, checks map[string]map[string]pebbleCheckStatus) {
+	if len(checks) == 0 {
+		w.Print("")
+		return
+	}
+	healthy := true
+	containers := make([]string, 0, len(checks))
+	for _, name := range naturalsort.Sort(stringKeysFromMap(checks)) {
+		health := "up"
+		for _, check := range checks[name] {
+			if check.Status != "up" {
+				health = "down"
+				healthy = false
+			}
+		}
+		containers = append(containers, name+":"+health)
+	}
+	color := output.GoodHighlight
+	if !healthy {
+		color = output.ErrorHighlight
+	}
+	w.PrintColor(color, strings.Join(containers, ","))
+}
+
 func printPorts(w OutputWriter, ps []string) {
 	sorted := append([]string(nil), ps...)
 	sort.Strings(sorted)
@@ -119,6 +119,18 @@ The '--format' option allows you to specify how the status report is formatted.
   --format=yaml
                     Provide information in a JSON or YAML formats for 
                     programmatic use.
+
+Pebble checks
+
+The unit agents of Kubernetes sidecar charms record the status of the Pebble
+checks in their workload containers. When any are recorded, the tabular format
+includes a Health column showing whether each container's checks are up, and
+the json and yaml formats include the status of every check. A unit's workload
+status is set to error while any check named by the pebble-critical-checks
+model configuration key is down, and restored once the check is up again; both
+changes are recorded in the unit's workload status history. Use
+'juju show-status-log --type pebble-check <unit>' to see the transitions of a
+unit's checks.
 `
 
 const usageExamples = `
@@ -4759,6 +4759,65 @@ foo/1  active    running     10.0.0.1  80/TCP
 `[1:])
 }
 
+func (s *StatusSuite) TestFormatTabularCAASModelPebbleChecks(c *gc.C) {
+	status := formattedStatus{
+		Model: modelStatus{
+			Type: "caas",
+		},
+		Applications: map[string]applicationStatus{
+			"foo": {
+				Scale:   2,
+				Address: "54.32.1.2",
+				Version: "user/image:tag",
+				Units: map[string]unitStatus{
+					"foo/0": {
+						JujuStatusInfo: statusInfoContents{
+							Current: status.Allocating,
+						},
+						WorkloadStatusInfo: statusInfoContents{
+							Current: status.Active,
+						},
+					},
+					"foo/1": {
+						Address:     "10.0.0.1",
+						OpenedPorts: []string{"80/TCP"},
+						JujuStatusInfo: statusInfoContents{
+							Current: status.Running,
+						},
+						WorkloadStatusInfo: statusInfoContents{
+							Current: status.Error,
+							Message: `container "redis" check "online" failed`,
+						},
+						PebbleChecks: map[string]map[string]pebbleCheckStatus{
+							"redis": {
+								"online": {Status: "down", Critical: true},
+								"ready":  {Status: "up"},
+							},
+							"nginx": {
+								"ready": {Status: "up"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	out := &bytes.Buffer{}
+	err := FormatTabular(out, false, status)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(out.String(), gc.Equals, `
+Model  Controller  Cloud/Region  Version
+                                 
+
+App  Version         Status  Scale  Charm  Channel  Rev  Address    Exposed  Message
+foo  user/image:tag            1/2                    0  54.32.1.2  no       
+
+Unit   Workload  Agent       Address   Ports   Health               Message
+foo/0  active    allocating                                         
+foo/1  error     running     10.0.0.1  80/TCP  nginx:up,redis:down  container "redis" check "online" failed
+`[1:])
+}
+
 func (s *StatusSuite) TestFormatTabularCAASModelTruncatedVersion(c *gc.C) {
 	status := formattedStatus{
 		Model: modelStatus{
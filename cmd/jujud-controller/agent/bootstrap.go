@@ -48,6 +48,7 @@ import (
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/internal/mongo"
 	pkissh "github.com/juju/juju/internal/pki/ssh"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/storage/provider"
 	"github.com/juju/juju/internal/tools"
 	"github.com/juju/juju/internal/worker/peergrouper"
@@ -273,6 +274,9 @@ func (c *BootstrapCommand) Run(ctx *cmd.Context) error {
 	if err := ensureKeys(isCAAS, &args, &info); err != nil {
 		return errors.Trace(err)
 	}
+	if err := ensureSecretContentKeys(&info); err != nil {
+		return errors.Trace(err)
+	}
 	if err := ensureSSHServerHostKey(&args); err != nil {
 		return errors.Trace(err)
 	}
@@ -454,6 +458,21 @@ func ensureKeys(
 	return nil
 }
 
+// ensureSecretContentKeys generates the key which wraps the keys encrypting
+// secret content stored in model databases. It is held in the state serving
+// info rather than the database, so that database backups don't contain it.
+func ensureSecretContentKeys(info *controller.StateServingInfo) error {
+	if len(info.SecretContentKeys) > 0 {
+		return nil
+	}
+	ring, err := envelope.GenerateKeyRing()
+	if err != nil {
+		return errors.Annotate(err, "generating secret content key")
+	}
+	info.SecretContentKeys = ring.Encode()
+	return nil
+}
+
 func (c *BootstrapCommand) startMongo(ctx context.Context, isCAAS bool, addrs network.ProviderAddresses, agentConfig agent.Config) error {
 	logger.Debugf(context.TODO(), "starting mongo")
 
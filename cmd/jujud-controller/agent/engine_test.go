@@ -47,6 +47,8 @@ var (
 		"provider-tracker",
 		"provider-upgrader",
 		"remote-relations", // tertiary dependency: will be inactive because migration workers will be inactive
+		"secret-content-key",
+		"secret-stage",
 		"secrets-pruner",
 		"state-cleaner",       // tertiary dependency: will be inactive because migration workers will be inactive
 		"storage-provisioner", // tertiary dependency: will be inactive because migration workers will be inactive
@@ -69,6 +71,8 @@ var (
 		"migration-master",
 		"provider-tracker",
 		"remote-relations",
+		"secret-content-key",
+		"secret-stage",
 		"secrets-pruner",
 		"state-cleaner",
 		"storage-provisioner",
@@ -657,6 +657,8 @@ func (a *MachineAgent) makeEngineCreator(
 			NewEnvironFunc:          newEnvirons,
 			NewCAASBrokerFunc:       newCAASBroker,
 		}
+		applyChangeStreamOverrides(agentConfig, &manifoldsCfg)
+
 		manifolds := iaasMachineManifolds(manifoldsCfg)
 		if a.isCaasAgent {
 			manifolds = caasMachineManifolds(manifoldsCfg)
@@ -1033,6 +1035,18 @@ func applyTestingOverrides(agentConfig agent.Config, manifoldsCfg *model.Manifol
 	}
 }
 
+func applyChangeStreamOverrides(agentConfig agent.Config, manifoldsCfg *machine.ManifoldsConfig) {
+	if v := agentConfig.Value(agent.ChangeStreamDurableConsumerMaxLag); v != "" {
+		maxLag, err := strconv.ParseInt(v, 10, 64)
+		if err == nil && maxLag > 0 {
+			manifoldsCfg.ChangeStreamDurableConsumerMaxLag = maxLag
+			logger.Infof(context.TODO(), "change stream durable consumer max lag set to %d", maxLag)
+		} else {
+			logger.Warningf(context.TODO(), "invalid change stream durable consumer max lag %q, using default", v)
+		}
+	}
+}
+
 type modelWorker struct {
 	*dependency.Engine
 	logger    modelworkermanager.ModelLogger
@@ -279,6 +279,11 @@ type ManifoldsConfig struct {
 	// context for the unit.
 	SetupLogging func(corelogger.LoggerContext, coreagent.Config)
 
+	// ChangeStreamDurableConsumerMaxLag is the maximum number of change log
+	// entries that a durable change stream consumer can fall behind, before
+	// it is evicted. If zero, the pruner default is used.
+	ChangeStreamDurableConsumerMaxLag int64
+
 	// DependencyEngineMetrics creates a set of metrics for a model, so it's
 	// possible to know the lifecycle of the workers in the dependency engine.
 	DependencyEngineMetrics modelworkermanager.ModelMetrics
@@ -701,6 +706,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 		})),
 
 		domainServicesName: workerdomainservices.Manifold(workerdomainservices.ManifoldConfig{
+			AgentName:                   agentName,
 			DBAccessorName:              dbAccessorName,
 			ChangeStreamName:            changeStreamName,
 			ProviderFactoryName:         providerTrackerName,
@@ -749,10 +755,11 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 		}),
 
 		changeStreamPrunerName: ifPrimaryController(changestreampruner.Manifold(changestreampruner.ManifoldConfig{
-			DBAccessor: dbAccessorName,
-			Clock:      config.Clock,
-			Logger:     internallogger.GetLogger("juju.worker.changestreampruner"),
-			NewWorker:  changestreampruner.NewWorker,
+			DBAccessor:            dbAccessorName,
+			DurableConsumerMaxLag: config.ChangeStreamDurableConsumerMaxLag,
+			Clock:                 config.Clock,
+			Logger:                internallogger.GetLogger("juju.worker.changestreampruner"),
+			NewWorker:             changestreampruner.NewWorker,
 		})),
 
 		auditConfigUpdaterName: ifDatabaseUpgradeComplete(auditconfigupdater.Manifold(auditconfigupdater.ManifoldConfig{
@@ -834,11 +841,15 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			ObjectStoreServicesName:    objectStoreServicesName,
 			LeaseManagerName:           leaseManagerName,
 			S3ClientName:               objectStoreS3CallerName,
+			APIRemoteCallerName:        apiRemoteCallerName,
+			HTTPClientName:             httpClientName,
 			Clock:                      config.Clock,
 			Logger:                     internallogger.GetLogger("juju.worker.objectstore"),
 			NewObjectStoreWorker:       internalobjectstore.ObjectStoreFactory,
 			GetControllerConfigService: objectstore.GetControllerConfigService,
 			GetMetadataService:         objectstore.GetMetadataService,
+			GetBlobMetadataService:     objectstore.GetBlobMetadataService,
+			NewBlobCollector:           internalobjectstore.NewBlobCollector,
 			IsBootstrapController:      internalbootstrap.IsBootstrapController,
 		})),
 
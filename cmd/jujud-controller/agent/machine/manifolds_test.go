@@ -571,7 +571,9 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"api-server": {
 		"agent",
+		"api-remote-caller",
 		"audit-config-updater",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -602,6 +604,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"audit-config-updater": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -626,6 +630,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"bootstrap": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -666,6 +672,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"certificate-updater": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"certificate-watcher",
 		"change-stream",
 		"clock",
@@ -726,6 +734,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"control-socket": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -821,6 +831,7 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"http-server": {
 		"agent",
+		"api-remote-caller",
 		"api-server",
 		"audit-config-updater",
 		"central-hub",
@@ -855,6 +866,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"http-server-args": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -986,6 +999,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"log-sink": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1070,6 +1085,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"model-worker-manager": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"certificate-watcher",
 		"change-stream",
 		"clock",
@@ -1101,6 +1118,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"peer-grouper": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1161,6 +1180,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"object-store": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1239,6 +1260,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"domain-services": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1289,6 +1312,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"state": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1390,6 +1415,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 
 	"upgrade-database-runner": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1420,6 +1447,8 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"agent",
 		"api-caller",
 		"api-config-watcher",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1490,7 +1519,9 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"api-server": {
 		"agent",
+		"api-remote-caller",
 		"audit-config-updater",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1521,6 +1552,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"audit-config-updater": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1545,6 +1578,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"bootstrap": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1603,6 +1638,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"control-socket": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1668,6 +1705,7 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"http-server": {
 		"agent",
+		"api-remote-caller",
 		"api-server",
 		"audit-config-updater",
 		"central-hub",
@@ -1702,6 +1740,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"http-server-args": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1794,6 +1834,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"log-sink": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1854,6 +1896,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"model-worker-manager": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"certificate-watcher",
 		"change-stream",
 		"clock",
@@ -1885,6 +1929,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"peer-grouper": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -1945,6 +1991,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"object-store": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -2011,6 +2059,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"domain-services": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -2049,6 +2099,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"state": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -2107,6 +2159,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 
 	"upgrade-database-runner": {
 		"agent",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
@@ -2137,6 +2191,8 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"agent",
 		"api-caller",
 		"api-config-watcher",
+		"api-remote-caller",
+		"central-hub",
 		"change-stream",
 		"clock",
 		"controller-agent-config",
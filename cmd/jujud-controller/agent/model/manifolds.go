@@ -50,8 +50,10 @@ import (
 	"github.com/juju/juju/internal/worker/modelworkermanager"
 	"github.com/juju/juju/internal/worker/providertracker"
 	"github.com/juju/juju/internal/worker/remoterelations"
+	"github.com/juju/juju/internal/worker/secretcontentkey"
 	"github.com/juju/juju/internal/worker/secretsdrainworker"
 	"github.com/juju/juju/internal/worker/secretspruner"
+	"github.com/juju/juju/internal/worker/secretstage"
 	"github.com/juju/juju/internal/worker/singular"
 	"github.com/juju/juju/internal/worker/storageprovisioner"
 	"github.com/juju/juju/internal/worker/undertaker"
@@ -333,6 +335,20 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Clock:                  config.Clock,
 		})),
 
+		secretContentKeyName: ifNotMigrating(secretcontentkey.Manifold(secretcontentkey.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			KeyRotationPeriod:  secretcontentkey.DefaultKeyRotationPeriod,
+			NewWorker:          secretcontentkey.NewWorker,
+			Clock:              config.Clock,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretcontentkey"),
+		})),
+		secretStageName: ifNotMigrating(secretstage.Manifold(secretstage.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			CheckInterval:      secretstage.DefaultCheckInterval,
+			NewWorker:          secretstage.NewWorker,
+			Clock:              config.Clock,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretstage"),
+		})),
 		secretsPrunerName: ifNotMigrating(secretspruner.Manifold(secretspruner.ManifoldConfig{
 			APICallerName:        apiCallerName,
 			Logger:               config.LoggingContext.GetLogger("juju.worker.secretspruner"),
@@ -648,6 +664,8 @@ const (
 	caasApplicationProvisionerName = "caas-application-provisioner"
 	caasStorageProvisionerName     = "caas-storage-provisioner"
 
+	secretContentKeyName   = "secret-content-key"
+	secretStageName        = "secret-stage"
 	secretsPrunerName      = "secrets-pruner"
 	userSecretsDrainWorker = "user-secrets-drain-worker"
 
@@ -59,6 +59,8 @@ func (s *ManifoldsSuite) TestIAASNames(c *gc.C) {
 		"provider-service-factories",
 		"provider-tracker",
 		"remote-relations",
+		"secret-content-key",
+		"secret-stage",
 		"secrets-pruner",
 		"state-cleaner",
 		"storage-provisioner",
@@ -104,6 +106,8 @@ func (s *ManifoldsSuite) TestCAASNames(c *gc.C) {
 		"provider-service-factories",
 		"provider-tracker",
 		"remote-relations",
+		"secret-content-key",
+		"secret-stage",
 		"secrets-pruner",
 		"state-cleaner",
 		"undertaker",
@@ -201,6 +205,26 @@ func (s *ManifoldsSuite) TestCAASManifold(c *gc.C) {
 
 var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
+	"secret-content-key": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-stage": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"agent",
 		"api-caller",
@@ -381,6 +405,26 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
 var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 
+	"secret-content-key": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
+	"secret-stage": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"agent",
 		"api-caller",
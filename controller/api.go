@@ -14,4 +14,8 @@ type StateServingInfo struct {
 	// this will be passed as the KeyFile argument to MongoDB
 	SharedSecret   string
 	SystemIdentity string
+	// SecretContentKeys holds the base64 encoded keys which wrap the keys
+	// encrypting secret content stored in model databases. The first key
+	// is the active key; the others have been rotated out.
+	SecretContentKeys []string
 }
@@ -302,6 +302,18 @@ const (
 	// object stores.
 	ObjectStoreS3StaticSession = "object-store-s3-static-session"
 
+	// ObjectStoreModelQuota is the maximum size of the objects that a single
+	// model can store in the object store, eg "10G". A value of 0 disables
+	// the quota.
+	ObjectStoreModelQuota = "object-store-model-quota"
+
+	// ObjectStoreControllerQuota is the maximum size of the objects that all
+	// the models can store in the object store on the controller, eg "100G".
+	// Objects with the same contents are only counted once. This only
+	// applies to the file backend, as the s3 backend doesn't store the
+	// objects on the controllers. A value of 0 disables the quota.
+	ObjectStoreControllerQuota = "object-store-controller-quota"
+
 	// SystemSSHKeys returns the set of ssh keys that should be trusted by
 	// agents of this controller regardless of the model.
 	SystemSSHKeys = "system-ssh-keys"
@@ -480,6 +492,15 @@ const (
 	// DefaultObjectStoreType is the default type of object store to use for
 	// storing blobs.
 	DefaultObjectStoreType = objectstore.FileBackend
+
+	// DefaultObjectStoreModelQuota is the default quota for the objects a
+	// single model can store in the object store. The default is unlimited.
+	DefaultObjectStoreModelQuota = "0"
+
+	// DefaultObjectStoreControllerQuota is the default quota for the objects
+	// all the models can store in the object store. The default is
+	// unlimited.
+	DefaultObjectStoreControllerQuota = "0"
 )
 
 var (
@@ -543,6 +564,8 @@ var (
 		ObjectStoreS3StaticKey,
 		ObjectStoreS3StaticSecret,
 		ObjectStoreS3StaticSession,
+		ObjectStoreModelQuota,
+		ObjectStoreControllerQuota,
 		SystemSSHKeys,
 		JujudControllerSnapSource,
 		SSHMaxConcurrentConnections,
@@ -606,6 +629,8 @@ var (
 		ObjectStoreS3StaticKey,
 		ObjectStoreS3StaticSecret,
 		ObjectStoreS3StaticSession,
+		ObjectStoreModelQuota,
+		ObjectStoreControllerQuota,
 		SSHMaxConcurrentConnections,
 		SSHServerPort,
 	)
@@ -1155,6 +1180,20 @@ func (c Config) ObjectStoreS3StaticSession() string {
 	return c.asString(ObjectStoreS3StaticSession)
 }
 
+// ObjectStoreModelQuotaMB returns the maximum size in MiB of the objects a
+// single model can store in the object store. A value of 0 means there is no
+// quota.
+func (c Config) ObjectStoreModelQuotaMB() int {
+	return c.sizeMBOrDefault(ObjectStoreModelQuota, 0)
+}
+
+// ObjectStoreControllerQuotaMB returns the maximum size in MiB of the objects
+// all the models can store in the object store. A value of 0 means there is
+// no quota.
+func (c Config) ObjectStoreControllerQuotaMB() int {
+	return c.sizeMBOrDefault(ObjectStoreControllerQuota, 0)
+}
+
 // SSHServerPort returns the port the SSH server listens on.
 func (c Config) SSHServerPort() int {
 	return c.intOrDefault(SSHServerPort, DefaultSSHServerPort)
@@ -1293,6 +1332,14 @@ func Validate(c Config) error {
 		}
 	}
 
+	for _, name := range []string{ObjectStoreModelQuota, ObjectStoreControllerQuota} {
+		if v, ok := c[name].(string); ok {
+			if _, err := utils.ParseSize(v); err != nil {
+				return errors.Annotatef(err, "invalid %s in configuration", name)
+			}
+		}
+	}
+
 	if v, ok := c[PruneTxnSleepTime].(string); ok {
 		if _, err := time.ParseDuration(v); err != nil {
 			return errors.Annotatef(err, `%s must be a valid duration (eg "10ms")`, PruneTxnSleepTime)
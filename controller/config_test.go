@@ -421,6 +421,18 @@ var newConfigTests = []struct {
 		controller.ObjectStoreS3StaticSession: 1,
 	},
 	expectError: `object-store-s3-static-session: expected string, got int\(1\)`,
+}, {
+	about: "invalid object store model quota value",
+	config: controller.Config{
+		controller.ObjectStoreModelQuota: "ten",
+	},
+	expectError: `invalid object-store-model-quota in configuration: .*`,
+}, {
+	about: "invalid object store controller quota value",
+	config: controller.Config{
+		controller.ObjectStoreControllerQuota: "ten",
+	},
+	expectError: `invalid object-store-controller-quota in configuration: .*`,
 }, {
 	about: "invalid jujud-controller-snap-source value",
 	config: controller.Config{
@@ -1099,3 +1111,24 @@ func (s *ConfigSuite) TestObjectStoreS3Credentials(c *gc.C) {
 	c.Assert(cfg.ObjectStoreS3StaticSecret(), gc.Equals, "secret")
 	c.Assert(cfg.ObjectStoreS3StaticSession(), gc.Equals, "session")
 }
+
+func (s *ConfigSuite) TestObjectStoreQuotaDefault(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.ObjectStoreModelQuotaMB(), gc.Equals, 0)
+	c.Assert(cfg.ObjectStoreControllerQuotaMB(), gc.Equals, 0)
+}
+
+func (s *ConfigSuite) TestObjectStoreQuota(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.ObjectStoreModelQuota:      "10G",
+			controller.ObjectStoreControllerQuota: "512M",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.ObjectStoreModelQuotaMB(), gc.Equals, 10240)
+	c.Assert(cfg.ObjectStoreControllerQuotaMB(), gc.Equals, 512)
+}
@@ -67,6 +67,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	ObjectStoreS3StaticKey:             schema.String(),
 	ObjectStoreS3StaticSecret:          schema.String(),
 	ObjectStoreS3StaticSession:         schema.String(),
+	ObjectStoreModelQuota:              schema.String(),
+	ObjectStoreControllerQuota:         schema.String(),
 	SystemSSHKeys:                      schema.String(),
 	JujudControllerSnapSource:          schema.String(),
 	SSHServerPort:                      schema.ForceInt(),
@@ -127,6 +129,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	ObjectStoreS3StaticKey:             schema.Omit,
 	ObjectStoreS3StaticSecret:          schema.Omit,
 	ObjectStoreS3StaticSession:         schema.Omit,
+	ObjectStoreModelQuota:              DefaultObjectStoreModelQuota,
+	ObjectStoreControllerQuota:         DefaultObjectStoreControllerQuota,
 	SystemSSHKeys:                      schema.Omit,
 	JujudControllerSnapSource:          DefaultJujudControllerSnapSource,
 	SSHServerPort:                      DefaultSSHServerPort,
@@ -368,6 +372,14 @@ will be output if tracing is enabled.`,
 		Type:        configschema.Tstring,
 		Description: `The s3 static session for the object store backend`,
 	},
+	ObjectStoreModelQuota: {
+		Type:        configschema.Tstring,
+		Description: `The maximum size of the objects a single model can store in the object store, eg "10G". A value of 0 disables the quota`,
+	},
+	ObjectStoreControllerQuota: {
+		Type:        configschema.Tstring,
+		Description: `The maximum size of the objects all the models can store in the object store on the controllers, eg "100G". Only applies to the file backend. A value of 0 disables the quota`,
+	},
 	SystemSSHKeys: {
 		Type:        configschema.Tstring,
 		Description: `Defines the system ssh keys`,
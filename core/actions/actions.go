@@ -13,6 +13,10 @@ import (
 // JujuExecActionName defines the action name used by juju-exec.
 const JujuExecActionName = "juju-exec"
 
+// JujuReplayHookActionName defines the action name used to replay a hook
+// from a snapshot recorded by the uniter.
+const JujuReplayHookActionName = "juju-replay-hook"
+
 // legacyJujuRunActionName will be removed in Juju 4.
 const legacyJujuRunActionName = "juju-run"
 
@@ -23,6 +27,11 @@ func IsJujuExecAction(name string) bool {
 	return name == JujuExecActionName || name == legacyJujuRunActionName
 }
 
+// IsJujuReplayHookAction returns true if name is the "juju-replay-hook" action.
+func IsJujuReplayHookAction(name string) bool {
+	return name == JujuReplayHookActionName
+}
+
 // HasJujuExecAction returns true if the "juju-exec" binary name appears
 // anywhere in the specified commands.
 func HasJujuExecAction(commands string) bool {
@@ -55,4 +64,18 @@ var PredefinedActionsSpec = map[string]charm.ActionSpec{
 			},
 		},
 	},
+	JujuReplayHookActionName: {
+		Description: "predefined juju-replay-hook action",
+		Params: map[string]interface{}{
+			"type":        "object",
+			"title":       JujuReplayHookActionName,
+			"description": "predefined juju-replay-hook action params",
+			"properties": map[string]interface{}{
+				"snapshot": map[string]interface{}{
+					"type":        "string",
+					"description": "id of the hook snapshot to replay, all snapshots are listed if empty",
+				},
+			},
+		},
+	},
 }
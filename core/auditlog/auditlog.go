@@ -92,20 +92,34 @@ type Error struct {
 	Code    string `json:"code"`
 }
 
+// SecretAccess represents a read of secret content. Unlike requests,
+// these are recorded for agents as well as users, and don't belong
+// to a conversation.
+type SecretAccess struct {
+	Who       string `json:"who"` // tag of the reader, e.g. "unit-mysql-0"
+	When      string `json:"when"`
+	ModelUUID string `json:"model-uuid"`
+	SecretURI string `json:"secret-uri"`
+	Revision  int    `json:"revision"`
+}
+
 // Record is the top-level entry type in an audit log, which serves as
-// a type discriminator. Only one of Conversation/Request/Errors should be set.
+// a type discriminator. Only one of Conversation/Request/Errors/SecretAccess
+// should be set.
 type Record struct {
 	Conversation *Conversation   `json:"conversation,omitempty"`
 	Request      *Request        `json:"request,omitempty"`
 	Errors       *ResponseErrors `json:"errors,omitempty"`
+	SecretAccess *SecretAccess   `json:"secret-access,omitempty"`
 }
 
-// AuditLog represents something that can store calls, requests and
-// responses somewhere.
+// AuditLog represents something that can store calls, requests,
+// responses and secret reads somewhere.
 type AuditLog interface {
 	AddConversation(c Conversation) error
 	AddRequest(r Request) error
 	AddResponse(r ResponseErrors) error
+	AddSecretAccess(a SecretAccess) error
 	Close() error
 }
 
@@ -226,6 +240,11 @@ func (a *auditLogFile) AddResponse(m ResponseErrors) error {
 	return errors.Trace(a.addRecord(Record{Errors: &m}))
 }
 
+// AddSecretAccess implements AuditLog.
+func (a *auditLogFile) AddSecretAccess(m SecretAccess) error {
+	return errors.Trace(a.addRecord(Record{SecretAccess: &m}))
+}
+
 // Close implements AuditLog.
 func (a *auditLogFile) Close() error {
 	return errors.Trace(a.fileLogger.Close())
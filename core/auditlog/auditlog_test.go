@@ -56,6 +56,14 @@ func (s *AuditLogSuite) TestAuditLogFile(c *gc.C) {
 		},
 	})
 	c.Assert(err, jc.ErrorIsNil)
+	err = logFile.AddSecretAccess(auditlog.SecretAccess{
+		Who:       "unit-mysql-0",
+		When:      "2017-12-12T11:36:02Z",
+		ModelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		SecretURI: "secret:9m4e2mr0ui3e8a215n4g",
+		Revision:  2,
+	})
+	c.Assert(err, jc.ErrorIsNil)
 	err = logFile.Close()
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -160,6 +168,11 @@ func (l *fakeLog) AddResponse(m auditlog.ResponseErrors) error {
 	return l.stub.NextErr()
 }
 
+func (l *fakeLog) AddSecretAccess(m auditlog.SecretAccess) error {
+	l.stub.AddCall("AddSecretAccess", m)
+	return l.stub.NextErr()
+}
+
 func (l *fakeLog) Close() error {
 	l.stub.AddCall("Close")
 	return l.stub.NextErr()
@@ -170,5 +183,6 @@ var (
 {"conversation":{"who":"deerhoof","what":"gojira","when":"2017-11-27T13:21:24Z","model-name":"admin/default","model-uuid":"","conversation-id":"0123456789abcdef","connection-id":"AC1"}}
 {"request":{"conversation-id":"0123456789abcdef","connection-id":"AC1","request-id":25,"when":"2017-12-12T11:34:56Z","facade":"Application","method":"Deploy","version":4,"args":"{\"applications\": [{\"application\": \"prometheus\"}]}"}}
 {"errors":{"conversation-id":"0123456789abcdef","connection-id":"AC1","request-id":25,"when":"2017-12-12T11:35:11Z","errors":[{"message":"oops","code":"unauthorized access"}]}}
+{"secret-access":{"who":"unit-mysql-0","when":"2017-12-12T11:36:02Z","model-uuid":"deadbeef-0bad-400d-8000-4b1d0d06f00d","secret-uri":"secret:9m4e2mr0ui3e8a215n4g","revision":2}}
 `[1:]
 )
@@ -17,10 +17,12 @@ import (
 )
 
 const (
-	contentDir   = "juju-backup"
-	filesBundle  = "root.tar"
-	dbDumpDir    = "dump"
-	metadataFile = "metadata.json"
+	contentDir    = "juju-backup"
+	filesBundle   = "root.tar"
+	dbDumpDir     = "dump"
+	objectsDir    = "objects"
+	metadataFile  = "metadata.json"
+	checksumsFile = "checksums.sha256"
 )
 
 // ArchivePaths holds the paths to the files and directories in a
@@ -42,8 +44,17 @@ type ArchivePaths struct {
 	// database.
 	DBDumpDir string
 
+	// ObjectsDir is the path to the directory within the archive
+	// contents that contains the objects from the object store, for
+	// each of the database namespaces.
+	ObjectsDir string
+
 	// MetadataFile is the path to the metadata file.
 	MetadataFile string
+
+	// ChecksumsFile is the path to the file containing the SHA256
+	// checksums of all the other files in the archive.
+	ChecksumsFile string
 }
 
 // NewCanonicalArchivePaths composes a new ArchivePaths with default
@@ -52,10 +63,12 @@ type ArchivePaths struct {
 // resolving the paths in a backup archive file (which is a tar file).
 func NewCanonicalArchivePaths() ArchivePaths {
 	return ArchivePaths{
-		ContentDir:   contentDir,
-		FilesBundle:  path.Join(contentDir, filesBundle),
-		DBDumpDir:    path.Join(contentDir, dbDumpDir),
-		MetadataFile: path.Join(contentDir, metadataFile),
+		ContentDir:    contentDir,
+		FilesBundle:   path.Join(contentDir, filesBundle),
+		DBDumpDir:     path.Join(contentDir, dbDumpDir),
+		ObjectsDir:    path.Join(contentDir, objectsDir),
+		MetadataFile:  path.Join(contentDir, metadataFile),
+		ChecksumsFile: path.Join(contentDir, checksumsFile),
 	}
 }
 
@@ -66,10 +79,12 @@ func NewCanonicalArchivePaths() ArchivePaths {
 // been unpacked.
 func NewNonCanonicalArchivePaths(rootDir string) ArchivePaths {
 	return ArchivePaths{
-		ContentDir:   filepath.Join(rootDir, contentDir),
-		FilesBundle:  filepath.Join(rootDir, contentDir, filesBundle),
-		DBDumpDir:    filepath.Join(rootDir, contentDir, dbDumpDir),
-		MetadataFile: filepath.Join(rootDir, contentDir, metadataFile),
+		ContentDir:    filepath.Join(rootDir, contentDir),
+		FilesBundle:   filepath.Join(rootDir, contentDir, filesBundle),
+		DBDumpDir:     filepath.Join(rootDir, contentDir, dbDumpDir),
+		ObjectsDir:    filepath.Join(rootDir, contentDir, objectsDir),
+		MetadataFile:  filepath.Join(rootDir, contentDir, metadataFile),
+		ChecksumsFile: filepath.Join(rootDir, contentDir, checksumsFile),
 	}
 }
 
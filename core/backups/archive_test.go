@@ -23,7 +23,9 @@ func (s *archiveSuite) TestNewCanonoicalArchivePaths(c *gc.C) {
 	c.Check(ap.ContentDir, gc.Equals, "juju-backup")
 	c.Check(ap.FilesBundle, gc.Equals, "juju-backup/root.tar")
 	c.Check(ap.DBDumpDir, gc.Equals, "juju-backup/dump")
+	c.Check(ap.ObjectsDir, gc.Equals, "juju-backup/objects")
 	c.Check(ap.MetadataFile, gc.Equals, "juju-backup/metadata.json")
+	c.Check(ap.ChecksumsFile, gc.Equals, "juju-backup/checksums.sha256")
 }
 
 func (s *archiveSuite) TestNewNonCanonicalArchivePaths(c *gc.C) {
@@ -32,5 +34,7 @@ func (s *archiveSuite) TestNewNonCanonicalArchivePaths(c *gc.C) {
 	c.Check(ap.ContentDir, jc.SamePath, "/tmp/juju-backup")
 	c.Check(ap.FilesBundle, jc.SamePath, "/tmp/juju-backup/root.tar")
 	c.Check(ap.DBDumpDir, jc.SamePath, "/tmp/juju-backup/dump")
+	c.Check(ap.ObjectsDir, jc.SamePath, "/tmp/juju-backup/objects")
 	c.Check(ap.MetadataFile, jc.SamePath, "/tmp/juju-backup/metadata.json")
+	c.Check(ap.ChecksumsFile, jc.SamePath, "/tmp/juju-backup/checksums.sha256")
 }
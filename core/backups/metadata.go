@@ -11,6 +11,8 @@ import (
 	"io"
 	"math"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/juju/errors"
@@ -26,6 +28,22 @@ const (
 	FilenameTemplate = FilenamePrefix + "20060102-150405.tar.gz"
 )
 
+// BackupDir returns the directory that backup archives are written to on
+// the controller. If no directory is configured, the temporary directory is
+// used.
+func BackupDir(configured string) string {
+	if configured != "" {
+		return configured
+	}
+	return os.TempDir()
+}
+
+// ValidFilename returns true if the filename is the name of a backup archive,
+// rather than a path to one.
+func ValidFilename(filename string) bool {
+	return strings.HasPrefix(filename, FilenamePrefix) && filepath.Base(filename) == filename
+}
+
 // Paths holds the paths that backups needs.
 type Paths struct {
 	BackupDir string
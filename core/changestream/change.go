@@ -49,6 +49,29 @@ type ChangeEvent interface {
 	// the primary key of the row that was changed or the field of the change
 	// that was changed.
 	Changed() string
+	// ChangedColumns returns the columns that were changed for an update.
+	// If the columns are not known (creates, deletes or triggers that don't
+	// record the columns) then nil is returned, which should be treated as
+	// all the columns have changed.
+	ChangedColumns() []string
+}
+
+// ColumnsChanged returns true if the change event could have changed any of
+// the given columns. Events that don't record the columns that changed are
+// always considered to have changed.
+func ColumnsChanged(event ChangeEvent, columns ...string) bool {
+	changed := event.ChangedColumns()
+	if changed == nil {
+		return true
+	}
+	for _, column := range changed {
+		for _, c := range columns {
+			if column == c {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // Term represents a set of changes that are bounded by a coalesced set.
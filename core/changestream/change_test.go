@@ -0,0 +1,64 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type changeSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&changeSuite{})
+
+func (s *changeSuite) TestColumnsChangedUnknownColumns(c *gc.C) {
+	event := changeEvent{changeType: create}
+	c.Check(ColumnsChanged(event, "life_id"), jc.IsTrue)
+}
+
+func (s *changeSuite) TestColumnsChangedMatchingColumn(c *gc.C) {
+	event := changeEvent{changeType: update, columns: []string{"charm_uuid", "life_id"}}
+	c.Check(ColumnsChanged(event, "life_id"), jc.IsTrue)
+	c.Check(ColumnsChanged(event, "exposed", "charm_uuid"), jc.IsTrue)
+}
+
+func (s *changeSuite) TestColumnsChangedNoMatchingColumn(c *gc.C) {
+	event := changeEvent{changeType: update, columns: []string{"updated_at"}}
+	c.Check(ColumnsChanged(event, "life_id", "charm_uuid"), jc.IsFalse)
+}
+
+func (s *changeSuite) TestFilteredColumnsNamespace(c *gc.C) {
+	opt := FilteredColumnsNamespace("application", All, "life_id")
+	c.Check(opt.Namespace(), gc.Equals, "application")
+	c.Check(opt.ChangeMask(), gc.Equals, All)
+
+	filter := opt.Filter()
+	c.Check(filter(changeEvent{changeType: delete}), jc.IsTrue)
+	c.Check(filter(changeEvent{changeType: update, columns: []string{"life_id"}}), jc.IsTrue)
+	c.Check(filter(changeEvent{changeType: update, columns: []string{"exposed"}}), jc.IsFalse)
+}
+
+type changeEvent struct {
+	changeType ChangeType
+	columns    []string
+}
+
+func (e changeEvent) Type() ChangeType {
+	return e.changeType
+}
+
+func (e changeEvent) Namespace() string {
+	return "application"
+}
+
+func (e changeEvent) Changed() string {
+	return "foo"
+}
+
+func (e changeEvent) ChangedColumns() []string {
+	return e.columns
+}
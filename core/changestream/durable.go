@@ -0,0 +1,52 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changestream
+
+import "context"
+
+// DurableChangeEvent represents a change read from the change log by a
+// durable subscription.
+type DurableChangeEvent interface {
+	ChangeEvent
+
+	// ID returns the change log ID of the change. This can be checkpointed
+	// to resume the subscription from after a restart.
+	ID() int64
+}
+
+// DurableSubscription describes the ability to receive changes from the
+// change log, resuming from the last checkpointed change log ID. Unlike a
+// Subscription, changes are not coalesced and changes that happened whilst
+// the subscriber was not running are not lost.
+type DurableSubscription interface {
+	// Changes returns the channel that the subscription will receive
+	// changes on, in the order they were written to the change log.
+	Changes() <-chan []DurableChangeEvent
+
+	// Checkpoint records the change log ID of the last change that the
+	// subscriber has processed. After a restart, the subscription resumes
+	// from the change after the checkpoint.
+	Checkpoint(ctx context.Context, id int64) error
+
+	// Unsubscribe stops the subscription. The checkpoint is retained, so
+	// that subscribing again with the same consumer ID resumes from it.
+	Unsubscribe()
+
+	// Done provides a way to know from the consumer side if the underlying
+	// subscription has been terminated.
+	Done() <-chan struct{}
+
+	// Err returns the error that terminated the subscription, once Done has
+	// been closed. If the consumer fell too far behind and was evicted by the
+	// pruner, the subscriber must perform a full resync.
+	Err() error
+}
+
+// DurableEventSource describes the ability to subscribe to changes from a
+// change stream, resuming from the last checkpoint of the given consumer.
+type DurableEventSource interface {
+	// SubscribeDurable returns a durable subscription for the consumer,
+	// according to the input subscription options.
+	SubscribeDurable(consumerID string, opts ...SubscriptionOption) (DurableSubscription, error)
+}
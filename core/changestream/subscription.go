@@ -64,3 +64,12 @@ func FilteredNamespace(namespace string, changeMask ChangeType, filter func(Chan
 	opt.filter = filter
 	return opt
 }
+
+// FilteredColumnsNamespace returns a SubscriptionOption that will subscribe
+// to the given namespace, only accepting events that could have changed one
+// of the given columns.
+func FilteredColumnsNamespace(namespace string, changeMask ChangeType, columns ...string) SubscriptionOption {
+	return FilteredNamespace(namespace, changeMask, func(ce ChangeEvent) bool {
+		return ColumnsChanged(ce, columns...)
+	})
+}
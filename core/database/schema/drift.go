@@ -0,0 +1,219 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/database"
+)
+
+// DriftKind describes how an object in a database differs from the schema.
+type DriftKind string
+
+const (
+	// DriftMissing is an object in the schema that is missing from the
+	// database.
+	DriftMissing DriftKind = "missing"
+	// DriftModified is an object in the database that is defined
+	// differently to the schema.
+	DriftModified DriftKind = "modified"
+	// DriftUnexpected is an object in the database that isn't in the
+	// schema.
+	DriftUnexpected DriftKind = "unexpected"
+)
+
+// Drift describes an object in a database that differs from the schema.
+type Drift struct {
+	// Kind is how the object differs from the schema.
+	Kind DriftKind
+	// Object is the object as defined in the schema, or as found in the
+	// database if it is unexpected.
+	Object Object
+}
+
+// Repairable returns true if the drift can be repaired by recreating the
+// object from the schema. Tables hold data, so they are never recreated,
+// and unexpected objects are left for an operator to deal with.
+func (d Drift) Repairable() bool {
+	return d.Kind != DriftUnexpected && d.Object.Type != ObjectTable
+}
+
+// String returns a description of the drift.
+func (d Drift) String() string {
+	return fmt.Sprintf("%s %s %q", d.Kind, d.Object.Type, d.Object.Name)
+}
+
+// DriftReport describes how the schema of a database has drifted from the
+// schema.
+type DriftReport struct {
+	// Version is the number of patches applied to the database.
+	Version int
+	// Expected is the number of patches in the schema.
+	Expected int
+	// Drift holds the objects in the database that differ from the schema,
+	// as of the applied version.
+	Drift []Drift
+}
+
+// OK returns true if the database has all the patches in the schema
+// applied, and no objects have drifted.
+func (r DriftReport) OK() bool {
+	return r.Version == r.Expected && len(r.Drift) == 0
+}
+
+// CheckDrift compares the tables, indexes, triggers and views in the database
+// with those defined by the applied patches of the schema. The definition of
+// tables isn't compared, as it can be changed by altering the table.
+func (s *Schema) CheckDrift(ctx context.Context, runner database.TxnRunner) (DriftReport, error) {
+	var report DriftReport
+	err := runner.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var err error
+		report, err = s.checkDrift(ctx, tx)
+		return errors.Trace(err)
+	})
+	return report, errors.Trace(err)
+}
+
+// RepairDrift recreates any indexes, triggers and views in the database that
+// are missing or defined differently to the schema. The repaired drift is
+// returned. All repairs are applied in a single transaction.
+func (s *Schema) RepairDrift(ctx context.Context, runner database.TxnRunner) ([]Drift, error) {
+	var repaired []Drift
+	err := runner.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		repaired = nil
+
+		report, err := s.checkDrift(ctx, tx)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, drift := range report.Drift {
+			if !drift.Repairable() {
+				continue
+			}
+			if drift.Kind == DriftModified {
+				stmt := fmt.Sprintf("DROP %s %s", strings.ToUpper(string(drift.Object.Type)), quoteIdentifier(drift.Object.Name))
+				if _, err := tx.ExecContext(ctx, stmt); err != nil {
+					return errors.Annotatef(err, "dropping %s %q", drift.Object.Type, drift.Object.Name)
+				}
+			}
+			if _, err := tx.ExecContext(ctx, drift.Object.SQL); err != nil {
+				return errors.Annotatef(err, "creating %s %q", drift.Object.Type, drift.Object.Name)
+			}
+			repaired = append(repaired, drift)
+		}
+		return nil
+	})
+	return repaired, errors.Trace(err)
+}
+
+func (s *Schema) checkDrift(ctx context.Context, tx *sql.Tx) (DriftReport, error) {
+	version, err := appliedVersion(ctx, tx, computeHashes(s.patches))
+	if err != nil {
+		return DriftReport{}, errors.Trace(err)
+	}
+	if version > len(s.patches) {
+		return DriftReport{}, errors.Errorf(
+			"schema version '%d' is more recent than expected '%d'",
+			version, len(s.patches),
+		)
+	}
+
+	actual, err := selectObjects(ctx, tx)
+	if err != nil {
+		return DriftReport{}, errors.Annotate(err, "reading schema objects")
+	}
+
+	// Only the objects from the applied patches are expected, any missing
+	// patches will be applied by the next upgrade.
+	var objects []Object
+	if version > 0 {
+		objects = objectsForPatches(s.patches[:version])
+	}
+
+	var drift []Drift
+	expected := make(map[objectKey]bool)
+	for _, obj := range objects {
+		key := objectKey{objectType: obj.Type, name: obj.Name}
+		expected[key] = true
+
+		found, ok := actual[key]
+		switch {
+		case !ok:
+			drift = append(drift, Drift{Kind: DriftMissing, Object: obj})
+		case obj.Type != ObjectTable && normalizeSQL(found.SQL) != normalizeSQL(obj.SQL):
+			drift = append(drift, Drift{Kind: DriftModified, Object: obj})
+		}
+	}
+
+	var unexpected []Drift
+	for key, obj := range actual {
+		if !expected[key] {
+			unexpected = append(unexpected, Drift{Kind: DriftUnexpected, Object: obj})
+		}
+	}
+	sort.Slice(unexpected, func(i, j int) bool {
+		a, b := unexpected[i].Object, unexpected[j].Object
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Name < b.Name
+	})
+
+	return DriftReport{
+		Version:  version,
+		Expected: len(s.patches),
+		Drift:    append(drift, unexpected...),
+	}, nil
+}
+
+// appliedVersion returns the highest patch version applied to the database,
+// without creating the schema table if it doesn't exist.
+func appliedVersion(ctx context.Context, tx *sql.Tx, hashes []string) (int, error) {
+	var count int
+	row := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema'`)
+	if err := row.Scan(&count); err != nil {
+		return -1, errors.Trace(err)
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	version, err := queryCurrentVersion(ctx, tx, hashes)
+	return version, errors.Annotate(err, "failed to query current schema version")
+}
+
+// selectObjects returns the tables, indexes, triggers and views in the
+// database, excluding those that are created internally by SQLite.
+func selectObjects(ctx context.Context, tx *sql.Tx) (map[objectKey]Object, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT type, name, sql
+FROM   sqlite_master
+WHERE  type IN ('table', 'index', 'trigger', 'view')
+AND    name NOT LIKE 'sqlite_%'
+AND    sql IS NOT NULL`)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer rows.Close()
+
+	objects := make(map[objectKey]Object)
+	for rows.Next() {
+		var obj Object
+		if err := rows.Scan(&obj.Type, &obj.Name, &obj.SQL); err != nil {
+			return nil, errors.Trace(err)
+		}
+		objects[objectKey{objectType: obj.Type, name: obj.Name}] = obj
+	}
+	return objects, errors.Trace(rows.Err())
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schema
+
+import (
+	"context"
+	"database/sql"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	databasetesting "github.com/juju/juju/internal/database/testing"
+)
+
+type driftSuite struct {
+	databasetesting.DqliteSuite
+}
+
+var _ = gc.Suite(&driftSuite{})
+
+func (s *driftSuite) newSchema() *Schema {
+	return New(
+		MakePatch(`
+CREATE TABLE foo (id INT PRIMARY KEY, name TEXT);
+CREATE INDEX idx_foo_name ON foo (name);
+CREATE TABLE bar (id INT PRIMARY KEY);
+CREATE TRIGGER trg_foo_insert AFTER INSERT ON foo FOR EACH ROW
+BEGIN
+    INSERT INTO bar VALUES (NEW.id);
+END;
+`),
+		MakePatch(`CREATE VIEW v_foo AS SELECT id, name FROM foo;`),
+	)
+}
+
+func (s *driftSuite) TestCheckDriftNone(c *gc.C) {
+	schema := s.newSchema()
+	_, err := schema.Ensure(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	report, err := schema.CheckDrift(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.OK(), jc.IsTrue)
+	c.Check(report.Version, gc.Equals, 2)
+	c.Check(report.Expected, gc.Equals, 2)
+}
+
+func (s *driftSuite) TestCheckDriftBehind(c *gc.C) {
+	schema := s.newSchema()
+	_, err := schema.Ensure(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	schema.Add(MakePatch(`CREATE TABLE baz (id INT PRIMARY KEY);`))
+
+	// The objects from the patch that hasn't been applied aren't reported
+	// as missing.
+	report, err := schema.CheckDrift(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.OK(), jc.IsFalse)
+	c.Check(report.Version, gc.Equals, 2)
+	c.Check(report.Expected, gc.Equals, 3)
+	c.Check(report.Drift, gc.HasLen, 0)
+}
+
+func (s *driftSuite) TestCheckDrift(c *gc.C) {
+	schema := s.newSchema()
+	_, err := schema.Ensure(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.exec(c, `
+DROP TRIGGER trg_foo_insert;
+DROP INDEX idx_foo_name;
+CREATE INDEX idx_foo_name ON foo (id);
+DROP TABLE bar;
+CREATE TABLE hotfix (id INT PRIMARY KEY);
+`)
+
+	report, err := schema.CheckDrift(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report.OK(), jc.IsFalse)
+
+	var drift []string
+	for _, d := range report.Drift {
+		drift = append(drift, d.String())
+	}
+	c.Check(drift, jc.DeepEquals, []string{
+		`modified index "idx_foo_name"`,
+		`missing table "bar"`,
+		`missing trigger "trg_foo_insert"`,
+		`unexpected table "hotfix"`,
+	})
+}
+
+func (s *driftSuite) TestRepairDrift(c *gc.C) {
+	schema := s.newSchema()
+	_, err := schema.Ensure(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.exec(c, `
+DROP TRIGGER trg_foo_insert;
+DROP INDEX idx_foo_name;
+CREATE INDEX idx_foo_name ON foo (id);
+CREATE TABLE hotfix (id INT PRIMARY KEY);
+`)
+
+	repaired, err := schema.RepairDrift(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	var names []string
+	for _, d := range repaired {
+		names = append(names, d.String())
+	}
+	c.Check(names, jc.DeepEquals, []string{
+		`modified index "idx_foo_name"`,
+		`missing trigger "trg_foo_insert"`,
+	})
+
+	// Only the unexpected table remains, as it isn't repairable.
+	report, err := schema.CheckDrift(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(report.Drift, gc.HasLen, 1)
+	c.Check(report.Drift[0].String(), gc.Equals, `unexpected table "hotfix"`)
+	c.Check(report.Drift[0].Repairable(), jc.IsFalse)
+
+	// The repaired trigger works.
+	s.exec(c, `INSERT INTO foo VALUES (1, 'one')`)
+	var count int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM bar`).Scan(&count)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 1)
+}
+
+func (s *driftSuite) exec(c *gc.C, stmt string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, stmt)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
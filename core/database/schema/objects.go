@@ -0,0 +1,378 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schema
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ObjectType is the type of an object defined by a schema.
+type ObjectType string
+
+const (
+	// ObjectTable is a table.
+	ObjectTable ObjectType = "table"
+	// ObjectIndex is an index.
+	ObjectIndex ObjectType = "index"
+	// ObjectTrigger is a trigger.
+	ObjectTrigger ObjectType = "trigger"
+	// ObjectView is a view.
+	ObjectView ObjectType = "view"
+)
+
+// Object is a table, index, trigger or view defined by a schema.
+type Object struct {
+	// Type is the type of the object.
+	Type ObjectType
+	// Name is the name of the object.
+	Name string
+	// SQL is the statement that creates the object.
+	SQL string
+}
+
+// Objects returns the tables, indexes, triggers and views that are defined
+// by all of the patches in the schema, in the order they are created. This
+// includes the objects used to track the applied patches.
+func (s *Schema) Objects() []Object {
+	return objectsForPatches(s.patches)
+}
+
+func objectsForPatches(patches []Patch) []Object {
+	objs := &objectSet{index: make(map[objectKey]int)}
+	objs.apply(schemaTable)
+	for _, patch := range patches {
+		objs.apply(patch.stmt)
+	}
+	return objs.list()
+}
+
+type objectKey struct {
+	objectType ObjectType
+	name       string
+}
+
+// objectSet tracks the objects created, renamed and dropped by a series of
+// statements.
+type objectSet struct {
+	objects []*Object
+	index   map[objectKey]int
+}
+
+func (o *objectSet) apply(sql string) {
+	for _, stmt := range splitStatements(sql) {
+		tokens := headTokens(stmt, 8)
+		if len(tokens) < 3 {
+			continue
+		}
+		switch strings.ToUpper(tokens[0]) {
+		case "CREATE":
+			o.create(stmt, tokens[1:])
+		case "DROP":
+			o.drop(tokens[1:])
+		case "ALTER":
+			o.alter(tokens[1:])
+		}
+	}
+}
+
+func (o *objectSet) create(stmt string, tokens []string) {
+	tokens = skipKeywords(tokens, "UNIQUE", "VIRTUAL")
+	if len(tokens) > 0 {
+		switch strings.ToUpper(tokens[0]) {
+		case "TEMP", "TEMPORARY":
+			// Temporary objects are not part of the database schema.
+			return
+		}
+	}
+	objectType, tokens, ok := objectTypeOf(tokens)
+	if !ok {
+		return
+	}
+	if hasKeywords(tokens, "IF", "NOT", "EXISTS") {
+		tokens = tokens[3:]
+	}
+	if len(tokens) == 0 {
+		return
+	}
+
+	key := objectKey{objectType: objectType, name: unquoteIdentifier(tokens[0])}
+	if _, ok := o.index[key]; ok {
+		// The object already exists, so the statement will either fail
+		// or, with IF NOT EXISTS, do nothing.
+		return
+	}
+	o.index[key] = len(o.objects)
+	o.objects = append(o.objects, &Object{
+		Type: objectType,
+		Name: key.name,
+		SQL:  stmt,
+	})
+}
+
+func (o *objectSet) drop(tokens []string) {
+	objectType, tokens, ok := objectTypeOf(tokens)
+	if !ok {
+		return
+	}
+	if hasKeywords(tokens, "IF", "EXISTS") {
+		tokens = tokens[2:]
+	}
+	if len(tokens) == 0 {
+		return
+	}
+
+	key := objectKey{objectType: objectType, name: unquoteIdentifier(tokens[0])}
+	if i, ok := o.index[key]; ok {
+		o.objects[i] = nil
+		delete(o.index, key)
+	}
+	if objectType != ObjectTable {
+		return
+	}
+	// Dropping a table drops its indexes and triggers too.
+	for i, obj := range o.objects {
+		if obj != nil && obj.Type != ObjectTable && obj.Type != ObjectView && referencesTable(obj, key.name) {
+			o.objects[i] = nil
+			delete(o.index, objectKey{objectType: obj.Type, name: obj.Name})
+		}
+	}
+}
+
+func (o *objectSet) alter(tokens []string) {
+	// ALTER TABLE <name> RENAME TO <new name>
+	if len(tokens) < 5 || !hasKeywords(tokens, "TABLE") || !hasKeywords(tokens[2:], "RENAME", "TO") {
+		return
+	}
+	key := objectKey{objectType: ObjectTable, name: unquoteIdentifier(tokens[1])}
+	i, ok := o.index[key]
+	if !ok {
+		return
+	}
+	newName := unquoteIdentifier(tokens[4])
+	delete(o.index, key)
+	o.objects[i].Name = newName
+	o.index[objectKey{objectType: ObjectTable, name: newName}] = i
+}
+
+func (o *objectSet) list() []Object {
+	var result []Object
+	for _, obj := range o.objects {
+		if obj != nil {
+			result = append(result, *obj)
+		}
+	}
+	return result
+}
+
+// referencesTable returns true if the index or trigger is on the table.
+func referencesTable(obj *Object, table string) bool {
+	tokens := headTokens(obj.SQL, 16)
+	for i, token := range tokens {
+		if strings.EqualFold(token, "ON") && i+1 < len(tokens) {
+			return strings.EqualFold(unquoteIdentifier(tokens[i+1]), table)
+		}
+	}
+	return false
+}
+
+func objectTypeOf(tokens []string) (ObjectType, []string, bool) {
+	if len(tokens) == 0 {
+		return "", nil, false
+	}
+	switch strings.ToUpper(tokens[0]) {
+	case "TABLE":
+		return ObjectTable, tokens[1:], true
+	case "INDEX":
+		return ObjectIndex, tokens[1:], true
+	case "TRIGGER":
+		return ObjectTrigger, tokens[1:], true
+	case "VIEW":
+		return ObjectView, tokens[1:], true
+	}
+	return "", nil, false
+}
+
+func skipKeywords(tokens []string, keywords ...string) []string {
+	for len(tokens) > 0 {
+		var skipped bool
+		for _, keyword := range keywords {
+			if strings.EqualFold(tokens[0], keyword) {
+				tokens = tokens[1:]
+				skipped = true
+				break
+			}
+		}
+		if !skipped {
+			break
+		}
+	}
+	return tokens
+}
+
+func hasKeywords(tokens []string, keywords ...string) bool {
+	if len(tokens) < len(keywords) {
+		return false
+	}
+	for i, keyword := range keywords {
+		if !strings.EqualFold(tokens[i], keyword) {
+			return false
+		}
+	}
+	return true
+}
+
+// unquoteIdentifier returns the name of an identifier, without any schema
+// name or quotes.
+func unquoteIdentifier(name string) string {
+	if len(name) >= 2 {
+		switch first, last := name[0], name[len(name)-1]; {
+		case first == '"' && last == '"':
+			return strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+		case first == '`' && last == '`':
+			return strings.ReplaceAll(name[1:len(name)-1], "``", "`")
+		case first == '[' && last == ']':
+			return name[1 : len(name)-1]
+		}
+	}
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		return unquoteIdentifier(name[i+1:])
+	}
+	return name
+}
+
+// headTokens returns up to n of the leading keywords and identifiers of
+// a statement, stopping at the first punctuation.
+func headTokens(stmt string, n int) []string {
+	var tokens []string
+	for i := 0; i < len(stmt) && len(tokens) < n; {
+		c := stmt[i]
+		switch {
+		case unicode.IsSpace(rune(c)):
+			i++
+			continue
+		case c == '"' || c == '`' || c == '[':
+			end := closingQuote(stmt, i)
+			tokens = append(tokens, stmt[i:end])
+			i = end
+			continue
+		case strings.IndexByte("(),;", c) >= 0:
+			return tokens
+		}
+		start := i
+		for i < len(stmt) && !unicode.IsSpace(rune(stmt[i])) && strings.IndexByte("(),;", stmt[i]) < 0 {
+			i++
+		}
+		tokens = append(tokens, stmt[start:i])
+	}
+	return tokens
+}
+
+// closingQuote returns the index after the quoted string or identifier that
+// starts at i.
+func closingQuote(s string, i int) int {
+	closing := s[i]
+	if closing == '[' {
+		closing = ']'
+	}
+	for j := i + 1; j < len(s); j++ {
+		if s[j] != closing {
+			continue
+		}
+		// Quotes are escaped by doubling them.
+		if closing != ']' && j+1 < len(s) && s[j+1] == closing {
+			j++
+			continue
+		}
+		return j + 1
+	}
+	return len(s)
+}
+
+// splitStatements splits SQL into the statements it contains, with any
+// comments removed. The statements in the body of a trigger are kept with
+// the trigger.
+func splitStatements(sql string) []string {
+	var (
+		statements []string
+		current    strings.Builder
+		word       strings.Builder
+		depth      int
+	)
+	endWord := func() {
+		switch strings.ToUpper(word.String()) {
+		case "BEGIN", "CASE":
+			depth++
+		case "END":
+			if depth > 0 {
+				depth--
+			}
+		}
+		word.Reset()
+	}
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			endWord()
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end
+			}
+			current.WriteByte(' ')
+			continue
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			endWord()
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				i = len(sql)
+			} else {
+				i += end + 4
+			}
+			current.WriteByte(' ')
+			continue
+		case c == '\'' || c == '"' || c == '`' || c == '[':
+			endWord()
+			end := closingQuote(sql, i)
+			current.WriteString(sql[i:end])
+			i = end
+			continue
+		case c == '_' || unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)):
+			word.WriteByte(c)
+		default:
+			endWord()
+		}
+		if c == ';' && depth == 0 {
+			if stmt := strings.TrimSpace(current.String()); stmt != "" {
+				statements = append(statements, stmt)
+			}
+			current.Reset()
+			i++
+			continue
+		}
+		current.WriteByte(c)
+		i++
+	}
+	endWord()
+	if stmt := strings.TrimSpace(current.String()); stmt != "" {
+		statements = append(statements, stmt)
+	}
+	return statements
+}
+
+// normalizeSQL returns the statement in a form that can be compared with
+// the statements recorded in the sqlite_master table, which have comments
+// and the IF NOT EXISTS clause removed.
+func normalizeSQL(stmt string) string {
+	fields := strings.Fields(strings.Join(splitStatements(stmt), "; "))
+	for i := 0; i+2 < len(fields) && i < 6; i++ {
+		if hasKeywords(fields[i:], "IF", "NOT", "EXISTS") {
+			fields = append(fields[:i:i], fields[i+3:]...)
+			break
+		}
+	}
+	return strings.ToLower(strings.Join(fields, " "))
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schema
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type objectsSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&objectsSuite{})
+
+func (s *objectsSuite) TestSplitStatements(c *gc.C) {
+	stmts := splitStatements(`
+-- a comment; with a semicolon
+CREATE TABLE foo (id INT PRIMARY KEY, name TEXT DEFAULT 'a;b');
+/* another; comment */
+CREATE TRIGGER trg_foo AFTER UPDATE ON foo FOR EACH ROW
+BEGIN
+    INSERT INTO bar VALUES (CASE WHEN NEW.id = 1 THEN 'x' ELSE 'y' END);
+    DELETE FROM baz;
+END;
+INSERT INTO foo VALUES (1, 'x')`)
+
+	c.Assert(stmts, gc.HasLen, 3)
+	c.Check(stmts[0], gc.Equals, `CREATE TABLE foo (id INT PRIMARY KEY, name TEXT DEFAULT 'a;b')`)
+	c.Check(stmts[1], gc.Matches, `(?s)CREATE TRIGGER trg_foo .*DELETE FROM baz;\s+END`)
+	c.Check(stmts[2], gc.Equals, `INSERT INTO foo VALUES (1, 'x')`)
+}
+
+func (s *objectsSuite) TestObjects(c *gc.C) {
+	schema := New(
+		MakePatch(`
+CREATE TABLE foo (id INT PRIMARY KEY);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_foo ON foo (id);
+CREATE VIEW v_foo AS SELECT id FROM foo;
+CREATE TEMP TABLE tmp (id INT);
+INSERT INTO foo VALUES (1);
+`),
+		MakePatch(`
+CREATE TABLE "bar baz" (id INT PRIMARY KEY);
+CREATE TRIGGER trg_bar AFTER INSERT ON "bar baz" FOR EACH ROW
+BEGIN
+    INSERT INTO foo VALUES (NEW.id);
+END;
+ALTER TABLE foo RENAME TO qux;
+DROP VIEW v_foo;
+`),
+	)
+
+	var names []string
+	for _, obj := range schema.Objects() {
+		names = append(names, string(obj.Type)+":"+obj.Name)
+	}
+	c.Check(names, jc.DeepEquals, []string{
+		"table:schema",
+		"index:idx_schema_version",
+		"table:qux",
+		"index:idx_foo",
+		"table:bar baz",
+		"trigger:trg_bar",
+	})
+}
+
+func (s *objectsSuite) TestObjectsDropTable(c *gc.C) {
+	schema := New(
+		MakePatch(`
+CREATE TABLE foo (id INT PRIMARY KEY);
+CREATE INDEX idx_foo ON foo (id);
+CREATE TRIGGER trg_foo AFTER INSERT ON foo FOR EACH ROW BEGIN SELECT 1; END;
+DROP TABLE foo;
+`),
+	)
+
+	var names []string
+	for _, obj := range schema.Objects() {
+		names = append(names, obj.Name)
+	}
+	c.Check(names, jc.DeepEquals, []string{"schema", "idx_schema_version"})
+}
+
+func (s *objectsSuite) TestNormalizeSQL(c *gc.C) {
+	c.Check(normalizeSQL(`CREATE INDEX IF NOT EXISTS idx_foo
+    ON foo (id) -- comment`), gc.Equals, normalizeSQL(`CREATE INDEX idx_foo ON foo (id)`))
+	c.Check(normalizeSQL(`CREATE INDEX idx_foo ON foo (id)`), gc.Not(gc.Equals), normalizeSQL(`CREATE INDEX idx_foo ON foo (name)`))
+}
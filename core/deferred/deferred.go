@@ -0,0 +1,65 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package deferred defines the state of a unit's deferred hooks, as
+// recorded by the unit agent in the unit state and read by the
+// controller to report it.
+package deferred
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Entry describes a hook which the charm deferred.
+type Entry struct {
+	// ID identifies the deferred hook amongst those of the unit.
+	ID string `yaml:"id"`
+
+	// Hook is the name of the deferred hook.
+	Hook string `yaml:"hook"`
+
+	// Reason is why the charm last deferred the hook.
+	Reason string `yaml:"reason,omitempty"`
+
+	// DeferredAt is when the charm first deferred the hook.
+	DeferredAt time.Time `yaml:"deferred-at"`
+
+	// Deferrals is how many times the charm has deferred the hook.
+	Deferrals int `yaml:"deferrals"`
+
+	// RetryAt is when the hook is run again if no other hook has
+	// been run before then. It is zero if the hook waits for
+	// another hook to be run.
+	RetryAt time.Time `yaml:"retry-at,omitempty"`
+
+	// Ready is true once another hook has been run since the hook
+	// was deferred, so it is waiting to be run again.
+	Ready bool `yaml:"ready,omitempty"`
+
+	// DropOnUpgrade is true if the hook is discarded when the
+	// charm is upgraded.
+	DropOnUpgrade bool `yaml:"drop-on-upgrade,omitempty"`
+}
+
+// State holds the deferred hooks of a unit, in the order they
+// were deferred.
+type State struct {
+	Hooks []Entry `yaml:"hooks,omitempty"`
+}
+
+// ParseState returns the State serialised in the given unit state value.
+// The unit agent also records the details it needs to run each hook
+// again; these are ignored.
+func ParseState(in string) (*State, error) {
+	st := &State{}
+	if in == "" {
+		return st, nil
+	}
+	if err := yaml.Unmarshal([]byte(in), st); err != nil {
+		return nil, errors.Annotate(err, "parsing deferred hook state")
+	}
+	return st, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/deferred"
+)
+
+type StateSuite struct{}
+
+var _ = gc.Suite(&StateSuite{})
+
+func (s *StateSuite) TestParseEmpty(c *gc.C) {
+	st, err := deferred.ParseState("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(st.Hooks, gc.HasLen, 0)
+}
+
+func (s *StateSuite) TestParse(c *gc.C) {
+	st, err := deferred.ParseState(`
+last-id: 2
+hooks:
+- id: "1"
+  hook: db-relation-changed
+  reason: database not ready
+  deferred-at: 2025-03-14T10:30:00Z
+  deferrals: 2
+  ready: true
+  info:
+    kind: relation-changed
+    relation-id: 1
+    remote-unit: mysql/0
+- id: "2"
+  hook: config-changed
+  deferred-at: 2025-03-14T10:35:00Z
+  deferrals: 1
+  retry-at: 2025-03-14T10:40:00Z
+  drop-on-upgrade: true
+  info:
+    kind: config-changed
+`[1:])
+	c.Assert(err, jc.ErrorIsNil)
+	deferredAt := time.Date(2025, time.March, 14, 10, 30, 0, 0, time.UTC)
+	c.Check(st, jc.DeepEquals, &deferred.State{
+		Hooks: []deferred.Entry{{
+			ID:         "1",
+			Hook:       "db-relation-changed",
+			Reason:     "database not ready",
+			DeferredAt: deferredAt,
+			Deferrals:  2,
+			Ready:      true,
+		}, {
+			ID:            "2",
+			Hook:          "config-changed",
+			DeferredAt:    deferredAt.Add(5 * time.Minute),
+			Deferrals:     1,
+			RetryAt:       deferredAt.Add(10 * time.Minute),
+			DropOnUpgrade: true,
+		}},
+	})
+}
+
+func (s *StateSuite) TestParseInvalid(c *gc.C) {
+	_, err := deferred.ParseState("hooks: [")
+	c.Assert(err, gc.ErrorMatches, "parsing deferred hook state: .*")
+}
@@ -65,9 +65,10 @@ type Operation interface {
 // Scope is a collection of database txn runners that can be used by the
 // operations.
 type Scope struct {
-	controllerDB database.TxnRunnerFactory
-	modelDB      database.TxnRunnerFactory
-	modelDeleter database.DBDeleter
+	controllerDB      database.TxnRunnerFactory
+	modelDB           database.TxnRunnerFactory
+	modelDeleter      database.DBDeleter
+	secretContentKeys []string
 }
 
 // ScopeForModel returns a Scope for the given model UUID.
@@ -92,6 +93,20 @@ func (s Scope) ModelDB() database.TxnRunnerFactory {
 	return s.modelDB
 }
 
+// WithSecretContentKeys returns a copy of the scope with the base64 encoded
+// keys which wrap the keys encrypting secret content stored in the model
+// database.
+func (s Scope) WithSecretContentKeys(keys []string) Scope {
+	s.secretContentKeys = keys
+	return s
+}
+
+// SecretContentKeys returns the base64 encoded keys which wrap the keys
+// encrypting secret content stored in the model database.
+func (s Scope) SecretContentKeys() []string {
+	return s.secretContentKeys
+}
+
 // ModelDeleter returns the database deleter for the model.
 func (s Scope) ModelDeleter() database.DBDeleter {
 	return s.modelDeleter
@@ -45,6 +45,12 @@ type ObjectStoreMetadata interface {
 	// PutMetadata adds a new specified path for the persistence metadata.
 	PutMetadata(ctx context.Context, metadata Metadata) (UUID, error)
 
+	// PutMetadataWithinQuota adds a new specified path for the persistence
+	// metadata, as long as the total size of the objects stored doesn't
+	// exceed the quota in bytes. The quota is enforced atomically with adding
+	// the metadata.
+	PutMetadataWithinQuota(ctx context.Context, metadata Metadata, quota int64) (UUID, error)
+
 	// RemoveMetadata removes the specified path for the persistence metadata.
 	RemoveMetadata(ctx context.Context, path string) error
 
@@ -55,3 +61,43 @@ type ObjectStoreMetadata interface {
 	// added or removed.
 	Watch() (watcher.StringsWatcher, error)
 }
+
+// Blob represents a blob in the controller-wide, content addressed, blob
+// store. The same blob is shared by every namespace that stores an object
+// with the same contents.
+type Blob struct {
+	// SHA256 is the 256 hash of the blob.
+	SHA256 string
+	// SHA384 is the 384 hash of the blob, which addresses the blob in the
+	// blob store.
+	SHA384 string
+	// Size is the size of the blob.
+	Size int64
+	// References is the number of namespaces that reference the blob.
+	References int64
+}
+
+// BlobMetadata tracks the namespaces that reference the blobs in the
+// controller-wide blob store.
+type BlobMetadata interface {
+	// AddBlobReference records that the namespace references the blob. A
+	// namespace only ever holds a single reference to a blob.
+	AddBlobReference(ctx context.Context, namespace string, blob Blob) error
+
+	// AddBlobReferenceWithinQuota records that the namespace references the
+	// blob, as long as the total size of the blobs doesn't exceed the quota
+	// in bytes. The quota is enforced atomically with adding the blob.
+	AddBlobReferenceWithinQuota(ctx context.Context, namespace string, blob Blob, quota int64) error
+
+	// RemoveBlobReference removes the reference the namespace holds to the
+	// blob with the specified SHA384.
+	RemoveBlobReference(ctx context.Context, namespace, sha384 string) error
+}
+
+// Usage describes the storage used by the objects in the object store.
+type Usage struct {
+	// Objects is the number of distinct objects stored.
+	Objects int64
+	// Size is the total size of the distinct objects stored, in bytes.
+	Size int64
+}
@@ -6,6 +6,7 @@ package objectstore
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/juju/errors"
 )
@@ -67,6 +68,61 @@ type BucketSession interface {
 	CreateBucket(ctx context.Context, bucketName string) error
 }
 
+// MultipartSession provides streaming uploads to the object store, where an
+// object is uploaded in parts as it's read. Not every session supports
+// multipart uploads, so callers should check whether a session implements it.
+type MultipartSession interface {
+	// CreateMultipartUpload starts a multipart upload of an object, returning
+	// the ID of the upload.
+	CreateMultipartUpload(ctx context.Context, bucketName, objectName string) (string, error)
+
+	// UploadPart uploads a part of the object for the multipart upload. Parts
+	// are numbered from 1. The hash is the base64 encoded SHA256 of the part,
+	// which is verified by the object store.
+	UploadPart(ctx context.Context, bucketName, objectName, uploadID string, partNumber int32, body io.ReadSeeker, hash string) (CompletedPart, error)
+
+	// CompleteMultipartUpload assembles the uploaded parts into the object.
+	CompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, parts []CompletedPart) error
+
+	// AbortMultipartUpload aborts the multipart upload, removing any parts
+	// that have been uploaded.
+	AbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error
+
+	// ListMultipartUploads returns the multipart uploads in progress for the
+	// objects with the given prefix.
+	ListMultipartUploads(ctx context.Context, bucketName, prefix string) ([]MultipartUpload, error)
+
+	// ListParts returns the parts that have been uploaded for the multipart
+	// upload, ordered by their part number. This allows an interrupted
+	// upload to be resumed.
+	ListParts(ctx context.Context, bucketName, objectName, uploadID string) ([]CompletedPart, error)
+
+	// CopyObject copies an object to another object in the same bucket,
+	// without downloading it.
+	CopyObject(ctx context.Context, bucketName, srcObjectName, dstObjectName string) error
+}
+
+// CompletedPart describes a part of a multipart upload that has been
+// uploaded.
+type CompletedPart struct {
+	// PartNumber is the number of the part, starting from 1.
+	PartNumber int32
+	// ETag is the entity tag returned by the object store for the part.
+	ETag string
+	// Hash is the base64 encoded SHA256 of the part.
+	Hash string
+}
+
+// MultipartUpload describes a multipart upload that is in progress.
+type MultipartUpload struct {
+	// ObjectName is the name of the object being uploaded.
+	ObjectName string
+	// UploadID is the ID of the upload.
+	UploadID string
+	// Initiated is the time the upload was started.
+	Initiated time.Time
+}
+
 // ObjectStoreGetter is the interface that is used to get a object store.
 type ObjectStoreGetter interface {
 	// GetObjectStore returns a object store for the given namespace.
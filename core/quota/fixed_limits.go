@@ -11,4 +11,24 @@ const (
 	// MaxCharmStateValueSize describes the max allowed value length for
 	// each entry that a charm attempts to persist to the controller.
 	MaxCharmStateValueSize = 64 * 1024
+
+	// MaxRelationSettingsKeySize describes the max allowed key length for
+	// each relation setting that a charm attempts to write in bulk.
+	MaxRelationSettingsKeySize = 256
+
+	// MaxRelationSettingsValueSize describes the max allowed value length
+	// for each relation setting that a charm attempts to write in bulk.
+	MaxRelationSettingsValueSize = 1024 * 1024
+
+	// MaxWorkloadMetricsPerHook describes the max number of workload
+	// metric samples that a charm can publish from a single hook.
+	MaxWorkloadMetricsPerHook = 1000
+
+	// MaxPebbleCheckStatusHistory describes the max number of status
+	// transitions kept for each Pebble check in a unit's workload containers.
+	MaxPebbleCheckStatusHistory = 100
+
+	// MaxUnitWorkloadStatusHistory describes the max number of changes
+	// kept of each unit's workload status.
+	MaxUnitWorkloadStatusHistory = 100
 )
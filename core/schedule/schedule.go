@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package schedule defines the state of a unit's scheduled hooks, as
+// recorded by the unit agent in the unit state and read by the
+// controller to report it.
+package schedule
+
+import (
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/internal/charm"
+)
+
+// Source describes where a schedule was defined.
+type Source string
+
+const (
+	// SourceCharm is used for schedules declared in the charm metadata.
+	SourceCharm Source = "charm"
+
+	// SourceRuntime is used for schedules registered by the charm
+	// with the schedule-set hook tool.
+	SourceRuntime Source = "runtime"
+)
+
+// Entry holds a schedule and when its hook was last run and is next due.
+type Entry struct {
+	charm.Schedule `yaml:",inline"`
+
+	// Source is where the schedule was defined.
+	Source Source `yaml:"source"`
+
+	// LastRun is when the schedule's hook last completed. It is zero if
+	// the hook has never run.
+	LastRun time.Time `yaml:"last-run,omitempty"`
+
+	// NextRun is when the schedule's hook is next due, including any
+	// jitter.
+	NextRun time.Time `yaml:"next-run,omitempty"`
+}
+
+// State holds the schedules of a unit.
+type State struct {
+	Schedules map[string]Entry `yaml:"schedules,omitempty"`
+}
+
+// NewState returns an empty State.
+func NewState() *State {
+	return &State{Schedules: make(map[string]Entry)}
+}
+
+// ParseState returns the State serialised in the given unit state value.
+func ParseState(in string) (*State, error) {
+	st := NewState()
+	if in == "" {
+		return st, nil
+	}
+	if err := yaml.Unmarshal([]byte(in), st); err != nil {
+		return nil, errors.Annotate(err, "parsing schedule state")
+	}
+	if st.Schedules == nil {
+		st.Schedules = make(map[string]Entry)
+	}
+	return st, nil
+}
+
+// Serialise returns the State as stored in the unit state.
+func (s *State) Serialise() (string, error) {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(data), nil
+}
+
+// Entries returns the schedules sorted by name.
+func (s *State) Entries() []Entry {
+	result := make([]Entry, 0, len(s.Schedules))
+	for _, e := range s.Schedules {
+		result = append(result, e)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/charm"
+)
+
+type StateSuite struct{}
+
+var _ = gc.Suite(&StateSuite{})
+
+func (s *StateSuite) TestParseEmpty(c *gc.C) {
+	st, err := schedule.ParseState("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(st.Schedules, gc.HasLen, 0)
+	c.Check(st.Schedules, gc.NotNil)
+}
+
+func (s *StateSuite) TestRoundTrip(c *gc.C) {
+	lastRun := time.Date(2025, time.January, 30, 3, 30, 0, 0, time.UTC)
+	st := schedule.NewState()
+	st.Schedules["poll"] = schedule.Entry{
+		Schedule: charm.Schedule{Name: "poll", Interval: 5 * time.Minute, CatchUp: charm.CatchUpSkip},
+		Source:   schedule.SourceRuntime,
+		NextRun:  lastRun.Add(5 * time.Minute),
+	}
+	st.Schedules["backup"] = schedule.Entry{
+		Schedule: charm.Schedule{Name: "backup", Cron: "30 3 * * *", Jitter: time.Minute, CatchUp: charm.CatchUpOnce},
+		Source:   schedule.SourceCharm,
+		LastRun:  lastRun,
+		NextRun:  lastRun.Add(24 * time.Hour),
+	}
+
+	data, err := st.Serialise()
+	c.Assert(err, jc.ErrorIsNil)
+	got, err := schedule.ParseState(data)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, st)
+
+	entries := got.Entries()
+	c.Assert(entries, gc.HasLen, 2)
+	c.Check(entries[0].Name, gc.Equals, "backup")
+	c.Check(entries[1].Name, gc.Equals, "poll")
+}
+
+func (s *StateSuite) TestParseInvalid(c *gc.C) {
+	_, err := schedule.ParseState("schedules: [")
+	c.Assert(err, gc.ErrorMatches, "parsing schedule state: .*")
+}
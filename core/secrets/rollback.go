@@ -0,0 +1,18 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import "time"
+
+// RevisionRollback holds details of a revision created by
+// restoring an earlier revision as the latest revision.
+type RevisionRollback struct {
+	// RestoredFromRevision is the earlier revision
+	// whose content was restored.
+	RestoredFromRevision int
+	// ReplacedRevision is the latest revision at the
+	// time of the rollback.
+	ReplacedRevision int
+	Time             time.Time
+}
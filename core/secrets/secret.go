@@ -173,6 +173,9 @@ type SecretMetadata struct {
 	// whenever an incompatible change is made.
 	Version int
 
+	// Type describes the structure of the secret content.
+	Type SecretType
+
 	// These can be updated after creation.
 	Description  string
 	Label        string
@@ -212,6 +215,13 @@ type AccessInfo struct {
 	Role   SecretRole
 }
 
+// AccessRecord holds info about a read of secret content.
+type AccessRecord struct {
+	Revision   int
+	Accessor   string
+	AccessTime time.Time
+}
+
 // AccessorKind represents the kind of a secret accessor entity.
 type AccessorKind string
 
@@ -246,6 +256,14 @@ type SecretRevisionMetadata struct {
 	CreateTime  time.Time
 	UpdateTime  time.Time
 	ExpireTime  *time.Time
+	// Stage is set if the revision was created by a staged rotation.
+	Stage *RevisionStage
+	// Rollback is set if the revision was restored as the latest
+	// revision by rolling back the secret.
+	Rollback *RevisionRollback
+	// PinnedApplications holds the names of the consuming
+	// applications which are pinned to the revision.
+	PinnedApplications []string
 }
 
 // SecretOwnerMetadata holds a secret metadata and any backend references of revisions.
@@ -289,4 +307,8 @@ type Filter struct {
 	Label    *string
 	Revision *int
 	Owner    *Owner
+
+	// IncludeAccessLog is true if the recorded reads of
+	// each secret's content should be returned as well.
+	IncludeAccessLog bool
 }
@@ -0,0 +1,219 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+// SecretType describes the structure of the content of a secret.
+type SecretType string
+
+const (
+	TypeGeneric        = SecretType("generic")
+	TypeTLS            = SecretType("tls")
+	TypePassword       = SecretType("password")
+	TypeSSHKey         = SecretType("ssh-key")
+	TypeDockerRegistry = SecretType("docker-registry")
+)
+
+// These are the keys used in the content of typed secrets.
+const (
+	// CertificateKey holds a PEM encoded certificate, followed by
+	// any intermediate certificates, for a tls secret.
+	CertificateKey = "certificate"
+	// PrivateKeyKey holds a PEM encoded private key for a tls
+	// or ssh-key secret.
+	PrivateKeyKey = "private-key"
+	// CAKey holds the PEM encoded CA certificates for a tls secret.
+	CAKey = "ca"
+	// PublicKeyKey holds the authorized_keys formatted public key
+	// for an ssh-key secret.
+	PublicKeyKey = "public-key"
+	// UsernameKey holds the user name for a password or
+	// docker-registry secret.
+	UsernameKey = "username"
+	// PasswordKey holds the password for a password or
+	// docker-registry secret.
+	PasswordKey = "password"
+	// RegistryKey holds the registry address for a
+	// docker-registry secret.
+	RegistryKey = "registry"
+)
+
+type secretTypeKeys struct {
+	required []string
+	optional []string
+}
+
+var typedSecretKeys = map[SecretType]secretTypeKeys{
+	TypeTLS: {
+		required: []string{CertificateKey, PrivateKeyKey},
+		optional: []string{CAKey},
+	},
+	TypePassword: {
+		required: []string{PasswordKey},
+		optional: []string{UsernameKey},
+	},
+	TypeSSHKey: {
+		required: []string{PrivateKeyKey},
+		optional: []string{PublicKeyKey},
+	},
+	TypeDockerRegistry: {
+		required: []string{RegistryKey, UsernameKey, PasswordKey},
+	},
+}
+
+func (t SecretType) String() string {
+	if t == "" {
+		return string(TypeGeneric)
+	}
+	return string(t)
+}
+
+// IsValid returns true if t is a valid secret type.
+func (t SecretType) IsValid() bool {
+	switch t {
+	case TypeGeneric, TypeTLS, TypePassword, TypeSSHKey, TypeDockerRegistry:
+		return true
+	}
+	return false
+}
+
+// ValidateContent checks that the secret content, whose values are base64
+// encoded, has the keys and values required by the secret type.
+// For a tls secret, it also checks that the certificate has not expired by
+// the specified time and returns the time at which it expires; for other
+// types, the returned expire time is nil.
+func (t SecretType) ValidateContent(data SecretData, now time.Time) (*time.Time, error) {
+	if t == "" || t == TypeGeneric {
+		return nil, nil
+	}
+	keys, ok := typedSecretKeys[t]
+	if !ok {
+		return nil, errors.NotValidf("secret type %q", t)
+	}
+
+	values := make(map[string]string, len(data))
+	for k, v := range data {
+		decoded, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, errors.NotValidf("base64 encoded value for %q", k)
+		}
+		values[k] = string(decoded)
+	}
+	for _, k := range keys.required {
+		if strings.TrimSpace(values[k]) == "" {
+			return nil, errors.NewNotValid(nil, fmt.Sprintf("%s secret missing %q", t, k))
+		}
+	}
+	var unexpected []string
+	for k := range values {
+		if !containsKey(keys.required, k) && !containsKey(keys.optional, k) {
+			unexpected = append(unexpected, k)
+		}
+	}
+	if len(unexpected) > 0 {
+		sort.Strings(unexpected)
+		return nil, errors.NewNotValid(nil, fmt.Sprintf("%s secret has unexpected keys: %s", t, strings.Join(unexpected, ", ")))
+	}
+
+	switch t {
+	case TypeTLS:
+		return validateTLSContent(values, now)
+	case TypeSSHKey:
+		return nil, validateSSHKeyContent(values)
+	}
+	return nil, nil
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func validateTLSContent(values map[string]string, now time.Time) (*time.Time, error) {
+	pair, err := tls.X509KeyPair([]byte(values[CertificateKey]), []byte(values[PrivateKeyKey]))
+	if err != nil {
+		return nil, errors.NewNotValid(err, "tls secret certificate and private key")
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, errors.NewNotValid(err, "tls secret certificate")
+	}
+	if !cert.NotAfter.After(now) {
+		return nil, errors.NewNotValid(nil, fmt.Sprintf("tls secret certificate expired at %s", cert.NotAfter.UTC().Format(time.RFC3339)))
+	}
+	if ca, ok := values[CAKey]; ok {
+		if err := validateCACertificates([]byte(ca)); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	expireTime := cert.NotAfter.UTC()
+	return &expireTime, nil
+}
+
+func validateCACertificates(ca []byte) error {
+	var found bool
+	for {
+		var block *pem.Block
+		block, ca = pem.Decode(ca)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return errors.NotValidf("tls secret CA with %q PEM block", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return errors.NewNotValid(err, "tls secret CA certificate")
+		}
+		found = true
+	}
+	if !found || len(bytes.TrimSpace(ca)) > 0 {
+		return errors.NotValidf("tls secret CA certificates")
+	}
+	return nil
+}
+
+func validateSSHKeyContent(values map[string]string) error {
+	var publicKey ssh.PublicKey
+	signer, err := ssh.ParsePrivateKey([]byte(values[PrivateKeyKey]))
+	if err == nil {
+		publicKey = signer.PublicKey()
+	} else if missing, ok := err.(*ssh.PassphraseMissingError); ok {
+		// An encrypted key is allowed, but its public key
+		// can only be checked if it is in the key file.
+		publicKey = missing.PublicKey
+	} else {
+		return errors.NewNotValid(err, "ssh-key secret private key")
+	}
+
+	authorizedKey, ok := values[PublicKeyKey]
+	if !ok {
+		return nil
+	}
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(authorizedKey))
+	if err != nil {
+		return errors.NewNotValid(err, "ssh-key secret public key")
+	}
+	if publicKey != nil && !bytes.Equal(parsed.Marshal(), publicKey.Marshal()) {
+		return errors.NewNotValid(nil, "ssh-key secret public key does not match private key")
+	}
+	return nil
+}
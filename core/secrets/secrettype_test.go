@@ -0,0 +1,175 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"golang.org/x/crypto/ssh"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/secrets"
+)
+
+type SecretTypeSuite struct{}
+
+var _ = gc.Suite(&SecretTypeSuite{})
+
+func encode(in map[string]string) secrets.SecretData {
+	out := make(secrets.SecretData)
+	for k, v := range in {
+		out[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	return out
+}
+
+func newCertificate(c *gc.C, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, jc.ErrorIsNil)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "juju"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	c.Assert(err, jc.ErrorIsNil)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func newSSHKey(c *gc.C) (string, string) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	c.Assert(err, jc.ErrorIsNil)
+	block, err := ssh.MarshalPrivateKey(priv, "")
+	c.Assert(err, jc.ErrorIsNil)
+	sshPub, err := ssh.NewPublicKey(pub)
+	c.Assert(err, jc.ErrorIsNil)
+	return string(pem.EncodeToMemory(block)), string(ssh.MarshalAuthorizedKey(sshPub))
+}
+
+func (s *SecretTypeSuite) TestIsValid(c *gc.C) {
+	for _, t := range []secrets.SecretType{
+		secrets.TypeGeneric, secrets.TypeTLS, secrets.TypePassword,
+		secrets.TypeSSHKey, secrets.TypeDockerRegistry,
+	} {
+		c.Assert(t.IsValid(), jc.IsTrue)
+	}
+	c.Assert(secrets.SecretType("foo").IsValid(), jc.IsFalse)
+	c.Assert(secrets.SecretType("").String(), gc.Equals, "generic")
+}
+
+func (s *SecretTypeSuite) TestValidateGeneric(c *gc.C) {
+	expire, err := secrets.TypeGeneric.ValidateContent(encode(map[string]string{"foo": "bar"}), time.Now())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expire, gc.IsNil)
+}
+
+func (s *SecretTypeSuite) TestValidateTLS(c *gc.C) {
+	now := time.Now()
+	notAfter := now.Add(time.Hour).Truncate(time.Second).UTC()
+	cert, key := newCertificate(c, notAfter)
+	ca, _ := newCertificate(c, notAfter)
+
+	expire, err := secrets.TypeTLS.ValidateContent(encode(map[string]string{
+		"certificate": cert,
+		"private-key": key,
+		"ca":          ca,
+	}), now)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expire, gc.NotNil)
+	c.Assert(expire.Equal(notAfter), jc.IsTrue)
+}
+
+func (s *SecretTypeSuite) TestValidateTLSErrors(c *gc.C) {
+	now := time.Now()
+	cert, key := newCertificate(c, now.Add(time.Hour))
+	_, otherKey := newCertificate(c, now.Add(time.Hour))
+	expired, expiredKey := newCertificate(c, now.Add(-time.Hour))
+
+	for _, t := range []struct {
+		data map[string]string
+		err  string
+	}{{
+		data: map[string]string{"certificate": cert},
+		err:  `tls secret missing "private-key"`,
+	}, {
+		data: map[string]string{"certificate": cert, "private-key": key, "foo": "bar"},
+		err:  `tls secret has unexpected keys: foo`,
+	}, {
+		data: map[string]string{"certificate": cert, "private-key": otherKey},
+		err:  `tls secret certificate and private key: .*`,
+	}, {
+		data: map[string]string{"certificate": "foo", "private-key": key},
+		err:  `tls secret certificate and private key: .*`,
+	}, {
+		data: map[string]string{"certificate": expired, "private-key": expiredKey},
+		err:  `tls secret certificate expired at .*`,
+	}, {
+		data: map[string]string{"certificate": cert, "private-key": key, "ca": "foo"},
+		err:  `tls secret CA certificates not valid`,
+	}} {
+		_, err := secrets.TypeTLS.ValidateContent(encode(t.data), now)
+		c.Check(err, jc.ErrorIs, errors.NotValid)
+		c.Check(err, gc.ErrorMatches, t.err)
+	}
+}
+
+func (s *SecretTypeSuite) TestValidatePassword(c *gc.C) {
+	_, err := secrets.TypePassword.ValidateContent(encode(map[string]string{
+		"username": "admin", "password": "s3cret",
+	}), time.Now())
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = secrets.TypePassword.ValidateContent(encode(map[string]string{
+		"username": "admin",
+	}), time.Now())
+	c.Assert(err, gc.ErrorMatches, `password secret missing "password"`)
+}
+
+func (s *SecretTypeSuite) TestValidateDockerRegistry(c *gc.C) {
+	_, err := secrets.TypeDockerRegistry.ValidateContent(encode(map[string]string{
+		"registry": "ghcr.io", "username": "admin", "password": "s3cret",
+	}), time.Now())
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = secrets.TypeDockerRegistry.ValidateContent(encode(map[string]string{
+		"username": "admin", "password": "s3cret",
+	}), time.Now())
+	c.Assert(err, gc.ErrorMatches, `docker-registry secret missing "registry"`)
+}
+
+func (s *SecretTypeSuite) TestValidateSSHKey(c *gc.C) {
+	key, pub := newSSHKey(c)
+	_, otherPub := newSSHKey(c)
+
+	_, err := secrets.TypeSSHKey.ValidateContent(encode(map[string]string{
+		"private-key": key, "public-key": pub,
+	}), time.Now())
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = secrets.TypeSSHKey.ValidateContent(encode(map[string]string{
+		"private-key": key, "public-key": otherPub,
+	}), time.Now())
+	c.Assert(err, gc.ErrorMatches, `ssh-key secret public key does not match private key`)
+
+	_, err = secrets.TypeSSHKey.ValidateContent(encode(map[string]string{
+		"private-key": "foo",
+	}), time.Now())
+	c.Assert(err, gc.ErrorMatches, `ssh-key secret private key: .*`)
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import "time"
+
+// StageStatus is the status of a secret revision created
+// by a staged rotation.
+type StageStatus string
+
+const (
+	// StagePending means some consumers are yet to track the
+	// staged revision, so the previous revision is retained.
+	StagePending = StageStatus("pending")
+
+	// StageCommitted means all consumers track the staged
+	// revision and the previous revision has been released.
+	StageCommitted = StageStatus("committed")
+
+	// StageRolledBack means the deadline passed before all
+	// consumers tracked the staged revision, so the previous
+	// revision was restored as the latest revision.
+	StageRolledBack = StageStatus("rolled-back")
+
+	// StageExpired means the deadline passed before all
+	// consumers tracked the staged revision, and the owner
+	// did not ask for a rollback, so the previous revision is
+	// no longer held back for the consumers yet to move off it.
+	StageExpired = StageStatus("expired")
+)
+
+// DefaultStageTimeout is how long consumers have to track a
+// staged revision before the rotation expires, if the owner
+// does not specify a timeout.
+const DefaultStageTimeout = time.Hour
+
+// RevisionStage holds the progress of a staged rotation
+// for the revision it created.
+type RevisionStage struct {
+	Status StageStatus
+	// PreviousRevision is the revision which was replaced by
+	// the staged revision.
+	PreviousRevision int
+	Deadline         time.Time
+	// RollbackOnTimeout is true if the previous revision is to be
+	// restored should the rotation not be committed by the deadline.
+	RollbackOnTimeout bool
+}
@@ -81,6 +81,9 @@ const (
 	KindUnitAgent HistoryKind = "juju-unit"
 	// KindWorkload represents a charm workload status history entry.
 	KindWorkload HistoryKind = "workload"
+	// KindPebbleCheck represents a status history entry for a Pebble check
+	// in one of a unit's workload containers.
+	KindPebbleCheck HistoryKind = "pebble-check"
 	// KindMachineInstance represents an entry for a machine instance.
 	KindMachineInstance HistoryKind = "machine"
 	// KindMachine represents an entry for a machine agent.
@@ -99,7 +102,7 @@ func (k HistoryKind) String() string {
 // Valid will return true if the current kind is a valid one.
 func (k HistoryKind) Valid() bool {
 	switch k {
-	case KindModel, KindUnit, KindUnitAgent, KindWorkload,
+	case KindModel, KindUnit, KindUnitAgent, KindWorkload, KindPebbleCheck,
 		KindApplication, KindSAAS,
 		KindMachineInstance, KindMachine,
 		KindContainerInstance, KindContainer:
@@ -117,6 +120,7 @@ func AllHistoryKind() map[HistoryKind]string {
 		KindUnit:              "statuses for specified unit and its workload",
 		KindUnitAgent:         "statuses from the agent that is managing a unit",
 		KindWorkload:          "statuses for unit's workload",
+		KindPebbleCheck:       "statuses of the Pebble checks in a unit's workload containers",
 		KindMachineInstance:   "statuses that occur due to provisioning of a machine",
 		KindMachine:           "status of the agent that is managing a machine",
 		KindContainerInstance: "statuses from the agent that is managing containers",
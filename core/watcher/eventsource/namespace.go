@@ -34,6 +34,10 @@ type NamespaceWatcher struct {
 	initialQuery NamespaceQuery
 	changeMask   changestream.ChangeType
 
+	// columns optionally restricts the update events to those that changed
+	// at least one of the columns.
+	columns []string
+
 	mapper Mapper
 }
 
@@ -66,6 +70,27 @@ func NewNamespaceMapperWatcher(
 	return w
 }
 
+// NewNamespaceColumnsWatcher returns a new watcher that receives changes
+// from the input base watcher's db/queue when changes in the namespace occur.
+// Update events are only emitted if they changed at least one of the input
+// columns, preventing the watcher from waking for irrelevant updates.
+func NewNamespaceColumnsWatcher(
+	base *BaseWatcher, namespace string, changeMask changestream.ChangeType, columns []string, initialQuery NamespaceQuery,
+) watcher.StringsWatcher {
+	w := &NamespaceWatcher{
+		BaseWatcher:  base,
+		out:          make(chan []string),
+		namespace:    namespace,
+		initialQuery: initialQuery,
+		changeMask:   changeMask,
+		columns:      columns,
+		mapper:       defaultMapper,
+	}
+
+	w.tomb.Go(w.loop)
+	return w
+}
+
 // Changes returns the channel on which the keys for
 // changed rows are sent to downstream consumers.
 func (w *NamespaceWatcher) Changes() <-chan []string {
@@ -78,7 +103,11 @@ func (w *NamespaceWatcher) loop() error {
 	if w.changeMask == 0 {
 		return errors.NotValidf("changeMask value: 0")
 	}
-	subscription, err := w.watchableDB.Subscribe(changestream.Namespace(w.namespace, w.changeMask))
+	opt := changestream.Namespace(w.namespace, w.changeMask)
+	if len(w.columns) > 0 {
+		opt = changestream.FilteredColumnsNamespace(w.namespace, w.changeMask, w.columns...)
+	}
+	subscription, err := w.watchableDB.Subscribe(opt)
 	if err != nil {
 		return errors.Annotatef(err, "subscribing to namespace %q", w.namespace)
 	}
@@ -375,6 +375,65 @@ func (s *namespaceSuite) TestSubscriptionDoneKillsWorker(c *gc.C) {
 	c.Check(err, jc.ErrorIs, ErrSubscriptionClosed)
 }
 
+func (s *namespaceSuite) TestColumnsFilterSubscription(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	subExp := s.sub.EXPECT()
+
+	done := make(chan struct{})
+	subExp.Done().Return(done).Times(2)
+
+	deltas := make(chan []changestream.ChangeEvent)
+	subExp.Changes().Return(deltas)
+	subExp.Unsubscribe()
+
+	var filter func(changestream.ChangeEvent) bool
+	s.eventsource.EXPECT().Subscribe(
+		subscriptionOptionMatcher{changestream.Namespace(
+			"external_controller",
+			changestream.All,
+		)},
+	).DoAndReturn(func(opts ...changestream.SubscriptionOption) (changestream.Subscription, error) {
+		filter = opts[0].Filter()
+		return s.sub, nil
+	})
+
+	w := NewNamespaceColumnsWatcher(
+		s.newBaseWatcher(c), "external_controller", changestream.All, []string{"ca_cert"},
+		InitialNamespaceChanges("SELECT uuid FROM external_controller"))
+	defer workertest.CleanKill(c, w)
+
+	select {
+	case changes := <-w.Changes():
+		c.Assert(changes, gc.HasLen, 0)
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for initial watcher changes")
+	}
+
+	// Only updates to the ca_cert column, or events that don't record the
+	// columns, are accepted by the subscription.
+	c.Assert(filter, gc.NotNil)
+	c.Check(filter(changeEvent{
+		changeType: 1,
+		namespace:  "external_controller",
+		changed:    "some-ec-uuid",
+	}), jc.IsTrue)
+	c.Check(filter(changeEvent{
+		changeType:     2,
+		namespace:      "external_controller",
+		changed:        "some-ec-uuid",
+		changedColumns: []string{"ca_cert"},
+	}), jc.IsTrue)
+	c.Check(filter(changeEvent{
+		changeType:     2,
+		namespace:      "external_controller",
+		changed:        "some-ec-uuid",
+		changedColumns: []string{"alias"},
+	}), jc.IsFalse)
+
+	workertest.CleanKill(c, w)
+}
+
 func (s *namespaceSuite) TestInvalidChangeMask(c *gc.C) {
 	w := NewNamespaceWatcher(s.newBaseWatcher(c), "external_controller", 0, InitialNamespaceChanges("SELECT uuid FROM external_controller"))
 	defer workertest.DirtyKill(c, w)
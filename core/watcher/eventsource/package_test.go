@@ -108,9 +108,10 @@ func (m subscriptionOptionMatcher) String() string {
 }
 
 type changeEvent struct {
-	changeType changestream.ChangeType
-	namespace  string
-	changed    string
+	changeType     changestream.ChangeType
+	namespace      string
+	changed        string
+	changedColumns []string
 }
 
 func (e changeEvent) Type() changestream.ChangeType {
@@ -124,3 +125,7 @@ func (e changeEvent) Namespace() string {
 func (e changeEvent) Changed() string {
 	return e.changed
 }
+
+func (e changeEvent) ChangedColumns() []string {
+	return e.changedColumns
+}
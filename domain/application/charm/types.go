@@ -357,6 +357,9 @@ type Relation struct {
 	Optional  bool
 	Limit     int
 	Scope     RelationScope
+	// Schema is the JSON encoded schema of the relation's databags, if the
+	// charm declares one.
+	Schema []byte
 }
 
 // ExtraBinding represents an extra bindable endpoint that is not a relation.
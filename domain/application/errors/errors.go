@@ -252,4 +252,8 @@ const (
 	// InvalidStorageMountPoint describes an error that occurs when
 	// a storage attachment's location cannot be mounted on the node.
 	InvalidStorageMountPoint = errors.ConstError("invalid storage mount point")
+
+	// PebbleCheckNotValid describes an error that occurs when the status of
+	// a Pebble check in a unit's workload container is not valid.
+	PebbleCheckNotValid = errors.ConstError("pebble check not valid")
 )
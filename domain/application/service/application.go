@@ -21,6 +21,7 @@ import (
 	corelife "github.com/juju/juju/core/life"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/quota"
 	"github.com/juju/juju/core/resource"
 	corestatus "github.com/juju/juju/core/status"
 	corestorage "github.com/juju/juju/core/storage"
@@ -75,8 +76,27 @@ type ApplicationState interface {
 	// GetUnitWorkloadStatus returns the workload status of the specified unit.
 	GetUnitWorkloadStatus(context.Context, coreunit.UUID) (*application.StatusInfo[application.WorkloadStatusType], error)
 
-	// SetUnitWorkloadStatus sets the workload status of the specified unit.
-	SetUnitWorkloadStatus(context.Context, coreunit.UUID, *application.StatusInfo[application.WorkloadStatusType]) error
+	// SetUnitWorkloadStatus sets the workload status of the specified unit,
+	// keeping at most historySize changes of the unit's workload status.
+	SetUnitWorkloadStatus(ctx context.Context, unitUUID coreunit.UUID, status *application.StatusInfo[application.WorkloadStatusType], historySize int) error
+
+	// GetUnitWorkloadStatusHistory returns the changes to the workload
+	// status of the specified unit, oldest first.
+	GetUnitWorkloadStatusHistory(context.Context, coreunit.UUID) ([]application.StatusInfo[application.WorkloadStatusType], error)
+
+	// SetUnitPebbleCheckStatus records the status of a Pebble check in one
+	// of the specified unit's workload containers, keeping at most
+	// historySize transitions of the check's status. It returns whether the
+	// check changed status.
+	SetUnitPebbleCheckStatus(ctx context.Context, unitUUID coreunit.UUID, check application.PebbleCheckStatusInfo, historySize int) (bool, error)
+
+	// GetAllUnitPebbleCheckStatuses returns the latest status of the Pebble
+	// checks of every unit in the model, keyed by unit name.
+	GetAllUnitPebbleCheckStatuses(context.Context) (map[coreunit.Name][]application.PebbleCheckStatusInfo, error)
+
+	// GetUnitPebbleCheckStatusHistory returns the transitions between
+	// statuses of the Pebble checks of the specified unit, oldest first.
+	GetUnitPebbleCheckStatusHistory(context.Context, coreunit.UUID) ([]application.PebbleCheckStatusInfo, error)
 
 	// DeleteUnit deletes the specified unit.
 	// If the unit's application is Dying and no
@@ -881,7 +901,52 @@ func (s *Service) SetUnitWorkloadStatus(ctx context.Context, unitName coreunit.N
 	if err != nil {
 		return errors.Trace(err)
 	}
-	return s.st.SetUnitWorkloadStatus(ctx, unitUUID, workloadStatus)
+	return s.st.SetUnitWorkloadStatus(ctx, unitUUID, workloadStatus, quota.MaxUnitWorkloadStatusHistory)
+}
+
+// GetUnitWorkloadStatusHistory returns the changes to the workload status of
+// the specified unit which match the filter, oldest first. The filter's
+// Exclude values are matched against the statuses' messages.
+// If the unit doesn't exist, an error satisfying
+// [applicationerrors.UnitNotFound] is returned.
+func (s *Service) GetUnitWorkloadStatusHistory(
+	ctx context.Context, unitName coreunit.Name, filter corestatus.StatusHistoryFilter,
+) ([]corestatus.StatusInfo, error) {
+	if err := unitName.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	unitUUID, err := s.st.GetUnitUUIDByName(ctx, unitName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	history, err := s.st.GetUnitWorkloadStatusHistory(ctx, unitUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	from := filter.FromDate
+	if filter.Delta != nil {
+		t := s.clock.Now().Add(-*filter.Delta)
+		from = &t
+	}
+	var result []corestatus.StatusInfo
+	for _, h := range history {
+		if (from != nil && h.Since != nil && h.Since.Before(*from)) || filter.Exclude.Contains(h.Message) {
+			continue
+		}
+		info, err := decodeWorkloadStatus(&h)
+		if err != nil {
+			return nil, internalerrors.Errorf("decoding workload status: %w", err)
+		}
+		result = append(result, *info)
+	}
+	if filter.Size > 0 && len(result) > filter.Size {
+		result = result[len(result)-filter.Size:]
+	}
+	return result, nil
 }
 
 // DeleteApplication deletes the specified application, returning an error
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/juju/clock/testclock"
+	"github.com/juju/collections/set"
 	jujuerrors "github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -26,6 +27,7 @@ import (
 	"github.com/juju/juju/core/constraints"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
+	"github.com/juju/juju/core/quota"
 	"github.com/juju/juju/core/resource"
 	resourcetesting "github.com/juju/juju/core/resource/testing"
 	corestatus "github.com/juju/juju/core/status"
@@ -1408,7 +1410,7 @@ func (s *applicationServiceSuite) TestSetWorkloadUnitStatus(c *gc.C) {
 		Message: "doink",
 		Data:    []byte(`{"foo":"bar"}`),
 		Since:   &now,
-	})
+	}, quota.MaxUnitWorkloadStatusHistory)
 
 	err := s.service.SetUnitWorkloadStatus(context.Background(), coreunit.Name("foo/666"), &corestatus.StatusInfo{
 		Status:  corestatus.Active,
@@ -1433,6 +1435,47 @@ func (s *applicationServiceSuite) TestSetWorkloadUnitStatusInvalidStatus(c *gc.C
 	c.Assert(err, gc.ErrorMatches, `.*unknown workload status "allocating"`)
 }
 
+func (s *applicationServiceSuite) TestGetUnitWorkloadStatusHistory(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	unitUUID := unittesting.GenUnitUUID(c)
+	s.state.EXPECT().GetUnitUUIDByName(gomock.Any(), coreunit.Name("foo/666")).Return(unitUUID, nil)
+	s.state.EXPECT().GetUnitWorkloadStatusHistory(gomock.Any(), unitUUID).Return(
+		[]application.StatusInfo[application.WorkloadStatusType]{{
+			Status:  application.WorkloadStatusActive,
+			Message: "doink",
+			Since:   &earlier,
+		}, {
+			Status:  application.WorkloadStatusError,
+			Message: "boink",
+			Data:    []byte(`{"foo":"bar"}`),
+			Since:   &now,
+		}, {
+			Status:  application.WorkloadStatusActive,
+			Message: "excluded",
+			Since:   &now,
+		}}, nil)
+
+	obtained, err := s.service.GetUnitWorkloadStatusHistory(context.Background(), coreunit.Name("foo/666"), corestatus.StatusHistoryFilter{
+		Size:    2,
+		Exclude: set.NewStrings("excluded"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, jc.DeepEquals, []corestatus.StatusInfo{{
+		Status:  corestatus.Active,
+		Message: "doink",
+		Since:   &earlier,
+	}, {
+		Status:  corestatus.Error,
+		Message: "boink",
+		Data:    map[string]interface{}{"foo": "bar"},
+		Since:   &now,
+	}})
+}
+
 func (s *applicationServiceSuite) TestGetApplicationStatus(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
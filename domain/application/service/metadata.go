@@ -108,6 +108,11 @@ func decodeMetadataRelation(relations map[string]charm.Relation) (map[string]int
 			return nil, fmt.Errorf("decode scope: %w", err)
 		}
 
+		schema, err := decodeRelationSchema(v.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("decode schema: %w", err)
+		}
+
 		result[k] = internalcharm.Relation{
 			Name:      v.Name,
 			Role:      role,
@@ -115,11 +120,24 @@ func decodeMetadataRelation(relations map[string]charm.Relation) (map[string]int
 			Interface: v.Interface,
 			Optional:  v.Optional,
 			Limit:     v.Limit,
+			Schema:    schema,
 		}
 	}
 	return result, nil
 }
 
+func decodeRelationSchema(schema []byte) (*internalcharm.RelationSchema, error) {
+	if len(schema) == 0 {
+		return nil, nil
+	}
+
+	var result internalcharm.RelationSchema
+	if err := json.Unmarshal(schema, &result); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+	return &result, nil
+}
+
 func decodeMetadataRole(role charm.RelationRole) (internalcharm.RelationRole, error) {
 	switch role {
 	case charm.RoleProvider:
@@ -419,6 +437,11 @@ func encodeMetadataRelation(relations map[string]internalcharm.Relation) (map[st
 			return nil, fmt.Errorf("encode scope: %w", err)
 		}
 
+		schema, err := encodeRelationSchema(v.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("encode schema: %w", err)
+		}
+
 		result[name] = charm.Relation{
 			Key:       name,
 			Name:      v.Name,
@@ -427,11 +450,24 @@ func encodeMetadataRelation(relations map[string]internalcharm.Relation) (map[st
 			Interface: v.Interface,
 			Optional:  v.Optional,
 			Limit:     v.Limit,
+			Schema:    schema,
 		}
 	}
 	return result, nil
 }
 
+func encodeRelationSchema(schema *internalcharm.RelationSchema) ([]byte, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	result, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+	return result, nil
+}
+
 func encodeMetadataRole(role internalcharm.RelationRole) (charm.RelationRole, error) {
 	switch role {
 	case internalcharm.RoleProvider:
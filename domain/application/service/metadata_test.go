@@ -174,6 +174,41 @@ var metadataTestCases = [...]struct {
 			},
 		},
 	},
+	{
+		name: "relation schema",
+		input: charm.Metadata{
+			Name:  "foo",
+			RunAs: charm.RunAsDefault,
+			Provides: map[string]charm.Relation{
+				"db": {
+					Key:       "db",
+					Name:      "db",
+					Role:      charm.RoleProvider,
+					Interface: "mysql",
+					Scope:     charm.ScopeGlobal,
+					Schema:    []byte(`{"app":{"required":["database"],"type":"object"},"unit":{"type":"object"}}`),
+				},
+			},
+		},
+		output: internalcharm.Meta{
+			Name: "foo",
+			Provides: map[string]internalcharm.Relation{
+				"db": {
+					Name:      "db",
+					Role:      internalcharm.RoleProvider,
+					Interface: "mysql",
+					Scope:     internalcharm.ScopeGlobal,
+					Schema: &internalcharm.RelationSchema{
+						Application: map[string]interface{}{
+							"type":     "object",
+							"required": []interface{}{"database"},
+						},
+						Unit: map[string]interface{}{"type": "object"},
+					},
+				},
+			},
+		},
+	},
 	{
 		name: "storage",
 		input: charm.Metadata{
@@ -401,6 +401,45 @@ func (c *MockStateDetachStorageForUnitCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetAllUnitPebbleCheckStatuses mocks base method.
+func (m *MockState) GetAllUnitPebbleCheckStatuses(arg0 context.Context) (map[unit.Name][]application0.PebbleCheckStatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUnitPebbleCheckStatuses", arg0)
+	ret0, _ := ret[0].(map[unit.Name][]application0.PebbleCheckStatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUnitPebbleCheckStatuses indicates an expected call of GetAllUnitPebbleCheckStatuses.
+func (mr *MockStateMockRecorder) GetAllUnitPebbleCheckStatuses(arg0 any) *MockStateGetAllUnitPebbleCheckStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUnitPebbleCheckStatuses", reflect.TypeOf((*MockState)(nil).GetAllUnitPebbleCheckStatuses), arg0)
+	return &MockStateGetAllUnitPebbleCheckStatusesCall{Call: call}
+}
+
+// MockStateGetAllUnitPebbleCheckStatusesCall wrap *gomock.Call
+type MockStateGetAllUnitPebbleCheckStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetAllUnitPebbleCheckStatusesCall) Return(arg0 map[unit.Name][]application0.PebbleCheckStatusInfo, arg1 error) *MockStateGetAllUnitPebbleCheckStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetAllUnitPebbleCheckStatusesCall) Do(f func(context.Context) (map[unit.Name][]application0.PebbleCheckStatusInfo, error)) *MockStateGetAllUnitPebbleCheckStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetAllUnitPebbleCheckStatusesCall) DoAndReturn(f func(context.Context) (map[unit.Name][]application0.PebbleCheckStatusInfo, error)) *MockStateGetAllUnitPebbleCheckStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationConfigAndSettings mocks base method.
 func (m *MockState) GetApplicationConfigAndSettings(ctx context.Context, appID application.ID) (map[string]application0.ApplicationConfig, application0.ApplicationSettings, error) {
 	m.ctrl.T.Helper()
@@ -1934,6 +1973,45 @@ func (c *MockStateGetUnitLifeCall) DoAndReturn(f func(context.Context, unit.Name
 	return c
 }
 
+// GetUnitPebbleCheckStatusHistory mocks base method.
+func (m *MockState) GetUnitPebbleCheckStatusHistory(arg0 context.Context, arg1 unit.UUID) ([]application0.PebbleCheckStatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitPebbleCheckStatusHistory", arg0, arg1)
+	ret0, _ := ret[0].([]application0.PebbleCheckStatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitPebbleCheckStatusHistory indicates an expected call of GetUnitPebbleCheckStatusHistory.
+func (mr *MockStateMockRecorder) GetUnitPebbleCheckStatusHistory(arg0, arg1 any) *MockStateGetUnitPebbleCheckStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitPebbleCheckStatusHistory", reflect.TypeOf((*MockState)(nil).GetUnitPebbleCheckStatusHistory), arg0, arg1)
+	return &MockStateGetUnitPebbleCheckStatusHistoryCall{Call: call}
+}
+
+// MockStateGetUnitPebbleCheckStatusHistoryCall wrap *gomock.Call
+type MockStateGetUnitPebbleCheckStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUnitPebbleCheckStatusHistoryCall) Return(arg0 []application0.PebbleCheckStatusInfo, arg1 error) *MockStateGetUnitPebbleCheckStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUnitPebbleCheckStatusHistoryCall) Do(f func(context.Context, unit.UUID) ([]application0.PebbleCheckStatusInfo, error)) *MockStateGetUnitPebbleCheckStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUnitPebbleCheckStatusHistoryCall) DoAndReturn(f func(context.Context, unit.UUID) ([]application0.PebbleCheckStatusInfo, error)) *MockStateGetUnitPebbleCheckStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitUUIDByName mocks base method.
 func (m *MockState) GetUnitUUIDByName(arg0 context.Context, arg1 unit.Name) (unit.UUID, error) {
 	m.ctrl.T.Helper()
@@ -2012,6 +2090,45 @@ func (c *MockStateGetUnitWorkloadStatusCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetUnitWorkloadStatusHistory mocks base method.
+func (m *MockState) GetUnitWorkloadStatusHistory(arg0 context.Context, arg1 unit.UUID) ([]application0.StatusInfo[application0.WorkloadStatusType], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitWorkloadStatusHistory", arg0, arg1)
+	ret0, _ := ret[0].([]application0.StatusInfo[application0.WorkloadStatusType])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitWorkloadStatusHistory indicates an expected call of GetUnitWorkloadStatusHistory.
+func (mr *MockStateMockRecorder) GetUnitWorkloadStatusHistory(arg0, arg1 any) *MockStateGetUnitWorkloadStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitWorkloadStatusHistory", reflect.TypeOf((*MockState)(nil).GetUnitWorkloadStatusHistory), arg0, arg1)
+	return &MockStateGetUnitWorkloadStatusHistoryCall{Call: call}
+}
+
+// MockStateGetUnitWorkloadStatusHistoryCall wrap *gomock.Call
+type MockStateGetUnitWorkloadStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUnitWorkloadStatusHistoryCall) Return(arg0 []application0.StatusInfo[application0.WorkloadStatusType], arg1 error) *MockStateGetUnitWorkloadStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUnitWorkloadStatusHistoryCall) Do(f func(context.Context, unit.UUID) ([]application0.StatusInfo[application0.WorkloadStatusType], error)) *MockStateGetUnitWorkloadStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUnitWorkloadStatusHistoryCall) DoAndReturn(f func(context.Context, unit.UUID) ([]application0.StatusInfo[application0.WorkloadStatusType], error)) *MockStateGetUnitWorkloadStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementApplicationConfigHash mocks base method.
 func (m *MockState) InitialWatchStatementApplicationConfigHash(appName string) (string, eventsource.NamespaceQuery) {
 	m.ctrl.T.Helper()
@@ -2859,18 +2976,57 @@ func (c *MockStateSetUnitPasswordCall) DoAndReturn(f func(context.Context, unit.
 	return c
 }
 
+// SetUnitPebbleCheckStatus mocks base method.
+func (m *MockState) SetUnitPebbleCheckStatus(ctx context.Context, unitUUID unit.UUID, check application0.PebbleCheckStatusInfo, historySize int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnitPebbleCheckStatus", ctx, unitUUID, check, historySize)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUnitPebbleCheckStatus indicates an expected call of SetUnitPebbleCheckStatus.
+func (mr *MockStateMockRecorder) SetUnitPebbleCheckStatus(ctx, unitUUID, check, historySize any) *MockStateSetUnitPebbleCheckStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitPebbleCheckStatus", reflect.TypeOf((*MockState)(nil).SetUnitPebbleCheckStatus), ctx, unitUUID, check, historySize)
+	return &MockStateSetUnitPebbleCheckStatusCall{Call: call}
+}
+
+// MockStateSetUnitPebbleCheckStatusCall wrap *gomock.Call
+type MockStateSetUnitPebbleCheckStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetUnitPebbleCheckStatusCall) Return(arg0 bool, arg1 error) *MockStateSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetUnitPebbleCheckStatusCall) Do(f func(context.Context, unit.UUID, application0.PebbleCheckStatusInfo, int) (bool, error)) *MockStateSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetUnitPebbleCheckStatusCall) DoAndReturn(f func(context.Context, unit.UUID, application0.PebbleCheckStatusInfo, int) (bool, error)) *MockStateSetUnitPebbleCheckStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetUnitWorkloadStatus mocks base method.
-func (m *MockState) SetUnitWorkloadStatus(arg0 context.Context, arg1 unit.UUID, arg2 *application0.StatusInfo[application0.WorkloadStatusType]) error {
+func (m *MockState) SetUnitWorkloadStatus(ctx context.Context, unitUUID unit.UUID, status *application0.StatusInfo[application0.WorkloadStatusType], historySize int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetUnitWorkloadStatus", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SetUnitWorkloadStatus", ctx, unitUUID, status, historySize)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetUnitWorkloadStatus indicates an expected call of SetUnitWorkloadStatus.
-func (mr *MockStateMockRecorder) SetUnitWorkloadStatus(arg0, arg1, arg2 any) *MockStateSetUnitWorkloadStatusCall {
+func (mr *MockStateMockRecorder) SetUnitWorkloadStatus(ctx, unitUUID, status, historySize any) *MockStateSetUnitWorkloadStatusCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitWorkloadStatus", reflect.TypeOf((*MockState)(nil).SetUnitWorkloadStatus), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitWorkloadStatus", reflect.TypeOf((*MockState)(nil).SetUnitWorkloadStatus), ctx, unitUUID, status, historySize)
 	return &MockStateSetUnitWorkloadStatusCall{Call: call}
 }
 
@@ -2886,13 +3042,13 @@ func (c *MockStateSetUnitWorkloadStatusCall) Return(arg0 error) *MockStateSetUni
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateSetUnitWorkloadStatusCall) Do(f func(context.Context, unit.UUID, *application0.StatusInfo[application0.WorkloadStatusType]) error) *MockStateSetUnitWorkloadStatusCall {
+func (c *MockStateSetUnitWorkloadStatusCall) Do(f func(context.Context, unit.UUID, *application0.StatusInfo[application0.WorkloadStatusType], int) error) *MockStateSetUnitWorkloadStatusCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateSetUnitWorkloadStatusCall) DoAndReturn(f func(context.Context, unit.UUID, *application0.StatusInfo[application0.WorkloadStatusType]) error) *MockStateSetUnitWorkloadStatusCall {
+func (c *MockStateSetUnitWorkloadStatusCall) DoAndReturn(f func(context.Context, unit.UUID, *application0.StatusInfo[application0.WorkloadStatusType], int) error) *MockStateSetUnitWorkloadStatusCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
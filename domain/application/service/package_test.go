@@ -162,3 +162,7 @@ func (c *changeEvent) Namespace() string {
 func (c *changeEvent) Changed() string {
 	return c.changed
 }
+
+func (c *changeEvent) ChangedColumns() []string {
+	return nil
+}
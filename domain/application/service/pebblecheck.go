@@ -0,0 +1,97 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juju/juju/core/quota"
+	corestatus "github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetUnitPebbleCheckStatus records the status of a Pebble check in one of the
+// specified unit's workload containers. Transitions between statuses are kept
+// in the check's status history. A status older than the one already
+// recorded for the check is ignored. It returns whether the check changed
+// status.
+// If the check is not valid, an error satisfying
+// [applicationerrors.PebbleCheckNotValid] is returned. If the unit doesn't
+// exist, an error satisfying [applicationerrors.UnitNotFound] is returned.
+func (s *Service) SetUnitPebbleCheckStatus(ctx context.Context, unitName coreunit.Name, check application.PebbleCheckStatusInfo) (bool, error) {
+	if err := unitName.Validate(); err != nil {
+		return false, errors.Capture(err)
+	}
+	if check.ContainerName == "" {
+		return false, errors.Errorf("empty container name").Add(applicationerrors.PebbleCheckNotValid)
+	}
+	if check.CheckName == "" {
+		return false, errors.Errorf("empty check name").Add(applicationerrors.PebbleCheckNotValid)
+	}
+	if check.Status.String() == "" {
+		return false, errors.Errorf("unknown status %d", check.Status).Add(applicationerrors.PebbleCheckNotValid)
+	}
+	if check.Since.IsZero() {
+		check.Since = s.clock.Now()
+	}
+
+	unitUUID, err := s.st.GetUnitUUIDByName(ctx, unitName)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	return s.st.SetUnitPebbleCheckStatus(ctx, unitUUID, check, quota.MaxPebbleCheckStatusHistory)
+}
+
+// GetAllUnitPebbleCheckStatuses returns the latest status of the Pebble checks
+// in the workload containers of every unit in the model, keyed by unit name.
+func (s *Service) GetAllUnitPebbleCheckStatuses(ctx context.Context) (map[coreunit.Name][]application.PebbleCheckStatusInfo, error) {
+	statuses, err := s.st.GetAllUnitPebbleCheckStatuses(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return statuses, nil
+}
+
+// GetUnitPebbleCheckStatusHistory returns the transitions between statuses of
+// the Pebble checks in the specified unit's workload containers which match
+// the filter, oldest first. The filter's Exclude values are matched against
+// the transitions' messages.
+// If the unit doesn't exist, an error satisfying
+// [applicationerrors.UnitNotFound] is returned.
+func (s *Service) GetUnitPebbleCheckStatusHistory(
+	ctx context.Context, unitName coreunit.Name, filter corestatus.StatusHistoryFilter,
+) ([]application.PebbleCheckStatusInfo, error) {
+	if err := unitName.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	unitUUID, err := s.st.GetUnitUUIDByName(ctx, unitName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	history, err := s.st.GetUnitPebbleCheckStatusHistory(ctx, unitUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	from := filter.FromDate
+	if filter.Delta != nil {
+		t := s.clock.Now().Add(-*filter.Delta)
+		from = &t
+	}
+	history = slices.DeleteFunc(history, func(h application.PebbleCheckStatusInfo) bool {
+		return (from != nil && h.Since.Before(*from)) || filter.Exclude.Contains(h.Message)
+	})
+	if filter.Size > 0 && len(history) > filter.Size {
+		history = history[len(history)-filter.Size:]
+	}
+	return history, nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/collections/set"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/quota"
+	corestatus "github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
+	unittesting "github.com/juju/juju/core/unit/testing"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+)
+
+func (s *applicationServiceSuite) TestSetUnitPebbleCheckStatus(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	check := application.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusDown,
+		Message:       "connection refused",
+		Critical:      true,
+	}
+	expected := check
+	expected.Since = s.clock.Now()
+
+	unitUUID := unittesting.GenUnitUUID(c)
+	s.state.EXPECT().GetUnitUUIDByName(gomock.Any(), coreunit.Name("foo/666")).Return(unitUUID, nil)
+	s.state.EXPECT().SetUnitPebbleCheckStatus(gomock.Any(), unitUUID, expected, quota.MaxPebbleCheckStatusHistory).Return(true, nil)
+
+	changed, err := s.service.SetUnitPebbleCheckStatus(context.Background(), coreunit.Name("foo/666"), check)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changed, jc.IsTrue)
+}
+
+func (s *applicationServiceSuite) TestSetUnitPebbleCheckStatusUnitNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnitUUIDByName(gomock.Any(), coreunit.Name("foo/666")).Return("", applicationerrors.UnitNotFound)
+
+	_, err := s.service.SetUnitPebbleCheckStatus(context.Background(), coreunit.Name("foo/666"), application.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusUp,
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *applicationServiceSuite) TestSetUnitPebbleCheckStatusNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	for _, check := range []application.PebbleCheckStatusInfo{{
+		CheckName: "online",
+		Status:    application.PebbleCheckStatusUp,
+	}, {
+		ContainerName: "redis",
+		Status:        application.PebbleCheckStatusUp,
+	}, {
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusType(42),
+	}} {
+		_, err := s.service.SetUnitPebbleCheckStatus(context.Background(), coreunit.Name("foo/666"), check)
+		c.Check(err, jc.ErrorIs, applicationerrors.PebbleCheckNotValid)
+	}
+}
+
+func (s *applicationServiceSuite) TestGetUnitPebbleCheckStatusHistory(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	history := []application.PebbleCheckStatusInfo{{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusDown,
+		Message:       "connection refused",
+		Since:         now.Add(-2 * time.Hour),
+	}, {
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusUp,
+		Since:         now.Add(-time.Hour),
+	}, {
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusDown,
+		Message:       "timed out",
+		Since:         now.Add(-time.Minute),
+	}}
+
+	unitUUID := unittesting.GenUnitUUID(c)
+	s.state.EXPECT().GetUnitUUIDByName(gomock.Any(), coreunit.Name("foo/666")).Return(unitUUID, nil).Times(3)
+	s.state.EXPECT().GetUnitPebbleCheckStatusHistory(gomock.Any(), unitUUID).DoAndReturn(
+		func(context.Context, coreunit.UUID) ([]application.PebbleCheckStatusInfo, error) {
+			return append([]application.PebbleCheckStatusInfo(nil), history...), nil
+		},
+	).Times(3)
+
+	obtained, err := s.service.GetUnitPebbleCheckStatusHistory(context.Background(), coreunit.Name("foo/666"), corestatus.StatusHistoryFilter{
+		Size: 2,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, jc.DeepEquals, history[1:])
+
+	delta := 90 * time.Minute
+	obtained, err = s.service.GetUnitPebbleCheckStatusHistory(context.Background(), coreunit.Name("foo/666"), corestatus.StatusHistoryFilter{
+		Delta: &delta,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, jc.DeepEquals, history[1:])
+
+	obtained, err = s.service.GetUnitPebbleCheckStatusHistory(context.Background(), coreunit.Name("foo/666"), corestatus.StatusHistoryFilter{
+		Size:    10,
+		Exclude: set.NewStrings("timed out"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, jc.DeepEquals, history[:2])
+}
+
+func (s *applicationServiceSuite) TestGetUnitPebbleCheckStatusHistoryInvalidFilter(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetUnitPebbleCheckStatusHistory(context.Background(), coreunit.Name("foo/666"), corestatus.StatusHistoryFilter{})
+	c.Assert(err, gc.ErrorMatches, "missing filter parameters not valid")
+}
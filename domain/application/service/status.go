@@ -66,6 +66,8 @@ func encodeWorkloadStatusType(s status.Status) (application.WorkloadStatusType,
 		return application.WorkloadStatusActive, nil
 	case status.Terminated:
 		return application.WorkloadStatusTerminated, nil
+	case status.Error:
+		return application.WorkloadStatusError, nil
 	default:
 		return -1, errors.Errorf("unknown workload status %q", s)
 	}
@@ -89,6 +91,8 @@ func decodeUnitWorkloadStatusType(s application.WorkloadStatusType) (status.Stat
 		return status.Active, nil
 	case application.WorkloadStatusTerminated:
 		return status.Terminated, nil
+	case application.WorkloadStatusError:
+		return status.Error, nil
 	default:
 		return "", errors.Errorf("unknown workload status %q", s)
 	}
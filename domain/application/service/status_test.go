@@ -76,6 +76,14 @@ func (s *statusSuite) TestEncodeWorkloadStatus(c *gc.C) {
 				Status: application.WorkloadStatusTerminated,
 			},
 		},
+		{
+			input: &status.StatusInfo{
+				Status: status.Error,
+			},
+			output: &application.StatusInfo[application.WorkloadStatusType]{
+				Status: application.WorkloadStatusError,
+			},
+		},
 		{
 			input: &status.StatusInfo{
 				Status:  status.Active,
@@ -34,6 +34,7 @@ import (
 	secretstate "github.com/juju/juju/domain/secret/state"
 	domaintesting "github.com/juju/juju/domain/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/internal/storage/provider"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -56,7 +57,9 @@ func ptr[T any](v T) *T {
 func (s *serviceSuite) SetUpTest(c *gc.C) {
 	s.ModelSuite.SetUpTest(c)
 
-	s.secretState = secretstate.NewState(func() (database.TxnRunner, error) { return s.ModelTxnRunner(), nil }, loggertesting.WrapCheckLog(c))
+	keys, err := envelope.NewKeyRing([]byte("0123456789abcdef0123456789abcdef"))
+	c.Assert(err, jc.ErrorIsNil)
+	s.secretState = secretstate.NewState(func() (database.TxnRunner, error) { return s.ModelTxnRunner(), nil }, keys, loggertesting.WrapCheckLog(c))
 	s.svc = service.NewService(
 		state.NewState(func() (database.TxnRunner, error) { return s.ModelTxnRunner(), nil }, clock.WallClock, loggertesting.WrapCheckLog(c)),
 		domaintesting.NoopLeaderEnsurer(),
@@ -69,7 +72,7 @@ func (s *serviceSuite) SetUpTest(c *gc.C) {
 	)
 
 	modelUUID := uuid.MustNewUUID()
-	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
 		_, err := tx.ExecContext(ctx, `
 			INSERT INTO model (uuid, controller_uuid, target_agent_version, name, type, cloud, cloud_type)
 			VALUES (?, ?, ?, "test", "iaas", "test-model", "ec2")
@@ -296,6 +296,15 @@ WHERE application_uuid = $applicationDetails.uuid
 		return jujuerrors.Trace(err)
 	}
 
+	// Likewise, remove any pins of the application to secret revisions.
+	deleteSecretRevisionPinStmt, err := st.Prepare(`
+DELETE FROM secret_revision_pin
+WHERE application_uuid = $applicationDetails.uuid
+`, app)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+
 	deleteApplicationStmt, err := st.Prepare(`DELETE FROM application WHERE name = $applicationDetails.name`, app)
 	if err != nil {
 		return jujuerrors.Trace(err)
@@ -320,6 +329,9 @@ WHERE application_uuid = $applicationDetails.uuid
 	if err := tx.Query(ctx, deleteSecretOwnerStmt, app).Run(); err != nil {
 		return errors.Errorf("deleting secret owner for application %q: %w", name, err)
 	}
+	if err := tx.Query(ctx, deleteSecretRevisionPinStmt, app).Run(); err != nil {
+		return errors.Errorf("deleting secret revision pins for application %q: %w", name, err)
+	}
 
 	if err := st.deleteCloudServices(ctx, tx, appUUID); err != nil {
 		return errors.Errorf("deleting cloud service for application %q: %w", name, err)
@@ -594,23 +606,118 @@ SELECT &statusInfo.* FROM unit_workload_status WHERE unit_uuid = $unitUUID.uuid
 	}, nil
 }
 
-// SetUnitWorkloadStatus updates the workload status of the specified unit, returning an error
-// satisfying [applicationerrors.UnitNotFound] if the unit doesn't exist.
-func (st *State) SetUnitWorkloadStatus(ctx context.Context, unitUUID coreunit.UUID, status *application.StatusInfo[application.WorkloadStatusType]) error {
+// SetUnitWorkloadStatus updates the workload status of the specified unit,
+// adding it to the unit's workload status history, which is pruned to the
+// most recent historySize entries. It returns an error satisfying
+// [applicationerrors.UnitNotFound] if the unit doesn't exist.
+func (st *State) SetUnitWorkloadStatus(
+	ctx context.Context, uuid coreunit.UUID, status *application.StatusInfo[application.WorkloadStatusType], historySize int,
+) error {
 	db, err := st.DB()
 	if err != nil {
 		return jujuerrors.Trace(err)
 	}
 
+	statusID, err := encodeWorkloadStatus(status.Status)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+	entry := unitStatusInfo{
+		UnitUUID:  uuid,
+		StatusID:  statusID,
+		Message:   status.Message,
+		Data:      status.Data,
+		UpdatedAt: status.Since,
+	}
+	unit := unitUUID{UnitUUID: uuid}
+	limit := historyLimit{Limit: historySize}
+
+	insertHistoryStmt, err := st.Prepare(`
+INSERT INTO unit_workload_status_history (*) VALUES ($unitStatusInfo.*)
+`, entry)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+
+	pruneHistoryStmt, err := st.Prepare(`
+DELETE FROM unit_workload_status_history
+WHERE unit_uuid = $unitUUID.uuid
+AND rowid NOT IN (
+    SELECT rowid
+    FROM unit_workload_status_history
+    WHERE unit_uuid = $unitUUID.uuid
+    ORDER BY updated_at DESC, rowid DESC
+    LIMIT $historyLimit.limit
+)`, unit, limit)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		return st.setUnitWorkloadStatus(ctx, tx, unitUUID, status)
+		if err := st.setUnitWorkloadStatus(ctx, tx, uuid, status); err != nil {
+			return err
+		}
+		if err := tx.Query(ctx, insertHistoryStmt, entry).Run(); err != nil {
+			return errors.Errorf("recording workload status history: %w", err)
+		}
+		if err := tx.Query(ctx, pruneHistoryStmt, unit, limit).Run(); err != nil {
+			return errors.Errorf("pruning workload status history: %w", err)
+		}
+		return nil
 	})
 	if err != nil {
-		return errors.Errorf("setting workload status for unit %q: %w", unitUUID, err)
+		return errors.Errorf("setting workload status for unit %q: %w", uuid, err)
 	}
 	return nil
 }
 
+// GetUnitWorkloadStatusHistory returns the changes to the workload status of
+// the specified unit, oldest first.
+func (st *State) GetUnitWorkloadStatusHistory(ctx context.Context, uuid coreunit.UUID) ([]application.StatusInfo[application.WorkloadStatusType], error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+
+	unit := unitUUID{UnitUUID: uuid}
+	stmt, err := st.Prepare(`
+SELECT &statusInfo.*
+FROM unit_workload_status_history
+WHERE unit_uuid = $unitUUID.uuid
+ORDER BY updated_at, rowid
+`, statusInfo{}, unit)
+	if err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+
+	var rows []statusInfo
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, unit).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting workload status history for unit %q: %w", uuid, err)
+	}
+
+	result := make([]application.StatusInfo[application.WorkloadStatusType], len(rows))
+	for i, row := range rows {
+		statusID, err := decodeWorkloadStatus(row.StatusID)
+		if err != nil {
+			return nil, errors.Errorf("decoding workload status ID for unit %q: %w", uuid, err)
+		}
+		result[i] = application.StatusInfo[application.WorkloadStatusType]{
+			Status:  statusID,
+			Message: row.Message,
+			Data:    row.Data,
+			Since:   row.UpdatedAt,
+		}
+	}
+	return result, nil
+}
+
 func makeCloudContainerArg(unitName coreunit.Name, cloudContainer application.CloudContainerParams) *application.CloudContainer {
 	result := &application.CloudContainer{
 		ProviderID: cloudContainer.ProviderID,
@@ -1328,8 +1435,12 @@ func (st *State) deleteSimpleUnitReferences(ctx context.Context, tx *sqlair.TX,
 		"unit_state",
 		"unit_state_charm",
 		"unit_state_relation",
+		"unit_workload_metric",
 		"unit_agent_status",
 		"unit_workload_status",
+		"unit_workload_status_history",
+		"unit_pebble_check_status",
+		"unit_pebble_check_status_history",
 		"cloud_container_status",
 	} {
 		deleteUnitReference := fmt.Sprintf(`DELETE FROM %s WHERE unit_uuid = $minimalUnit.uuid`, table)
@@ -1706,7 +1706,7 @@ func (s *applicationStateSuite) TestSetWorkloadStatus(c *gc.C) {
 		Since:   ptr(time.Now()),
 	}
 
-	err = s.state.SetUnitWorkloadStatus(context.Background(), unitUUID, status)
+	err = s.state.SetUnitWorkloadStatus(context.Background(), unitUUID, status, 10)
 	c.Assert(err, jc.ErrorIsNil)
 
 	gotStatus, err := s.state.GetUnitWorkloadStatus(context.Background(), unitUUID)
@@ -1722,7 +1722,7 @@ func (s *applicationStateSuite) TestSetWorkloadStatus(c *gc.C) {
 		Since:   ptr(time.Now()),
 	}
 
-	err = s.state.SetUnitWorkloadStatus(context.Background(), unitUUID, status)
+	err = s.state.SetUnitWorkloadStatus(context.Background(), unitUUID, status, 10)
 	c.Assert(err, jc.ErrorIsNil)
 
 	gotStatus, err = s.state.GetUnitWorkloadStatus(context.Background(), unitUUID)
@@ -1738,10 +1738,44 @@ func (s *applicationStateSuite) TestSetUnitWorkloadStatusNotFound(c *gc.C) {
 		Since:   ptr(time.Now()),
 	}
 
-	err := s.state.SetUnitWorkloadStatus(context.Background(), "missing-uuid", &status)
+	err := s.state.SetUnitWorkloadStatus(context.Background(), "missing-uuid", &status, 10)
 	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
 }
 
+func (s *applicationStateSuite) TestGetUnitWorkloadStatusHistory(c *gc.C) {
+	u1 := application.InsertUnitArg{
+		UnitName: "foo/666",
+	}
+	s.createApplication(c, "foo", life.Alive, u1)
+
+	unitUUID, err := s.state.GetUnitUUIDByName(context.Background(), u1.UnitName)
+	c.Assert(err, jc.ErrorIsNil)
+
+	now := time.Now().UTC()
+	var statuses []application.StatusInfo[application.WorkloadStatusType]
+	for i, st := range []application.WorkloadStatusType{
+		application.WorkloadStatusActive,
+		application.WorkloadStatusError,
+		application.WorkloadStatusActive,
+	} {
+		status := application.StatusInfo[application.WorkloadStatusType]{
+			Status:  st,
+			Message: fmt.Sprintf("status %d", i),
+			Since:   ptr(now.Add(time.Duration(i) * time.Second)),
+		}
+		err = s.state.SetUnitWorkloadStatus(context.Background(), unitUUID, &status, 2)
+		c.Assert(err, jc.ErrorIsNil)
+		statuses = append(statuses, status)
+	}
+
+	history, err := s.state.GetUnitWorkloadStatusHistory(context.Background(), unitUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 2)
+	for i, h := range history {
+		assertStatusInfoEqual(c, &h, &statuses[i+1])
+	}
+}
+
 func (s *applicationStateSuite) TestGetApplicationScaleState(c *gc.C) {
 	u := application.InsertUnitArg{
 		UnitName: "foo/666",
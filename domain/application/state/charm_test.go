@@ -1921,10 +1921,11 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithRelations(c *gc.C)
 				Scope: charm.ScopeGlobal,
 			},
 			"fred": {
-				Key:   "fred",
-				Name:  "bar",
-				Role:  charm.RoleProvider,
-				Scope: charm.ScopeContainer,
+				Key:    "fred",
+				Name:   "bar",
+				Role:   charm.RoleProvider,
+				Scope:  charm.ScopeContainer,
+				Schema: []byte(`{"unit":{"type":"object"}}`),
 			},
 		},
 		Requires: map[string]charm.Relation{
@@ -152,6 +152,7 @@ func decodeRelations(relations []charmRelation) (map[string]charm.Relation, map[
 			Limit:     relation.Capacity,
 			Role:      role,
 			Scope:     scope,
+			Schema:    relation.Schema,
 		}, nil
 	}
 
@@ -508,6 +509,7 @@ func encodeRelation(id corecharm.ID, kind string, relation charm.Relation) (setC
 		Optional:  relation.Optional,
 		Capacity:  relation.Limit,
 		ScopeID:   scopeID,
+		Schema:    relation.Schema,
 	}, nil
 }
 
@@ -0,0 +1,251 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetUnitPebbleCheckStatus records the status of a Pebble check in one of the
+// specified unit's workload containers. A status older than the one already
+// recorded for the check is ignored. When the status changes, the transition
+// is added to the check's history, which is pruned to the most recent
+// historySize entries, and true is returned.
+// If the unit doesn't exist, an error satisfying
+// [applicationerrors.UnitNotFound] is returned.
+func (st *State) SetUnitPebbleCheckStatus(
+	ctx context.Context, unitUUID coreunit.UUID, check application.PebbleCheckStatusInfo, historySize int,
+) (bool, error) {
+	db, err := st.DB()
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	statusID, err := encodePebbleCheckStatus(check.Status)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	key := pebbleCheckKey{
+		UnitUUID:      unitUUID,
+		ContainerName: check.ContainerName,
+		CheckName:     check.CheckName,
+	}
+	current := pebbleCheckStatus{
+		UnitUUID:      unitUUID,
+		ContainerName: check.ContainerName,
+		CheckName:     check.CheckName,
+		StatusID:      statusID,
+		Message:       check.Message,
+		Critical:      check.Critical,
+		UpdatedAt:     check.Since.UTC(),
+	}
+	transition := pebbleCheckStatusHistory{
+		UnitUUID:      unitUUID,
+		ContainerName: check.ContainerName,
+		CheckName:     check.CheckName,
+		StatusID:      statusID,
+		Message:       check.Message,
+		UpdatedAt:     check.Since.UTC(),
+	}
+	limit := historyLimit{Limit: historySize}
+
+	getStmt, err := st.Prepare(`
+SELECT &pebbleCheckStatus.*
+FROM unit_pebble_check_status
+WHERE unit_uuid = $pebbleCheckKey.unit_uuid
+AND container_name = $pebbleCheckKey.container_name
+AND check_name = $pebbleCheckKey.check_name
+`, current, key)
+	if err != nil {
+		return false, errors.Errorf("preparing pebble check status query: %w", err)
+	}
+
+	upsertStmt, err := st.Prepare(`
+INSERT INTO unit_pebble_check_status (*) VALUES ($pebbleCheckStatus.*)
+ON CONFLICT(unit_uuid, container_name, check_name) DO UPDATE SET
+    status_id = excluded.status_id,
+    message = excluded.message,
+    critical = excluded.critical,
+    updated_at = excluded.updated_at
+`, current)
+	if err != nil {
+		return false, errors.Errorf("preparing set pebble check status statement: %w", err)
+	}
+
+	insertHistoryStmt, err := st.Prepare(`
+INSERT INTO unit_pebble_check_status_history (*) VALUES ($pebbleCheckStatusHistory.*)
+`, transition)
+	if err != nil {
+		return false, errors.Errorf("preparing insert pebble check history statement: %w", err)
+	}
+
+	pruneHistoryStmt, err := st.Prepare(`
+DELETE FROM unit_pebble_check_status_history
+WHERE unit_uuid = $pebbleCheckKey.unit_uuid
+AND container_name = $pebbleCheckKey.container_name
+AND check_name = $pebbleCheckKey.check_name
+AND rowid NOT IN (
+    SELECT rowid
+    FROM unit_pebble_check_status_history
+    WHERE unit_uuid = $pebbleCheckKey.unit_uuid
+    AND container_name = $pebbleCheckKey.container_name
+    AND check_name = $pebbleCheckKey.check_name
+    ORDER BY updated_at DESC, rowid DESC
+    LIMIT $historyLimit.limit
+)`, key, limit)
+	if err != nil {
+		return false, errors.Errorf("preparing prune pebble check history statement: %w", err)
+	}
+
+	var changed bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		changed = false
+		var existing pebbleCheckStatus
+		err := tx.Query(ctx, getStmt, key).Get(&existing)
+		found := err == nil
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting status of pebble check %q: %w", check.CheckName, err)
+		}
+		if found && !current.UpdatedAt.After(existing.UpdatedAt) {
+			// A status which has been superseded, for example one replayed
+			// from an old Pebble notice.
+			return nil
+		}
+
+		err = tx.Query(ctx, upsertStmt, current).Run()
+		if internaldatabase.IsErrConstraintForeignKey(err) {
+			return errors.Errorf("%w: %q", applicationerrors.UnitNotFound, unitUUID)
+		} else if err != nil {
+			return errors.Errorf("setting status of pebble check %q: %w", check.CheckName, err)
+		}
+
+		if found && existing.StatusID == statusID {
+			return nil
+		}
+		if err := tx.Query(ctx, insertHistoryStmt, transition).Run(); err != nil {
+			return errors.Errorf("recording history of pebble check %q: %w", check.CheckName, err)
+		}
+		if err := tx.Query(ctx, pruneHistoryStmt, key, limit).Run(); err != nil {
+			return errors.Errorf("pruning history of pebble check %q: %w", check.CheckName, err)
+		}
+		changed = true
+		return nil
+	})
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	return changed, nil
+}
+
+// GetAllUnitPebbleCheckStatuses returns the latest status of the Pebble checks
+// in the workload containers of every unit in the model, keyed by unit name
+// and ordered by container and check name.
+func (st *State) GetAllUnitPebbleCheckStatuses(ctx context.Context) (map[coreunit.Name][]application.PebbleCheckStatusInfo, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT u.name AS &unitPebbleCheckStatus.unit_name,
+       c.container_name AS &unitPebbleCheckStatus.container_name,
+       c.check_name AS &unitPebbleCheckStatus.check_name,
+       c.status_id AS &unitPebbleCheckStatus.status_id,
+       c.message AS &unitPebbleCheckStatus.message,
+       c.critical AS &unitPebbleCheckStatus.critical,
+       c.updated_at AS &unitPebbleCheckStatus.updated_at
+FROM unit_pebble_check_status AS c
+JOIN unit AS u ON c.unit_uuid = u.uuid
+ORDER BY u.name, c.container_name, c.check_name
+`, unitPebbleCheckStatus{})
+	if err != nil {
+		return nil, errors.Errorf("preparing pebble check statuses query: %w", err)
+	}
+
+	var rows []unitPebbleCheckStatus
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting pebble check statuses: %w", err)
+	}
+
+	result := make(map[coreunit.Name][]application.PebbleCheckStatusInfo)
+	for _, row := range rows {
+		status, err := decodePebbleCheckStatus(row.StatusID)
+		if err != nil {
+			return nil, errors.Errorf("decoding status of pebble check %q: %w", row.CheckName, err)
+		}
+		result[row.UnitName] = append(result[row.UnitName], application.PebbleCheckStatusInfo{
+			ContainerName: row.ContainerName,
+			CheckName:     row.CheckName,
+			Status:        status,
+			Message:       row.Message,
+			Critical:      row.Critical,
+			Since:         row.UpdatedAt.UTC(),
+		})
+	}
+	return result, nil
+}
+
+// GetUnitPebbleCheckStatusHistory returns the transitions between statuses of
+// the Pebble checks in the specified unit's workload containers, oldest first.
+func (st *State) GetUnitPebbleCheckStatusHistory(ctx context.Context, uuid coreunit.UUID) ([]application.PebbleCheckStatusInfo, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	unit := unitUUID{UnitUUID: uuid}
+	stmt, err := st.Prepare(`
+SELECT &pebbleCheckStatusHistory.*
+FROM unit_pebble_check_status_history
+WHERE unit_uuid = $unitUUID.uuid
+ORDER BY updated_at, rowid
+`, pebbleCheckStatusHistory{}, unit)
+	if err != nil {
+		return nil, errors.Errorf("preparing pebble check history query: %w", err)
+	}
+
+	var rows []pebbleCheckStatusHistory
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, unit).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting pebble check history of unit %q: %w", uuid, err)
+	}
+
+	result := make([]application.PebbleCheckStatusInfo, len(rows))
+	for i, row := range rows {
+		status, err := decodePebbleCheckStatus(row.StatusID)
+		if err != nil {
+			return nil, errors.Errorf("decoding status of pebble check %q: %w", row.CheckName, err)
+		}
+		result[i] = application.PebbleCheckStatusInfo{
+			ContainerName: row.ContainerName,
+			CheckName:     row.CheckName,
+			Status:        status,
+			Message:       row.Message,
+			Since:         row.UpdatedAt.UTC(),
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+)
+
+func (s *applicationStateSuite) TestSetUnitPebbleCheckStatus(c *gc.C) {
+	u := application.InsertUnitArg{
+		UnitName: "foo/666",
+	}
+	s.createApplication(c, "foo", life.Alive, u)
+	unitUUID, err := s.state.GetUnitUUIDByName(context.Background(), u.UnitName)
+	c.Assert(err, jc.ErrorIsNil)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	down := application.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusDown,
+		Message:       "connection refused",
+		Critical:      true,
+		Since:         now,
+	}
+	changed, err := s.state.SetUnitPebbleCheckStatus(context.Background(), unitUUID, down, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changed, jc.IsTrue)
+
+	// The same status again only updates the message.
+	stillDown := down
+	stillDown.Message = "timed out"
+	stillDown.Since = now.Add(time.Minute)
+	changed, err = s.state.SetUnitPebbleCheckStatus(context.Background(), unitUUID, stillDown, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changed, jc.IsFalse)
+
+	up := application.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusUp,
+		Critical:      true,
+		Since:         now.Add(2 * time.Minute),
+	}
+	changed, err = s.state.SetUnitPebbleCheckStatus(context.Background(), unitUUID, up, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changed, jc.IsTrue)
+
+	// A superseded status is ignored.
+	changed, err = s.state.SetUnitPebbleCheckStatus(context.Background(), unitUUID, down, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changed, jc.IsFalse)
+
+	statuses, err := s.state.GetAllUnitPebbleCheckStatuses(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(statuses, jc.DeepEquals, map[coreunit.Name][]application.PebbleCheckStatusInfo{
+		"foo/666": {up},
+	})
+
+	history, err := s.state.GetUnitPebbleCheckStatusHistory(context.Background(), unitUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, jc.DeepEquals, []application.PebbleCheckStatusInfo{{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusDown,
+		Message:       "connection refused",
+		Since:         now,
+	}, {
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusUp,
+		Since:         now.Add(2 * time.Minute),
+	}})
+}
+
+func (s *applicationStateSuite) TestSetUnitPebbleCheckStatusPrunesHistory(c *gc.C) {
+	u := application.InsertUnitArg{
+		UnitName: "foo/666",
+	}
+	s.createApplication(c, "foo", life.Alive, u)
+	unitUUID, err := s.state.GetUnitUUIDByName(context.Background(), u.UnitName)
+	c.Assert(err, jc.ErrorIsNil)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	other := application.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "ready",
+		Status:        application.PebbleCheckStatusDown,
+		Since:         now,
+	}
+	_, err = s.state.SetUnitPebbleCheckStatus(context.Background(), unitUUID, other, 2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	for i, status := range []application.PebbleCheckStatusType{
+		application.PebbleCheckStatusDown,
+		application.PebbleCheckStatusUp,
+		application.PebbleCheckStatusDown,
+	} {
+		_, err := s.state.SetUnitPebbleCheckStatus(context.Background(), unitUUID, application.PebbleCheckStatusInfo{
+			ContainerName: "redis",
+			CheckName:     "online",
+			Status:        status,
+			Since:         now.Add(time.Duration(i+1) * time.Minute),
+		}, 2)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	history, err := s.state.GetUnitPebbleCheckStatusHistory(context.Background(), unitUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, jc.DeepEquals, []application.PebbleCheckStatusInfo{
+		other,
+		{
+			ContainerName: "redis",
+			CheckName:     "online",
+			Status:        application.PebbleCheckStatusUp,
+			Since:         now.Add(2 * time.Minute),
+		}, {
+			ContainerName: "redis",
+			CheckName:     "online",
+			Status:        application.PebbleCheckStatusDown,
+			Since:         now.Add(3 * time.Minute),
+		},
+	})
+}
+
+func (s *applicationStateSuite) TestSetUnitPebbleCheckStatusUnitNotFound(c *gc.C) {
+	_, err := s.state.SetUnitPebbleCheckStatus(context.Background(), "missing-uuid", application.PebbleCheckStatusInfo{
+		ContainerName: "redis",
+		CheckName:     "online",
+		Status:        application.PebbleCheckStatusDown,
+		Since:         time.Now(),
+	}, 10)
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *applicationStateSuite) TestGetAllUnitPebbleCheckStatusesNone(c *gc.C) {
+	statuses, err := s.state.GetAllUnitPebbleCheckStatuses(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(statuses, gc.HasLen, 0)
+}
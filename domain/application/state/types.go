@@ -376,6 +376,7 @@ type charmRelation struct {
 	Optional  bool   `db:"optional"`
 	Capacity  int    `db:"capacity"`
 	Scope     string `db:"scope"`
+	Schema    []byte `db:"schema"`
 }
 
 // setCharmRelation is used to set the relations of a charm.
@@ -390,6 +391,7 @@ type setCharmRelation struct {
 	Optional  bool   `db:"optional"`
 	Capacity  int    `db:"capacity"`
 	ScopeID   int    `db:"scope_id"`
+	Schema    []byte `db:"schema"`
 }
 
 // charmExtraBinding is used to get the extra bindings of a charm.
@@ -860,6 +862,8 @@ func encodeWorkloadStatus(s application.WorkloadStatusType) (int, error) {
 		return 5, nil
 	case application.WorkloadStatusTerminated:
 		return 6, nil
+	case application.WorkloadStatusError:
+		return 7, nil
 	default:
 		return -1, errors.Errorf("unknown status %q", s)
 	}
@@ -881,6 +885,8 @@ func decodeWorkloadStatus(s int) (application.WorkloadStatusType, error) {
 		return application.WorkloadStatusActive, nil
 	case 6:
 		return application.WorkloadStatusTerminated, nil
+	case 7:
+		return application.WorkloadStatusError, nil
 	default:
 		return -1, errors.Errorf("unknown status %d", s)
 	}
@@ -890,3 +896,72 @@ type storageInstance struct {
 	StorageUUID corestorage.UUID `db:"uuid"`
 	StorageID   corestorage.ID   `db:"storage_id"`
 }
+
+// pebbleCheckKey identifies a Pebble check in one of a unit's workload
+// containers.
+type pebbleCheckKey struct {
+	UnitUUID      coreunit.UUID `db:"unit_uuid"`
+	ContainerName string        `db:"container_name"`
+	CheckName     string        `db:"check_name"`
+}
+
+// pebbleCheckStatus is a row in the unit_pebble_check_status table.
+type pebbleCheckStatus struct {
+	UnitUUID      coreunit.UUID `db:"unit_uuid"`
+	ContainerName string        `db:"container_name"`
+	CheckName     string        `db:"check_name"`
+	StatusID      int           `db:"status_id"`
+	Message       string        `db:"message"`
+	Critical      bool          `db:"critical"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+}
+
+// pebbleCheckStatusHistory is a row in the unit_pebble_check_status_history
+// table.
+type pebbleCheckStatusHistory struct {
+	UnitUUID      coreunit.UUID `db:"unit_uuid"`
+	ContainerName string        `db:"container_name"`
+	CheckName     string        `db:"check_name"`
+	StatusID      int           `db:"status_id"`
+	Message       string        `db:"message"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+}
+
+// unitPebbleCheckStatus is the status of a Pebble check along with the name
+// of the unit it belongs to.
+type unitPebbleCheckStatus struct {
+	UnitName      coreunit.Name `db:"unit_name"`
+	ContainerName string        `db:"container_name"`
+	CheckName     string        `db:"check_name"`
+	StatusID      int           `db:"status_id"`
+	Message       string        `db:"message"`
+	Critical      bool          `db:"critical"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+}
+
+// historyLimit is the number of status history entries kept for each check.
+type historyLimit struct {
+	Limit int `db:"limit"`
+}
+
+func encodePebbleCheckStatus(s application.PebbleCheckStatusType) (int, error) {
+	switch s {
+	case application.PebbleCheckStatusUp:
+		return 0, nil
+	case application.PebbleCheckStatusDown:
+		return 1, nil
+	default:
+		return -1, errors.Errorf("unknown status %q", s)
+	}
+}
+
+func decodePebbleCheckStatus(s int) (application.PebbleCheckStatusType, error) {
+	switch s {
+	case 0:
+		return application.PebbleCheckStatusUp, nil
+	case 1:
+		return application.PebbleCheckStatusDown, nil
+	default:
+		return -1, errors.Errorf("unknown status %d", s)
+	}
+}
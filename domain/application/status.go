@@ -5,6 +5,8 @@ package application
 
 import (
 	"time"
+
+	"github.com/juju/juju/internal/errors"
 )
 
 // StatusID represents the status of an entity.
@@ -63,4 +65,58 @@ const (
 	WorkloadStatusBlocked
 	WorkloadStatusActive
 	WorkloadStatusTerminated
+	WorkloadStatusError
+)
+
+// PebbleCheckStatusType represents the status of a Pebble check in a unit's
+// workload container as recorded in the pebble_check_status_value lookup
+// table.
+type PebbleCheckStatusType int
+
+const (
+	PebbleCheckStatusUp PebbleCheckStatusType = iota
+	PebbleCheckStatusDown
 )
+
+// String returns the status as reported by Pebble.
+func (s PebbleCheckStatusType) String() string {
+	switch s {
+	case PebbleCheckStatusUp:
+		return "up"
+	case PebbleCheckStatusDown:
+		return "down"
+	default:
+		return ""
+	}
+}
+
+// ParsePebbleCheckStatus returns the status corresponding to the input
+// status as reported by Pebble.
+func ParsePebbleCheckStatus(s string) (PebbleCheckStatusType, error) {
+	switch s {
+	case "up":
+		return PebbleCheckStatusUp, nil
+	case "down":
+		return PebbleCheckStatusDown, nil
+	default:
+		return -1, errors.Errorf("unknown pebble check status %q", s)
+	}
+}
+
+// PebbleCheckStatusInfo holds details about the status of a Pebble check in
+// one of a unit's workload containers.
+type PebbleCheckStatusInfo struct {
+	// ContainerName is the name of the workload container the check runs in.
+	ContainerName string
+	// CheckName is the name of the check in the container's Pebble plan.
+	CheckName string
+	// Status is the status of the check.
+	Status PebbleCheckStatusType
+	// Message describes why the check is down.
+	Message string
+	// Critical is whether the unit's workload status is reported as error
+	// while the check is down.
+	Critical bool
+	// Since is when the check changed to the status.
+	Since time.Time
+}
@@ -99,5 +99,37 @@ func (s *statusSuite) TestWorkloadStatusDBValues(c *gc.C) {
 		WorkloadStatusBlocked:     "blocked",
 		WorkloadStatusActive:      "active",
 		WorkloadStatusTerminated:  "terminated",
+		WorkloadStatusError:       "error",
 	})
 }
+
+// TestPebbleCheckStatusDBValues ensures there's no skew between what's in the
+// database table for pebble check status and the typed consts used in the
+// state packages.
+func (s *statusSuite) TestPebbleCheckStatusDBValues(c *gc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, status FROM pebble_check_status_value")
+	c.Assert(err, jc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[PebbleCheckStatusType]string)
+	for rows.Next() {
+		var (
+			id   int
+			name string
+		)
+		err := rows.Scan(&id, &name)
+		c.Assert(err, jc.ErrorIsNil)
+		dbValues[PebbleCheckStatusType(id)] = name
+	}
+	c.Assert(dbValues, jc.DeepEquals, map[PebbleCheckStatusType]string{
+		PebbleCheckStatusUp:   "up",
+		PebbleCheckStatusDown: "down",
+	})
+	for id, name := range dbValues {
+		c.Check(id.String(), gc.Equals, name)
+		parsed, err := ParsePebbleCheckStatus(name)
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(parsed, gc.Equals, id)
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// SchemaMismatch describes an error that occurs when a snapshot is
+	// restored into a database with a different schema to the one the
+	// snapshot was taken from.
+	SchemaMismatch = errors.ConstError("schema mismatch")
+
+	// SnapshotNotValid describes an error that occurs when a snapshot can
+	// not be read, either because it is corrupt or was written in an
+	// unsupported format.
+	SnapshotNotValid = errors.ConstError("snapshot not valid")
+)
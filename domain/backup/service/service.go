@@ -0,0 +1,243 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// formatHeader is the header line that records the format version of a
+	// snapshot.
+	formatHeader = "-- juju-snapshot-format: "
+
+	// schemaHeader is the header line that records the schema hash of the
+	// database the snapshot was taken from.
+	schemaHeader = "-- schema: "
+)
+
+// State defines an interface for interacting with the underlying state.
+type State interface {
+	// EnsureDatabase ensures that the database exists.
+	EnsureDatabase(ctx context.Context) error
+
+	// GetSnapshot writes a consistent point-in-time snapshot of all the
+	// data in the database to w.
+	GetSnapshot(ctx context.Context, w backup.SnapshotWriter) (backup.Snapshot, error)
+
+	// RestoreSnapshot replaces all the data in the database with the data
+	// from the snapshot statements, except for the preserved tables.
+	RestoreSnapshot(ctx context.Context, schemaHash string, statements backup.StatementReader, preserve []string) error
+}
+
+// Service provides the API for taking and restoring snapshots of a
+// database.
+type Service struct {
+	st     State
+	logger logger.Logger
+}
+
+// NewService returns a new Service for taking and restoring snapshots of a
+// database.
+func NewService(st State, logger logger.Logger) *Service {
+	return &Service{
+		st:     st,
+		logger: logger,
+	}
+}
+
+// EnsureDatabase ensures that the database exists, so that a snapshot can
+// be restored into it. The database of a model doesn't exist on a freshly
+// bootstrapped controller until the model has been registered in the
+// controller database, and the model database has been opened.
+func (s *Service) EnsureDatabase(ctx context.Context) error {
+	if err := s.st.EnsureDatabase(ctx); err != nil {
+		return errors.Errorf("ensuring database: %w", err)
+	}
+	return nil
+}
+
+// Snapshot writes a consistent point-in-time snapshot of all the data in
+// the database to w. The snapshot is written as SQL, with one insert
+// statement per line, so it can also be inspected or loaded with standard
+// SQLite tooling.
+func (s *Service) Snapshot(ctx context.Context, w io.Writer) (backup.SnapshotInfo, error) {
+	hasher := sha256.New()
+	counter := &countingWriter{w: io.MultiWriter(w, hasher)}
+	sw := &snapshotWriter{w: bufio.NewWriter(counter)}
+
+	snapshot, err := s.st.GetSnapshot(ctx, sw)
+	if err != nil {
+		return backup.SnapshotInfo{}, errors.Errorf("getting snapshot: %w", err)
+	}
+	if err := sw.w.Flush(); err != nil {
+		return backup.SnapshotInfo{}, errors.Errorf("writing snapshot: %w", err)
+	}
+
+	s.logger.Debugf(ctx, "written snapshot of %d tables, %d rows", snapshot.Tables, snapshot.Rows)
+
+	return backup.SnapshotInfo{
+		SchemaHash: snapshot.SchemaHash,
+		Tables:     snapshot.Tables,
+		Rows:       snapshot.Rows,
+		Objects:    snapshot.Objects,
+		Size:       counter.n,
+		SHA256:     hex.EncodeToString(hasher.Sum(nil)),
+	}, nil
+}
+
+// Restore replaces all the data in the database with the data from the
+// snapshot read from r, except for the data in the preserved tables. The
+// snapshot is restored in a single transaction, so either all of the data
+// is restored or none of it is. As no changes are written to the change log
+// for the restored data, any agents using the database should be restarted
+// once the restore is complete.
+// Returns an error [backuperrors.SnapshotNotValid] if the snapshot can't be
+// read.
+// Returns an error [backuperrors.SchemaMismatch] if the snapshot was taken
+// from a database with a different schema.
+func (s *Service) Restore(ctx context.Context, r io.Reader, preserve ...string) error {
+	sr := &snapshotReader{r: bufio.NewReader(r)}
+	hash, err := sr.readHeader()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := s.st.RestoreSnapshot(ctx, hash, sr, preserve); err != nil {
+		return errors.Errorf("restoring snapshot: %w", err)
+	}
+
+	s.logger.Debugf(ctx, "restored snapshot of %d rows", sr.rows)
+	return nil
+}
+
+// snapshotWriter writes a snapshot as SQL, with the header comments
+// followed by one statement per line.
+type snapshotWriter struct {
+	w *bufio.Writer
+}
+
+// WriteSchema writes the header of the snapshot.
+func (w *snapshotWriter) WriteSchema(hash string) error {
+	if _, err := fmt.Fprintf(w.w, "%s%d\n", formatHeader, backup.SnapshotFormatVersion); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w.w, "%s%s\n", schemaHeader, hash)
+	return err
+}
+
+// WriteStatement writes a statement on its own line.
+func (w *snapshotWriter) WriteStatement(stmt string) error {
+	if _, err := w.w.WriteString(stmt); err != nil {
+		return err
+	}
+	return w.w.WriteByte('\n')
+}
+
+// snapshotReader reads a snapshot one line at a time. Rows can contain
+// large values, such as charm metadata or certificates, so lines are read
+// whole rather than with a fixed size buffer.
+type snapshotReader struct {
+	r *bufio.Reader
+
+	// next is the first statement, that is read along with the header.
+	next string
+	rows int64
+}
+
+// readHeader reads the header comments before the first statement,
+// returning the schema hash of the snapshot.
+func (r *snapshotReader) readHeader() (string, error) {
+	var (
+		version int
+		hash    string
+	)
+	for {
+		line, err := r.readLine()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return "", errors.Errorf("reading snapshot: %w", err)
+		}
+
+		if strings.HasPrefix(line, formatHeader) {
+			v, err := strconv.Atoi(strings.TrimPrefix(line, formatHeader))
+			if err != nil {
+				return "", errors.Errorf("reading format version: %w", backuperrors.SnapshotNotValid)
+			}
+			version = v
+		} else if strings.HasPrefix(line, schemaHeader) {
+			hash = strings.TrimPrefix(line, schemaHeader)
+		} else if !strings.HasPrefix(line, "--") {
+			r.next = line
+			break
+		}
+	}
+
+	if version != backup.SnapshotFormatVersion {
+		return "", errors.Errorf("format version %d not supported: %w", version, backuperrors.SnapshotNotValid)
+	}
+	if hash == "" {
+		return "", errors.Errorf("missing schema hash: %w", backuperrors.SnapshotNotValid)
+	}
+	return hash, nil
+}
+
+// ReadStatement returns the next statement in the snapshot, skipping any
+// comments. Returns [io.EOF] once every statement has been read.
+func (r *snapshotReader) ReadStatement() (string, error) {
+	if next := r.next; next != "" {
+		r.next = ""
+		r.rows++
+		return next, nil
+	}
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			return "", err
+		}
+		if !strings.HasPrefix(line, "--") {
+			r.rows++
+			return line, nil
+		}
+	}
+}
+
+// readLine returns the next line that isn't empty, without the line ending.
+func (r *snapshotReader) readLine() (string, error) {
+	for {
+		line, err := r.r.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			return "", err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if line != "" {
+			return line, nil
+		}
+	}
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type serviceSuite struct {
+	state *MockState
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) TestSnapshot(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, w backup.SnapshotWriter) (backup.Snapshot, error) {
+		c.Assert(w.WriteSchema("deadbeef"), jc.ErrorIsNil)
+		c.Assert(w.WriteStatement(`INSERT INTO "foo" ("id") VALUES (1);`), jc.ErrorIsNil)
+		c.Assert(w.WriteStatement(`INSERT INTO "foo" ("id") VALUES (2);`), jc.ErrorIsNil)
+		return backup.Snapshot{
+			SchemaHash: "deadbeef",
+			Tables:     1,
+			Rows:       2,
+			Objects:    []string{"abc"},
+		}, nil
+	})
+
+	var buf bytes.Buffer
+	info, err := s.newService(c).Snapshot(context.Background(), &buf)
+	c.Assert(err, jc.ErrorIsNil)
+
+	expected := `
+-- juju-snapshot-format: 1
+-- schema: deadbeef
+INSERT INTO "foo" ("id") VALUES (1);
+INSERT INTO "foo" ("id") VALUES (2);
+`[1:]
+	c.Check(buf.String(), gc.Equals, expected)
+
+	sum := sha256.Sum256([]byte(expected))
+	c.Check(info, jc.DeepEquals, backup.SnapshotInfo{
+		SchemaHash: "deadbeef",
+		Tables:     1,
+		Rows:       2,
+		Objects:    []string{"abc"},
+		Size:       int64(len(expected)),
+		SHA256:     hex.EncodeToString(sum[:]),
+	})
+}
+
+func (s *serviceSuite) TestRestore(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var statements []string
+	s.state.EXPECT().RestoreSnapshot(gomock.Any(), "deadbeef", gomock.Any(), []string{"bar"}).DoAndReturn(func(_ context.Context, _ string, r backup.StatementReader, _ []string) error {
+		for {
+			stmt, err := r.ReadStatement()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			statements = append(statements, stmt)
+		}
+	})
+
+	r := strings.NewReader(`
+-- juju-snapshot-format: 1
+-- schema: deadbeef
+-- a comment
+INSERT INTO "foo" ("id") VALUES (1);
+
+-- another comment
+INSERT INTO "foo" ("id") VALUES (2);`)
+	err := s.newService(c).Restore(context.Background(), r, "bar")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(statements, jc.DeepEquals, []string{
+		`INSERT INTO "foo" ("id") VALUES (1);`,
+		`INSERT INTO "foo" ("id") VALUES (2);`,
+	})
+}
+
+func (s *serviceSuite) TestRestoreUnsupportedFormat(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	r := strings.NewReader(`
+-- juju-snapshot-format: 2
+-- schema: deadbeef
+`[1:])
+	err := s.newService(c).Restore(context.Background(), r)
+	c.Assert(err, jc.ErrorIs, backuperrors.SnapshotNotValid)
+}
+
+func (s *serviceSuite) TestRestoreMissingSchema(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	r := strings.NewReader(`
+-- juju-snapshot-format: 1
+INSERT INTO "foo" ("id") VALUES (1);
+`[1:])
+	err := s.newService(c).Restore(context.Background(), r)
+	c.Assert(err, jc.ErrorIs, backuperrors.SnapshotNotValid)
+}
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+
+	return ctrl
+}
+
+func (s *serviceSuite) newService(c *gc.C) *Service {
+	return NewService(s.state, loggertesting.WrapCheckLog(c))
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// timeFormat is the format used to write time values, it is the first
+// format the SQLite drivers attempt to parse time values with.
+const timeFormat = "2006-01-02 15:04:05.999999999-07:00"
+
+// insertPrefix is the prefix of every statement in a snapshot.
+const insertPrefix = "INSERT INTO "
+
+// quoteIdentifier quotes a table or column name for use in a statement.
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// sqlLiteral returns the SQL literal for a value read from the database.
+// Strings that contain line breaks are written as a blob cast to text, so
+// that every statement in a snapshot is written on a single line.
+func sqlLiteral(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		s := strconv.FormatFloat(v, 'g', -1, 64)
+		// Ensure the value is read back as a real, rather than an
+		// integer.
+		if !strings.ContainsAny(s, ".eEnN") {
+			s += ".0"
+		}
+		return s, nil
+	case bool:
+		if v {
+			return "1", nil
+		}
+		return "0", nil
+	case []byte:
+		return "X'" + hex.EncodeToString(v) + "'", nil
+	case string:
+		return quoteString(v), nil
+	case time.Time:
+		return quoteString(v.Format(timeFormat)), nil
+	default:
+		return "", errors.Errorf("unsupported value type %T", value)
+	}
+}
+
+func quoteString(s string) string {
+	if strings.ContainsAny(s, "\r\n\x00") {
+		return "CAST(X'" + hex.EncodeToString([]byte(s)) + "' AS TEXT)"
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// insertTable returns the table that the snapshot statement inserts into.
+// Returns an error [backuperrors.SnapshotNotValid] if the statement isn't an
+// insert written by a snapshot.
+func insertTable(stmt string) (string, error) {
+	rest, ok := strings.CutPrefix(stmt, insertPrefix+`"`)
+	if !ok || strings.ContainsAny(stmt, "\r\n") || !isSingleStatement(stmt) {
+		return "", errors.Errorf("unexpected statement: %w", backuperrors.SnapshotNotValid)
+	}
+
+	// Read the quoted identifier, with any doubled quotes unescaped.
+	var name strings.Builder
+	for i := 0; i < len(rest); i++ {
+		if rest[i] != '"' {
+			name.WriteByte(rest[i])
+			continue
+		}
+		if i+1 < len(rest) && rest[i+1] == '"' {
+			name.WriteByte('"')
+			i++
+			continue
+		}
+		if !strings.HasPrefix(rest[i+1:], " (") {
+			break
+		}
+		return name.String(), nil
+	}
+	return "", errors.Errorf("unexpected statement: %w", backuperrors.SnapshotNotValid)
+}
+
+// isSingleStatement returns true if the only statement terminator outside of
+// a quoted string or identifier is at the end of the statement.
+func isSingleStatement(stmt string) bool {
+	var quote byte
+	for i := 0; i < len(stmt); i++ {
+		switch c := stmt[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ';':
+			return i == len(stmt)-1 && strings.HasSuffix(stmt, ");")
+		}
+	}
+	return false
+}
@@ -0,0 +1,469 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/backup"
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// sequenceTable is the table SQLite uses to record the largest ID issued
+// for tables with an AUTOINCREMENT primary key.
+const sequenceTable = "sqlite_sequence"
+
+// State represents database interactions dealing with snapshots of a
+// database.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new backup state based on the input database factory
+// method.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// EnsureDatabase ensures that the database exists. The database of a model
+// is created, with the current schema, the first time it is opened after
+// the model has been registered in the controller database.
+func (s *State) EnsureDatabase(ctx context.Context) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		_, err := s.schemaHash(ctx, tx)
+		return errors.Capture(err)
+	})
+}
+
+// GetSnapshot writes a snapshot of all the data in the database to w, one
+// insert statement per row. All of the data is read in a single
+// transaction, so the snapshot is consistent at a single point in time. The
+// rows are written as they are read, so the snapshot is never held in
+// memory.
+func (s *State) GetSnapshot(ctx context.Context, w backup.SnapshotWriter) (backup.Snapshot, error) {
+	db, err := s.DB()
+	if err != nil {
+		return backup.Snapshot{}, errors.Capture(err)
+	}
+
+	var (
+		snapshot backup.Snapshot
+		started  bool
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		// The snapshot is written as it is read, so the transaction can't
+		// be retried once anything has been written.
+		if started {
+			return errors.Errorf("snapshot transaction retried after data was written")
+		}
+		started = true
+
+		snapshot.SchemaHash, err = s.schemaHash(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := w.WriteSchema(snapshot.SchemaHash); err != nil {
+			return errors.Errorf("writing schema: %w", err)
+		}
+
+		tables, err := s.tableNames(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		for _, table := range tables {
+			rows, err := s.dumpTable(ctx, tx, table, w)
+			if err != nil {
+				return errors.Errorf("dumping table %q: %w", table, err)
+			}
+			snapshot.Rows += rows
+			snapshot.Tables++
+		}
+
+		// The sequences must be written last, as inserting rows into
+		// tables with an AUTOINCREMENT primary key will also write to the
+		// sequence table.
+		if hasSequences, err := s.tableExists(ctx, tx, sequenceTable); err != nil {
+			return errors.Capture(err)
+		} else if hasSequences {
+			if _, err := s.dumpTable(ctx, tx, sequenceTable, w); err != nil {
+				return errors.Errorf("dumping sequences: %w", err)
+			}
+		}
+
+		snapshot.Objects, err = s.objectHashes(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return nil
+	}); err != nil {
+		return backup.Snapshot{}, errors.Capture(err)
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot replaces all the data in the database with the data from
+// the snapshot statements, in a single transaction. The statements are
+// restored as they are read, so the snapshot is never held in memory. The
+// data in the preserved tables is left untouched. The triggers are dropped
+// whilst the data is restored, so no changes are written to the change log.
+// Returns an error [backuperrors.SchemaMismatch] if the schema of the
+// database doesn't match the schema the snapshot was taken from.
+// Returns an error [backuperrors.SnapshotNotValid] if any of the statements
+// are not inserts into a known table.
+func (s *State) RestoreSnapshot(ctx context.Context, hash string, statements backup.StatementReader, preserve []string) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	preserved := make(map[string]bool, len(preserve))
+	for _, table := range preserve {
+		preserved[table] = true
+	}
+
+	var started bool
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		// The statements are consumed as they are restored, so the
+		// transaction can't be retried once any have been read.
+		if started {
+			return errors.Errorf("restore transaction retried after statements were read")
+		}
+		started = true
+
+		if target, err := s.schemaHash(ctx, tx); err != nil {
+			return errors.Capture(err)
+		} else if target != hash {
+			return errors.Errorf("snapshot schema %q, database schema %q: %w", hash, target, backuperrors.SchemaMismatch)
+		}
+
+		tables, err := s.tableNames(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		known := make(map[string]bool, len(tables)+1)
+		for _, table := range tables {
+			known[table] = true
+		}
+		known[sequenceTable] = true
+
+		// Defer the foreign key checks until the transaction is committed,
+		// as the rows are not deleted or inserted in dependency order.
+		if err := exec(ctx, tx, "PRAGMA defer_foreign_keys = ON"); err != nil {
+			return errors.Errorf("deferring foreign keys: %w", err)
+		}
+
+		triggers, err := s.dropTriggers(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		for _, table := range tables {
+			if preserved[table] {
+				continue
+			}
+
+			// Deleting from a table checks the foreign keys that reference
+			// it, even when it is empty, so only delete from tables with
+			// data to delete.
+			if empty, err := s.tableEmpty(ctx, tx, table); err != nil {
+				return errors.Capture(err)
+			} else if empty {
+				continue
+			}
+			if err := exec(ctx, tx, "DELETE FROM "+quoteIdentifier(table)); err != nil {
+				return errors.Errorf("deleting data from %q: %w", table, err)
+			}
+		}
+
+		var sequences bool
+		for {
+			stmt, err := statements.ReadStatement()
+			if errors.Is(err, io.EOF) {
+				break
+			} else if err != nil {
+				return errors.Errorf("reading snapshot: %w", err)
+			}
+
+			// Validate that each statement is an insert into a known
+			// table before it is run.
+			table, err := insertTable(stmt)
+			if err != nil {
+				return errors.Capture(err)
+			}
+			if !known[table] {
+				return errors.Errorf("unknown table %q: %w", table, backuperrors.SnapshotNotValid)
+			}
+			if preserved[table] {
+				continue
+			}
+
+			// The sequences are written after all of the data. Inserting
+			// the data will have written to the sequences, so they need to
+			// be cleared before the snapshot sequences are restored.
+			if table == sequenceTable && !sequences {
+				if err := exec(ctx, tx, "DELETE FROM "+sequenceTable); err != nil {
+					return errors.Errorf("deleting sequences: %w", err)
+				}
+				sequences = true
+			} else if table != sequenceTable && sequences {
+				return errors.Errorf("data for %q after sequences: %w", table, backuperrors.SnapshotNotValid)
+			}
+
+			if err := exec(ctx, tx, stmt); err != nil {
+				return errors.Errorf("restoring data: %w", err)
+			}
+		}
+
+		for _, trigger := range triggers {
+			if err := exec(ctx, tx, trigger); err != nil {
+				return errors.Errorf("restoring trigger: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// schemaHash returns a hash of the schema of the database, which can be used
+// to verify that two databases have the same schema.
+func (s *State) schemaHash(ctx context.Context, tx *sqlair.TX) (string, error) {
+	stmt, err := s.Prepare(`
+SELECT &schemaObject.*
+FROM   sqlite_master
+WHERE  sql IS NOT NULL
+AND    name NOT LIKE 'sqlite_%'
+ORDER BY type, name`, schemaObject{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var objects []schemaObject
+	if err := tx.Query(ctx, stmt).GetAll(&objects); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return "", errors.Errorf("reading schema: %w", err)
+	}
+
+	hasher := sha256.New()
+	for _, object := range objects {
+		_, _ = fmt.Fprintf(hasher, "%s\x00%s\x00%s\n", object.Type, object.Name, object.SQL)
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// tableNames returns the names of all the tables in the database, excluding
+// the internal SQLite tables.
+func (s *State) tableNames(ctx context.Context, tx *sqlair.TX) ([]string, error) {
+	stmt, err := s.Prepare(`
+SELECT &objectName.name
+FROM   sqlite_master
+WHERE  type = 'table'
+AND    name NOT LIKE 'sqlite_%'
+ORDER BY name`, objectName{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var names []objectName
+	if err := tx.Query(ctx, stmt).GetAll(&names); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("reading tables: %w", err)
+	}
+	tables := make([]string, len(names))
+	for i, n := range names {
+		tables[i] = n.Name
+	}
+	return tables, nil
+}
+
+func (s *State) tableExists(ctx context.Context, tx *sqlair.TX, table string) (bool, error) {
+	stmt, err := s.Prepare(`
+SELECT COUNT(*) AS &count.count
+FROM   sqlite_master
+WHERE  type = 'table'
+AND    name = $tableName.name`, count{}, tableName{})
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	var result count
+	if err := tx.Query(ctx, stmt, tableName{Name: table}).Get(&result); err != nil {
+		return false, errors.Errorf("checking table %q exists: %w", table, err)
+	}
+	return result.Count > 0, nil
+}
+
+// tableEmpty returns true if the table has no rows.
+func (s *State) tableEmpty(ctx context.Context, tx *sqlair.TX, table string) (bool, error) {
+	// The statement is not cached, as it depends on the table.
+	stmt, err := sqlair.Prepare(fmt.Sprintf("SELECT COUNT(*) AS &count.count FROM (SELECT 1 FROM %s LIMIT 1)", quoteIdentifier(table)), count{})
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	var result count
+	if err := tx.Query(ctx, stmt).Get(&result); err != nil {
+		return false, errors.Errorf("checking table %q is empty: %w", table, err)
+	}
+	return result.Count == 0, nil
+}
+
+// dumpTable writes an insert statement for each row in the table to w,
+// returning the number of rows written.
+func (s *State) dumpTable(ctx context.Context, tx *sqlair.TX, table string, w backup.SnapshotWriter) (int64, error) {
+	columns, err := s.tableColumns(ctx, tx, table)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	// Each column is aliased by its position, as SQLair reads map members
+	// by column name, and not every column name is a valid member name.
+	quoted := make([]string, len(columns))
+	aliased := make([]string, len(columns))
+	outputs := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = quoteIdentifier(column)
+		aliased[i] = fmt.Sprintf("%s AS c%d", quoted[i], i)
+		outputs[i] = fmt.Sprintf("&M.c%d", i)
+	}
+	prefix := fmt.Sprintf("INSERT INTO %s (%s) VALUES (", quoteIdentifier(table), strings.Join(quoted, ", "))
+
+	// The statement is not cached, as it depends on the table.
+	stmt, err := sqlair.Prepare(fmt.Sprintf("SELECT %s FROM (SELECT %s FROM %s)",
+		strings.Join(outputs, ", "), strings.Join(aliased, ", "), quoteIdentifier(table)), sqlair.M{})
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var rows int64
+	iter := tx.Query(ctx, stmt).Iter()
+	defer func() { _ = iter.Close() }()
+	for iter.Next() {
+		row := sqlair.M{}
+		if err := iter.Get(row); err != nil {
+			return rows, errors.Capture(err)
+		}
+
+		var b strings.Builder
+		b.WriteString(prefix)
+		for i := range columns {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			literal, err := sqlLiteral(row[fmt.Sprintf("c%d", i)])
+			if err != nil {
+				return rows, errors.Errorf("column %q: %w", columns[i], err)
+			}
+			b.WriteString(literal)
+		}
+		b.WriteString(");")
+		if err := w.WriteStatement(b.String()); err != nil {
+			return rows, errors.Errorf("writing statement: %w", err)
+		}
+		rows++
+	}
+	if err := iter.Close(); err != nil {
+		return rows, errors.Capture(err)
+	}
+	return rows, nil
+}
+
+// tableColumns returns the columns of the table that can be written to.
+// Generated columns are hidden, so are not included.
+func (s *State) tableColumns(ctx context.Context, tx *sqlair.TX, table string) ([]string, error) {
+	stmt, err := s.Prepare(`
+SELECT name AS &objectName.name
+FROM   pragma_table_info($tableName.name)
+ORDER BY cid`, objectName{}, tableName{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var names []objectName
+	if err := tx.Query(ctx, stmt, tableName{Name: table}).GetAll(&names); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("reading columns: %w", err)
+	}
+	columns := make([]string, len(names))
+	for i, n := range names {
+		columns[i] = n.Name
+	}
+	return columns, nil
+}
+
+// objectHashes returns the SHA256 hashes of all the objects in the object
+// store metadata.
+func (s *State) objectHashes(ctx context.Context, tx *sqlair.TX) ([]string, error) {
+	if exists, err := s.tableExists(ctx, tx, "object_store_metadata"); err != nil {
+		return nil, errors.Capture(err)
+	} else if !exists {
+		return nil, nil
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &objectHash.sha_256
+FROM   object_store_metadata
+ORDER BY sha_256`, objectHash{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var objects []objectHash
+	if err := tx.Query(ctx, stmt).GetAll(&objects); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("reading object store metadata: %w", err)
+	}
+	hashes := make([]string, len(objects))
+	for i, object := range objects {
+		hashes[i] = object.SHA256
+	}
+	return hashes, nil
+}
+
+// dropTriggers drops all the triggers in the database, returning the
+// statements to create them again.
+func (s *State) dropTriggers(ctx context.Context, tx *sqlair.TX) ([]string, error) {
+	stmt, err := s.Prepare(`
+SELECT &schemaObject.*
+FROM   sqlite_master
+WHERE  type = 'trigger'
+ORDER BY name`, schemaObject{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var objects []schemaObject
+	if err := tx.Query(ctx, stmt).GetAll(&objects); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("reading triggers: %w", err)
+	}
+
+	triggers := make([]string, len(objects))
+	for i, object := range objects {
+		if err := exec(ctx, tx, "DROP TRIGGER "+quoteIdentifier(object.Name)); err != nil {
+			return nil, errors.Errorf("dropping trigger %q: %w", object.Name, err)
+		}
+		triggers[i] = object.SQL
+	}
+	return triggers, nil
+}
+
+// exec runs a statement built from the schema or read from a snapshot. The
+// statements are not cached, as most of them are only ever run once.
+func exec(ctx context.Context, tx *sqlair.TX, query string) error {
+	stmt, err := sqlair.Prepare(query)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return tx.Query(ctx, stmt).Run()
+}
@@ -0,0 +1,223 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"io"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	backuperrors "github.com/juju/juju/domain/backup/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) TestGetSnapshot(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.exec(c, `INSERT INTO model_config ("key", value) VALUES ('foo', 'it''s'), ('bar', 'a
+b')`)
+	s.exec(c, `INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size) VALUES ('abc', 'sha-256', 'sha-384', 42)`)
+
+	var w statementWriter
+	snapshot, err := st.GetSnapshot(context.Background(), &w)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(snapshot.SchemaHash, gc.Not(gc.Equals), "")
+	c.Check(w.hash, gc.Equals, snapshot.SchemaHash)
+	c.Check(snapshot.Objects, jc.DeepEquals, []string{"sha-256"})
+	c.Check(snapshot.Tables > 0, jc.IsTrue)
+	c.Check(snapshot.Rows >= 3, jc.IsTrue)
+
+	statements := make(map[string]bool)
+	for _, stmt := range w.statements {
+		statements[stmt] = true
+	}
+	c.Check(statements[`INSERT INTO "model_config" ("key", "value") VALUES ('foo', 'it''s');`], jc.IsTrue)
+	c.Check(statements[`INSERT INTO "model_config" ("key", "value") VALUES ('bar', CAST(X'610a62' AS TEXT));`], jc.IsTrue)
+	c.Check(statements[`INSERT INTO "object_store_metadata" ("uuid", "sha_256", "sha_384", "size") VALUES ('abc', 'sha-256', 'sha-384', 42);`], jc.IsTrue)
+}
+
+func (s *stateSuite) TestRestoreSnapshot(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.exec(c, `INSERT INTO model_config ("key", value) VALUES ('foo', 'bar'), ('baz', 'a
+b')`)
+
+	var w statementWriter
+	snapshot, err := st.GetSnapshot(context.Background(), &w)
+	c.Assert(err, jc.ErrorIsNil)
+
+	triggers := s.count(c, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'trigger'")
+
+	s.exec(c, `DELETE FROM model_config`)
+	s.exec(c, `INSERT INTO model_config ("key", value) VALUES ('other', 'value')`)
+
+	err = st.RestoreSnapshot(context.Background(), snapshot.SchemaHash, w.reader(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.modelConfig(c), jc.DeepEquals, map[string]string{
+		"foo": "bar",
+		"baz": "a\nb",
+	})
+
+	// The triggers are restored, and still write to the change log.
+	c.Check(s.count(c, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'trigger'"), gc.Equals, triggers)
+
+	changes := s.count(c, "SELECT COUNT(*) FROM change_log")
+	s.exec(c, `INSERT INTO model_config ("key", value) VALUES ('new', 'value')`)
+	c.Check(s.count(c, "SELECT COUNT(*) FROM change_log"), gc.Equals, changes+1)
+}
+
+func (s *stateSuite) TestRestoreSnapshotPreserve(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.exec(c, `INSERT INTO model_config ("key", value) VALUES ('foo', 'bar')`)
+
+	var w statementWriter
+	snapshot, err := st.GetSnapshot(context.Background(), &w)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.exec(c, `DELETE FROM model_config`)
+	s.exec(c, `INSERT INTO model_config ("key", value) VALUES ('other', 'value')`)
+
+	err = st.RestoreSnapshot(context.Background(), snapshot.SchemaHash, w.reader(), []string{"model_config"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.modelConfig(c), jc.DeepEquals, map[string]string{
+		"other": "value",
+	})
+}
+
+func (s *stateSuite) TestRestoreSnapshotSchemaMismatch(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	var w statementWriter
+	snapshot, err := st.GetSnapshot(context.Background(), &w)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.exec(c, `CREATE TABLE extra (id INT PRIMARY KEY)`)
+
+	err = st.RestoreSnapshot(context.Background(), snapshot.SchemaHash, w.reader(), nil)
+	c.Assert(err, jc.ErrorIs, backuperrors.SchemaMismatch)
+}
+
+func (s *stateSuite) TestRestoreSnapshotInvalidStatement(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.exec(c, `INSERT INTO model_config ("key", value) VALUES ('foo', 'bar')`)
+
+	var w statementWriter
+	snapshot, err := st.GetSnapshot(context.Background(), &w)
+	c.Assert(err, jc.ErrorIsNil)
+
+	for _, stmt := range []string{
+		`DROP TABLE model_config;`,
+		`INSERT INTO "model_config" ("key", "value") VALUES ('a', 'b'); DELETE FROM model_config;`,
+		`INSERT INTO "unknown" ("key", "value") VALUES ('a', 'b');`,
+	} {
+		err = st.RestoreSnapshot(context.Background(), snapshot.SchemaHash, &statementReader{statements: []string{stmt}}, nil)
+		c.Check(err, jc.ErrorIs, backuperrors.SnapshotNotValid, gc.Commentf("statement %q", stmt))
+	}
+
+	// Nothing was restored.
+	c.Check(s.modelConfig(c), jc.DeepEquals, map[string]string{
+		"foo": "bar",
+	})
+}
+
+func (s *stateSuite) TestRestoreSnapshotDataAfterSequences(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	var w statementWriter
+	snapshot, err := st.GetSnapshot(context.Background(), &w)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RestoreSnapshot(context.Background(), snapshot.SchemaHash, &statementReader{statements: []string{
+		`INSERT INTO "sqlite_sequence" ("name", "seq") VALUES ('foo', 1);`,
+		`INSERT INTO "model_config" ("key", "value") VALUES ('a', 'b');`,
+	}}, nil)
+	c.Check(err, jc.ErrorIs, backuperrors.SnapshotNotValid)
+}
+
+func (s *stateSuite) exec(c *gc.C, query string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) count(c *gc.C, query string) int {
+	var count int
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, query).Scan(&count)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return count
+}
+
+func (s *stateSuite) modelConfig(c *gc.C) map[string]string {
+	config := make(map[string]string)
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, `SELECT "key", value FROM model_config`)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		for rows.Next() {
+			var key, value string
+			if err := rows.Scan(&key, &value); err != nil {
+				return err
+			}
+			config[key] = value
+		}
+		return rows.Err()
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return config
+}
+
+// statementWriter collects the statements of a snapshot.
+type statementWriter struct {
+	hash       string
+	statements []string
+}
+
+func (w *statementWriter) WriteSchema(hash string) error {
+	w.hash = hash
+	return nil
+}
+
+func (w *statementWriter) WriteStatement(stmt string) error {
+	w.statements = append(w.statements, stmt)
+	return nil
+}
+
+func (w *statementWriter) reader() *statementReader {
+	return &statementReader{statements: w.statements}
+}
+
+// statementReader reads the statements of a snapshot from a slice.
+type statementReader struct {
+	statements []string
+}
+
+func (r *statementReader) ReadStatement() (string, error) {
+	if len(r.statements) == 0 {
+		return "", io.EOF
+	}
+	stmt := r.statements[0]
+	r.statements = r.statements[1:]
+	return stmt, nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+// schemaObject is an object in the schema of the database, as recorded in
+// sqlite_master.
+type schemaObject struct {
+	Type string `db:"type"`
+	Name string `db:"name"`
+	SQL  string `db:"sql"`
+}
+
+// objectName is the name of an object in the schema, such as a column.
+type objectName struct {
+	Name string `db:"name"`
+}
+
+// tableName is the name of a table.
+type tableName struct {
+	Name string `db:"name"`
+}
+
+// count is the number of rows matching a query.
+type count struct {
+	Count int `db:"count"`
+}
+
+// objectHash is the SHA256 hash of an object in the object store metadata.
+type objectHash struct {
+	SHA256 string `db:"sha_256"`
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backup
+
+// SnapshotFormatVersion is the version of the snapshot format written by
+// this version of juju. Snapshots written in any other format can not be
+// restored.
+const SnapshotFormatVersion = 1
+
+// SnapshotWriter receives the contents of a snapshot as they are read from
+// the database, so that the snapshot never has to be held in memory.
+type SnapshotWriter interface {
+	// WriteSchema is called once, before any statements are written, with
+	// the hash of the schema of the database.
+	WriteSchema(hash string) error
+
+	// WriteStatement is called with the insert statement for each row in
+	// the database.
+	WriteStatement(stmt string) error
+}
+
+// StatementReader reads the statements of a snapshot one at a time.
+type StatementReader interface {
+	// ReadStatement returns the next statement of the snapshot, or
+	// [io.EOF] once every statement has been read.
+	ReadStatement() (string, error)
+}
+
+// Snapshot describes a consistent point-in-time snapshot of all the data in
+// a database, that has been written to a [SnapshotWriter].
+type Snapshot struct {
+	// SchemaHash is the hash of the schema of the database the snapshot was
+	// taken from. A snapshot can only be restored into a database with the
+	// same schema.
+	SchemaHash string
+
+	// Tables is the number of tables in the snapshot.
+	Tables int
+
+	// Rows is the number of rows in the snapshot.
+	Rows int64
+
+	// Objects are the SHA256 hashes of the objects in the object store that
+	// are referenced by the snapshot.
+	Objects []string
+}
+
+// SnapshotInfo describes a snapshot that has been written out.
+type SnapshotInfo struct {
+	// SchemaHash is the hash of the schema of the database the snapshot was
+	// taken from.
+	SchemaHash string
+
+	// Tables is the number of tables in the snapshot.
+	Tables int
+
+	// Rows is the number of rows in the snapshot.
+	Rows int64
+
+	// Objects are the SHA256 hashes of the objects in the object store that
+	// are referenced by the snapshot.
+	Objects []string
+
+	// Size is the size of the written snapshot in bytes.
+	Size int64
+
+	// SHA256 is the hex encoded SHA256 checksum of the written snapshot.
+	SHA256 string
+}
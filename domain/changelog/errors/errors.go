@@ -0,0 +1,19 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// NamespaceNotValid describes an error that occurs when the change log
+	// namespace being read is not known.
+	NamespaceNotValid = errors.ConstError("namespace not valid")
+
+	// ChangesPruned describes an error that occurs when the changes after a
+	// resume token have already been pruned from the change log. The consumer
+	// must perform a full resync before reading changes again.
+	ChangesPruned = errors.ConstError("changes pruned from change log")
+)
@@ -0,0 +1,194 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+
+	"github.com/juju/juju/core/changestream"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/changelog"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State defines an interface for interacting with the underlying state.
+type State interface {
+	// GetLatestChangeID returns the ID of the latest change written to the
+	// change log. If no changes have been written, 0 is returned.
+	GetLatestChangeID(ctx context.Context) (int64, error)
+
+	// GetChanges returns up to limit changes to the given tables that were
+	// written to the change log after the change with the given ID. The ID
+	// of the latest change written to the change log is also returned.
+	GetChanges(ctx context.Context, after int64, tables []string, limit int) ([]changelog.Change, int64, error)
+
+	// CheckpointConsumer records that the durable consumer has seen the
+	// changes up to and including the change with the given ID, registering
+	// the consumer if it isn't already.
+	CheckpointConsumer(ctx context.Context, consumerID string, id int64) error
+
+	// ResetConsumer registers the durable consumer at the latest change
+	// written to the change log, clearing any eviction, and returns the ID
+	// of the latest change.
+	ResetConsumer(ctx context.Context, consumerID string) (int64, error)
+}
+
+// WatcherFactory describes methods for creating watchers.
+type WatcherFactory interface {
+	// NewNamespaceNotifyWatcher returns a new namespace notify watcher
+	// for events based on the input change mask.
+	NewNamespaceNotifyWatcher(
+		namespace string, changeMask changestream.ChangeType,
+	) (watcher.NotifyWatcher, error)
+}
+
+// Service provides the API for reading the model change log.
+type Service struct {
+	st     State
+	logger logger.Logger
+}
+
+// NewService returns a new Service for reading the model change log.
+func NewService(st State, logger logger.Logger) *Service {
+	return &Service{
+		st:     st,
+		logger: logger,
+	}
+}
+
+// GetLatestChangeID returns the ID of the latest change written to the
+// model change log. This can be used as a resume token to read the changes
+// that occur from now on.
+func (s *Service) GetLatestChangeID(ctx context.Context) (int64, error) {
+	return s.st.GetLatestChangeID(ctx)
+}
+
+// GetChanges returns the changes to the given namespaces that were written
+// to the model change log after the change with the given ID. If no
+// namespaces are given, changes to all namespaces are returned. At most
+// limit changes are returned, if limit is not positive the default limit is
+// used. The returned resume ID should be used to read the next changes.
+// Returns an error [changelogerrors.NamespaceNotValid] if any of the
+// namespaces are not known, or [changelogerrors.ChangesPruned] if changes
+// after the given ID have already been pruned from the change log.
+func (s *Service) GetChanges(
+	ctx context.Context, after int64, namespaces []changelog.Namespace, limit int,
+) (changelog.Changes, error) {
+	tables, err := tablesForNamespaces(namespaces)
+	if err != nil {
+		return changelog.Changes{}, errors.Capture(err)
+	}
+
+	if limit <= 0 {
+		limit = changelog.DefaultChangesLimit
+	} else if limit > changelog.MaxChangesLimit {
+		limit = changelog.MaxChangesLimit
+	}
+
+	changes, latest, err := s.st.GetChanges(ctx, after, tables, limit)
+	if err != nil {
+		return changelog.Changes{}, errors.Capture(err)
+	}
+
+	// If the limit was reached, there may be more changes to read, so resume
+	// from the last change read. Otherwise, all the changes to the requested
+	// namespaces up to the latest change have been read, so there is no need
+	// to read the intervening changes to other namespaces again.
+	resumeID := max(after, latest)
+	if len(changes) == limit {
+		resumeID = changes[len(changes)-1].ID
+	}
+	return changelog.Changes{
+		Changes:  changes,
+		ResumeID: resumeID,
+	}, nil
+}
+
+// StartConsumer registers the durable consumer at the latest change written
+// to the model change log, and returns the ID of the latest change, which
+// can be used as a resume token to read the changes that occur from now on.
+// The change log pruner retains the changes the consumer hasn't seen, until
+// it falls too far behind and is evicted. A consumer that was evicted is
+// reinstated, as starting from the latest change follows a full resync.
+func (s *Service) StartConsumer(ctx context.Context, consumerID string) (int64, error) {
+	if consumerID == "" {
+		return -1, errors.Errorf("empty consumer ID %w", coreerrors.NotValid)
+	}
+	return s.st.ResetConsumer(ctx, consumerID)
+}
+
+// CheckpointConsumer records that the durable consumer has seen the changes
+// up to and including the change with the given ID, so that the change log
+// pruner retains the changes after it. The consumer is registered at the
+// given ID if it isn't already.
+// Returns an error [changelogerrors.ChangesPruned] if the consumer fell too
+// far behind and was evicted, in which case it must perform a full resync
+// and start again with [Service.StartConsumer].
+func (s *Service) CheckpointConsumer(ctx context.Context, consumerID string, id int64) error {
+	if consumerID == "" {
+		return errors.Errorf("empty consumer ID %w", coreerrors.NotValid)
+	}
+	return s.st.CheckpointConsumer(ctx, consumerID, id)
+}
+
+// WatchableService provides the API for reading the model change log, as
+// well as the ability to watch for changes to it.
+type WatchableService struct {
+	*Service
+	watcherFactory WatcherFactory
+}
+
+// NewWatchableService returns a new WatchableService for reading and watching
+// the model change log.
+func NewWatchableService(st State, watcherFactory WatcherFactory, logger logger.Logger) *WatchableService {
+	return &WatchableService{
+		Service:        NewService(st, logger),
+		watcherFactory: watcherFactory,
+	}
+}
+
+// WatchChanges returns a notify watcher that fires whenever a change is
+// written to the model change log for any of the given namespaces. If no
+// namespaces are given, the watcher fires for changes to all namespaces.
+// Returns an error [changelogerrors.NamespaceNotValid] if any of the
+// namespaces are not known.
+func (s *WatchableService) WatchChanges(ctx context.Context, namespaces []changelog.Namespace) (watcher.NotifyWatcher, error) {
+	tables, err := tablesForNamespaces(namespaces)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	watchers := make([]eventsource.Watcher[struct{}], 0, len(tables))
+	for _, table := range tables {
+		w, err := s.watcherFactory.NewNamespaceNotifyWatcher(table, changestream.All)
+		if err != nil {
+			for _, w := range watchers {
+				w.Kill()
+			}
+			return nil, errors.Errorf("watching %q: %w", table, err)
+		}
+		watchers = append(watchers, w)
+	}
+	return eventsource.NewMultiNotifyWatcher(ctx, watchers...)
+}
+
+func tablesForNamespaces(namespaces []changelog.Namespace) ([]string, error) {
+	if len(namespaces) == 0 {
+		namespaces = changelog.AllNamespaces()
+	}
+
+	tables := set.NewStrings()
+	for _, namespace := range namespaces {
+		if err := namespace.Validate(); err != nil {
+			return nil, errors.Capture(err)
+		}
+		tables = tables.Union(set.NewStrings(namespace.Tables()...))
+	}
+	return tables.SortedValues(), nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/changelog"
+	changelogerrors "github.com/juju/juju/domain/changelog/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type serviceSuite struct {
+	st *MockState
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.st = NewMockState(ctrl)
+
+	return ctrl
+}
+
+func (s *serviceSuite) service(c *gc.C) *Service {
+	return NewService(s.st, loggertesting.WrapCheckLog(c))
+}
+
+func (s *serviceSuite) TestGetChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	changes := []changelog.Change{{
+		ID:        11,
+		Namespace: changelog.Unit,
+		Table:     "unit",
+		Type:      changelog.Created,
+		Changed:   "unit-uuid",
+	}}
+	s.st.EXPECT().GetChanges(gomock.Any(), int64(10), []string{"port_range", "unit"}, changelog.DefaultChangesLimit).
+		Return(changes, int64(15), nil)
+
+	result, err := s.service(c).GetChanges(context.Background(), 10, []changelog.Namespace{changelog.Unit}, 0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Changes, jc.DeepEquals, changes)
+
+	// All of the changes were read, so the resume ID skips over the changes
+	// to other namespaces.
+	c.Check(result.ResumeID, gc.Equals, int64(15))
+}
+
+func (s *serviceSuite) TestGetChangesLimitReached(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	changes := []changelog.Change{{
+		ID:        11,
+		Namespace: changelog.Relation,
+		Table:     "relation",
+		Type:      changelog.Created,
+		Changed:   "relation-uuid",
+	}}
+	s.st.EXPECT().GetChanges(gomock.Any(), int64(10), []string{"relation"}, 1).
+		Return(changes, int64(15), nil)
+
+	result, err := s.service(c).GetChanges(context.Background(), 10, []changelog.Namespace{changelog.Relation}, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.ResumeID, gc.Equals, int64(11))
+}
+
+func (s *serviceSuite) TestGetChangesAllNamespaces(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetChanges(gomock.Any(), int64(10), gomock.Any(), changelog.MaxChangesLimit).
+		DoAndReturn(func(_ context.Context, _ int64, tables []string, _ int) ([]changelog.Change, int64, error) {
+			c.Check(set.NewStrings(tables...).Contains("application"), jc.IsTrue)
+			c.Check(set.NewStrings(tables...).Contains("relation"), jc.IsTrue)
+			c.Check(set.NewStrings(tables...).Contains("secret_metadata"), jc.IsTrue)
+			return nil, 10, nil
+		})
+
+	result, err := s.service(c).GetChanges(context.Background(), 10, nil, changelog.MaxChangesLimit+1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Changes, gc.HasLen, 0)
+	c.Check(result.ResumeID, gc.Equals, int64(10))
+}
+
+func (s *serviceSuite) TestGetChangesNamespaceNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).GetChanges(context.Background(), 10, []changelog.Namespace{"foo"}, 0)
+	c.Assert(err, jc.ErrorIs, changelogerrors.NamespaceNotValid)
+}
+
+func (s *serviceSuite) TestGetChangesPruned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetChanges(gomock.Any(), int64(10), []string{"model_config"}, changelog.DefaultChangesLimit).
+		Return(nil, int64(-1), changelogerrors.ChangesPruned)
+
+	_, err := s.service(c).GetChanges(context.Background(), 10, []changelog.Namespace{changelog.ModelConfig}, 0)
+	c.Assert(err, jc.ErrorIs, changelogerrors.ChangesPruned)
+}
+
+func (s *serviceSuite) TestStartConsumer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().ResetConsumer(gomock.Any(), "consumer").Return(int64(15), nil)
+
+	latest, err := s.service(c).StartConsumer(context.Background(), "consumer")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(latest, gc.Equals, int64(15))
+}
+
+func (s *serviceSuite) TestStartConsumerEmptyID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).StartConsumer(context.Background(), "")
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestCheckpointConsumer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().CheckpointConsumer(gomock.Any(), "consumer", int64(10)).Return(nil)
+
+	err := s.service(c).CheckpointConsumer(context.Background(), "consumer", 10)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCheckpointConsumerEvicted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().CheckpointConsumer(gomock.Any(), "consumer", int64(10)).Return(changelogerrors.ChangesPruned)
+
+	err := s.service(c).CheckpointConsumer(context.Background(), "consumer", 10)
+	c.Assert(err, jc.ErrorIs, changelogerrors.ChangesPruned)
+}
@@ -0,0 +1,278 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"strings"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/changelog"
+	changelogerrors "github.com/juju/juju/domain/changelog/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State represents database interactions dealing with the model change log.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new change log state based on the input database
+// factory method.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// The sqlite_sequence table records the largest change log ID that has ever
+// been issued, even if the change log has since been pruned.
+const latestChangeIDQuery = `
+SELECT COALESCE((
+    SELECT seq FROM sqlite_sequence WHERE name = 'change_log'
+), 0) AS &changeLogID.id`
+
+// The lowest change log ID that can still be read, if the change log is
+// empty, this is the next change log ID that will be issued.
+const lowestChangeIDQuery = `
+SELECT COALESCE(
+    (SELECT MIN(id) FROM change_log),
+    (SELECT seq + 1 FROM sqlite_sequence WHERE name = 'change_log'),
+    1
+) AS &changeLogID.id`
+
+// GetLatestChangeID returns the ID of the latest change written to the
+// change log. If no changes have been written, 0 is returned.
+func (s *State) GetLatestChangeID(ctx context.Context) (int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(latestChangeIDQuery, changeLogID{})
+	if err != nil {
+		return -1, errors.Errorf("preparing latest change ID statement: %w", err)
+	}
+
+	var latest changeLogID
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt).Get(&latest); err != nil {
+			return errors.Errorf("getting latest change ID: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return -1, errors.Capture(err)
+	}
+	return latest.ID, nil
+}
+
+// GetChanges returns up to limit changes to the given tables that were
+// written to the change log after the change with the given ID. The changes
+// are returned in the order they were written and are not coalesced. The ID
+// of the latest change written to the change log is also returned.
+// Returns an error [changelogerrors.ChangesPruned] if changes after the given
+// ID have already been pruned from the change log.
+func (s *State) GetChanges(
+	ctx context.Context, after int64, tables []string, limit int,
+) ([]changelog.Change, int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return nil, -1, errors.Capture(err)
+	}
+
+	latestStmt, err := s.Prepare(latestChangeIDQuery, changeLogID{})
+	if err != nil {
+		return nil, -1, errors.Errorf("preparing latest change ID statement: %w", err)
+	}
+	lowestStmt, err := s.Prepare(lowestChangeIDQuery, changeLogID{})
+	if err != nil {
+		return nil, -1, errors.Errorf("preparing lowest change ID statement: %w", err)
+	}
+	changesStmt, err := s.Prepare(`
+SELECT (c.id, c.edit_type_id, n.namespace, c.changed, c.changed_columns, c.created_at) AS (&changeLogRow.*)
+FROM change_log AS c
+JOIN change_log_namespace AS n ON c.namespace_id = n.id
+WHERE c.id > $changeLogID.id
+AND n.namespace IN ($changeLogNamespaces[:])
+ORDER BY c.id
+LIMIT $changeLogID.max_changes
+`, changeLogRow{}, changeLogID{}, changeLogNamespaces{})
+	if err != nil {
+		return nil, -1, errors.Errorf("preparing changes statement: %w", err)
+	}
+
+	var (
+		rows   []changeLogRow
+		latest changeLogID
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		// Check that no changes after the requested ID have been pruned, so
+		// that the consumer doesn't silently miss changes.
+		var lowest changeLogID
+		if err := tx.Query(ctx, lowestStmt).Get(&lowest); err != nil {
+			return errors.Errorf("getting lowest change ID: %w", err)
+		}
+		if after+1 < lowest.ID {
+			return errors.Errorf(
+				"changes after %d, lowest available is %d: %w", after, lowest.ID, changelogerrors.ChangesPruned,
+			)
+		}
+
+		if err := tx.Query(ctx, latestStmt).Get(&latest); err != nil {
+			return errors.Errorf("getting latest change ID: %w", err)
+		}
+
+		input := changeLogID{ID: after, Limit: limit}
+		err := tx.Query(ctx, changesStmt, input, changeLogNamespaces(tables)).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting changes: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, -1, errors.Capture(err)
+	}
+
+	changes := make([]changelog.Change, len(rows))
+	for i, row := range rows {
+		namespace, _ := changelog.NamespaceForTable(row.Namespace)
+
+		var columns []string
+		if row.ChangedColumns.Valid && row.ChangedColumns.String != "" {
+			columns = strings.Split(row.ChangedColumns.String, ",")
+		}
+
+		changeType, err := decodeChangeType(row.EditTypeID)
+		if err != nil {
+			return nil, -1, errors.Capture(err)
+		}
+
+		changes[i] = changelog.Change{
+			ID:             row.ID,
+			Namespace:      namespace,
+			Table:          row.Namespace,
+			Type:           changeType,
+			Changed:        row.Changed,
+			ChangedColumns: columns,
+			CreatedAt:      row.CreatedAt,
+		}
+	}
+	return changes, latest.ID, nil
+}
+
+// CheckpointConsumer records that the durable consumer has seen the changes
+// up to and including the change with the given ID, so that the change log
+// pruner retains the changes after it. The consumer is registered at the
+// given ID if it isn't already, and a checkpoint earlier than the one
+// recorded is ignored.
+// Returns an error [changelogerrors.ChangesPruned] if the consumer fell too
+// far behind and was evicted by the pruner.
+func (s *State) CheckpointConsumer(ctx context.Context, consumerID string, id int64) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	registerStmt, err := s.Prepare(`
+INSERT INTO change_log_durable_consumer (consumer_id, last_seen_id, updated_at)
+VALUES ($durableConsumer.consumer_id, $durableConsumer.last_seen_id, DATETIME('now'))
+ON CONFLICT (consumer_id) DO NOTHING`, durableConsumer{})
+	if err != nil {
+		return errors.Errorf("preparing register consumer statement: %w", err)
+	}
+	selectStmt, err := s.Prepare(`
+SELECT (consumer_id, last_seen_id) AS (&durableConsumer.*),
+       evicted_at IS NOT NULL AS &durableConsumer.evicted
+FROM change_log_durable_consumer
+WHERE consumer_id = $durableConsumer.consumer_id`, durableConsumer{})
+	if err != nil {
+		return errors.Errorf("preparing select consumer statement: %w", err)
+	}
+	checkpointStmt, err := s.Prepare(`
+UPDATE change_log_durable_consumer
+SET last_seen_id = $durableConsumer.last_seen_id,
+    updated_at = DATETIME('now')
+WHERE consumer_id = $durableConsumer.consumer_id
+AND last_seen_id <= $durableConsumer.last_seen_id`, durableConsumer{})
+	if err != nil {
+		return errors.Errorf("preparing checkpoint consumer statement: %w", err)
+	}
+
+	input := durableConsumer{ConsumerID: consumerID, LastSeenID: id}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, registerStmt, input).Run(); err != nil {
+			return errors.Errorf("registering consumer %q: %w", consumerID, err)
+		}
+
+		var consumer durableConsumer
+		if err := tx.Query(ctx, selectStmt, input).Get(&consumer); err != nil {
+			return errors.Errorf("getting consumer %q: %w", consumerID, err)
+		}
+		if consumer.Evicted {
+			return errors.Errorf("consumer %q evicted: %w", consumerID, changelogerrors.ChangesPruned)
+		}
+
+		if err := tx.Query(ctx, checkpointStmt, input).Run(); err != nil {
+			return errors.Errorf("checkpointing consumer %q: %w", consumerID, err)
+		}
+		return nil
+	})
+}
+
+// ResetConsumer registers the durable consumer at the latest change written
+// to the change log, clearing any eviction, and returns the ID of the latest
+// change. This is used when the consumer starts reading from the latest
+// change, after performing a full resync.
+func (s *State) ResetConsumer(ctx context.Context, consumerID string) (int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return -1, errors.Capture(err)
+	}
+
+	latestStmt, err := s.Prepare(latestChangeIDQuery, changeLogID{})
+	if err != nil {
+		return -1, errors.Errorf("preparing latest change ID statement: %w", err)
+	}
+	resetStmt, err := s.Prepare(`
+INSERT INTO change_log_durable_consumer (consumer_id, last_seen_id, updated_at)
+VALUES ($durableConsumer.consumer_id, $durableConsumer.last_seen_id, DATETIME('now'))
+ON CONFLICT (consumer_id) DO UPDATE SET
+    last_seen_id = excluded.last_seen_id,
+    evicted_at = NULL,
+    updated_at = excluded.updated_at`, durableConsumer{})
+	if err != nil {
+		return -1, errors.Errorf("preparing reset consumer statement: %w", err)
+	}
+
+	var latest changeLogID
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, latestStmt).Get(&latest); err != nil {
+			return errors.Errorf("getting latest change ID: %w", err)
+		}
+		input := durableConsumer{ConsumerID: consumerID, LastSeenID: latest.ID}
+		if err := tx.Query(ctx, resetStmt, input).Run(); err != nil {
+			return errors.Errorf("resetting consumer %q: %w", consumerID, err)
+		}
+		return nil
+	}); err != nil {
+		return -1, errors.Capture(err)
+	}
+	return latest.ID, nil
+}
+
+// decodeChangeType converts the change_log_edit_type ID into a change type.
+func decodeChangeType(editTypeID int) (changelog.ChangeType, error) {
+	switch editTypeID {
+	case 1:
+		return changelog.Created, nil
+	case 2:
+		return changelog.Updated, nil
+	case 4:
+		return changelog.Deleted, nil
+	default:
+		return "", errors.Errorf("unknown change log edit type %d", editTypeID)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/changelog"
+	changelogerrors "github.com/juju/juju/domain/changelog/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) TestGetLatestChangeID(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+
+	latest, err := st.GetLatestChangeID(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The schema itself may have written changes, so we can only assert
+	// that the latest ID matches the sequence.
+	c.Check(latest, gc.Equals, s.sequence(c))
+}
+
+func (s *stateSuite) TestGetChanges(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+	after := s.sequence(c)
+
+	s.insertChange(c, 1, "application", "foo", "")
+	s.insertChange(c, 2, "model_config", "bar", "value")
+	s.insertChange(c, 2, "application_scale", "foo", "scale,scaling")
+
+	changes, latest, err := st.GetChanges(context.Background(), after, []string{"application", "application_scale"}, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(latest, gc.Equals, after+3)
+	c.Assert(changes, gc.HasLen, 2)
+
+	c.Check(changes[0].ID, gc.Equals, after+1)
+	c.Check(changes[0].Namespace, gc.Equals, changelog.Application)
+	c.Check(changes[0].Table, gc.Equals, "application")
+	c.Check(changes[0].Type, gc.Equals, changelog.Created)
+	c.Check(changes[0].Changed, gc.Equals, "foo")
+	c.Check(changes[0].ChangedColumns, gc.HasLen, 0)
+
+	c.Check(changes[1].ID, gc.Equals, after+3)
+	c.Check(changes[1].Namespace, gc.Equals, changelog.Application)
+	c.Check(changes[1].Table, gc.Equals, "application_scale")
+	c.Check(changes[1].Type, gc.Equals, changelog.Updated)
+	c.Check(changes[1].ChangedColumns, jc.DeepEquals, []string{"scale", "scaling"})
+}
+
+func (s *stateSuite) TestGetChangesLimit(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+	after := s.sequence(c)
+
+	s.insertChange(c, 1, "unit", "a", "")
+	s.insertChange(c, 1, "unit", "b", "")
+	s.insertChange(c, 1, "unit", "c", "")
+
+	changes, latest, err := st.GetChanges(context.Background(), after, []string{"unit"}, 2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(latest, gc.Equals, after+3)
+	c.Assert(changes, gc.HasLen, 2)
+	c.Check(changes[0].Changed, gc.Equals, "a")
+	c.Check(changes[1].Changed, gc.Equals, "b")
+
+	changes, _, err = st.GetChanges(context.Background(), changes[1].ID, []string{"unit"}, 2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(changes, gc.HasLen, 1)
+	c.Check(changes[0].Changed, gc.Equals, "c")
+}
+
+func (s *stateSuite) TestGetChangesPruned(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+	after := s.sequence(c)
+
+	s.insertChange(c, 1, "unit", "a", "")
+	s.insertChange(c, 1, "unit", "b", "")
+	s.clearChangeLog(c)
+
+	_, _, err := st.GetChanges(context.Background(), after, []string{"unit"}, 10)
+	c.Assert(err, jc.ErrorIs, changelogerrors.ChangesPruned)
+
+	// Reading from the latest change is still possible, even if the change
+	// log is empty.
+	changes, latest, err := st.GetChanges(context.Background(), after+2, []string{"unit"}, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changes, gc.HasLen, 0)
+	c.Check(latest, gc.Equals, after+2)
+}
+
+func (s *stateSuite) TestResetConsumer(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+	s.insertChange(c, 1, "unit", "a", "")
+
+	latest, err := st.ResetConsumer(context.Background(), "consumer")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(latest, gc.Equals, s.sequence(c))
+	c.Check(s.consumerLastSeen(c, "consumer"), gc.Equals, latest)
+}
+
+func (s *stateSuite) TestCheckpointConsumer(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+	after := s.sequence(c)
+
+	// An unknown consumer is registered at the checkpoint.
+	err := st.CheckpointConsumer(context.Background(), "consumer", after)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.consumerLastSeen(c, "consumer"), gc.Equals, after)
+
+	err = st.CheckpointConsumer(context.Background(), "consumer", after+2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.consumerLastSeen(c, "consumer"), gc.Equals, after+2)
+
+	// An earlier checkpoint is ignored.
+	err = st.CheckpointConsumer(context.Background(), "consumer", after+1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.consumerLastSeen(c, "consumer"), gc.Equals, after+2)
+}
+
+func (s *stateSuite) TestCheckpointConsumerResumeAfterPrune(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+	after := s.sequence(c)
+
+	s.insertChange(c, 1, "unit", "a", "")
+	s.insertChange(c, 1, "unit", "b", "")
+	s.insertChange(c, 1, "unit", "c", "")
+
+	// The consumer has read the first change, and checkpoints it when it
+	// reads the next changes.
+	err := st.CheckpointConsumer(context.Background(), "consumer", after+1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.pruneChangeLog(c)
+
+	changes, _, err := st.GetChanges(context.Background(), after+1, []string{"unit"}, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(changes, gc.HasLen, 2)
+	c.Check(changes[0].Changed, gc.Equals, "b")
+	c.Check(changes[1].Changed, gc.Equals, "c")
+
+	// Without the consumer, the changes would have been pruned.
+	_, _, err = st.GetChanges(context.Background(), after, []string{"unit"}, 10)
+	c.Assert(err, jc.ErrorIs, changelogerrors.ChangesPruned)
+}
+
+func (s *stateSuite) TestCheckpointConsumerEvicted(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.clearChangeLog(c)
+	after := s.sequence(c)
+
+	err := st.CheckpointConsumer(context.Background(), "consumer", after)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.DB().Exec(`
+UPDATE change_log_durable_consumer
+SET evicted_at = DATETIME('now')
+WHERE consumer_id = 'consumer'`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.CheckpointConsumer(context.Background(), "consumer", after)
+	c.Assert(err, jc.ErrorIs, changelogerrors.ChangesPruned)
+
+	// Once the consumer has resynced, resetting it clears the eviction.
+	latest, err := st.ResetConsumer(context.Background(), "consumer")
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.CheckpointConsumer(context.Background(), "consumer", latest)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) clearChangeLog(c *gc.C) {
+	_, err := s.DB().Exec("DELETE FROM change_log")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) sequence(c *gc.C) int64 {
+	var seq sql.NullInt64
+	row := s.DB().QueryRow("SELECT seq FROM sqlite_sequence WHERE name = 'change_log'")
+	err := row.Scan(&seq)
+	if err != sql.ErrNoRows {
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	return seq.Int64
+}
+
+func (s *stateSuite) insertChange(c *gc.C, editTypeID int, table, changed, columns string) {
+	var changedColumns any
+	if columns != "" {
+		changedColumns = columns
+	}
+	_, err := s.DB().Exec(`
+INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+SELECT ?, id, ?, ?, DATETIME('now')
+FROM change_log_namespace
+WHERE namespace = ?`, editTypeID, changed, changedColumns, table)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// pruneChangeLog removes the changes that all the durable consumers which
+// haven't been evicted have seen, as the change stream pruner does.
+func (s *stateSuite) pruneChangeLog(c *gc.C) {
+	_, err := s.DB().Exec(`
+DELETE FROM change_log
+WHERE id <= (
+    SELECT IFNULL(MIN(last_seen_id), (SELECT MAX(id) FROM change_log))
+    FROM change_log_durable_consumer
+    WHERE evicted_at IS NULL
+)`)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) consumerLastSeen(c *gc.C, consumerID string) int64 {
+	var lastSeen int64
+	row := s.DB().QueryRow("SELECT last_seen_id FROM change_log_durable_consumer WHERE consumer_id = ?", consumerID)
+	err := row.Scan(&lastSeen)
+	c.Assert(err, jc.ErrorIsNil)
+	return lastSeen
+}
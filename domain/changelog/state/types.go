@@ -0,0 +1,38 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+)
+
+// changeLogRow represents a single row from the change_log table, joined with
+// the change_log_namespace table.
+type changeLogRow struct {
+	ID             int64          `db:"id"`
+	EditTypeID     int            `db:"edit_type_id"`
+	Namespace      string         `db:"namespace"`
+	Changed        string         `db:"changed"`
+	ChangedColumns sql.NullString `db:"changed_columns"`
+	CreatedAt      time.Time      `db:"created_at"`
+}
+
+// changeLogID represents a change log ID, along with the maximum number of
+// changes to read after it.
+type changeLogID struct {
+	ID    int64 `db:"id"`
+	Limit int   `db:"max_changes"`
+}
+
+// changeLogNamespaces is a list of change log namespaces (table names).
+type changeLogNamespaces []string
+
+// durableConsumer represents a row from the change_log_durable_consumer
+// table.
+type durableConsumer struct {
+	ConsumerID string `db:"consumer_id"`
+	LastSeenID int64  `db:"last_seen_id"`
+	Evicted    bool   `db:"evicted"`
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package changelog
+
+import (
+	"time"
+
+	changelogerrors "github.com/juju/juju/domain/changelog/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Namespace is a group of related change log namespaces that can be read by
+// external consumers of the model change log. Each namespace maps to one or
+// more of the tables that record changes in the change log.
+type Namespace string
+
+const (
+	// Application includes changes to applications, their charms, scale and
+	// config.
+	Application Namespace = "application"
+
+	// Unit includes changes to units and their opened ports.
+	Unit Namespace = "unit"
+
+	// Machine includes changes to machines, their cloud instances, LXD
+	// profiles and reboot requests.
+	Machine Namespace = "machine"
+
+	// Relation includes changes to relations.
+	Relation Namespace = "relation"
+
+	// Secret includes changes to secrets and their revisions.
+	Secret Namespace = "secret"
+
+	// ModelConfig includes changes to the model config.
+	ModelConfig Namespace = "model-config"
+)
+
+var namespaceTables = map[Namespace][]string{
+	Application: {
+		"application",
+		"application_config_hash",
+		"application_scale",
+		"charm",
+	},
+	Unit: {
+		"unit",
+		"port_range",
+	},
+	Machine: {
+		"machine",
+		"machine_cloud_instance",
+		"machine_lxd_profile",
+		"machine_requires_reboot",
+	},
+	Relation: {
+		"relation",
+	},
+	Secret: {
+		"secret_metadata",
+		"secret_reference",
+		"secret_revision",
+		"secret_revision_expire",
+		"secret_revision_obsolete",
+		"secret_rotation",
+	},
+	ModelConfig: {
+		"model_config",
+	},
+}
+
+// AllNamespaces returns all the namespaces that can be read from the change
+// log.
+func AllNamespaces() []Namespace {
+	return []Namespace{
+		Application,
+		Unit,
+		Machine,
+		Relation,
+		Secret,
+		ModelConfig,
+	}
+}
+
+// Validate returns an error if the namespace is not known.
+func (n Namespace) Validate() error {
+	if _, ok := namespaceTables[n]; !ok {
+		return errors.Errorf("namespace %q %w", n, changelogerrors.NamespaceNotValid)
+	}
+	return nil
+}
+
+// Tables returns the names of the tables that record changes for the
+// namespace.
+func (n Namespace) Tables() []string {
+	return namespaceTables[n]
+}
+
+// NamespaceForTable returns the namespace that the given table belongs to.
+// If the table does not belong to a namespace, false is returned.
+func NamespaceForTable(table string) (Namespace, bool) {
+	for namespace, tables := range namespaceTables {
+		for _, t := range tables {
+			if t == table {
+				return namespace, true
+			}
+		}
+	}
+	return "", false
+}
+
+// ChangeType is the type of change recorded in the change log.
+type ChangeType string
+
+const (
+	// Created indicates that a row was created.
+	Created ChangeType = "create"
+
+	// Updated indicates that a row was updated.
+	Updated ChangeType = "update"
+
+	// Deleted indicates that a row was deleted.
+	Deleted ChangeType = "delete"
+)
+
+// Change represents a single entry read from the model change log.
+type Change struct {
+	// ID is the change log ID of the change. It can be used as a resume
+	// token, to read the changes that occurred after it.
+	ID int64
+
+	// Namespace is the namespace that the change belongs to.
+	Namespace Namespace
+
+	// Table is the name of the table that was changed.
+	Table string
+
+	// Type is the type of change.
+	Type ChangeType
+
+	// Changed is the value that identifies the changed row, this is
+	// usually the primary key of the row.
+	Changed string
+
+	// ChangedColumns are the columns that were changed for an update. If
+	// the columns are not known, this is empty.
+	ChangedColumns []string
+
+	// CreatedAt is the time that the change was recorded.
+	CreatedAt time.Time
+}
+
+// Changes is the result of reading the model change log.
+type Changes struct {
+	// Changes are the changes that were read, in the order that they were
+	// recorded.
+	Changes []Change
+
+	// ResumeID is the change log ID that should be used to read the next set
+	// of changes.
+	ResumeID int64
+}
+
+const (
+	// DefaultChangesLimit is the number of changes that are read from the
+	// change log, if no limit is requested.
+	DefaultChangesLimit = 100
+
+	// MaxChangesLimit is the maximum number of changes that can be read from
+	// the change log at once.
+	MaxChangesLimit = 1000
+)
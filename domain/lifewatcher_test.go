@@ -38,6 +38,10 @@ func (c changeEvent) Changed() string {
 	return c.changed
 }
 
+func (c changeEvent) ChangedColumns() []string {
+	return nil
+}
+
 var _ = gc.Suite(&lifeWatcherSuite{})
 
 func (s *lifeWatcherSuite) lifeGetter(ctx context.Context, db coredatabase.TxnRunner, ids []string) (map[string]life.Life, error) {
@@ -141,9 +141,10 @@ func (s *WatchableService) machineToCareForReboot(ctx context.Context, uuid stri
 // changeEventShim implements changestream.ChangeEvent and allows the
 // substituting of events in an implementation of eventsource.Mapper.
 type changeEventShim struct {
-	changeType changestream.ChangeType
-	namespace  string
-	changed    string
+	changeType     changestream.ChangeType
+	namespace      string
+	changed        string
+	changedColumns []string
 }
 
 // Type returns the type of change (create, update, delete).
@@ -164,6 +165,11 @@ func (e changeEventShim) Changed() string {
 	return e.changed
 }
 
+// ChangedColumns returns the columns that were changed for an update.
+func (e changeEventShim) ChangedColumns() []string {
+	return e.changedColumns
+}
+
 // uuidToNameMapper is an eventsource.Mapper that converts a slice of
 // changestream.ChangeEvent containing machine UUIDs to another slice of
 // events with the machine names that correspond to the UUIDs.
@@ -205,9 +211,10 @@ func uuidToNameMapper(filter func(string, machine.Name) bool) eventsource.Mapper
 
 				e := eventsByUUID[uuid]
 				newEvents = append(newEvents, changeEventShim{
-					changeType: e.Type(),
-					namespace:  e.Namespace(),
-					changed:    name,
+					changeType:     e.Type(),
+					namespace:      e.Namespace(),
+					changed:        name,
+					changedColumns: e.ChangedColumns(),
 				})
 			}
 
@@ -34,4 +34,16 @@ const (
 
 	// ErrInvalidHashLength is returned when the hash length is invalid.
 	ErrInvalidHashLength = errors.ConstError("invalid hash length")
+
+	// ErrBlobNotFound is returned when a blob is not found in the
+	// controller-wide blob store.
+	ErrBlobNotFound = errors.ConstError("blob not found")
+
+	// ErrBlobReferenced is returned when attempting to remove a blob that is
+	// still referenced by a namespace.
+	ErrBlobReferenced = errors.ConstError("blob is referenced")
+
+	// ErrQuotaExceeded is returned when storing an object would take the
+	// storage used by the objects over the quota.
+	ErrQuotaExceeded = errors.ConstError("quota exceeded")
 )
@@ -0,0 +1,198 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"regexp"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// The sha384Regexp is used to validate the SHA384 hash that addresses a blob.
+var sha384Regexp = regexp.MustCompile(`^[a-f0-9]{96}$`)
+
+// BlobState describes retrieval and persistence methods for the references to
+// the blobs in the controller-wide blob store.
+type BlobState interface {
+	// AddBlobReference records that the namespace references the blob, adding
+	// the blob if it isn't already known.
+	AddBlobReference(ctx context.Context, namespace string, blob objectstore.Blob) error
+
+	// AddBlobReferenceWithinQuota records that the namespace references the
+	// blob, as long as adding the blob doesn't take the total size of the
+	// blobs over the quota in bytes.
+	AddBlobReferenceWithinQuota(ctx context.Context, namespace string, blob objectstore.Blob, quota int64) error
+
+	// RemoveBlobReference removes the reference the namespace holds to the
+	// blob with the specified SHA384.
+	RemoveBlobReference(ctx context.Context, namespace, sha384 string) error
+
+	// ListBlobs returns the blobs in the blob store, along with the number of
+	// namespaces that reference each blob.
+	ListBlobs(ctx context.Context) ([]objectstore.Blob, error)
+
+	// GetBlob returns the blob with the specified SHA384, along with the
+	// number of namespaces that reference it.
+	GetBlob(ctx context.Context, sha384 string) (objectstore.Blob, error)
+
+	// GetBlobUsage returns the number and total size of the blobs in the blob
+	// store.
+	GetBlobUsage(ctx context.Context) (objectstore.Usage, error)
+
+	// RemoveBlob removes the blob with the specified SHA384, if it is no
+	// longer referenced.
+	RemoveBlob(ctx context.Context, sha384 string) error
+
+	// RemoveOrphanedBlobReferences removes the blob references held by
+	// namespaces that no longer exist.
+	RemoveOrphanedBlobReferences(ctx context.Context) (int64, error)
+}
+
+// BlobService provides the API for tracking the namespaces that reference
+// the blobs in the controller-wide, content addressed, blob store. A blob is
+// stored once, no matter how many namespaces store the same object, and is
+// only removed once no namespace references it.
+type BlobService struct {
+	st BlobState
+}
+
+// NewBlobService returns a new service reference wrapping the input state.
+func NewBlobService(st BlobState) *BlobService {
+	return &BlobService{
+		st: st,
+	}
+}
+
+// AddBlobReference records that the namespace references the blob. A
+// namespace only ever holds a single reference to a blob, adding a reference
+// that is already held is a no-op.
+func (s *BlobService) AddBlobReference(ctx context.Context, namespace string, blob objectstore.Blob) error {
+	if namespace == "" {
+		return errors.Errorf("empty namespace").Add(coreerrors.NotValid)
+	}
+	if !sha384Regexp.MatchString(blob.SHA384) {
+		return errors.Errorf("sha384 %q: %w", blob.SHA384, objectstoreerrors.ErrInvalidHash)
+	} else if !hashRegexp.MatchString(blob.SHA256) {
+		return errors.Errorf("sha256 %q: %w", blob.SHA256, objectstoreerrors.ErrInvalidHash)
+	}
+
+	if err := s.st.AddBlobReference(ctx, namespace, objectstore.Blob{
+		SHA256: blob.SHA256,
+		SHA384: blob.SHA384,
+		Size:   blob.Size,
+	}); err != nil {
+		return errors.Errorf("adding reference to blob %s for %s: %w", blob.SHA384, namespace, err)
+	}
+	return nil
+}
+
+// AddBlobReferenceWithinQuota records that the namespace references the blob,
+// as long as adding the blob doesn't take the total size of the blobs in the
+// blob store over the quota in bytes. Referencing a blob that is already
+// stored doesn't use any more storage. If the quota would be exceeded, a
+// [objectstoreerrors.ErrQuotaExceeded] error is returned.
+func (s *BlobService) AddBlobReferenceWithinQuota(ctx context.Context, namespace string, blob objectstore.Blob, quota int64) error {
+	if namespace == "" {
+		return errors.Errorf("empty namespace").Add(coreerrors.NotValid)
+	}
+	if !sha384Regexp.MatchString(blob.SHA384) {
+		return errors.Errorf("sha384 %q: %w", blob.SHA384, objectstoreerrors.ErrInvalidHash)
+	} else if !hashRegexp.MatchString(blob.SHA256) {
+		return errors.Errorf("sha256 %q: %w", blob.SHA256, objectstoreerrors.ErrInvalidHash)
+	}
+	if quota <= 0 {
+		return errors.Errorf("quota %d: %w", quota, coreerrors.NotValid)
+	}
+
+	if err := s.st.AddBlobReferenceWithinQuota(ctx, namespace, objectstore.Blob{
+		SHA256: blob.SHA256,
+		SHA384: blob.SHA384,
+		Size:   blob.Size,
+	}, quota); err != nil {
+		return errors.Errorf("adding reference to blob %s for %s: %w", blob.SHA384, namespace, err)
+	}
+	return nil
+}
+
+// RemoveBlobReference removes the reference the namespace holds to the blob
+// with the specified SHA384. The blob is left in the blob store until it is
+// removed by the garbage collector.
+func (s *BlobService) RemoveBlobReference(ctx context.Context, namespace, sha384 string) error {
+	if namespace == "" {
+		return errors.Errorf("empty namespace").Add(coreerrors.NotValid)
+	}
+	if !sha384Regexp.MatchString(sha384) {
+		return errors.Errorf("sha384 %q: %w", sha384, objectstoreerrors.ErrInvalidHash)
+	}
+
+	if err := s.st.RemoveBlobReference(ctx, namespace, sha384); err != nil {
+		return errors.Errorf("removing reference to blob %s for %s: %w", sha384, namespace, err)
+	}
+	return nil
+}
+
+// ListBlobs returns the blobs in the blob store, along with the number of
+// namespaces that reference each blob.
+func (s *BlobService) ListBlobs(ctx context.Context) ([]objectstore.Blob, error) {
+	blobs, err := s.st.ListBlobs(ctx)
+	if err != nil {
+		return nil, errors.Errorf("retrieving blobs: %w", err)
+	}
+	return blobs, nil
+}
+
+// GetBlob returns the blob with the specified SHA384, along with the number of
+// namespaces that reference it. If the blob isn't known, then a
+// [objectstoreerrors.ErrNotFound] error is returned.
+func (s *BlobService) GetBlob(ctx context.Context, sha384 string) (objectstore.Blob, error) {
+	if !sha384Regexp.MatchString(sha384) {
+		return objectstore.Blob{}, errors.Errorf("sha384 %q: %w", sha384, objectstoreerrors.ErrInvalidHash)
+	}
+
+	blob, err := s.st.GetBlob(ctx, sha384)
+	if err != nil {
+		return objectstore.Blob{}, errors.Errorf("retrieving blob %s: %w", sha384, err)
+	}
+	return blob, nil
+}
+
+// GetBlobUsage returns the number and total size of the blobs in the blob
+// store. Every blob is stored once, no matter how many namespaces reference
+// it, so this is the storage used by the objects of all the namespaces.
+func (s *BlobService) GetBlobUsage(ctx context.Context) (objectstore.Usage, error) {
+	usage, err := s.st.GetBlobUsage(ctx)
+	if err != nil {
+		return objectstore.Usage{}, errors.Errorf("retrieving blob usage: %w", err)
+	}
+	return usage, nil
+}
+
+// RemoveBlob removes the blob with the specified SHA384 from the blob store.
+// If the blob is still referenced by a namespace, then a
+// [objectstoreerrors.ErrBlobReferenced] error is returned.
+func (s *BlobService) RemoveBlob(ctx context.Context, sha384 string) error {
+	if !sha384Regexp.MatchString(sha384) {
+		return errors.Errorf("sha384 %q: %w", sha384, objectstoreerrors.ErrInvalidHash)
+	}
+
+	if err := s.st.RemoveBlob(ctx, sha384); err != nil {
+		return errors.Errorf("removing blob %s: %w", sha384, err)
+	}
+	return nil
+}
+
+// RemoveOrphanedBlobReferences removes the blob references held by
+// namespaces that no longer exist, so that the blobs they referenced can be
+// garbage collected. The number of references removed is returned.
+func (s *BlobService) RemoveOrphanedBlobReferences(ctx context.Context) (int64, error) {
+	removed, err := s.st.RemoveOrphanedBlobReferences(ctx)
+	if err != nil {
+		return -1, errors.Errorf("removing orphaned blob references: %w", err)
+	}
+	return removed, nil
+}
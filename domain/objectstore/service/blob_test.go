@@ -0,0 +1,198 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+type blobServiceSuite struct {
+	testing.IsolationSuite
+
+	state *MockBlobState
+}
+
+var _ = gc.Suite(&blobServiceSuite{})
+
+func (s *blobServiceSuite) TestAddBlobReference(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	s.state.EXPECT().AddBlobReference(gomock.Any(), "inferi", blob).Return(nil)
+
+	err := NewBlobService(s.state).AddBlobReference(context.Background(), "inferi", blob)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *blobServiceSuite) TestAddBlobReferenceEmptyNamespace(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewBlobService(s.state).AddBlobReference(context.Background(), "", s.blob("foo"))
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *blobServiceSuite) TestAddBlobReferenceInvalidHash(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	blob.SHA384 = "foo"
+
+	err := NewBlobService(s.state).AddBlobReference(context.Background(), "inferi", blob)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrInvalidHash)
+}
+
+func (s *blobServiceSuite) TestAddBlobReferenceWithinQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	s.state.EXPECT().AddBlobReferenceWithinQuota(gomock.Any(), "inferi", blob, int64(1024)).Return(nil)
+
+	err := NewBlobService(s.state).AddBlobReferenceWithinQuota(context.Background(), "inferi", blob, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *blobServiceSuite) TestAddBlobReferenceWithinQuotaExceeded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	s.state.EXPECT().AddBlobReferenceWithinQuota(gomock.Any(), "inferi", blob, int64(1024)).Return(objectstoreerrors.ErrQuotaExceeded)
+
+	err := NewBlobService(s.state).AddBlobReferenceWithinQuota(context.Background(), "inferi", blob, 1024)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrQuotaExceeded)
+}
+
+func (s *blobServiceSuite) TestAddBlobReferenceWithinQuotaInvalidQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewBlobService(s.state).AddBlobReferenceWithinQuota(context.Background(), "inferi", s.blob("foo"), 0)
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *blobServiceSuite) TestRemoveBlobReference(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	s.state.EXPECT().RemoveBlobReference(gomock.Any(), "inferi", blob.SHA384).Return(nil)
+
+	err := NewBlobService(s.state).RemoveBlobReference(context.Background(), "inferi", blob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *blobServiceSuite) TestRemoveBlobReferenceInvalidHash(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewBlobService(s.state).RemoveBlobReference(context.Background(), "inferi", "foo")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrInvalidHash)
+}
+
+func (s *blobServiceSuite) TestListBlobs(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	blob.References = 2
+	s.state.EXPECT().ListBlobs(gomock.Any()).Return([]objectstore.Blob{blob}, nil)
+
+	blobs, err := NewBlobService(s.state).ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(blobs, gc.DeepEquals, []objectstore.Blob{blob})
+}
+
+func (s *blobServiceSuite) TestGetBlob(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	blob.References = 1
+	s.state.EXPECT().GetBlob(gomock.Any(), blob.SHA384).Return(blob, nil)
+
+	result, err := NewBlobService(s.state).GetBlob(context.Background(), blob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, blob)
+}
+
+func (s *blobServiceSuite) TestGetBlobNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	s.state.EXPECT().GetBlob(gomock.Any(), blob.SHA384).Return(objectstore.Blob{}, objectstoreerrors.ErrNotFound)
+
+	_, err := NewBlobService(s.state).GetBlob(context.Background(), blob.SHA384)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *blobServiceSuite) TestGetBlobInvalidHash(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewBlobService(s.state).GetBlob(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrInvalidHash)
+}
+
+func (s *blobServiceSuite) TestGetBlobUsage(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetBlobUsage(gomock.Any()).Return(objectstore.Usage{Objects: 2, Size: 1024}, nil)
+
+	usage, err := NewBlobService(s.state).GetBlobUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.DeepEquals, objectstore.Usage{Objects: 2, Size: 1024})
+}
+
+func (s *blobServiceSuite) TestRemoveBlob(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	s.state.EXPECT().RemoveBlob(gomock.Any(), blob.SHA384).Return(nil)
+
+	err := NewBlobService(s.state).RemoveBlob(context.Background(), blob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *blobServiceSuite) TestRemoveBlobReferenced(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blob := s.blob("foo")
+	s.state.EXPECT().RemoveBlob(gomock.Any(), blob.SHA384).Return(objectstoreerrors.ErrBlobReferenced)
+
+	err := NewBlobService(s.state).RemoveBlob(context.Background(), blob.SHA384)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrBlobReferenced)
+}
+
+func (s *blobServiceSuite) TestRemoveOrphanedBlobReferences(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RemoveOrphanedBlobReferences(gomock.Any()).Return(3, nil)
+
+	removed, err := NewBlobService(s.state).RemoveOrphanedBlobReferences(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(removed, gc.Equals, int64(3))
+}
+
+func (s *blobServiceSuite) blob(contents string) objectstore.Blob {
+	hash384 := sha512.Sum384([]byte(contents))
+	hash256 := sha256.Sum256([]byte(contents))
+	return objectstore.Blob{
+		SHA384: hex.EncodeToString(hash384[:]),
+		SHA256: hex.EncodeToString(hash256[:]),
+		Size:   int64(len(contents)),
+	}
+}
+
+func (s *blobServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockBlobState(ctrl)
+
+	return ctrl
+}
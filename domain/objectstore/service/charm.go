@@ -0,0 +1,49 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// CharmState describes retrieval methods for the charm archives stored in the
+// object store.
+type CharmState interface {
+	// GetCharmDownloadURL returns the charmhub URL that the charm archive
+	// stored with the specified SHA256 was downloaded from.
+	GetCharmDownloadURL(ctx context.Context, sha256 string) (string, error)
+}
+
+// CharmService provides the API for locating the origin of the charm
+// archives stored in the object store, so they can be downloaded again if
+// the stored copy is lost.
+type CharmService struct {
+	st CharmState
+}
+
+// NewCharmService returns a new service reference wrapping the input state.
+func NewCharmService(st CharmState) *CharmService {
+	return &CharmService{
+		st: st,
+	}
+}
+
+// GetCharmDownloadURL returns the charmhub URL that the charm archive stored
+// with the specified SHA256 was downloaded from. If the object isn't a charm
+// archive downloaded from charmhub, then a [objectstoreerrors.ErrNotFound]
+// error is returned.
+func (s *CharmService) GetCharmDownloadURL(ctx context.Context, sha256 string) (string, error) {
+	if !hashRegexp.MatchString(sha256) {
+		return "", errors.Errorf("sha256 %q: %w", sha256, objectstoreerrors.ErrInvalidHash)
+	}
+
+	url, err := s.st.GetCharmDownloadURL(ctx, sha256)
+	if err != nil {
+		return "", errors.Errorf("retrieving charm download url for %s: %w", sha256, err)
+	}
+	return url, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+type charmServiceSuite struct {
+	testing.IsolationSuite
+
+	state *MockCharmState
+}
+
+var _ = gc.Suite(&charmServiceSuite{})
+
+const charmSHA256 = "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+
+func (s *charmServiceSuite) TestGetCharmDownloadURL(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetCharmDownloadURL(gomock.Any(), charmSHA256).Return("https://api.charmhub.io/foo_1.charm", nil)
+
+	url, err := NewCharmService(s.state).GetCharmDownloadURL(context.Background(), charmSHA256)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(url, gc.Equals, "https://api.charmhub.io/foo_1.charm")
+}
+
+func (s *charmServiceSuite) TestGetCharmDownloadURLNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetCharmDownloadURL(gomock.Any(), charmSHA256).Return("", objectstoreerrors.ErrNotFound)
+
+	_, err := NewCharmService(s.state).GetCharmDownloadURL(context.Background(), charmSHA256)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *charmServiceSuite) TestGetCharmDownloadURLInvalidHash(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewCharmService(s.state).GetCharmDownloadURL(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrInvalidHash)
+}
+
+func (s *charmServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockCharmState(ctrl)
+
+	return ctrl
+}
@@ -10,6 +10,9 @@ import (
 )
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/objectstore/service State,WatcherFactory
+//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination blobstate_mock_test.go github.com/juju/juju/domain/objectstore/service BlobState
+//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination charmstate_mock_test.go github.com/juju/juju/domain/objectstore/service CharmState
+//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination usagestate_mock_test.go github.com/juju/juju/domain/objectstore/service UsageState
 
 func TestPackage(t *testing.T) {
 	gc.TestingT(t)
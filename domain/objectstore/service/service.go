@@ -8,6 +8,7 @@ import (
 	"regexp"
 
 	"github.com/juju/juju/core/changestream"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
@@ -48,6 +49,11 @@ type State interface {
 	// PutMetadata adds a new specified path for the persistence metadata.
 	PutMetadata(ctx context.Context, metadata objectstore.Metadata) (objectstore.UUID, error)
 
+	// PutMetadataWithinQuota adds a new specified path for the persistence
+	// metadata, as long as the total size of the objects stored doesn't
+	// exceed the quota in bytes.
+	PutMetadataWithinQuota(ctx context.Context, metadata objectstore.Metadata, quota int64) (objectstore.UUID, error)
+
 	// ListMetadata returns the persistence metadata for all paths.
 	ListMetadata(ctx context.Context) ([]objectstore.Metadata, error)
 
@@ -177,6 +183,36 @@ func (s *Service) PutMetadata(ctx context.Context, metadata objectstore.Metadata
 	return uuid, nil
 }
 
+// PutMetadataWithinQuota adds a new specified path for the persistence
+// metadata, as long as storing the object doesn't take the total size of the
+// objects over the quota in bytes. An object with the same contents as an
+// object that is already stored doesn't use any more storage. If the quota
+// would be exceeded, a [objectstoreerrors.ErrQuotaExceeded] error is
+// returned.
+func (s *Service) PutMetadataWithinQuota(ctx context.Context, metadata objectstore.Metadata, quota int64) (objectstore.UUID, error) {
+	// If you have one hash, you must have the other.
+	if h1, h2 := metadata.SHA384, metadata.SHA256; h1 != "" && h2 == "" {
+		return "", errors.Errorf("missing hash256: %w", objectstoreerrors.ErrMissingHash)
+	} else if h1 == "" && h2 != "" {
+		return "", errors.Errorf("missing hash384: %w", objectstoreerrors.ErrMissingHash)
+	}
+	if quota <= 0 {
+		return "", errors.Errorf("quota %d: %w", quota, coreerrors.NotValid)
+	}
+
+	uuid, err := s.st.PutMetadataWithinQuota(ctx, objectstore.Metadata{
+		SHA256: metadata.SHA256,
+		SHA384: metadata.SHA384,
+		Path:   metadata.Path,
+		Size:   metadata.Size,
+	}, quota)
+	if err != nil {
+		return "", errors.Errorf("adding path %s: %w", metadata.Path, err)
+	}
+
+	return uuid, nil
+}
+
 // RemoveMetadata removes the specified path for the persistence metadata.
 func (s *Service) RemoveMetadata(ctx context.Context, path string) error {
 	err := s.st.RemoveMetadata(ctx, path)
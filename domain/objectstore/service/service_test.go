@@ -211,6 +211,40 @@ func (s *serviceSuite) TestPutMetadata(c *gc.C) {
 	c.Check(result, gc.Equals, uuid)
 }
 
+func (s *serviceSuite) TestPutMetadataWithinQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metadata := objectstore.Metadata{
+		Path:   uuid.MustNewUUID().String(),
+		SHA256: uuid.MustNewUUID().String(),
+		SHA384: uuid.MustNewUUID().String(),
+		Size:   666,
+	}
+
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+	s.state.EXPECT().PutMetadataWithinQuota(gomock.Any(), metadata, int64(1024)).Return(uuid, nil)
+
+	result, err := NewService(s.state).PutMetadataWithinQuota(context.Background(), metadata, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.Equals, uuid)
+}
+
+func (s *serviceSuite) TestPutMetadataWithinQuotaExceeded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metadata := objectstore.Metadata{
+		Path:   uuid.MustNewUUID().String(),
+		SHA256: uuid.MustNewUUID().String(),
+		SHA384: uuid.MustNewUUID().String(),
+		Size:   666,
+	}
+
+	s.state.EXPECT().PutMetadataWithinQuota(gomock.Any(), metadata, int64(1024)).Return("", objectstoreerrors.ErrQuotaExceeded)
+
+	_, err := NewService(s.state).PutMetadataWithinQuota(context.Background(), metadata, 1024)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrQuotaExceeded)
+}
+
 func (s *serviceSuite) TestPutMetadataMissingSHA384(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
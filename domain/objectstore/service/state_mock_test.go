@@ -277,6 +277,45 @@ func (c *MockStatePutMetadataCall) DoAndReturn(f func(context.Context, objectsto
 	return c
 }
 
+// PutMetadataWithinQuota mocks base method.
+func (m *MockState) PutMetadataWithinQuota(arg0 context.Context, arg1 objectstore.Metadata, arg2 int64) (objectstore.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMetadataWithinQuota", arg0, arg1, arg2)
+	ret0, _ := ret[0].(objectstore.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMetadataWithinQuota indicates an expected call of PutMetadataWithinQuota.
+func (mr *MockStateMockRecorder) PutMetadataWithinQuota(arg0, arg1, arg2 any) *MockStatePutMetadataWithinQuotaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMetadataWithinQuota", reflect.TypeOf((*MockState)(nil).PutMetadataWithinQuota), arg0, arg1, arg2)
+	return &MockStatePutMetadataWithinQuotaCall{Call: call}
+}
+
+// MockStatePutMetadataWithinQuotaCall wrap *gomock.Call
+type MockStatePutMetadataWithinQuotaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatePutMetadataWithinQuotaCall) Return(arg0 objectstore.UUID, arg1 error) *MockStatePutMetadataWithinQuotaCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatePutMetadataWithinQuotaCall) Do(f func(context.Context, objectstore.Metadata, int64) (objectstore.UUID, error)) *MockStatePutMetadataWithinQuotaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatePutMetadataWithinQuotaCall) DoAndReturn(f func(context.Context, objectstore.Metadata, int64) (objectstore.UUID, error)) *MockStatePutMetadataWithinQuotaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveMetadata mocks base method.
 func (m *MockState) RemoveMetadata(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,44 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+)
+
+// UsageState describes retrieval methods for the storage used by the objects
+// in the object store.
+type UsageState interface {
+	// GetUsage returns the number and total size of the distinct objects
+	// stored in the object store.
+	GetUsage(ctx context.Context) (objectstore.Usage, error)
+}
+
+// UsageService provides the API for accounting for the storage used by the
+// objects in the object store, so that it can be reported and checked
+// against the object store quotas.
+type UsageService struct {
+	st UsageState
+}
+
+// NewUsageService returns a new service reference wrapping the input state.
+func NewUsageService(st UsageState) *UsageService {
+	return &UsageService{
+		st: st,
+	}
+}
+
+// GetUsage returns the number and total size of the distinct objects stored
+// in the object store. Objects stored at more than one path are only counted
+// once.
+func (s *UsageService) GetUsage(ctx context.Context) (objectstore.Usage, error) {
+	usage, err := s.st.GetUsage(ctx)
+	if err != nil {
+		return objectstore.Usage{}, errors.Errorf("retrieving object store usage: %w", err)
+	}
+	return usage, nil
+}
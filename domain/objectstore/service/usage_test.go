@@ -0,0 +1,51 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+)
+
+type usageServiceSuite struct {
+	testing.IsolationSuite
+
+	state *MockUsageState
+}
+
+var _ = gc.Suite(&usageServiceSuite{})
+
+func (s *usageServiceSuite) TestGetUsage(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUsage(gomock.Any()).Return(objectstore.Usage{Objects: 3, Size: 4096}, nil)
+
+	usage, err := NewUsageService(s.state).GetUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.DeepEquals, objectstore.Usage{Objects: 3, Size: 4096})
+}
+
+func (s *usageServiceSuite) TestGetUsageError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUsage(gomock.Any()).Return(objectstore.Usage{}, errors.Errorf("boom"))
+
+	_, err := NewUsageService(s.state).GetUsage(context.Background())
+	c.Assert(err, gc.ErrorMatches, `retrieving object store usage: boom`)
+}
+
+func (s *usageServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockUsageState(ctrl)
+
+	return ctrl
+}
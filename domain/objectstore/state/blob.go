@@ -0,0 +1,326 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/transform"
+	"github.com/juju/errors"
+
+	coredatabase "github.com/juju/juju/core/database"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+// AddBlobReference records that the namespace references the blob in the
+// controller-wide blob store, adding the blob if it isn't already known.
+// Adding a reference that the namespace already holds is a no-op. If the
+// blob is known with a different size, then a
+// [objectstoreerrors.ErrHashAndSizeAlreadyExists] error is returned.
+func (s *State) AddBlobReference(ctx context.Context, namespace string, blob coreobjectstore.Blob) error {
+	return s.addBlobReference(ctx, namespace, blob, 0)
+}
+
+// AddBlobReferenceWithinQuota records that the namespace references the blob
+// in the controller-wide blob store, as long as adding the blob doesn't take
+// the total size of the blobs over the quota in bytes. The quota is checked
+// in the same transaction as the blob is added, so concurrent puts can't
+// exceed it. Referencing a blob that is already stored doesn't use any more
+// storage. If the quota would be exceeded, then a
+// [objectstoreerrors.ErrQuotaExceeded] error is returned.
+func (s *State) AddBlobReferenceWithinQuota(ctx context.Context, namespace string, blob coreobjectstore.Blob, quota int64) error {
+	return s.addBlobReference(ctx, namespace, blob, quota)
+}
+
+// addBlobReference records that the namespace references the blob. A quota
+// of 0 means that there is no quota.
+func (s *State) addBlobReference(ctx context.Context, namespace string, blob coreobjectstore.Blob, quota int64) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	blobRow := dbBlob{
+		SHA384: blob.SHA384,
+		SHA256: blob.SHA256,
+		Size:   blob.Size,
+	}
+	referenceRow := dbBlobReference{
+		SHA384:    blob.SHA384,
+		Namespace: namespace,
+	}
+
+	blobStmt, err := s.Prepare(`
+INSERT INTO object_store_blob (sha_384, sha_256, size)
+VALUES ($dbBlob.sha_384, $dbBlob.sha_256, $dbBlob.size)
+ON CONFLICT (sha_384) DO NOTHING`, blobRow)
+	if err != nil {
+		return errors.Annotate(err, "preparing insert blob statement")
+	}
+
+	blobLookupStmt, err := s.Prepare(`
+SELECT &dbBlob.*
+FROM   v_object_store_blob
+WHERE  sha_384 = $dbBlob.sha_384`, blobRow)
+	if err != nil {
+		return errors.Annotate(err, "preparing select blob statement")
+	}
+
+	referenceStmt, err := s.Prepare(`
+INSERT INTO object_store_blob_reference (*)
+VALUES ($dbBlobReference.*)
+ON CONFLICT (sha_384, namespace) DO NOTHING`, referenceRow)
+	if err != nil {
+		return errors.Annotate(err, "preparing insert blob reference statement")
+	}
+
+	var usage dbUsage
+	usageStmt, err := s.Prepare(`
+SELECT COALESCE(SUM(size), 0) AS &dbUsage.size
+FROM   object_store_blob`, usage)
+	if err != nil {
+		return errors.Annotate(err, "preparing select blob usage statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, blobStmt, blobRow).Get(&outcome); err != nil {
+			return errors.Annotate(err, "inserting blob")
+		}
+
+		// Only a new blob uses more storage. Returning the error rolls back
+		// the transaction, so the blob is never added.
+		if rows, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Annotate(err, "inserting blob")
+		} else if rows == 1 && quota > 0 {
+			if err := tx.Query(ctx, usageStmt).Get(&usage); err != nil {
+				return errors.Annotate(err, "retrieving blob usage")
+			}
+			if usage.Size > quota {
+				return errors.Annotatef(objectstoreerrors.ErrQuotaExceeded,
+					"using %d of the %d bytes quota with a blob of %d bytes", usage.Size, quota, blob.Size)
+			}
+		}
+
+		// The blob may have already been added by another namespace, so
+		// ensure that it's the same blob.
+		var existing dbBlob
+		if err := tx.Query(ctx, blobLookupStmt, blobRow).Get(&existing); err != nil {
+			return errors.Annotate(err, "retrieving blob")
+		}
+		if existing.Size != blob.Size {
+			return objectstoreerrors.ErrHashAndSizeAlreadyExists
+		}
+
+		if err := tx.Query(ctx, referenceStmt, referenceRow).Run(); err != nil {
+			return errors.Annotate(err, "inserting blob reference")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Annotatef(err, "adding reference to blob %s for %s", blob.SHA384, namespace)
+	}
+	return nil
+}
+
+// RemoveBlobReference removes the reference the namespace holds to the blob
+// with the specified SHA384. The blob itself is left for the garbage
+// collector to remove, once it is no longer referenced.
+func (s *State) RemoveBlobReference(ctx context.Context, namespace, sha384 string) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	referenceRow := dbBlobReference{
+		SHA384:    sha384,
+		Namespace: namespace,
+	}
+
+	stmt, err := s.Prepare(`
+DELETE FROM object_store_blob_reference
+WHERE  sha_384 = $dbBlobReference.sha_384
+AND    namespace = $dbBlobReference.namespace`, referenceRow)
+	if err != nil {
+		return errors.Annotate(err, "preparing delete blob reference statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Trace(tx.Query(ctx, stmt, referenceRow).Run())
+	})
+	if err != nil {
+		return errors.Annotatef(err, "removing reference to blob %s for %s", sha384, namespace)
+	}
+	return nil
+}
+
+// ListBlobs returns the blobs in the controller-wide blob store, along with
+// the number of namespaces that reference each blob.
+func (s *State) ListBlobs(ctx context.Context) ([]coreobjectstore.Blob, error) {
+	db, err := s.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &dbBlob.*
+FROM   v_object_store_blob`, dbBlob{})
+	if err != nil {
+		return nil, errors.Annotate(err, "preparing select blobs statement")
+	}
+
+	var blobs []dbBlob
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&blobs)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotate(err, "retrieving blobs")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return transform.Slice(blobs, decodeDbBlob), nil
+}
+
+// GetBlob returns the blob with the specified SHA384 from the controller-wide
+// blob store, along with the number of namespaces that reference it. If the
+// blob isn't known, then a [objectstoreerrors.ErrNotFound] error is returned.
+func (s *State) GetBlob(ctx context.Context, sha384 string) (coreobjectstore.Blob, error) {
+	db, err := s.DB()
+	if err != nil {
+		return coreobjectstore.Blob{}, errors.Trace(err)
+	}
+
+	blob := dbBlob{SHA384: sha384}
+	stmt, err := s.Prepare(`
+SELECT &dbBlob.*
+FROM   v_object_store_blob
+WHERE  sha_384 = $dbBlob.sha_384`, blob)
+	if err != nil {
+		return coreobjectstore.Blob{}, errors.Annotate(err, "preparing select blob statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, blob).Get(&blob)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return objectstoreerrors.ErrNotFound
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return coreobjectstore.Blob{}, errors.Annotatef(err, "retrieving blob %s", sha384)
+	}
+	return decodeDbBlob(blob), nil
+}
+
+// GetBlobUsage returns the number and total size of the blobs in the
+// controller-wide blob store. As every blob is stored once, this is the
+// storage used by the objects of all the namespaces.
+func (s *State) GetBlobUsage(ctx context.Context) (coreobjectstore.Usage, error) {
+	db, err := s.DB()
+	if err != nil {
+		return coreobjectstore.Usage{}, errors.Trace(err)
+	}
+
+	var usage dbUsage
+	stmt, err := s.Prepare(`
+SELECT COUNT(*) AS &dbUsage.objects,
+       COALESCE(SUM(size), 0) AS &dbUsage.size
+FROM   object_store_blob`, usage)
+	if err != nil {
+		return coreobjectstore.Usage{}, errors.Annotate(err, "preparing select blob usage statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Trace(tx.Query(ctx, stmt).Get(&usage))
+	})
+	if err != nil {
+		return coreobjectstore.Usage{}, errors.Annotate(err, "retrieving blob usage")
+	}
+	return decodeDbUsage(usage), nil
+}
+
+// RemoveBlob removes the blob with the specified SHA384 from the
+// controller-wide blob store. If the blob is still referenced by a namespace,
+// then a [objectstoreerrors.ErrBlobReferenced] error is returned. Removing
+// a blob that isn't known is a no-op.
+func (s *State) RemoveBlob(ctx context.Context, sha384 string) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	blob := dbBlob{SHA384: sha384}
+
+	lookupStmt, err := s.Prepare(`
+SELECT &dbBlob.*
+FROM   v_object_store_blob
+WHERE  sha_384 = $dbBlob.sha_384`, blob)
+	if err != nil {
+		return errors.Annotate(err, "preparing select blob statement")
+	}
+
+	deleteStmt, err := s.Prepare(`
+DELETE FROM object_store_blob
+WHERE  sha_384 = $dbBlob.sha_384`, blob)
+	if err != nil {
+		return errors.Annotate(err, "preparing delete blob statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existing dbBlob
+		err := tx.Query(ctx, lookupStmt, blob).Get(&existing)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		if existing.References > 0 {
+			return objectstoreerrors.ErrBlobReferenced
+		}
+		return errors.Trace(tx.Query(ctx, deleteStmt, blob).Run())
+	})
+	if err != nil {
+		return errors.Annotatef(err, "removing blob %s", sha384)
+	}
+	return nil
+}
+
+// RemoveOrphanedBlobReferences removes the blob references held by
+// namespaces that no longer exist, which is every namespace other than the
+// controller that isn't a model. The number of references removed is
+// returned.
+func (s *State) RemoveOrphanedBlobReferences(ctx context.Context) (int64, error) {
+	db, err := s.DB()
+	if err != nil {
+		return -1, errors.Trace(err)
+	}
+
+	controller := dbBlobReference{Namespace: coredatabase.ControllerNS}
+
+	stmt, err := s.Prepare(`
+DELETE FROM object_store_blob_reference
+WHERE  namespace != $dbBlobReference.namespace
+AND    namespace NOT IN (SELECT uuid FROM model)`, controller)
+	if err != nil {
+		return -1, errors.Annotate(err, "preparing delete blob references statement")
+	}
+
+	var removed int64
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, controller).Get(&outcome); err != nil {
+			return errors.Trace(err)
+		}
+		removed, err = outcome.Result().RowsAffected()
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return -1, errors.Annotate(err, "removing orphaned blob references")
+	}
+	return removed, nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coredatabase "github.com/juju/juju/core/database"
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type blobSuite struct {
+	schematesting.ControllerSuite
+}
+
+var _ = gc.Suite(&blobSuite{})
+
+var testBlob = coreobjectstore.Blob{
+	SHA256: "sha256",
+	SHA384: "sha384",
+	Size:   666,
+}
+
+func (s *blobSuite) TestAddBlobReference(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddBlobReference(context.Background(), "bar", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+
+	blobs, err := st.ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(blobs, gc.DeepEquals, []coreobjectstore.Blob{{
+		SHA256:     "sha256",
+		SHA384:     "sha384",
+		Size:       666,
+		References: 2,
+	}})
+}
+
+func (s *blobSuite) TestAddBlobReferenceIdempotent(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+
+	blobs, err := st.ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(blobs, gc.HasLen, 1)
+	c.Check(blobs[0].References, gc.Equals, int64(1))
+}
+
+func (s *blobSuite) TestAddBlobReferenceDifferentSize(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+
+	blob := testBlob
+	blob.Size = 42
+	err = st.AddBlobReference(context.Background(), "bar", blob)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrHashAndSizeAlreadyExists)
+}
+
+func (s *blobSuite) TestAddBlobReferenceWithinQuota(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReferenceWithinQuota(context.Background(), "foo", testBlob, 1000)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Referencing a blob that is already stored doesn't use any more
+	// storage.
+	err = st.AddBlobReferenceWithinQuota(context.Background(), "bar", testBlob, 1000)
+	c.Assert(err, jc.ErrorIsNil)
+
+	blobs, err := st.ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(blobs, gc.HasLen, 1)
+	c.Check(blobs[0].References, gc.Equals, int64(2))
+}
+
+func (s *blobSuite) TestAddBlobReferenceWithinQuotaExceeded(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReferenceWithinQuota(context.Background(), "foo", testBlob, 1000)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.AddBlobReferenceWithinQuota(context.Background(), "foo", coreobjectstore.Blob{
+		SHA256: "other-sha256",
+		SHA384: "other-sha384",
+		Size:   335,
+	}, 1000)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrQuotaExceeded)
+
+	// The blob that exceeded the quota isn't added.
+	_, err = st.GetBlob(context.Background(), "other-sha384")
+	c.Check(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *blobSuite) TestRemoveBlobReference(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddBlobReference(context.Background(), "bar", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RemoveBlobReference(context.Background(), "foo", testBlob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Removing a reference that isn't held is a no-op.
+	err = st.RemoveBlobReference(context.Background(), "foo", testBlob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+
+	blobs, err := st.ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(blobs, gc.HasLen, 1)
+	c.Check(blobs[0].References, gc.Equals, int64(1))
+}
+
+func (s *blobSuite) TestListBlobsNoRows(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	blobs, err := st.ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(blobs, gc.HasLen, 0)
+}
+
+func (s *blobSuite) TestGetBlob(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+
+	blob, err := st.GetBlob(context.Background(), testBlob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(blob, gc.DeepEquals, coreobjectstore.Blob{
+		SHA256:     "sha256",
+		SHA384:     "sha384",
+		Size:       666,
+		References: 1,
+	})
+}
+
+func (s *blobSuite) TestGetBlobNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetBlob(context.Background(), testBlob.SHA384)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *blobSuite) TestGetBlobUsage(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddBlobReference(context.Background(), "bar", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddBlobReference(context.Background(), "bar", coreobjectstore.Blob{
+		SHA256: "other-sha256",
+		SHA384: "other-sha384",
+		Size:   42,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Blobs referenced by more than one namespace are only counted once.
+	usage, err := st.GetBlobUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.DeepEquals, coreobjectstore.Usage{
+		Objects: 2,
+		Size:    708,
+	})
+}
+
+func (s *blobSuite) TestRemoveBlob(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.RemoveBlobReference(context.Background(), "foo", testBlob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RemoveBlob(context.Background(), testBlob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+
+	blobs, err := st.ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(blobs, gc.HasLen, 0)
+}
+
+func (s *blobSuite) TestRemoveBlobReferenced(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddBlobReference(context.Background(), "foo", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RemoveBlob(context.Background(), testBlob.SHA384)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrBlobReferenced)
+}
+
+func (s *blobSuite) TestRemoveBlobNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.RemoveBlob(context.Background(), testBlob.SHA384)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *blobSuite) TestRemoveOrphanedBlobReferences(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	// The controller namespace is never orphaned, but a namespace for a
+	// model that doesn't exist is.
+	err := st.AddBlobReference(context.Background(), coredatabase.ControllerNS, testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddBlobReference(context.Background(), "deadbeef-0bad-400d-8000-4b1d0d06f00d", testBlob)
+	c.Assert(err, jc.ErrorIsNil)
+
+	removed, err := st.RemoveOrphanedBlobReferences(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(removed, gc.Equals, int64(1))
+
+	blobs, err := st.ListBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(blobs, gc.HasLen, 1)
+	c.Check(blobs[0].References, gc.Equals, int64(1))
+}
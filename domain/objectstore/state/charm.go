@@ -0,0 +1,52 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+)
+
+// GetCharmDownloadURL returns the charmhub URL that the charm archive stored
+// with the specified SHA256 was downloaded from. If the object isn't a charm
+// archive downloaded from charmhub, then a [objectstoreerrors.ErrNotFound]
+// error is returned.
+func (s *State) GetCharmDownloadURL(ctx context.Context, sha256 string) (string, error) {
+	db, err := s.DB()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	ident := sha256Ident{SHA256: sha256}
+	var downloadURL charmDownloadURL
+
+	stmt, err := s.Prepare(`
+SELECT cdi.download_url AS &charmDownloadURL.download_url
+FROM   object_store_metadata AS osm
+JOIN   charm AS c ON c.object_store_uuid = osm.uuid
+JOIN   charm_download_info AS cdi ON cdi.charm_uuid = c.uuid
+JOIN   charm_source AS cs ON cs.id = c.source_id
+WHERE  osm.sha_256 = $sha256Ident.sha_256
+AND    cs.name = 'charmhub'
+AND    cdi.download_url != ''`, downloadURL, ident)
+	if err != nil {
+		return "", errors.Annotate(err, "preparing select charm download url statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).Get(&downloadURL)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return objectstoreerrors.ErrNotFound
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return "", errors.Annotatef(err, "retrieving charm download url with sha256 %s", sha256)
+	}
+	return downloadURL.DownloadURL, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	objectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type charmSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&charmSuite{})
+
+func (s *charmSuite) TestGetCharmDownloadURL(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	objectUUID := s.putMetadata(c, st, "sha256")
+	s.addCharm(c, objectUUID, 1, "https://api.charmhub.io/foo_1.charm")
+
+	url, err := st.GetCharmDownloadURL(context.Background(), "sha256")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(url, gc.Equals, "https://api.charmhub.io/foo_1.charm")
+}
+
+func (s *charmSuite) TestGetCharmDownloadURLNotCharm(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.putMetadata(c, st, "sha256")
+
+	_, err := st.GetCharmDownloadURL(context.Background(), "sha256")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *charmSuite) TestGetCharmDownloadURLLocalCharm(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	objectUUID := s.putMetadata(c, st, "sha256")
+	s.addCharm(c, objectUUID, 0, "")
+
+	_, err := st.GetCharmDownloadURL(context.Background(), "sha256")
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+}
+
+func (s *charmSuite) putMetadata(c *gc.C, st *State, sha256 string) string {
+	uuid, err := st.PutMetadata(context.Background(), coreobjectstore.Metadata{
+		SHA256: sha256,
+		SHA384: "sha384",
+		Path:   "charms/foo",
+		Size:   666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return uuid.String()
+}
+
+func (s *charmSuite) addCharm(c *gc.C, objectUUID string, sourceID int, downloadURL string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO charm (uuid, reference_name, source_id, architecture_id, object_store_uuid)
+VALUES ('charm-uuid', 'foo', ?, 0, ?)`, sourceID, objectUUID)
+		if err != nil {
+			return err
+		}
+		if downloadURL == "" {
+			return nil
+		}
+		_, err = tx.ExecContext(ctx, `
+INSERT INTO charm_download_info (charm_uuid, provenance_id, charmhub_identifier, download_url, download_size)
+VALUES ('charm-uuid', 0, 'ident', ?, 666)`, downloadURL)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -165,6 +165,23 @@ FROM v_object_store_metadata`, dbMetadata{})
 
 // PutMetadata adds a new specified path for the persistence metadata.
 func (s *State) PutMetadata(ctx context.Context, metadata coreobjectstore.Metadata) (coreobjectstore.UUID, error) {
+	return s.putMetadata(ctx, metadata, 0)
+}
+
+// PutMetadataWithinQuota adds a new specified path for the persistence
+// metadata, as long as the total size of the distinct objects stored doesn't
+// exceed the quota in bytes. The quota is checked in the same transaction as
+// the metadata is added, so concurrent puts can't exceed it. Adding a path
+// for an object that is already stored doesn't use any more storage. If the
+// quota would be exceeded, then a [objectstoreerrors.ErrQuotaExceeded] error
+// is returned.
+func (s *State) PutMetadataWithinQuota(ctx context.Context, metadata coreobjectstore.Metadata, quota int64) (coreobjectstore.UUID, error) {
+	return s.putMetadata(ctx, metadata, quota)
+}
+
+// putMetadata adds the path for the persistence metadata. A quota of 0 means
+// that there is no quota.
+func (s *State) putMetadata(ctx context.Context, metadata coreobjectstore.Metadata, quota int64) (coreobjectstore.UUID, error) {
 	db, err := s.DB()
 	if err != nil {
 		return "", errors.Trace(err)
@@ -215,6 +232,14 @@ AND size = $dbMetadata.size`, dbMetadata, dbMetadataPath)
 		return "", errors.Annotate(err, "preparing select metadata statement")
 	}
 
+	var usage dbUsage
+	usageStmt, err := s.Prepare(`
+SELECT COALESCE(SUM(size), 0) AS &dbUsage.size
+FROM   object_store_metadata`, usage)
+	if err != nil {
+		return "", errors.Annotate(err, "preparing select usage statement")
+	}
+
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		var outcome sqlair.Outcome
 		err := tx.Query(ctx, metadataStmt, dbMetadata).Get(&outcome)
@@ -240,6 +265,16 @@ AND size = $dbMetadata.size`, dbMetadata, dbMetadataPath)
 			if err != nil {
 				return errors.Annotatef(err, "parsing present uuid in metadata")
 			}
+		} else if quota > 0 {
+			// Only a new object uses more storage. Returning the error rolls
+			// back the transaction, so the object is never added.
+			if err := tx.Query(ctx, usageStmt).Get(&usage); err != nil {
+				return errors.Annotatef(err, "retrieving usage")
+			}
+			if usage.Size > quota {
+				return errors.Annotatef(objectstoreerrors.ErrQuotaExceeded,
+					"using %d of the %d bytes quota with an object of %d bytes", usage.Size, quota, metadata.Size)
+			}
 		}
 
 		err = tx.Query(ctx, pathStmt, dbMetadataPath).Get(&outcome)
@@ -191,6 +191,65 @@ func (s *stateSuite) TestPutMetadataConflict(c *gc.C) {
 	c.Check(err, jc.ErrorIs, objectstoreerrors.ErrPathAlreadyExistsDifferentHash)
 }
 
+func (s *stateSuite) TestPutMetadataWithinQuota(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.PutMetadataWithinQuota(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-foo",
+		SHA384: "hash-384-foo",
+		Path:   "foo",
+		Size:   600,
+	}, 1000)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The same object stored at another path doesn't use any more storage.
+	_, err = st.PutMetadataWithinQuota(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-foo",
+		SHA384: "hash-384-foo",
+		Path:   "bar",
+		Size:   600,
+	}, 1000)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.PutMetadataWithinQuota(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-baz",
+		SHA384: "hash-384-baz",
+		Path:   "baz",
+		Size:   400,
+	}, 1000)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestPutMetadataWithinQuotaExceeded(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.PutMetadataWithinQuota(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-foo",
+		SHA384: "hash-384-foo",
+		Path:   "foo",
+		Size:   600,
+	}, 1000)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.PutMetadataWithinQuota(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-bar",
+		SHA384: "hash-384-bar",
+		Path:   "bar",
+		Size:   401,
+	}, 1000)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ErrQuotaExceeded)
+
+	// The object that exceeded the quota isn't added.
+	_, err = st.GetMetadata(context.Background(), "bar")
+	c.Check(err, jc.ErrorIs, objectstoreerrors.ErrNotFound)
+	usage, err := st.GetUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.DeepEquals, coreobjectstore.Usage{
+		Objects: 1,
+		Size:    600,
+	})
+}
+
 func (s *stateSuite) TestPutMetadataWithSameHashesAndSize(c *gc.C) {
 	st := NewState(s.TxnRunnerFactory())
 
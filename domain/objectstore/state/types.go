@@ -48,3 +48,56 @@ func decodeDbMetadata(m dbMetadata) coreobjectstore.Metadata {
 		Size:   m.Size,
 	}
 }
+
+// dbBlob represents the database serialisable blob in the controller-wide
+// blob store.
+type dbBlob struct {
+	// SHA384 is the 512-384 hash of the blob.
+	SHA384 string `db:"sha_384"`
+	// SHA256 is the 256 hash of the blob.
+	SHA256 string `db:"sha_256"`
+	// Size is the size of the blob.
+	Size int64 `db:"size"`
+	// References is the number of namespaces referencing the blob.
+	References int64 `db:"ref_count"`
+}
+
+// dbBlobReference represents the database serialisable reference a namespace
+// holds to a blob.
+type dbBlobReference struct {
+	// SHA384 is the 512-384 hash of the blob.
+	SHA384 string `db:"sha_384"`
+	// Namespace is the namespace referencing the blob.
+	Namespace string `db:"namespace"`
+}
+
+func decodeDbBlob(b dbBlob) coreobjectstore.Blob {
+	return coreobjectstore.Blob{
+		SHA256:     b.SHA256,
+		SHA384:     b.SHA384,
+		Size:       b.Size,
+		References: b.References,
+	}
+}
+
+// charmDownloadURL represents the URL that a charm archive was downloaded
+// from.
+type charmDownloadURL struct {
+	// DownloadURL is the charmhub URL of the charm archive.
+	DownloadURL string `db:"download_url"`
+}
+
+// dbUsage represents the database serialisable usage of the object store.
+type dbUsage struct {
+	// Objects is the number of distinct objects.
+	Objects int64 `db:"objects"`
+	// Size is the total size of the distinct objects.
+	Size int64 `db:"size"`
+}
+
+func decodeDbUsage(u dbUsage) coreobjectstore.Usage {
+	return coreobjectstore.Usage{
+		Objects: u.Objects,
+		Size:    u.Size,
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+)
+
+// GetUsage returns the number and total size of the distinct objects stored
+// in the object store. Objects stored at more than one path are only counted
+// once.
+func (s *State) GetUsage(ctx context.Context) (coreobjectstore.Usage, error) {
+	db, err := s.DB()
+	if err != nil {
+		return coreobjectstore.Usage{}, errors.Trace(err)
+	}
+
+	var usage dbUsage
+	stmt, err := s.Prepare(`
+SELECT COUNT(*) AS &dbUsage.objects,
+       COALESCE(SUM(size), 0) AS &dbUsage.size
+FROM   object_store_metadata`, usage)
+	if err != nil {
+		return coreobjectstore.Usage{}, errors.Annotate(err, "preparing select usage statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Trace(tx.Query(ctx, stmt).Get(&usage))
+	})
+	if err != nil {
+		return coreobjectstore.Usage{}, errors.Annotate(err, "retrieving usage")
+	}
+	return decodeDbUsage(usage), nil
+}
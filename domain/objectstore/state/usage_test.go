@@ -0,0 +1,64 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coreobjectstore "github.com/juju/juju/core/objectstore"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type usageSuite struct {
+	schematesting.ControllerSuite
+}
+
+var _ = gc.Suite(&usageSuite{})
+
+func (s *usageSuite) TestGetUsage(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.PutMetadata(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-foo",
+		SHA384: "hash-384-foo",
+		Path:   "foo",
+		Size:   666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The same object stored at another path is only counted once.
+	_, err = st.PutMetadata(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-foo",
+		SHA384: "hash-384-foo",
+		Path:   "bar",
+		Size:   666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.PutMetadata(context.Background(), coreobjectstore.Metadata{
+		SHA256: "hash-256-baz",
+		SHA384: "hash-384-baz",
+		Path:   "baz",
+		Size:   42,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	usage, err := st.GetUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.DeepEquals, coreobjectstore.Usage{
+		Objects: 2,
+		Size:    708,
+	})
+}
+
+func (s *usageSuite) TestGetUsageNoObjects(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	usage, err := st.GetUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, gc.DeepEquals, coreobjectstore.Usage{})
+}
@@ -14,7 +14,8 @@ ON secret_backend_type (type);
 INSERT INTO secret_backend_type VALUES
 (0, 'controller', 'the juju controller secret backend'),
 (1, 'kubernetes', 'the kubernetes secret backend'),
-(2, 'vault', 'the vault secret backend');
+(2, 'vault', 'the vault secret backend'),
+(3, 'file', 'the encrypted file secret backend');
 
 CREATE TABLE secret_backend (
     uuid TEXT NOT NULL PRIMARY KEY,
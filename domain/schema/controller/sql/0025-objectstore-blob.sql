@@ -0,0 +1,33 @@
+-- The object store blob table holds the blobs in the controller-wide, content
+-- addressed, blob store. A blob is stored once, regardless of how many
+-- namespaces (the controller and models) store the same object.
+CREATE TABLE object_store_blob (
+    sha_384 TEXT NOT NULL PRIMARY KEY,
+    sha_256 TEXT NOT NULL,
+    size INT NOT NULL
+);
+
+-- Each namespace that has metadata for a blob holds a single reference to it.
+-- A blob without any references can be removed by the garbage collector.
+CREATE TABLE object_store_blob_reference (
+    sha_384 TEXT NOT NULL,
+    namespace TEXT NOT NULL,
+    PRIMARY KEY (sha_384, namespace),
+    CONSTRAINT fk_object_store_blob_reference_sha_384
+    FOREIGN KEY (sha_384)
+    REFERENCES object_store_blob (sha_384)
+);
+
+CREATE INDEX idx_object_store_blob_reference_namespace
+ON object_store_blob_reference (namespace);
+
+CREATE VIEW v_object_store_blob AS
+SELECT
+    osb.sha_384,
+    osb.sha_256,
+    osb.size,
+    COUNT(osbr.namespace) AS ref_count
+FROM object_store_blob AS osb
+LEFT JOIN object_store_blob_reference AS osbr
+    ON osb.sha_384 = osbr.sha_384
+GROUP BY osb.sha_384, osb.sha_256, osb.size;
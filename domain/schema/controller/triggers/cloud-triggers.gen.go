@@ -37,8 +37,15 @@ WHEN
 	(NEW.storage_endpoint != OLD.storage_endpoint OR (NEW.storage_endpoint IS NOT NULL AND OLD.storage_endpoint IS NULL) OR (NEW.storage_endpoint IS NULL AND OLD.storage_endpoint IS NOT NULL)) OR
 	NEW.skip_tls_verify != OLD.skip_tls_verify 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN NEW.cloud_type_id != OLD.cloud_type_id THEN 'cloud_type_id,' ELSE '' END ||
+        CASE WHEN NEW.endpoint != OLD.endpoint THEN 'endpoint,' ELSE '' END ||
+        CASE WHEN (NEW.identity_endpoint != OLD.identity_endpoint OR (NEW.identity_endpoint IS NOT NULL AND OLD.identity_endpoint IS NULL) OR (NEW.identity_endpoint IS NULL AND OLD.identity_endpoint IS NOT NULL)) THEN 'identity_endpoint,' ELSE '' END ||
+        CASE WHEN (NEW.storage_endpoint != OLD.storage_endpoint OR (NEW.storage_endpoint IS NOT NULL AND OLD.storage_endpoint IS NULL) OR (NEW.storage_endpoint IS NULL AND OLD.storage_endpoint IS NOT NULL)) THEN 'storage_endpoint,' ELSE '' END ||
+        CASE WHEN NEW.skip_tls_verify != OLD.skip_tls_verify THEN 'skip_tls_verify,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for Cloud
 CREATE TRIGGER trg_log_cloud_delete
@@ -79,8 +86,16 @@ WHEN
 	(NEW.invalid != OLD.invalid OR (NEW.invalid IS NOT NULL AND OLD.invalid IS NULL) OR (NEW.invalid IS NULL AND OLD.invalid IS NOT NULL)) OR
 	(NEW.invalid_reason != OLD.invalid_reason OR (NEW.invalid_reason IS NOT NULL AND OLD.invalid_reason IS NULL) OR (NEW.invalid_reason IS NULL AND OLD.invalid_reason IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.cloud_uuid != OLD.cloud_uuid THEN 'cloud_uuid,' ELSE '' END ||
+        CASE WHEN NEW.auth_type_id != OLD.auth_type_id THEN 'auth_type_id,' ELSE '' END ||
+        CASE WHEN NEW.owner_uuid != OLD.owner_uuid THEN 'owner_uuid,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN (NEW.revoked != OLD.revoked OR (NEW.revoked IS NOT NULL AND OLD.revoked IS NULL) OR (NEW.revoked IS NULL AND OLD.revoked IS NOT NULL)) THEN 'revoked,' ELSE '' END ||
+        CASE WHEN (NEW.invalid != OLD.invalid OR (NEW.invalid IS NOT NULL AND OLD.invalid IS NULL) OR (NEW.invalid IS NULL AND OLD.invalid IS NOT NULL)) THEN 'invalid,' ELSE '' END ||
+        CASE WHEN (NEW.invalid_reason != OLD.invalid_reason OR (NEW.invalid_reason IS NOT NULL AND OLD.invalid_reason IS NULL) OR (NEW.invalid_reason IS NULL AND OLD.invalid_reason IS NOT NULL)) THEN 'invalid_reason,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for CloudCredential
 CREATE TRIGGER trg_log_cloud_credential_delete
@@ -116,8 +131,11 @@ WHEN
 	(NEW.alias != OLD.alias OR (NEW.alias IS NOT NULL AND OLD.alias IS NULL) OR (NEW.alias IS NULL AND OLD.alias IS NOT NULL)) OR
 	NEW.ca_cert != OLD.ca_cert 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN (NEW.alias != OLD.alias OR (NEW.alias IS NOT NULL AND OLD.alias IS NULL) OR (NEW.alias IS NULL AND OLD.alias IS NOT NULL)) THEN 'alias,' ELSE '' END ||
+        CASE WHEN NEW.ca_cert != OLD.ca_cert THEN 'ca_cert,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ExternalController
 CREATE TRIGGER trg_log_external_controller_delete
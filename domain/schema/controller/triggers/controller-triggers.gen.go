@@ -32,8 +32,10 @@ WHEN
 	NEW.key != OLD.key OR
 	(NEW.value != OLD.value OR (NEW.value IS NOT NULL AND OLD.value IS NULL) OR (NEW.value IS NULL AND OLD.value IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.key != OLD.key THEN 'key,' ELSE '' END ||
+        CASE WHEN (NEW.value != OLD.value OR (NEW.value IS NOT NULL AND OLD.value IS NULL) OR (NEW.value IS NULL AND OLD.value IS NOT NULL)) THEN 'value,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ControllerConfig
 CREATE TRIGGER trg_log_controller_config_delete
@@ -69,8 +71,11 @@ WHEN
 	(NEW.dqlite_node_id != OLD.dqlite_node_id OR (NEW.dqlite_node_id IS NOT NULL AND OLD.dqlite_node_id IS NULL) OR (NEW.dqlite_node_id IS NULL AND OLD.dqlite_node_id IS NOT NULL)) OR
 	(NEW.bind_address != OLD.bind_address OR (NEW.bind_address IS NOT NULL AND OLD.bind_address IS NULL) OR (NEW.bind_address IS NULL AND OLD.bind_address IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.controller_id != OLD.controller_id THEN 'controller_id,' ELSE '' END ||
+        CASE WHEN (NEW.dqlite_node_id != OLD.dqlite_node_id OR (NEW.dqlite_node_id IS NOT NULL AND OLD.dqlite_node_id IS NULL) OR (NEW.dqlite_node_id IS NULL AND OLD.dqlite_node_id IS NOT NULL)) THEN 'dqlite_node_id,' ELSE '' END ||
+        CASE WHEN (NEW.bind_address != OLD.bind_address OR (NEW.bind_address IS NOT NULL AND OLD.bind_address IS NULL) OR (NEW.bind_address IS NULL AND OLD.bind_address IS NOT NULL)) THEN 'bind_address,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ControllerNode
 CREATE TRIGGER trg_log_controller_node_delete
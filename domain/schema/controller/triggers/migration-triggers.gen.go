@@ -36,8 +36,14 @@ WHEN
 	(NEW.time != OLD.time OR (NEW.time IS NOT NULL AND OLD.time IS NULL) OR (NEW.time IS NULL AND OLD.time IS NOT NULL)) OR
 	(NEW.success != OLD.success OR (NEW.success IS NOT NULL AND OLD.success IS NULL) OR (NEW.success IS NULL AND OLD.success IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.migration_uuid != OLD.migration_uuid THEN 'migration_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.phase != OLD.phase OR (NEW.phase IS NOT NULL AND OLD.phase IS NULL) OR (NEW.phase IS NULL AND OLD.phase IS NOT NULL)) THEN 'phase,' ELSE '' END ||
+        CASE WHEN (NEW.entity_key != OLD.entity_key OR (NEW.entity_key IS NOT NULL AND OLD.entity_key IS NULL) OR (NEW.entity_key IS NULL AND OLD.entity_key IS NOT NULL)) THEN 'entity_key,' ELSE '' END ||
+        CASE WHEN (NEW.time != OLD.time OR (NEW.time IS NOT NULL AND OLD.time IS NULL) OR (NEW.time IS NULL AND OLD.time IS NOT NULL)) THEN 'time,' ELSE '' END ||
+        CASE WHEN (NEW.success != OLD.success OR (NEW.success IS NOT NULL AND OLD.success IS NULL) OR (NEW.success IS NULL AND OLD.success IS NOT NULL)) THEN 'success,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ModelMigrationMinionSync
 CREATE TRIGGER trg_log_model_migration_minion_sync_delete
@@ -77,8 +83,15 @@ WHEN
 	(NEW.phase_changed_time != OLD.phase_changed_time OR (NEW.phase_changed_time IS NOT NULL AND OLD.phase_changed_time IS NULL) OR (NEW.phase_changed_time IS NULL AND OLD.phase_changed_time IS NOT NULL)) OR
 	(NEW.status != OLD.status OR (NEW.status IS NOT NULL AND OLD.status IS NULL) OR (NEW.status IS NULL AND OLD.status IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN (NEW.start_time != OLD.start_time OR (NEW.start_time IS NOT NULL AND OLD.start_time IS NULL) OR (NEW.start_time IS NULL AND OLD.start_time IS NOT NULL)) THEN 'start_time,' ELSE '' END ||
+        CASE WHEN (NEW.success_time != OLD.success_time OR (NEW.success_time IS NOT NULL AND OLD.success_time IS NULL) OR (NEW.success_time IS NULL AND OLD.success_time IS NOT NULL)) THEN 'success_time,' ELSE '' END ||
+        CASE WHEN (NEW.end_time != OLD.end_time OR (NEW.end_time IS NOT NULL AND OLD.end_time IS NULL) OR (NEW.end_time IS NULL AND OLD.end_time IS NOT NULL)) THEN 'end_time,' ELSE '' END ||
+        CASE WHEN (NEW.phase != OLD.phase OR (NEW.phase IS NOT NULL AND OLD.phase IS NULL) OR (NEW.phase IS NULL AND OLD.phase IS NOT NULL)) THEN 'phase,' ELSE '' END ||
+        CASE WHEN (NEW.phase_changed_time != OLD.phase_changed_time OR (NEW.phase_changed_time IS NOT NULL AND OLD.phase_changed_time IS NULL) OR (NEW.phase_changed_time IS NULL AND OLD.phase_changed_time IS NOT NULL)) THEN 'phase_changed_time,' ELSE '' END ||
+        CASE WHEN (NEW.status != OLD.status OR (NEW.status IS NOT NULL AND OLD.status IS NULL) OR (NEW.status IS NULL AND OLD.status IS NOT NULL)) THEN 'status,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ModelMigrationStatus
 CREATE TRIGGER trg_log_model_migration_status_delete
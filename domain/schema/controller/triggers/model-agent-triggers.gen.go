@@ -33,8 +33,11 @@ WHEN
 	NEW.previous_version != OLD.previous_version OR
 	NEW.target_version != OLD.target_version 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.model_uuid != OLD.model_uuid THEN 'model_uuid,' ELSE '' END ||
+        CASE WHEN NEW.previous_version != OLD.previous_version THEN 'previous_version,' ELSE '' END ||
+        CASE WHEN NEW.target_version != OLD.target_version THEN 'target_version,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ModelAgent
 CREATE TRIGGER trg_log_model_agent_delete
@@ -32,8 +32,10 @@ WHEN
 	NEW.model_uuid != OLD.model_uuid OR
 	NEW.user_public_ssh_key_id != OLD.user_public_ssh_key_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.model_uuid != OLD.model_uuid THEN 'model_uuid,' ELSE '' END ||
+        CASE WHEN NEW.user_public_ssh_key_id != OLD.user_public_ssh_key_id THEN 'user_public_ssh_key_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ModelAuthorizedKeys
 CREATE TRIGGER trg_log_model_authorized_keys_delete
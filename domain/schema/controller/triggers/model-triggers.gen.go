@@ -39,8 +39,17 @@ WHEN
 	NEW.name != OLD.name OR
 	NEW.owner_uuid != OLD.owner_uuid 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.activated != OLD.activated THEN 'activated,' ELSE '' END ||
+        CASE WHEN NEW.cloud_uuid != OLD.cloud_uuid THEN 'cloud_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.cloud_region_uuid != OLD.cloud_region_uuid OR (NEW.cloud_region_uuid IS NOT NULL AND OLD.cloud_region_uuid IS NULL) OR (NEW.cloud_region_uuid IS NULL AND OLD.cloud_region_uuid IS NOT NULL)) THEN 'cloud_region_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.cloud_credential_uuid != OLD.cloud_credential_uuid OR (NEW.cloud_credential_uuid IS NOT NULL AND OLD.cloud_credential_uuid IS NULL) OR (NEW.cloud_credential_uuid IS NULL AND OLD.cloud_credential_uuid IS NOT NULL)) THEN 'cloud_credential_uuid,' ELSE '' END ||
+        CASE WHEN NEW.model_type_id != OLD.model_type_id THEN 'model_type_id,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN NEW.owner_uuid != OLD.owner_uuid THEN 'owner_uuid,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for Model
 CREATE TRIGGER trg_log_model_delete
@@ -32,8 +32,10 @@ WHEN
 	NEW.model_uuid != OLD.model_uuid OR
 	NEW.secret_backend_uuid != OLD.secret_backend_uuid 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.model_uuid != OLD.model_uuid THEN 'model_uuid,' ELSE '' END ||
+        CASE WHEN NEW.secret_backend_uuid != OLD.secret_backend_uuid THEN 'secret_backend_uuid,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ModelSecretBackend
 CREATE TRIGGER trg_log_model_secret_backend_delete
@@ -68,8 +70,10 @@ WHEN
 	NEW.backend_uuid != OLD.backend_uuid OR
 	NEW.next_rotation_time != OLD.next_rotation_time 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.backend_uuid != OLD.backend_uuid THEN 'backend_uuid,' ELSE '' END ||
+        CASE WHEN NEW.next_rotation_time != OLD.next_rotation_time THEN 'next_rotation_time,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretBackendRotation
 CREATE TRIGGER trg_log_secret_backend_rotation_delete
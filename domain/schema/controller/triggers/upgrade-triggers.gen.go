@@ -34,8 +34,12 @@ WHEN
 	NEW.target_version != OLD.target_version OR
 	NEW.state_type_id != OLD.state_type_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.previous_version != OLD.previous_version THEN 'previous_version,' ELSE '' END ||
+        CASE WHEN NEW.target_version != OLD.target_version THEN 'target_version,' ELSE '' END ||
+        CASE WHEN NEW.state_type_id != OLD.state_type_id THEN 'state_type_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for UpgradeInfo
 CREATE TRIGGER trg_log_upgrade_info_delete
@@ -72,8 +76,12 @@ WHEN
 	NEW.upgrade_info_uuid != OLD.upgrade_info_uuid OR
 	(NEW.node_upgrade_completed_at != OLD.node_upgrade_completed_at OR (NEW.node_upgrade_completed_at IS NOT NULL AND OLD.node_upgrade_completed_at IS NULL) OR (NEW.node_upgrade_completed_at IS NULL AND OLD.node_upgrade_completed_at IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.controller_node_id != OLD.controller_node_id THEN 'controller_node_id,' ELSE '' END ||
+        CASE WHEN NEW.upgrade_info_uuid != OLD.upgrade_info_uuid THEN 'upgrade_info_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.node_upgrade_completed_at != OLD.node_upgrade_completed_at OR (NEW.node_upgrade_completed_at IS NOT NULL AND OLD.node_upgrade_completed_at IS NULL) OR (NEW.node_upgrade_completed_at IS NULL AND OLD.node_upgrade_completed_at IS NOT NULL)) THEN 'node_upgrade_completed_at,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for UpgradeInfoControllerNode
 CREATE TRIGGER trg_log_upgrade_info_controller_node_delete
@@ -32,8 +32,10 @@ WHEN
 	NEW.user_uuid != OLD.user_uuid OR
 	NEW.disabled != OLD.disabled 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.user_uuid != OLD.user_uuid THEN 'user_uuid,' ELSE '' END ||
+        CASE WHEN NEW.disabled != OLD.disabled THEN 'disabled,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for UserAuthentication
 CREATE TRIGGER trg_log_user_authentication_delete
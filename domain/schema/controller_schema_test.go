@@ -38,6 +38,7 @@ func (s *controllerSchemaSuite) TestControllerTables(c *gc.C) {
 
 		// Change log
 		"change_log",
+		"change_log_durable_consumer",
 		"change_log_edit_type",
 		"change_log_namespace",
 		"change_log_witness",
@@ -90,6 +91,8 @@ func (s *controllerSchemaSuite) TestControllerTables(c *gc.C) {
 		// Object store metadata
 		"object_store_metadata",
 		"object_store_metadata_path",
+		"object_store_blob",
+		"object_store_blob_reference",
 
 		// SSH Keys
 		"ssh_fingerprint_hash_algorithm",
@@ -136,6 +139,13 @@ func (s *controllerSchemaSuite) TestControllerTables(c *gc.C) {
 	))
 }
 
+func (s *controllerSchemaSuite) TestControllerDDLNoDrift(c *gc.C) {
+	ddl := ControllerDDL()
+	s.applyDDL(c, ddl)
+
+	s.assertNoDrift(c, ddl)
+}
+
 func (s *controllerSchemaSuite) TestControllerViews(c *gc.C) {
 	c.Logf("Committing schema DDL")
 
@@ -175,6 +185,7 @@ func (s *controllerSchemaSuite) TestControllerViews(c *gc.C) {
 
 		// Object store metadata
 		"v_object_store_metadata",
+		"v_object_store_blob",
 	)
 	c.Assert(readEntityNames(c, s.DB(), "view"), jc.SameContents, expected.SortedValues())
 }
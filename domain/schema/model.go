@@ -22,6 +22,7 @@ import (
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/machine-requires-reboot-triggers.gen.go -package=triggers -tables=machine_requires_reboot
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/application-triggers.gen.go -package=triggers -tables=application,application_config_hash,charm,unit,application_scale,port_range
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/cleanup-triggers.gen.go -package=triggers -tables=removal
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/relation-triggers.gen.go -package=triggers -tables=relation
 
 //go:embed model/sql/*.sql
 var modelSchemaDir embed.FS
@@ -55,6 +56,7 @@ const (
 	tableApplication
 	tableRemoval
 	tableApplicationConfigHash
+	tableRelation
 )
 
 // ModelDDL is used to create model databases.
@@ -118,6 +120,7 @@ func ModelDDL() *schema.Schema {
 		triggers.ChangeLogTriggersForApplication("uuid", tableApplication),
 		triggers.ChangeLogTriggersForRemoval("uuid", tableRemoval),
 		triggers.ChangeLogTriggersForApplicationConfigHash("application_uuid", tableApplicationConfigHash),
+		triggers.ChangeLogTriggersForRelation("uuid", tableRelation),
 	)
 
 	// Generic triggers.
@@ -22,11 +22,16 @@ CREATE TABLE change_log_namespace (
 CREATE UNIQUE INDEX idx_change_log_namespace_namespace
 ON change_log_namespace (namespace);
 
+-- The changed_columns column is a comma separated list of the columns that
+-- were changed for an update. It is NULL for creates and deletes, or when the
+-- trigger does not record the columns, in which case any column could have
+-- changed.
 CREATE TABLE change_log (
     id INTEGER PRIMARY KEY AUTOINCREMENT,
     edit_type_id INT NOT NULL,
     namespace_id INT NOT NULL,
     changed TEXT NOT NULL,
+    changed_columns TEXT,
     created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
     CONSTRAINT fk_change_log_edit_type
     FOREIGN KEY (edit_type_id)
@@ -47,3 +52,15 @@ CREATE TABLE change_log_witness (
     upper_bound INT NOT NULL DEFAULT (-1),
     updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc'))
 );
+
+-- The change log durable consumer table is used to track the last change log
+-- entry that has been processed by a durable consumer. Durable consumers
+-- survive restarts, so the pruner will retain change log entries that a
+-- consumer has not yet seen. If a consumer falls too far behind, it is
+-- evicted by the pruner and must perform a full resync before continuing.
+CREATE TABLE change_log_durable_consumer (
+    consumer_id TEXT NOT NULL PRIMARY KEY,
+    last_seen_id INT NOT NULL DEFAULT (-1),
+    evicted_at DATETIME,
+    updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc'))
+);
@@ -19,6 +19,23 @@ INSERT INTO secret_rotate_policy VALUES
 (5, 'quarterly'),
 (6, 'yearly');
 
+CREATE TABLE secret_type (
+    id INT PRIMARY KEY,
+    type TEXT NOT NULL,
+    CONSTRAINT chk_empty_type
+    CHECK (type != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_type_type
+ON secret_type (type);
+
+INSERT INTO secret_type VALUES
+(0, 'generic'),
+(1, 'tls'),
+(2, 'password'),
+(3, 'ssh-key'),
+(4, 'docker-registry');
+
 CREATE TABLE secret (
     id TEXT NOT NULL PRIMARY KEY
 );
@@ -40,6 +57,7 @@ CREATE TABLE secret_metadata (
     version INT NOT NULL,
     description TEXT,
     rotate_policy_id INT NOT NULL,
+    type_id INT NOT NULL DEFAULT 0,
     auto_prune BOOLEAN NOT NULL DEFAULT (FALSE),
     latest_revision_checksum TEXT,
     create_time DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
@@ -49,7 +67,10 @@ CREATE TABLE secret_metadata (
     REFERENCES secret (id),
     CONSTRAINT fk_secret_rotate_policy
     FOREIGN KEY (rotate_policy_id)
-    REFERENCES secret_rotate_policy (id)
+    REFERENCES secret_rotate_policy (id),
+    CONSTRAINT fk_secret_type
+    FOREIGN KEY (type_id)
+    REFERENCES secret_type (id)
 );
 
 CREATE TABLE secret_rotation (
@@ -79,11 +100,31 @@ CREATE TABLE secret_deleted_value_ref (
     revision_id TEXT NOT NULL
 );
 
+-- The keys used to encrypt secret content stored in the model, each
+-- wrapped by the controller key. Only the active key is used to encrypt
+-- new content; content encrypted with older keys is re-encrypted in the
+-- background after the key is rotated.
+CREATE TABLE secret_content_key (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    wrapped_key TEXT NOT NULL,
+    controller_key_id TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    create_time DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
+    CONSTRAINT chk_empty_wrapped_key
+    CHECK (wrapped_key != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_content_key_active
+ON secret_content_key (active) WHERE active = TRUE;
+
 -- 1:many
 CREATE TABLE secret_content (
     revision_uuid TEXT NOT NULL,
     name TEXT NOT NULL,
     content TEXT NOT NULL,
+    -- key_uuid is the key the content is encrypted with,
+    -- or NULL if it was stored before content was encrypted.
+    key_uuid TEXT,
     CONSTRAINT chk_empty_name
     CHECK (name != ''),
     CONSTRAINT chk_empty_content
@@ -92,9 +133,15 @@ CREATE TABLE secret_content (
     PRIMARY KEY (revision_uuid, name),
     CONSTRAINT fk_secret_content_secret_revision_uuid
     FOREIGN KEY (revision_uuid)
-    REFERENCES secret_revision (uuid)
+    REFERENCES secret_revision (uuid),
+    CONSTRAINT fk_secret_content_secret_content_key_uuid
+    FOREIGN KEY (key_uuid)
+    REFERENCES secret_content_key (uuid)
 );
 
+CREATE INDEX idx_secret_content_key_uuid
+ON secret_content (key_uuid);
+
 CREATE INDEX idx_secret_content_revision_uuid
 ON secret_content (revision_uuid);
 
@@ -130,6 +177,98 @@ CREATE TABLE secret_revision_expire (
     REFERENCES secret_revision (uuid)
 );
 
+CREATE TABLE secret_revision_stage_status (
+    id INT PRIMARY KEY,
+    status TEXT NOT NULL,
+    CONSTRAINT chk_empty_status
+    CHECK (status != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_revision_stage_status_status
+ON secret_revision_stage_status (status);
+
+INSERT INTO secret_revision_stage_status VALUES
+(0, 'pending'),
+(1, 'committed'),
+(2, 'rolled-back'),
+(3, 'expired');
+
+-- secret_revision_stage records a revision created by a staged
+-- rotation. While the stage is pending, the previous revision is
+-- kept until all consumers track the staged revision. If the
+-- deadline passes first, the stage expires, and if the owner asked
+-- for it with rollback_on_timeout, the previous revision is restored
+-- as the latest revision instead.
+CREATE TABLE secret_revision_stage (
+    revision_uuid TEXT NOT NULL PRIMARY KEY,
+    secret_id TEXT NOT NULL,
+    previous_revision_uuid TEXT NOT NULL,
+    previous_revision_checksum TEXT,
+    deadline DATETIME NOT NULL,
+    rollback_on_timeout BOOLEAN NOT NULL DEFAULT FALSE,
+    status_id INT NOT NULL DEFAULT 0,
+    update_time DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
+    CONSTRAINT fk_secret_revision_stage_revision_uuid
+    FOREIGN KEY (revision_uuid)
+    REFERENCES secret_revision (uuid),
+    CONSTRAINT fk_secret_revision_stage_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id),
+    CONSTRAINT fk_secret_revision_stage_previous_revision_uuid
+    FOREIGN KEY (previous_revision_uuid)
+    REFERENCES secret_revision (uuid),
+    CONSTRAINT fk_secret_revision_stage_status
+    FOREIGN KEY (status_id)
+    REFERENCES secret_revision_stage_status (id)
+);
+
+-- A secret has at most one pending stage.
+CREATE UNIQUE INDEX idx_secret_revision_stage_pending
+ON secret_revision_stage (secret_id) WHERE status_id = 0;
+
+-- secret_revision_rollback records the revisions created by restoring
+-- an existing revision as the latest revision of its secret, either by
+-- the owner or because a staged rotation was rolled back. The restored
+-- revision is a new revision with the content of restored_from_revision,
+-- which is kept as is; replaced_revision is the latest revision it
+-- replaced.
+CREATE TABLE secret_revision_rollback (
+    revision_uuid TEXT NOT NULL PRIMARY KEY,
+    secret_id TEXT NOT NULL,
+    restored_from_revision INT NOT NULL,
+    replaced_revision INT NOT NULL,
+    rollback_time DATETIME NOT NULL,
+    CONSTRAINT fk_secret_revision_rollback_revision_uuid
+    FOREIGN KEY (revision_uuid)
+    REFERENCES secret_revision (uuid),
+    CONSTRAINT fk_secret_revision_rollback_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
+
+-- secret_revision_pin records the revision of a secret which the units
+-- of a consuming application track instead of the latest revision,
+-- e.g. while an operator deals with a bad rotation.
+CREATE TABLE secret_revision_pin (
+    secret_id TEXT NOT NULL,
+    application_uuid TEXT NOT NULL,
+    revision_uuid TEXT NOT NULL,
+    CONSTRAINT pk_secret_revision_pin_secret_id_application_uuid
+    PRIMARY KEY (secret_id, application_uuid),
+    CONSTRAINT fk_secret_revision_pin_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id),
+    CONSTRAINT fk_secret_revision_pin_application_uuid
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT fk_secret_revision_pin_revision_uuid
+    FOREIGN KEY (revision_uuid)
+    REFERENCES secret_revision (uuid)
+);
+
+CREATE INDEX idx_secret_revision_pin_revision_uuid
+ON secret_revision_pin (revision_uuid);
+
 CREATE TABLE secret_application_owner (
     secret_id TEXT NOT NULL,
     application_uuid TEXT NOT NULL,
@@ -290,6 +429,44 @@ ON secret_permission (secret_id);
 CREATE INDEX idx_secret_permission_subject_uuid_subject_type_id
 ON secret_permission (subject_uuid, subject_type_id);
 
+CREATE TABLE secret_accessor_type (
+    id INT PRIMARY KEY,
+    type TEXT NOT NULL,
+    CONSTRAINT chk_empty_type
+    CHECK (type != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_accessor_type_type
+ON secret_accessor_type (type);
+
+INSERT INTO secret_accessor_type VALUES
+(0, 'unit'),
+(1, 'application'),
+(2, 'remote-application'),
+(3, 'user');
+
+-- secret_access_log records each read of secret content. The
+-- accessor is recorded by its natural id so the entry outlives
+-- the unit, application or user which read the content. Only
+-- the most recent entries for each secret are kept.
+CREATE TABLE secret_access_log (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    secret_id TEXT NOT NULL,
+    revision INT NOT NULL,
+    accessor_type_id INT NOT NULL,
+    accessor_id TEXT NOT NULL,
+    access_time DATETIME NOT NULL,
+    CONSTRAINT fk_secret_access_log_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id),
+    CONSTRAINT fk_secret_access_log_accessor_type
+    FOREIGN KEY (accessor_type_id)
+    REFERENCES secret_accessor_type (id)
+);
+
+CREATE INDEX idx_secret_access_log_secret_id_access_time
+ON secret_access_log (secret_id, access_time);
+
 CREATE VIEW v_secret_permission AS
 SELECT
     sp.secret_id,
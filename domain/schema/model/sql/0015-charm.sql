@@ -282,6 +282,7 @@ CREATE TABLE charm_relation (
     optional BOOLEAN,
     capacity INT,
     scope_id INT,
+    schema TEXT,
     CONSTRAINT fk_charm_relation_charm
     FOREIGN KEY (charm_uuid)
     REFERENCES charm (uuid),
@@ -309,7 +310,8 @@ SELECT
     cr.interface,
     cr.optional,
     cr.capacity,
-    crs.name AS scope
+    crs.name AS scope,
+    cr.schema
 FROM charm_relation AS cr
 LEFT JOIN charm_relation_kind AS crk ON cr.kind_id = crk.id
 LEFT JOIN charm_relation_role AS crr ON cr.role_id = crr.id
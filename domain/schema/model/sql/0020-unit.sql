@@ -93,6 +93,8 @@ CREATE TABLE unit_state (
     uniter_state TEXT,
     storage_state TEXT,
     secret_state TEXT,
+    schedule_state TEXT,
+    deferred_state TEXT,
     CONSTRAINT fk_unit_state_unit
     FOREIGN KEY (unit_uuid)
     REFERENCES unit (uuid)
@@ -197,6 +199,24 @@ CREATE TABLE unit_workload_status (
     REFERENCES workload_status_value (id)
 );
 
+-- The changes to a unit's workload status, most recent last.
+CREATE TABLE unit_workload_status_history (
+    unit_uuid TEXT NOT NULL,
+    status_id INT NOT NULL,
+    message TEXT,
+    data TEXT,
+    updated_at DATETIME,
+    CONSTRAINT fk_unit_workload_status_history_unit
+    FOREIGN KEY (unit_uuid)
+    REFERENCES unit (uuid),
+    CONSTRAINT fk_unit_workload_status_history_status
+    FOREIGN KEY (status_id)
+    REFERENCES workload_status_value (id)
+);
+
+CREATE INDEX idx_unit_workload_status_history_unit
+ON unit_workload_status_history (unit_uuid);
+
 CREATE TABLE cloud_container_status (
     unit_uuid TEXT NOT NULL PRIMARY KEY,
     status_id INT NOT NULL,
@@ -210,3 +230,53 @@ CREATE TABLE cloud_container_status (
     FOREIGN KEY (status_id)
     REFERENCES cloud_container_status_value (id)
 );
+
+-- Status values for the Pebble checks in a unit's workload containers.
+CREATE TABLE pebble_check_status_value (
+    id INT PRIMARY KEY,
+    status TEXT NOT NULL
+);
+
+INSERT INTO pebble_check_status_value VALUES
+(0, 'up'),
+(1, 'down');
+
+-- The latest status of each Pebble check in a unit's workload containers.
+CREATE TABLE unit_pebble_check_status (
+    unit_uuid TEXT NOT NULL,
+    container_name TEXT NOT NULL,
+    check_name TEXT NOT NULL,
+    status_id INT NOT NULL,
+    message TEXT,
+    -- critical is whether the unit's workload status is reported as error
+    -- while the check is down, per the pebble-critical-checks model config.
+    critical BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at DATETIME NOT NULL,
+    CONSTRAINT fk_unit_pebble_check_status_unit
+    FOREIGN KEY (unit_uuid)
+    REFERENCES unit (uuid),
+    CONSTRAINT fk_unit_pebble_check_status_status
+    FOREIGN KEY (status_id)
+    REFERENCES pebble_check_status_value (id),
+    PRIMARY KEY (unit_uuid, container_name, check_name)
+);
+
+-- The transitions between statuses of the Pebble checks in a unit's
+-- workload containers, most recent last.
+CREATE TABLE unit_pebble_check_status_history (
+    unit_uuid TEXT NOT NULL,
+    container_name TEXT NOT NULL,
+    check_name TEXT NOT NULL,
+    status_id INT NOT NULL,
+    message TEXT,
+    updated_at DATETIME NOT NULL,
+    CONSTRAINT fk_unit_pebble_check_status_history_unit
+    FOREIGN KEY (unit_uuid)
+    REFERENCES unit (uuid),
+    CONSTRAINT fk_unit_pebble_check_status_history_status
+    FOREIGN KEY (status_id)
+    REFERENCES pebble_check_status_value (id)
+);
+
+CREATE INDEX idx_unit_pebble_check_status_history_check
+ON unit_pebble_check_status_history (unit_uuid, container_name, check_name);
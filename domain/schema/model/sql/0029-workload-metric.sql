@@ -0,0 +1,20 @@
+-- Workload metrics published by charms with the metric-add hook tool.
+-- Samples are kept for the workload-metrics-retention model config duration.
+CREATE TABLE unit_workload_metric (
+    unit_uuid TEXT NOT NULL,
+    metric_key TEXT NOT NULL,
+    -- labels is the canonical JSON encoding of the sample's labels, which
+    -- together with the key identifies the series the sample belongs to.
+    labels TEXT NOT NULL DEFAULT '',
+    value REAL NOT NULL,
+    recorded_at DATETIME NOT NULL,
+    CONSTRAINT fk_unit_workload_metric_unit
+    FOREIGN KEY (unit_uuid)
+    REFERENCES unit (uuid)
+);
+
+CREATE INDEX idx_unit_workload_metric_unit_key
+ON unit_workload_metric (unit_uuid, metric_key);
+
+CREATE INDEX idx_unit_workload_metric_recorded_at
+ON unit_workload_metric (recorded_at);
@@ -40,8 +40,18 @@ WHEN
 	(NEW.password_hash_algorithm_id != OLD.password_hash_algorithm_id OR (NEW.password_hash_algorithm_id IS NOT NULL AND OLD.password_hash_algorithm_id IS NULL) OR (NEW.password_hash_algorithm_id IS NULL AND OLD.password_hash_algorithm_id IS NOT NULL)) OR
 	(NEW.password_hash != OLD.password_hash OR (NEW.password_hash IS NOT NULL AND OLD.password_hash IS NULL) OR (NEW.password_hash IS NULL AND OLD.password_hash IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN NEW.charm_uuid != OLD.charm_uuid THEN 'charm_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.charm_modified_version != OLD.charm_modified_version OR (NEW.charm_modified_version IS NOT NULL AND OLD.charm_modified_version IS NULL) OR (NEW.charm_modified_version IS NULL AND OLD.charm_modified_version IS NOT NULL)) THEN 'charm_modified_version,' ELSE '' END ||
+        CASE WHEN (NEW.charm_upgrade_on_error != OLD.charm_upgrade_on_error OR (NEW.charm_upgrade_on_error IS NOT NULL AND OLD.charm_upgrade_on_error IS NULL) OR (NEW.charm_upgrade_on_error IS NULL AND OLD.charm_upgrade_on_error IS NOT NULL)) THEN 'charm_upgrade_on_error,' ELSE '' END ||
+        CASE WHEN (NEW.exposed != OLD.exposed OR (NEW.exposed IS NOT NULL AND OLD.exposed IS NULL) OR (NEW.exposed IS NULL AND OLD.exposed IS NOT NULL)) THEN 'exposed,' ELSE '' END ||
+        CASE WHEN (NEW.placement != OLD.placement OR (NEW.placement IS NOT NULL AND OLD.placement IS NULL) OR (NEW.placement IS NULL AND OLD.placement IS NOT NULL)) THEN 'placement,' ELSE '' END ||
+        CASE WHEN (NEW.password_hash_algorithm_id != OLD.password_hash_algorithm_id OR (NEW.password_hash_algorithm_id IS NOT NULL AND OLD.password_hash_algorithm_id IS NULL) OR (NEW.password_hash_algorithm_id IS NULL AND OLD.password_hash_algorithm_id IS NOT NULL)) THEN 'password_hash_algorithm_id,' ELSE '' END ||
+        CASE WHEN (NEW.password_hash != OLD.password_hash OR (NEW.password_hash IS NOT NULL AND OLD.password_hash IS NULL) OR (NEW.password_hash IS NULL AND OLD.password_hash IS NOT NULL)) THEN 'password_hash,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for Application
 CREATE TRIGGER trg_log_application_delete
@@ -76,8 +86,10 @@ WHEN
 	NEW.application_uuid != OLD.application_uuid OR
 	NEW.sha256 != OLD.sha256 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.application_uuid != OLD.application_uuid THEN 'application_uuid,' ELSE '' END ||
+        CASE WHEN NEW.sha256 != OLD.sha256 THEN 'sha256,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ApplicationConfigHash
 CREATE TRIGGER trg_log_application_config_hash_delete
@@ -114,8 +126,12 @@ WHEN
 	(NEW.scale_target != OLD.scale_target OR (NEW.scale_target IS NOT NULL AND OLD.scale_target IS NULL) OR (NEW.scale_target IS NULL AND OLD.scale_target IS NOT NULL)) OR
 	(NEW.scaling != OLD.scaling OR (NEW.scaling IS NOT NULL AND OLD.scaling IS NULL) OR (NEW.scaling IS NULL AND OLD.scaling IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.application_uuid != OLD.application_uuid THEN 'application_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.scale != OLD.scale OR (NEW.scale IS NOT NULL AND OLD.scale IS NULL) OR (NEW.scale IS NULL AND OLD.scale IS NOT NULL)) THEN 'scale,' ELSE '' END ||
+        CASE WHEN (NEW.scale_target != OLD.scale_target OR (NEW.scale_target IS NOT NULL AND OLD.scale_target IS NULL) OR (NEW.scale_target IS NULL AND OLD.scale_target IS NOT NULL)) THEN 'scale_target,' ELSE '' END ||
+        CASE WHEN (NEW.scaling != OLD.scaling OR (NEW.scaling IS NOT NULL AND OLD.scaling IS NULL) OR (NEW.scaling IS NULL AND OLD.scaling IS NOT NULL)) THEN 'scaling,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ApplicationScale
 CREATE TRIGGER trg_log_application_scale_delete
@@ -159,8 +175,19 @@ WHEN
 	NEW.reference_name != OLD.reference_name OR
 	NEW.create_time != OLD.create_time 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN (NEW.archive_path != OLD.archive_path OR (NEW.archive_path IS NOT NULL AND OLD.archive_path IS NULL) OR (NEW.archive_path IS NULL AND OLD.archive_path IS NOT NULL)) THEN 'archive_path,' ELSE '' END ||
+        CASE WHEN (NEW.object_store_uuid != OLD.object_store_uuid OR (NEW.object_store_uuid IS NOT NULL AND OLD.object_store_uuid IS NULL) OR (NEW.object_store_uuid IS NULL AND OLD.object_store_uuid IS NOT NULL)) THEN 'object_store_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.available != OLD.available OR (NEW.available IS NOT NULL AND OLD.available IS NULL) OR (NEW.available IS NULL AND OLD.available IS NOT NULL)) THEN 'available,' ELSE '' END ||
+        CASE WHEN (NEW.version != OLD.version OR (NEW.version IS NOT NULL AND OLD.version IS NULL) OR (NEW.version IS NULL AND OLD.version IS NOT NULL)) THEN 'version,' ELSE '' END ||
+        CASE WHEN (NEW.lxd_profile != OLD.lxd_profile OR (NEW.lxd_profile IS NOT NULL AND OLD.lxd_profile IS NULL) OR (NEW.lxd_profile IS NULL AND OLD.lxd_profile IS NOT NULL)) THEN 'lxd_profile,' ELSE '' END ||
+        CASE WHEN NEW.source_id != OLD.source_id THEN 'source_id,' ELSE '' END ||
+        CASE WHEN NEW.revision != OLD.revision THEN 'revision,' ELSE '' END ||
+        CASE WHEN (NEW.architecture_id != OLD.architecture_id OR (NEW.architecture_id IS NOT NULL AND OLD.architecture_id IS NULL) OR (NEW.architecture_id IS NULL AND OLD.architecture_id IS NOT NULL)) THEN 'architecture_id,' ELSE '' END ||
+        CASE WHEN NEW.reference_name != OLD.reference_name THEN 'reference_name,' ELSE '' END ||
+        CASE WHEN NEW.create_time != OLD.create_time THEN 'create_time,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for Charm
 CREATE TRIGGER trg_log_charm_delete
@@ -199,8 +226,14 @@ WHEN
 	(NEW.relation_uuid != OLD.relation_uuid OR (NEW.relation_uuid IS NOT NULL AND OLD.relation_uuid IS NULL) OR (NEW.relation_uuid IS NULL AND OLD.relation_uuid IS NOT NULL)) OR
 	NEW.unit_uuid != OLD.unit_uuid 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.protocol_id != OLD.protocol_id THEN 'protocol_id,' ELSE '' END ||
+        CASE WHEN (NEW.from_port != OLD.from_port OR (NEW.from_port IS NOT NULL AND OLD.from_port IS NULL) OR (NEW.from_port IS NULL AND OLD.from_port IS NOT NULL)) THEN 'from_port,' ELSE '' END ||
+        CASE WHEN (NEW.to_port != OLD.to_port OR (NEW.to_port IS NOT NULL AND OLD.to_port IS NULL) OR (NEW.to_port IS NULL AND OLD.to_port IS NOT NULL)) THEN 'to_port,' ELSE '' END ||
+        CASE WHEN (NEW.relation_uuid != OLD.relation_uuid OR (NEW.relation_uuid IS NOT NULL AND OLD.relation_uuid IS NULL) OR (NEW.relation_uuid IS NULL AND OLD.relation_uuid IS NOT NULL)) THEN 'relation_uuid,' ELSE '' END ||
+        CASE WHEN NEW.unit_uuid != OLD.unit_uuid THEN 'unit_uuid,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for PortRange
 CREATE TRIGGER trg_log_port_range_delete
@@ -242,8 +275,17 @@ WHEN
 	(NEW.password_hash_algorithm_id != OLD.password_hash_algorithm_id OR (NEW.password_hash_algorithm_id IS NOT NULL AND OLD.password_hash_algorithm_id IS NULL) OR (NEW.password_hash_algorithm_id IS NULL AND OLD.password_hash_algorithm_id IS NOT NULL)) OR
 	(NEW.password_hash != OLD.password_hash OR (NEW.password_hash IS NOT NULL AND OLD.password_hash IS NULL) OR (NEW.password_hash IS NULL AND OLD.password_hash IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN NEW.application_uuid != OLD.application_uuid THEN 'application_uuid,' ELSE '' END ||
+        CASE WHEN NEW.net_node_uuid != OLD.net_node_uuid THEN 'net_node_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.charm_uuid != OLD.charm_uuid OR (NEW.charm_uuid IS NOT NULL AND OLD.charm_uuid IS NULL) OR (NEW.charm_uuid IS NULL AND OLD.charm_uuid IS NOT NULL)) THEN 'charm_uuid,' ELSE '' END ||
+        CASE WHEN NEW.resolve_kind_id != OLD.resolve_kind_id THEN 'resolve_kind_id,' ELSE '' END ||
+        CASE WHEN (NEW.password_hash_algorithm_id != OLD.password_hash_algorithm_id OR (NEW.password_hash_algorithm_id IS NOT NULL AND OLD.password_hash_algorithm_id IS NULL) OR (NEW.password_hash_algorithm_id IS NULL AND OLD.password_hash_algorithm_id IS NOT NULL)) THEN 'password_hash_algorithm_id,' ELSE '' END ||
+        CASE WHEN (NEW.password_hash != OLD.password_hash OR (NEW.password_hash IS NOT NULL AND OLD.password_hash IS NULL) OR (NEW.password_hash IS NULL AND OLD.password_hash IS NOT NULL)) THEN 'password_hash,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for Unit
 CREATE TRIGGER trg_log_unit_delete
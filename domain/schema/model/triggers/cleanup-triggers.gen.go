@@ -35,8 +35,13 @@ WHEN
 	NEW.scheduled_for != OLD.scheduled_for OR
 	(NEW.arg != OLD.arg OR (NEW.arg IS NOT NULL AND OLD.arg IS NULL) OR (NEW.arg IS NULL AND OLD.arg IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.removal_type_id != OLD.removal_type_id THEN 'removal_type_id,' ELSE '' END ||
+        CASE WHEN NEW.entity_uuid != OLD.entity_uuid THEN 'entity_uuid,' ELSE '' END ||
+        CASE WHEN NEW.scheduled_for != OLD.scheduled_for THEN 'scheduled_for,' ELSE '' END ||
+        CASE WHEN (NEW.arg != OLD.arg OR (NEW.arg IS NOT NULL AND OLD.arg IS NULL) OR (NEW.arg IS NULL AND OLD.arg IS NOT NULL)) THEN 'arg,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for Removal
 CREATE TRIGGER trg_log_removal_delete
@@ -32,8 +32,10 @@ WHEN
 	NEW.machine_uuid != OLD.machine_uuid OR
 	NEW.created_at != OLD.created_at 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.machine_uuid != OLD.machine_uuid THEN 'machine_uuid,' ELSE '' END ||
+        CASE WHEN NEW.created_at != OLD.created_at THEN 'created_at,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for MachineRequiresReboot
 CREATE TRIGGER trg_log_machine_requires_reboot_delete
@@ -45,8 +45,23 @@ WHEN
 	(NEW.is_controller != OLD.is_controller OR (NEW.is_controller IS NOT NULL AND OLD.is_controller IS NULL) OR (NEW.is_controller IS NULL AND OLD.is_controller IS NOT NULL)) OR
 	(NEW.keep_instance != OLD.keep_instance OR (NEW.keep_instance IS NOT NULL AND OLD.keep_instance IS NULL) OR (NEW.keep_instance IS NULL AND OLD.keep_instance IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN NEW.net_node_uuid != OLD.net_node_uuid THEN 'net_node_uuid,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN (NEW.base != OLD.base OR (NEW.base IS NOT NULL AND OLD.base IS NULL) OR (NEW.base IS NULL AND OLD.base IS NOT NULL)) THEN 'base,' ELSE '' END ||
+        CASE WHEN (NEW.nonce != OLD.nonce OR (NEW.nonce IS NOT NULL AND OLD.nonce IS NULL) OR (NEW.nonce IS NULL AND OLD.nonce IS NOT NULL)) THEN 'nonce,' ELSE '' END ||
+        CASE WHEN (NEW.password_hash_algorithm_id != OLD.password_hash_algorithm_id OR (NEW.password_hash_algorithm_id IS NOT NULL AND OLD.password_hash_algorithm_id IS NULL) OR (NEW.password_hash_algorithm_id IS NULL AND OLD.password_hash_algorithm_id IS NOT NULL)) THEN 'password_hash_algorithm_id,' ELSE '' END ||
+        CASE WHEN (NEW.password_hash != OLD.password_hash OR (NEW.password_hash IS NOT NULL AND OLD.password_hash IS NULL) OR (NEW.password_hash IS NULL AND OLD.password_hash IS NOT NULL)) THEN 'password_hash,' ELSE '' END ||
+        CASE WHEN (NEW.clean != OLD.clean OR (NEW.clean IS NOT NULL AND OLD.clean IS NULL) OR (NEW.clean IS NULL AND OLD.clean IS NOT NULL)) THEN 'clean,' ELSE '' END ||
+        CASE WHEN (NEW.force_destroyed != OLD.force_destroyed OR (NEW.force_destroyed IS NOT NULL AND OLD.force_destroyed IS NULL) OR (NEW.force_destroyed IS NULL AND OLD.force_destroyed IS NOT NULL)) THEN 'force_destroyed,' ELSE '' END ||
+        CASE WHEN (NEW.placement != OLD.placement OR (NEW.placement IS NOT NULL AND OLD.placement IS NULL) OR (NEW.placement IS NULL AND OLD.placement IS NOT NULL)) THEN 'placement,' ELSE '' END ||
+        CASE WHEN (NEW.agent_started_at != OLD.agent_started_at OR (NEW.agent_started_at IS NOT NULL AND OLD.agent_started_at IS NULL) OR (NEW.agent_started_at IS NULL AND OLD.agent_started_at IS NOT NULL)) THEN 'agent_started_at,' ELSE '' END ||
+        CASE WHEN (NEW.hostname != OLD.hostname OR (NEW.hostname IS NOT NULL AND OLD.hostname IS NULL) OR (NEW.hostname IS NULL AND OLD.hostname IS NOT NULL)) THEN 'hostname,' ELSE '' END ||
+        CASE WHEN (NEW.is_controller != OLD.is_controller OR (NEW.is_controller IS NOT NULL AND OLD.is_controller IS NULL) OR (NEW.is_controller IS NULL AND OLD.is_controller IS NOT NULL)) THEN 'is_controller,' ELSE '' END ||
+        CASE WHEN (NEW.keep_instance != OLD.keep_instance OR (NEW.keep_instance IS NOT NULL AND OLD.keep_instance IS NULL) OR (NEW.keep_instance IS NULL AND OLD.keep_instance IS NOT NULL)) THEN 'keep_instance,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for Machine
 CREATE TRIGGER trg_log_machine_delete
@@ -82,8 +97,11 @@ WHEN
 	NEW.name != OLD.name OR
 	NEW.array_index != OLD.array_index 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.machine_uuid != OLD.machine_uuid THEN 'machine_uuid,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN NEW.array_index != OLD.array_index THEN 'array_index,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for MachineLxdProfile
 CREATE TRIGGER trg_log_machine_lxd_profile_delete
@@ -32,8 +32,10 @@ WHEN
 	NEW.key != OLD.key OR
 	NEW.value != OLD.value 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.key != OLD.key THEN 'key,' ELSE '' END ||
+        CASE WHEN NEW.value != OLD.value THEN 'value,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ModelConfig
 CREATE TRIGGER trg_log_model_config_delete
@@ -32,8 +32,10 @@ WHEN
 	NEW.path != OLD.path OR
 	NEW.metadata_uuid != OLD.metadata_uuid 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.path != OLD.path THEN 'path,' ELSE '' END ||
+        CASE WHEN NEW.metadata_uuid != OLD.metadata_uuid THEN 'metadata_uuid,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for ObjectStoreMetadataPath
 CREATE TRIGGER trg_log_object_store_metadata_path_delete
@@ -33,8 +33,11 @@ WHEN
 	NEW.backend_uuid != OLD.backend_uuid OR
 	NEW.revision_id != OLD.revision_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.revision_uuid != OLD.revision_uuid THEN 'revision_uuid,' ELSE '' END ||
+        CASE WHEN NEW.backend_uuid != OLD.backend_uuid THEN 'backend_uuid,' ELSE '' END ||
+        CASE WHEN NEW.revision_id != OLD.revision_id THEN 'revision_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretDeletedValueRef
 CREATE TRIGGER trg_log_secret_deleted_value_ref_delete
@@ -75,8 +78,16 @@ WHEN
 	NEW.create_time != OLD.create_time OR
 	NEW.update_time != OLD.update_time 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.secret_id != OLD.secret_id THEN 'secret_id,' ELSE '' END ||
+        CASE WHEN NEW.version != OLD.version THEN 'version,' ELSE '' END ||
+        CASE WHEN (NEW.description != OLD.description OR (NEW.description IS NOT NULL AND OLD.description IS NULL) OR (NEW.description IS NULL AND OLD.description IS NOT NULL)) THEN 'description,' ELSE '' END ||
+        CASE WHEN NEW.rotate_policy_id != OLD.rotate_policy_id THEN 'rotate_policy_id,' ELSE '' END ||
+        CASE WHEN NEW.auto_prune != OLD.auto_prune THEN 'auto_prune,' ELSE '' END ||
+        CASE WHEN (NEW.latest_revision_checksum != OLD.latest_revision_checksum OR (NEW.latest_revision_checksum IS NOT NULL AND OLD.latest_revision_checksum IS NULL) OR (NEW.latest_revision_checksum IS NULL AND OLD.latest_revision_checksum IS NOT NULL)) THEN 'latest_revision_checksum,' ELSE '' END ||
+        CASE WHEN NEW.create_time != OLD.create_time THEN 'create_time,' ELSE '' END ||
+        CASE WHEN NEW.update_time != OLD.update_time THEN 'update_time,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretMetadata
 CREATE TRIGGER trg_log_secret_metadata_delete
@@ -111,8 +122,10 @@ WHEN
 	NEW.secret_id != OLD.secret_id OR
 	NEW.latest_revision != OLD.latest_revision 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.secret_id != OLD.secret_id THEN 'secret_id,' ELSE '' END ||
+        CASE WHEN NEW.latest_revision != OLD.latest_revision THEN 'latest_revision,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretReference
 CREATE TRIGGER trg_log_secret_reference_delete
@@ -149,8 +162,12 @@ WHEN
 	NEW.revision != OLD.revision OR
 	NEW.create_time != OLD.create_time 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.secret_id != OLD.secret_id THEN 'secret_id,' ELSE '' END ||
+        CASE WHEN NEW.revision != OLD.revision THEN 'revision,' ELSE '' END ||
+        CASE WHEN NEW.create_time != OLD.create_time THEN 'create_time,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretRevision
 CREATE TRIGGER trg_log_secret_revision_delete
@@ -185,8 +202,10 @@ WHEN
 	NEW.revision_uuid != OLD.revision_uuid OR
 	NEW.expire_time != OLD.expire_time 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.revision_uuid != OLD.revision_uuid THEN 'revision_uuid,' ELSE '' END ||
+        CASE WHEN NEW.expire_time != OLD.expire_time THEN 'expire_time,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretRevisionExpire
 CREATE TRIGGER trg_log_secret_revision_expire_delete
@@ -222,8 +241,11 @@ WHEN
 	NEW.obsolete != OLD.obsolete OR
 	NEW.pending_delete != OLD.pending_delete 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.revision_uuid != OLD.revision_uuid THEN 'revision_uuid,' ELSE '' END ||
+        CASE WHEN NEW.obsolete != OLD.obsolete THEN 'obsolete,' ELSE '' END ||
+        CASE WHEN NEW.pending_delete != OLD.pending_delete THEN 'pending_delete,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretRevisionObsolete
 CREATE TRIGGER trg_log_secret_revision_obsolete_delete
@@ -258,8 +280,10 @@ WHEN
 	NEW.secret_id != OLD.secret_id OR
 	NEW.next_rotation_time != OLD.next_rotation_time 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.secret_id != OLD.secret_id THEN 'secret_id,' ELSE '' END ||
+        CASE WHEN NEW.next_rotation_time != OLD.next_rotation_time THEN 'next_rotation_time,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for SecretRotation
 CREATE TRIGGER trg_log_secret_rotation_delete
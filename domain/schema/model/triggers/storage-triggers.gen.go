@@ -43,8 +43,21 @@ WHEN
 	(NEW.mount_point != OLD.mount_point OR (NEW.mount_point IS NOT NULL AND OLD.mount_point IS NULL) OR (NEW.mount_point IS NULL AND OLD.mount_point IS NOT NULL)) OR
 	(NEW.in_use != OLD.in_use OR (NEW.in_use IS NOT NULL AND OLD.in_use IS NULL) OR (NEW.in_use IS NULL AND OLD.in_use IS NOT NULL)) 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.machine_uuid != OLD.machine_uuid THEN 'machine_uuid,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN (NEW.label != OLD.label OR (NEW.label IS NOT NULL AND OLD.label IS NULL) OR (NEW.label IS NULL AND OLD.label IS NOT NULL)) THEN 'label,' ELSE '' END ||
+        CASE WHEN (NEW.device_uuid != OLD.device_uuid OR (NEW.device_uuid IS NOT NULL AND OLD.device_uuid IS NULL) OR (NEW.device_uuid IS NULL AND OLD.device_uuid IS NOT NULL)) THEN 'device_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.hardware_id != OLD.hardware_id OR (NEW.hardware_id IS NOT NULL AND OLD.hardware_id IS NULL) OR (NEW.hardware_id IS NULL AND OLD.hardware_id IS NOT NULL)) THEN 'hardware_id,' ELSE '' END ||
+        CASE WHEN (NEW.wwn != OLD.wwn OR (NEW.wwn IS NOT NULL AND OLD.wwn IS NULL) OR (NEW.wwn IS NULL AND OLD.wwn IS NOT NULL)) THEN 'wwn,' ELSE '' END ||
+        CASE WHEN (NEW.bus_address != OLD.bus_address OR (NEW.bus_address IS NOT NULL AND OLD.bus_address IS NULL) OR (NEW.bus_address IS NULL AND OLD.bus_address IS NOT NULL)) THEN 'bus_address,' ELSE '' END ||
+        CASE WHEN (NEW.serial_id != OLD.serial_id OR (NEW.serial_id IS NOT NULL AND OLD.serial_id IS NULL) OR (NEW.serial_id IS NULL AND OLD.serial_id IS NOT NULL)) THEN 'serial_id,' ELSE '' END ||
+        CASE WHEN (NEW.filesystem_type_id != OLD.filesystem_type_id OR (NEW.filesystem_type_id IS NOT NULL AND OLD.filesystem_type_id IS NULL) OR (NEW.filesystem_type_id IS NULL AND OLD.filesystem_type_id IS NOT NULL)) THEN 'filesystem_type_id,' ELSE '' END ||
+        CASE WHEN (NEW.size_mib != OLD.size_mib OR (NEW.size_mib IS NOT NULL AND OLD.size_mib IS NULL) OR (NEW.size_mib IS NULL AND OLD.size_mib IS NOT NULL)) THEN 'size_mib,' ELSE '' END ||
+        CASE WHEN (NEW.mount_point != OLD.mount_point OR (NEW.mount_point IS NOT NULL AND OLD.mount_point IS NULL) OR (NEW.mount_point IS NULL AND OLD.mount_point IS NOT NULL)) THEN 'mount_point,' ELSE '' END ||
+        CASE WHEN (NEW.in_use != OLD.in_use OR (NEW.in_use IS NOT NULL AND OLD.in_use IS NULL) OR (NEW.in_use IS NULL AND OLD.in_use IS NOT NULL)) THEN 'in_use,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for BlockDevice
 CREATE TRIGGER trg_log_block_device_delete
@@ -80,8 +93,11 @@ WHEN
 	NEW.unit_uuid != OLD.unit_uuid OR
 	NEW.life_id != OLD.life_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.storage_instance_uuid != OLD.storage_instance_uuid THEN 'storage_instance_uuid,' ELSE '' END ||
+        CASE WHEN NEW.unit_uuid != OLD.unit_uuid THEN 'unit_uuid,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for StorageAttachment
 CREATE TRIGGER trg_log_storage_attachment_delete
@@ -120,8 +136,14 @@ WHEN
 	(NEW.size_mib != OLD.size_mib OR (NEW.size_mib IS NOT NULL AND OLD.size_mib IS NULL) OR (NEW.size_mib IS NULL AND OLD.size_mib IS NOT NULL)) OR
 	NEW.provisioning_status_id != OLD.provisioning_status_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN (NEW.provider_id != OLD.provider_id OR (NEW.provider_id IS NOT NULL AND OLD.provider_id IS NULL) OR (NEW.provider_id IS NULL AND OLD.provider_id IS NOT NULL)) THEN 'provider_id,' ELSE '' END ||
+        CASE WHEN (NEW.storage_pool_uuid != OLD.storage_pool_uuid OR (NEW.storage_pool_uuid IS NOT NULL AND OLD.storage_pool_uuid IS NULL) OR (NEW.storage_pool_uuid IS NULL AND OLD.storage_pool_uuid IS NOT NULL)) THEN 'storage_pool_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.size_mib != OLD.size_mib OR (NEW.size_mib IS NOT NULL AND OLD.size_mib IS NULL) OR (NEW.size_mib IS NULL AND OLD.size_mib IS NOT NULL)) THEN 'size_mib,' ELSE '' END ||
+        CASE WHEN NEW.provisioning_status_id != OLD.provisioning_status_id THEN 'provisioning_status_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for StorageFilesystem
 CREATE TRIGGER trg_log_storage_filesystem_delete
@@ -161,8 +183,15 @@ WHEN
 	(NEW.read_only != OLD.read_only OR (NEW.read_only IS NOT NULL AND OLD.read_only IS NULL) OR (NEW.read_only IS NULL AND OLD.read_only IS NOT NULL)) OR
 	NEW.provisioning_status_id != OLD.provisioning_status_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.storage_filesystem_uuid != OLD.storage_filesystem_uuid THEN 'storage_filesystem_uuid,' ELSE '' END ||
+        CASE WHEN NEW.net_node_uuid != OLD.net_node_uuid THEN 'net_node_uuid,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN (NEW.mount_point != OLD.mount_point OR (NEW.mount_point IS NOT NULL AND OLD.mount_point IS NULL) OR (NEW.mount_point IS NULL AND OLD.mount_point IS NOT NULL)) THEN 'mount_point,' ELSE '' END ||
+        CASE WHEN (NEW.read_only != OLD.read_only OR (NEW.read_only IS NOT NULL AND OLD.read_only IS NULL) OR (NEW.read_only IS NULL AND OLD.read_only IS NOT NULL)) THEN 'read_only,' ELSE '' END ||
+        CASE WHEN NEW.provisioning_status_id != OLD.provisioning_status_id THEN 'provisioning_status_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for StorageFilesystemAttachment
 CREATE TRIGGER trg_log_storage_filesystem_attachment_delete
@@ -205,8 +234,18 @@ WHEN
 	(NEW.persistent != OLD.persistent OR (NEW.persistent IS NOT NULL AND OLD.persistent IS NULL) OR (NEW.persistent IS NULL AND OLD.persistent IS NOT NULL)) OR
 	NEW.provisioning_status_id != OLD.provisioning_status_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN NEW.name != OLD.name THEN 'name,' ELSE '' END ||
+        CASE WHEN (NEW.provider_id != OLD.provider_id OR (NEW.provider_id IS NOT NULL AND OLD.provider_id IS NULL) OR (NEW.provider_id IS NULL AND OLD.provider_id IS NOT NULL)) THEN 'provider_id,' ELSE '' END ||
+        CASE WHEN (NEW.storage_pool_uuid != OLD.storage_pool_uuid OR (NEW.storage_pool_uuid IS NOT NULL AND OLD.storage_pool_uuid IS NULL) OR (NEW.storage_pool_uuid IS NULL AND OLD.storage_pool_uuid IS NOT NULL)) THEN 'storage_pool_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.size_mib != OLD.size_mib OR (NEW.size_mib IS NOT NULL AND OLD.size_mib IS NULL) OR (NEW.size_mib IS NULL AND OLD.size_mib IS NOT NULL)) THEN 'size_mib,' ELSE '' END ||
+        CASE WHEN (NEW.hardware_id != OLD.hardware_id OR (NEW.hardware_id IS NOT NULL AND OLD.hardware_id IS NULL) OR (NEW.hardware_id IS NULL AND OLD.hardware_id IS NOT NULL)) THEN 'hardware_id,' ELSE '' END ||
+        CASE WHEN (NEW.wwn != OLD.wwn OR (NEW.wwn IS NOT NULL AND OLD.wwn IS NULL) OR (NEW.wwn IS NULL AND OLD.wwn IS NOT NULL)) THEN 'wwn,' ELSE '' END ||
+        CASE WHEN (NEW.persistent != OLD.persistent OR (NEW.persistent IS NOT NULL AND OLD.persistent IS NULL) OR (NEW.persistent IS NULL AND OLD.persistent IS NOT NULL)) THEN 'persistent,' ELSE '' END ||
+        CASE WHEN NEW.provisioning_status_id != OLD.provisioning_status_id THEN 'provisioning_status_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for StorageVolume
 CREATE TRIGGER trg_log_storage_volume_delete
@@ -246,8 +285,15 @@ WHEN
 	(NEW.read_only != OLD.read_only OR (NEW.read_only IS NOT NULL AND OLD.read_only IS NULL) OR (NEW.read_only IS NULL AND OLD.read_only IS NOT NULL)) OR
 	NEW.provisioning_status_id != OLD.provisioning_status_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.storage_volume_uuid != OLD.storage_volume_uuid THEN 'storage_volume_uuid,' ELSE '' END ||
+        CASE WHEN NEW.net_node_uuid != OLD.net_node_uuid THEN 'net_node_uuid,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN (NEW.block_device_uuid != OLD.block_device_uuid OR (NEW.block_device_uuid IS NOT NULL AND OLD.block_device_uuid IS NULL) OR (NEW.block_device_uuid IS NULL AND OLD.block_device_uuid IS NOT NULL)) THEN 'block_device_uuid,' ELSE '' END ||
+        CASE WHEN (NEW.read_only != OLD.read_only OR (NEW.read_only IS NOT NULL AND OLD.read_only IS NULL) OR (NEW.read_only IS NULL AND OLD.read_only IS NOT NULL)) THEN 'read_only,' ELSE '' END ||
+        CASE WHEN NEW.provisioning_status_id != OLD.provisioning_status_id THEN 'provisioning_status_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for StorageVolumeAttachment
 CREATE TRIGGER trg_log_storage_volume_attachment_delete
@@ -287,8 +333,15 @@ WHEN
 	(NEW.block_device_uuid != OLD.block_device_uuid OR (NEW.block_device_uuid IS NOT NULL AND OLD.block_device_uuid IS NULL) OR (NEW.block_device_uuid IS NULL AND OLD.block_device_uuid IS NOT NULL)) OR
 	NEW.provisioning_status_id != OLD.provisioning_status_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN NEW.uuid != OLD.uuid THEN 'uuid,' ELSE '' END ||
+        CASE WHEN NEW.storage_volume_uuid != OLD.storage_volume_uuid THEN 'storage_volume_uuid,' ELSE '' END ||
+        CASE WHEN NEW.net_node_uuid != OLD.net_node_uuid THEN 'net_node_uuid,' ELSE '' END ||
+        CASE WHEN NEW.life_id != OLD.life_id THEN 'life_id,' ELSE '' END ||
+        CASE WHEN (NEW.device_type_id != OLD.device_type_id OR (NEW.device_type_id IS NOT NULL AND OLD.device_type_id IS NULL) OR (NEW.device_type_id IS NULL AND OLD.device_type_id IS NOT NULL)) THEN 'device_type_id,' ELSE '' END ||
+        CASE WHEN (NEW.block_device_uuid != OLD.block_device_uuid OR (NEW.block_device_uuid IS NOT NULL AND OLD.block_device_uuid IS NULL) OR (NEW.block_device_uuid IS NULL AND OLD.block_device_uuid IS NOT NULL)) THEN 'block_device_uuid,' ELSE '' END ||
+        CASE WHEN NEW.provisioning_status_id != OLD.provisioning_status_id THEN 'provisioning_status_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for StorageVolumeAttachmentPlan
 CREATE TRIGGER trg_log_storage_volume_attachment_plan_delete
@@ -35,8 +35,14 @@ WHEN
 	NEW.public_key != OLD.public_key OR
 	NEW.user_id != OLD.user_id 
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM(
+        CASE WHEN (NEW.id != OLD.id OR (NEW.id IS NOT NULL AND OLD.id IS NULL) OR (NEW.id IS NULL AND OLD.id IS NOT NULL)) THEN 'id,' ELSE '' END ||
+        CASE WHEN NEW.comment != OLD.comment THEN 'comment,' ELSE '' END ||
+        CASE WHEN NEW.fingerprint_hash_algorithm_id != OLD.fingerprint_hash_algorithm_id THEN 'fingerprint_hash_algorithm_id,' ELSE '' END ||
+        CASE WHEN NEW.fingerprint != OLD.fingerprint THEN 'fingerprint,' ELSE '' END ||
+        CASE WHEN NEW.public_key != OLD.public_key THEN 'public_key,' ELSE '' END ||
+        CASE WHEN NEW.user_id != OLD.user_id THEN 'user_id,' ELSE '' END, ','), DATETIME('now'));
 END;
 -- delete trigger for UserPublicSshKey
 CREATE TRIGGER trg_log_user_public_ssh_key_delete
@@ -4,6 +4,9 @@
 package schema
 
 import (
+	"database/sql"
+	"fmt"
+
 	"github.com/juju/collections/set"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/utils/v4"
@@ -62,6 +65,7 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 
 		// Change log
 		"change_log",
+		"change_log_durable_consumer",
 		"change_log_edit_type",
 		"change_log_namespace",
 		"change_log_witness",
@@ -110,12 +114,16 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"unit_agent_status_value",
 		"unit_agent_status",
 		"unit_agent",
+		"pebble_check_status_value",
+		"unit_pebble_check_status",
+		"unit_pebble_check_status_history",
 		"unit_principal",
 		"unit_resolve_kind",
 		"unit_state_charm",
 		"unit_state_relation",
 		"unit_state",
 		"unit_workload_status",
+		"unit_workload_status_history",
 		"unit",
 
 		// Constraint
@@ -226,6 +234,7 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 
 		// Secret
 		"secret_rotate_policy",
+		"secret_type",
 		"secret",
 		"secret_reference",
 		"secret_metadata",
@@ -233,9 +242,14 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"secret_value_ref",
 		"secret_deleted_value_ref",
 		"secret_content",
+		"secret_content_key",
 		"secret_revision",
 		"secret_revision_obsolete",
 		"secret_revision_expire",
+		"secret_revision_stage_status",
+		"secret_revision_stage",
+		"secret_revision_rollback",
+		"secret_revision_pin",
 		"secret_application_owner",
 		"secret_model_owner",
 		"secret_unit_owner",
@@ -245,6 +259,8 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"secret_role",
 		"secret_grant_subject_type",
 		"secret_grant_scope_type",
+		"secret_accessor_type",
+		"secret_access_log",
 
 		// Opened Ports
 		"protocol",
@@ -267,6 +283,9 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 
 		// Sequence
 		"sequence",
+
+		// Workload metrics
+		"unit_workload_metric",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
@@ -277,6 +296,13 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 	))
 }
 
+func (s *modelSchemaSuite) TestModelDDLNoDrift(c *gc.C) {
+	ddl := ModelDDL()
+	s.applyDDL(c, ddl)
+
+	s.assertNoDrift(c, ddl)
+}
+
 func (s *modelSchemaSuite) TestModelViews(c *gc.C) {
 	c.Logf("Committing schema DDL")
 
@@ -442,6 +468,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *gc.C) {
 		"trg_log_removal_delete",
 		"trg_log_removal_insert",
 		"trg_log_removal_update",
+
+		"trg_log_relation_delete",
+		"trg_log_relation_insert",
+		"trg_log_relation_update",
 	)
 
 	// These are additional triggers that are not change log triggers, but
@@ -514,3 +544,33 @@ VALUES (?, 'foo');`,
 
 	s.assertExecSQL(c, "DELETE FROM charm_metadata WHERE charm_uuid = ?;", id)
 }
+
+func (s *modelSchemaSuite) TestChangeLogTriggersRecordChangedColumns(c *gc.C) {
+	s.applyDDL(c, ModelDDL())
+
+	s.assertExecSQL(c, `INSERT INTO model_config (key, value) VALUES ('foo', 'bar');`)
+	s.assertExecSQL(c, `UPDATE model_config SET value = 'baz' WHERE key = 'foo';`)
+	s.assertExecSQL(c, `DELETE FROM model_config WHERE key = 'foo';`)
+
+	rows, err := s.DB().Query(`
+SELECT edit_type_id, changed_columns FROM change_log
+WHERE namespace_id = ?
+ORDER BY id;`[1:], tableModelConfig)
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = rows.Close() }()
+
+	var got []string
+	for rows.Next() {
+		var (
+			editType int
+			columns  sql.NullString
+		)
+		err := rows.Scan(&editType, &columns)
+		c.Assert(err, jc.ErrorIsNil)
+		got = append(got, fmt.Sprintf("%d:%s", editType, columns.String))
+	}
+	c.Assert(rows.Err(), jc.ErrorIsNil)
+
+	// Only updates record the columns that changed.
+	c.Check(got, gc.DeepEquals, []string{"1:", "2:value", "4:"})
+}
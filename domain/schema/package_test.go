@@ -52,6 +52,21 @@ func (s *schemaBaseSuite) applyDDL(c *gc.C, ddl *schema.Schema) {
 	c.Check(changeSet.Post, gc.Equals, ddl.Len())
 }
 
+// assertNoDrift checks that the objects in the database match those defined
+// by the schema, once the schema has been applied.
+func (s *schemaBaseSuite) assertNoDrift(c *gc.C, ddl *schema.Schema) {
+	report, err := ddl.CheckDrift(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	var drift []string
+	for _, d := range report.Drift {
+		drift = append(drift, d.String())
+	}
+	c.Check(drift, gc.HasLen, 0, gc.Commentf("drift: %v", drift))
+	c.Check(report.Version, gc.Equals, ddl.Len())
+	c.Check(report.OK(), jc.IsTrue)
+}
+
 func (s *schemaBaseSuite) assertExecSQL(c *gc.C, q string, args ...any) {
 	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
 		_, err := tx.ExecContext(ctx, q, args...)
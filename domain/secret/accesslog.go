@@ -0,0 +1,39 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import "time"
+
+// AccessorType represents the type of an entity which read secret
+// content, as recorded in the secret_accessor_type lookup table.
+type AccessorType int
+
+const (
+	AccessorUnit AccessorType = iota
+	AccessorApplication
+	AccessorRemoteApplication
+	AccessorUser
+)
+
+// String implements fmt.Stringer.
+func (t AccessorType) String() string {
+	switch t {
+	case AccessorApplication:
+		return "application"
+	case AccessorRemoteApplication:
+		return "remote-application"
+	case AccessorUser:
+		return "user"
+	default:
+		return "unit"
+	}
+}
+
+// AccessRecord records a read of secret content.
+type AccessRecord struct {
+	Revision     int
+	AccessorType AccessorType
+	AccessorID   string
+	AccessTime   time.Time
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type accessLogSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&accessLogSuite{})
+
+// TestAccessorTypeDBValues ensures there's no skew between what's in the
+// database table for accessor type and the typed consts used in the state packages.
+func (s *accessLogSuite) TestAccessorTypeDBValues(c *gc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, type FROM secret_accessor_type")
+	c.Assert(err, jc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[AccessorType]string)
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+		err := rows.Scan(&id, &value)
+		c.Assert(err, jc.ErrorIsNil)
+		dbValues[AccessorType(id)] = value
+	}
+	c.Assert(dbValues, jc.DeepEquals, map[AccessorType]string{
+		AccessorUnit:              "unit",
+		AccessorApplication:       "application",
+		AccessorRemoteApplication: "remote-application",
+		AccessorUser:              "user",
+	})
+	for id, value := range dbValues {
+		c.Assert(id.String(), gc.Equals, value)
+	}
+}
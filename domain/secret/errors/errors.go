@@ -41,6 +41,14 @@ const (
 	// being operated on does not exist.
 	SecretAccessScopeNotFound = errors.ConstError("secret access scope not found")
 
+	// SecretRevisionStagePending describes an error that occurs when a new secret revision
+	// is created while a staged rotation of the secret is still pending.
+	SecretRevisionStagePending = errors.ConstError("secret has a pending staged revision")
+
+	// SecretRevisionObsolete describes an error that occurs when a secret revision
+	// which is no longer retained is rolled back to or pinned.
+	SecretRevisionObsolete = errors.ConstError("secret revision is obsolete")
+
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
 )
@@ -47,8 +47,12 @@ func (e *exportOperation) Name() string {
 }
 
 func (e *exportOperation) Setup(scope modelmigration.Scope) error {
+	keys, err := secretContentKeys(scope)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	e.service = service.NewSecretService(
-		state.NewState(scope.ModelDB(), e.logger),
+		state.NewState(scope.ModelDB(), keys, e.logger),
 		secretbackendstate.NewState(scope.ControllerDB(), e.logger),
 		nil,
 		e.logger,
@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/domain/secret/state"
 	backendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // Coordinator is the interface that is used to add operations to a migration.
@@ -67,11 +68,15 @@ func (i *importOperation) Name() string {
 }
 
 func (i *importOperation) Setup(scope modelmigration.Scope) error {
+	keys, err := secretContentKeys(scope)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	// We must not use a watcher during migration, so it's safe to pass a
 	// nil watcher factory.
 	backendstate := secretbackendstate.NewState(scope.ControllerDB(), i.logger)
 	i.service = service.NewSecretService(
-		state.NewState(scope.ModelDB(), i.logger),
+		state.NewState(scope.ModelDB(), keys, i.logger),
 		backendstate, nil, i.logger,
 		service.SecretServiceParams{
 			BackendUserSecretConfigGetter: service.NotImplementedBackendUserSecretConfigGetter,
@@ -83,6 +88,17 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	return nil
 }
 
+// secretContentKeys returns the controller keys used to wrap the keys
+// encrypting secret content in the model being migrated.
+func secretContentKeys(scope modelmigration.Scope) (envelope.KeyRing, error) {
+	encoded := scope.SecretContentKeys()
+	if len(encoded) == 0 {
+		return envelope.KeyRing{}, nil
+	}
+	keys, err := envelope.ParseKeyRing(encoded)
+	return keys, errors.Annotate(err, "parsing secret content keys")
+}
+
 func ownerFromTag(owner names.Tag) (secrets.Owner, error) {
 	switch owner.Kind() {
 	case names.ApplicationTagKind:
@@ -0,0 +1,33 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import coresecrets "github.com/juju/juju/core/secrets"
+
+// SecretType represents the type of a secret
+// as recorded in the secret_type lookup table.
+type SecretType int
+
+const (
+	TypeGeneric SecretType = iota
+	TypeTLS
+	TypePassword
+	TypeSSHKey
+	TypeDockerRegistry
+)
+
+// MarshallSecretType converts a secret type to a db secret type id.
+func MarshallSecretType(secretType coresecrets.SecretType) SecretType {
+	switch secretType {
+	case coresecrets.TypeTLS:
+		return TypeTLS
+	case coresecrets.TypePassword:
+		return TypePassword
+	case coresecrets.TypeSSHKey:
+		return TypeSSHKey
+	case coresecrets.TypeDockerRegistry:
+		return TypeDockerRegistry
+	}
+	return TypeGeneric
+}
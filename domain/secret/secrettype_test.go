@@ -0,0 +1,50 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type secretTypeSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&secretTypeSuite{})
+
+// TestSecretTypeDBValues ensures there's no skew between what's in the
+// database table for secret type and the typed consts used in the state packages.
+func (s *secretTypeSuite) TestSecretTypeDBValues(c *gc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, type FROM secret_type")
+	c.Assert(err, jc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[SecretType]string)
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+		err := rows.Scan(&id, &value)
+		c.Assert(err, jc.ErrorIsNil)
+		dbValues[SecretType(id)] = value
+	}
+	c.Assert(dbValues, jc.DeepEquals, map[SecretType]string{
+		TypeGeneric:        "generic",
+		TypeTLS:            "tls",
+		TypePassword:       "password",
+		TypeSSHKey:         "ssh-key",
+		TypeDockerRegistry: "docker-registry",
+	})
+	// Also check the core secret enums match.
+	for _, t := range dbValues {
+		c.Assert(coresecrets.SecretType(t).IsValid(), jc.IsTrue)
+		c.Assert(dbValues[MarshallSecretType(coresecrets.SecretType(t))], gc.Equals, t)
+	}
+}
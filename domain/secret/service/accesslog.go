@@ -0,0 +1,71 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// recordSecretAccess records a read of the content of the specified
+// secret revision by accessor. Reads made by the model itself, e.g.
+// when draining secrets to a new backend, are not recorded.
+func (s *SecretService) recordSecretAccess(ctx context.Context, uri *secrets.URI, rev int, accessor SecretAccessor) error {
+	record := domainsecret.AccessRecord{
+		Revision:   rev,
+		AccessorID: accessor.ID,
+		AccessTime: s.clock.Now().UTC(),
+	}
+	switch accessor.Kind {
+	case UnitAccessor:
+		record.AccessorType = domainsecret.AccessorUnit
+	case ApplicationAccessor:
+		record.AccessorType = domainsecret.AccessorApplication
+	case RemoteApplicationAccessor:
+		record.AccessorType = domainsecret.AccessorRemoteApplication
+	case UserAccessor:
+		record.AccessorType = domainsecret.AccessorUser
+	case ModelAccessor:
+		return nil
+	default:
+		return errors.Errorf("recording access to secret %q by %q: unexpected accessor kind %q", uri, accessor.ID, accessor.Kind)
+	}
+	if err := s.secretState.RecordSecretAccess(ctx, uri, record); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// GetSecretAccessLog returns the recorded reads of the content of the
+// specified secret, oldest first.
+// If the secret does not exist, an error satisfying
+// [secreterrors.SecretNotFound] is returned.
+func (s *SecretService) GetSecretAccessLog(ctx context.Context, uri *secrets.URI) ([]SecretAccessRecord, error) {
+	records, err := s.secretState.GetSecretAccessLog(ctx, uri)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	result := make([]SecretAccessRecord, len(records))
+	for i, r := range records {
+		result[i] = SecretAccessRecord{
+			Revision:   r.Revision,
+			Accessor:   SecretAccessor{ID: r.AccessorID},
+			AccessTime: r.AccessTime,
+		}
+		switch r.AccessorType {
+		case domainsecret.AccessorUnit:
+			result[i].Accessor.Kind = UnitAccessor
+		case domainsecret.AccessorApplication:
+			result[i].Accessor.Kind = ApplicationAccessor
+		case domainsecret.AccessorRemoteApplication:
+			result[i].Accessor.Kind = RemoteApplicationAccessor
+		case domainsecret.AccessorUser:
+			result[i].Accessor.Kind = UserAccessor
+		}
+	}
+	return result, nil
+}
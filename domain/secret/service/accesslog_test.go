@@ -0,0 +1,95 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+func (s *serviceSuite) TestGetSecretValueModelAccessorNotRecorded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+
+	data, _, err := s.service.GetSecretValue(context.Background(), uri, 1, SecretAccessor{
+		Kind: ModelAccessor,
+		ID:   s.modelID.String(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
+
+func (s *serviceSuite) TestGetSecretValueRecordError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, gomock.Any()).Return(secreterrors.SecretNotFound)
+
+	_, _, err := s.service.GetSecretValue(context.Background(), uri, 1, SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *serviceSuite) TestGetSecretAccessLog(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	now := time.Now()
+
+	s.state.EXPECT().GetSecretAccessLog(gomock.Any(), uri).Return([]domainsecret.AccessRecord{{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mariadb/0",
+		AccessTime:   now,
+	}, {
+		Revision:     2,
+		AccessorType: domainsecret.AccessorRemoteApplication,
+		AccessorID:   "remote-app",
+		AccessTime:   now,
+	}, {
+		Revision:     2,
+		AccessorType: domainsecret.AccessorUser,
+		AccessorID:   "admin",
+		AccessTime:   now,
+	}}, nil)
+
+	got, err := s.service.GetSecretAccessLog(context.Background(), uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, []SecretAccessRecord{{
+		Revision:   1,
+		Accessor:   SecretAccessor{Kind: UnitAccessor, ID: "mariadb/0"},
+		AccessTime: now,
+	}, {
+		Revision:   2,
+		Accessor:   SecretAccessor{Kind: RemoteApplicationAccessor, ID: "remote-app"},
+		AccessTime: now,
+	}, {
+		Revision:   2,
+		Accessor:   SecretAccessor{Kind: UserAccessor, ID: "admin"},
+		AccessTime: now,
+	}})
+}
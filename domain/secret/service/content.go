@@ -0,0 +1,39 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// RotateSecretContentKey replaces the key used to encrypt secret content
+// stored in the model if it is older than maxAge, or if the controller key
+// which wrapped it has been rotated out. It returns true if the
+// key was replaced, after which [SecretService.ReencryptSecretContent]
+// should be called until all content is encrypted with the new key.
+func (s *SecretService) RotateSecretContentKey(ctx context.Context, maxAge time.Duration) (bool, error) {
+	rotated, err := s.secretState.RotateSecretContentKey(ctx, s.clock.Now().Add(-maxAge))
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	return rotated, nil
+}
+
+// ReencryptSecretContent re-encrypts up to batchSize items of secret content
+// stored in the model which are not encrypted with the current key, and
+// returns the number of items re-encrypted. Zero is returned once all
+// content is encrypted with the current key.
+func (s *SecretService) ReencryptSecretContent(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, errors.Errorf("batch size %d not valid", batchSize)
+	}
+	count, err := s.secretState.ReencryptSecretContent(ctx, batchSize)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	return count, nil
+}
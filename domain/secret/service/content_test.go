@@ -0,0 +1,43 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+)
+
+func (s *serviceSuite) TestRotateSecretContentKey(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.state.EXPECT().RotateSecretContentKey(gomock.Any(), s.clock.Now().Add(-24*time.Hour)).Return(true, nil)
+
+	rotated, err := s.service.RotateSecretContentKey(context.Background(), 24*time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotated, jc.IsTrue)
+}
+
+func (s *serviceSuite) TestReencryptSecretContent(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.state.EXPECT().ReencryptSecretContent(gomock.Any(), 100).Return(42, nil)
+
+	count, err := s.service.ReencryptSecretContent(context.Background(), 100)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 42)
+}
+
+func (s *serviceSuite) TestReencryptSecretContentInvalidBatchSize(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	_, err := s.service.ReencryptSecretContent(context.Background(), 0)
+	c.Assert(err, gc.ErrorMatches, `batch size 0 not valid`)
+}
@@ -6,8 +6,11 @@ package service
 import (
 	"context"
 
+	"github.com/juju/collections/set"
+
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain"
+	domainsecret "github.com/juju/juju/domain/secret"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -34,6 +37,11 @@ func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, para
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) error {
+		_, revisions, err := s.secretState.ListSecrets(innerCtx, uri, nil, domainsecret.NilLabels)
+		if err != nil {
+			return errors.Errorf("deleting secret %q: %w", uri.ID, err)
+		}
+
 		// TODO (manadart 2024-11-29): This context naming is nasty,
 		// but will be removed with RunAtomic.
 		if err := s.secretState.RunAtomic(innerCtx, func(innerInnerCtx domain.AtomicContext) error {
@@ -41,6 +49,17 @@ func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, para
 		}); err != nil {
 			return errors.Errorf("deleting secret %q: %w", uri.ID, err)
 		}
+
+		// Agents can't delete content from a backend which
+		// is only accessed by the controller.
+		deleted := set.NewInts(params.Revisions...)
+		for _, revs := range revisions {
+			for _, rev := range revs {
+				if len(params.Revisions) == 0 || deleted.Contains(rev.Revision) {
+					s.deleteContentForAgent(innerCtx, rev.ValueRef)
+				}
+			}
+		}
 		return nil
 	})
 }
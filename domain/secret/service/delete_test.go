@@ -43,6 +43,9 @@ func (s *serviceSuite) TestDeleteSecret(c *gc.C) {
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().ListSecrets(gomock.Any(), uri, nil, domainsecret.NilLabels).Return(
+		[]*coresecrets.SecretMetadata{{URI: uri}},
+		[][]*coresecrets.SecretRevisionMetadata{{{Revision: 1}, {Revision: 2}}}, nil)
 	s.state.EXPECT().DeleteSecret(domaintesting.IsAtomicContextChecker, uri, []int{1, 2})
 
 	revs := provider.SecretRevisions{}
@@ -58,3 +61,75 @@ func (s *serviceSuite) TestDeleteSecret(c *gc.C) {
 	})
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *serviceSuite) TestDeleteSecretControllerOnlyBackend(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.service.controllerOnly["backend-id"] = true
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().ListSecrets(gomock.Any(), uri, nil, domainsecret.NilLabels).Return(
+		[]*coresecrets.SecretMetadata{{URI: uri}},
+		[][]*coresecrets.SecretRevisionMetadata{{{
+			Revision: 1,
+			ValueRef: &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id1"},
+		}, {
+			Revision: 2,
+			ValueRef: &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id2"},
+		}}}, nil)
+	s.state.EXPECT().DeleteSecret(domaintesting.IsAtomicContextChecker, uri, []int{1})
+	s.state.EXPECT().CountSecretValueRefRevisions(gomock.Any(), &coresecrets.ValueRef{
+		BackendID: "backend-id", RevisionID: "rev-id1",
+	}).Return(0, nil)
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-id1").Return(nil)
+
+	err := s.service.DeleteSecret(context.Background(), uri, DeleteSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Revisions: []int{1},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestDeleteSecretControllerOnlyBackendSharedContent(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.service.controllerOnly["backend-id"] = true
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().ListSecrets(gomock.Any(), uri, nil, domainsecret.NilLabels).Return(
+		[]*coresecrets.SecretMetadata{{URI: uri}},
+		[][]*coresecrets.SecretRevisionMetadata{{{
+			Revision: 1,
+			ValueRef: &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id1"},
+		}, {
+			Revision: 2,
+			ValueRef: &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id1"},
+		}}}, nil)
+	s.state.EXPECT().DeleteSecret(domaintesting.IsAtomicContextChecker, uri, []int{1})
+	// Revision 2 was restored from revision 1, so the content is kept.
+	s.state.EXPECT().CountSecretValueRefRevisions(gomock.Any(), &coresecrets.ValueRef{
+		BackendID: "backend-id", RevisionID: "rev-id1",
+	}).Return(1, nil)
+
+	err := s.service.DeleteSecret(context.Background(), uri, DeleteSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Revisions: []int{1},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
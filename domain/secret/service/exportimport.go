@@ -274,6 +274,10 @@ func (s *SecretService) createImportedSecret(
 	if md.AutoPrune {
 		params.AutoPrune = &md.AutoPrune
 	}
+	if md.Type != "" && md.Type != coresecrets.TypeGeneric {
+		secretType := secret.MarshallSecretType(md.Type)
+		params.Type = &secretType
+	}
 
 	revisionID, err := s.uuidGenerator()
 	if err != nil {
@@ -87,12 +87,29 @@ type State interface {
 	ListUserSecretsToDrain(ctx context.Context) ([]*secrets.SecretMetadataForDrain, error)
 	SecretRotated(ctx context.Context, uri *secrets.URI, next time.Time) error
 	GetRotatePolicy(ctx context.Context, uri *secrets.URI) (secrets.RotatePolicy, error)
+	GetSecretType(ctx context.Context, uri *secrets.URI) (secrets.SecretType, error)
 	GetRotationExpiryInfo(ctx context.Context, uri *secrets.URI) (*domainsecret.RotationExpiryInfo, error)
 	GetSecretRevisionID(ctx context.Context, uri *secrets.URI, revision int) (string, error)
+	CountSecretValueRefRevisions(ctx context.Context, ref *secrets.ValueRef) (int, error)
 	ChangeSecretBackend(
 		ctx context.Context, revisionID uuid.UUID, valueRef *secrets.ValueRef, data secrets.SecretData,
 	) error
 
+	// For managing the keys which encrypt secret content.
+	RotateSecretContentKey(ctx context.Context, createdBefore time.Time) (bool, error)
+	ReencryptSecretContent(ctx context.Context, limit int) (int, error)
+
+	// For resolving staged rotations.
+	ProcessStagedRevisions(ctx context.Context, now time.Time) ([]string, []string, []string, error)
+
+	// For pinning consumers to secret revisions.
+	PinSecretRevision(ctx context.Context, uri *secrets.URI, appName string, revision int) error
+	UnpinSecretRevision(ctx context.Context, uri *secrets.URI, appName string) error
+
+	// For recording reads of secret content.
+	RecordSecretAccess(ctx context.Context, uri *secrets.URI, record domainsecret.AccessRecord) error
+	GetSecretAccessLog(ctx context.Context, uri *secrets.URI) ([]domainsecret.AccessRecord, error)
+
 	// For watching obsolete secret revision changes.
 	InitialWatchStatementForObsoleteRevision(
 		appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners,
@@ -362,6 +362,45 @@ func (c *MockStateCheckUserSecretLabelExistsCall) DoAndReturn(f func(domain.Atom
 	return c
 }
 
+// CountSecretValueRefRevisions mocks base method.
+func (m *MockState) CountSecretValueRefRevisions(arg0 context.Context, arg1 *secrets.ValueRef) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSecretValueRefRevisions", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSecretValueRefRevisions indicates an expected call of CountSecretValueRefRevisions.
+func (mr *MockStateMockRecorder) CountSecretValueRefRevisions(arg0, arg1 any) *MockStateCountSecretValueRefRevisionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSecretValueRefRevisions", reflect.TypeOf((*MockState)(nil).CountSecretValueRefRevisions), arg0, arg1)
+	return &MockStateCountSecretValueRefRevisionsCall{Call: call}
+}
+
+// MockStateCountSecretValueRefRevisionsCall wrap *gomock.Call
+type MockStateCountSecretValueRefRevisionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCountSecretValueRefRevisionsCall) Return(arg0 int, arg1 error) *MockStateCountSecretValueRefRevisionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCountSecretValueRefRevisionsCall) Do(f func(context.Context, *secrets.ValueRef) (int, error)) *MockStateCountSecretValueRefRevisionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCountSecretValueRefRevisionsCall) DoAndReturn(f func(context.Context, *secrets.ValueRef) (int, error)) *MockStateCountSecretValueRefRevisionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateCharmApplicationSecret mocks base method.
 func (m *MockState) CreateCharmApplicationSecret(arg0 domain.AtomicContext, arg1 int, arg2 *secrets.URI, arg3 application.ID, arg4 secret.UpsertSecretParams) error {
 	m.ctrl.T.Helper()
@@ -1041,6 +1080,45 @@ func (c *MockStateGetSecretAccessCall) DoAndReturn(f func(context.Context, *secr
 	return c
 }
 
+// GetSecretAccessLog mocks base method.
+func (m *MockState) GetSecretAccessLog(arg0 context.Context, arg1 *secrets.URI) ([]secret.AccessRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretAccessLog", arg0, arg1)
+	ret0, _ := ret[0].([]secret.AccessRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretAccessLog indicates an expected call of GetSecretAccessLog.
+func (mr *MockStateMockRecorder) GetSecretAccessLog(arg0, arg1 any) *MockStateGetSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretAccessLog", reflect.TypeOf((*MockState)(nil).GetSecretAccessLog), arg0, arg1)
+	return &MockStateGetSecretAccessLogCall{Call: call}
+}
+
+// MockStateGetSecretAccessLogCall wrap *gomock.Call
+type MockStateGetSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretAccessLogCall) Return(arg0 []secret.AccessRecord, arg1 error) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretAccessLogCall) Do(f func(context.Context, *secrets.URI) ([]secret.AccessRecord, error)) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets.URI) ([]secret.AccessRecord, error)) *MockStateGetSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretAccessScope mocks base method.
 func (m *MockState) GetSecretAccessScope(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessParams) (*secret.AccessScope, error) {
 	m.ctrl.T.Helper()
@@ -1277,6 +1355,45 @@ func (c *MockStateGetSecretRevisionIDCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// GetSecretType mocks base method.
+func (m *MockState) GetSecretType(arg0 context.Context, arg1 *secrets.URI) (secrets.SecretType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretType", arg0, arg1)
+	ret0, _ := ret[0].(secrets.SecretType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretType indicates an expected call of GetSecretType.
+func (mr *MockStateMockRecorder) GetSecretType(arg0, arg1 any) *MockStateGetSecretTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretType", reflect.TypeOf((*MockState)(nil).GetSecretType), arg0, arg1)
+	return &MockStateGetSecretTypeCall{Call: call}
+}
+
+// MockStateGetSecretTypeCall wrap *gomock.Call
+type MockStateGetSecretTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretTypeCall) Return(arg0 secrets.SecretType, arg1 error) *MockStateGetSecretTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretTypeCall) Do(f func(context.Context, *secrets.URI) (secrets.SecretType, error)) *MockStateGetSecretTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretTypeCall) DoAndReturn(f func(context.Context, *secrets.URI) (secrets.SecretType, error)) *MockStateGetSecretTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretValue mocks base method.
 func (m *MockState) GetSecretValue(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretData, *secrets.ValueRef, error) {
 	m.ctrl.T.Helper()
@@ -2030,6 +2147,162 @@ func (c *MockStateListUserSecretsToDrainCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// PinSecretRevision mocks base method.
+func (m *MockState) PinSecretRevision(arg0 context.Context, arg1 *secrets.URI, arg2 string, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinSecretRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinSecretRevision indicates an expected call of PinSecretRevision.
+func (mr *MockStateMockRecorder) PinSecretRevision(arg0, arg1, arg2, arg3 any) *MockStatePinSecretRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinSecretRevision", reflect.TypeOf((*MockState)(nil).PinSecretRevision), arg0, arg1, arg2, arg3)
+	return &MockStatePinSecretRevisionCall{Call: call}
+}
+
+// MockStatePinSecretRevisionCall wrap *gomock.Call
+type MockStatePinSecretRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatePinSecretRevisionCall) Return(arg0 error) *MockStatePinSecretRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatePinSecretRevisionCall) Do(f func(context.Context, *secrets.URI, string, int) error) *MockStatePinSecretRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatePinSecretRevisionCall) DoAndReturn(f func(context.Context, *secrets.URI, string, int) error) *MockStatePinSecretRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProcessStagedRevisions mocks base method.
+func (m *MockState) ProcessStagedRevisions(arg0 context.Context, arg1 time.Time) ([]string, []string, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessStagedRevisions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].([]string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ProcessStagedRevisions indicates an expected call of ProcessStagedRevisions.
+func (mr *MockStateMockRecorder) ProcessStagedRevisions(arg0, arg1 any) *MockStateProcessStagedRevisionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessStagedRevisions", reflect.TypeOf((*MockState)(nil).ProcessStagedRevisions), arg0, arg1)
+	return &MockStateProcessStagedRevisionsCall{Call: call}
+}
+
+// MockStateProcessStagedRevisionsCall wrap *gomock.Call
+type MockStateProcessStagedRevisionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateProcessStagedRevisionsCall) Return(arg0, arg1, arg2 []string, arg3 error) *MockStateProcessStagedRevisionsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2, arg3)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateProcessStagedRevisionsCall) Do(f func(context.Context, time.Time) ([]string, []string, []string, error)) *MockStateProcessStagedRevisionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateProcessStagedRevisionsCall) DoAndReturn(f func(context.Context, time.Time) ([]string, []string, []string, error)) *MockStateProcessStagedRevisionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordSecretAccess mocks base method.
+func (m *MockState) RecordSecretAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockStateMockRecorder) RecordSecretAccess(arg0, arg1, arg2 any) *MockStateRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretAccess", reflect.TypeOf((*MockState)(nil).RecordSecretAccess), arg0, arg1, arg2)
+	return &MockStateRecordSecretAccessCall{Call: call}
+}
+
+// MockStateRecordSecretAccessCall wrap *gomock.Call
+type MockStateRecordSecretAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRecordSecretAccessCall) Return(arg0 error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRecordSecretAccessCall) Do(f func(context.Context, *secrets.URI, secret.AccessRecord) error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRecordSecretAccessCall) DoAndReturn(f func(context.Context, *secrets.URI, secret.AccessRecord) error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReencryptSecretContent mocks base method.
+func (m *MockState) ReencryptSecretContent(arg0 context.Context, arg1 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptSecretContent", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReencryptSecretContent indicates an expected call of ReencryptSecretContent.
+func (mr *MockStateMockRecorder) ReencryptSecretContent(arg0, arg1 any) *MockStateReencryptSecretContentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptSecretContent", reflect.TypeOf((*MockState)(nil).ReencryptSecretContent), arg0, arg1)
+	return &MockStateReencryptSecretContentCall{Call: call}
+}
+
+// MockStateReencryptSecretContentCall wrap *gomock.Call
+type MockStateReencryptSecretContentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateReencryptSecretContentCall) Return(arg0 int, arg1 error) *MockStateReencryptSecretContentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateReencryptSecretContentCall) Do(f func(context.Context, int) (int, error)) *MockStateReencryptSecretContentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateReencryptSecretContentCall) DoAndReturn(f func(context.Context, int) (int, error)) *MockStateReencryptSecretContentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RevokeAccess mocks base method.
 func (m *MockState) RevokeAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessParams) error {
 	m.ctrl.T.Helper()
@@ -2068,6 +2341,45 @@ func (c *MockStateRevokeAccessCall) DoAndReturn(f func(context.Context, *secrets
 	return c
 }
 
+// RotateSecretContentKey mocks base method.
+func (m *MockState) RotateSecretContentKey(arg0 context.Context, arg1 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecretContentKey", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSecretContentKey indicates an expected call of RotateSecretContentKey.
+func (mr *MockStateMockRecorder) RotateSecretContentKey(arg0, arg1 any) *MockStateRotateSecretContentKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecretContentKey", reflect.TypeOf((*MockState)(nil).RotateSecretContentKey), arg0, arg1)
+	return &MockStateRotateSecretContentKeyCall{Call: call}
+}
+
+// MockStateRotateSecretContentKeyCall wrap *gomock.Call
+type MockStateRotateSecretContentKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRotateSecretContentKeyCall) Return(arg0 bool, arg1 error) *MockStateRotateSecretContentKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRotateSecretContentKeyCall) Do(f func(context.Context, time.Time) (bool, error)) *MockStateRotateSecretContentKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRotateSecretContentKeyCall) DoAndReturn(f func(context.Context, time.Time) (bool, error)) *MockStateRotateSecretContentKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RunAtomic mocks base method.
 func (m *MockState) RunAtomic(arg0 context.Context, arg1 func(domain.AtomicContext) error) error {
 	m.ctrl.T.Helper()
@@ -2220,6 +2532,44 @@ func (c *MockStateSecretRotatedCall) DoAndReturn(f func(context.Context, *secret
 	return c
 }
 
+// UnpinSecretRevision mocks base method.
+func (m *MockState) UnpinSecretRevision(arg0 context.Context, arg1 *secrets.URI, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinSecretRevision", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinSecretRevision indicates an expected call of UnpinSecretRevision.
+func (mr *MockStateMockRecorder) UnpinSecretRevision(arg0, arg1, arg2 any) *MockStateUnpinSecretRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinSecretRevision", reflect.TypeOf((*MockState)(nil).UnpinSecretRevision), arg0, arg1, arg2)
+	return &MockStateUnpinSecretRevisionCall{Call: call}
+}
+
+// MockStateUnpinSecretRevisionCall wrap *gomock.Call
+type MockStateUnpinSecretRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUnpinSecretRevisionCall) Return(arg0 error) *MockStateUnpinSecretRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUnpinSecretRevisionCall) Do(f func(context.Context, *secrets.URI, string) error) *MockStateUnpinSecretRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUnpinSecretRevisionCall) DoAndReturn(f func(context.Context, *secrets.URI, string) error) *MockStateUnpinSecretRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateRemoteSecretRevision mocks base method.
 func (m *MockState) UpdateRemoteSecretRevision(arg0 context.Context, arg1 *secrets.URI, arg2 int) error {
 	m.ctrl.T.Helper()
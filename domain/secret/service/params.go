@@ -21,6 +21,9 @@ type CreateCharmSecretParams struct {
 	UpdateCharmSecretParams
 	Version int
 
+	// Type describes the structure of the secret content.
+	Type secrets.SecretType
+
 	CharmOwner CharmSecretOwner
 }
 
@@ -36,12 +39,27 @@ type UpdateCharmSecretParams struct {
 	Data         secrets.SecretData
 	ValueRef     *secrets.ValueRef
 	Checksum     string
+
+	// StageTimeout is set if new content is to be staged, and is
+	// how long consumers have to track the new revision before
+	// the rotation expires.
+	StageTimeout *time.Duration
+	// RollbackOnTimeout is set if the previous revision is to be
+	// restored when a staged rotation expires.
+	RollbackOnTimeout bool
+
+	// RollbackRevision is set to restore an existing
+	// revision as the latest revision of the secret.
+	RollbackRevision *int
 }
 
 // CreateUserSecretParams are used to create a user secret.
 type CreateUserSecretParams struct {
 	UpdateUserSecretParams
 	Version int
+
+	// Type describes the structure of the secret content.
+	Type secrets.SecretType
 }
 
 // UpdateUserSecretParams are used to update a user secret.
@@ -54,6 +72,10 @@ type UpdateUserSecretParams struct {
 	Data        secrets.SecretData
 	Checksum    string
 	AutoPrune   *bool
+
+	// RollbackRevision is set to restore an existing
+	// revision as the latest revision of the secret.
+	RollbackRevision *int
 }
 
 // DeleteSecretParams are used to delete a secret.
@@ -97,6 +119,7 @@ const (
 	RemoteApplicationAccessor SecretAccessorKind = "remote-application"
 	UnitAccessor              SecretAccessorKind = "unit"
 	ModelAccessor             SecretAccessorKind = "model"
+	UserAccessor              SecretAccessorKind = "user"
 )
 
 // GrantedSecretsGetter returns the revisions on the given backend for which
@@ -111,6 +134,13 @@ type SecretAccessor struct {
 	ID   string
 }
 
+// SecretAccessRecord records a read of secret content.
+type SecretAccessRecord struct {
+	Revision   int
+	Accessor   SecretAccessor
+	AccessTime time.Time
+}
+
 // SecretAccessScopeKind represents the kind of an access scope for a secret permission.
 type SecretAccessScopeKind string
 
@@ -0,0 +1,103 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	jujuerrors "github.com/juju/errors"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+)
+
+// validateRollbackRevision checks that a rollback revision, if any,
+// is not combined with new or staged content.
+func validateRollbackRevision(revision *int, hasContent, staged bool) error {
+	if revision == nil {
+		return nil
+	}
+	if *revision <= 0 {
+		return jujuerrors.NotValidf("rollback revision %d", *revision)
+	}
+	if hasContent || staged {
+		return jujuerrors.NotValidf("rolling back a secret while also updating its content")
+	}
+	return nil
+}
+
+// addRestoredBackendReference adds a secret backend reference for the
+// latest revision of a secret once it has been restored from an earlier
+// revision whose content is stored in a backend, since both revisions
+// then refer to the same content. Failures are logged since the revision
+// has already been restored.
+func (s *SecretService) addRestoredBackendReference(ctx context.Context, uri *secrets.URI) {
+	err := func() error {
+		latest, err := s.secretState.GetLatestRevision(ctx, uri)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		_, ref, err := s.secretState.GetSecretValue(ctx, uri, latest)
+		if err != nil || ref == nil {
+			return errors.Capture(err)
+		}
+		revisionID, err := s.secretState.GetSecretRevisionID(ctx, uri, latest)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		modelID, err := s.secretState.GetModelUUID(ctx)
+		if err != nil {
+			return errors.Errorf("getting model uuid: %w", err)
+		}
+		_, err = s.secretBackendState.AddSecretBackendReference(ctx, ref, coremodel.UUID(modelID), revisionID)
+		return errors.Capture(err)
+	}()
+	if err != nil {
+		s.logger.Warningf(ctx, "failed to add secret backend reference for restored revision of secret %q: %v", uri.ID, err)
+	}
+}
+
+// IsSharedSecretValueRef returns true if the content stored in a backend
+// under the specified value reference belongs to more than one secret
+// revision, as it does once a revision is restored by a rollback. Such
+// content must not be deleted when just one of the revisions is removed.
+func (s *SecretService) IsSharedSecretValueRef(ctx context.Context, ref *secrets.ValueRef) (bool, error) {
+	n, err := s.secretState.CountSecretValueRefRevisions(ctx, ref)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	return n > 1, nil
+}
+
+// PinSecretRevision pins the units of the specified application to the
+// specified revision of a secret, so that they keep using it even when
+// later revisions are created.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// does not exist, [secreterrors.SecretRevisionNotFound] if the revision does
+// not exist, [secreterrors.SecretRevisionObsolete] if the revision is
+// obsolete, or [applicationerrors.ApplicationNotFound] if the application
+// does not exist.
+func (s *SecretService) PinSecretRevision(ctx context.Context, uri *secrets.URI, appName string, revision int) error {
+	if revision <= 0 {
+		return jujuerrors.NotValidf("secret revision %d", revision)
+	}
+	if err := s.secretState.PinSecretRevision(ctx, uri, appName, revision); err != nil {
+		return errors.Errorf("pinning %q to revision %d of secret %q: %w", appName, revision, uri.ID, err)
+	}
+	return nil
+}
+
+// UnpinSecretRevision removes any pin of the units of the specified
+// application to a revision of a secret, so that they track the latest
+// revision again.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// does not exist, or [applicationerrors.ApplicationNotFound] if the
+// application does not exist.
+func (s *SecretService) UnpinSecretRevision(ctx context.Context, uri *secrets.URI, appName string) error {
+	if err := s.secretState.UnpinSecretRevision(ctx, uri, appName); err != nil {
+		return errors.Errorf("unpinning %q from secret %q: %w", appName, uri.ID, err)
+	}
+	return nil
+}
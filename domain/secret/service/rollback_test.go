@@ -0,0 +1,141 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	domaintesting "github.com/juju/juju/domain/testing"
+)
+
+func (s *serviceSuite) TestUpdateUserSecretRollback(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     "model-uuid",
+	}).Return("manage", nil)
+	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, domainsecret.UpsertSecretParams{
+		RollbackRevision: ptr(1),
+	}).Return(nil)
+	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(3, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 3).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+
+	err := s.service.UpdateUserSecret(context.Background(), uri, UpdateUserSecretParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   "model-uuid",
+		},
+		RollbackRevision: ptr(1),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestUpdateCharmSecretRollback(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, domainsecret.UpsertSecretParams{
+		RotatePolicy:     ptr(domainsecret.RotateNever),
+		RollbackRevision: ptr(1),
+	}).Return(secreterrors.SecretRevisionObsolete)
+
+	err := s.service.UpdateCharmSecret(context.Background(), uri, UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		RollbackRevision: ptr(1),
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionObsolete)
+}
+
+func (s *serviceSuite) TestUpdateCharmSecretRollbackWithContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.UpdateCharmSecret(context.Background(), coresecrets.NewURI(), UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data:             map[string]string{"foo": "bar"},
+		RollbackRevision: ptr(1),
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+
+	err = s.service.UpdateCharmSecret(context.Background(), coresecrets.NewURI(), UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		StageTimeout:     ptr(time.Minute),
+		RollbackRevision: ptr(1),
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestUpdateUserSecretRollbackInvalidRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.UpdateUserSecret(context.Background(), coresecrets.NewURI(), UpdateUserSecretParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   "model-uuid",
+		},
+		RollbackRevision: ptr(0),
+	})
+	c.Assert(err, gc.ErrorMatches, `rollback revision 0 not valid`)
+}
+
+func (s *serviceSuite) TestPinSecretRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().PinSecretRevision(gomock.Any(), uri, "mysql", 2).Return(nil)
+
+	err := s.service.PinSecretRevision(context.Background(), uri, "mysql", 2)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestPinSecretRevisionApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().PinSecretRevision(gomock.Any(), uri, "mysql", 2).Return(applicationerrors.ApplicationNotFound)
+
+	err := s.service.PinSecretRevision(context.Background(), uri, "mysql", 2)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *serviceSuite) TestPinSecretRevisionInvalidRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.PinSecretRevision(context.Background(), coresecrets.NewURI(), "mysql", 0)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestUnpinSecretRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().UnpinSecretRevision(gomock.Any(), uri, "mysql").Return(nil)
+
+	err := s.service.UnpinSecretRevision(context.Background(), uri, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	jujuerrors "github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+)
+
+// validateTypedContent checks that the content of a secret is valid for the
+// secret type, returning an error satisfying [jujuerrors.NotValid] if not.
+// It returns the expire time to use for the new revision: for a tls secret,
+// this is when the certificate expires, unless an earlier expire time is
+// specified.
+//
+// Content saved by a unit agent to an external backend only reaches the
+// controller as a value reference, with no data. It can't be validated
+// here, and the expire time is taken as given; the agent validates the
+// content and sets the expire time of a tls secret before saving it.
+func (s *SecretService) validateTypedContent(
+	secretType secrets.SecretType, data secrets.SecretData, expireTime *time.Time,
+) (*time.Time, error) {
+	if secretType != "" && !secretType.IsValid() {
+		return nil, jujuerrors.NotValidf("secret type %q", secretType)
+	}
+	if len(data) == 0 {
+		return expireTime, nil
+	}
+	certExpireTime, err := secretType.ValidateContent(data, s.clock.Now())
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if certExpireTime == nil || (expireTime != nil && expireTime.Before(*certExpireTime)) {
+		return expireTime, nil
+	}
+	return certExpireTime, nil
+}
+
+// validateUpdatedContent checks that any new content of the specified secret
+// is valid for the type of the secret, and returns the expire time to use
+// for the new revision.
+func (s *SecretService) validateUpdatedContent(
+	ctx context.Context, uri *secrets.URI, data secrets.SecretData, expireTime *time.Time,
+) (*time.Time, error) {
+	if len(data) == 0 {
+		return expireTime, nil
+	}
+	secretType, err := s.secretState.GetSecretType(ctx, uri)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return s.validateTypedContent(secretType, data, expireTime)
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	domaintesting "github.com/juju/juju/domain/testing"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+func tlsSecretData(c *gc.C, notAfter time.Time) coresecrets.SecretData {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	c.Assert(err, jc.ErrorIsNil)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "juju"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	c.Assert(err, jc.ErrorIsNil)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	return coresecrets.SecretData{
+		"certificate": base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"private-key": base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+func (s *serviceSuite) TestValidateTypedContentTLS(c *gc.C) {
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	data := tlsSecretData(c, notAfter)
+
+	expireTime, err := s.service.validateTypedContent(coresecrets.TypeTLS, data, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expireTime, gc.NotNil)
+	c.Assert(expireTime.Equal(notAfter), jc.IsTrue)
+
+	// An earlier expire time is kept.
+	earlier := notAfter.Add(-time.Minute)
+	expireTime, err = s.service.validateTypedContent(coresecrets.TypeTLS, data, &earlier)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expireTime, gc.Equals, &earlier)
+
+	// A later expire time is capped at the certificate expiry.
+	later := notAfter.Add(time.Minute)
+	expireTime, err = s.service.validateTypedContent(coresecrets.TypeTLS, data, &later)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expireTime.Equal(notAfter), jc.IsTrue)
+}
+
+func (s *serviceSuite) TestValidateTypedContentInvalidType(c *gc.C) {
+	_, err := s.service.validateTypedContent("foo", coresecrets.SecretData{"foo": "YmFy"}, nil)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `secret type "foo" not valid`)
+}
+
+func (s *serviceSuite) TestCreateUserSecretTLS(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	data := tlsSecretData(c, notAfter)
+	uri := coresecrets.NewURI()
+
+	s.secretsBackendProvider.EXPECT().Type().Return("active-type").AnyTimes()
+	s.secretsBackendProvider.EXPECT().NewBackend(ptr(backendConfigs.Configs["backend-id"])).DoAndReturn(
+		func(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+			return s.secretsBackend, nil
+		},
+	)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, coresecrets.NewSecretValue(data)).
+		Return("", errors.NotSupportedf("not supported"))
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(
+		func() error { return nil }, nil,
+	)
+	s.state.EXPECT().CreateUserSecret(domaintesting.IsAtomicContextChecker, 1, uri, domainsecret.UpsertSecretParams{
+		Data:       data,
+		Checksum:   "checksum-1234",
+		RevisionID: ptr(s.fakeUUID.String()),
+		Type:       ptr(domainsecret.TypeTLS),
+		ExpireTime: &notAfter,
+	}).Return(nil)
+
+	err := s.service.CreateUserSecret(context.Background(), uri, CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Data:     data,
+			Checksum: "checksum-1234",
+		},
+		Version: 1,
+		Type:    coresecrets.TypeTLS,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCreateUserSecretInvalidContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateUserSecret(context.Background(), coresecrets.NewURI(), CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Data: coresecrets.SecretData{"username": "YWRtaW4="},
+		},
+		Version: 1,
+		Type:    coresecrets.TypePassword,
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `password secret missing "password"`)
+}
+
+func (s *serviceSuite) TestCreateCharmSecretInvalidType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateCharmSecret(context.Background(), coresecrets.NewURI(), CreateCharmSecretParams{
+		UpdateCharmSecretParams: UpdateCharmSecretParams{
+			Data: coresecrets.SecretData{"foo": "YmFy"},
+		},
+		Version: 1,
+		Type:    "foo",
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestUpdateCharmSecretInvalidTypedContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeDockerRegistry, nil)
+
+	err := s.service.UpdateCharmSecret(context.Background(), uri, UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data: coresecrets.SecretData{"username": "YWRtaW4=", "password": "czNjcmV0"},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `docker-registry secret missing "registry"`)
+}
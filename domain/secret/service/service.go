@@ -72,6 +72,7 @@ type SecretService struct {
 
 	activeBackendID string
 	backends        map[string]provider.SecretsBackend
+	controllerOnly  map[string]bool
 	uuidGenerator   func() (uuid.UUID, error)
 
 	leaderEnsurer leadership.Ensurer
@@ -141,11 +142,18 @@ func (s *SecretService) loadBackendInfo(ctx context.Context, activeOnly bool) er
 	}
 
 	s.backends = make(map[string]provider.SecretsBackend)
+	s.controllerOnly = make(map[string]bool)
 	for _, b := range backends {
 		if activeOnly && b.ID != s.activeBackendID {
 			continue
 		}
 
+		p, err := s.providerGetter(b.BackendType)
+		if err != nil {
+			return errors.Errorf("getting secret backend provider %s: %w", b.ID, err)
+		}
+		s.controllerOnly[b.ID] = provider.HasControllerOnlyAccess(p)
+
 		cfg := provider.ModelBackendConfig{
 			ControllerUUID: modelBackend.ControllerUUID,
 			ModelUUID:      mUUID,
@@ -176,6 +184,75 @@ func (s *SecretService) loadBackendInfo(ctx context.Context, activeOnly bool) er
 	return nil
 }
 
+// controllerOnlyBackend returns the specified backend if its content is
+// only accessed by the controller, or nil otherwise.
+func (s *SecretService) controllerOnlyBackend(ctx context.Context, backendID string) (provider.SecretsBackend, error) {
+	if _, ok := s.controllerOnly[backendID]; !ok {
+		if err := s.loadBackendInfo(ctx, false); err != nil {
+			return nil, jujuerrors.Trace(err)
+		}
+	}
+	if !s.controllerOnly[backendID] {
+		return nil, nil
+	}
+	backend, ok := s.backends[backendID]
+	if !ok {
+		return nil, fmt.Errorf("secret backend %q%w", backendID, jujuerrors.Hide(backenderrors.NotFound))
+	}
+	return backend, nil
+}
+
+// saveContentForAgent saves content sent to the controller by an agent
+// to the model's active backend if the backend is only accessed by the
+// controller, returning a reference to the saved content. A nil reference
+// is returned if the content is to be stored in the database.
+func (s *SecretService) saveContentForAgent(
+	ctx context.Context, uri *secrets.URI, revision func() (int, error), data secrets.SecretData,
+) (*secrets.ValueRef, error) {
+	if s.backends == nil {
+		if err := s.loadBackendInfo(ctx, false); err != nil {
+			return nil, jujuerrors.Trace(err)
+		}
+	}
+	backendID := s.activeBackendID
+	if !s.controllerOnly[backendID] {
+		return nil, nil
+	}
+	rev, err := revision()
+	if err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+	revId, err := s.backends[backendID].SaveContent(ctx, uri, rev, secrets.NewSecretValue(data))
+	if err != nil {
+		return nil, jujuerrors.Annotatef(err, "saving secret content to backend")
+	}
+	return &secrets.ValueRef{
+		BackendID:  backendID,
+		RevisionID: revId,
+	}, nil
+}
+
+// deleteContentForAgent deletes content saved to a backend which is only
+// accessed by the controller. Failures are logged since the secret
+// metadata has already been updated.
+func (s *SecretService) deleteContentForAgent(ctx context.Context, ref *secrets.ValueRef) {
+	if ref == nil {
+		return
+	}
+	backend, err := s.controllerOnlyBackend(ctx, ref.BackendID)
+	if err == nil && backend != nil {
+		// The content may still belong to a revision restored
+		// from the revision which no longer needs it.
+		var n int
+		if n, err = s.secretState.CountSecretValueRefRevisions(ctx, ref); err == nil && n == 0 {
+			err = backend.DeleteContent(ctx, ref.RevisionID)
+		}
+	}
+	if err != nil && !errors.Is(err, secreterrors.SecretRevisionNotFound) {
+		s.logger.Warningf(ctx, "failed to delete secret content %q from backend %q: %v", ref.RevisionID, ref.BackendID, err)
+	}
+}
+
 // CreateUserSecret creates a user secret with the specified parameters, returning an error
 // satisfying [secreterrors.SecretLabelAlreadyExists] if the secret owner already has
 // a secret with the same label.
@@ -183,12 +260,18 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 	if len(params.Data) == 0 {
 		return jujuerrors.NotValidf("empty secret value")
 	}
+	expireTime, err := s.validateTypedContent(params.Type, params.Data, nil)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
 
 	p := domainsecret.UpsertSecretParams{
 		Description: params.Description,
 		Label:       params.Label,
 		AutoPrune:   params.AutoPrune,
 		Checksum:    params.Checksum,
+		Type:        ptr(domainsecret.MarshallSecretType(params.Type)),
+		ExpireTime:  expireTime,
 	}
 	// Take a copy as we may set it to nil below
 	// if the content is saved to a backend.
@@ -265,12 +348,17 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 	if len(params.Data) > 0 && params.ValueRef != nil {
 		return jujuerrors.New("must specify either content or a value reference but not both")
 	}
+	expireTime, err := s.validateTypedContent(params.Type, params.Data, params.ExpireTime)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
 
 	p := domainsecret.UpsertSecretParams{
 		Description: params.Description,
 		Label:       params.Label,
 		ValueRef:    params.ValueRef,
 		Checksum:    params.Checksum,
+		Type:        ptr(domainsecret.MarshallSecretType(params.Type)),
 	}
 	if len(params.Data) > 0 {
 		p.Data = make(map[string]string)
@@ -284,7 +372,23 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 	if params.RotatePolicy.WillRotate() {
 		p.NextRotateTime = params.RotatePolicy.NextRotateTime(s.clock.Now())
 	}
-	p.ExpireTime = params.ExpireTime
+	p.ExpireTime = expireTime
+
+	if len(p.Data) > 0 {
+		ref, err := s.saveContentForAgent(ctx, uri, func() (int, error) { return 1, nil }, p.Data)
+		if err != nil {
+			return jujuerrors.Trace(err)
+		}
+		if ref != nil {
+			defer func() {
+				if errOut != nil {
+					s.deleteContentForAgent(ctx, ref)
+				}
+			}()
+			p.Data = nil
+			p.ValueRef = ref
+		}
+	}
 
 	revisionID, err := s.uuidGenerator()
 	if err != nil {
@@ -335,17 +439,30 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 // It also returns an error satisfying [secreterrors.SecretLabelAlreadyExists] if
 // the secret owner already has a secret with the same label.
 // It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+// If a rollback revision is specified, it returns an error satisfying
+// [secreterrors.SecretRevisionNotFound] or [secreterrors.SecretRevisionObsolete]
+// if that revision cannot be restored.
 func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI, params UpdateUserSecretParams) error {
+	if err := validateRollbackRevision(params.RollbackRevision, len(params.Data) > 0, false); err != nil {
+		return errors.Capture(err)
+	}
+
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Capture(err)
 	}
+	expireTime, err := s.validateUpdatedContent(ctx, uri, params.Data, nil)
+	if err != nil {
+		return errors.Capture(err)
+	}
 
 	p := domainsecret.UpsertSecretParams{
-		Description: params.Description,
-		Label:       params.Label,
-		AutoPrune:   params.AutoPrune,
-		Checksum:    params.Checksum,
+		Description:      params.Description,
+		Label:            params.Label,
+		AutoPrune:        params.AutoPrune,
+		Checksum:         params.Checksum,
+		ExpireTime:       expireTime,
+		RollbackRevision: params.RollbackRevision,
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
@@ -424,6 +541,9 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 		if err != nil {
 			return errors.Errorf("updating user secret %q: %w", uri.ID, err)
 		}
+		if p.RollbackRevision != nil {
+			s.addRestoredBackendReference(innerCtx, uri)
+		}
 		return nil
 	})
 }
@@ -432,23 +552,48 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 // satisfying [secreterrors.SecretNotFound] if the secret does not exist.
 // It also returns an error satisfying [secreterrors.SecretLabelAlreadyExists] if
 // the secret owner already has a secret with the same label.
-// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor,
+// and [secreterrors.SecretRevisionStagePending] if new content or a rollback is specified while
+// a staged rotation of the secret is pending.
 func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI, params UpdateCharmSecretParams) error {
 	if len(params.Data) > 0 && params.ValueRef != nil {
 		return jujuerrors.New("must specify either content or a value reference but not both")
 	}
+	hasContent := len(params.Data) > 0 || params.ValueRef != nil
+	if err := validateRollbackRevision(params.RollbackRevision, hasContent, params.StageTimeout != nil); err != nil {
+		return errors.Capture(err)
+	}
+	if params.StageTimeout != nil {
+		if len(params.Data) == 0 && params.ValueRef == nil {
+			return jujuerrors.NotValidf("staging a secret rotation without new content")
+		}
+		if *params.StageTimeout <= 0 {
+			return jujuerrors.NotValidf("stage timeout %v", *params.StageTimeout)
+		}
+	} else if params.RollbackOnTimeout {
+		return jujuerrors.NotValidf("rolling back on timeout without staging a secret rotation")
+	}
 
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Capture(err)
 	}
+	expireTime, err := s.validateUpdatedContent(ctx, uri, params.Data, params.ExpireTime)
+	if err != nil {
+		return errors.Capture(err)
+	}
 
 	p := domainsecret.UpsertSecretParams{
-		Description: params.Description,
-		Label:       params.Label,
-		ValueRef:    params.ValueRef,
-		ExpireTime:  params.ExpireTime,
-		Checksum:    params.Checksum,
+		Description:      params.Description,
+		Label:            params.Label,
+		ValueRef:         params.ValueRef,
+		ExpireTime:       expireTime,
+		Checksum:         params.Checksum,
+		RollbackRevision: params.RollbackRevision,
+	}
+	if params.StageTimeout != nil {
+		p.StageDeadline = ptr(s.clock.Now().Add(*params.StageTimeout))
+		p.RollbackOnTimeout = params.RollbackOnTimeout
 	}
 	rotatePolicy := domainsecret.MarshallRotatePolicy(params.RotatePolicy)
 	p.RotatePolicy = &rotatePolicy
@@ -469,6 +614,24 @@ func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI,
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
+		if len(p.Data) > 0 {
+			ref, err := s.saveContentForAgent(innerCtx, uri, func() (int, error) {
+				latestRevision, err := s.secretState.GetLatestRevision(innerCtx, uri)
+				return latestRevision + 1, err
+			}, p.Data)
+			if err != nil {
+				return errors.Capture(err)
+			}
+			if ref != nil {
+				defer func() {
+					if errOut != nil {
+						s.deleteContentForAgent(innerCtx, ref)
+					}
+				}()
+				p.Data = nil
+				p.ValueRef = ref
+			}
+		}
 		if p.ValueRef != nil || len(p.Data) != 0 {
 			revisionID, err := s.uuidGenerator()
 			if err != nil {
@@ -502,6 +665,9 @@ func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI,
 		if err != nil {
 			return jujuerrors.Annotatef(err, "cannot update charm secret %q", uri.ID)
 		}
+		if p.RollbackRevision != nil {
+			s.addRestoredBackendReference(innerCtx, uri)
+		}
 		return nil
 	})
 }
@@ -659,19 +825,58 @@ func (s *SecretService) ListUserSecretsToDrain(ctx context.Context) ([]*secrets.
 }
 
 // GetSecretValue returns the value of the specified secret revision.
+// The read is recorded in the secret's access log, and if it can't be
+// recorded the read fails, so that no content is returned without a
+// record of it. Recording costs a database write per read, except that
+// repeated reads of a revision by the same accessor within a minute are
+// only recorded once.
 // If returns [secreterrors.SecretRevisionNotFound] is there's no such secret revision.
 func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, rev int, accessor SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
 	if err := s.canRead(ctx, uri, accessor); err != nil {
 		return nil, nil, jujuerrors.Trace(err)
 	}
 	data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-	return secrets.NewSecretValue(data), ref, jujuerrors.Trace(err)
+	if err != nil {
+		return nil, nil, jujuerrors.Trace(err)
+	}
+	val := secrets.NewSecretValue(data)
+	if ref != nil {
+		// Content in a backend which is only accessed by the controller
+		// is read here and returned in place of the reference.
+		backend, err := s.controllerOnlyBackend(ctx, ref.BackendID)
+		if err != nil {
+			return nil, nil, jujuerrors.Trace(err)
+		}
+		if backend != nil {
+			if val, err = backend.GetContent(ctx, ref.RevisionID); err != nil {
+				return nil, nil, jujuerrors.Trace(err)
+			}
+			ref = nil
+		}
+	}
+	if err := s.recordSecretAccess(ctx, uri, rev, accessor); err != nil {
+		return nil, nil, jujuerrors.Trace(err)
+	}
+	return val, ref, nil
 }
 
-// GetSecretContentFromBackend retrieves the content for the specified secret revision.
+// GetSecretContentFromBackend retrieves the content for the specified secret revision,
+// and records the read by accessor in the secret's access log. As with
+// [SecretService.GetSecretValue], the read fails if it can't be recorded.
 // If the content is not found, it may be that the secret has been drained so it tries
 // again using the new active backend.
-func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
+func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int, accessor SecretAccessor) (secrets.SecretValue, error) {
+	val, err := s.getSecretContentFromBackend(ctx, uri, rev)
+	if err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+	if err := s.recordSecretAccess(ctx, uri, rev, accessor); err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+	return val, nil
+}
+
+func (s *SecretService) getSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
 	if s.activeBackendID == "" {
 		err := s.loadBackendInfo(ctx, false)
 		if err != nil {
@@ -881,6 +1086,26 @@ func (s *SecretService) ChangeSecretBackend(
 	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
+		_, oldRef, err := s.secretState.GetSecretValue(innerCtx, uri, revision)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if len(params.Data) > 0 {
+			ref, err := s.saveContentForAgent(innerCtx, uri, func() (int, error) { return revision, nil }, params.Data)
+			if err != nil {
+				return errors.Capture(err)
+			}
+			if ref != nil {
+				defer func() {
+					if errOut != nil {
+						s.deleteContentForAgent(innerCtx, ref)
+					}
+				}()
+				params.Data = nil
+				params.ValueRef = ref
+			}
+		}
+
 		rollBack, err := s.secretBackendState.UpdateSecretBackendReference(
 			innerCtx, params.ValueRef, coremodel.UUID(modelID), revisionID.String())
 		if err != nil {
@@ -899,6 +1124,11 @@ func (s *SecretService) ChangeSecretBackend(
 		if err != nil {
 			return errors.Capture(err)
 		}
+		// Agents can't delete the drained content from a backend
+		// which is only accessed by the controller.
+		if oldRef != nil && (params.ValueRef == nil || *oldRef != *params.ValueRef) {
+			s.deleteContentForAgent(innerCtx, oldRef)
+		}
 		return nil
 	})
 }
@@ -110,6 +110,16 @@ func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 		uuidGenerator:          func() (uuid.UUID, error) { return s.fakeUUID, nil },
 		clock:                  s.clock,
 		logger:                 loggertesting.WrapCheckLog(c),
+
+		activeBackendID: "backend-id",
+		backends: map[string]provider.SecretsBackend{
+			"backend-id":       s.secretsBackend,
+			"other-backend-id": s.secretsBackend,
+		},
+		controllerOnly: map[string]bool{
+			"backend-id":       false,
+			"other-backend-id": false,
+		},
 	}
 	return ctrl
 }
@@ -150,6 +160,7 @@ func (s *serviceSuite) assertCreateUserSecret(c *gc.C, isInternal, finalStepFail
 		AutoPrune:   ptr(true),
 		Checksum:    "checksum-1234",
 		RevisionID:  ptr(s.fakeUUID.String()),
+		Type:        ptr(domainsecret.TypeGeneric),
 	}
 	if isInternal {
 		params.Data = map[string]string{"foo": "bar"}
@@ -275,6 +286,7 @@ func (s *serviceSuite) assertUpdateUserSecret(c *gc.C, isInternal, finalStepFail
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeGeneric, nil)
 	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(2, nil)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
 	rollbackCalled := false
@@ -333,6 +345,7 @@ func (s *serviceSuite) TestCreateCharmUnitSecret(c *gc.C) {
 		ExpireTime:     ptr(exipreTime),
 		NextRotateTime: ptr(rotateTime),
 		RevisionID:     ptr(s.fakeUUID.String()),
+		Type:           ptr(domainsecret.TypeGeneric),
 	}
 	unitUUID, err := coreunit.NewUUID()
 	c.Assert(err, jc.ErrorIsNil)
@@ -435,6 +448,7 @@ func (s *serviceSuite) TestCreateCharmApplicationSecret(c *gc.C) {
 		ExpireTime:     ptr(exipreTime),
 		NextRotateTime: ptr(rotateTime),
 		RevisionID:     ptr(s.fakeUUID.String()),
+		Type:           ptr(domainsecret.TypeGeneric),
 	}
 
 	appUUID, err := coreapplication.NewID()
@@ -550,6 +564,7 @@ func (s *serviceSuite) TestUpdateCharmSecretNoRotate(c *gc.C) {
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeGeneric, nil)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
 	rollbackCalled := false
 	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(func() error {
@@ -597,6 +612,7 @@ func (s *serviceSuite) TestUpdateCharmSecretForUnitOwned(c *gc.C) {
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeGeneric, nil)
 	s.state.EXPECT().GetRotatePolicy(gomock.Any(), uri).Return(
 		coresecrets.RotateNever, // No rotate policy.
 		nil)
@@ -647,6 +663,7 @@ func (s *serviceSuite) TestUpdateCharmSecretForUnitOwnedFailedLabelExists(c *gc.
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeGeneric, nil)
 	s.state.EXPECT().GetRotatePolicy(gomock.Any(), uri).Return(
 		coresecrets.RotateNever, // No rotate policy.
 		nil)
@@ -698,6 +715,7 @@ func (s *serviceSuite) TestUpdateCharmSecretForAppOwned(c *gc.C) {
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeGeneric, nil)
 	s.state.EXPECT().GetRotatePolicy(gomock.Any(), uri).Return(
 		coresecrets.RotateNever, // No rotate policy.
 		nil)
@@ -748,6 +766,7 @@ func (s *serviceSuite) TestUpdateCharmSecretForAppOwnedFailedLabelExists(c *gc.C
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeGeneric, nil)
 	s.state.EXPECT().GetRotatePolicy(gomock.Any(), uri).Return(
 		coresecrets.RotateNever, // No rotate policy.
 		nil)
@@ -803,6 +822,44 @@ func (s *serviceSuite) TestGetSecretValue(c *gc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, domainsecret.AccessRecord{
+		Revision:     666,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mariadb/0",
+		AccessTime:   s.clock.Now().UTC(),
+	}).Return(nil)
+
+	data, ref, err := s.service.GetSecretValue(context.Background(), uri, 666, SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ref, gc.IsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
+}
+
+func (s *serviceSuite) TestGetSecretValueControllerOnlyBackend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.controllerOnly["backend-id"] = true
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(nil, &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	}, nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "rev-id").Return(
+		coresecrets.NewSecretValue(map[string]string{"foo": "bar"}), nil)
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, domainsecret.AccessRecord{
+		Revision:     666,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mariadb/0",
+		AccessTime:   s.clock.Now().UTC(),
+	}).Return(nil)
 
 	data, ref, err := s.service.GetSecretValue(context.Background(), uri, 666, SecretAccessor{
 		Kind: UnitAccessor,
@@ -1379,6 +1436,7 @@ func (s *serviceSuite) TestChangeSecretBackendToExternalBackend(c *gc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, nil, nil)
 	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, valueRef, nil).Return(nil)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
 	rollbackCalled := false
@@ -1409,6 +1467,7 @@ func (s *serviceSuite) TestChangeSecretBackendToInternalBackend(c *gc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, nil, nil)
 	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, nil, map[string]string{"foo": "bar"}).Return(nil)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
 	rollbackCalled := false
@@ -1428,6 +1487,80 @@ func (s *serviceSuite) TestChangeSecretBackendToInternalBackend(c *gc.C) {
 	c.Assert(rollbackCalled, jc.IsFalse)
 }
 
+func (s *serviceSuite) TestChangeSecretBackendToControllerOnlyBackend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.controllerOnly["backend-id"] = true
+
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	valueRef := &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	}
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, &coresecrets.ValueRef{
+		BackendID:  "other-backend-id",
+		RevisionID: "old-rev-id",
+	}, nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 1, coresecrets.NewSecretValue(map[string]string{"foo": "bar"})).Return("rev-id", nil)
+	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, valueRef, nil).Return(nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(gomock.Any(), valueRef, s.modelID, s.fakeUUID.String()).Return(func() error {
+		return nil
+	}, nil)
+
+	err := s.service.ChangeSecretBackend(ctx, uri, 1, ChangeSecretBackendParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data: map[string]string{"foo": "bar"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestChangeSecretBackendFromControllerOnlyBackend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	s.service.controllerOnly["other-backend-id"] = true
+
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, &coresecrets.ValueRef{
+		BackendID:  "other-backend-id",
+		RevisionID: "old-rev-id",
+	}, nil)
+	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, nil, map[string]string{"foo": "bar"}).Return(nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().UpdateSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(func() error {
+		return nil
+	}, nil)
+	s.state.EXPECT().CountSecretValueRefRevisions(gomock.Any(), &coresecrets.ValueRef{
+		BackendID:  "other-backend-id",
+		RevisionID: "old-rev-id",
+	}).Return(0, nil)
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "old-rev-id").Return(nil)
+
+	err := s.service.ChangeSecretBackend(ctx, uri, 1, ChangeSecretBackendParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data: map[string]string{"foo": "bar"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestChangeSecretBackendFailedAndRollback(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1439,6 +1572,7 @@ func (s *serviceSuite) TestChangeSecretBackendFailedAndRollback(c *gc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, nil, nil)
 	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, nil, map[string]string{"foo": "bar"}).Return(errors.New("boom"))
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
 	rollbackCalled := false
@@ -1492,6 +1626,7 @@ func (s *serviceSuite) TestChangeSecretBackendFailedSecretNotFound(c *gc.C) {
 		SubjectID:     "mariadb/0",
 	}).Return("manage", nil)
 	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 1).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(nil, nil, nil)
 	s.state.EXPECT().ChangeSecretBackend(gomock.Any(), s.fakeUUID, nil, map[string]string{"foo": "bar"}).Return(secreterrors.SecretNotFound)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
 	rollbackCalled := false
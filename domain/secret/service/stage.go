@@ -0,0 +1,35 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ProcessStagedRevisions resolves pending staged secret rotations. A staged
+// rotation is committed once all consumers track the staged revision, which
+// releases the previous revision to be removed by the owner. If that has not
+// happened by the rotation's deadline, the rotation expires, which releases
+// the previous revision in the same way, unless the owner asked for the
+// previous revision to be restored as the latest revision instead.
+func (s *SecretService) ProcessStagedRevisions(ctx context.Context) error {
+	committed, expired, rolledBack, err := s.secretState.ProcessStagedRevisions(ctx, s.clock.Now().UTC())
+	if err != nil {
+		return errors.Capture(err)
+	}
+	for _, id := range committed {
+		s.logger.Infof(ctx, "committed staged rotation of secret %q", id)
+	}
+	for _, id := range expired {
+		s.logger.Warningf(ctx, "staged rotation of secret %q expired: not all consumers track the staged revision", id)
+	}
+	for _, id := range rolledBack {
+		s.logger.Warningf(ctx, "rolled back staged rotation of secret %q: not all consumers track the staged revision", id)
+		s.addRestoredBackendReference(ctx, &secrets.URI{ID: id})
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	domaintesting "github.com/juju/juju/domain/testing"
+)
+
+func (s *serviceSuite) TestUpdateCharmSecretStaged(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	p := domainsecret.UpsertSecretParams{
+		RotatePolicy:      ptr(domainsecret.RotateNever),
+		Data:              coresecrets.SecretData{"foo": "bar"},
+		Checksum:          "checksum-1234",
+		RevisionID:        ptr(s.fakeUUID.String()),
+		StageDeadline:     ptr(s.clock.Now().Add(30 * time.Minute)),
+		RollbackOnTimeout: true,
+	}
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretType(gomock.Any(), uri).Return(coresecrets.TypeGeneric, nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(func() error {
+		return nil
+	}, nil)
+	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, p).Return(nil)
+	err := s.service.UpdateCharmSecret(context.Background(), uri, UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data:              map[string]string{"foo": "bar"},
+		Checksum:          "checksum-1234",
+		StageTimeout:      ptr(30 * time.Minute),
+		RollbackOnTimeout: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestUpdateCharmSecretRollbackOnTimeoutNotStaged(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.UpdateCharmSecret(context.Background(), coresecrets.NewURI(), UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data:              map[string]string{"foo": "bar"},
+		RollbackOnTimeout: true,
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestUpdateCharmSecretStagedNoContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.UpdateCharmSecret(context.Background(), coresecrets.NewURI(), UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Description:  ptr("a secret"),
+		StageTimeout: ptr(30 * time.Minute),
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestUpdateCharmSecretStagedInvalidTimeout(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.UpdateCharmSecret(context.Background(), coresecrets.NewURI(), UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data:         map[string]string{"foo": "bar"},
+		StageTimeout: ptr(-time.Minute),
+	})
+	c.Assert(err, gc.ErrorMatches, `stage timeout -1m0s not valid`)
+}
+
+func (s *serviceSuite) TestProcessStagedRevisions(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ProcessStagedRevisions(gomock.Any(), s.clock.Now().UTC()).Return([]string{"a"}, []string{"c"}, []string{"b"}, nil)
+	s.state.EXPECT().GetLatestRevision(gomock.Any(), &coresecrets.URI{ID: "b"}).Return(3, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), &coresecrets.URI{ID: "b"}, 3).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+
+	err := s.service.ProcessStagedRevisions(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestProcessStagedRevisionsBackendContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := &coresecrets.URI{ID: "b"}
+	valueRef := &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id"}
+	s.state.EXPECT().ProcessStagedRevisions(gomock.Any(), s.clock.Now().UTC()).Return(nil, nil, []string{"b"}, nil)
+	s.state.EXPECT().GetLatestRevision(gomock.Any(), uri).Return(3, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 3).Return(nil, valueRef, nil)
+	s.state.EXPECT().GetSecretRevisionID(gomock.Any(), uri, 3).Return(s.fakeUUID.String(), nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), valueRef, s.modelID, s.fakeUUID.String()).Return(func() error {
+		return nil
+	}, nil)
+
+	err := s.service.ProcessStagedRevisions(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+}
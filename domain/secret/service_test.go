@@ -26,6 +26,7 @@ import (
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/domain/secret/service"
 	"github.com/juju/juju/domain/secret/state"
+	"github.com/juju/juju/domain/secretbackend"
 	domaintesting "github.com/juju/juju/domain/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/storage"
@@ -103,11 +104,16 @@ func (s *serviceSuite) TestDeleteSecretExternal(c *gc.C) {
 func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.secretBackendState = secret.NewMockSecretBackendState(ctrl)
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), s.modelUUID).Return(secretbackend.ModelSecretBackend{
+		ModelID:         s.modelUUID,
+		SecretBackendID: "backend-id",
+	}, nil).AnyTimes()
+	s.secretBackendState.EXPECT().ListSecretBackendsForModel(gomock.Any(), s.modelUUID, true).Return(nil, nil).AnyTimes()
 
 	s.svc = service.NewSecretService(
 		state.NewState(func() (database.TxnRunner, error) {
 			return s.ModelTxnRunner(c, s.modelUUID.String()), nil
-		}, loggertesting.WrapCheckLog(c)),
+		}, testControllerKeys(c), loggertesting.WrapCheckLog(c)),
 		s.secretBackendState,
 		nil,
 		loggertesting.WrapCheckLog(c),
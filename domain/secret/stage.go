@@ -0,0 +1,36 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import coresecrets "github.com/juju/juju/core/secrets"
+
+// StageStatus represents the status of a staged secret revision
+// as recorded in the secret_revision_stage_status lookup table.
+type StageStatus int
+
+const (
+	StagePending StageStatus = iota
+	StageCommitted
+	StageRolledBack
+	StageExpired
+)
+
+// String implements fmt.Stringer.
+func (s StageStatus) String() string {
+	return string(s.ToCore())
+}
+
+// ToCore converts a db stage status id to a core stage status.
+func (s StageStatus) ToCore() coresecrets.StageStatus {
+	switch s {
+	case StageCommitted:
+		return coresecrets.StageCommitted
+	case StageRolledBack:
+		return coresecrets.StageRolledBack
+	case StageExpired:
+		return coresecrets.StageExpired
+	default:
+		return coresecrets.StagePending
+	}
+}
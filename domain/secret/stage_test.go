@@ -0,0 +1,47 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type stageSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&stageSuite{})
+
+// TestStageStatusDBValues ensures there's no skew between what's in the
+// database table for stage status and the typed consts used in the state packages.
+func (s *stageSuite) TestStageStatusDBValues(c *gc.C) {
+	db := s.DB()
+	rows, err := db.Query("SELECT id, status FROM secret_revision_stage_status")
+	c.Assert(err, jc.ErrorIsNil)
+	defer rows.Close()
+
+	dbValues := make(map[StageStatus]string)
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+		err := rows.Scan(&id, &value)
+		c.Assert(err, jc.ErrorIsNil)
+		dbValues[StageStatus(id)] = value
+	}
+	c.Assert(dbValues, jc.DeepEquals, map[StageStatus]string{
+		StagePending:    "pending",
+		StageCommitted:  "committed",
+		StageRolledBack: "rolled-back",
+		StageExpired:    "expired",
+	})
+	// Also check the core secret enums match.
+	for id, value := range dbValues {
+		c.Assert(id.String(), gc.Equals, value)
+	}
+}
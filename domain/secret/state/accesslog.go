@@ -0,0 +1,166 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// maxAccessLogEntries is the number of access log entries
+// kept for each secret. Older entries are discarded.
+var maxAccessLogEntries = 1000
+
+// accessLogInterval is the period within which repeated reads of a secret
+// revision by the same accessor are only recorded once, so that a secret
+// which is read often doesn't fill its access log or cause a write for
+// every read.
+var accessLogInterval = time.Minute
+
+// RecordSecretAccess records a read of the content of the specified secret,
+// discarding the oldest entries for the secret if there are too many.
+// A read of the same revision by the same accessor as one recorded less
+// than [accessLogInterval] earlier is not recorded again.
+// If the secret does not exist, an error satisfying
+// [secreterrors.SecretNotFound] is returned.
+func (st State) RecordSecretAccess(ctx context.Context, uri *coresecrets.URI, record domainsecret.AccessRecord) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	entry := secretAccessLog{
+		UUID:           id.String(),
+		SecretID:       uri.ID,
+		Revision:       record.Revision,
+		AccessorTypeID: int(record.AccessorType),
+		AccessorID:     record.AccessorID,
+		AccessTime:     record.AccessTime.UTC(),
+	}
+
+	existsStmt, err := st.Prepare(`
+SELECT secret_id AS &secretID.id
+FROM   secret_metadata
+WHERE  secret_id = $secretID.id`, secretID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	lastStmt, err := st.Prepare(`
+SELECT &secretAccessLog.*
+FROM   secret_access_log
+WHERE  secret_id = $secretAccessLog.secret_id
+AND    revision = $secretAccessLog.revision
+AND    accessor_type_id = $secretAccessLog.accessor_type_id
+AND    accessor_id = $secretAccessLog.accessor_id
+ORDER BY access_time DESC
+LIMIT 1`, entry)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_access_log (*)
+VALUES ($secretAccessLog.*)`, entry)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	pruneStmt, err := st.Prepare(`
+DELETE FROM secret_access_log
+WHERE  secret_id = $accessLogLimit.secret_id
+AND    uuid NOT IN (
+    SELECT uuid FROM secret_access_log
+    WHERE  secret_id = $accessLogLimit.secret_id
+    ORDER BY access_time DESC
+    LIMIT $accessLogLimit.max_entries
+)`, accessLogLimit{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		id := secretID{ID: uri.ID}
+		err := tx.Query(ctx, existsStmt, id).Get(&id)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("secret %q not found%w", uri, errors.Hide(secreterrors.SecretNotFound))
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		var last secretAccessLog
+		err = tx.Query(ctx, lastStmt, entry).Get(&last)
+		if err == nil && entry.AccessTime.Sub(last.AccessTime) < accessLogInterval {
+			return nil
+		} else if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Trace(err)
+		}
+		if err := tx.Query(ctx, insertStmt, entry).Run(); err != nil {
+			return errors.Trace(err)
+		}
+		limit := accessLogLimit{SecretID: uri.ID, MaxEntries: maxAccessLogEntries}
+		return errors.Trace(tx.Query(ctx, pruneStmt, limit).Run())
+	})
+	return errors.Annotatef(err, "recording access to secret %q", uri)
+}
+
+// GetSecretAccessLog returns the recorded reads of the content of the
+// specified secret, oldest first.
+// If the secret does not exist, an error satisfying
+// [secreterrors.SecretNotFound] is returned.
+func (st State) GetSecretAccessLog(ctx context.Context, uri *coresecrets.URI) ([]domainsecret.AccessRecord, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	existsStmt, err := st.Prepare(`
+SELECT secret_id AS &secretID.id
+FROM   secret_metadata
+WHERE  secret_id = $secretID.id`, secretID{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &secretAccessLog.*
+FROM   secret_access_log
+WHERE  secret_id = $secretID.id
+ORDER BY access_time, revision`, secretAccessLog{}, secretID{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var rows secretAccessLogs
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		id := secretID{ID: uri.ID}
+		err := tx.Query(ctx, existsStmt, id).Get(&id)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("secret %q not found%w", uri, errors.Hide(secreterrors.SecretNotFound))
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		err = tx.Query(ctx, stmt, id).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "querying access log for secret %q", uri)
+	}
+	return rows.toAccessRecords(), nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+func (s *stateSuite) TestRecordSecretAccess(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	records := []domainsecret.AccessRecord{{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mysql/0",
+		AccessTime:   now.Add(-time.Minute),
+	}, {
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUser,
+		AccessorID:   "admin",
+		AccessTime:   now,
+	}}
+	for _, r := range records {
+		err := st.RecordSecretAccess(context.Background(), uri, r)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	got, err := st.GetSecretAccessLog(context.Background(), uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, gc.HasLen, 2)
+	for i, r := range got {
+		c.Check(r.AccessTime.Equal(records[i].AccessTime), jc.IsTrue)
+		r.AccessTime = records[i].AccessTime
+		c.Check(r, jc.DeepEquals, records[i])
+	}
+}
+
+func (s *stateSuite) TestRecordSecretAccessPrunes(c *gc.C) {
+	s.PatchValue(&maxAccessLogEntries, 2)
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 0; i < 3; i++ {
+		err := st.RecordSecretAccess(context.Background(), uri, domainsecret.AccessRecord{
+			Revision:     1,
+			AccessorType: domainsecret.AccessorUnit,
+			AccessorID:   "mysql/0",
+			AccessTime:   now.Add(time.Duration(i) * time.Minute),
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	got, err := st.GetSecretAccessLog(context.Background(), uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, gc.HasLen, 2)
+	c.Check(got[0].AccessTime.Equal(now.Add(time.Minute)), jc.IsTrue)
+	c.Check(got[1].AccessTime.Equal(now.Add(2*time.Minute)), jc.IsTrue)
+}
+
+func (s *stateSuite) TestRecordSecretAccessCoalescesRepeatedReads(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+
+	now := time.Now().UTC().Truncate(time.Second)
+	record := func(rev int, accessorID string, when time.Time) {
+		err := st.RecordSecretAccess(context.Background(), uri, domainsecret.AccessRecord{
+			Revision:     rev,
+			AccessorType: domainsecret.AccessorUnit,
+			AccessorID:   accessorID,
+			AccessTime:   when,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	record(1, "mysql/0", now)
+	// Repeated reads within the interval aren't recorded.
+	record(1, "mysql/0", now.Add(10*time.Second))
+	record(1, "mysql/0", now.Add(50*time.Second))
+	// Reads of another revision or by another accessor are.
+	record(2, "mysql/0", now.Add(10*time.Second))
+	record(1, "mysql/1", now.Add(20*time.Second))
+	// As are reads once the interval has passed.
+	record(1, "mysql/0", now.Add(time.Minute))
+
+	got, err := st.GetSecretAccessLog(context.Background(), uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, gc.HasLen, 4)
+	c.Check(got[0].AccessTime.Equal(now), jc.IsTrue)
+	c.Check(got[1].Revision, gc.Equals, 2)
+	c.Check(got[2].AccessorID, gc.Equals, "mysql/1")
+	c.Check(got[3].AccessTime.Equal(now.Add(time.Minute)), jc.IsTrue)
+}
+
+func (s *stateSuite) TestRecordSecretAccessNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	err := st.RecordSecretAccess(context.Background(), coresecrets.NewURI(), domainsecret.AccessRecord{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mysql/0",
+		AccessTime:   time.Now(),
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+
+	_, err = st.GetSecretAccessLog(context.Background(), coresecrets.NewURI())
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *stateSuite) TestDeleteSecretDeletesAccessLog(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+
+	err := st.RecordSecretAccess(context.Background(), uri, domainsecret.AccessRecord{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUser,
+		AccessorID:   "admin",
+		AccessTime:   time.Now(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return st.DeleteSecret(ctx, uri, nil)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var count int
+	row := s.DB().QueryRowContext(context.Background(), "SELECT COUNT(*) FROM secret_access_log")
+	err = row.Scan(&count)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 0)
+}
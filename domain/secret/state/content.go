@@ -0,0 +1,363 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/secrets/envelope"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// contentCipher encrypts and decrypts secret content using the
+// model's secret content keys.
+type contentCipher struct {
+	controllerKeys envelope.KeyRing
+	// keys holds the unwrapped content keys, keyed on uuid.
+	keys map[string][]byte
+}
+
+func (st State) newContentCipher() (*contentCipher, error) {
+	if st.controllerKeys.IsZero() {
+		return nil, errors.New("no secret content controller keys")
+	}
+	return &contentCipher{
+		controllerKeys: st.controllerKeys,
+		keys:           make(map[string][]byte),
+	}, nil
+}
+
+// unwrap returns the content key, unwrapping it with the controller key
+// which wrapped it.
+func (c *contentCipher) unwrap(k secretContentKey) ([]byte, error) {
+	if key, ok := c.keys[k.UUID]; ok {
+		return key, nil
+	}
+	controllerKey, ok := c.controllerKeys.Key(k.ControllerKeyID)
+	if !ok {
+		return nil, errors.Errorf("secret content key %q not wrapped with a controller key", k.UUID)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(k.WrappedKey)
+	if err != nil {
+		return nil, errors.Annotatef(err, "decoding secret content key %q", k.UUID)
+	}
+	key, err := envelope.Open(controllerKey, wrapped, []byte(k.UUID))
+	if err != nil {
+		return nil, errors.Annotatef(err, "unwrapping secret content key %q", k.UUID)
+	}
+	c.keys[k.UUID] = key
+	return key, nil
+}
+
+// newKey generates a new content key wrapped with the controller key.
+func (c *contentCipher) newKey(now time.Time) (secretContentKey, error) {
+	keyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return secretContentKey{}, errors.Trace(err)
+	}
+	key, err := envelope.NewKey()
+	if err != nil {
+		return secretContentKey{}, errors.Trace(err)
+	}
+	wrapped, err := envelope.Seal(c.controllerKeys.Active(), key, []byte(keyUUID.String()))
+	if err != nil {
+		return secretContentKey{}, errors.Annotate(err, "wrapping secret content key")
+	}
+	c.keys[keyUUID.String()] = key
+	return secretContentKey{
+		UUID:            keyUUID.String(),
+		WrappedKey:      base64.StdEncoding.EncodeToString(wrapped),
+		ControllerKeyID: envelope.KeyID(c.controllerKeys.Active()),
+		Active:          true,
+		CreateTime:      now,
+	}, nil
+}
+
+// contentAdditionalData binds encrypted content to the revision and name
+// it is stored against, so that it cannot be moved to another row.
+func contentAdditionalData(revisionUUID, name string) []byte {
+	return []byte(revisionUUID + "/" + name)
+}
+
+// encrypt sets the content of the row to the encrypted value,
+// using the specified content key.
+func (c *contentCipher) encrypt(row *secretContent, keyUUID, value string) error {
+	key, ok := c.keys[keyUUID]
+	if !ok {
+		return errors.Errorf("secret content key %q not unwrapped", keyUUID)
+	}
+	sealed, err := envelope.Seal(key, []byte(value), contentAdditionalData(row.RevisionUUID, row.Name))
+	if err != nil {
+		return errors.Annotatef(err, "encrypting secret content %q", row.Name)
+	}
+	row.Content = base64.StdEncoding.EncodeToString(sealed)
+	row.KeyUUID = sql.NullString{String: keyUUID, Valid: true}
+	return nil
+}
+
+// decrypt returns the plaintext content of the row. Content stored before
+// encryption was introduced has no key and is returned as is.
+func (c *contentCipher) decrypt(row secretContent, keys map[string]secretContentKey) (string, error) {
+	if !row.KeyUUID.Valid {
+		return row.Content, nil
+	}
+	k, ok := keys[row.KeyUUID.String]
+	if !ok {
+		return "", errors.NotFoundf("secret content key %q", row.KeyUUID.String)
+	}
+	key, err := c.unwrap(k)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(row.Content)
+	if err != nil {
+		return "", errors.Annotatef(err, "decoding secret content %q", row.Name)
+	}
+	value, err := envelope.Open(key, sealed, contentAdditionalData(row.RevisionUUID, row.Name))
+	if err != nil {
+		return "", errors.Annotatef(err, "decrypting secret content %q", row.Name)
+	}
+	return string(value), nil
+}
+
+// toSecretData decrypts the secret content rows.
+func (c *contentCipher) toSecretData(
+	rows secretValues, keys map[string]secretContentKey,
+) (coresecrets.SecretData, error) {
+	result := make(coresecrets.SecretData)
+	for _, row := range rows {
+		value, err := c.decrypt(row, keys)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[row.Name] = value
+	}
+	return result, nil
+}
+
+// getContentKeys returns the secret content keys with the specified uuids.
+func (st State) getContentKeys(
+	ctx context.Context, tx *sqlair.TX, keyUUIDs secretContentKeyUUIDs,
+) (map[string]secretContentKey, error) {
+	result := make(map[string]secretContentKey)
+	if len(keyUUIDs) == 0 {
+		return result, nil
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &secretContentKey.*
+FROM   secret_content_key
+WHERE  uuid IN ($secretContentKeyUUIDs[:])`, secretContentKey{}, keyUUIDs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var keys []secretContentKey
+	err = tx.Query(ctx, stmt, keyUUIDs).GetAll(&keys)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Trace(err)
+	}
+	for _, k := range keys {
+		result[k.UUID] = k
+	}
+	return result, nil
+}
+
+// getActiveContentKey returns the key used to encrypt new secret content,
+// returning an error satisfying [errors.NotFound] if there is none.
+func (st State) getActiveContentKey(ctx context.Context, tx *sqlair.TX) (secretContentKey, error) {
+	stmt, err := st.Prepare(`
+SELECT &secretContentKey.*
+FROM   secret_content_key
+WHERE  active = TRUE`, secretContentKey{})
+	if err != nil {
+		return secretContentKey{}, errors.Trace(err)
+	}
+
+	var result secretContentKey
+	err = tx.Query(ctx, stmt).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return secretContentKey{}, errors.NotFoundf("active secret content key")
+	}
+	return result, errors.Trace(err)
+}
+
+// ensureActiveContentKey returns the uuid of the key used to encrypt new
+// secret content, creating the key if the model does not yet have one.
+func (st State) ensureActiveContentKey(ctx context.Context, tx *sqlair.TX, cipher *contentCipher) (string, error) {
+	active, err := st.getActiveContentKey(ctx, tx)
+	if err == nil {
+		if _, err := cipher.unwrap(active); err != nil {
+			return "", errors.Trace(err)
+		}
+		return active.UUID, nil
+	} else if !errors.Is(err, errors.NotFound) {
+		return "", errors.Trace(err)
+	}
+	return st.insertContentKey(ctx, tx, cipher)
+}
+
+func (st State) insertContentKey(ctx context.Context, tx *sqlair.TX, cipher *contentCipher) (string, error) {
+	stmt, err := st.Prepare(`
+INSERT INTO secret_content_key (*)
+VALUES ($secretContentKey.*)`, secretContentKey{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	k, err := cipher.newKey(time.Now().UTC())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := tx.Query(ctx, stmt, k).Run(); err != nil {
+		return "", errors.Annotate(err, "inserting secret content key")
+	}
+	return k.UUID, nil
+}
+
+// RotateSecretContentKey replaces the key used to encrypt new secret
+// content if it was created before the specified time, or if it was
+// wrapped with a controller key which has since been rotated out. It
+// returns true if the key was replaced. Content encrypted with the old key remains
+// readable until it is re-encrypted by [State.ReencryptSecretContent].
+func (st State) RotateSecretContentKey(ctx context.Context, createdBefore time.Time) (bool, error) {
+	db, err := st.DB()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	cipher, err := st.newContentCipher()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	deactivateStmt, err := st.Prepare(`
+UPDATE secret_content_key
+SET    active = FALSE
+WHERE  uuid = $secretContentKey.uuid`, secretContentKey{})
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	var rotated bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		rotated = false
+		active, err := st.getActiveContentKey(ctx, tx)
+		if errors.Is(err, errors.NotFound) {
+			// A key is created when content is first stored.
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		wrappedWithActive := active.ControllerKeyID == envelope.KeyID(cipher.controllerKeys.Active())
+		if wrappedWithActive && !active.CreateTime.Before(createdBefore) {
+			return nil
+		}
+		if err := tx.Query(ctx, deactivateStmt, active).Run(); err != nil {
+			return errors.Trace(err)
+		}
+		if _, err := st.insertContentKey(ctx, tx, cipher); err != nil {
+			return errors.Trace(err)
+		}
+		rotated = true
+		return nil
+	})
+	if err != nil {
+		return false, errors.Annotate(err, "rotating secret content key")
+	}
+	return rotated, nil
+}
+
+// ReencryptSecretContent re-encrypts up to limit items of secret content
+// which are not encrypted with the active key, including any stored before
+// encryption was introduced. It returns the number of items re-encrypted.
+// Once there is no content left to re-encrypt, keys which are no longer
+// used are deleted.
+func (st State) ReencryptSecretContent(ctx context.Context, limit int) (int, error) {
+	db, err := st.DB()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	cipher, err := st.newContentCipher()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	selectStmt, err := st.Prepare(`
+SELECT &secretContent.*
+FROM   secret_content
+WHERE  key_uuid IS NULL
+OR     key_uuid NOT IN (
+    SELECT uuid FROM secret_content_key WHERE active = TRUE
+)
+LIMIT  $contentLimit.limit`, secretContent{}, contentLimit{})
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE secret_content
+SET    content = $secretContent.content,
+       key_uuid = $secretContent.key_uuid
+WHERE  revision_uuid = $secretContent.revision_uuid
+AND    name = $secretContent.name`, secretContent{})
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	deleteKeysStmt, err := st.Prepare(`
+DELETE FROM secret_content_key
+WHERE  active = FALSE
+AND    uuid NOT IN (
+    SELECT key_uuid FROM secret_content WHERE key_uuid IS NOT NULL
+)`)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	var count int
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		count = 0
+		var rows secretValues
+		err := tx.Query(ctx, selectStmt, contentLimit{Limit: limit}).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Trace(tx.Query(ctx, deleteKeysStmt).Run())
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		keys, err := st.getContentKeys(ctx, tx, rows.keyUUIDs())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		activeUUID, err := st.ensureActiveContentKey(ctx, tx, cipher)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, row := range rows {
+			value, err := cipher.decrypt(row, keys)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if err := cipher.encrypt(&row, activeUUID, value); err != nil {
+				return errors.Trace(err)
+			}
+			if err := tx.Query(ctx, updateStmt, row).Run(); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		count = len(rows)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "re-encrypting secret content")
+	}
+	return count, nil
+}
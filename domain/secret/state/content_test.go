@@ -0,0 +1,193 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/uuid"
+)
+
+func (s *stateSuite) createSecretWithContent(c *gc.C, st *State, data coresecrets.SecretData) (*coresecrets.URI, string) {
+	revisionID := uuid.MustNewUUID().String()
+	sp := domainsecret.UpsertSecretParams{
+		Data:       data,
+		RevisionID: ptr(revisionID),
+	}
+	uri := coresecrets.NewURI()
+	err := createUserSecret(context.Background(), st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+	return uri, revisionID
+}
+
+func (s *stateSuite) getRawContent(c *gc.C, revisionID, name string) (string, sql.NullString) {
+	var (
+		content string
+		keyUUID sql.NullString
+	)
+	row := s.DB().QueryRowContext(context.Background(), `
+SELECT content, key_uuid FROM secret_content
+WHERE revision_uuid = ? AND name = ?`, revisionID, name)
+	err := row.Scan(&content, &keyUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	return content, keyUUID
+}
+
+func (s *stateSuite) countContentKeys(c *gc.C) int {
+	var count int
+	row := s.DB().QueryRowContext(context.Background(), "SELECT COUNT(*) FROM secret_content_key")
+	err := row.Scan(&count)
+	c.Assert(err, jc.ErrorIsNil)
+	return count
+}
+
+func (s *stateSuite) TestSecretContentEncrypted(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, revisionID := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+
+	content, keyUUID := s.getRawContent(c, revisionID, "foo")
+	c.Assert(content, gc.Not(gc.Equals), "bar")
+	c.Assert(keyUUID.Valid, jc.IsTrue)
+
+	data, _, err := st.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+}
+
+func (s *stateSuite) TestSecretContentWrongControllerKey(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+
+	other := newSecretStateWithKeys(c, s.TxnRunnerFactory(), []byte("fedcba9876543210fedcba9876543210"))
+	_, _, err := other.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, gc.ErrorMatches, `reading secret value for .* revision 1: secret content key .* not wrapped with a controller key`)
+}
+
+func (s *stateSuite) TestRotateControllerKey(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, revisionID := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+	_, oldKeyUUID := s.getRawContent(c, revisionID, "foo")
+
+	// The controller key has been rotated, with the old key kept in the ring.
+	newControllerKey := []byte("fedcba9876543210fedcba9876543210")
+	rotatedSt := newSecretStateWithKeys(c, s.TxnRunnerFactory(), newControllerKey, testControllerKey)
+
+	data, _, err := rotatedSt.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+
+	// The content key is young, but is rotated as the controller key
+	// which wrapped it has been rotated out.
+	rotated, err := rotatedSt.RotateSecretContentKey(context.Background(), time.Now().Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotated, jc.IsTrue)
+
+	count, err := rotatedSt.ReencryptSecretContent(context.Background(), 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 1)
+	count, err = rotatedSt.ReencryptSecretContent(context.Background(), 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 0)
+	c.Assert(s.countContentKeys(c), gc.Equals, 1)
+
+	_, newKeyUUID := s.getRawContent(c, revisionID, "foo")
+	c.Assert(newKeyUUID.String, gc.Not(gc.Equals), oldKeyUUID.String)
+
+	// The old controller key is no longer needed.
+	newSt := newSecretStateWithKeys(c, s.TxnRunnerFactory(), newControllerKey)
+	data, _, err = newSt.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+}
+
+func (s *stateSuite) TestReencryptSecretContentPlaintext(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, revisionID := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar"})
+
+	// Simulate content stored before encryption was introduced.
+	_, err := s.DB().ExecContext(context.Background(), `
+UPDATE secret_content SET content = 'bar', key_uuid = NULL
+WHERE revision_uuid = ?`, revisionID)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, _, err := st.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+
+	count, err := st.ReencryptSecretContent(context.Background(), 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 1)
+
+	content, keyUUID := s.getRawContent(c, revisionID, "foo")
+	c.Assert(content, gc.Not(gc.Equals), "bar")
+	c.Assert(keyUUID.Valid, jc.IsTrue)
+
+	data, _, err = st.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+
+	count, err = st.ReencryptSecretContent(context.Background(), 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 0)
+}
+
+func (s *stateSuite) TestRotateSecretContentKeyNoKey(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	rotated, err := st.RotateSecretContentKey(context.Background(), time.Now().Add(time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotated, jc.IsFalse)
+	c.Assert(s.countContentKeys(c), gc.Equals, 0)
+}
+
+func (s *stateSuite) TestRotateSecretContentKey(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, revisionID := s.createSecretWithContent(c, st, coresecrets.SecretData{"foo": "bar", "hello": "world"})
+	_, oldKeyUUID := s.getRawContent(c, revisionID, "foo")
+
+	// The key is not old enough to rotate.
+	rotated, err := st.RotateSecretContentKey(context.Background(), time.Now().Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotated, jc.IsFalse)
+	c.Assert(s.countContentKeys(c), gc.Equals, 1)
+
+	rotated, err = st.RotateSecretContentKey(context.Background(), time.Now().Add(time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotated, jc.IsTrue)
+	c.Assert(s.countContentKeys(c), gc.Equals, 2)
+
+	// Content encrypted with the old key is still readable.
+	data, _, err := st.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar", "hello": "world"})
+
+	// Re-encrypt in batches.
+	count, err := st.ReencryptSecretContent(context.Background(), 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 1)
+	count, err = st.ReencryptSecretContent(context.Background(), 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 1)
+
+	_, newKeyUUID := s.getRawContent(c, revisionID, "foo")
+	c.Assert(newKeyUUID.Valid, jc.IsTrue)
+	c.Assert(newKeyUUID.String, gc.Not(gc.Equals), oldKeyUUID.String)
+
+	// Once everything is re-encrypted, the old key is deleted.
+	count, err = st.ReencryptSecretContent(context.Background(), 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 0)
+	c.Assert(s.countContentKeys(c), gc.Equals, 1)
+
+	data, _, err = st.GetSecretValue(context.Background(), uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar", "hello": "world"})
+}
@@ -0,0 +1,213 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	coreunit "github.com/juju/juju/core/unit"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+// PinSecretRevision pins the units of the specified application to the
+// specified revision of a local secret. The units are moved to the pinned
+// revision, which is retained until the pin is removed, and they are not
+// offered any later revision while pinned.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// does not exist, [secreterrors.SecretRevisionNotFound] if the revision does
+// not exist, [secreterrors.SecretRevisionObsolete] if the revision is
+// obsolete, or [applicationerrors.ApplicationNotFound] if the application
+// does not exist.
+func (st State) PinSecretRevision(ctx context.Context, uri *coresecrets.URI, appName string, revision int) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	upsertPinStmt, err := st.Prepare(`
+INSERT INTO secret_revision_pin (*)
+VALUES ($secretRevisionPin.*)
+ON CONFLICT(secret_id, application_uuid) DO UPDATE SET
+    revision_uuid=excluded.revision_uuid`, secretRevisionPin{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	moveConsumersStmt, err := st.Prepare(`
+UPDATE secret_unit_consumer
+SET    current_revision = $pinnedRevision.revision
+WHERE  secret_id = $pinnedRevision.secret_id
+AND    unit_uuid IN (
+    SELECT uuid FROM unit WHERE application_uuid = $pinnedRevision.application_uuid
+)`, pinnedRevision{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		pinned, err := st.getRetainedRevision(ctx, tx, uri, revision)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if pinned.Obsolete {
+			return fmt.Errorf("secret %q revision %d%w", uri, revision, errors.Hide(secreterrors.SecretRevisionObsolete))
+		}
+		appID, err := st.getApplicationUUID(ctx, tx, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		appUUID := appID.String()
+
+		pin := secretRevisionPin{
+			SecretID:        uri.ID,
+			ApplicationUUID: appUUID,
+			RevisionUUID:    pinned.UUID,
+		}
+		if err := tx.Query(ctx, upsertPinStmt, pin).Run(); err != nil {
+			return errors.Annotatef(err, "pinning %q to revision %d of secret %q", appName, revision, uri)
+		}
+		move := pinnedRevision{
+			SecretID:        uri.ID,
+			ApplicationUUID: appUUID,
+			Revision:        revision,
+		}
+		if err := tx.Query(ctx, moveConsumersStmt, move).Run(); err != nil {
+			return errors.Annotatef(err, "moving units of %q to revision %d of secret %q", appName, revision, uri)
+		}
+		return errors.Trace(st.markObsoleteRevisions(ctx, tx, uri))
+	})
+}
+
+// UnpinSecretRevision removes any pin of the units of the specified
+// application to a revision of a local secret. The units tracking the
+// previously pinned revision are moved to the latest revision.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// does not exist, or [applicationerrors.ApplicationNotFound] if the
+// application does not exist.
+func (st State) UnpinSecretRevision(ctx context.Context, uri *coresecrets.URI, appName string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deletePinStmt, err := st.Prepare(`
+DELETE FROM secret_revision_pin
+WHERE  secret_id = $secretRevisionPin.secret_id
+AND    application_uuid = $secretRevisionPin.application_uuid`, secretRevisionPin{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	moveConsumersStmt, err := st.Prepare(`
+UPDATE secret_unit_consumer
+SET    current_revision = (
+    SELECT MAX(revision) FROM secret_revision WHERE secret_id = $pinnedRevision.secret_id
+)
+WHERE  secret_id = $pinnedRevision.secret_id
+AND    current_revision = $pinnedRevision.revision
+AND    unit_uuid IN (
+    SELECT uuid FROM unit WHERE application_uuid = $pinnedRevision.application_uuid
+)`, pinnedRevision{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if _, err := st.checkExistsIfLocal(ctx, tx, uri); err != nil {
+			return errors.Trace(err)
+		}
+		appID, err := st.getApplicationUUID(ctx, tx, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		appUUID := appID.String()
+
+		pinned, err := st.getApplicationPinnedRevision(ctx, tx, uri.ID, appUUID)
+		if errors.Is(err, errors.NotFound) {
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		pin := secretRevisionPin{SecretID: uri.ID, ApplicationUUID: appUUID}
+		if err := tx.Query(ctx, deletePinStmt, pin).Run(); err != nil {
+			return errors.Annotatef(err, "unpinning %q from secret %q", appName, uri)
+		}
+		if err := tx.Query(ctx, moveConsumersStmt, pinned).Run(); err != nil {
+			return errors.Annotatef(err, "moving units of %q to the latest revision of secret %q", appName, uri)
+		}
+		return errors.Trace(st.markObsoleteRevisions(ctx, tx, uri))
+	})
+}
+
+// getApplicationPinnedRevision returns the revision of the secret which the
+// specified application is pinned to, or an error satisfying
+// [errors.NotFound] if there is no pin.
+func (st State) getApplicationPinnedRevision(
+	ctx context.Context, tx *sqlair.TX, secretID, appUUID string,
+) (pinnedRevision, error) {
+	stmt, err := st.Prepare(`
+SELECT sr.revision AS &pinnedRevision.revision
+FROM   secret_revision_pin srp
+       JOIN secret_revision sr ON sr.uuid = srp.revision_uuid
+WHERE  srp.secret_id = $pinnedRevision.secret_id
+AND    srp.application_uuid = $pinnedRevision.application_uuid`, pinnedRevision{})
+	if err != nil {
+		return pinnedRevision{}, errors.Trace(err)
+	}
+	result := pinnedRevision{SecretID: secretID, ApplicationUUID: appUUID}
+	err = tx.Query(ctx, stmt, result).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return pinnedRevision{}, errors.NotFoundf("revision pin for secret %q", secretID)
+	}
+	return result, errors.Trace(err)
+}
+
+// getUnitPinnedRevision returns the revision of the secret which the
+// application of the specified unit is pinned to, or an error satisfying
+// [errors.NotFound] if there is no pin.
+func (st State) getUnitPinnedRevision(
+	ctx context.Context, tx *sqlair.TX, secretID string, unitUUID coreunit.UUID,
+) (int, error) {
+	stmt, err := st.Prepare(`
+SELECT sr.revision AS &pinnedRevision.revision
+FROM   secret_revision_pin srp
+       JOIN secret_revision sr ON sr.uuid = srp.revision_uuid
+       JOIN unit u ON u.application_uuid = srp.application_uuid
+WHERE  srp.secret_id = $pinnedRevision.secret_id
+AND    u.uuid = $unit.uuid`, pinnedRevision{}, unit{})
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	result := pinnedRevision{SecretID: secretID}
+	err = tx.Query(ctx, stmt, result, unit{UUID: unitUUID}).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return 0, errors.NotFoundf("revision pin for secret %q", secretID)
+	}
+	return result.Revision, errors.Trace(err)
+}
+
+// getRevisionPins returns the names of the applications pinned to
+// revisions of the specified secret.
+func (st State) getRevisionPins(ctx context.Context, tx *sqlair.TX, secretID string) (revisionPins, error) {
+	stmt, err := st.Prepare(`
+SELECT srp.revision_uuid AS &revisionPin.revision_uuid,
+       a.name AS &revisionPin.name
+FROM   secret_revision_pin srp
+       JOIN application a ON a.uuid = srp.application_uuid
+WHERE  srp.secret_id = $secretRef.secret_id
+ORDER BY a.name`, revisionPin{}, secretRef{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var pins revisionPins
+	err = tx.Query(ctx, stmt, secretRef{ID: secretID}).GetAll(&pins)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil
+	}
+	return pins, errors.Annotatef(err, "querying revision pins for secret %q", secretID)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+func (s *stateSuite) TestPinSecretRevision(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"}, "mysql/0")
+	err := st.SaveSecretConsumer(ctx, uri, "mysql/1", &coresecrets.SecretConsumerMetadata{CurrentRevision: 2})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.PinSecretRevision(ctx, uri, "mysql", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.getRevisionMetadata(c, st, uri, 1).PinnedApplications, jc.DeepEquals, []string{"mysql"})
+	c.Check(s.getRevisionMetadata(c, st, uri, 2).PinnedApplications, gc.HasLen, 0)
+
+	// All units of the application are moved to, and
+	// not offered anything later than, the pinned revision.
+	for _, unit := range []string{"mysql/0", "mysql/1"} {
+		consumer, latest, err := st.GetSecretConsumer(ctx, uri, unit)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(consumer.CurrentRevision, gc.Equals, 1)
+		c.Check(latest, gc.Equals, 1)
+	}
+
+	// The pinned revision is retained even with no consumers.
+	_, err = s.DB().ExecContext(ctx, "DELETE FROM secret_unit_consumer WHERE secret_id = ?", uri.ID)
+	c.Assert(err, jc.ErrorIsNil)
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	fillDataForUpsertSecretParams(c, &sp, coresecrets.SecretData{"password": "newer"})
+	err = updateSecret(ctx, st, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+	obsolete, _ := s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsFalse)
+	obsolete, _ = s.getObsolete(c, uri, 2)
+	c.Check(obsolete, jc.IsTrue)
+}
+
+func (s *stateSuite) TestUnpinSecretRevision(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"}, "mysql/0")
+
+	err := st.PinSecretRevision(ctx, uri, "mysql", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.UnpinSecretRevision(ctx, uri, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.getRevisionMetadata(c, st, uri, 1).PinnedApplications, gc.HasLen, 0)
+
+	consumer, latest, err := st.GetSecretConsumer(ctx, uri, "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(consumer.CurrentRevision, gc.Equals, 2)
+	c.Check(latest, gc.Equals, 2)
+	obsolete, _ := s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsTrue)
+
+	// Unpinning again is a no-op.
+	err = st.UnpinSecretRevision(ctx, uri, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestPinSecretRevisionObsolete(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"})
+
+	err := st.PinSecretRevision(context.Background(), uri, "mysql", 1)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionObsolete)
+}
+
+func (s *stateSuite) TestPinSecretRevisionNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri := s.createSecretWithRevisions(c, st, []string{"old"})
+
+	err := st.PinSecretRevision(context.Background(), uri, "mysql", 2)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionNotFound)
+	err = st.PinSecretRevision(context.Background(), coresecrets.NewURI(), "mysql", 1)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *stateSuite) TestPinSecretRevisionApplicationNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri := s.createSecretWithRevisions(c, st, []string{"old"})
+
+	err := st.PinSecretRevision(context.Background(), uri, "mariadb", 1)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+	err = st.UnpinSecretRevision(context.Background(), uri, "mariadb")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
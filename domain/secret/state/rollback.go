@@ -0,0 +1,240 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// getRetainedRevision returns the specified revision of a local secret,
+// along with whether it is obsolete.
+// If the secret does not exist, an error satisfying
+// [secreterrors.SecretNotFound] is returned, and if the revision does not
+// exist, an error satisfying [secreterrors.SecretRevisionNotFound].
+func (st State) getRetainedRevision(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, revision int,
+) (retainedRevision, error) {
+	existsStmt, err := st.Prepare(`
+SELECT secret_id AS &secretID.id
+FROM   secret_metadata
+WHERE  secret_id = $secretID.id`, secretID{})
+	if err != nil {
+		return retainedRevision{}, errors.Trace(err)
+	}
+	revisionStmt, err := st.Prepare(`
+SELECT sr.uuid AS &retainedRevision.uuid,
+       sr.secret_id AS &retainedRevision.secret_id,
+       sr.revision AS &retainedRevision.revision,
+       COALESCE(sro.obsolete, FALSE) AS &retainedRevision.obsolete
+FROM   secret_revision sr
+       LEFT JOIN secret_revision_obsolete sro ON sro.revision_uuid = sr.uuid
+WHERE  sr.secret_id = $retainedRevision.secret_id
+AND    sr.revision = $retainedRevision.revision`, retainedRevision{})
+	if err != nil {
+		return retainedRevision{}, errors.Trace(err)
+	}
+
+	id := secretID{ID: uri.ID}
+	err = tx.Query(ctx, existsStmt, id).Get(&id)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return retainedRevision{}, fmt.Errorf("secret %q not found%w", uri, errors.Hide(secreterrors.SecretNotFound))
+	} else if err != nil {
+		return retainedRevision{}, errors.Trace(err)
+	}
+
+	result := retainedRevision{SecretID: uri.ID, Revision: revision}
+	err = tx.Query(ctx, revisionStmt, result).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return retainedRevision{}, fmt.Errorf(
+			"secret %q revision %d not found%w", uri, revision, errors.Hide(secreterrors.SecretRevisionNotFound))
+	} else if err != nil {
+		return retainedRevision{}, errors.Annotatef(err, "looking up revision %d of secret %q", revision, uri)
+	}
+	return result, nil
+}
+
+// restoreRevision restores an existing revision as the latest revision of
+// its secret, and returns the uuid of the restored revision. A new revision
+// is created with the same content as the existing one, which is left as
+// is, so the consumers tracking it are notified of the new latest revision
+// like any other update. The restore is recorded against the new revision.
+func (st State) restoreRevision(
+	ctx context.Context, tx *sqlair.TX, revision retainedRevision, checksum string, now time.Time,
+) (string, error) {
+	maxRevisionStmt, err := st.Prepare(`
+SELECT MAX(revision) AS &secretRevision.revision
+FROM   secret_revision
+WHERE  secret_id = $secretRevision.secret_id`, secretRevision{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	checksumStmt, err := st.Prepare(`
+UPDATE secret_metadata
+SET    latest_revision_checksum = $restoredRevision.checksum,
+       update_time = $restoredRevision.update_time
+WHERE  secret_id = $restoredRevision.secret_id`, restoredRevision{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	recordStmt, err := st.Prepare(`
+INSERT INTO secret_revision_rollback (*)
+VALUES ($secretRevisionRollback.*)`, secretRevisionRollback{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	latest := secretRevision{SecretID: revision.SecretID}
+	if err := tx.Query(ctx, maxRevisionStmt, latest).Get(&latest); err != nil {
+		return "", errors.Annotatef(err, "getting latest revision of secret %q", revision.SecretID)
+	}
+	revisionUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	restored := &secretRevision{
+		ID:         revisionUUID.String(),
+		SecretID:   revision.SecretID,
+		Revision:   latest.Revision + 1,
+		CreateTime: now,
+	}
+	if err := st.upsertSecretRevision(ctx, tx, restored); err != nil {
+		return "", errors.Annotatef(err, "inserting revision for secret %q", revision.SecretID)
+	}
+	if err := st.copyRevisionContent(ctx, tx, revision.UUID, restored.ID); err != nil {
+		return "", errors.Annotatef(err, "copying content of revision %d of secret %q", revision.Revision, revision.SecretID)
+	}
+
+	update := restoredRevision{
+		SecretID:   revision.SecretID,
+		Checksum:   checksum,
+		UpdateTime: now,
+	}
+	if err := tx.Query(ctx, checksumStmt, update).Run(); err != nil {
+		return "", errors.Trace(err)
+	}
+
+	record := secretRevisionRollback{
+		RevisionUUID:         restored.ID,
+		SecretID:             revision.SecretID,
+		RestoredFromRevision: revision.Revision,
+		ReplacedRevision:     latest.Revision,
+		RollbackTime:         now,
+	}
+	if err := tx.Query(ctx, recordStmt, record).Run(); err != nil {
+		return "", errors.Annotatef(err, "recording rollback of secret %q", revision.SecretID)
+	}
+	return restored.ID, nil
+}
+
+// copyRevisionContent copies the content of a revision, either data or a
+// backend value reference, to another revision. Data is re-encrypted since
+// it is bound to the revision it is stored against. A copied value
+// reference is shared by both revisions.
+func (st State) copyRevisionContent(ctx context.Context, tx *sqlair.TX, fromUUID, toUUID string) error {
+	contentStmt, err := st.Prepare(`
+SELECT &secretContent.*
+FROM   secret_content
+WHERE  revision_uuid = $revisionUUID.uuid`, secretContent{}, revisionUUID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	valueRefStmt, err := st.Prepare(`
+SELECT &secretValueRef.*
+FROM   secret_value_ref
+WHERE  revision_uuid = $revisionUUID.uuid`, secretValueRef{}, revisionUUID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	from := revisionUUID{UUID: fromUUID}
+	var rows secretValues
+	err = tx.Query(ctx, contentStmt, from).GetAll(&rows)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return errors.Trace(err)
+	}
+	if len(rows) > 0 {
+		keys, err := st.getContentKeys(ctx, tx, rows.keyUUIDs())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		cipher, err := st.newContentCipher()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		data, err := cipher.toSecretData(rows, keys)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Trace(st.updateSecretContent(ctx, tx, toUUID, data))
+	}
+
+	var ref secretValueRef
+	err = tx.Query(ctx, valueRefStmt, from).Get(&ref)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return errors.NotFoundf("content of revision %q", fromUUID)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(st.upsertSecretValueRef(ctx, tx, toUUID, &coresecrets.ValueRef{
+		BackendID:  ref.BackendUUID,
+		RevisionID: ref.RevisionID,
+	}))
+}
+
+// rollbackSecret restores the specified revision of a secret as its latest
+// revision, and returns the uuid of the restored revision. Only revisions
+// which are still retained can be restored.
+// It returns an error satisfying [secreterrors.SecretRevisionNotFound] if
+// the revision does not exist, [secreterrors.SecretRevisionObsolete] if it
+// is obsolete, and [secreterrors.SecretRevisionStagePending] if a staged
+// rotation of the secret is pending.
+func (st State) rollbackSecret(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, revision int, now time.Time,
+) (string, error) {
+	if _, err := st.getPendingStage(ctx, tx, uri.ID); err == nil {
+		return "", fmt.Errorf("secret %q has a pending staged revision%w", uri, errors.Hide(secreterrors.SecretRevisionStagePending))
+	} else if !errors.Is(err, errors.NotFound) {
+		return "", errors.Trace(err)
+	}
+
+	restore, err := st.getRetainedRevision(ctx, tx, uri, revision)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if restore.Obsolete {
+		return "", fmt.Errorf("secret %q revision %d%w", uri, revision, errors.Hide(secreterrors.SecretRevisionObsolete))
+	}
+
+	latest := secretRevision{SecretID: uri.ID}
+	latestStmt, err := st.Prepare(`
+SELECT MAX(revision) AS &secretRevision.revision
+FROM   secret_revision
+WHERE  secret_id = $secretRevision.secret_id`, secretRevision{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := tx.Query(ctx, latestStmt, latest).Get(&latest); err != nil {
+		return "", errors.Annotatef(err, "getting latest revision of secret %q", uri)
+	}
+	if latest.Revision == revision {
+		return "", errors.NotValidf("rolling back secret %q to its latest revision %d", uri, revision)
+	}
+
+	// The checksum of the restored content is not known, so clear it;
+	// the next update with content will always create a new revision.
+	restoredUUID, err := st.restoreRevision(ctx, tx, restore, "", now)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return restoredUUID, nil
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// createSecretWithRevisions creates a secret with a revision for each of the
+// specified passwords. The first revision is consumed by the specified units
+// of mysql so that it is retained.
+func (s *stateSuite) createSecretWithRevisions(c *gc.C, st *State, passwords []string, units ...string) *coresecrets.URI {
+	s.setupUnits(c, "mysql")
+	ctx := context.Background()
+
+	uri := coresecrets.NewURI()
+	for i, password := range passwords {
+		sp := domainsecret.UpsertSecretParams{
+			RevisionID: ptr(uuid.MustNewUUID().String()),
+		}
+		fillDataForUpsertSecretParams(c, &sp, coresecrets.SecretData{"password": password})
+		if i == 0 {
+			err := createUserSecret(ctx, st, 1, uri, sp)
+			c.Assert(err, jc.ErrorIsNil)
+			for _, unit := range units {
+				err = st.SaveSecretConsumer(ctx, uri, unit, &coresecrets.SecretConsumerMetadata{CurrentRevision: 1})
+				c.Assert(err, jc.ErrorIsNil)
+			}
+			continue
+		}
+		err := updateSecret(ctx, st, uri, sp)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	return uri
+}
+
+func (s *stateSuite) getRevisionMetadata(c *gc.C, st *State, uri *coresecrets.URI, rev int) *coresecrets.SecretRevisionMetadata {
+	_, revisions, err := st.ListSecrets(context.Background(), uri, &rev, domainsecret.NilLabels)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(revisions, gc.HasLen, 1)
+	c.Assert(revisions[0], gc.HasLen, 1)
+	return revisions[0][0]
+}
+
+func (s *stateSuite) TestRollbackSecret(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"}, "mysql/0")
+
+	now := time.Now().UTC()
+	err := updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{RollbackRevision: ptr(1)})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The old content is restored as revision 3.
+	md, err := st.GetSecret(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(md.LatestRevision, gc.Equals, 3)
+	c.Check(md.LatestRevisionChecksum, gc.Equals, "")
+	data, _, err := st.GetSecretValue(ctx, uri, 3)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(data, jc.DeepEquals, coresecrets.SecretData{"password": "old"})
+
+	// The restored revision is kept as is.
+	data, _, err = st.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(data, jc.DeepEquals, coresecrets.SecretData{"password": "old"})
+	c.Check(s.getRevisionMetadata(c, st, uri, 1).Rollback, gc.IsNil)
+
+	rollback := s.getRevisionMetadata(c, st, uri, 3).Rollback
+	c.Assert(rollback, gc.NotNil)
+	c.Check(rollback.RestoredFromRevision, gc.Equals, 1)
+	c.Check(rollback.ReplacedRevision, gc.Equals, 2)
+	c.Check(rollback.Time, jc.Almost, now)
+
+	// The consumer of the restored revision still tracks it until it
+	// refreshes, and the replaced revision is obsolete as nothing
+	// consumes it.
+	consumer, latest, err := st.GetSecretConsumer(ctx, uri, "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(consumer.CurrentRevision, gc.Equals, 1)
+	c.Check(latest, gc.Equals, 3)
+	obsolete, _ := s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsFalse)
+	obsolete, _ = s.getObsolete(c, uri, 2)
+	c.Check(obsolete, jc.IsTrue)
+
+	// The checksum is unknown, so an update creates a new revision.
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	fillDataForUpsertSecretParams(c, &sp, coresecrets.SecretData{"password": "old"})
+	err = updateSecret(ctx, st, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+	md, err = st.GetSecret(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(md.LatestRevision, gc.Equals, 4)
+}
+
+func (s *stateSuite) TestRollbackSecretValueRef(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"}, "mysql/0")
+	ref := &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id"}
+	err := updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		ValueRef:   ref,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.SaveSecretConsumer(ctx, uri, "mysql/0", &coresecrets.SecretConsumerMetadata{CurrentRevision: 3})
+	c.Assert(err, jc.ErrorIsNil)
+	err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		ValueRef:   &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id2"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{RollbackRevision: ptr(3)})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The restored revision shares the value reference.
+	_, restoredRef, err := st.GetSecretValue(ctx, uri, 5)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(restoredRef, jc.DeepEquals, ref)
+	_, valueRef, err := st.GetSecretValue(ctx, uri, 3)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(valueRef, jc.DeepEquals, ref)
+}
+
+func (s *stateSuite) TestRollbackSecretRevisionNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"})
+
+	err := updateSecret(context.Background(), st, uri, domainsecret.UpsertSecretParams{RollbackRevision: ptr(5)})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *stateSuite) TestRollbackSecretRevisionObsolete(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"})
+
+	err := updateSecret(context.Background(), st, uri, domainsecret.UpsertSecretParams{RollbackRevision: ptr(1)})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionObsolete)
+}
+
+func (s *stateSuite) TestRollbackSecretLatestRevision(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"})
+
+	err := updateSecret(context.Background(), st, uri, domainsecret.UpsertSecretParams{RollbackRevision: ptr(2)})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *stateSuite) TestRollbackSecretStagePending(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createStagedSecret(c, st, time.Now().Add(time.Hour), false, "mysql/0")
+
+	err := updateSecret(context.Background(), st, uri, domainsecret.UpsertSecretParams{RollbackRevision: ptr(1)})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionStagePending)
+}
+
+func (s *stateSuite) TestRollbackSecretWithContent(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri := s.createSecretWithRevisions(c, st, []string{"old", "new"}, "mysql/0")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID:       ptr(uuid.MustNewUUID().String()),
+		RollbackRevision: ptr(1),
+	}
+	fillDataForUpsertSecretParams(c, &sp, coresecrets.SecretData{"password": "newer"})
+	err := updateSecret(context.Background(), st, uri, sp)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+)
+
+const selectPendingStages = `
+SELECT srs.revision_uuid AS &pendingStage.revision_uuid,
+       srs.secret_id AS &pendingStage.secret_id,
+       sr.revision AS &pendingStage.revision,
+       srs.previous_revision_uuid AS &pendingStage.previous_revision_uuid,
+       prev.revision AS &pendingStage.previous_revision,
+       COALESCE(srs.previous_revision_checksum, '') AS &pendingStage.previous_revision_checksum,
+       srs.deadline AS &pendingStage.deadline,
+       srs.rollback_on_timeout AS &pendingStage.rollback_on_timeout
+FROM   secret_revision_stage srs
+       JOIN secret_revision sr ON sr.uuid = srs.revision_uuid
+       JOIN secret_revision prev ON prev.uuid = srs.previous_revision_uuid
+WHERE  srs.status_id = 0`
+
+func (st State) insertSecretRevisionStage(ctx context.Context, tx *sqlair.TX, stage secretRevisionStage) error {
+	stmt, err := st.Prepare(`
+INSERT INTO secret_revision_stage (*)
+VALUES ($secretRevisionStage.*)`, secretRevisionStage{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(tx.Query(ctx, stmt, stage).Run())
+}
+
+// getPendingStage returns the pending staged rotation of the specified
+// secret, or an error satisfying [errors.NotFound] if there is none.
+func (st State) getPendingStage(ctx context.Context, tx *sqlair.TX, secretID string) (pendingStage, error) {
+	stmt, err := st.Prepare(selectPendingStages+`
+AND    srs.secret_id = $pendingStage.secret_id`, pendingStage{})
+	if err != nil {
+		return pendingStage{}, errors.Trace(err)
+	}
+
+	result := pendingStage{SecretID: secretID}
+	err = tx.Query(ctx, stmt, result).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return pendingStage{}, errors.NotFoundf("pending staged revision for secret %q", secretID)
+	}
+	return result, errors.Trace(err)
+}
+
+// commitStagedRevision commits the pending staged rotation of the specified
+// secret if all consumers track the staged revision or a later one, so that
+// the previous revision is no longer retained. It returns true if a staged
+// rotation was committed.
+func (st State) commitStagedRevision(ctx context.Context, tx *sqlair.TX, secretID string) (bool, error) {
+	stage, err := st.getPendingStage(ctx, tx, secretID)
+	if errors.Is(err, errors.NotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	countStmt, err := st.Prepare(`
+SELECT COUNT(*) AS &count.num
+FROM (
+    SELECT current_revision FROM secret_unit_consumer
+    WHERE  secret_id = $pendingStage.secret_id
+    AND    current_revision < $pendingStage.revision
+    UNION ALL
+    SELECT current_revision FROM secret_remote_unit_consumer
+    WHERE  secret_id = $pendingStage.secret_id
+    AND    current_revision < $pendingStage.revision
+)`, pendingStage{}, count{})
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	var lagging count
+	if err := tx.Query(ctx, countStmt, stage).Get(&lagging); err != nil {
+		return false, errors.Annotatef(err, "counting consumers of secret %q yet to track revision %d", secretID, stage.Revision)
+	}
+	if lagging.Num > 0 {
+		return false, nil
+	}
+
+	err = st.updateStageStatus(ctx, tx, stage.RevisionUUID, domainsecret.StageCommitted, time.Now().UTC())
+	if err != nil {
+		return false, errors.Annotatef(err, "committing staged revision %d of secret %q", stage.Revision, secretID)
+	}
+	return true, nil
+}
+
+func (st State) updateStageStatus(
+	ctx context.Context, tx *sqlair.TX, revisionUUID string, status domainsecret.StageStatus, now time.Time,
+) error {
+	stmt, err := st.Prepare(`
+UPDATE secret_revision_stage
+SET    status_id = $secretRevisionStage.status_id,
+       update_time = $secretRevisionStage.update_time
+WHERE  revision_uuid = $secretRevisionStage.revision_uuid`, secretRevisionStage{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	stage := secretRevisionStage{
+		RevisionUUID: revisionUUID,
+		StatusID:     int(status),
+		UpdateTime:   now,
+	}
+	return errors.Trace(tx.Query(ctx, stmt, stage).Run())
+}
+
+// expireStagedRevision ends a pending staged rotation whose deadline has
+// passed without rolling it back. The previous revision is no longer held
+// back, and is obsoleted like any other once no consumer tracks it.
+func (st State) expireStagedRevision(ctx context.Context, tx *sqlair.TX, stage pendingStage, now time.Time) error {
+	if err := st.updateStageStatus(ctx, tx, stage.RevisionUUID, domainsecret.StageExpired, now); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(st.markObsoleteRevisions(ctx, tx, &coresecrets.URI{ID: stage.SecretID}))
+}
+
+// rollbackStagedRevision restores the previous revision of a pending staged
+// rotation as a new latest revision of the secret. Consumers tracking the
+// staged or previous revision are notified of the new latest revision, and
+// once they have moved off them those revisions are obsoleted like any other.
+func (st State) rollbackStagedRevision(ctx context.Context, tx *sqlair.TX, stage pendingStage, now time.Time) error {
+	restore := retainedRevision{
+		UUID:     stage.PreviousRevisionUUID,
+		SecretID: stage.SecretID,
+		Revision: stage.PreviousRevision,
+	}
+	if _, err := st.restoreRevision(ctx, tx, restore, stage.PreviousRevisionChecksum, now); err != nil {
+		return errors.Annotatef(err, "restoring revision %d of secret %q", stage.PreviousRevision, stage.SecretID)
+	}
+
+	if err := st.updateStageStatus(ctx, tx, stage.RevisionUUID, domainsecret.StageRolledBack, now); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(st.markObsoleteRevisions(ctx, tx, &coresecrets.URI{ID: stage.SecretID}))
+}
+
+// ProcessStagedRevisions resolves pending staged rotations. A staged rotation
+// is committed once all consumers track the staged revision. If that has not
+// happened by its deadline, it is rolled back if the owner asked for that,
+// and otherwise expires. It returns the ids of the secrets whose staged
+// rotations were committed, expired and rolled back.
+func (st State) ProcessStagedRevisions(
+	ctx context.Context, now time.Time,
+) (committed, expired, rolledBack []string, _ error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, nil, nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(selectPendingStages, pendingStage{})
+	if err != nil {
+		return nil, nil, nil, errors.Trace(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		committed, expired, rolledBack = nil, nil, nil
+
+		var stages []pendingStage
+		err := tx.Query(ctx, stmt).GetAll(&stages)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		for _, stage := range stages {
+			// Committing releases the previous revision,
+			// which may now be obsolete.
+			uri := &coresecrets.URI{ID: stage.SecretID}
+			if err := st.markObsoleteRevisions(ctx, tx, uri); err != nil {
+				return errors.Annotatef(err, "marking obsolete revisions for secret %q", uri)
+			}
+			if _, err := st.getPendingStage(ctx, tx, stage.SecretID); errors.Is(err, errors.NotFound) {
+				committed = append(committed, stage.SecretID)
+				continue
+			} else if err != nil {
+				return errors.Trace(err)
+			}
+			if stage.Deadline.After(now) {
+				continue
+			}
+			if !stage.RollbackOnTimeout {
+				if err := st.expireStagedRevision(ctx, tx, stage, now); err != nil {
+					return errors.Annotatef(err, "expiring staged revision %d of secret %q", stage.Revision, uri)
+				}
+				expired = append(expired, stage.SecretID)
+				continue
+			}
+			if err := st.rollbackStagedRevision(ctx, tx, stage, now); err != nil {
+				return errors.Annotatef(err, "rolling back staged revision %d of secret %q", stage.Revision, uri)
+			}
+			rolledBack = append(rolledBack, stage.SecretID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, errors.Annotate(err, "processing staged secret revisions")
+	}
+	return committed, expired, rolledBack, nil
+}
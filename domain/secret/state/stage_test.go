@@ -0,0 +1,227 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain"
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// createStagedSecret creates a secret consumed at revision 1 by the
+// specified units of mysql, and stages revision 2.
+func (s *stateSuite) createStagedSecret(
+	c *gc.C, st *State, deadline time.Time, rollbackOnTimeout bool, units ...string,
+) (*coresecrets.URI, string) {
+	s.setupUnits(c, "mysql")
+	ctx := context.Background()
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	fillDataForUpsertSecretParams(c, &sp, coresecrets.SecretData{"password": "old"})
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	for _, unit := range units {
+		err = st.SaveSecretConsumer(ctx, uri, unit, &coresecrets.SecretConsumerMetadata{CurrentRevision: 1})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	staged := domainsecret.UpsertSecretParams{
+		RevisionID:        ptr(uuid.MustNewUUID().String()),
+		StageDeadline:     ptr(deadline),
+		RollbackOnTimeout: rollbackOnTimeout,
+	}
+	fillDataForUpsertSecretParams(c, &staged, coresecrets.SecretData{"password": "new"})
+	err = updateSecret(ctx, st, uri, staged)
+	c.Assert(err, jc.ErrorIsNil)
+	return uri, sp.Checksum
+}
+
+func (s *stateSuite) getRevisionStage(c *gc.C, st *State, uri *coresecrets.URI, rev int) *coresecrets.RevisionStage {
+	_, revisions, err := st.ListSecrets(context.Background(), uri, &rev, domainsecret.NilLabels)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(revisions, gc.HasLen, 1)
+	c.Assert(revisions[0], gc.HasLen, 1)
+	return revisions[0][0].Stage
+}
+
+func (s *stateSuite) TestStagedRevisionCommittedWhenConsumersTrackIt(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	deadline := time.Now().Add(time.Hour).UTC()
+	uri, _ := s.createStagedSecret(c, st, deadline, false, "mysql/0", "mysql/1")
+
+	c.Assert(s.getRevisionStage(c, st, uri, 1), gc.IsNil)
+	stage := s.getRevisionStage(c, st, uri, 2)
+	c.Assert(stage, gc.NotNil)
+	c.Check(stage.Status, gc.Equals, coresecrets.StagePending)
+	c.Check(stage.PreviousRevision, gc.Equals, 1)
+	c.Check(stage.Deadline, jc.Almost, deadline)
+	c.Check(stage.RollbackOnTimeout, jc.IsFalse)
+
+	// No new revisions while the rotation is pending.
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	fillDataForUpsertSecretParams(c, &sp, coresecrets.SecretData{"password": "newer"})
+	err := updateSecret(ctx, st, uri, sp)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionStagePending)
+
+	err = st.SaveSecretConsumer(ctx, uri, "mysql/0", &coresecrets.SecretConsumerMetadata{CurrentRevision: 2})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.getRevisionStage(c, st, uri, 2).Status, gc.Equals, coresecrets.StagePending)
+	obsolete, _ := s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsFalse)
+
+	err = st.SaveSecretConsumer(ctx, uri, "mysql/1", &coresecrets.SecretConsumerMetadata{CurrentRevision: 2})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.getRevisionStage(c, st, uri, 2).Status, gc.Equals, coresecrets.StageCommitted)
+	obsolete, _ = s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsTrue)
+}
+
+func (s *stateSuite) TestStagedRevisionNoConsumers(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createStagedSecret(c, st, time.Now().Add(time.Hour), false)
+
+	c.Assert(s.getRevisionStage(c, st, uri, 2).Status, gc.Equals, coresecrets.StageCommitted)
+	obsolete, _ := s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsTrue)
+}
+
+func (s *stateSuite) TestProcessStagedRevisionsRollsBack(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	now := time.Now().UTC()
+	uri, checksum := s.createStagedSecret(c, st, now.Add(time.Hour), true, "mysql/0", "mysql/1")
+
+	err := st.SaveSecretConsumer(ctx, uri, "mysql/0", &coresecrets.SecretConsumerMetadata{CurrentRevision: 2})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.getRevisionStage(c, st, uri, 2).RollbackOnTimeout, jc.IsTrue)
+
+	// The deadline has not passed.
+	committed, expired, rolledBack, err := st.ProcessStagedRevisions(ctx, now)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(committed, gc.HasLen, 0)
+	c.Check(expired, gc.HasLen, 0)
+	c.Check(rolledBack, gc.HasLen, 0)
+
+	committed, expired, rolledBack, err = st.ProcessStagedRevisions(ctx, now.Add(2*time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(committed, gc.HasLen, 0)
+	c.Check(expired, gc.HasLen, 0)
+	c.Check(rolledBack, jc.DeepEquals, []string{uri.ID})
+
+	// The previous content is restored as revision 3.
+	md, err := st.GetSecret(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(md.LatestRevision, gc.Equals, 3)
+	c.Check(md.LatestRevisionChecksum, gc.Equals, checksum)
+	data, _, err := st.GetSecretValue(ctx, uri, 3)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(data, jc.DeepEquals, coresecrets.SecretData{"password": "old"})
+	data, _, err = st.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(data, jc.DeepEquals, coresecrets.SecretData{"password": "old"})
+	rollback := s.getRevisionMetadata(c, st, uri, 3).Rollback
+	c.Assert(rollback, gc.NotNil)
+	c.Check(rollback.RestoredFromRevision, gc.Equals, 1)
+	c.Check(rollback.ReplacedRevision, gc.Equals, 2)
+
+	stage := s.getRevisionStage(c, st, uri, 2)
+	c.Check(stage.Status, gc.Equals, coresecrets.StageRolledBack)
+	c.Check(stage.PreviousRevision, gc.Equals, 1)
+
+	// The unit which did not move to the staged revision
+	// still tracks the previous revision until it refreshes.
+	consumer, latest, err := st.GetSecretConsumer(ctx, uri, "mysql/1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(consumer.CurrentRevision, gc.Equals, 1)
+	c.Check(latest, gc.Equals, 3)
+
+	// The staged revision is obsoleted once no unit tracks it.
+	obsolete, _ := s.getObsolete(c, uri, 2)
+	c.Check(obsolete, jc.IsFalse)
+	err = st.SaveSecretConsumer(ctx, uri, "mysql/0", &coresecrets.SecretConsumerMetadata{CurrentRevision: 3})
+	c.Assert(err, jc.ErrorIsNil)
+	obsolete, _ = s.getObsolete(c, uri, 2)
+	c.Check(obsolete, jc.IsTrue)
+}
+
+func (s *stateSuite) TestProcessStagedRevisionsExpires(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	now := time.Now().UTC()
+	uri, _ := s.createStagedSecret(c, st, now.Add(time.Hour), false, "mysql/0", "mysql/1")
+
+	err := st.SaveSecretConsumer(ctx, uri, "mysql/0", &coresecrets.SecretConsumerMetadata{CurrentRevision: 2})
+	c.Assert(err, jc.ErrorIsNil)
+
+	committed, expired, rolledBack, err := st.ProcessStagedRevisions(ctx, now.Add(2*time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(committed, gc.HasLen, 0)
+	c.Check(expired, jc.DeepEquals, []string{uri.ID})
+	c.Check(rolledBack, gc.HasLen, 0)
+
+	// The staged revision stays the latest revision.
+	md, err := st.GetSecret(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(md.LatestRevision, gc.Equals, 2)
+	c.Check(s.getRevisionStage(c, st, uri, 2).Status, gc.Equals, coresecrets.StageExpired)
+
+	// The previous revision is obsoleted once no unit tracks it.
+	obsolete, _ := s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsFalse)
+	err = st.SaveSecretConsumer(ctx, uri, "mysql/1", &coresecrets.SecretConsumerMetadata{CurrentRevision: 2})
+	c.Assert(err, jc.ErrorIsNil)
+	obsolete, _ = s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsTrue)
+}
+
+func (s *stateSuite) TestProcessStagedRevisionsCommits(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	now := time.Now().UTC()
+	uri, _ := s.createStagedSecret(c, st, now.Add(time.Hour), false, "mysql/0")
+
+	// The consumer goes away without tracking the staged revision.
+	_, err := s.DB().ExecContext(ctx, "DELETE FROM secret_unit_consumer WHERE secret_id = ?", uri.ID)
+	c.Assert(err, jc.ErrorIsNil)
+
+	committed, expired, rolledBack, err := st.ProcessStagedRevisions(ctx, now.Add(2*time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(committed, jc.DeepEquals, []string{uri.ID})
+	c.Check(expired, gc.HasLen, 0)
+	c.Check(rolledBack, gc.HasLen, 0)
+	c.Check(s.getRevisionStage(c, st, uri, 2).Status, gc.Equals, coresecrets.StageCommitted)
+	obsolete, _ := s.getObsolete(c, uri, 1)
+	c.Check(obsolete, jc.IsTrue)
+}
+
+func (s *stateSuite) TestDeleteStagedRevision(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	uri, _ := s.createStagedSecret(c, st, time.Now().Add(time.Hour), false, "mysql/0")
+
+	err := st.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return st.DeleteSecret(ctx, uri, []int{2})
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var count int
+	row := s.DB().QueryRowContext(context.Background(), "SELECT COUNT(*) FROM secret_revision_stage")
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 0)
+}
@@ -24,21 +24,26 @@ import (
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/uuid"
 )
 
 // State represents database interactions dealing with storage pools.
 type State struct {
 	*domain.StateBase
-	logger logger.Logger
+	controllerKeys envelope.KeyRing
+	logger         logger.Logger
 }
 
 // NewState returns a new secretMetadata state
 // based on the input database factory method.
-func NewState(factory coredatabase.TxnRunnerFactory, logger logger.Logger) *State {
+// Secret content is encrypted with keys wrapped by
+// the active key of controllerKeys.
+func NewState(factory coredatabase.TxnRunnerFactory, controllerKeys envelope.KeyRing, logger logger.Logger) *State {
 	return &State{
-		StateBase: domain.NewStateBase(factory),
-		logger:    logger,
+		StateBase:      domain.NewStateBase(factory),
+		controllerKeys: controllerKeys,
+		logger:         logger,
 	}
 }
 
@@ -82,6 +87,16 @@ func (st State) getModelUUID(ctx context.Context, tx *sqlair.TX) (string, error)
 // GetApplicationUUID returns the UUID of the application with the given name, returning an error satisfying
 // [applicationerrors.ApplicationNotFound] if the application does not exist.
 func (st State) GetApplicationUUID(ctx domain.AtomicContext, appName string) (coreapplication.ID, error) {
+	var appUUID coreapplication.ID
+	err := domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		appUUID, err = st.getApplicationUUID(ctx, tx, appName)
+		return errors.Trace(err)
+	})
+	return appUUID, errors.Trace(err)
+}
+
+func (st State) getApplicationUUID(ctx context.Context, tx *sqlair.TX, appName string) (coreapplication.ID, error) {
 	app := application{Name: appName}
 
 	selectApplicationUUIDStmt, err := st.Prepare(`
@@ -91,18 +106,14 @@ WHERE name=$application.name`, app)
 	if err != nil {
 		return "", errors.Trace(err)
 	}
-
-	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		err = tx.Query(ctx, selectApplicationUUIDStmt, app).Get(&app)
-		if errors.Is(err, sqlair.ErrNoRows) {
-			return fmt.Errorf("application %q not found%w", appName, errors.Hide(applicationerrors.ApplicationNotFound))
-		}
-		if err != nil {
-			return errors.Annotatef(err, "looking up application UUID for %q", appName)
-		}
-		return nil
-	})
-	return app.UUID, errors.Trace(err)
+	err = tx.Query(ctx, selectApplicationUUIDStmt, app).Get(&app)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return "", fmt.Errorf("application %q not found%w", appName, errors.Hide(applicationerrors.ApplicationNotFound))
+	}
+	if err != nil {
+		return "", errors.Annotatef(err, "looking up application UUID for %q", appName)
+	}
+	return app.UUID, nil
 }
 
 // GetUnitUUID returns the UUID of the unit with the given name, returning an error satisfying
@@ -413,6 +424,9 @@ VALUES ($secretID.id)`
 		UpdateTime: now,
 	}
 	updateSecretMetadataFromParams(secret, &dbSecret)
+	if secret.Type != nil {
+		dbSecret.TypeID = int(*secret.Type)
+	}
 	if err := st.upsertSecret(ctx, tx, dbSecret); err != nil {
 		return errors.Annotatef(err, "creating user secret %q", uri)
 	}
@@ -566,6 +580,7 @@ GROUP BY sm.secret_id`
 	}
 	existing := existingResult[0]
 	latestRevisionUUID := dbSecrets[0].LatestRevisionUUID
+	previousRevisionUUID := latestRevisionUUID
 
 	now := time.Now().UTC()
 	dbSecret := secretMetadata{
@@ -606,6 +621,17 @@ GROUP BY sm.secret_id`
 		}
 	}
 
+	if secret.RollbackRevision != nil {
+		if len(secret.Data) > 0 || secret.ValueRef != nil {
+			return errors.NotValidf("rolling back secret %q while also updating its content", uri)
+		}
+		restoredUUID, err := st.rollbackSecret(ctx, tx, uri, *secret.RollbackRevision, now)
+		if err != nil {
+			return errors.Annotatef(err, "rolling back secret %q to revision %d", uri, *secret.RollbackRevision)
+		}
+		latestRevisionUUID = restoredUUID
+	}
+
 	var dbRevision *secretRevision
 	shouldCreateNewRevision := (len(secret.Data) > 0 || secret.ValueRef != nil) && (secret.Checksum != existing.LatestRevisionChecksum ||
 		// migrated charm-owned secrets from old models.
@@ -614,6 +640,11 @@ GROUP BY sm.secret_id`
 		if secret.RevisionID == nil {
 			return errors.Errorf("revision ID must be provided")
 		}
+		if _, err := st.getPendingStage(ctx, tx, uri.ID); err == nil {
+			return fmt.Errorf("secret %q has a pending staged revision%w", uri, errors.Hide(secreterrors.SecretRevisionStagePending))
+		} else if !errors.Is(err, errors.NotFound) {
+			return errors.Trace(err)
+		}
 		latestRevisionUUID = *secret.RevisionID
 		nextRevision := existing.LatestRevision + 1
 		dbRevision = &secretRevision{
@@ -628,6 +659,21 @@ GROUP BY sm.secret_id`
 			return errors.Annotatef(err, "inserting revision for secret %q", uri)
 		}
 	}
+	if dbRevision != nil && secret.StageDeadline != nil {
+		stage := secretRevisionStage{
+			RevisionUUID:             dbRevision.ID,
+			SecretID:                 uri.ID,
+			PreviousRevisionUUID:     previousRevisionUUID,
+			PreviousRevisionChecksum: existing.LatestRevisionChecksum,
+			Deadline:                 secret.StageDeadline.UTC(),
+			RollbackOnTimeout:        secret.RollbackOnTimeout,
+			StatusID:                 int(domainsecret.StagePending),
+			UpdateTime:               now,
+		}
+		if err := st.insertSecretRevisionStage(ctx, tx, stage); err != nil {
+			return errors.Annotatef(err, "staging revision for secret %q", uri)
+		}
+	}
 	if secret.ExpireTime != nil {
 		if err := st.upsertSecretRevisionExpiry(ctx, tx, latestRevisionUUID, secret.ExpireTime); err != nil {
 			return errors.Annotatef(err, "inserting revision expiry for secret %q", uri)
@@ -656,8 +702,14 @@ GROUP BY sm.secret_id`
 // markObsoleteRevisions obsoletes the revisions and sets the pending_delete
 // to true in the secret_revision table for the specified secret if the
 // revision is not the latest revision and there are no consumers for the
-// revision.
+// revision. The revision replaced by a pending staged rotation is retained,
+// so any staged rotation which all consumers now track is committed first.
+// Revisions which consuming applications are pinned to are also retained.
 func (st State) markObsoleteRevisions(ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI) error {
+	if _, err := st.commitStagedRevision(ctx, tx, uri.ID); err != nil {
+		return errors.Trace(err)
+	}
+
 	query, err := st.Prepare(`
 SELECT sr.uuid AS &secretRevision.uuid
 FROM   secret_revision sr
@@ -673,6 +725,17 @@ FROM   secret_revision sr
            -- the latest revision.
            SELECT MAX(revision) FROM secret_revision rev
            WHERE  rev.secret_id = $secretRef.secret_id
+           UNION
+           -- the revision replaced by a pending staged rotation.
+           SELECT prev.revision FROM secret_revision_stage srs
+           JOIN   secret_revision prev ON prev.uuid = srs.previous_revision_uuid
+           WHERE  srs.secret_id = $secretRef.secret_id
+           AND    srs.status_id = 0
+           UNION
+           -- revisions which consuming applications are pinned to.
+           SELECT pinned.revision FROM secret_revision_pin srp
+           JOIN   secret_revision pinned ON pinned.uuid = srp.revision_uuid
+           WHERE  srp.secret_id = $secretRef.secret_id
        ) in_use ON sr.revision = in_use.revision
 WHERE sr.secret_id = $secretRef.secret_id
 AND (in_use.revision IS NULL OR in_use.revision = 0);
@@ -981,17 +1044,28 @@ INSERT INTO secret_content
 VALUES (
     $secretContent.revision_uuid,
     $secretContent.name,
-    $secretContent.content
+    $secretContent.content,
+    $secretContent.key_uuid
 )
 ON CONFLICT(revision_uuid, name) DO UPDATE SET
     name=excluded.name,
-    content=excluded.content`
+    content=excluded.content,
+    key_uuid=excluded.key_uuid`
 
 	insertStmt, err := st.Prepare(insertQuery, secretContent{})
 	if err != nil {
 		return errors.Trace(err)
 	}
 
+	cipher, err := st.newContentCipher()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	keyUUID, err := st.ensureActiveContentKey(ctx, tx, cipher)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	var keys keysToKeep
 	for k := range content {
 		keys = append(keys, k)
@@ -1000,11 +1074,14 @@ ON CONFLICT(revision_uuid, name) DO UPDATE SET
 		return errors.Trace(err)
 	}
 	for key, value := range content {
-		if err := tx.Query(ctx, insertStmt, secretContent{
+		row := secretContent{
 			RevisionUUID: revUUID,
 			Name:         key,
-			Content:      value,
-		}).Run(); err != nil {
+		}
+		if err := cipher.encrypt(&row, keyUUID, value); err != nil {
+			return errors.Trace(err)
+		}
+		if err := tx.Query(ctx, insertStmt, row).Run(); err != nil {
 			return errors.Trace(err)
 		}
 	}
@@ -1242,6 +1319,34 @@ WHERE  sm.secret_id = $secretID.id`, secretID{}, secretInfo{})
 	return coresecrets.RotatePolicy(info.RotatePolicy), nil
 }
 
+// GetSecretType returns the type of the specified secret.
+func (st State) GetSecretType(ctx context.Context, uri *coresecrets.URI) (coresecrets.SecretType, error) {
+	db, err := st.DB()
+	if err != nil {
+		return coresecrets.TypeGeneric, errors.Trace(err)
+	}
+	stmt, err := st.Prepare(`
+SELECT sty.type AS &secretInfo.type
+FROM   secret_metadata sm
+       JOIN secret_type sty ON sty.id = sm.type_id
+WHERE  sm.secret_id = $secretID.id`, secretID{}, secretInfo{})
+	if err != nil {
+		return coresecrets.TypeGeneric, errors.Trace(err)
+	}
+
+	var info secretInfo
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, secretID{ID: uri.ID}).Get(&info)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("secret type for %q not found%w", uri, errors.Hide(secreterrors.SecretNotFound))
+		}
+		return errors.Trace(err)
+	}); err != nil {
+		return coresecrets.TypeGeneric, errors.Trace(err)
+	}
+	return coresecrets.SecretType(info.Type), nil
+}
+
 func (st State) listSecretsAnyOwner(
 	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI,
 ) ([]*coresecrets.SecretMetadata, error) {
@@ -1255,6 +1360,7 @@ SELECT sm.secret_id AS &secretInfo.secret_id,
        sm.create_time AS &secretInfo.create_time,
        sm.update_time AS &secretInfo.update_time,
        rp.policy AS &secretInfo.policy,
+       sty.type AS &secretInfo.type,
        sro.next_rotation_time AS &secretInfo.next_rotation_time,
        sre.expire_time AS &secretInfo.latest_expire_time,
        MAX(sr.revision) AS &secretInfo.latest_revision,
@@ -1265,6 +1371,7 @@ FROM   secret_metadata sm
        JOIN secret_revision sr ON sm.secret_id = sr.secret_id
        LEFT JOIN secret_revision_expire sre ON sre.revision_uuid = sr.uuid
        LEFT JOIN secret_rotate_policy rp ON rp.id = sm.rotate_policy_id
+       LEFT JOIN secret_type sty ON sty.id = sm.type_id
        LEFT JOIN secret_rotation sro ON sro.secret_id = sm.secret_id
        LEFT JOIN (
           SELECT $ownerKind.model_owner_kind AS owner_kind, (SELECT uuid FROM model) AS owner_id, label, secret_id
@@ -1386,6 +1493,7 @@ SELECT sm.secret_id AS &secretInfo.secret_id,
        sm.description AS &secretInfo.description,
        sm.auto_prune AS &secretInfo.auto_prune,
        rp.policy AS &secretInfo.policy,
+       sty.type AS &secretInfo.type,
        sro.next_rotation_time AS &secretInfo.next_rotation_time,
        sre.expire_time AS &secretInfo.latest_expire_time,
        sm.latest_revision_checksum AS &secretInfo.latest_revision_checksum,
@@ -1399,6 +1507,7 @@ FROM   secret_metadata sm
        JOIN secret_revision sr ON sr.secret_id = sm.secret_id
        LEFT JOIN secret_revision_expire sre ON sre.revision_uuid = sr.uuid
        LEFT JOIN secret_rotate_policy rp ON rp.id = sm.rotate_policy_id
+       LEFT JOIN secret_type sty ON sty.id = sm.type_id
        LEFT JOIN secret_rotation sro ON sro.secret_id = sm.secret_id`
 
 	queryParts = append(queryParts, query)
@@ -1681,19 +1790,27 @@ func (st State) listSecretRevisions(
 	query := `
 SELECT (sr.*) AS (&secretRevision.*),
        (svr.*) AS (&secretValueRef.*),
-       (sre.*) AS (&secretRevisionExpire.*)
+       (sre.*) AS (&secretRevisionExpire.*),
+       (srs.revision_uuid, srs.status_id, srs.deadline, srs.rollback_on_timeout) AS (&revisionStage.*),
+       prev.revision AS &revisionStage.previous_revision,
+       (srr.*) AS (&secretRevisionRollback.*)
 FROM   secret_revision sr
        LEFT JOIN secret_revision_expire sre ON sre.revision_uuid = sr.uuid
        LEFT JOIN secret_value_ref svr ON svr.revision_uuid = sr.uuid
-WHERE  secret_id = $secretRevision.secret_id
+       LEFT JOIN secret_revision_stage srs ON srs.revision_uuid = sr.uuid
+       LEFT JOIN secret_revision prev ON prev.uuid = srs.previous_revision_uuid
+       LEFT JOIN secret_revision_rollback srr ON srr.revision_uuid = sr.uuid
+WHERE  sr.secret_id = $secretRevision.secret_id
 `
 	want := secretRevision{SecretID: uri.ID}
 	if revision != nil {
-		query = query + "\nAND revision = $secretRevision.revision"
+		query = query + "\nAND sr.revision = $secretRevision.revision"
 		want.Revision = *revision
 	}
 
-	queryStmt, err := st.Prepare(query, secretRevision{}, secretRevisionExpire{}, secretValueRef{})
+	queryStmt, err := st.Prepare(query,
+		secretRevision{}, secretRevisionExpire{}, secretValueRef{}, revisionStage{}, secretRevisionRollback{},
+	)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1702,13 +1819,24 @@ WHERE  secret_id = $secretRevision.secret_id
 		dbSecretRevisions       secretRevisions
 		dbSecretValueRefs       secretValueRefs
 		dbSecretRevisionsExpire secretRevisionsExpire
+		dbRevisionStages        revisionStages
+		dbRevisionRollbacks     secretRevisionRollbacks
+	)
+	err = tx.Query(ctx, queryStmt, want).GetAll(
+		&dbSecretRevisions, &dbSecretValueRefs, &dbSecretRevisionsExpire, &dbRevisionStages, &dbRevisionRollbacks,
 	)
-	err = tx.Query(ctx, queryStmt, want).GetAll(&dbSecretRevisions, &dbSecretValueRefs, &dbSecretRevisionsExpire)
 	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 		return nil, errors.Annotatef(err, "retrieving secret revisions for %q", uri)
 	}
 
-	return dbSecretRevisions.toSecretRevisions(dbSecretValueRefs, dbSecretRevisionsExpire)
+	pins, err := st.getRevisionPins(ctx, tx, uri.ID)
+	if err != nil {
+		return nil, errors.Annotatef(err, "retrieving pinned revisions for %q", uri)
+	}
+
+	return dbSecretRevisions.toSecretRevisions(
+		dbSecretValueRefs, dbSecretRevisionsExpire, dbRevisionStages, dbRevisionRollbacks, pins,
+	)
 }
 
 // GetSecretValue returns the contents - either data or value reference - of a
@@ -1751,6 +1879,7 @@ AND    rev.revision = $secretRevision.revision`
 
 	var (
 		dbSecretValues    secretValues
+		dbContentKeys     map[string]secretContentKey
 		dbSecretValueRefs []secretValueRef
 	)
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
@@ -1760,7 +1889,8 @@ AND    rev.revision = $secretRevision.revision`
 		}
 		// Do we have content from the db?
 		if len(dbSecretValues) > 0 {
-			return nil
+			dbContentKeys, err = st.getContentKeys(ctx, tx, dbSecretValues.keyUUIDs())
+			return errors.Annotatef(err, "retrieving secret content keys for %q revision %d", uri, revision)
 		}
 
 		// No content, try a value reference.
@@ -1775,7 +1905,14 @@ AND    rev.revision = $secretRevision.revision`
 
 	// Compose and return any secret content from the db.
 	if len(dbSecretValues) > 0 {
-		content := dbSecretValues.toSecretData()
+		cipher, err := st.newContentCipher()
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		content, err := cipher.toSecretData(dbSecretValues, dbContentKeys)
+		if err != nil {
+			return nil, nil, errors.Annotatef(err, "reading secret value for %q revision %d", uri, revision)
+		}
 		return content, nil, nil
 	}
 
@@ -1832,6 +1969,8 @@ FROM (SELECT * FROM local UNION SELECT * FROM remote)`
 
 // GetSecretConsumer returns the secret consumer info for the specified unit
 // and secret, along withthe latest revision for the secret.
+// If the unit's application is pinned to a revision of the secret, that
+// revision is returned as the latest revision.
 // If the unit does not exist, an error satisfying [applicationerrors.UnitNotFound] is
 // returned.If the secret does not exist, an error satisfying
 // [secreterrors.SecretNotFound] is returned.
@@ -1918,6 +2057,17 @@ WHERE  ref.secret_id = $secretRef.secret_id`
 		}
 		latestRevision = latest.Revision
 
+		if !isLocal {
+			return nil
+		}
+		// Units of an application pinned to a revision are not
+		// offered anything later than that revision.
+		pinned, err := st.getUnitPinnedRevision(ctx, tx, uri.ID, consumer.UnitUUID)
+		if err == nil {
+			latestRevision = pinned
+		} else if !errors.Is(err, errors.NotFound) {
+			return errors.Trace(err)
+		}
 		return nil
 	})
 	if err != nil {
@@ -2848,6 +2998,36 @@ WHERE  secret_id = $secretRevision.secret_id
 	return secretRev.ID, nil
 }
 
+// CountSecretValueRefRevisions returns the number of secret revisions whose
+// content is stored in a backend under the specified value reference. A
+// revision restored by a rollback shares the value reference of the revision
+// it was restored from.
+func (st State) CountSecretValueRefRevisions(ctx context.Context, ref *coresecrets.ValueRef) (int, error) {
+	db, err := st.DB()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT COUNT(*) AS &count.num
+FROM   secret_value_ref
+WHERE  backend_uuid = $secretValueRef.backend_uuid
+AND    revision_id = $secretValueRef.revision_id`, count{}, secretValueRef{})
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	valueRef := secretValueRef{BackendUUID: ref.BackendID, RevisionID: ref.RevisionID}
+	var result count
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Trace(tx.Query(ctx, stmt, valueRef).Get(&result))
+	})
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return result.Num, nil
+}
+
 type dbrevisionUUIDs []revisionUUID
 
 // InitialWatchStatementForConsumedSecretsChange returns the initial watch
@@ -3429,6 +3609,17 @@ DELETE FROM secret_value_ref WHERE revision_uuid IN ($revisionUUIDs[:])`
 	deleteRevisionObsolete := `
 DELETE FROM secret_revision_obsolete WHERE revision_uuid IN ($revisionUUIDs[:])`
 
+	deleteRevisionStage := `
+DELETE FROM secret_revision_stage
+WHERE  revision_uuid IN ($revisionUUIDs[:])
+OR     previous_revision_uuid IN ($revisionUUIDs[:])`
+
+	deleteRevisionRollback := `
+DELETE FROM secret_revision_rollback WHERE revision_uuid IN ($revisionUUIDs[:])`
+
+	deleteRevisionPin := `
+DELETE FROM secret_revision_pin WHERE revision_uuid IN ($revisionUUIDs[:])`
+
 	deleteRevision := `
 DELETE FROM secret_revision WHERE uuid IN ($revisionUUIDs[:])`
 
@@ -3437,6 +3628,9 @@ DELETE FROM secret_revision WHERE uuid IN ($revisionUUIDs[:])`
 		deleteRevisionContent,
 		deleteRevisionValueRef,
 		deleteRevisionObsolete,
+		deleteRevisionStage,
+		deleteRevisionRollback,
+		deleteRevisionPin,
 		deleteRevision,
 	}
 
@@ -3508,6 +3702,8 @@ DELETE FROM secret_remote_unit_consumer WHERE secret_id = $secretID.id`
 DELETE FROM secret_reference WHERE secret_id = $secretID.id`
 	deleteSecretPermission := `
 DELETE FROM secret_permission WHERE secret_id = $secretID.id`
+	deleteSecretAccessLog := `
+DELETE FROM secret_access_log WHERE secret_id = $secretID.id`
 	deleteSecretMetadata := `
 DELETE FROM secret_metadata WHERE secret_id = $secretID.id`
 	deleteSecret := `
@@ -3522,6 +3718,7 @@ DELETE FROM secret WHERE id = $secretID.id`
 		deleteSecretRemoteUnitConsumer,
 		deleteSecretRef,
 		deleteSecretPermission,
+		deleteSecretAccessLog,
 		deleteSecretMetadata,
 		deleteSecret,
 	}
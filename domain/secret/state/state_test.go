@@ -23,6 +23,7 @@ import (
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -35,10 +36,19 @@ type stateSuite struct {
 
 var _ = gc.Suite(&stateSuite{})
 
+var testControllerKey = []byte("0123456789abcdef0123456789abcdef")
+
 func newSecretState(c *gc.C, factory coredatabase.TxnRunnerFactory) *State {
+	return newSecretStateWithKeys(c, factory, testControllerKey)
+}
+
+func newSecretStateWithKeys(c *gc.C, factory coredatabase.TxnRunnerFactory, keys ...[]byte) *State {
+	controllerKeys, err := envelope.NewKeyRing(keys...)
+	c.Assert(err, jc.ErrorIsNil)
 	return &State{
-		StateBase: domain.NewStateBase(factory),
-		logger:    loggertesting.WrapCheckLog(c),
+		StateBase:      domain.NewStateBase(factory),
+		controllerKeys: controllerKeys,
+		logger:         loggertesting.WrapCheckLog(c),
 	}
 }
 
@@ -265,6 +275,80 @@ func (s *stateSuite) TestGetRotatePolicyNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
 }
 
+func (s *stateSuite) TestGetSecretType(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	sp := domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"password": "c2VjcmV0"},
+		Type:       ptr(domainsecret.TypePassword),
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := st.GetSecretType(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.Equals, coresecrets.TypePassword)
+}
+
+func (s *stateSuite) TestGetSecretTypeGeneric(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	sp := domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := st.GetSecretType(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.Equals, coresecrets.TypeGeneric)
+
+	secrets, _, err := st.ListSecrets(ctx, uri, domainsecret.NilRevision, domainsecret.NilLabels)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(secrets, gc.HasLen, 1)
+	c.Assert(secrets[0].Type, gc.Equals, coresecrets.TypeGeneric)
+}
+
+func (s *stateSuite) TestGetSecretTypeNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	_, err := st.GetSecretType(context.Background(), coresecrets.NewURI())
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *stateSuite) TestListSecretsType(c *gc.C) {
+	s.setupUnits(c, "mysql")
+
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	sp := domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"password": "c2VjcmV0"},
+		Type:       ptr(domainsecret.TypePassword),
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createCharmApplicationSecret(ctx, st, 1, uri, "mysql", sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	secrets, _, err := st.ListSecrets(ctx, uri, domainsecret.NilRevision, domainsecret.NilLabels)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(secrets, gc.HasLen, 1)
+	c.Assert(secrets[0].Type, gc.Equals, coresecrets.TypePassword)
+
+	owners := domainsecret.ApplicationOwners{"mysql"}
+	secrets, _, err = st.ListCharmSecrets(ctx, owners, domainsecret.NilUnitOwners)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(secrets, gc.HasLen, 1)
+	c.Assert(secrets[0].Type, gc.Equals, coresecrets.TypePassword)
+}
+
 func (s *stateSuite) TestGetRotationExpiryInfo(c *gc.C) {
 	s.setupUnits(c, "mysql")
 
@@ -4,6 +4,7 @@
 package state
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -56,6 +57,7 @@ type secretMetadata struct {
 	Description            string    `db:"description"`
 	AutoPrune              bool      `db:"auto_prune"`
 	RotatePolicyID         int       `db:"rotate_policy_id"`
+	TypeID                 int       `db:"type_id"`
 	CreateTime             time.Time `db:"create_time"`
 	UpdateTime             time.Time `db:"update_time"`
 	LatestRevisionChecksum string    `db:"latest_revision_checksum"`
@@ -67,6 +69,7 @@ type secretInfo struct {
 	Version                int       `db:"version"`
 	Description            string    `db:"description"`
 	RotatePolicy           string    `db:"policy"`
+	Type                   string    `db:"type"`
 	AutoPrune              bool      `db:"auto_prune"`
 	LatestRevisionChecksum string    `db:"latest_revision_checksum"`
 	CreateTime             time.Time `db:"create_time"`
@@ -138,10 +141,111 @@ type secretRevisionExpireChange struct {
 	ExpireTime   time.Time `db:"expire_time"`
 }
 
+type secretRevisionStage struct {
+	RevisionUUID             string    `db:"revision_uuid"`
+	SecretID                 string    `db:"secret_id"`
+	PreviousRevisionUUID     string    `db:"previous_revision_uuid"`
+	PreviousRevisionChecksum string    `db:"previous_revision_checksum"`
+	Deadline                 time.Time `db:"deadline"`
+	RollbackOnTimeout        bool      `db:"rollback_on_timeout"`
+	StatusID                 int       `db:"status_id"`
+	UpdateTime               time.Time `db:"update_time"`
+}
+
+// pendingStage holds a pending staged rotation along with
+// the revision numbers of the staged and previous revisions.
+type pendingStage struct {
+	RevisionUUID             string    `db:"revision_uuid"`
+	SecretID                 string    `db:"secret_id"`
+	Revision                 int       `db:"revision"`
+	PreviousRevisionUUID     string    `db:"previous_revision_uuid"`
+	PreviousRevision         int       `db:"previous_revision"`
+	PreviousRevisionChecksum string    `db:"previous_revision_checksum"`
+	Deadline                 time.Time `db:"deadline"`
+	RollbackOnTimeout        bool      `db:"rollback_on_timeout"`
+}
+
+// restoredRevision holds the checksum of the latest revision
+// of a secret once an existing revision has been restored.
+type restoredRevision struct {
+	SecretID   string    `db:"secret_id"`
+	Checksum   string    `db:"checksum"`
+	UpdateTime time.Time `db:"update_time"`
+}
+
+// revisionStage holds the stage of a revision, if any,
+// when listing secret revisions.
+type revisionStage struct {
+	RevisionUUID      string    `db:"revision_uuid"`
+	StatusID          int       `db:"status_id"`
+	PreviousRevision  int       `db:"previous_revision"`
+	Deadline          time.Time `db:"deadline"`
+	RollbackOnTimeout bool      `db:"rollback_on_timeout"`
+}
+
+type revisionStages []revisionStage
+
+type secretRevisionRollback struct {
+	RevisionUUID         string    `db:"revision_uuid"`
+	SecretID             string    `db:"secret_id"`
+	RestoredFromRevision int       `db:"restored_from_revision"`
+	ReplacedRevision     int       `db:"replaced_revision"`
+	RollbackTime         time.Time `db:"rollback_time"`
+}
+
+type secretRevisionRollbacks []secretRevisionRollback
+
+// retainedRevision holds a revision of a secret along
+// with whether it is obsolete.
+type retainedRevision struct {
+	UUID     string `db:"uuid"`
+	SecretID string `db:"secret_id"`
+	Revision int    `db:"revision"`
+	Obsolete bool   `db:"obsolete"`
+}
+
+type secretRevisionPin struct {
+	SecretID        string `db:"secret_id"`
+	ApplicationUUID string `db:"application_uuid"`
+	RevisionUUID    string `db:"revision_uuid"`
+}
+
+// pinnedRevision holds the revision number which the
+// units of an application are pinned to.
+type pinnedRevision struct {
+	SecretID        string `db:"secret_id"`
+	ApplicationUUID string `db:"application_uuid"`
+	Revision        int    `db:"revision"`
+}
+
+// revisionPin holds the name of an application pinned
+// to a revision when listing secret revisions.
+type revisionPin struct {
+	RevisionUUID    string `db:"revision_uuid"`
+	ApplicationName string `db:"name"`
+}
+
+type revisionPins []revisionPin
+
 type secretContent struct {
-	RevisionUUID string `db:"revision_uuid"`
-	Name         string `db:"name"`
-	Content      string `db:"content"`
+	RevisionUUID string         `db:"revision_uuid"`
+	Name         string         `db:"name"`
+	Content      string         `db:"content"`
+	KeyUUID      sql.NullString `db:"key_uuid"`
+}
+
+type secretContentKey struct {
+	UUID            string    `db:"uuid"`
+	WrappedKey      string    `db:"wrapped_key"`
+	ControllerKeyID string    `db:"controller_key_id"`
+	Active          bool      `db:"active"`
+	CreateTime      time.Time `db:"create_time"`
+}
+
+type secretContentKeyUUIDs []string
+
+type contentLimit struct {
+	Limit int `db:"limit"`
 }
 
 type secretValueRef struct {
@@ -258,6 +362,7 @@ func (rows secretInfos) toSecretMetadata(secretOwners []secretOwner) ([]*coresec
 			LatestRevisionChecksum: row.LatestRevisionChecksum,
 			AutoPrune:              row.AutoPrune,
 			RotatePolicy:           coresecrets.RotatePolicy(row.RotatePolicy),
+			Type:                   coresecrets.SecretType(row.Type),
 		}
 		if tm := row.NextRotateTime; !tm.IsZero() {
 			result[i].NextRotateTime = &tm
@@ -347,13 +452,19 @@ type secretRevisions []secretRevision
 type secretRevisionsExpire []secretRevisionExpire
 
 func (rows secretRevisions) toSecretRevisions(
-	valueRefs secretValueRefs, revExpire secretRevisionsExpire,
+	valueRefs secretValueRefs, revExpire secretRevisionsExpire, stages revisionStages,
+	rollbacks secretRevisionRollbacks, pins revisionPins,
 ) ([]*coresecrets.SecretRevisionMetadata, error) {
-	if n := len(rows); n != len(valueRefs) || n != len(revExpire) {
+	if n := len(rows); n != len(valueRefs) || n != len(revExpire) || n != len(stages) || n != len(rollbacks) {
 		// Should never happen.
 		return nil, errors.New("row length mismatch composing secret revision results")
 	}
 
+	pinned := make(map[string][]string)
+	for _, pin := range pins {
+		pinned[pin.RevisionUUID] = append(pinned[pin.RevisionUUID], pin.ApplicationName)
+	}
+
 	result := make([]*coresecrets.SecretRevisionMetadata, len(rows))
 	for i, row := range rows {
 		result[i] = &coresecrets.SecretRevisionMetadata{
@@ -371,16 +482,39 @@ func (rows secretRevisions) toSecretRevisions(
 				RevisionID: v.RevisionID,
 			}
 		}
+		if st := stages[i]; st.RevisionUUID != "" {
+			result[i].Stage = &coresecrets.RevisionStage{
+				Status:            domainsecret.StageStatus(st.StatusID).ToCore(),
+				PreviousRevision:  st.PreviousRevision,
+				Deadline:          st.Deadline,
+				RollbackOnTimeout: st.RollbackOnTimeout,
+			}
+		}
+		if rb := rollbacks[i]; rb.RevisionUUID != "" {
+			result[i].Rollback = &coresecrets.RevisionRollback{
+				RestoredFromRevision: rb.RestoredFromRevision,
+				ReplacedRevision:     rb.ReplacedRevision,
+				Time:                 rb.RollbackTime,
+			}
+		}
+		result[i].PinnedApplications = pinned[row.ID]
 	}
 	return result, nil
 }
 
 type secretValues []secretContent
 
-func (rows secretValues) toSecretData() coresecrets.SecretData {
-	result := make(coresecrets.SecretData)
+// keyUUIDs returns the distinct uuids of the keys used to
+// encrypt the content.
+func (rows secretValues) keyUUIDs() secretContentKeyUUIDs {
+	seen := make(map[string]bool)
+	var result secretContentKeyUUIDs
 	for _, row := range rows {
-		result[row.Name] = row.Content
+		if !row.KeyUUID.Valid || seen[row.KeyUUID.String] {
+			continue
+		}
+		seen[row.KeyUUID.String] = true
+		result = append(result, row.KeyUUID.String)
 	}
 	return result
 }
@@ -503,3 +637,33 @@ type count struct {
 	// Num is the number of rows.
 	Num int `db:"num"`
 }
+
+type secretAccessLog struct {
+	UUID           string    `db:"uuid"`
+	SecretID       string    `db:"secret_id"`
+	Revision       int       `db:"revision"`
+	AccessorTypeID int       `db:"accessor_type_id"`
+	AccessorID     string    `db:"accessor_id"`
+	AccessTime     time.Time `db:"access_time"`
+}
+
+type secretAccessLogs []secretAccessLog
+
+func (rows secretAccessLogs) toAccessRecords() []domainsecret.AccessRecord {
+	result := make([]domainsecret.AccessRecord, len(rows))
+	for i, row := range rows {
+		result[i] = domainsecret.AccessRecord{
+			Revision:     row.Revision,
+			AccessorType: domainsecret.AccessorType(row.AccessorTypeID),
+			AccessorID:   row.AccessorID,
+			AccessTime:   row.AccessTime,
+		}
+	}
+	return result
+}
+
+// accessLogLimit is used to prune the access log of a secret.
+type accessLogLimit struct {
+	SecretID   string `db:"secret_id"`
+	MaxEntries int    `db:"max_entries"`
+}
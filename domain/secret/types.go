@@ -41,13 +41,25 @@ type Owner struct {
 // UpsertSecretParams are used to upsert a secret.
 // Only non-nil values are used.
 type UpsertSecretParams struct {
-	RevisionID     *string
-	RotatePolicy   *RotatePolicy
+	RevisionID   *string
+	RotatePolicy *RotatePolicy
+	// Type is only used when creating a secret.
+	Type           *SecretType
 	ExpireTime     *time.Time
 	NextRotateTime *time.Time
 	Description    *string
 	Label          *string
 	AutoPrune      *bool
+	// StageDeadline is set if a new revision is to be staged,
+	// and is when the rotation expires if consumers have not
+	// all started tracking the new revision.
+	StageDeadline *time.Time
+	// RollbackOnTimeout is set if the previous revision of a
+	// staged rotation is to be restored when the rotation expires.
+	RollbackOnTimeout bool
+	// RollbackRevision is set if the secret is to be rolled
+	// back to the specified existing revision.
+	RollbackRevision *int
 
 	Data     secrets.SecretData
 	ValueRef *secrets.ValueRef
@@ -63,7 +75,8 @@ func (u *UpsertSecretParams) HasUpdate() bool {
 		u.ExpireTime != nil ||
 		len(u.Data) > 0 ||
 		u.ValueRef != nil ||
-		u.AutoPrune != nil
+		u.AutoPrune != nil ||
+		u.RollbackRevision != nil
 }
 
 // GrantParams are used when granting access to a secret.
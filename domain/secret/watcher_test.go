@@ -32,6 +32,7 @@ import (
 	"github.com/juju/juju/internal/changestream/testing"
 	"github.com/juju/juju/internal/charm"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/storage"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
@@ -894,7 +895,7 @@ func (s *watcherSuite) setupUnits(c *gc.C, appName string) {
 
 func (s *watcherSuite) setupServiceAndState(c *gc.C) (*service.WatchableService, *state.State) {
 	logger := loggertesting.WrapCheckLog(c)
-	st := state.NewState(s.TxnRunnerFactory(), logger)
+	st := state.NewState(s.TxnRunnerFactory(), testControllerKeys(c), logger)
 	factory := domain.NewWatcherFactory(
 		changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "secret_revision"),
 		logger,
@@ -902,6 +903,14 @@ func (s *watcherSuite) setupServiceAndState(c *gc.C) (*service.WatchableService,
 	return service.NewWatchableService(st, nil, nil, factory, logger, service.SecretServiceParams{}), st
 }
 
+// testControllerKeys returns a fixed key ring for wrapping
+// the keys which encrypt secret content.
+func testControllerKeys(c *gc.C) envelope.KeyRing {
+	keys, err := envelope.NewKeyRing([]byte("0123456789abcdef0123456789abcdef"))
+	c.Assert(err, jc.ErrorIsNil)
+	return keys
+}
+
 func revID(uri *coresecrets.URI, rev int) string {
 	return fmt.Sprintf("%s/%d", uri.ID, rev)
 }
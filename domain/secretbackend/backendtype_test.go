@@ -8,6 +8,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/secrets/provider/file"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/secrets/provider/vault"
@@ -41,5 +42,6 @@ func (s *backendtypeSuite) TestBackendTypeDBValues(c *gc.C) {
 		BackendTypeController: juju.BackendType,
 		BackendTypeKubernetes: kubernetes.BackendType,
 		BackendTypeVault:      vault.BackendType,
+		BackendTypeFile:       file.BackendType,
 	})
 }
@@ -589,6 +589,17 @@ func (s *Service) RotateBackendToken(ctx context.Context, backendID string) erro
 		if err == nil {
 			next, _ := coresecrets.NextBackendRotateTime(s.clock.Now(), *backendInfo.TokenRotateInterval)
 			nextRotateTime = *next
+			// Refresh the auth again before it expires, if that is
+			// sooner than the rotate interval.
+			if expiring, ok := p.(provider.SupportAuthExpiry); ok {
+				if expiry := expiring.AuthExpiry(*auth); !expiry.IsZero() {
+					now := s.clock.Now()
+					refreshAt := now.Add(expiry.Sub(now) * 3 / 4)
+					if refreshAt.Before(nextRotateTime) {
+						nextRotateTime = refreshAt
+					}
+				}
+			}
 		}
 	}
 
@@ -79,6 +79,15 @@ func (providerWithConfig) RefreshAuth(_ context.Context, cfg provider.BackendCon
 	return &result, nil
 }
 
+type providerWithExpiry struct {
+	providerWithConfig
+	expiry time.Time
+}
+
+func (p providerWithExpiry) AuthExpiry(provider.BackendConfig) time.Time {
+	return p.expiry
+}
+
 var (
 	jujuBackendID  = utils.MustNewUUID().String()
 	k8sBackendID   = utils.MustNewUUID().String()
@@ -1347,6 +1356,51 @@ func (s *serviceSuite) TestRotateBackendToken(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestRotateBackendTokenAuthExpiry(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	now := s.clock.Now()
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return providerWithExpiry{
+				providerWithConfig: providerWithConfig{
+					SecretBackendProvider: s.mockRegistry,
+				},
+				expiry: now.Add(40 * time.Minute),
+			}, nil
+		},
+	)
+
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: "backend-uuid"}).Return(&secretbackend.SecretBackend{
+		ID:                  "backend-uuid",
+		Name:                "myvault",
+		BackendType:         vault.BackendType,
+		TokenRotateInterval: ptr(200 * time.Minute),
+		Config: map[string]any{
+			"endpoint": "http://vault",
+		},
+	}, nil)
+	s.mockState.EXPECT().UpdateSecretBackend(gomock.Any(), secretbackend.UpdateSecretBackendParams{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID: "backend-uuid",
+		},
+		Config: map[string]string{
+			"endpoint": "http://vault",
+			"token":    "3h20m0s",
+		},
+	}).Return("", nil)
+
+	// The auth expires before the rotate interval, so it is
+	// refreshed again three quarters of the way to its expiry.
+	nextRotateTime := now.Add(30 * time.Minute)
+	s.mockState.EXPECT().SecretBackendRotated(gomock.Any(), "backend-uuid", nextRotateTime).Return(nil)
+
+	err := svc.RotateBackendToken(context.Background(), "backend-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestRotateBackendTokenRetry(c *gc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
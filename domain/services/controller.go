@@ -13,6 +13,8 @@ import (
 	accessstate "github.com/juju/juju/domain/access/state"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
 	autocertcachestate "github.com/juju/juju/domain/autocert/state"
+	backupservice "github.com/juju/juju/domain/backup/service"
+	backupstate "github.com/juju/juju/domain/backup/state"
 	cloudservice "github.com/juju/juju/domain/cloud/service"
 	cloudstate "github.com/juju/juju/domain/cloud/state"
 	controllerservice "github.com/juju/juju/domain/controller/service"
@@ -176,3 +178,12 @@ func (s *ControllerServices) Macaroon() *macaroonservice.Service {
 		s.clock,
 	)
 }
+
+// Backup returns the service for taking and restoring snapshots of the
+// controller database.
+func (s *ControllerServices) Backup() *backupservice.Service {
+	return backupservice.NewService(
+		backupstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.logger.Child("backup"),
+	)
+}
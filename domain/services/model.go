@@ -25,10 +25,14 @@ import (
 	charmstore "github.com/juju/juju/domain/application/charm/store"
 	applicationservice "github.com/juju/juju/domain/application/service"
 	applicationstate "github.com/juju/juju/domain/application/state"
+	backupservice "github.com/juju/juju/domain/backup/service"
+	backupstate "github.com/juju/juju/domain/backup/state"
 	blockcommandservice "github.com/juju/juju/domain/blockcommand/service"
 	blockcommandstate "github.com/juju/juju/domain/blockcommand/state"
 	blockdeviceservice "github.com/juju/juju/domain/blockdevice/service"
 	blockdevicestate "github.com/juju/juju/domain/blockdevice/state"
+	changelogservice "github.com/juju/juju/domain/changelog/service"
+	changelogstate "github.com/juju/juju/domain/changelog/state"
 	cloudimagemetadataservice "github.com/juju/juju/domain/cloudimagemetadata/service"
 	cloudimagemetadatastate "github.com/juju/juju/domain/cloudimagemetadata/state"
 	containerimageresourcestoreservice "github.com/juju/juju/domain/containerimageresourcestore/service"
@@ -51,6 +55,8 @@ import (
 	modelmigrationstate "github.com/juju/juju/domain/modelmigration/state"
 	networkservice "github.com/juju/juju/domain/network/service"
 	networkstate "github.com/juju/juju/domain/network/state"
+	objectstoreservice "github.com/juju/juju/domain/objectstore/service"
+	objectstorestate "github.com/juju/juju/domain/objectstore/state"
 	portservice "github.com/juju/juju/domain/port/service"
 	portstate "github.com/juju/juju/domain/port/state"
 	proxy "github.com/juju/juju/domain/proxy/service"
@@ -69,8 +75,11 @@ import (
 	stubservice "github.com/juju/juju/domain/stub"
 	unitstateservice "github.com/juju/juju/domain/unitstate/service"
 	unitstatestate "github.com/juju/juju/domain/unitstate/state"
+	workloadmetricsservice "github.com/juju/juju/domain/workloadmetrics/service"
+	workloadmetricsstate "github.com/juju/juju/domain/workloadmetrics/state"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/resource/store"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 // PublicKeyImporter describes a service that is capable of fetching and
@@ -97,6 +106,7 @@ type ModelServices struct {
 	objectstore       objectstore.ModelObjectStoreGetter
 	storageRegistry   corestorage.ModelStorageRegistryGetter
 	publicKeyImporter PublicKeyImporter
+	secretContentKeys envelope.KeyRing
 	leaseManager      lease.ModelLeaseManagerGetter
 }
 
@@ -110,6 +120,7 @@ func NewModelServices(
 	objectStore objectstore.ModelObjectStoreGetter,
 	storageRegistry corestorage.ModelStorageRegistryGetter,
 	publicKeyImporter PublicKeyImporter,
+	secretContentKeys envelope.KeyRing,
 	leaseManager lease.ModelLeaseManagerGetter,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -128,6 +139,7 @@ func NewModelServices(
 		objectstore:       objectStore,
 		storageRegistry:   storageRegistry,
 		publicKeyImporter: publicKeyImporter,
+		secretContentKeys: secretContentKeys,
 		leaseManager:      leaseManager,
 	}
 }
@@ -259,9 +271,10 @@ func (s *ModelServices) Storage() *storageservice.Service {
 // Secret returns the model's secret service.
 func (s *ModelServices) Secret(params secretservice.SecretServiceParams) *secretservice.WatchableService {
 	log := s.logger.Child("secret")
+	backendState := secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log)
 	return secretservice.NewWatchableService(
-		secretstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), log),
-		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
+		secretstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.secretContentKeys, log),
+		backendState,
 		domain.NewLeaseService(s.leaseManager),
 		s.modelWatcherFactory("secret"),
 		log,
@@ -344,6 +357,15 @@ func (s *ModelServices) Port() *portservice.WatchableService {
 	)
 }
 
+// ModelBackup returns the service for taking and restoring snapshots of the
+// model database.
+func (s *ModelServices) ModelBackup() *backupservice.Service {
+	return backupservice.NewService(
+		backupstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.logger.Child("backup"),
+	)
+}
+
 // BlockCommand returns the service for blocking commands.
 func (s *ModelServices) BlockCommand() *blockcommandservice.Service {
 	return blockcommandservice.NewService(
@@ -352,6 +374,16 @@ func (s *ModelServices) BlockCommand() *blockcommandservice.Service {
 	)
 }
 
+// ChangeLog returns the service for reading and watching the model change
+// log, for external consumers of model changes.
+func (s *ModelServices) ChangeLog() *changelogservice.WatchableService {
+	return changelogservice.NewWatchableService(
+		changelogstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.modelWatcherFactory("changelog"),
+		s.logger.Child("changelog"),
+	)
+}
+
 // Resource returns the service for persisting and retrieving application
 // resources for the current model.
 func (s *ModelServices) Resource() *resourceservice.Service {
@@ -403,6 +435,23 @@ func (s *ModelServices) Removal() *removalservice.WatchableService {
 	)
 }
 
+// ObjectStoreUsage returns the service for accounting for the storage used by
+// the model's object store.
+func (s *ModelServices) ObjectStoreUsage() *objectstoreservice.UsageService {
+	return objectstoreservice.NewUsageService(
+		objectstorestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+	)
+}
+
+// WorkloadMetrics returns the service for recording and retrieving the
+// workload metrics published by charms.
+func (s *ModelServices) WorkloadMetrics() *workloadmetricsservice.Service {
+	return workloadmetricsservice.NewService(
+		workloadmetricsstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.clock,
+	)
+}
+
 // Stub returns the stub service. A special service which collects temporary
 // methods required to wire together domains which are not completely implemented
 // or wired up.
@@ -52,6 +52,22 @@ func (s *ObjectStoreServices) AgentObjectStore() *objectstoreservice.WatchableSe
 	)
 }
 
+// ObjectStoreBlobs returns the service for tracking references to the blobs
+// in the controller-wide blob store.
+func (s *ObjectStoreServices) ObjectStoreBlobs() *objectstoreservice.BlobService {
+	return objectstoreservice.NewBlobService(
+		objectstorestate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+	)
+}
+
+// ObjectStoreCharms returns the service for locating the origin of the charm
+// archives in the model's object store.
+func (s *ObjectStoreServices) ObjectStoreCharms() *objectstoreservice.CharmService {
+	return objectstoreservice.NewCharmService(
+		objectstorestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
+	)
+}
+
 // ObjectStore returns the model's object store service.
 func (s *ObjectStoreServices) ObjectStore() *objectstoreservice.WatchableService {
 	return objectstoreservice.NewWatchableService(
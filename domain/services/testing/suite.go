@@ -40,6 +40,7 @@ import (
 	databasetesting "github.com/juju/juju/internal/database/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	_ "github.com/juju/juju/internal/provider/dummy"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 	"github.com/juju/juju/internal/storage"
@@ -254,6 +255,8 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *gc.C, o
 		clock := clock.WallClock
 		logger := loggertesting.WrapCheckLog(c)
 		controllerServices := domainservices.NewControllerServices(databasetesting.ConstFactory(s.TxnRunner()), stubDBDeleter{}, clock, logger)
+		secretContentKeys, err := envelope.NewKeyRing([]byte("0123456789abcdef0123456789abcdef"))
+		c.Assert(err, jc.ErrorIsNil)
 		modelServices := domainservices.NewModelServices(
 			modelUUID,
 			databasetesting.ConstFactory(s.TxnRunner()),
@@ -266,6 +269,7 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *gc.C, o
 				return storageRegistry, nil
 			}),
 			sshimporter.NewImporter(&http.Client{}),
+			secretContentKeys,
 			modelApplicationLeaseManagerGetter(func() lease.Checker {
 				return leaseManager
 			}),
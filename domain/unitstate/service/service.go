@@ -35,6 +35,14 @@ type State interface {
 	// state for the unit with the input UUID.
 	UpdateUnitStateSecret(domain.AtomicContext, string, string) error
 
+	// UpdateUnitStateSchedule updates the agent schedule
+	// state for the unit with the input UUID.
+	UpdateUnitStateSchedule(domain.AtomicContext, string, string) error
+
+	// UpdateUnitStateDeferred updates the agent deferred hook
+	// state for the unit with the input UUID.
+	UpdateUnitStateDeferred(domain.AtomicContext, string, string) error
+
 	// SetUnitStateCharm replaces the agent charm
 	// state for the unit with the input UUID.
 	SetUnitStateCharm(domain.AtomicContext, string, map[string]string) error
@@ -107,6 +115,18 @@ func (s *Service) SetState(ctx context.Context, as unitstate.UnitState) error {
 			}
 		}
 
+		if as.ScheduleState != nil {
+			if err = s.st.UpdateUnitStateSchedule(ctx, uuid, *as.ScheduleState); err != nil {
+				return errors.Errorf("setting schedule state for %s: %w", as.Name, err)
+			}
+		}
+
+		if as.DeferredState != nil {
+			if err = s.st.UpdateUnitStateDeferred(ctx, uuid, *as.DeferredState); err != nil {
+				return errors.Errorf("setting deferred hook state for %s: %w", as.Name, err)
+			}
+		}
+
 		if as.CharmState != nil {
 			if err = s.st.SetUnitStateCharm(ctx, uuid, *as.CharmState); err != nil {
 				return errors.Errorf("setting charm state for %s: %w", as.Name, err)
@@ -34,6 +34,8 @@ func (s *serviceSuite) TestSetStateAllAttributes(c *gc.C) {
 	exp.UpdateUnitStateUniter(gomock.Any(), uuid, "some-uniter-state-yaml").Return(nil)
 	exp.UpdateUnitStateStorage(gomock.Any(), uuid, "some-storage-state-yaml").Return(nil)
 	exp.UpdateUnitStateSecret(gomock.Any(), uuid, "some-secret-state-yaml").Return(nil)
+	exp.UpdateUnitStateSchedule(gomock.Any(), uuid, "some-schedule-state-yaml").Return(nil)
+	exp.UpdateUnitStateDeferred(gomock.Any(), uuid, "some-deferred-state-yaml").Return(nil)
 	exp.SetUnitStateCharm(gomock.Any(), uuid, map[string]string{"one-key": "one-value"}).Return(nil)
 	exp.SetUnitStateRelation(gomock.Any(), uuid, map[int]string{1: "one-value"}).Return(nil)
 
@@ -44,6 +46,8 @@ func (s *serviceSuite) TestSetStateAllAttributes(c *gc.C) {
 		RelationState: ptr(map[int]string{1: "one-value"}),
 		StorageState:  ptr("some-storage-state-yaml"),
 		SecretState:   ptr("some-secret-state-yaml"),
+		ScheduleState: ptr("some-schedule-state-yaml"),
+		DeferredState: ptr("some-deferred-state-yaml"),
 	})
 	c.Assert(err, jc.ErrorIsNil)
 }
@@ -271,6 +271,82 @@ func (c *MockStateSetUnitStateRelationCall) DoAndReturn(f func(domain.AtomicCont
 	return c
 }
 
+// UpdateUnitStateDeferred mocks base method.
+func (m *MockState) UpdateUnitStateDeferred(arg0 domain.AtomicContext, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnitStateDeferred", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUnitStateDeferred indicates an expected call of UpdateUnitStateDeferred.
+func (mr *MockStateMockRecorder) UpdateUnitStateDeferred(arg0, arg1, arg2 any) *MockStateUpdateUnitStateDeferredCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnitStateDeferred", reflect.TypeOf((*MockState)(nil).UpdateUnitStateDeferred), arg0, arg1, arg2)
+	return &MockStateUpdateUnitStateDeferredCall{Call: call}
+}
+
+// MockStateUpdateUnitStateDeferredCall wrap *gomock.Call
+type MockStateUpdateUnitStateDeferredCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUpdateUnitStateDeferredCall) Return(arg0 error) *MockStateUpdateUnitStateDeferredCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUpdateUnitStateDeferredCall) Do(f func(domain.AtomicContext, string, string) error) *MockStateUpdateUnitStateDeferredCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUpdateUnitStateDeferredCall) DoAndReturn(f func(domain.AtomicContext, string, string) error) *MockStateUpdateUnitStateDeferredCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUnitStateSchedule mocks base method.
+func (m *MockState) UpdateUnitStateSchedule(arg0 domain.AtomicContext, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUnitStateSchedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUnitStateSchedule indicates an expected call of UpdateUnitStateSchedule.
+func (mr *MockStateMockRecorder) UpdateUnitStateSchedule(arg0, arg1, arg2 any) *MockStateUpdateUnitStateScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUnitStateSchedule", reflect.TypeOf((*MockState)(nil).UpdateUnitStateSchedule), arg0, arg1, arg2)
+	return &MockStateUpdateUnitStateScheduleCall{Call: call}
+}
+
+// MockStateUpdateUnitStateScheduleCall wrap *gomock.Call
+type MockStateUpdateUnitStateScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUpdateUnitStateScheduleCall) Return(arg0 error) *MockStateUpdateUnitStateScheduleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUpdateUnitStateScheduleCall) Do(f func(domain.AtomicContext, string, string) error) *MockStateUpdateUnitStateScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUpdateUnitStateScheduleCall) DoAndReturn(f func(domain.AtomicContext, string, string) error) *MockStateUpdateUnitStateScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateUnitStateSecret mocks base method.
 func (m *MockState) UpdateUnitStateSecret(arg0 domain.AtomicContext, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -138,6 +138,40 @@ func (st *State) UpdateUnitStateSecret(ctx domain.AtomicContext, uuid, state str
 	})
 }
 
+// UpdateUnitStateSchedule sets the input schedule
+// state against the input unit UUID.
+func (st *State) UpdateUnitStateSchedule(ctx domain.AtomicContext, uuid, state string) error {
+	id := unitUUID{UUID: uuid}
+	uSt := unitState{ScheduleState: state}
+
+	q := "UPDATE unit_state SET schedule_state = $unitState.schedule_state WHERE unit_uuid = $unitUUID.uuid"
+	stmt, err := st.Prepare(q, id, uSt)
+	if err != nil {
+		return errors.Errorf("preparing schedule state update query: %w", err)
+	}
+
+	return domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, id, uSt).Run()
+	})
+}
+
+// UpdateUnitStateDeferred sets the input deferred hook
+// state against the input unit UUID.
+func (st *State) UpdateUnitStateDeferred(ctx domain.AtomicContext, uuid, state string) error {
+	id := unitUUID{UUID: uuid}
+	uSt := unitState{DeferredState: state}
+
+	q := "UPDATE unit_state SET deferred_state = $unitState.deferred_state WHERE unit_uuid = $unitUUID.uuid"
+	stmt, err := st.Prepare(q, id, uSt)
+	if err != nil {
+		return errors.Errorf("preparing deferred hook state update query: %w", err)
+	}
+
+	return domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, id, uSt).Run()
+	})
+}
+
 // SetUnitStateCharm sets the input key/value pairs
 // as the charm state for the input unit UUID.
 func (st *State) SetUnitStateCharm(ctx domain.AtomicContext, uuid string, state map[string]string) error {
@@ -276,9 +310,11 @@ WHERE unit_uuid = $unitUUID.uuid`
 	}
 
 	unitState := unitstate.RetrievedUnitState{
-		UniterState:  state.UniterState,
-		StorageState: state.StorageState,
-		SecretState:  state.SecretState,
+		UniterState:   state.UniterState,
+		StorageState:  state.StorageState,
+		SecretState:   state.SecretState,
+		ScheduleState: state.ScheduleState,
+		DeferredState: state.DeferredState,
 	}
 	if len(charmKVs) > 0 {
 		unitState.CharmState = makeMapFromCharmUnitStateKeyVals(charmKVs)
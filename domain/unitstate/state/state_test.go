@@ -174,6 +174,50 @@ func (s *stateSuite) TestUpdateUnitStateSecret(c *gc.C) {
 	c.Assert(gotState, gc.Equals, expState)
 }
 
+func (s *stateSuite) TestUpdateUnitStateSchedule(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+	expState := "some schedule state YAML"
+
+	err := st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		if err := st.EnsureUnitStateRecord(ctx, s.unitUUID); err != nil {
+			return err
+		}
+		return st.UpdateUnitStateSchedule(ctx, s.unitUUID, expState)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var gotState string
+	err = s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		q := "SELECT schedule_state FROM unit_state where unit_uuid = ?"
+		return tx.QueryRowContext(ctx, q, s.unitUUID).Scan(&gotState)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(gotState, gc.Equals, expState)
+}
+
+func (s *stateSuite) TestUpdateUnitStateDeferred(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+	expState := "some deferred hook state YAML"
+
+	err := st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		if err := st.EnsureUnitStateRecord(ctx, s.unitUUID); err != nil {
+			return err
+		}
+		return st.UpdateUnitStateDeferred(ctx, s.unitUUID, expState)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var gotState string
+	err = s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		q := "SELECT deferred_state FROM unit_state where unit_uuid = ?"
+		return tx.QueryRowContext(ctx, q, s.unitUUID).Scan(&gotState)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(gotState, gc.Equals, expState)
+}
+
 func (s *stateSuite) TestUpdateUnitStateCharm(c *gc.C) {
 	st := NewState(s.TxnRunnerFactory())
 	ctx := context.Background()
@@ -310,6 +354,8 @@ func (s *stateSuite) TestGetUnitState(c *gc.C) {
 		RelationState: ptr(map[int]string{1: "one-value"}),
 		StorageState:  ptr("some-storage-state-yaml"),
 		SecretState:   ptr("some-secret-state-yaml"),
+		ScheduleState: ptr("some-schedule-state-yaml"),
+		DeferredState: ptr("some-deferred-state-yaml"),
 	}
 	s.setUnitState(c, st, s.unitUUID, agentState)
 
@@ -319,6 +365,8 @@ func (s *stateSuite) TestGetUnitState(c *gc.C) {
 		RelationState: *agentState.RelationState,
 		StorageState:  *agentState.StorageState,
 		SecretState:   *agentState.SecretState,
+		ScheduleState: *agentState.ScheduleState,
+		DeferredState: *agentState.DeferredState,
 	}
 
 	state, err := st.GetUnitState(context.Background(), s.unitUUID)
@@ -374,6 +422,18 @@ func (s *stateSuite) setUnitState(c *gc.C, st *State, uuid string, unitState uni
 				return err
 			}
 		}
+		if unitState.ScheduleState != nil {
+			err = st.UpdateUnitStateSchedule(ctx, uuid, *unitState.ScheduleState)
+			if err != nil {
+				return err
+			}
+		}
+		if unitState.DeferredState != nil {
+			err = st.UpdateUnitStateDeferred(ctx, uuid, *unitState.DeferredState)
+			if err != nil {
+				return err
+			}
+		}
 		if unitState.CharmState != nil {
 			err = st.SetUnitStateCharm(ctx, uuid, *unitState.CharmState)
 			if err != nil {
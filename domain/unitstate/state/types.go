@@ -17,7 +17,8 @@ type unitName struct {
 }
 
 // unitState contains a YAML string representing the
-// state for a unit's uniter, storage and secrets.
+// state for a unit's uniter, storage, secrets, schedules and
+// deferred hooks.
 type unitState struct {
 	// UniterState is the units uniter state YAML string.
 	UniterState string `db:"uniter_state"`
@@ -25,6 +26,10 @@ type unitState struct {
 	StorageState string `db:"storage_state"`
 	// SecretState is the units secret state YAML string.
 	SecretState string `db:"secret_state"`
+	// ScheduleState is the units schedule state YAML string.
+	ScheduleState string `db:"schedule_state"`
+	// DeferredState is the units deferred hook state YAML string.
+	DeferredState string `db:"deferred_state"`
 }
 
 // unitStateVal is a type for holding a key/value pair that is
@@ -23,6 +23,12 @@ type UnitState struct {
 
 	// SecretState is a YAML string.
 	SecretState *string
+
+	// ScheduleState is a YAML string.
+	ScheduleState *string
+
+	// DeferredState is a YAML string.
+	DeferredState *string
 }
 
 // RetrievedUnitState represents a unit state persisted and then retrieved
@@ -45,4 +51,10 @@ type RetrievedUnitState struct {
 
 	// SecretState is a YAML string.
 	SecretState string
+
+	// ScheduleState is a YAML string.
+	ScheduleState string
+
+	// DeferredState is a YAML string.
+	DeferredState string
 }
@@ -57,6 +57,21 @@ func (f *WatcherFactory) NewNamespaceWatcher(
 	return eventsource.NewNamespaceWatcher(base, namespace, changeMask, initialStateQuery), nil
 }
 
+// NewNamespaceColumnsWatcher returns a new namespace watcher for events
+// based on the input change mask, that only emits updates if one of the
+// input columns has changed.
+func (f *WatcherFactory) NewNamespaceColumnsWatcher(
+	namespace string, changeMask changestream.ChangeType, columns []string,
+	initialStateQuery eventsource.NamespaceQuery,
+) (watcher.StringsWatcher, error) {
+	base, err := f.newBaseWatcher()
+	if err != nil {
+		return nil, errors.Annotate(err, "creating base watcher")
+	}
+
+	return eventsource.NewNamespaceColumnsWatcher(base, namespace, changeMask, columns, initialStateQuery), nil
+}
+
 // NewNamespaceMapperWatcher returns a new namespace watcher
 // for events based on the input change mask and mapper.
 func (f *WatcherFactory) NewNamespaceMapperWatcher(
@@ -0,0 +1,20 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// UnitNotFound describes an error that occurs when
+	// the unit publishing metrics does not exist.
+	UnitNotFound = errors.ConstError("unit not found")
+
+	// ApplicationNotFound describes an error that occurs when
+	// the application whose metrics are requested does not exist.
+	ApplicationNotFound = errors.ConstError("application not found")
+
+	// MetricNotValid describes an error that occurs when a
+	// metric published by a charm is not valid.
+	MetricNotValid = errors.ConstError("metric not valid")
+)
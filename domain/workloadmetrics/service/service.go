@@ -0,0 +1,173 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/quota"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State describes retrieval and persistence methods for workload metrics.
+type State interface {
+	// AddUnitMetrics records the metric samples published by the named unit,
+	// and removes the samples recorded before the input time.
+	// If no unit with the name exists, a
+	// [workloadmetricserrors.UnitNotFound] error is returned.
+	AddUnitMetrics(ctx context.Context, unitName coreunit.Name, metrics []workloadmetrics.Metric, before time.Time) error
+
+	// GetApplicationMetrics returns the metric samples published by the
+	// units of the named application since the input time. If latest is true
+	// only the most recent sample of each series is returned.
+	// If no application with the name exists, a
+	// [workloadmetricserrors.ApplicationNotFound] error is returned.
+	GetApplicationMetrics(ctx context.Context, appName string, latest bool, since time.Time) ([]workloadmetrics.UnitMetric, error)
+
+	// GetLatestMetrics returns the most recent sample of each series
+	// published by the units in the model since the input time.
+	GetLatestMetrics(ctx context.Context, since time.Time) ([]workloadmetrics.UnitMetric, error)
+
+	// GetRetention returns the workload metrics retention period of the
+	// model, as it is recorded in the model config. If it isn't set, an
+	// empty string is returned.
+	GetRetention(ctx context.Context) (string, error)
+}
+
+// Service provides the API for working with the workload
+// metrics published by charms.
+type Service struct {
+	st    State
+	clock clock.Clock
+}
+
+// NewService returns a new Service for working with workload metrics.
+func NewService(st State, clock clock.Clock) *Service {
+	return &Service{
+		st:    st,
+		clock: clock,
+	}
+}
+
+// AddUnitMetrics records the metric samples published by the named unit.
+// Samples without a time are recorded at the current time. Samples older
+// than the retention period, from any unit, are removed.
+// If a metric is not valid, a [workloadmetricserrors.MetricNotValid] error is
+// returned. If no unit with the name exists, a
+// [workloadmetricserrors.UnitNotFound] error is returned.
+func (s *Service) AddUnitMetrics(
+	ctx context.Context, unitName coreunit.Name, metrics []workloadmetrics.Metric, retention time.Duration,
+) error {
+	if err := unitName.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	if retention <= 0 {
+		return errors.Errorf("workload metrics retention %v must be positive", retention)
+	}
+	if len(metrics) > quota.MaxWorkloadMetricsPerHook {
+		return errors.Errorf(
+			"cannot add %d metrics, at most %d can be added at once", len(metrics), quota.MaxWorkloadMetricsPerHook,
+		).Add(workloadmetricserrors.MetricNotValid)
+	}
+
+	now := s.clock.Now().UTC()
+	toAdd := make([]workloadmetrics.Metric, len(metrics))
+	for i, m := range metrics {
+		if err := validateMetric(m); err != nil {
+			return errors.Capture(err)
+		}
+		if m.Time.IsZero() {
+			m.Time = now
+		}
+		m.Time = m.Time.UTC()
+		toAdd[i] = m
+	}
+
+	if err := s.st.AddUnitMetrics(ctx, unitName, toAdd, now.Add(-retention)); err != nil {
+		return errors.Errorf("adding workload metrics for unit %q: %w", unitName, err)
+	}
+	return nil
+}
+
+// GetApplicationMetrics returns the metric samples published by the units of
+// the named application. Unless history is true, only the most recent sample
+// of each series is returned. Samples older than the model's retention period
+// are not returned, even if they haven't been removed yet.
+// If no application with the name exists, a
+// [workloadmetricserrors.ApplicationNotFound] error is returned.
+func (s *Service) GetApplicationMetrics(ctx context.Context, appName string, history bool) ([]workloadmetrics.UnitMetric, error) {
+	since, err := s.retainedSince(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	metrics, err := s.st.GetApplicationMetrics(ctx, appName, !history, since)
+	if err != nil {
+		return nil, errors.Errorf("getting workload metrics for application %q: %w", appName, err)
+	}
+	return metrics, nil
+}
+
+// GetLatestMetrics returns the most recent sample of each
+// series published by the units in the model. Series whose most recent
+// sample is older than the model's retention period are not returned.
+func (s *Service) GetLatestMetrics(ctx context.Context) ([]workloadmetrics.UnitMetric, error) {
+	since, err := s.retainedSince(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	metrics, err := s.st.GetLatestMetrics(ctx, since)
+	if err != nil {
+		return nil, errors.Errorf("getting latest workload metrics: %w", err)
+	}
+	return metrics, nil
+}
+
+// retainedSince returns the time before which samples are outside of the
+// model's retention period. Samples are only removed when a unit publishes,
+// so they're filtered when read as well.
+func (s *Service) retainedSince(ctx context.Context) (time.Time, error) {
+	value, err := s.st.GetRetention(ctx)
+	if err != nil {
+		return time.Time{}, errors.Capture(err)
+	}
+
+	retention := workloadmetrics.DefaultRetention
+	if value != "" {
+		retention, err = time.ParseDuration(value)
+		if err != nil {
+			return time.Time{}, errors.Errorf("parsing workload metrics retention %q: %w", value, err)
+		}
+	}
+	if retention <= 0 {
+		return time.Time{}, errors.Errorf("workload metrics retention %v must be positive", retention)
+	}
+	return s.clock.Now().UTC().Add(-retention), nil
+}
+
+func validateMetric(m workloadmetrics.Metric) error {
+	if !workloadmetrics.IsValidKey(m.Key) {
+		return errors.Errorf(
+			"key %q must start with a letter or underscore and contain only letters, digits and underscores", m.Key,
+		).Add(workloadmetricserrors.MetricNotValid)
+	}
+	if math.IsNaN(m.Value) || math.IsInf(m.Value, 0) {
+		return errors.Errorf("value of %q must be a finite number", m.Key).Add(workloadmetricserrors.MetricNotValid)
+	}
+	for name := range m.Labels {
+		if !workloadmetrics.IsValidLabel(name) {
+			return errors.Errorf(
+				"label %q of %q must contain only letters, digits and underscores and not start with %q",
+				name, m.Key, workloadmetrics.ReservedLabelPrefix,
+			).Add(workloadmetricserrors.MetricNotValid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/quota"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
+)
+
+type serviceSuite struct {
+	st    *MockState
+	clock *testclock.Clock
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) TestAddUnitMetrics(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	sampled := now.Add(-time.Minute)
+	s.st.EXPECT().AddUnitMetrics(gomock.Any(), coreunit.Name("app/0"), []workloadmetrics.Metric{{
+		Key:    "queue_depth",
+		Value:  12,
+		Labels: map[string]string{"queue": "inbound"},
+		Time:   sampled,
+	}, {
+		Key:   "replication_lag",
+		Value: 0.5,
+		Time:  now,
+	}}, now.Add(-time.Hour)).Return(nil)
+
+	err := NewService(s.st, s.clock).AddUnitMetrics(context.Background(), "app/0", []workloadmetrics.Metric{{
+		Key:    "queue_depth",
+		Value:  12,
+		Labels: map[string]string{"queue": "inbound"},
+		Time:   sampled,
+	}, {
+		Key:   "replication_lag",
+		Value: 0.5,
+	}}, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddUnitMetricsUnitNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().AddUnitMetrics(gomock.Any(), coreunit.Name("app/0"), gomock.Any(), gomock.Any()).
+		Return(workloadmetricserrors.UnitNotFound)
+
+	err := NewService(s.st, s.clock).AddUnitMetrics(context.Background(), "app/0", []workloadmetrics.Metric{{
+		Key:   "queue_depth",
+		Value: 12,
+	}}, time.Hour)
+	c.Assert(err, jc.ErrorIs, workloadmetricserrors.UnitNotFound)
+}
+
+func (s *serviceSuite) TestAddUnitMetricsInvalidUnitName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.st, s.clock).AddUnitMetrics(context.Background(), "app", nil, time.Hour)
+	c.Assert(err, jc.ErrorIs, coreunit.InvalidUnitName)
+}
+
+func (s *serviceSuite) TestAddUnitMetricsNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := NewService(s.st, s.clock)
+	for i, test := range []struct {
+		metric workloadmetrics.Metric
+		err    string
+	}{{
+		metric: workloadmetrics.Metric{Key: "queue-depth"},
+		err:    `key "queue-depth" must start with a letter or underscore and contain only letters, digits and underscores`,
+	}, {
+		metric: workloadmetrics.Metric{Key: "1st"},
+		err:    `key "1st" must start with a letter or underscore and contain only letters, digits and underscores`,
+	}, {
+		metric: workloadmetrics.Metric{Key: "lag", Value: math.NaN()},
+		err:    `value of "lag" must be a finite number`,
+	}, {
+		metric: workloadmetrics.Metric{Key: "lag", Value: math.Inf(1)},
+		err:    `value of "lag" must be a finite number`,
+	}, {
+		metric: workloadmetrics.Metric{Key: "lag", Labels: map[string]string{"juju_unit": "app/1"}},
+		err:    `label "juju_unit" of "lag" must contain only letters, digits and underscores and not start with "juju_"`,
+	}, {
+		metric: workloadmetrics.Metric{Key: "lag", Labels: map[string]string{"peer.name": "app/1"}},
+		err:    `label "peer.name" of "lag" must contain only letters, digits and underscores and not start with "juju_"`,
+	}} {
+		c.Logf("test %d", i)
+		err := svc.AddUnitMetrics(context.Background(), "app/0", []workloadmetrics.Metric{test.metric}, time.Hour)
+		c.Check(err, jc.ErrorIs, workloadmetricserrors.MetricNotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *serviceSuite) TestAddUnitMetricsTooMany(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metrics := make([]workloadmetrics.Metric, quota.MaxWorkloadMetricsPerHook+1)
+	for i := range metrics {
+		metrics[i] = workloadmetrics.Metric{Key: "queue_depth", Value: float64(i)}
+	}
+
+	err := NewService(s.st, s.clock).AddUnitMetrics(context.Background(), "app/0", metrics, time.Hour)
+	c.Assert(err, jc.ErrorIs, workloadmetricserrors.MetricNotValid)
+	c.Check(err, gc.ErrorMatches, `cannot add 1001 metrics, at most 1000 can be added at once`)
+}
+
+func (s *serviceSuite) TestGetApplicationMetrics(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metrics := []workloadmetrics.UnitMetric{{
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:   "queue_depth",
+			Value: 12,
+			Time:  s.clock.Now(),
+		},
+	}}
+	since := s.clock.Now().Add(-workloadmetrics.DefaultRetention)
+	s.st.EXPECT().GetRetention(gomock.Any()).Return("", nil).Times(2)
+	s.st.EXPECT().GetApplicationMetrics(gomock.Any(), "app", true, since).Return(metrics, nil)
+	s.st.EXPECT().GetApplicationMetrics(gomock.Any(), "app", false, since).Return(metrics, nil)
+
+	svc := NewService(s.st, s.clock)
+	result, err := svc.GetApplicationMetrics(context.Background(), "app", false)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, metrics)
+
+	result, err = svc.GetApplicationMetrics(context.Background(), "app", true)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, metrics)
+}
+
+func (s *serviceSuite) TestGetApplicationMetricsNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetRetention(gomock.Any()).Return("", nil)
+	s.st.EXPECT().GetApplicationMetrics(gomock.Any(), "app", true, gomock.Any()).Return(nil, workloadmetricserrors.ApplicationNotFound)
+
+	_, err := NewService(s.st, s.clock).GetApplicationMetrics(context.Background(), "app", false)
+	c.Assert(err, jc.ErrorIs, workloadmetricserrors.ApplicationNotFound)
+}
+
+func (s *serviceSuite) TestGetLatestMetrics(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	metrics := []workloadmetrics.UnitMetric{{
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:   "queue_depth",
+			Value: 12,
+			Time:  s.clock.Now(),
+		},
+	}}
+	s.st.EXPECT().GetRetention(gomock.Any()).Return("1h", nil)
+	s.st.EXPECT().GetLatestMetrics(gomock.Any(), s.clock.Now().Add(-time.Hour)).Return(metrics, nil)
+
+	result, err := NewService(s.st, s.clock).GetLatestMetrics(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, metrics)
+}
+
+func (s *serviceSuite) TestGetLatestMetricsInvalidRetention(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetRetention(gomock.Any()).Return("forever", nil)
+
+	_, err := NewService(s.st, s.clock).GetLatestMetrics(context.Background())
+	c.Assert(err, gc.ErrorMatches, `parsing workload metrics retention "forever": .*`)
+}
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.st = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC))
+	return ctrl
+}
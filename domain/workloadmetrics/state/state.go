@@ -0,0 +1,245 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/database"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// latestSampleCondition restricts the unit_workload_metric rows aliased as m
+// to the most recent sample of each series.
+const latestSampleCondition = `
+m.rowid = (
+    SELECT l.rowid
+    FROM unit_workload_metric AS l
+    WHERE l.unit_uuid = m.unit_uuid
+    AND l.metric_key = m.metric_key
+    AND l.labels = m.labels
+    ORDER BY l.recorded_at DESC, l.rowid DESC
+    LIMIT 1
+)`
+
+// State implements persistence for the workload metrics published by charms.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState returns a new state reference.
+func NewState(factory database.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddUnitMetrics records the metric samples published by the named unit,
+// and removes the samples recorded before the input time.
+// If no unit with the name exists, a [workloadmetricserrors.UnitNotFound]
+// error is returned.
+func (st *State) AddUnitMetrics(ctx context.Context, name coreunit.Name, metrics []workloadmetrics.Metric, before time.Time) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	unit := unitName{Name: name}
+	uuidStmt, err := st.Prepare(`SELECT &unitUUID.uuid FROM unit WHERE name = $unitName.name`, unitUUID{}, unit)
+	if err != nil {
+		return errors.Errorf("preparing unit UUID query: %w", err)
+	}
+
+	insertStmt, err := st.Prepare(`INSERT INTO unit_workload_metric (*) VALUES ($metricSample.*)`, metricSample{})
+	if err != nil {
+		return errors.Errorf("preparing insert metric statement: %w", err)
+	}
+
+	pruneStmt, err := st.Prepare(`
+DELETE FROM unit_workload_metric
+WHERE recorded_at < $cutoff.recorded_at`, cutoff{})
+	if err != nil {
+		return errors.Errorf("preparing prune metrics statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var uuid unitUUID
+		err := tx.Query(ctx, uuidStmt, unit).Get(&uuid)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return workloadmetricserrors.UnitNotFound
+		} else if err != nil {
+			return errors.Errorf("getting UUID of unit %q: %w", name, err)
+		}
+
+		if len(metrics) > 0 {
+			samples := make([]metricSample, len(metrics))
+			for i, m := range metrics {
+				labels, err := encodeLabels(m.Labels)
+				if err != nil {
+					return errors.Errorf("encoding labels of %q: %w", m.Key, err)
+				}
+				samples[i] = metricSample{
+					UnitUUID:   uuid.UUID,
+					Key:        m.Key,
+					Labels:     labels,
+					Value:      m.Value,
+					RecordedAt: m.Time.UTC(),
+				}
+			}
+			if err := tx.Query(ctx, insertStmt, samples).Run(); err != nil {
+				return errors.Errorf("inserting metrics: %w", err)
+			}
+		}
+
+		if err := tx.Query(ctx, pruneStmt, cutoff{Time: before.UTC()}).Run(); err != nil {
+			return errors.Errorf("pruning metrics: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetApplicationMetrics returns the metric samples published by the units of
+// the named application since the input time, ordered by unit, series and
+// time. If latest is true only the most recent sample of each series is
+// returned.
+// If no application with the name exists, a
+// [workloadmetricserrors.ApplicationNotFound] error is returned.
+func (st *State) GetApplicationMetrics(ctx context.Context, appName string, latest bool, since time.Time) ([]workloadmetrics.UnitMetric, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	app := applicationName{Name: appName}
+	uuidStmt, err := st.Prepare(`SELECT &applicationUUID.uuid FROM application WHERE name = $applicationName.name`, applicationUUID{}, app)
+	if err != nil {
+		return nil, errors.Errorf("preparing application UUID query: %w", err)
+	}
+
+	query := `
+SELECT u.name AS &unitMetricSample.unit_name,
+       m.metric_key AS &unitMetricSample.metric_key,
+       m.labels AS &unitMetricSample.labels,
+       m.value AS &unitMetricSample.value,
+       m.recorded_at AS &unitMetricSample.recorded_at
+FROM unit_workload_metric AS m
+JOIN unit AS u ON m.unit_uuid = u.uuid
+WHERE u.application_uuid = $applicationUUID.uuid
+AND m.recorded_at >= $cutoff.recorded_at`
+	if latest {
+		query += `
+AND ` + latestSampleCondition
+	}
+	query += `
+ORDER BY u.name, m.metric_key, m.labels, m.recorded_at`
+	metricsStmt, err := st.Prepare(query, unitMetricSample{}, applicationUUID{}, cutoff{})
+	if err != nil {
+		return nil, errors.Errorf("preparing application metrics query: %w", err)
+	}
+
+	var samples []unitMetricSample
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var uuid applicationUUID
+		err := tx.Query(ctx, uuidStmt, app).Get(&uuid)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return workloadmetricserrors.ApplicationNotFound
+		} else if err != nil {
+			return errors.Errorf("getting UUID of application %q: %w", appName, err)
+		}
+
+		err = tx.Query(ctx, metricsStmt, uuid, cutoff{Time: since.UTC()}).GetAll(&samples)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return decodeSamples(samples)
+}
+
+// GetLatestMetrics returns the most recent sample of each series published
+// by the units in the model since the input time, ordered by unit and series.
+func (st *State) GetLatestMetrics(ctx context.Context, since time.Time) ([]workloadmetrics.UnitMetric, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT u.name AS &unitMetricSample.unit_name,
+       m.metric_key AS &unitMetricSample.metric_key,
+       m.labels AS &unitMetricSample.labels,
+       m.value AS &unitMetricSample.value,
+       m.recorded_at AS &unitMetricSample.recorded_at
+FROM unit_workload_metric AS m
+JOIN unit AS u ON m.unit_uuid = u.uuid
+WHERE m.recorded_at >= $cutoff.recorded_at
+AND `+latestSampleCondition+`
+ORDER BY u.name, m.metric_key, m.labels`, unitMetricSample{}, cutoff{})
+	if err != nil {
+		return nil, errors.Errorf("preparing latest metrics query: %w", err)
+	}
+
+	var samples []unitMetricSample
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, cutoff{Time: since.UTC()}).GetAll(&samples)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return decodeSamples(samples)
+}
+
+// GetRetention returns the workload metrics retention period of the model,
+// as it is recorded in the model config. If it isn't set, an empty string is
+// returned.
+func (st *State) GetRetention(ctx context.Context) (string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	key := configKeyValue{Key: workloadmetrics.RetentionConfigKey}
+	stmt, err := st.Prepare(`SELECT &configKeyValue.value FROM model_config WHERE key = $configKeyValue.key`, key)
+	if err != nil {
+		return "", errors.Errorf("preparing retention query: %w", err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, key).Get(&key)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return "", errors.Errorf("getting workload metrics retention: %w", err)
+	}
+	return key.Value, nil
+}
+
+func decodeSamples(samples []unitMetricSample) ([]workloadmetrics.UnitMetric, error) {
+	metrics := make([]workloadmetrics.UnitMetric, len(samples))
+	for i, s := range samples {
+		m, err := s.decode()
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		metrics[i] = m
+	}
+	return metrics, nil
+}
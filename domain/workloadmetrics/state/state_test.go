@@ -0,0 +1,263 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/juju/clock"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/architecture"
+	"github.com/juju/juju/domain/application/charm"
+	applicationstate "github.com/juju/juju/domain/application/state"
+	"github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/domain/workloadmetrics"
+	workloadmetricserrors "github.com/juju/juju/domain/workloadmetrics/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type stateSuite struct {
+	testing.ModelSuite
+
+	now time.Time
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) SetUpTest(c *gc.C) {
+	s.ModelSuite.SetUpTest(c)
+
+	appState := applicationstate.NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	appArg := application.AddApplicationArg{
+		Charm: charm.Charm{
+			Metadata: charm.Metadata{
+				Name: "app",
+			},
+			Manifest: charm.Manifest{
+				Bases: []charm.Base{{
+					Name:          "ubuntu",
+					Channel:       charm.Channel{Risk: charm.RiskStable},
+					Architectures: []string{"amd64"},
+				}},
+			},
+			ReferenceName: "app",
+			Source:        charm.LocalSource,
+			Architecture:  architecture.AMD64,
+		},
+	}
+
+	unitArgs := []application.AddUnitArg{{UnitName: "app/0"}, {UnitName: "app/1"}}
+
+	_, err := appState.CreateApplication(context.Background(), "app", appArg, unitArgs)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.now = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *stateSuite) TestAddUnitMetricsUnitNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.AddUnitMetrics(context.Background(), "other/0", []workloadmetrics.Metric{{
+		Key:  "queue_depth",
+		Time: s.now,
+	}}, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIs, workloadmetricserrors.UnitNotFound)
+}
+
+func (s *stateSuite) TestAddUnitMetricsPrunes(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+
+	err := st.AddUnitMetrics(ctx, "app/0", []workloadmetrics.Metric{{
+		Key:   "queue_depth",
+		Value: 1,
+		Time:  s.now.Add(-2 * time.Hour),
+	}}, s.now.Add(-3*time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.AddUnitMetrics(ctx, "app/1", []workloadmetrics.Metric{{
+		Key:   "queue_depth",
+		Value: 2,
+		Time:  s.now,
+	}}, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The sample published by app/0 is older than the retention period, so
+	// it is removed when app/1 publishes.
+	var count int
+	err = s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM unit_workload_metric").Scan(&count)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 1)
+}
+
+func (s *stateSuite) TestGetApplicationMetrics(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+	s.addMetrics(c, st)
+
+	metrics, err := st.GetApplicationMetrics(ctx, "app", false, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metrics, jc.DeepEquals, []workloadmetrics.UnitMetric{{
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:   "queue_depth",
+			Value: 10,
+			Time:  s.now.Add(-time.Minute),
+		},
+	}, {
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:   "queue_depth",
+			Value: 12,
+			Time:  s.now,
+		},
+	}, {
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:    "queue_depth",
+			Value:  3,
+			Labels: map[string]string{"queue": "inbound"},
+			Time:   s.now,
+		},
+	}, {
+		UnitName: "app/1",
+		Metric: workloadmetrics.Metric{
+			Key:   "replication_lag",
+			Value: 0.5,
+			Time:  s.now,
+		},
+	}})
+}
+
+func (s *stateSuite) TestGetApplicationMetricsLatest(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+	s.addMetrics(c, st)
+
+	metrics, err := st.GetApplicationMetrics(ctx, "app", true, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metrics, jc.DeepEquals, []workloadmetrics.UnitMetric{{
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:   "queue_depth",
+			Value: 12,
+			Time:  s.now,
+		},
+	}, {
+		UnitName: "app/0",
+		Metric: workloadmetrics.Metric{
+			Key:    "queue_depth",
+			Value:  3,
+			Labels: map[string]string{"queue": "inbound"},
+			Time:   s.now,
+		},
+	}, {
+		UnitName: "app/1",
+		Metric: workloadmetrics.Metric{
+			Key:   "replication_lag",
+			Value: 0.5,
+			Time:  s.now,
+		},
+	}})
+}
+
+func (s *stateSuite) TestGetApplicationMetricsApplicationNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetApplicationMetrics(context.Background(), "other", true, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIs, workloadmetricserrors.ApplicationNotFound)
+}
+
+func (s *stateSuite) TestGetApplicationMetricsNone(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	metrics, err := st.GetApplicationMetrics(context.Background(), "app", true, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metrics, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetLatestMetrics(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	s.addMetrics(c, st)
+
+	metrics, err := st.GetLatestMetrics(context.Background(), s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(metrics, gc.HasLen, 3)
+	c.Check(metrics[0].UnitName.String(), gc.Equals, "app/0")
+	c.Check(metrics[0].Value, gc.Equals, 12.0)
+	c.Check(metrics[1].Labels, jc.DeepEquals, map[string]string{"queue": "inbound"})
+	c.Check(metrics[2].UnitName.String(), gc.Equals, "app/1")
+}
+
+func (s *stateSuite) TestGetMetricsSince(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+	s.addMetrics(c, st)
+
+	// Samples which haven't been removed yet, but are older than the input
+	// time, are not returned.
+	metrics, err := st.GetApplicationMetrics(ctx, "app", false, s.now.Add(-30*time.Second))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metrics, gc.HasLen, 3)
+
+	metrics, err = st.GetLatestMetrics(ctx, s.now.Add(time.Second))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metrics, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetRetention(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+
+	retention, err := st.GetRetention(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(retention, gc.Equals, "")
+
+	err = s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "INSERT INTO model_config (key, value) VALUES ('workload-metrics-retention', '1h')")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	retention, err = st.GetRetention(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(retention, gc.Equals, "1h")
+}
+
+func (s *stateSuite) addMetrics(c *gc.C, st *State) {
+	ctx := context.Background()
+	err := st.AddUnitMetrics(ctx, "app/0", []workloadmetrics.Metric{{
+		Key:   "queue_depth",
+		Value: 10,
+		Time:  s.now.Add(-time.Minute),
+	}, {
+		Key:    "queue_depth",
+		Value:  3,
+		Labels: map[string]string{"queue": "inbound"},
+		Time:   s.now,
+	}}, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.AddUnitMetrics(ctx, "app/0", []workloadmetrics.Metric{{
+		Key:   "queue_depth",
+		Value: 12,
+		Time:  s.now,
+	}}, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.AddUnitMetrics(ctx, "app/1", []workloadmetrics.Metric{{
+		Key:   "replication_lag",
+		Value: 0.5,
+		Time:  s.now,
+	}}, s.now.Add(-time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"encoding/json"
+	"time"
+
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/workloadmetrics"
+	"github.com/juju/juju/internal/errors"
+)
+
+// unitName identifies a unit by name.
+type unitName struct {
+	Name coreunit.Name `db:"name"`
+}
+
+// unitUUID identifies a unit by UUID.
+type unitUUID struct {
+	UUID string `db:"uuid"`
+}
+
+// applicationName identifies an application by name.
+type applicationName struct {
+	Name string `db:"name"`
+}
+
+// applicationUUID identifies an application by UUID.
+type applicationUUID struct {
+	UUID string `db:"uuid"`
+}
+
+// configKeyValue is a row in the model_config table.
+type configKeyValue struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
+// cutoff is the time before which metric samples are removed, or
+// are not returned.
+type cutoff struct {
+	Time time.Time `db:"recorded_at"`
+}
+
+// metricSample is a row in the unit_workload_metric table.
+type metricSample struct {
+	UnitUUID   string    `db:"unit_uuid"`
+	Key        string    `db:"metric_key"`
+	Labels     string    `db:"labels"`
+	Value      float64   `db:"value"`
+	RecordedAt time.Time `db:"recorded_at"`
+}
+
+// unitMetricSample is a metric sample along with
+// the name of the unit which published it.
+type unitMetricSample struct {
+	UnitName   string    `db:"unit_name"`
+	Key        string    `db:"metric_key"`
+	Labels     string    `db:"labels"`
+	Value      float64   `db:"value"`
+	RecordedAt time.Time `db:"recorded_at"`
+}
+
+// encodeLabels returns the labels as canonical JSON, so that samples of
+// the same series have the same encoding. No labels are encoded as an
+// empty string.
+func encodeLabels(labels map[string]string) (string, error) {
+	if len(labels) == 0 {
+		return "", nil
+	}
+	// Maps are marshalled with sorted keys.
+	data, err := json.Marshal(labels)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return string(data), nil
+}
+
+func decodeLabels(data string) (map[string]string, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var labels map[string]string
+	if err := json.Unmarshal([]byte(data), &labels); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return labels, nil
+}
+
+func (s unitMetricSample) decode() (workloadmetrics.UnitMetric, error) {
+	labels, err := decodeLabels(s.Labels)
+	if err != nil {
+		return workloadmetrics.UnitMetric{}, errors.Errorf("decoding labels of %q: %w", s.Key, err)
+	}
+	return workloadmetrics.UnitMetric{
+		UnitName: coreunit.Name(s.UnitName),
+		Metric: workloadmetrics.Metric{
+			Key:    s.Key,
+			Value:  s.Value,
+			Labels: labels,
+			Time:   s.RecordedAt.UTC(),
+		},
+	}, nil
+}
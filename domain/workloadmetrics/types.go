@@ -0,0 +1,66 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package workloadmetrics
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	coreunit "github.com/juju/juju/core/unit"
+)
+
+const (
+	// RetentionConfigKey is the model config key of the period for which
+	// workload metrics are retained.
+	RetentionConfigKey = "workload-metrics-retention"
+
+	// DefaultRetention is the period for which workload metrics are retained
+	// when the model config doesn't set it.
+	DefaultRetention = 24 * time.Hour
+)
+
+// ReservedLabelPrefix is the prefix of the labels Juju adds to workload
+// metrics when it exposes them, which charms cannot use.
+const ReservedLabelPrefix = "juju_"
+
+var nameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// IsValidKey returns whether the input is valid as a metric key. Keys are
+// used in the names of the exposed Prometheus metrics, so they follow the
+// same rules.
+func IsValidKey(key string) bool {
+	return nameRegexp.MatchString(key)
+}
+
+// IsValidLabel returns whether the input is valid as the name of a metric
+// label.
+func IsValidLabel(name string) bool {
+	return nameRegexp.MatchString(name) && !strings.HasPrefix(name, ReservedLabelPrefix)
+}
+
+// Metric is a single sample of a workload metric published by a charm.
+type Metric struct {
+	// Key is the name of the metric, eg queue_depth.
+	Key string
+
+	// Value is the value of the metric when it was sampled.
+	Value float64
+
+	// Labels further identify the series the sample belongs to, eg
+	// queue=inbound.
+	Labels map[string]string
+
+	// Time is when the metric was sampled.
+	Time time.Time
+}
+
+// UnitMetric is a workload metric sample along with the
+// unit that published it.
+type UnitMetric struct {
+	// UnitName is the name of the unit which published the metric.
+	UnitName coreunit.Name
+
+	Metric
+}
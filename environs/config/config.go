@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -247,6 +248,29 @@ const (
 	// UpdateStatusHookInterval is how often to run the update-status hook.
 	UpdateStatusHookInterval = "update-status-hook-interval"
 
+	// HookTimeout is how long a hook can run for before it is killed, eg
+	// "30m". A zero duration means that hooks are never killed.
+	HookTimeout = "hook-timeout"
+
+	// HookTimeoutOverrides are the timeouts for specific kinds of hook, which
+	// take precedence over HookTimeout, eg "install=1h,config-changed=10m".
+	// An override can be qualified by the application it applies to, with
+	// "*" matching every kind of hook, eg "mysql:install=2h,mysql:*=1h".
+	HookTimeoutOverrides = "hook-timeout-overrides"
+
+	// HookSnapshots is whether units record a snapshot of each hook they
+	// run, so that the hook can be replayed with juju replay-hook.
+	HookSnapshots = "hook-snapshots"
+
+	// WorkloadMetricsRetention is how long the workload metrics published
+	// by charms with metric-add are kept for, eg "24h".
+	WorkloadMetricsRetention = "workload-metrics-retention"
+
+	// PebbleCriticalChecks are the Pebble checks that put a unit's workload
+	// status into error while they are failing, eg "online,mysql:replication".
+	// A check may be qualified by the application it applies to.
+	PebbleCriticalChecks = "pebble-critical-checks"
+
 	// EgressSubnets are the source addresses from which traffic from this model
 	// originates if the model is deployed such that NAT or similar is in use.
 	EgressSubnets = "egress-subnets"
@@ -494,6 +518,14 @@ const (
 	// UpdateStatusHookInterval
 	DefaultUpdateStatusHookInterval = "5m"
 
+	// DefaultHookTimeout is the default value for HookTimeout, which
+	// doesn't kill hooks however long they run for.
+	DefaultHookTimeout = "0s"
+
+	// DefaultWorkloadMetricsRetention is the default value for
+	// WorkloadMetricsRetention.
+	DefaultWorkloadMetricsRetention = "24h"
+
 	// DefaultActionResultsAge is the default for the age of the results for an
 	// action.
 	DefaultActionResultsAge = "336h" // 2 weeks
@@ -552,6 +584,11 @@ var defaultConfigValues = map[string]any{
 	DisableTelemetryKey:             false,
 	TransmitVendorMetricsKey:        true,
 	UpdateStatusHookInterval:        DefaultUpdateStatusHookInterval,
+	HookTimeout:                     DefaultHookTimeout,
+	HookTimeoutOverrides:            "",
+	HookSnapshots:                   false,
+	WorkloadMetricsRetention:        DefaultWorkloadMetricsRetention,
+	PebbleCriticalChecks:            "",
 	EgressSubnets:                   "",
 	CloudInitUserDataKey:            "",
 	ContainerInheritPropertiesKey:   "",
@@ -752,6 +789,38 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[HookTimeout].(string); ok {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid hook timeout in model configuration")
+		}
+		if duration < 0 {
+			return errors.Errorf("hook timeout %v cannot be negative", duration)
+		}
+	}
+
+	if v, ok := cfg.defined[HookTimeoutOverrides].(string); ok {
+		if _, err := parseHookTimeoutOverrides(v); err != nil {
+			return errors.Annotate(err, "invalid hook timeout overrides in model configuration")
+		}
+	}
+
+	if v, ok := cfg.defined[WorkloadMetricsRetention].(string); ok {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid workload metrics retention in model configuration")
+		}
+		if duration <= 0 {
+			return errors.Errorf("workload metrics retention %v must be positive", duration)
+		}
+	}
+
+	if v, ok := cfg.defined[PebbleCriticalChecks].(string); ok {
+		if _, err := parsePebbleCriticalChecks(v); err != nil {
+			return errors.Annotate(err, "invalid pebble critical checks in model configuration")
+		}
+	}
+
 	if v, ok := cfg.defined[EgressSubnets].(string); ok && v != "" {
 		cidrs := strings.Split(v, ",")
 		for _, cidr := range cidrs {
@@ -1622,6 +1691,172 @@ func (c *Config) UpdateStatusHookInterval() time.Duration {
 	return val
 }
 
+// HookTimeouts holds how long hooks can run for before they are killed.
+type HookTimeouts struct {
+	// Default is the timeout for the kinds of hook without an override. A
+	// zero duration means that the hooks are never killed.
+	Default time.Duration
+
+	// Overrides are the timeouts for specific kinds of hook.
+	Overrides map[string]time.Duration
+
+	// ApplicationOverrides are the timeouts for the hooks of specific
+	// applications, keyed by application and then by kind of hook. The
+	// kind "*" applies to every kind of hook of the application.
+	ApplicationOverrides map[string]map[string]time.Duration
+}
+
+// allHookKinds is the hook kind of an application override which applies to
+// every kind of hook.
+const allHookKinds = "*"
+
+// For returns the timeout for the given kind of hook, run by a unit of the
+// given application. An override for the application takes precedence over
+// an override for the kind of hook.
+func (t HookTimeouts) For(application, kind string) time.Duration {
+	if overrides, ok := t.ApplicationOverrides[application]; ok {
+		if timeout, ok := overrides[kind]; ok {
+			return timeout
+		}
+		if timeout, ok := overrides[allHookKinds]; ok {
+			return timeout
+		}
+	}
+	if timeout, ok := t.Overrides[kind]; ok {
+		return timeout
+	}
+	return t.Default
+}
+
+// HookTimeouts returns how long hooks can run for before they are killed.
+func (c *Config) HookTimeouts() HookTimeouts {
+	// Values have already been validated.
+	timeout, _ := time.ParseDuration(c.asString(HookTimeout))
+	timeouts, _ := parseHookTimeoutOverrides(c.asString(HookTimeoutOverrides))
+	timeouts.Default = timeout
+	return timeouts
+}
+
+// parseHookTimeoutOverrides parses a comma separated list of hook kinds,
+// each optionally qualified by an application name, and their timeouts, eg
+// "install=1h,config-changed=10m,mysql:*=2h".
+func parseHookTimeoutOverrides(raw string) (HookTimeouts, error) {
+	timeouts := HookTimeouts{
+		Overrides: make(map[string]time.Duration),
+	}
+	for _, override := range strings.Split(raw, ",") {
+		override = strings.TrimSpace(override)
+		if override == "" {
+			continue
+		}
+		kind, value, ok := strings.Cut(override, "=")
+		kind = strings.TrimSpace(kind)
+		application, qualifiedKind, qualified := strings.Cut(kind, ":")
+		if qualified {
+			kind = qualifiedKind
+		}
+		if !ok || kind == "" {
+			return HookTimeouts{}, errors.Errorf("expected [<application>:]<hook>=<timeout>, got %q", override)
+		}
+		if qualified && !names.IsValidApplication(application) {
+			return HookTimeouts{}, errors.NotValidf("application name %q in %q", application, override)
+		}
+		if !qualified && kind == allHookKinds {
+			return HookTimeouts{}, errors.Errorf("%q only applies to the hooks of an application, got %q", allHookKinds, override)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return HookTimeouts{}, errors.Annotatef(err, "timeout for %q hook", kind)
+		}
+		if timeout < 0 {
+			return HookTimeouts{}, errors.Errorf("timeout %v for %q hook cannot be negative", timeout, kind)
+		}
+		if !qualified {
+			timeouts.Overrides[kind] = timeout
+			continue
+		}
+		if timeouts.ApplicationOverrides == nil {
+			timeouts.ApplicationOverrides = make(map[string]map[string]time.Duration)
+		}
+		if timeouts.ApplicationOverrides[application] == nil {
+			timeouts.ApplicationOverrides[application] = make(map[string]time.Duration)
+		}
+		timeouts.ApplicationOverrides[application][kind] = timeout
+	}
+	return timeouts, nil
+}
+
+// HookSnapshots returns whether units record a snapshot of each hook they
+// run, so that the hook can be replayed.
+func (c *Config) HookSnapshots() bool {
+	val, _ := c.defined[HookSnapshots].(bool)
+	return val
+}
+
+// WorkloadMetricsRetention returns how long the workload metrics published
+// by charms are kept for.
+func (c *Config) WorkloadMetricsRetention() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.asString(WorkloadMetricsRetention))
+	return val
+}
+
+// CriticalPebbleChecks holds the Pebble checks that put a unit's workload
+// status into error while they are failing.
+type CriticalPebbleChecks struct {
+	// Checks are the names of the critical checks of every application.
+	Checks []string
+	// ApplicationChecks are the names of the critical checks of specific
+	// applications, keyed by application name.
+	ApplicationChecks map[string][]string
+}
+
+// IsCritical returns whether the named check is critical for the units of
+// the named application.
+func (c CriticalPebbleChecks) IsCritical(application, check string) bool {
+	return slices.Contains(c.Checks, check) || slices.Contains(c.ApplicationChecks[application], check)
+}
+
+// PebbleCriticalChecks returns the Pebble checks that put a unit's workload
+// status into error while they are failing.
+func (c *Config) PebbleCriticalChecks() CriticalPebbleChecks {
+	// Value has already been validated.
+	checks, _ := parsePebbleCriticalChecks(c.asString(PebbleCriticalChecks))
+	return checks
+}
+
+// parsePebbleCriticalChecks parses a comma separated list of check names,
+// each optionally qualified by an application name, eg
+// "online,mysql:replication".
+func parsePebbleCriticalChecks(raw string) (CriticalPebbleChecks, error) {
+	var checks CriticalPebbleChecks
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		application, check, qualified := strings.Cut(entry, ":")
+		if !qualified {
+			application, check = "", entry
+		}
+		if check == "" || strings.ContainsAny(check, " \t") {
+			return CriticalPebbleChecks{}, errors.Errorf("expected [<application>:]<check>, got %q", entry)
+		}
+		if !qualified {
+			checks.Checks = append(checks.Checks, check)
+			continue
+		}
+		if !names.IsValidApplication(application) {
+			return CriticalPebbleChecks{}, errors.NotValidf("application name %q in %q", application, entry)
+		}
+		if checks.ApplicationChecks == nil {
+			checks.ApplicationChecks = make(map[string][]string)
+		}
+		checks.ApplicationChecks[application] = append(checks.ApplicationChecks[application], check)
+	}
+	return checks, nil
+}
+
 // EgressSubnets are the source addresses from which traffic from this model
 // originates if the model is deployed such that NAT or similar is in use.
 func (c *Config) EgressSubnets() []string {
@@ -1784,6 +2019,11 @@ var alwaysOptional = schema.Defaults{
 	MaxActionResultsAge:             schema.Omit,
 	MaxActionResultsSize:            schema.Omit,
 	UpdateStatusHookInterval:        schema.Omit,
+	HookTimeout:                     schema.Omit,
+	HookTimeoutOverrides:            schema.Omit,
+	HookSnapshots:                   schema.Omit,
+	WorkloadMetricsRetention:        schema.Omit,
+	PebbleCriticalChecks:            schema.Omit,
 	EgressSubnets:                   schema.Omit,
 	CloudInitUserDataKey:            schema.Omit,
 	ContainerInheritPropertiesKey:   schema.Omit,
@@ -158,6 +158,76 @@ var configTests = []configTest{
 			"development": "invalid",
 		}),
 		err: `development: expected bool, got string\("invalid"\)`,
+	}, {
+		about:       "Invalid hook-timeout",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout": "forever",
+		}),
+		err: `invalid hook timeout in model configuration: time: invalid duration "forever"`,
+	}, {
+		about:       "Negative hook-timeout",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout": "-1m",
+		}),
+		err: `hook timeout -1m0s cannot be negative`,
+	}, {
+		about:       "Invalid hook-timeout-overrides",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout-overrides": "install",
+		}),
+		err: `invalid hook timeout overrides in model configuration: expected \[<application>:\]<hook>=<timeout>, got "install"`,
+	}, {
+		about:       "Invalid hook-timeout-overrides timeout",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout-overrides": "install=1h,start=soon",
+		}),
+		err: `invalid hook timeout overrides in model configuration: timeout for "start" hook: time: invalid duration "soon"`,
+	}, {
+		about:       "Invalid hook-timeout-overrides application",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout-overrides": "My-App:install=1h",
+		}),
+		err: `invalid hook timeout overrides in model configuration: application name "My-App" in "My-App:install=1h" not valid`,
+	}, {
+		about:       "Unqualified hook-timeout-overrides wildcard",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"hook-timeout-overrides": "*=1h",
+		}),
+		err: `invalid hook timeout overrides in model configuration: "\*" only applies to the hooks of an application, got "\*=1h"`,
+	}, {
+		about:       "Invalid workload-metrics-retention",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"workload-metrics-retention": "forever",
+		}),
+		err: `invalid workload metrics retention in model configuration: time: invalid duration "forever"`,
+	}, {
+		about:       "Zero workload-metrics-retention",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"workload-metrics-retention": "0s",
+		}),
+		err: `workload metrics retention 0s must be positive`,
+	}, {
+		about:       "Invalid pebble-critical-checks application",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"pebble-critical-checks": "online,my_app:ready",
+		}),
+		err: `invalid pebble critical checks in model configuration: application name "my_app" in "my_app:ready" not valid`,
+	}, {
+		about:       "Invalid pebble-critical-checks check",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"pebble-critical-checks": "mysql:",
+		}),
+		err: `invalid pebble critical checks in model configuration: expected \[<application>:\]<check>, got "mysql:"`,
 	}, {
 		about:       "Invalid disable-network-management flag",
 		useDefaults: config.UseDefaults,
@@ -1440,6 +1510,71 @@ func (s *ConfigSuite) TestUpdateStatusHookIntervalConfigValue(c *gc.C) {
 	c.Assert(cfg.UpdateStatusHookInterval(), gc.Equals, 30*time.Minute)
 }
 
+func (s *ConfigSuite) TestHookTimeoutsDefault(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	timeouts := cfg.HookTimeouts()
+	c.Check(timeouts.Default, gc.Equals, time.Duration(0))
+	c.Check(timeouts.For("mysql", "install"), gc.Equals, time.Duration(0))
+}
+
+func (s *ConfigSuite) TestHookTimeouts(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"hook-timeout":           "10m",
+		"hook-timeout-overrides": "install=1h, relation-changed=0s",
+	})
+	timeouts := cfg.HookTimeouts()
+	c.Check(timeouts.For("mysql", "install"), gc.Equals, time.Hour)
+	c.Check(timeouts.For("mysql", "relation-changed"), gc.Equals, time.Duration(0))
+	c.Check(timeouts.For("mysql", "config-changed"), gc.Equals, 10*time.Minute)
+}
+
+func (s *ConfigSuite) TestHookTimeoutsApplicationOverrides(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		"hook-timeout":           "10m",
+		"hook-timeout-overrides": "install=1h,mysql:install=2h,mysql:*=30m,redis:start=1m",
+	})
+	timeouts := cfg.HookTimeouts()
+	c.Check(timeouts.For("mysql", "install"), gc.Equals, 2*time.Hour)
+	c.Check(timeouts.For("mysql", "config-changed"), gc.Equals, 30*time.Minute)
+	c.Check(timeouts.For("redis", "install"), gc.Equals, time.Hour)
+	c.Check(timeouts.For("redis", "start"), gc.Equals, time.Minute)
+	c.Check(timeouts.For("redis", "config-changed"), gc.Equals, 10*time.Minute)
+	c.Check(timeouts.For("postgresql", "install"), gc.Equals, time.Hour)
+}
+
+func (s *ConfigSuite) TestHookSnapshots(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Check(cfg.HookSnapshots(), jc.IsFalse)
+
+	cfg = newTestConfig(c, testing.Attrs{"hook-snapshots": true})
+	c.Check(cfg.HookSnapshots(), jc.IsTrue)
+}
+
+func (s *ConfigSuite) TestWorkloadMetricsRetention(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Check(cfg.WorkloadMetricsRetention(), gc.Equals, 24*time.Hour)
+
+	cfg = newTestConfig(c, testing.Attrs{"workload-metrics-retention": "1h"})
+	c.Check(cfg.WorkloadMetricsRetention(), gc.Equals, time.Hour)
+}
+
+func (s *ConfigSuite) TestPebbleCriticalChecks(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Check(cfg.PebbleCriticalChecks(), jc.DeepEquals, config.CriticalPebbleChecks{})
+
+	cfg = newTestConfig(c, testing.Attrs{"pebble-critical-checks": "online, mysql:replication,mysql:ready"})
+	checks := cfg.PebbleCriticalChecks()
+	c.Check(checks, jc.DeepEquals, config.CriticalPebbleChecks{
+		Checks: []string{"online"},
+		ApplicationChecks: map[string][]string{
+			"mysql": {"replication", "ready"},
+		},
+	})
+	c.Check(checks.IsCritical("mysql", "online"), jc.IsTrue)
+	c.Check(checks.IsCritical("mysql", "replication"), jc.IsTrue)
+	c.Check(checks.IsCritical("postgresql", "replication"), jc.IsFalse)
+}
+
 func (s *ConfigSuite) TestEgressSubnets(c *gc.C) {
 	cfg := newTestConfig(c, testing.Attrs{
 		"egress-subnets": "10.0.0.1/32, 192.168.1.1/16",
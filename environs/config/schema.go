@@ -578,6 +578,31 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	HookTimeout: {
+		Description: "How long a charm hook can run for before it is killed, in human-readable time format (default 0s, which never kills hooks)",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	HookTimeoutOverrides: {
+		Description: `The timeouts for specific kinds of charm hook, which take precedence over hook-timeout, eg "install=1h,config-changed=10m". An override may be qualified by the application it applies to, with "*" matching every hook of the application, eg "mysql:install=2h,mysql:*=1h"`,
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	HookSnapshots: {
+		Description: "Whether units record a snapshot of the environment and data each hook runs with, so the hook can be replayed with juju replay-hook",
+		Type:        configschema.Tbool,
+		Group:       configschema.EnvironGroup,
+	},
+	WorkloadMetricsRetention: {
+		Description: "How long the workload metrics published by charms with metric-add are kept for, in human-readable time format (default 24h)",
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
+	PebbleCriticalChecks: {
+		Description: `A comma-separated list of Pebble checks that put a unit's workload status into error while they are failing, eg "online,mysql:replication". A check may be qualified by the application it applies to`,
+		Type:        configschema.Tstring,
+		Group:       configschema.EnvironGroup,
+	},
 	EgressSubnets: {
 		Description: "Source address(es) for traffic originating from this model",
 		Type:        configschema.Tstring,
@@ -324,8 +324,9 @@ AFTER UPDATE ON {{.Name}} FOR EACH ROW
 WHEN {{range $index, $column := .ColumnInfos}}
 	{{ (generateUpdateCompare $column) }} {{if (notLast $index $total)}}OR{{end}}{{end}}
 BEGIN
-    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
-    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, RTRIM({{range $index, $column := .ColumnInfos}}
+        CASE WHEN {{ (generateUpdateCompare $column) }} THEN '{{$column.Name}},' ELSE '' END{{if (notLast $index $total)}} ||{{end}}{{end}}, ','), DATETIME('now'));
 END;
 -- delete trigger for {{title .Name}}
 CREATE TRIGGER trg_log_{{.Name}}_delete
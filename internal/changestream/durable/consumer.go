@@ -0,0 +1,263 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package durable
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/changestream"
+	coredatabase "github.com/juju/juju/core/database"
+)
+
+const (
+	// ErrConsumerNotFound is returned when the durable consumer has not been
+	// registered.
+	ErrConsumerNotFound = errors.ConstError("durable consumer not found")
+
+	// ErrConsumerEvicted is returned when the durable consumer has fallen
+	// too far behind the change log and has been evicted by the pruner. The
+	// consumer must perform a full resync and then reset its position.
+	ErrConsumerEvicted = errors.ConstError("durable consumer evicted")
+)
+
+// Change represents a single change log entry read by a durable consumer.
+type Change struct {
+	id             int64
+	changeType     int
+	namespace      string
+	changed        string
+	changedColumns []string
+}
+
+// ID returns the change log ID of the change. This can be used as a resume
+// token for the consumer.
+func (c Change) ID() int64 {
+	return c.id
+}
+
+// Type returns the type of change (create, update, delete).
+func (c Change) Type() changestream.ChangeType {
+	return changestream.ChangeType(c.changeType)
+}
+
+// Namespace returns the namespace of the change. This is normally the
+// table name.
+func (c Change) Namespace() string {
+	return c.namespace
+}
+
+// Changed returns the changed value of event. This logically can be
+// the primary key of the row that was changed or the field of the change
+// that was changed.
+func (c Change) Changed() string {
+	return c.changed
+}
+
+// ChangedColumns returns the columns that were changed for an update.
+// If the columns are not known, then nil is returned.
+func (c Change) ChangedColumns() []string {
+	return c.changedColumns
+}
+
+// Consumer is a change log consumer that persists the last change log ID
+// that it has processed, allowing it to resume after a restart.
+type Consumer struct {
+	id string
+	db coredatabase.TxnRunner
+}
+
+// NewConsumer returns a new durable consumer with the given id, reading from
+// the change log of the given database.
+func NewConsumer(id string, db coredatabase.TxnRunner) *Consumer {
+	return &Consumer{
+		id: id,
+		db: db,
+	}
+}
+
+// ID returns the id of the consumer.
+func (c *Consumer) ID() string {
+	return c.id
+}
+
+// The WHERE clause is required, otherwise SQLite parses the ON CONFLICT
+// clause of the upsert as a join constraint of the SELECT.
+const registerQuery = `
+INSERT INTO change_log_durable_consumer (consumer_id, last_seen_id, updated_at)
+SELECT ?, IFNULL(MAX(id), -1), DATETIME('now') FROM change_log
+WHERE true
+ON CONFLICT (consumer_id) DO NOTHING;
+`
+
+const selectConsumerQuery = `
+SELECT last_seen_id, evicted_at IS NOT NULL
+FROM change_log_durable_consumer
+WHERE consumer_id = ?;
+`
+
+// Register registers the consumer, so that the pruner will retain the change
+// log entries that the consumer has not yet seen. It returns the last change
+// log ID that the consumer has processed. New consumers start from the latest
+// change log entry, it is expected that they will perform a full sync first.
+// If the consumer has been evicted, ErrConsumerEvicted is returned.
+func (c *Consumer) Register(ctx context.Context) (int64, error) {
+	var lastSeen int64
+	err := c.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, registerQuery, c.id); err != nil {
+			return errors.Annotatef(err, "registering durable consumer %q", c.id)
+		}
+
+		var err error
+		lastSeen, err = c.lastSeen(ctx, tx)
+		return errors.Trace(err)
+	})
+	return lastSeen, errors.Trace(err)
+}
+
+const selectChangesQuery = `
+SELECT c.id, c.edit_type_id, n.namespace, c.changed, c.changed_columns
+	FROM change_log c
+		JOIN change_log_namespace n ON c.namespace_id = n.id
+	WHERE c.id > ?
+	ORDER BY c.id
+	LIMIT ?;
+`
+
+// Changes returns up to limit changes that were written to the change log
+// after the given change log ID, in the order they were written. Changes are
+// not coalesced. If the consumer has been evicted, ErrConsumerEvicted is
+// returned.
+func (c *Consumer) Changes(ctx context.Context, after int64, limit int) ([]Change, error) {
+	var changes []Change
+	err := c.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		// Check the consumer inside of the same transaction, to ensure that
+		// the pruner hasn't evicted us, which would leave a gap in the
+		// changes returned.
+		if _, err := c.lastSeen(ctx, tx); err != nil {
+			return errors.Trace(err)
+		}
+
+		rows, err := tx.QueryContext(ctx, selectChangesQuery, after, limit)
+		if err != nil {
+			return errors.Annotate(err, "querying for changes")
+		}
+		defer rows.Close()
+
+		changes = nil
+		for rows.Next() {
+			var (
+				change         Change
+				changedColumns sql.NullString
+			)
+			if err := rows.Scan(
+				&change.id,
+				&change.changeType,
+				&change.namespace,
+				&change.changed,
+				&changedColumns,
+			); err != nil {
+				return errors.Annotate(err, "scanning change")
+			}
+			if changedColumns.Valid {
+				change.changedColumns = strings.Split(changedColumns.String, ",")
+			}
+			changes = append(changes, change)
+		}
+		return errors.Trace(rows.Err())
+	})
+	return changes, errors.Trace(err)
+}
+
+const checkpointQuery = `
+UPDATE change_log_durable_consumer
+SET last_seen_id = ?,
+	updated_at = DATETIME('now')
+WHERE consumer_id = ?
+AND evicted_at IS NULL
+AND last_seen_id <= ?;
+`
+
+// Checkpoint records the last change log ID that the consumer has processed.
+// Checkpointing an earlier change log ID than the one recorded is a no-op.
+// If the consumer has been evicted, ErrConsumerEvicted is returned.
+func (c *Consumer) Checkpoint(ctx context.Context, id int64) error {
+	err := c.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := c.lastSeen(ctx, tx); err != nil {
+			return errors.Trace(err)
+		}
+		if _, err := tx.ExecContext(ctx, checkpointQuery, id, c.id, id); err != nil {
+			return errors.Annotatef(err, "checkpointing durable consumer %q", c.id)
+		}
+		return nil
+	})
+	return errors.Trace(err)
+}
+
+const resetQuery = `
+UPDATE change_log_durable_consumer
+SET last_seen_id = (SELECT IFNULL(MAX(id), -1) FROM change_log),
+	evicted_at = NULL,
+	updated_at = DATETIME('now')
+WHERE consumer_id = ?;
+`
+
+// Reset moves the consumer to the latest change log entry and clears any
+// eviction. This should be called once the consumer has performed a full
+// resync. The new last seen change log ID is returned.
+func (c *Consumer) Reset(ctx context.Context) (int64, error) {
+	var lastSeen int64
+	err := c.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, resetQuery, c.id)
+		if err != nil {
+			return errors.Annotatef(err, "resetting durable consumer %q", c.id)
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return errors.Annotatef(err, "resetting durable consumer %q", c.id)
+		} else if affected == 0 {
+			return errors.Annotatef(ErrConsumerNotFound, "resetting %q", c.id)
+		}
+
+		lastSeen, err = c.lastSeen(ctx, tx)
+		return errors.Trace(err)
+	})
+	return lastSeen, errors.Trace(err)
+}
+
+const unregisterQuery = `
+DELETE FROM change_log_durable_consumer
+WHERE consumer_id = ?;
+`
+
+// Unregister removes the consumer, the pruner will no longer retain change
+// log entries for it.
+func (c *Consumer) Unregister(ctx context.Context) error {
+	err := c.db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, unregisterQuery, c.id); err != nil {
+			return errors.Annotatef(err, "unregistering durable consumer %q", c.id)
+		}
+		return nil
+	})
+	return errors.Trace(err)
+}
+
+func (c *Consumer) lastSeen(ctx context.Context, tx *sql.Tx) (int64, error) {
+	var (
+		lastSeen int64
+		evicted  bool
+	)
+	row := tx.QueryRowContext(ctx, selectConsumerQuery, c.id)
+	if err := row.Scan(&lastSeen, &evicted); errors.Is(err, sql.ErrNoRows) {
+		return -1, errors.Annotatef(ErrConsumerNotFound, "%q", c.id)
+	} else if err != nil {
+		return -1, errors.Annotatef(err, "reading durable consumer %q", c.id)
+	}
+	if evicted {
+		return -1, errors.Annotatef(ErrConsumerEvicted, "%q", c.id)
+	}
+	return lastSeen, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package durable
+
+import (
+	"context"
+	"database/sql"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type consumerSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&consumerSuite{})
+
+func (s *consumerSuite) SetUpTest(c *gc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.insertNamespace(c, 1000, "foo")
+}
+
+func (s *consumerSuite) TestRegisterStartsFromLatest(c *gc.C) {
+	s.insertChange(c, "a")
+	s.insertChange(c, "b")
+	latest := s.latestChangeLogID(c)
+
+	consumer := NewConsumer("audit", s.TxnRunner())
+	lastSeen, err := consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(lastSeen, gc.Equals, latest)
+}
+
+func (s *consumerSuite) TestRegisterResumes(c *gc.C) {
+	consumer := NewConsumer("audit", s.TxnRunner())
+	lastSeen, err := consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.insertChange(c, "a")
+	s.insertChange(c, "b")
+
+	changes, err := consumer.Changes(context.Background(), lastSeen, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(changes, gc.HasLen, 2)
+
+	err = consumer.Checkpoint(context.Background(), changes[0].ID())
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Registering again, i.e. after a restart, resumes from the checkpoint.
+	consumer = NewConsumer("audit", s.TxnRunner())
+	lastSeen, err = consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(lastSeen, gc.Equals, changes[0].ID())
+
+	changes, err = consumer.Changes(context.Background(), lastSeen, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(changes, gc.HasLen, 1)
+	c.Check(changes[0].Namespace(), gc.Equals, "foo")
+	c.Check(changes[0].Changed(), gc.Equals, "b")
+}
+
+func (s *consumerSuite) TestChangesAreNotCoalesced(c *gc.C) {
+	consumer := NewConsumer("audit", s.TxnRunner())
+	lastSeen, err := consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.insertChange(c, "a")
+	s.insertChange(c, "a")
+	s.insertChange(c, "b")
+
+	changes, err := consumer.Changes(context.Background(), lastSeen, 2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(changes, gc.HasLen, 2)
+	c.Check(changes[0].Changed(), gc.Equals, "a")
+	c.Check(changes[1].Changed(), gc.Equals, "a")
+	c.Check(changes[0].ID() < changes[1].ID(), jc.IsTrue)
+}
+
+func (s *consumerSuite) TestCheckpointDoesNotMoveBackwards(c *gc.C) {
+	consumer := NewConsumer("audit", s.TxnRunner())
+	_, err := consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = consumer.Checkpoint(context.Background(), 10)
+	c.Assert(err, jc.ErrorIsNil)
+	err = consumer.Checkpoint(context.Background(), 5)
+	c.Assert(err, jc.ErrorIsNil)
+
+	lastSeen, err := consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(lastSeen, gc.Equals, int64(10))
+}
+
+func (s *consumerSuite) TestCheckpointNotRegistered(c *gc.C) {
+	consumer := NewConsumer("audit", s.TxnRunner())
+	err := consumer.Checkpoint(context.Background(), 10)
+	c.Assert(err, jc.ErrorIs, ErrConsumerNotFound)
+}
+
+func (s *consumerSuite) TestEvictedConsumer(c *gc.C) {
+	consumer := NewConsumer("audit", s.TxnRunner())
+	_, err := consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.evict(c, "audit")
+
+	_, err = consumer.Changes(context.Background(), -1, 10)
+	c.Assert(err, jc.ErrorIs, ErrConsumerEvicted)
+
+	err = consumer.Checkpoint(context.Background(), 10)
+	c.Assert(err, jc.ErrorIs, ErrConsumerEvicted)
+
+	_, err = consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIs, ErrConsumerEvicted)
+
+	// Once the consumer has resynced, it can be reset to the latest change.
+	s.insertChange(c, "a")
+	lastSeen, err := consumer.Reset(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(lastSeen, gc.Equals, s.latestChangeLogID(c))
+
+	changes, err := consumer.Changes(context.Background(), lastSeen, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changes, gc.HasLen, 0)
+}
+
+func (s *consumerSuite) TestUnregister(c *gc.C) {
+	consumer := NewConsumer("audit", s.TxnRunner())
+	_, err := consumer.Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = consumer.Unregister(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = consumer.Changes(context.Background(), -1, 10)
+	c.Assert(err, jc.ErrorIs, ErrConsumerNotFound)
+}
+
+func (s *consumerSuite) insertNamespace(c *gc.C, id int, name string) {
+	_, err := s.DB().Exec(`INSERT INTO change_log_namespace VALUES (?, ?, ?);`, id, name, "blah")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *consumerSuite) insertChange(c *gc.C, changed string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `INSERT INTO change_log (edit_type_id, namespace_id, changed) VALUES (2, 1000, ?)`, changed)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *consumerSuite) evict(c *gc.C, id string) {
+	_, err := s.DB().Exec(`UPDATE change_log_durable_consumer SET evicted_at = DATETIME('now') WHERE consumer_id = ?`, id)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *consumerSuite) latestChangeLogID(c *gc.C) int64 {
+	var id int64
+	err := s.DB().QueryRow(`SELECT IFNULL(MAX(id), -1) FROM change_log`).Scan(&id)
+	c.Assert(err, jc.ErrorIsNil)
+	return id
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 Canonical Ltd. Licensed under the AGPLv3, see LICENCE file for
+// details.
+//
+// Package durable provides change log consumers that survive restarts.
+//
+// Subscriptions to the internal/changestream/eventmultiplexer are in-memory
+// only. If the subscriber is restarted, any changes that happened in the
+// meantime are lost and the subscriber must perform a full resync. A durable
+// consumer instead records the last change log ID that it has processed in the
+// change_log_durable_consumer table, and resumes reading the change log from
+// that ID after a restart.
+//
+// The changestreampruner worker honours registered durable consumers, retaining
+// change log entries that a consumer has not yet seen. To prevent a stalled
+// consumer from causing the change log to grow without bound, a consumer that
+// falls too far behind is evicted. An evicted consumer must perform a full
+// resync and then Reset its position before reading changes again.
+//
+// Unlike the stream worker, changes read by a durable consumer are not
+// coalesced, and each change carries the change log ID, which can be used as a
+// resume token.
+//
+// Durable subscriptions are created with the SubscribeDurable method of the
+// watchable database, which is an implementation of
+// changestream.DurableEventSource. The event multiplexer signals the
+// subscription when a matching change is witnessed, at which point the
+// consumer reads the change log from its last seen change log ID.
+
+package durable
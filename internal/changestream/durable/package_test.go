@@ -0,0 +1,32 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package durable
+
+import (
+	"testing"
+
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/schema"
+	domaintesting "github.com/juju/juju/domain/schema/testing"
+	databasetesting "github.com/juju/juju/internal/database/testing"
+)
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
+
+type baseSuite struct {
+	databasetesting.DqliteSuite
+}
+
+// SetUpTest is responsible for setting up a testing database suite initialised
+// with the controller schema.
+func (s *baseSuite) SetUpTest(c *gc.C) {
+	s.DqliteSuite.SetUpTest(c)
+	s.DqliteSuite.ApplyDDL(c, &domaintesting.SchemaApplier{
+		Schema:  schema.ControllerDDL(),
+		Verbose: s.Verbose,
+	})
+}
@@ -0,0 +1,265 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package eventmultiplexer
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/internal/changestream/durable"
+)
+
+const (
+	// DefaultDurableBatchSize is the maximum number of changes that a durable
+	// subscription reads from the change log at once.
+	DefaultDurableBatchSize = 1000
+)
+
+// DurableConsumer represents a change log consumer that persists the last
+// change log ID that it has processed.
+type DurableConsumer interface {
+	// Register registers the consumer, returning the last change log ID that
+	// the consumer has processed.
+	Register(ctx context.Context) (int64, error)
+
+	// Changes returns up to limit changes that were written to the change
+	// log after the given change log ID.
+	Changes(ctx context.Context, after int64, limit int) ([]durable.Change, error)
+
+	// Checkpoint records the last change log ID that the consumer has
+	// processed.
+	Checkpoint(ctx context.Context, id int64) error
+}
+
+// SubscribeDurable creates a new durable subscription for the consumer. The
+// subscription first replays the changes that the consumer has not yet
+// processed, then reads the change log each time the event queue witnesses a
+// change matching the options. Unlike Subscribe, changes are not coalesced,
+// and the subscriber is expected to checkpoint the changes it has processed.
+func (e *EventMultiplexer) SubscribeDurable(consumer DurableConsumer, opts ...changestream.SubscriptionOption) (changestream.DurableSubscription, error) {
+	// Subscribe to the event queue before reading the change log, so that
+	// a change written in between can't be missed.
+	sub, err := e.Subscribe(opts...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	durableSub, err := newDurableSubscription(consumer, sub, opts)
+	if err != nil {
+		sub.Unsubscribe()
+		return nil, errors.Trace(err)
+	}
+	return durableSub, nil
+}
+
+// durableSubscription reads changes from the change log on behalf of a
+// durable consumer, using a subscription to the event queue to know when to
+// read again.
+type durableSubscription struct {
+	tomb tomb.Tomb
+
+	consumer DurableConsumer
+	sub      changestream.Subscription
+	opts     []changestream.SubscriptionOption
+	lastSeen int64
+
+	changes chan []changestream.DurableChangeEvent
+	pending chan struct{}
+}
+
+func newDurableSubscription(consumer DurableConsumer, sub changestream.Subscription, opts []changestream.SubscriptionOption) (*durableSubscription, error) {
+	lastSeen, err := consumer.Register(context.Background())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	s := &durableSubscription{
+		consumer: consumer,
+		sub:      sub,
+		opts:     opts,
+		lastSeen: lastSeen,
+		changes:  make(chan []changestream.DurableChangeEvent),
+		// Always read the change log once, to replay the changes that
+		// happened whilst the consumer was not running.
+		pending: make(chan struct{}, 1),
+	}
+	s.pending <- struct{}{}
+
+	s.tomb.Go(func() error {
+		s.tomb.Go(s.witnessLoop)
+		return s.loop()
+	})
+
+	return s, nil
+}
+
+// Changes returns the channel that the subscription will receive changes on.
+func (s *durableSubscription) Changes() <-chan []changestream.DurableChangeEvent {
+	return s.changes
+}
+
+// Checkpoint records the change log ID of the last change that the
+// subscriber has processed.
+func (s *durableSubscription) Checkpoint(ctx context.Context, id int64) error {
+	return errors.Trace(s.consumer.Checkpoint(ctx, id))
+}
+
+// Unsubscribe stops the subscription, retaining the checkpoint.
+func (s *durableSubscription) Unsubscribe() {
+	s.tomb.Kill(nil)
+}
+
+// Done provides a way to know from the consumer side if the underlying
+// subscription has been terminated.
+func (s *durableSubscription) Done() <-chan struct{} {
+	return s.tomb.Dying()
+}
+
+// Err returns the error that terminated the subscription.
+func (s *durableSubscription) Err() error {
+	select {
+	case <-s.tomb.Dying():
+	default:
+		return nil
+	}
+	if err := s.tomb.Err(); err != tomb.ErrDying {
+		return err
+	}
+	return nil
+}
+
+// Kill implements worker.Worker.
+func (s *durableSubscription) Kill() {
+	s.tomb.Kill(nil)
+}
+
+// Wait implements worker.Worker.
+func (s *durableSubscription) Wait() error {
+	return s.tomb.Wait()
+}
+
+// witnessLoop consumes the changes dispatched by the event queue, so that the
+// subscription isn't unsubscribed for being unresponsive whilst the
+// subscriber is processing changes. The changes themselves are discarded,
+// they're only used to signal that the change log should be read again.
+func (s *durableSubscription) witnessLoop() error {
+	defer s.unsubscribe()
+
+	for {
+		select {
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+		case <-s.sub.Done():
+			return errors.New("event queue subscription terminated")
+		case _, ok := <-s.sub.Changes():
+			if !ok {
+				return errors.New("event queue subscription closed")
+			}
+			select {
+			case s.pending <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// unsubscribe removes the subscription from the event queue. The changes are
+// drained whilst unsubscribing, as the event queue won't process the
+// unsubscription whilst it is dispatching changes to the subscription.
+func (s *durableSubscription) unsubscribe() {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.sub.Unsubscribe()
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		case _, ok := <-s.sub.Changes():
+			if !ok {
+				// The changes are closed once unsubscribed, so stop
+				// draining them rather than spinning until done.
+				<-done
+				return
+			}
+		}
+	}
+}
+
+func (s *durableSubscription) loop() error {
+	ctx := s.tomb.Context(context.Background())
+
+	for {
+		select {
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+		case <-s.pending:
+		}
+
+		if err := s.readChanges(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+}
+
+// readChanges reads the change log until it's exhausted, sending the changes
+// that match the subscription options to the subscriber.
+func (s *durableSubscription) readChanges(ctx context.Context) error {
+	for {
+		changes, err := s.consumer.Changes(ctx, s.lastSeen, DefaultDurableBatchSize)
+		if err != nil {
+			return errors.Trace(err)
+		} else if len(changes) == 0 {
+			return nil
+		}
+		s.lastSeen = changes[len(changes)-1].ID()
+
+		events := s.filter(changes)
+		if len(events) == 0 {
+			continue
+		}
+
+		select {
+		case <-s.tomb.Dying():
+			return tomb.ErrDying
+		case s.changes <- events:
+		}
+	}
+}
+
+func (s *durableSubscription) filter(changes []durable.Change) []changestream.DurableChangeEvent {
+	var events []changestream.DurableChangeEvent
+	for _, change := range changes {
+		if s.matches(change) {
+			events = append(events, change)
+		}
+	}
+	return events
+}
+
+// matches returns true if the change matches any of the subscription
+// options. A subscription without options matches all changes, as it does
+// for the event queue.
+func (s *durableSubscription) matches(change changestream.ChangeEvent) bool {
+	if len(s.opts) == 0 {
+		return true
+	}
+	for _, opt := range s.opts {
+		if opt.Namespace() != change.Namespace() {
+			continue
+		}
+		if (change.Type() & opt.ChangeMask()) == 0 {
+			continue
+		}
+		if opt.Filter()(change) {
+			return true
+		}
+	}
+	return false
+}
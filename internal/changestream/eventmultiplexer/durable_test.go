@@ -0,0 +1,242 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package eventmultiplexer
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/changestream"
+	changestreamtesting "github.com/juju/juju/core/changestream/testing"
+	"github.com/juju/juju/internal/changestream/durable"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+)
+
+type durableSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&durableSuite{})
+
+func (s *durableSuite) SetUpTest(c *gc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.insertNamespace(c, 1000, "foo")
+	s.insertNamespace(c, 1001, "bar")
+}
+
+func (s *durableSuite) TestSubscribeDurableReplaysChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	queue := s.newQueue(c, make(chan changestream.Term))
+	defer workertest.CleanKill(c, queue)
+
+	_, err := durable.NewConsumer("audit", s.TxnRunner()).Register(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.insertChange(c, 1000, "a")
+	s.insertChange(c, 1001, "x")
+	s.insertChange(c, 1000, "b")
+
+	sub := s.subscribeDurable(c, queue, changestream.Namespace("foo", changestream.All))
+	changes := s.witnessChanges(c, sub)
+	c.Assert(changes, gc.HasLen, 2)
+	c.Check(changes[0].Changed(), gc.Equals, "a")
+	c.Check(changes[1].Changed(), gc.Equals, "b")
+
+	err = sub.Checkpoint(context.Background(), changes[1].ID())
+	c.Assert(err, jc.ErrorIsNil)
+	workertest.CleanKill(c, sub)
+
+	// Subscribing again, i.e. after a restart, resumes from the checkpoint.
+	s.insertChange(c, 1000, "c")
+
+	sub = s.subscribeDurable(c, queue, changestream.Namespace("foo", changestream.All))
+	defer workertest.CleanKill(c, sub)
+
+	changes = s.witnessChanges(c, sub)
+	c.Assert(changes, gc.HasLen, 1)
+	c.Check(changes[0].Changed(), gc.Equals, "c")
+}
+
+func (s *durableSuite) TestSubscribeDurableWitnessesChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	terms := make(chan changestream.Term)
+	queue := s.newQueue(c, terms)
+	defer workertest.CleanKill(c, queue)
+
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	sub := s.subscribeDurable(c, queue, changestream.Namespace("foo", changestream.All))
+	defer workertest.CleanKill(c, sub)
+
+	s.insertChange(c, 1000, "a")
+
+	// The change is read from the change log, once the event queue has
+	// witnessed it.
+	s.expectTerm(c, changeEvent{
+		ctype:   changestreamtesting.Update,
+		ns:      "foo",
+		changed: "a",
+	})
+	done := s.dispatchTerm(c, terms)
+
+	changes := s.witnessChanges(c, sub)
+	c.Assert(changes, gc.HasLen, 1)
+	c.Check(changes[0].Namespace(), gc.Equals, "foo")
+	c.Check(changes[0].Changed(), gc.Equals, "a")
+
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for term to be dispatched")
+	}
+}
+
+func (s *durableSuite) TestSubscribeDurableEvicted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	terms := make(chan changestream.Term)
+	queue := s.newQueue(c, terms)
+	defer workertest.CleanKill(c, queue)
+
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	sub := s.subscribeDurable(c, queue, changestream.Namespace("foo", changestream.All))
+	defer workertest.DirtyKill(c, sub)
+
+	s.evict(c, "audit")
+
+	s.expectTerm(c, changeEvent{
+		ctype:   changestreamtesting.Update,
+		ns:      "foo",
+		changed: "a",
+	})
+	done := s.dispatchTerm(c, terms)
+
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for term to be dispatched")
+	}
+
+	select {
+	case <-sub.Done():
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for subscription to be done")
+	}
+	c.Check(sub.Wait(), jc.ErrorIs, durable.ErrConsumerEvicted)
+	c.Check(sub.Err(), jc.ErrorIs, durable.ErrConsumerEvicted)
+}
+
+func (s *durableSuite) TestUnsubscribeClosedChanges(c *gc.C) {
+	sub := &closedSubscription{
+		changes:      make(chan []changestream.ChangeEvent),
+		unsubscribed: make(chan struct{}),
+		release:      make(chan struct{}),
+	}
+	close(sub.changes)
+	durableSub := &durableSubscription{sub: sub}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		durableSub.unsubscribe()
+	}()
+
+	select {
+	case <-sub.unsubscribed:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for unsubscribe")
+	}
+	close(sub.release)
+
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for unsubscribe to return")
+	}
+
+	// Once the changes are found to be closed, they aren't read again.
+	c.Check(sub.changesCalls.Load(), gc.Equals, int64(1))
+}
+
+func (s *durableSuite) newQueue(c *gc.C, terms chan changestream.Term) *EventMultiplexer {
+	s.expectStreamDying(make(<-chan struct{}))
+	s.stream.EXPECT().Terms().Return(terms).AnyTimes()
+	s.metrics.EXPECT().SubscriptionsInc().AnyTimes()
+	s.metrics.EXPECT().SubscriptionsDec().AnyTimes()
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+	return queue
+}
+
+func (s *durableSuite) subscribeDurable(c *gc.C, queue *EventMultiplexer, opts ...changestream.SubscriptionOption) *durableSubscription {
+	sub, err := queue.SubscribeDurable(durable.NewConsumer("audit", s.TxnRunner()), opts...)
+	c.Assert(err, jc.ErrorIsNil)
+	return sub.(*durableSubscription)
+}
+
+func (s *durableSuite) witnessChanges(c *gc.C, sub *durableSubscription) []changestream.DurableChangeEvent {
+	select {
+	case changes := <-sub.Changes():
+		return changes
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for changes")
+	}
+	return nil
+}
+
+func (s *durableSuite) insertNamespace(c *gc.C, id int, name string) {
+	_, err := s.DB().Exec(`INSERT INTO change_log_namespace VALUES (?, ?, ?);`, id, name, "blah")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *durableSuite) insertChange(c *gc.C, namespaceID int, changed string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `INSERT INTO change_log (edit_type_id, namespace_id, changed) VALUES (2, ?, ?)`, namespaceID, changed)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *durableSuite) evict(c *gc.C, id string) {
+	_, err := s.DB().Exec(`UPDATE change_log_durable_consumer SET evicted_at = DATETIME('now') WHERE consumer_id = ?`, id)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// closedSubscription is a subscription whose changes are closed, which
+// blocks when unsubscribing until released.
+type closedSubscription struct {
+	changes      chan []changestream.ChangeEvent
+	changesCalls atomic.Int64
+	unsubscribed chan struct{}
+	release      chan struct{}
+}
+
+func (s *closedSubscription) Changes() <-chan []changestream.ChangeEvent {
+	s.changesCalls.Add(1)
+	return s.changes
+}
+
+func (s *closedSubscription) Unsubscribe() {
+	close(s.unsubscribed)
+	<-s.release
+}
+
+func (s *closedSubscription) Done() <-chan struct{} {
+	return nil
+}
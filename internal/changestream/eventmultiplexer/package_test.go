@@ -110,6 +110,11 @@ func (c changeEvent) Changed() string {
 	return c.changed
 }
 
+// ChangedColumns returns the columns that were changed for an update.
+func (c changeEvent) ChangedColumns() []string {
+	return nil
+}
+
 type waitGroup struct {
 	ch            chan struct{}
 	state, amount uint64
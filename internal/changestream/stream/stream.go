@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -455,8 +456,12 @@ const (
 	// transactions are inserted in that order.
 	// If the namespace is later deleted, you'll no longer locate that during
 	// a select.
+	// The changed columns are only known if every coalesced change recorded
+	// them, otherwise any column could have changed and NULL is returned.
 	selectQuery = `
-SELECT MAX(c.id), c.edit_type_id, n.namespace, changed, created_at
+SELECT MAX(c.id), c.edit_type_id, n.namespace, changed,
+	CASE WHEN COUNT(c.changed_columns) = COUNT(*) THEN GROUP_CONCAT(c.changed_columns) END,
+	created_at
 	FROM change_log c
 		JOIN change_log_edit_type t ON c.edit_type_id = t.id
 		JOIN change_log_namespace n ON c.namespace_id = n.id
@@ -470,11 +475,12 @@ SELECT MAX(c.id), c.edit_type_id, n.namespace, changed, created_at
 // struct instead of an interface. We should work out if this is a good idea
 // or not.
 type changeEvent struct {
-	id         int64
-	changeType int
-	namespace  string
-	changed    string
-	createdAt  string
+	id             int64
+	changeType     int
+	namespace      string
+	changed        string
+	changedColumns []string
+	createdAt      string
 }
 
 // Type returns the type of change (create, update, delete).
@@ -495,6 +501,12 @@ func (e changeEvent) Changed() string {
 	return e.changed
 }
 
+// ChangedColumns returns the columns that were changed for an update.
+// If the columns are not known, then nil is returned.
+func (e changeEvent) ChangedColumns() []string {
+	return e.changedColumns
+}
+
 func (s *Stream) readChanges() ([]changeEvent, error) {
 	// As this is a self instantiated query, we don't have a root context to tie
 	// to, so we create a new one that's cancellable.
@@ -509,6 +521,7 @@ func (s *Stream) readChanges() ([]changeEvent, error) {
 		}
 		defer rows.Close()
 
+		var changedColumns sql.NullString
 		dest := func(i int) []interface{} {
 			changes = append(changes, changeEvent{})
 			return []interface{}{
@@ -516,6 +529,7 @@ func (s *Stream) readChanges() ([]changeEvent, error) {
 				&changes[i].changeType,
 				&changes[i].namespace,
 				&changes[i].changed,
+				&changedColumns,
 				&changes[i].createdAt,
 			}
 		}
@@ -523,12 +537,34 @@ func (s *Stream) readChanges() ([]changeEvent, error) {
 			if err := rows.Scan(dest(i)...); err != nil {
 				return errors.Annotate(err, "scanning change")
 			}
+			if changedColumns.Valid {
+				changes[i].changedColumns = parseChangedColumns(changedColumns.String)
+			}
 		}
 		return nil
 	})
 	return changes, errors.Trace(err)
 }
 
+// parseChangedColumns splits the comma separated changed columns, removing
+// any duplicates that come from coalescing multiple changes.
+func parseChangedColumns(s string) []string {
+	columns := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, column := range strings.Split(s, ",") {
+		if column == "" {
+			continue
+		}
+		if _, ok := seen[column]; ok {
+			continue
+		}
+		seen[column] = struct{}{}
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
 const (
 	watermarkCreateQuery = `
 INSERT INTO change_log_witness
@@ -1374,6 +1374,57 @@ func (s *streamSuite) TestReadChangesWithMultipleChangesInterweavedGroupsCorrect
 	}
 }
 
+func (s *streamSuite) TestReadChangesWithChangedColumns(c *gc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+
+	uuid := uuid.MustNewUUID().String()
+	s.insertChangeWithColumns(c, change{id: 1000, uuid: uuid}, "life_id")
+
+	results, err := stream.readChanges()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(results, gc.HasLen, 1)
+	c.Check(results[0].Changed(), gc.Equals, uuid)
+	c.Check(results[0].ChangedColumns(), gc.DeepEquals, []string{"life_id"})
+}
+
+func (s *streamSuite) TestReadChangesWithChangedColumnsCoalesce(c *gc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+
+	uuid := uuid.MustNewUUID().String()
+	s.insertChangeWithColumns(c, change{id: 1000, uuid: uuid}, "life_id,charm_uuid")
+	s.insertChangeWithColumns(c, change{id: 1000, uuid: uuid}, "life_id")
+	s.insertChangeWithColumns(c, change{id: 1000, uuid: uuid}, "exposed")
+
+	results, err := stream.readChanges()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(results, gc.HasLen, 1)
+	c.Check(results[0].ChangedColumns(), gc.DeepEquals, []string{"charm_uuid", "exposed", "life_id"})
+}
+
+func (s *streamSuite) TestReadChangesWithUnknownChangedColumnsCoalesce(c *gc.C) {
+	stream := s.newStream()
+
+	s.insertNamespace(c, 1000, "foo")
+
+	// If any of the coalesced changes don't record the columns, then we
+	// can't know which columns changed.
+	uuid := uuid.MustNewUUID().String()
+	s.insertChangeForType(c, 1, change{id: 1000, uuid: uuid})
+	s.insertChangeWithColumns(c, change{id: 1000, uuid: uuid}, "life_id")
+
+	results, err := stream.readChanges()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(results, gc.HasLen, 1)
+	c.Check(results[0].ChangedColumns(), gc.IsNil)
+}
+
 func (s *streamSuite) TestProcessWatermark(c *gc.C) {
 	stream := s.newStream()
 
@@ -1548,6 +1599,15 @@ func (s *streamSuite) insertChangeForType(c *gc.C, changeType changestream.Chang
 	c.Logf("Committed insert change")
 }
 
+func (s *streamSuite) insertChangeWithColumns(c *gc.C, ch change, columns string) {
+	q := `INSERT INTO change_log (edit_type_id, namespace_id, changed, changed_columns) VALUES (2, ?, ?, ?)`
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, q, ch.id, ch.uuid, columns)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func expectChanges(c *gc.C, expected []change, obtained []changestream.ChangeEvent) {
 	c.Assert(obtained, gc.HasLen, len(expected))
 
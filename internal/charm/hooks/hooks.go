@@ -46,6 +46,11 @@ const (
 
 	UpdateStatus Kind = "update-status"
 
+	// The `schedule` hook requires an associated schedule name. The hook file
+	// names that this kind represents will be suffixed by the schedule name;
+	// for example, "schedule-backup".
+	Schedule Kind = "schedule"
+
 	// These hooks require an associated secret.
 	SecretChanged Kind = "secret-changed"
 	SecretExpired Kind = "secret-expired"
@@ -210,3 +215,8 @@ func (kind Kind) IsSecret() bool {
 	}
 	return false
 }
+
+// IsSchedule returns whether the Kind represents a schedule hook.
+func (kind Kind) IsSchedule() bool {
+	return kind == Schedule
+}
@@ -157,6 +157,10 @@ type Relation struct {
 	Optional  bool
 	Limit     int
 	Scope     RelationScope
+	// Schema optionally holds the JSON schemas which the data written
+	// to the relation's databags must match. It is read from the charm
+	// metadata when needed, so it is not stored with relation endpoints.
+	Schema *RelationSchema `bson:"-"`
 }
 
 // ImplementedBy returns whether the relation is implemented by the supplied charm.
@@ -228,6 +232,7 @@ type Meta struct {
 	Resources      map[string]resource.Meta `json:"Resources,omitempty"`
 	Terms          []string                 `json:"Terms,omitempty"`
 	MinJujuVersion version.Number           `json:"min-juju-version,omitempty"`
+	Schedules      map[string]Schedule      `json:"Schedules,omitempty"`
 
 	// v2
 	Containers map[string]Container    `json:"containers,omitempty" yaml:"containers,omitempty"`
@@ -297,6 +302,9 @@ func (m Meta) Hooks() map[string]bool {
 	for containerName := range m.Containers {
 		generateContainerHooks(containerName, allHooks)
 	}
+	for _, schedule := range m.Schedules {
+		allHooks[schedule.HookName()] = true
+	}
 	return allHooks
 }
 
@@ -524,6 +532,11 @@ func parseMeta(m map[string]interface{}) (*Meta, error) {
 		return nil, err
 	}
 
+	meta.Schedules, err = parseSchedules(m["schedules"])
+	if err != nil {
+		return nil, errors.Annotatef(err, "parsing schedules")
+	}
+
 	// v2 parsing
 	meta.Containers, err = parseContainers(m["containers"], meta.Resources, meta.Storage)
 	if err != nil {
@@ -563,6 +576,7 @@ func (m Meta) MarshalYAML() (interface{}, error) {
 		Resources      map[string]marshaledResourceMeta `yaml:"resources,omitempty"`
 		Containers     map[string]marshaledContainer    `yaml:"containers,omitempty"`
 		Assumes        *assumes.ExpressionTree          `yaml:"assumes,omitempty"`
+		Schedules      map[string]marshaledSchedule     `yaml:"schedules,omitempty"`
 	}{
 		Name:           m.Name,
 		Summary:        m.Summary,
@@ -581,6 +595,7 @@ func (m Meta) MarshalYAML() (interface{}, error) {
 		Resources:      marshaledResources(m.Resources),
 		Containers:     marshaledContainers(m.Containers),
 		Assumes:        m.Assumes,
+		Schedules:      marshaledSchedules(m.Schedules),
 	}, nil
 }
 
@@ -618,18 +633,20 @@ type marshaledRelation Relation
 func (r marshaledRelation) MarshalYAML() (interface{}, error) {
 	// See calls to ifaceExpander in charmSchema.
 	var noLimit int
-	if !r.Optional && r.Limit == noLimit && r.Scope == ScopeGlobal {
+	if !r.Optional && r.Limit == noLimit && r.Scope == ScopeGlobal && r.Schema == nil {
 		// All attributes are default, so use the simple string form of the relation.
 		return r.Interface, nil
 	}
 	mr := struct {
-		Interface string        `yaml:"interface"`
-		Limit     *int          `yaml:"limit,omitempty"`
-		Optional  bool          `yaml:"optional,omitempty"`
-		Scope     RelationScope `yaml:"scope,omitempty"`
+		Interface string          `yaml:"interface"`
+		Limit     *int            `yaml:"limit,omitempty"`
+		Optional  bool            `yaml:"optional,omitempty"`
+		Scope     RelationScope   `yaml:"scope,omitempty"`
+		Schema    *RelationSchema `yaml:"schema,omitempty"`
 	}{
 		Interface: r.Interface,
 		Optional:  r.Optional,
+		Schema:    r.Schema,
 	}
 	if r.Limit != noLimit {
 		mr.Limit = &r.Limit
@@ -715,6 +732,15 @@ func (m Meta) Check(format Format, reasons ...FormatSelectionReason) error {
 		}
 	}
 
+	for name, relation := range m.CombinedRelations() {
+		if relation.Schema == nil {
+			continue
+		}
+		if err := relation.Schema.Validate(); err != nil {
+			return errors.Errorf("charm %q relation %q: %v", m.Name, name, err)
+		}
+	}
+
 	names := make(map[string]bool)
 	for name, store := range m.Storage {
 		if store.Location != "" && store.Type != StorageFilesystem {
@@ -761,6 +787,12 @@ func (m Meta) Check(format Format, reasons ...FormatSelectionReason) error {
 		}
 	}
 
+	for _, schedule := range m.Schedules {
+		if err := schedule.Validate(); err != nil {
+			return errors.Annotatef(err, "charm %q", m.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -808,6 +840,7 @@ func parseRelations(relations interface{}, role RelationRole) map[string]Relatio
 			// the int range should be more than enough.
 			relation.Limit = int(relMap["limit"].(int64))
 		}
+		relation.Schema = parseRelationSchema(relMap["schema"])
 		result[name] = relation
 	}
 	return result
@@ -891,10 +924,12 @@ var ifaceSchema = schema.FieldMap(
 		"limit":     schema.OneOf(schema.Const(nil), schema.Int()),
 		"scope":     schema.OneOf(schema.Const(string(ScopeGlobal)), schema.Const(string(ScopeContainer))),
 		"optional":  schema.Bool(),
+		"schema":    relationSchemaSchema,
 	},
 	schema.Defaults{
 		"scope":    string(ScopeGlobal),
 		"optional": false,
+		"schema":   schema.Omit,
 	},
 )
 
@@ -1223,6 +1258,7 @@ var charmSchema = schema.FieldMap(
 		"assumes":          schema.List(schema.Any()),
 		"containers":       schema.StringMap(containerSchema),
 		"charm-user":       schema.String(),
+		"schedules":        schema.StringMap(scheduleSchema),
 	},
 	schema.Defaults{
 		"provides":         schema.Omit,
@@ -1242,6 +1278,7 @@ var charmSchema = schema.FieldMap(
 		"assumes":          schema.Omit,
 		"containers":       schema.Omit,
 		"charm-user":       schema.Omit,
+		"schedules":        schema.Omit,
 	},
 )
 
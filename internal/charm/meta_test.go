@@ -863,6 +863,19 @@ peers:
     peerLessSimple:
         interface: peery
         optional: true
+    peerWithSchema:
+        interface: peery
+        schema:
+            app:
+                type: object
+                properties:
+                    leader:
+                        type: string
+            unit:
+                type: object
+                properties:
+                    port:
+                        type: integer
 extra-bindings:
     extraBar:
     extraFoo1:
@@ -0,0 +1,179 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package charm
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/juju/errors"
+	gjs "github.com/juju/gojsonschema"
+	"github.com/juju/schema"
+)
+
+// RelationSchema holds the JSON schemas which the data written to the
+// databags of a relation endpoint must match.
+//
+// Relation data is always stored as strings, so each value is decoded as
+// JSON before it is validated, unless the schema allows the property to be
+// a string, in which case the raw value is validated. Values which are not
+// valid JSON are validated as strings.
+type RelationSchema struct {
+	// Application is the schema of the application databag.
+	Application map[string]interface{} `yaml:"app,omitempty" json:"app,omitempty"`
+
+	// Unit is the schema of each unit's databag.
+	Unit map[string]interface{} `yaml:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// Validate returns an error if either of the schemas is not a valid JSON
+// schema.
+func (s *RelationSchema) Validate() error {
+	if s.Application != nil {
+		if _, err := gjs.NewSchema(gjs.NewGoLoader(s.Application)); err != nil {
+			return errors.Annotate(err, "invalid application schema")
+		}
+	}
+	if s.Unit != nil {
+		if _, err := gjs.NewSchema(gjs.NewGoLoader(s.Unit)); err != nil {
+			return errors.Annotate(err, "invalid unit schema")
+		}
+	}
+	return nil
+}
+
+// ValidateApplicationSettings returns an error satisfying errors.NotValid
+// if the application databag settings don't match the schema.
+func (s *RelationSchema) ValidateApplicationSettings(settings map[string]string) error {
+	return errors.Trace(validateDatabag(s.Application, settings))
+}
+
+// ValidateUnitSettings returns an error satisfying errors.NotValid if the
+// unit databag settings don't match the schema.
+func (s *RelationSchema) ValidateUnitSettings(settings map[string]string) error {
+	return errors.Trace(validateDatabag(s.Unit, settings))
+}
+
+// DecodeSettings returns the databag settings with each value decoded from
+// JSON. Values of properties which the schema allows to be strings are
+// returned unchanged, so that "80" or "true" remain valid strings, as are
+// values which are not valid JSON.
+func DecodeSettings(jsonSchema map[string]interface{}, settings map[string]string) map[string]interface{} {
+	result := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		if allowsString(propertySchema(jsonSchema, k)) {
+			result[k] = v
+			continue
+		}
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(v), &decoded); err != nil {
+			decoded = v
+		}
+		result[k] = decoded
+	}
+	return result
+}
+
+// propertySchema returns the schema of the named property of an object
+// schema, or nil if the schema doesn't declare the property.
+func propertySchema(jsonSchema map[string]interface{}, name string) map[string]interface{} {
+	properties, _ := jsonSchema["properties"].(map[string]interface{})
+	property, _ := properties[name].(map[string]interface{})
+	return property
+}
+
+// allowsString reports whether the property schema declares that its value
+// may be a string, either through its type or, if it has no type, through
+// a string const or enum.
+func allowsString(property map[string]interface{}) bool {
+	switch t := property["type"].(type) {
+	case string:
+		return t == "string"
+	case []interface{}:
+		for _, v := range t {
+			if v == "string" {
+				return true
+			}
+		}
+		return false
+	}
+	if c, ok := property["const"]; ok {
+		_, isString := c.(string)
+		return isString
+	}
+	enum, ok := property["enum"].([]interface{})
+	if !ok || len(enum) == 0 {
+		return false
+	}
+	for _, v := range enum {
+		if _, isString := v.(string); !isString {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonSchemaC coerces a JSON schema read from metadata.yaml into maps keyed
+// only with strings, as required by the schema validator.
+type jsonSchemaC struct{}
+
+func (jsonSchemaC) Coerce(v interface{}, path []string) (interface{}, error) {
+	v, err := mapC.Coerce(v, path)
+	if err != nil {
+		return nil, err
+	}
+	cleansed, err := cleanse(v)
+	if err != nil {
+		return nil, errors.Errorf("%s: %v", strings.Join(path[1:], ""), err)
+	}
+	return cleansed, nil
+}
+
+var relationSchemaSchema = schema.FieldMap(
+	schema.Fields{
+		"app":  jsonSchemaC{},
+		"unit": jsonSchemaC{},
+	},
+	schema.Defaults{
+		"app":  schema.Omit,
+		"unit": schema.Omit,
+	},
+)
+
+func parseRelationSchema(v interface{}) *RelationSchema {
+	if v == nil {
+		return nil
+	}
+	m := v.(map[string]interface{})
+	result := &RelationSchema{}
+	if app, ok := m["app"]; ok {
+		result.Application = app.(map[string]interface{})
+	}
+	if unit, ok := m["unit"]; ok {
+		result.Unit = unit.(map[string]interface{})
+	}
+	return result
+}
+
+func validateDatabag(jsonSchema map[string]interface{}, settings map[string]string) error {
+	if jsonSchema == nil {
+		return nil
+	}
+	s, err := gjs.NewSchema(gjs.NewGoLoader(jsonSchema))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	results, err := s.Validate(gjs.NewGoLoader(DecodeSettings(jsonSchema, settings)))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if results.Valid() {
+		return nil
+	}
+	var errorStrings []string
+	for _, validationError := range results.Errors() {
+		errorStrings = append(errorStrings, validationError.String())
+	}
+	return errors.NewNotValid(nil, "validation failed: "+strings.Join(errorStrings, "; "))
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package charm_test
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/charm"
+)
+
+type RelationSchemaSuite struct{}
+
+var _ = gc.Suite(&RelationSchemaSuite{})
+
+const relationSchemaMeta = `
+name: a
+summary: b
+description: c
+provides:
+  db:
+    interface: mysql
+    schema:
+      app:
+        type: object
+        properties:
+          database:
+            type: string
+        required: [database]
+      unit:
+        type: object
+        properties:
+          port:
+            type: integer
+            minimum: 1
+          tls:
+            type: boolean
+  admin: mysql-root
+`
+
+func (s *RelationSchemaSuite) TestParse(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(relationSchemaMeta))
+	c.Assert(err, jc.ErrorIsNil)
+	err = meta.Check(charm.FormatV2, charm.SelectionManifest)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(meta.Provides["admin"].Schema, gc.IsNil)
+	schema := meta.Provides["db"].Schema
+	c.Assert(schema, gc.NotNil)
+	c.Check(schema.Application, jc.DeepEquals, map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"database": map[string]interface{}{"type": "string"},
+		},
+		"required": []interface{}{"database"},
+	})
+	c.Check(schema.Unit["type"], gc.Equals, "object")
+}
+
+func (s *RelationSchemaSuite) TestParseOnlyUnitSchema(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+requires:
+  db:
+    interface: mysql
+    schema:
+      unit:
+        type: object
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	schema := meta.Requires["db"].Schema
+	c.Assert(schema, gc.NotNil)
+	c.Check(schema.Application, gc.IsNil)
+	c.Check(schema.Unit, jc.DeepEquals, map[string]interface{}{"type": "object"})
+}
+
+func (s *RelationSchemaSuite) TestParseRejectsReferences(c *gc.C) {
+	_, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+requires:
+  db:
+    interface: mysql
+    schema:
+      unit:
+        $ref: "http://example.com/schema.json"
+`))
+	c.Assert(err, gc.ErrorMatches, `.*schema key "\$ref" not compatible with this version of juju`)
+}
+
+func (s *RelationSchemaSuite) TestCheckInvalidSchema(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+requires:
+  db:
+    interface: mysql
+    schema:
+      app:
+        type: 42
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	err = meta.Check(charm.FormatV2, charm.SelectionManifest)
+	c.Assert(err, gc.ErrorMatches, `charm "a" relation "db": invalid application schema: .*`)
+}
+
+func (s *RelationSchemaSuite) TestValidateSettings(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(relationSchemaMeta))
+	c.Assert(err, jc.ErrorIsNil)
+	schema := meta.Provides["db"].Schema
+
+	err = schema.ValidateApplicationSettings(map[string]string{"database": "wordpress"})
+	c.Check(err, jc.ErrorIsNil)
+	err = schema.ValidateUnitSettings(map[string]string{"port": "3306", "tls": "true", "host": "10.0.0.1"})
+	c.Check(err, jc.ErrorIsNil)
+
+	err = schema.ValidateApplicationSettings(map[string]string{})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+	c.Check(err, gc.ErrorMatches, `validation failed: \(root\) : "database" property is missing and required, .*`)
+
+	err = schema.ValidateUnitSettings(map[string]string{"port": "http"})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+	c.Check(err, gc.ErrorMatches, `validation failed: \(root\).port : must be of type integer, given "http"`)
+}
+
+func (s *RelationSchemaSuite) TestValidateStringSettings(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(relationSchemaMeta))
+	c.Assert(err, jc.ErrorIsNil)
+	schema := meta.Provides["db"].Schema
+
+	// Strings which happen to be valid JSON are still valid strings.
+	for _, database := range []string{"80", "true", "null", "1.5", `"quoted"`} {
+		err = schema.ValidateApplicationSettings(map[string]string{"database": database})
+		c.Check(err, jc.ErrorIsNil, gc.Commentf("database %q", database))
+	}
+}
+
+func (s *RelationSchemaSuite) TestValidateWithoutSchema(c *gc.C) {
+	schema := &charm.RelationSchema{
+		Unit: map[string]interface{}{"type": "object"},
+	}
+	err := schema.ValidateApplicationSettings(map[string]string{"anything": "goes"})
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *RelationSchemaSuite) TestDecodeSettings(c *gc.C) {
+	decoded := charm.DecodeSettings(nil, map[string]string{
+		"port":    "3306",
+		"tls":     "true",
+		"hosts":   `["a", "b"]`,
+		"address": "10.0.0.1",
+	})
+	c.Check(decoded, jc.DeepEquals, map[string]interface{}{
+		"port":    float64(3306),
+		"tls":     true,
+		"hosts":   []interface{}{"a", "b"},
+		"address": "10.0.0.1",
+	})
+}
+
+func (s *RelationSchemaSuite) TestDecodeSettingsStringProperties(c *gc.C) {
+	jsonSchema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":    map[string]interface{}{"type": "string"},
+			"version": map[string]interface{}{"type": []interface{}{"string", "null"}},
+			"mode":    map[string]interface{}{"enum": []interface{}{"1", "2"}},
+			"level":   map[string]interface{}{"enum": []interface{}{1, 2}},
+			"port":    map[string]interface{}{"type": "integer"},
+		},
+	}
+	decoded := charm.DecodeSettings(jsonSchema, map[string]string{
+		"name":    "80",
+		"version": "null",
+		"mode":    "1",
+		"level":   "1",
+		"port":    "3306",
+		"other":   "true",
+	})
+	c.Check(decoded, jc.DeepEquals, map[string]interface{}{
+		"name":    "80",
+		"version": "null",
+		"mode":    "1",
+		"level":   float64(1),
+		"port":    float64(3306),
+		"other":   true,
+	})
+}
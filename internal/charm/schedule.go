@@ -0,0 +1,346 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package charm
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+)
+
+// CatchUpPolicy defines what happens to the runs of a schedule which were
+// missed while the unit agent was not running.
+type CatchUpPolicy string
+
+const (
+	// CatchUpSkip skips the missed runs; the schedule next runs at its
+	// next due time.
+	CatchUpSkip CatchUpPolicy = "skip"
+
+	// CatchUpOnce runs the schedule once as soon as possible, however many
+	// runs were missed.
+	CatchUpOnce CatchUpPolicy = "once"
+)
+
+// Schedule defines a named schedule on which the schedule-<name> hook is
+// run. Exactly one of Cron and Interval is set.
+type Schedule struct {
+	// Name is the name of the schedule.
+	Name string `json:"name" yaml:"name"`
+
+	// Cron is a cron expression, in the standard five field format or one
+	// of the @hourly, @daily, @weekly, @monthly or @yearly shortcuts.
+	// Cron expressions are evaluated in UTC.
+	Cron string `json:"cron,omitempty" yaml:"cron,omitempty"`
+
+	// Interval is the time between runs of the schedule.
+	Interval time.Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+
+	// Jitter is the maximum random delay added to each run, so that the
+	// units of an application don't all run the hook at the same time.
+	Jitter time.Duration `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+
+	// CatchUp defines what happens to runs missed while the unit agent
+	// was not running. It defaults to CatchUpSkip.
+	CatchUp CatchUpPolicy `json:"catch-up,omitempty" yaml:"catch-up,omitempty"`
+}
+
+// HookName returns the name of the hook run by the schedule.
+func (s Schedule) HookName() string {
+	return ScheduleHookName(s.Name)
+}
+
+// ScheduleHookName returns the name of the hook run by the named schedule.
+func ScheduleHookName(name string) string {
+	return "schedule-" + name
+}
+
+// Validate returns an error if the schedule is not valid.
+func (s Schedule) Validate() error {
+	if !validScheduleName.MatchString(s.Name) {
+		return errors.NotValidf("schedule name %q", s.Name)
+	}
+	switch {
+	case s.Cron == "" && s.Interval == 0:
+		return errors.NotValidf("schedule %q without cron or interval", s.Name)
+	case s.Cron != "" && s.Interval != 0:
+		return errors.NotValidf("schedule %q with both cron and interval", s.Name)
+	case s.Interval < 0:
+		return errors.NotValidf("schedule %q interval %v", s.Name, s.Interval)
+	case s.Interval > 0 && s.Interval < time.Minute:
+		return errors.NotValidf("schedule %q interval %v less than a minute", s.Name, s.Interval)
+	case s.Jitter < 0:
+		return errors.NotValidf("schedule %q jitter %v", s.Name, s.Jitter)
+	}
+	if s.Cron != "" {
+		expr, err := parseCron(s.Cron)
+		if err != nil {
+			return errors.Annotatef(err, "schedule %q", s.Name)
+		}
+		// Reject expressions such as "0 0 31 2 *" which never match.
+		if _, err := expr.next(time.Now()); err != nil {
+			return errors.NotValidf("schedule %q cron expression %q which never matches", s.Name, s.Cron)
+		}
+	}
+	switch s.CatchUp {
+	case "", CatchUpSkip, CatchUpOnce:
+	default:
+		return errors.NotValidf("schedule %q catch-up policy %q", s.Name, s.CatchUp)
+	}
+	return nil
+}
+
+// Next returns the first time after the given time at which the schedule
+// is due, not taking jitter into account.
+func (s Schedule) Next(after time.Time) (time.Time, error) {
+	if s.Interval > 0 {
+		return after.Add(s.Interval), nil
+	}
+	expr, err := parseCron(s.Cron)
+	if err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	return expr.next(after)
+}
+
+// validScheduleName matches the names allowed for schedules; as the name
+// forms part of a hook name it is limited to the characters allowed in
+// other hook names.
+var validScheduleName = regexp.MustCompile("^[a-z][a-z0-9]*(-[a-z0-9]+)*$")
+
+var scheduleSchema = schema.FieldMap(
+	schema.Fields{
+		"cron":     schema.String(),
+		"interval": schema.String(),
+		"jitter":   schema.String(),
+		"catch-up": schema.OneOf(schema.Const(string(CatchUpSkip)), schema.Const(string(CatchUpOnce))),
+	},
+	schema.Defaults{
+		"cron":     schema.Omit,
+		"interval": schema.Omit,
+		"jitter":   schema.Omit,
+		"catch-up": string(CatchUpSkip),
+	},
+)
+
+func parseSchedules(input interface{}) (map[string]Schedule, error) {
+	if input == nil {
+		return nil, nil
+	}
+	result := make(map[string]Schedule)
+	for name, v := range input.(map[string]interface{}) {
+		m := v.(map[string]interface{})
+		s := Schedule{
+			Name:    name,
+			CatchUp: CatchUpPolicy(m["catch-up"].(string)),
+		}
+		if cron, ok := m["cron"]; ok {
+			s.Cron = cron.(string)
+		}
+		var err error
+		if interval, ok := m["interval"]; ok {
+			if s.Interval, err = time.ParseDuration(interval.(string)); err != nil {
+				return nil, errors.Annotatef(err, "schedule %q interval", name)
+			}
+		}
+		if jitter, ok := m["jitter"]; ok {
+			if s.Jitter, err = time.ParseDuration(jitter.(string)); err != nil {
+				return nil, errors.Annotatef(err, "schedule %q jitter", name)
+			}
+		}
+		result[name] = s
+	}
+	return result, nil
+}
+
+type marshaledSchedule struct {
+	Cron     string        `yaml:"cron,omitempty"`
+	Interval string        `yaml:"interval,omitempty"`
+	Jitter   string        `yaml:"jitter,omitempty"`
+	CatchUp  CatchUpPolicy `yaml:"catch-up,omitempty"`
+}
+
+func marshaledSchedules(schedules map[string]Schedule) map[string]marshaledSchedule {
+	if len(schedules) == 0 {
+		return nil
+	}
+	marshaled := make(map[string]marshaledSchedule, len(schedules))
+	for name, s := range schedules {
+		ms := marshaledSchedule{
+			Cron:    s.Cron,
+			CatchUp: s.CatchUp,
+		}
+		if s.Interval != 0 {
+			ms.Interval = s.Interval.String()
+		}
+		if s.Jitter != 0 {
+			ms.Jitter = s.Jitter.String()
+		}
+		marshaled[name] = ms
+	}
+	return marshaled
+}
+
+// cronShortcuts maps the supported cron shortcuts to their expressions.
+var cronShortcuts = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+// cronField holds the values matched by one field of a cron expression.
+type cronField struct {
+	values map[int]bool
+	// any is true if the field is "*", which matters for the day of
+	// month and day of week fields.
+	any bool
+}
+
+func (f cronField) matches(v int) bool {
+	return f.values[v]
+}
+
+// cronExpr is a parsed cron expression.
+type cronExpr struct {
+	minute, hour, dom, month, dow cronField
+}
+
+func parseCron(expr string) (*cronExpr, error) {
+	if shortcut, ok := cronShortcuts[expr]; ok {
+		expr = shortcut
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.NotValidf("cron expression %q, expected 5 fields", expr)
+	}
+	bounds := []struct {
+		name     string
+		min, max int
+	}{
+		{"minute", 0, 59},
+		{"hour", 0, 23},
+		{"day of month", 1, 31},
+		{"month", 1, 12},
+		{"day of week", 0, 7},
+	}
+	parsed := make([]cronField, len(fields))
+	for i, field := range fields {
+		f, err := parseCronField(field, bounds[i].min, bounds[i].max)
+		if err != nil {
+			return nil, errors.Annotatef(err, "cron expression %q %s", expr, bounds[i].name)
+		}
+		parsed[i] = f
+	}
+	// Both 0 and 7 mean Sunday.
+	if parsed[4].values[7] {
+		parsed[4].values[0] = true
+	}
+	return &cronExpr{
+		minute: parsed[0],
+		hour:   parsed[1],
+		dom:    parsed[2],
+		month:  parsed[3],
+		dow:    parsed[4],
+	}, nil
+}
+
+func parseCronField(field string, min, max int) (cronField, error) {
+	result := cronField{values: make(map[int]bool), any: strings.HasPrefix(field, "*")}
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if idx := strings.Index(part, "/"); idx != -1 {
+			var err error
+			rangePart = part[:idx]
+			if step, err = strconv.Atoi(part[idx+1:]); err != nil || step <= 0 {
+				return cronField{}, errors.NotValidf("step %q", part[idx+1:])
+			}
+		}
+		lo, hi := min, max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if lo, err = parseCronValue(bounds[0], min, max); err != nil {
+				return cronField{}, errors.Trace(err)
+			}
+			if hi, err = parseCronValue(bounds[1], min, max); err != nil {
+				return cronField{}, errors.Trace(err)
+			}
+			if lo > hi {
+				return cronField{}, errors.NotValidf("range %q", rangePart)
+			}
+		default:
+			v, err := parseCronValue(rangePart, min, max)
+			if err != nil {
+				return cronField{}, errors.Trace(err)
+			}
+			lo = v
+			if step == 1 {
+				hi = v
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			result.values[v] = true
+		}
+	}
+	return result, nil
+}
+
+func parseCronValue(s string, min, max int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < min || v > max {
+		return 0, errors.NotValidf("value %q, expected %d-%d", s, min, max)
+	}
+	return v, nil
+}
+
+// cronSearchLimit bounds the search for the next matching time, so that
+// expressions which can never match, such as "0 0 31 2 *", don't loop
+// forever.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+func (e *cronExpr) next(after time.Time) (time.Time, error) {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		if !e.month.matches(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !e.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !e.hour.matches(t.Hour()) {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !e.minute.matches(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, nil
+	}
+	return time.Time{}, errors.NotFoundf("time matching cron expression")
+}
+
+// dayMatches implements the cron rule that, when both the day of month
+// and the day of week are restricted, a day matching either is matched.
+// As in other cron implementations, a field starting with "*", such as
+// "*/2", is not considered restricted.
+func (e *cronExpr) dayMatches(t time.Time) bool {
+	dom := e.dom.matches(t.Day())
+	dow := e.dow.matches(int(t.Weekday()))
+	if e.dom.any || e.dow.any {
+		return dom && dow
+	}
+	return dom || dow
+}
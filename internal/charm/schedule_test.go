@@ -0,0 +1,190 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package charm_test
+
+import (
+	"strings"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/internal/charm"
+)
+
+type ScheduleSuite struct{}
+
+var _ = gc.Suite(&ScheduleSuite{})
+
+const scheduleMeta = `
+name: a
+summary: b
+description: c
+schedules:
+  backup:
+    cron: "30 3 * * *"
+    jitter: 10m
+    catch-up: once
+  poll:
+    interval: 5m
+`
+
+func (s *ScheduleSuite) TestParse(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(scheduleMeta))
+	c.Assert(err, jc.ErrorIsNil)
+	err = meta.Check(charm.FormatV2, charm.SelectionManifest)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(meta.Schedules, jc.DeepEquals, map[string]charm.Schedule{
+		"backup": {
+			Name:    "backup",
+			Cron:    "30 3 * * *",
+			Jitter:  10 * time.Minute,
+			CatchUp: charm.CatchUpOnce,
+		},
+		"poll": {
+			Name:     "poll",
+			Interval: 5 * time.Minute,
+			CatchUp:  charm.CatchUpSkip,
+		},
+	})
+	c.Check(meta.Hooks()["schedule-backup"], jc.IsTrue)
+	c.Check(meta.Hooks()["schedule-poll"], jc.IsTrue)
+}
+
+func (s *ScheduleSuite) TestYAMLMarshal(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(scheduleMeta))
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := yaml.Marshal(meta)
+	c.Assert(err, jc.ErrorIsNil)
+	got, err := charm.ReadMeta(strings.NewReader(string(data)))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got.Schedules, jc.DeepEquals, meta.Schedules)
+}
+
+func (s *ScheduleSuite) TestParseInvalidDuration(c *gc.C) {
+	_, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+schedules:
+  poll:
+    interval: often
+`))
+	c.Assert(err, gc.ErrorMatches, `.*parsing schedules: schedule "poll" interval: time: invalid duration "often"`)
+}
+
+func (s *ScheduleSuite) TestValidate(c *gc.C) {
+	tests := []struct {
+		schedule charm.Schedule
+		err      string
+	}{{
+		schedule: charm.Schedule{Name: "poll", Interval: time.Hour},
+	}, {
+		schedule: charm.Schedule{Name: "nightly", Cron: "@daily", Jitter: time.Minute, CatchUp: charm.CatchUpOnce},
+	}, {
+		schedule: charm.Schedule{Name: "Poll", Interval: time.Hour},
+		err:      `schedule name "Poll" not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll"},
+		err:      `schedule "poll" without cron or interval not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Cron: "@daily", Interval: time.Hour},
+		err:      `schedule "poll" with both cron and interval not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Interval: time.Second},
+		err:      `schedule "poll" interval 1s less than a minute not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Interval: time.Hour, Jitter: -time.Second},
+		err:      `schedule "poll" jitter -1s not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Cron: "* * *"},
+		err:      `schedule "poll": cron expression "\* \* \*", expected 5 fields not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Cron: "61 * * * *"},
+		err:      `schedule "poll": cron expression "61 \* \* \* \*" minute: value "61", expected 0-59 not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Cron: "*/0 * * * *"},
+		err:      `schedule "poll": cron expression "\*/0 \* \* \* \*" minute: step "0" not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Cron: "0 0 31 2 *"},
+		err:      `schedule "poll" cron expression "0 0 31 2 \*" which never matches not valid`,
+	}, {
+		schedule: charm.Schedule{Name: "poll", Interval: time.Hour, CatchUp: "always"},
+		err:      `schedule "poll" catch-up policy "always" not valid`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %+v", i, test.schedule)
+		err := test.schedule.Validate()
+		if test.err == "" {
+			c.Check(err, jc.ErrorIsNil)
+		} else {
+			c.Check(err, gc.ErrorMatches, test.err)
+		}
+	}
+}
+
+func (s *ScheduleSuite) TestCheckInvalidSchedule(c *gc.C) {
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+schedules:
+  poll:
+    jitter: 1m
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	err = meta.Check(charm.FormatV2, charm.SelectionManifest)
+	c.Assert(err, gc.ErrorMatches, `charm "a": schedule "poll" without cron or interval not valid`)
+}
+
+func (s *ScheduleSuite) TestNext(c *gc.C) {
+	after := time.Date(2025, time.January, 30, 10, 17, 42, 0, time.UTC)
+	tests := []struct {
+		schedule charm.Schedule
+		expected time.Time
+	}{{
+		schedule: charm.Schedule{Interval: 90 * time.Minute},
+		expected: after.Add(90 * time.Minute),
+	}, {
+		schedule: charm.Schedule{Cron: "* * * * *"},
+		expected: time.Date(2025, time.January, 30, 10, 18, 0, 0, time.UTC),
+	}, {
+		schedule: charm.Schedule{Cron: "*/15 * * * *"},
+		expected: time.Date(2025, time.January, 30, 10, 30, 0, 0, time.UTC),
+	}, {
+		schedule: charm.Schedule{Cron: "30 3 * * *"},
+		expected: time.Date(2025, time.January, 31, 3, 30, 0, 0, time.UTC),
+	}, {
+		schedule: charm.Schedule{Cron: "0 9-17/4 * * 1-5"},
+		expected: time.Date(2025, time.January, 30, 13, 0, 0, 0, time.UTC),
+	}, {
+		schedule: charm.Schedule{Cron: "@monthly"},
+		expected: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}, {
+		// Either the 1st of the month or a Sunday.
+		schedule: charm.Schedule{Cron: "0 0 1 * 7"},
+		expected: time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}, {
+		// An odd day of the month which is also a Monday, as a stepped
+		// "*" doesn't restrict the day of month.
+		schedule: charm.Schedule{Cron: "0 0 */2 * 1"},
+		expected: time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC),
+	}, {
+		schedule: charm.Schedule{Cron: "0 12 29 2 *"},
+		expected: time.Date(2028, time.February, 29, 12, 0, 0, 0, time.UTC),
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %+v", i, test.schedule)
+		next, err := test.schedule.Next(after)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(next, gc.Equals, test.expected)
+	}
+}
+
+func (s *ScheduleSuite) TestNextNeverMatches(c *gc.C) {
+	_, err := charm.Schedule{Cron: "0 0 31 2 *"}.Next(time.Now())
+	c.Assert(err, gc.ErrorMatches, "time matching cron expression not found")
+}
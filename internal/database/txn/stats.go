@@ -0,0 +1,308 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package txn
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/juju/internal/database/drivererrors"
+)
+
+const (
+	// statsKey is the context key used to store the transaction stats.
+	statsKey contextKey = "stats"
+
+	// UnknownCaller is the caller name used when the caller of a transaction
+	// can't be determined.
+	UnknownCaller = "unknown"
+)
+
+// Stats records the attribution of a single transaction across all of its
+// attempts. It allows the caller of the retry to report on which method
+// issued the transaction, how many times it was attempted and how long it
+// spent waiting on the database being busy.
+type Stats struct {
+	mu sync.Mutex
+
+	caller        string
+	attempts      int
+	busyErrors    int
+	conflicts     int
+	rowsAffected  int64
+	busyWait      time.Duration
+	lastAttempt   time.Time
+	lastAttemptOK bool
+}
+
+// NewStats returns a new Stats for recording a transaction.
+func NewStats() *Stats {
+	return &Stats{
+		lastAttemptOK: true,
+	}
+}
+
+// WithStats returns a new context with the given transaction stats.
+func WithStats(ctx context.Context, stats *Stats) context.Context {
+	return context.WithValue(ctx, statsKey, stats)
+}
+
+// StatsFromContext returns the transaction stats from the given context.
+// If no stats are found, then nil is returned.
+func StatsFromContext(ctx context.Context) *Stats {
+	stats, _ := ctx.Value(statsKey).(*Stats)
+	return stats
+}
+
+// RecordAttempt records the start of a new attempt of the transaction. If
+// the previous attempt failed because the database was busy, the time since
+// that attempt started is accounted as time spent waiting on the database.
+func (s *Stats) RecordAttempt(now time.Time) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.lastAttemptOK && !s.lastAttempt.IsZero() {
+		s.busyWait += now.Sub(s.lastAttempt)
+	}
+	s.attempts++
+	s.lastAttempt = now
+	s.lastAttemptOK = true
+}
+
+// RecordError records the error of the current attempt. Busy and locked
+// errors are classified separately from other retryable errors, which are
+// considered conflicts.
+func (s *Stats) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if drivererrors.IsErrLocked(err) {
+		s.busyErrors++
+		s.lastAttemptOK = false
+	} else if drivererrors.IsErrRetryable(err) {
+		s.conflicts++
+	}
+}
+
+// Caller returns the method that issued the transaction, or UnknownCaller
+// if it couldn't be located.
+func (s *Stats) Caller() string {
+	if s == nil {
+		return UnknownCaller
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.caller == "" {
+		return UnknownCaller
+	}
+	return s.caller
+}
+
+// Attempts returns the number of attempts of the transaction.
+func (s *Stats) Attempts() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.attempts
+}
+
+// BusyErrors returns the number of attempts that failed because the
+// database was busy or locked.
+func (s *Stats) BusyErrors() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.busyErrors
+}
+
+// Conflicts returns the number of attempts that failed with a retryable
+// error, that wasn't a busy error.
+func (s *Stats) Conflicts() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conflicts
+}
+
+// BusyWait returns the time spent waiting on the database because it was
+// busy or locked.
+func (s *Stats) BusyWait() time.Duration {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.busyWait
+}
+
+// RowsAffected returns the number of rows touched by the transaction. This
+// is only recorded when tracing is enabled.
+func (s *Stats) RowsAffected() int64 {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rowsAffected
+}
+
+// setCaller sets the caller, if it hasn't already been set by a previous
+// attempt.
+func (s *Stats) setCaller(caller string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.caller == "" {
+		s.caller = caller
+	}
+}
+
+func (s *Stats) addRowsAffected(rows int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rowsAffected += rows
+}
+
+// callerPrefixes are the packages that are part of the plumbing for running
+// a transaction. These are skipped when locating the caller of a
+// transaction.
+var callerPrefixes = []string{
+	"runtime.",
+	"database/sql.",
+	"github.com/canonical/sqlair",
+	"github.com/juju/retry",
+	"github.com/juju/juju/core/database",
+	"github.com/juju/juju/domain.",
+	"github.com/juju/juju/internal/changestream",
+	"github.com/juju/juju/internal/database",
+	"github.com/juju/juju/internal/worker/dbaccessor",
+}
+
+// domainServicePackage is the marker for a domain service package. Domain
+// services are preferred as callers, as they give the best indication of
+// what is running the transaction.
+const domainServicePackage = "github.com/juju/juju/domain/"
+
+// maxCachedCallers bounds the number of call stacks for which the caller is
+// cached. There are only so many places from which transactions are run, so
+// this is only reached if something is badly wrong.
+const maxCachedCallers = 4096
+
+// callerStack is the program counters of a call stack, used as the key for
+// the caller cache.
+type callerStack [64]uintptr
+
+var (
+	callersMu sync.RWMutex
+	callers   = make(map[callerStack]string)
+)
+
+// callerFromStack locates the function that issued the transaction.
+// Collecting the program counters of the stack is cheap, but resolving them
+// to functions is not, so the caller is cached for each distinct stack.
+func callerFromStack() string {
+	var pcs callerStack
+	runtime.Callers(3, pcs[:])
+
+	callersMu.RLock()
+	caller, ok := callers[pcs]
+	callersMu.RUnlock()
+	if ok {
+		return caller
+	}
+
+	caller = resolveCaller(pcs[:])
+
+	callersMu.Lock()
+	defer callersMu.Unlock()
+	if len(callers) < maxCachedCallers {
+		callers[pcs] = caller
+	}
+	return caller
+}
+
+// resolveCaller walks the stack to find the function that issued the
+// transaction. Functions from domain service packages are preferred, with
+// the first function outside of the transaction plumbing used otherwise.
+func resolveCaller(pcs []uintptr) string {
+	frames := runtime.CallersFrames(pcs)
+
+	var fallback string
+	for {
+		frame, more := frames.Next()
+		if name := frame.Function; name != "" && !isPlumbing(frame) {
+			if strings.HasPrefix(name, domainServicePackage) && strings.Contains(name, "/service.") {
+				return shortFuncName(name)
+			}
+			if fallback == "" {
+				fallback = shortFuncName(name)
+			}
+		}
+		if !more {
+			break
+		}
+	}
+	if fallback == "" {
+		return UnknownCaller
+	}
+	return fallback
+}
+
+func isPlumbing(frame runtime.Frame) bool {
+	// Tests of the plumbing packages are callers in their own right.
+	if strings.HasSuffix(frame.File, "_test.go") {
+		return false
+	}
+	for _, prefix := range callerPrefixes {
+		if strings.HasPrefix(frame.Function, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// shortFuncName trims the module path from the function name, leaving the
+// package path relative to the module, along with the receiver and method.
+// Closures are reported as the function that declares them.
+func shortFuncName(name string) string {
+	name = strings.TrimPrefix(name, "github.com/juju/juju/")
+
+	// Remove any closure suffixes (.func1, .func1.2, etc).
+	for i := strings.Index(name, ".func"); i > 0; {
+		if j := i + len(".func"); j < len(name) && name[j] >= '0' && name[j] <= '9' {
+			return name[:i]
+		}
+		next := strings.Index(name[i+1:], ".func")
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return name
+}
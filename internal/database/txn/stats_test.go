@@ -0,0 +1,109 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package txn_test
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/mattn/go-sqlite3"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/database/testing"
+	"github.com/juju/juju/internal/database/txn"
+)
+
+type statsSuite struct {
+	testing.DqliteSuite
+}
+
+var _ = gc.Suite(&statsSuite{})
+
+func (s *statsSuite) TestStatsRecordsCaller(c *gc.C) {
+	runner := txn.NewRetryingTxnRunner()
+
+	stats := txn.NewStats()
+	ctx := txn.WithStats(context.Background(), stats)
+	ctx = trace.WithTracer(ctx, enabledTracer{})
+
+	err := runner.StdTxn(ctx, s.DB(), func(ctx context.Context, tx *sql.Tx) error {
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(stats.Caller(), gc.Equals, "internal/database/txn_test.(*statsSuite).TestStatsRecordsCaller")
+}
+
+func (s *statsSuite) TestStatsRecordsCallerWithoutTracing(c *gc.C) {
+	runner := txn.NewRetryingTxnRunner()
+
+	// Run the transaction twice from the same place, so that the second
+	// caller comes from the cache.
+	for i := 0; i < 2; i++ {
+		stats := txn.NewStats()
+		ctx := txn.WithStats(context.Background(), stats)
+
+		err := runner.StdTxn(ctx, s.DB(), func(ctx context.Context, tx *sql.Tx) error {
+			return nil
+		})
+		c.Assert(err, jc.ErrorIsNil)
+
+		c.Check(stats.Caller(), gc.Equals, "internal/database/txn_test.(*statsSuite).TestStatsRecordsCallerWithoutTracing")
+	}
+}
+
+func (s *statsSuite) TestStatsRecordsErrors(c *gc.C) {
+	runner := txn.NewRetryingTxnRunner()
+
+	stats := txn.NewStats()
+	ctx := txn.WithStats(context.Background(), stats)
+
+	now := time.Now()
+	stats.RecordAttempt(now)
+	err := runner.StdTxn(ctx, s.DB(), func(ctx context.Context, tx *sql.Tx) error {
+		return sqlite3.ErrBusy
+	})
+	c.Assert(err, jc.ErrorIs, sqlite3.ErrBusy)
+
+	stats.RecordAttempt(now.Add(time.Second))
+	err = runner.StdTxn(ctx, s.DB(), func(ctx context.Context, tx *sql.Tx) error {
+		return errors.New("bad connection")
+	})
+	c.Assert(err, gc.ErrorMatches, "bad connection")
+
+	stats.RecordAttempt(now.Add(time.Second * 2))
+	err = runner.StdTxn(ctx, s.DB(), func(ctx context.Context, tx *sql.Tx) error {
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(stats.Attempts(), gc.Equals, 3)
+	c.Check(stats.BusyErrors(), gc.Equals, 1)
+	c.Check(stats.Conflicts(), gc.Equals, 1)
+	c.Check(stats.BusyWait(), gc.Equals, time.Second)
+}
+
+func (s *statsSuite) TestStatsFromContextWithoutStats(c *gc.C) {
+	stats := txn.StatsFromContext(context.Background())
+	c.Assert(stats, gc.IsNil)
+
+	// A nil stats is safe to use.
+	stats.RecordAttempt(time.Now())
+	stats.RecordError(sqlite3.ErrBusy)
+	c.Check(stats.Caller(), gc.Equals, txn.UnknownCaller)
+	c.Check(stats.Attempts(), gc.Equals, 0)
+}
+
+// enabledTracer is a tracer that is enabled, but doesn't record anything.
+type enabledTracer struct {
+	trace.NoopTracer
+}
+
+func (enabledTracer) Enabled() bool {
+	return true
+}
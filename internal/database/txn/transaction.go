@@ -144,12 +144,21 @@ func (t *RetryingTxnRunner) Txn(ctx context.Context, db *sqlair.DB, fn func(cont
 			return errors.Trace(err)
 		}
 
+		// Only count the rows touched when tracing, as it requires
+		// additional queries against the transaction.
+		rows := t.rowCounter(ctx, func() (int64, error) {
+			var count changeCount
+			err := tx.Query(ctx, totalChangesStmt).Get(&count)
+			return count.Count, err
+		})
+
 		if err := fn(ctx, tx); err != nil {
 			if rErr := t.retryStrategy(ctx, tx.Rollback); rErr != nil {
 				t.logger.Warningf(context.TODO(), "failed to rollback transaction: %v", rErr)
 			}
 			return errors.Trace(err)
 		}
+		rows()
 
 		return errors.Trace(t.commit(ctx, tx))
 	})
@@ -170,17 +179,58 @@ func (t *RetryingTxnRunner) StdTxn(ctx context.Context, db *sql.DB, fn func(cont
 			return errors.Trace(err)
 		}
 
+		// Only count the rows touched when tracing, as it requires
+		// additional queries against the transaction.
+		rows := t.rowCounter(ctx, func() (int64, error) {
+			var count int64
+			err := tx.QueryRowContext(ctx, "SELECT total_changes()").Scan(&count)
+			return count, err
+		})
+
 		if err := fn(ctx, tx); err != nil {
 			if rErr := t.retryStrategy(ctx, tx.Rollback); rErr != nil {
 				t.logger.Warningf(context.TODO(), "failed to rollback transaction: %v", rErr)
 			}
 			return errors.Trace(err)
 		}
+		rows()
 
 		return errors.Trace(t.commit(ctx, tx))
 	})
 }
 
+// changeCount is used to read the total number of changes on a connection.
+type changeCount struct {
+	Count int64 `db:"count"`
+}
+
+var totalChangesStmt = sqlair.MustPrepare("SELECT total_changes() AS &changeCount.count", changeCount{})
+
+// rowCounter returns a function that records the rows touched by the
+// transaction since the rowCounter was called. The rows are only counted if
+// the transaction is being traced and has stats to record them against,
+// otherwise the returned function does nothing.
+func (t *RetryingTxnRunner) rowCounter(ctx context.Context, totalChanges func() (int64, error)) func() {
+	stats := StatsFromContext(ctx)
+	if _, enabled := trace.TracerFromContext(ctx); !enabled || stats == nil {
+		return func() {}
+	}
+
+	before, err := totalChanges()
+	if err != nil {
+		t.logger.Debugf(context.TODO(), "failed to count rows touched by txn: %v", err)
+		return func() {}
+	}
+	return func() {
+		after, err := totalChanges()
+		if err != nil {
+			t.logger.Debugf(context.TODO(), "failed to count rows touched by txn: %v", err)
+			return
+		}
+		stats.addRowsAffected(after - before)
+	}
+}
+
 // Commit is split out as we can't pass a context directly to the commit. To
 // enable tracing, we need to just wrap the commit call. All other traces are
 // done at the dqlite level.
@@ -217,10 +267,31 @@ func (t *RetryingTxnRunner) run(ctx context.Context, fn func(context.Context) er
 		return errors.Trace(err)
 	}
 
+	// If the transaction isn't being retried by a caller that records the
+	// stats, then the stats are just for this run.
+	stats := StatsFromContext(ctx)
+	if stats == nil {
+		stats = NewStats()
+		stats.RecordAttempt(time.Now())
+		ctx = WithStats(ctx, stats)
+	}
+
+	// Attribute the transaction to the caller, so that slow or contended
+	// transactions can be tied back to the method that issued them.
+	stats.setCaller(callerFromStack())
+
 	ctx, span := trace.Start(ctx, traceName("run"))
 	defer func() {
 		span.RecordError(err)
-		span.End()
+		stats.RecordError(err)
+		span.End(
+			trace.StringAttr("caller", stats.Caller()),
+			trace.IntAttr("attempt", stats.Attempts()),
+			trace.IntAttr("busy-errors", stats.BusyErrors()),
+			trace.IntAttr("conflicts", stats.Conflicts()),
+			trace.StringAttr("busy-wait", stats.BusyWait().String()),
+			trace.Int64Attr("rows", stats.RowsAffected()),
+		)
 	}()
 
 	ctx, cancel := context.WithTimeout(ctx, t.timeout)
@@ -80,6 +80,7 @@ type baseObjectStore struct {
 	tomb            tomb.Tomb
 	path            string
 	metadataService objectstore.ObjectStoreMetadata
+	quotaChecker    QuotaChecker
 	claimer         Claimer
 	logger          logger.Logger
 	clock           clock.Clock
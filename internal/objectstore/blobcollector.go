@@ -0,0 +1,280 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+	"gopkg.in/tomb.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// defaultCollectInterval is the interval between collections of the
+	// unreferenced blobs in the blob store.
+	defaultCollectInterval = time.Hour
+)
+
+// blobHashRegexp matches the SHA384 hash that names a blob in the blob store.
+var blobHashRegexp = regexp.MustCompile(`^[a-f0-9]{96}$`)
+
+// BlobCollectorMetadata is the interface used by the blob collector to find
+// and remove the blobs that are no longer referenced by any namespace.
+type BlobCollectorMetadata interface {
+	// ListBlobs returns the blobs in the blob store, along with the number of
+	// namespaces that reference each blob.
+	ListBlobs(ctx context.Context) ([]objectstore.Blob, error)
+
+	// RemoveBlob removes the blob with the specified SHA384, if it is no
+	// longer referenced.
+	RemoveBlob(ctx context.Context, sha384 string) error
+
+	// RemoveOrphanedBlobReferences removes the blob references held by
+	// namespaces that no longer exist.
+	RemoveOrphanedBlobReferences(ctx context.Context) (int64, error)
+}
+
+// BlobCollectorConfig is the configuration for the blob collector.
+type BlobCollectorConfig struct {
+	// RootDir is the root directory for the file object store.
+	RootDir string
+	// MetadataService is the metadata service for the blob store.
+	MetadataService BlobCollectorMetadata
+	// Claimer is the claimer used to lock a blob while it's removed. It must
+	// lock the same blobs as the claimer of the file object stores.
+	Claimer Claimer
+
+	Logger logger.Logger
+	Clock  clock.Clock
+}
+
+// Validate ensures that the config values are valid.
+func (c BlobCollectorConfig) Validate() error {
+	if c.RootDir == "" {
+		return errors.Errorf("empty RootDir").Add(coreerrors.NotValid)
+	}
+	if c.MetadataService == nil {
+		return errors.Errorf("nil MetadataService").Add(coreerrors.NotValid)
+	}
+	if c.Claimer == nil {
+		return errors.Errorf("nil Claimer").Add(coreerrors.NotValid)
+	}
+	if c.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if c.Clock == nil {
+		return errors.Errorf("nil Clock").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// collection describes the blobs reclaimed by a collection.
+type collection struct {
+	blobs int
+	bytes int64
+}
+
+// blobCollector periodically removes the blobs in the blob store that are no
+// longer referenced by any namespace, reporting the space reclaimed.
+type blobCollector struct {
+	baseObjectStore
+	fs              fs.FS
+	metadataService BlobCollectorMetadata
+
+	mu             sync.Mutex
+	lastCollection time.Time
+	last           collection
+	total          collection
+}
+
+// NewBlobCollector returns a new worker that garbage collects the blobs in
+// the blob store that are no longer referenced by any namespace.
+func NewBlobCollector(cfg BlobCollectorConfig) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	path := blobBasePath(cfg.RootDir)
+
+	c := &blobCollector{
+		baseObjectStore: baseObjectStore{
+			path:    path,
+			claimer: cfg.Claimer,
+			logger:  cfg.Logger,
+			clock:   cfg.Clock,
+		},
+		fs:              os.DirFS(path),
+		metadataService: cfg.MetadataService,
+	}
+
+	c.tomb.Go(c.loop)
+
+	return c, nil
+}
+
+// Report returns the blobs and bytes reclaimed by the blob collector. This
+// is used by the engine report.
+func (c *blobCollector) Report() map[string]any {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	report := map[string]any{
+		"reclaimed-blobs": c.total.blobs,
+		"reclaimed-bytes": c.total.bytes,
+	}
+	if !c.lastCollection.IsZero() {
+		report["last-collection"] = c.lastCollection.Format(time.RFC3339)
+		report["last-reclaimed-blobs"] = c.last.blobs
+		report["last-reclaimed-bytes"] = c.last.bytes
+	}
+	return report
+}
+
+func (c *blobCollector) loop() error {
+	ctx, cancel := c.scopedContext()
+	defer cancel()
+
+	timer := c.clock.NewTimer(jitter(defaultCollectInterval))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-c.tomb.Dying():
+			return tomb.ErrDying
+
+		case <-timer.Chan():
+			timer.Reset(defaultCollectInterval)
+
+			reclaimed, err := c.collect(ctx)
+			if err != nil {
+				c.logger.Errorf(ctx, "collecting blobs: %v", err)
+				continue
+			}
+
+			c.mu.Lock()
+			c.lastCollection = c.clock.Now()
+			c.last = reclaimed
+			c.total.blobs += reclaimed.blobs
+			c.total.bytes += reclaimed.bytes
+			c.mu.Unlock()
+
+			if reclaimed.blobs > 0 {
+				c.logger.Infof(ctx, "reclaimed %d bytes from %d unreferenced blobs", reclaimed.bytes, reclaimed.blobs)
+			}
+		}
+	}
+}
+
+// collect removes the blobs that aren't referenced by any namespace, and
+// returns the blobs and bytes reclaimed. Each blob is locked while it's
+// removed, so it can't be referenced again while it's being removed.
+func (c *blobCollector) collect(ctx context.Context) (collection, error) {
+	c.logger.Debugf(ctx, "collecting unreferenced blobs")
+
+	removed, err := c.metadataService.RemoveOrphanedBlobReferences(ctx)
+	if err != nil {
+		return collection{}, errors.Capture(err)
+	} else if removed > 0 {
+		c.logger.Infof(ctx, "removed %d blob references held by namespaces that no longer exist", removed)
+	}
+
+	blobs, err := c.metadataService.ListBlobs(ctx)
+	if err != nil {
+		return collection{}, errors.Capture(err)
+	}
+	unreferenced := make(map[string]bool)
+	for _, blob := range blobs {
+		unreferenced[blob.SHA384] = blob.References == 0
+	}
+
+	entries, err := fs.ReadDir(c.fs, ".")
+	if errors.Is(err, os.ErrNotExist) {
+		// The file object store has never been used.
+		entries = nil
+	} else if err != nil {
+		return collection{}, errors.Errorf("reading blob store directory: %w", err)
+	}
+
+	var reclaimed collection
+	for _, entry := range entries {
+		hash := entry.Name()
+		if entry.IsDir() || !blobHashRegexp.MatchString(hash) {
+			continue
+		}
+
+		// A blob without any metadata isn't referenced either, which
+		// happens if the metadata couldn't be saved after the blob was
+		// written.
+		if isUnreferenced, ok := unreferenced[hash]; ok && !isUnreferenced {
+			c.logger.Tracef(ctx, "blob %q is referenced", hash)
+			continue
+		}
+		delete(unreferenced, hash)
+
+		size, err := c.removeBlob(ctx, hash)
+		if errors.Is(err, domainobjectstoreerrors.ErrBlobReferenced) {
+			c.logger.Debugf(ctx, "blob %q was referenced while collecting", hash)
+			continue
+		} else if err != nil {
+			c.logger.Infof(ctx, "failed to remove unreferenced blob %q: %v, will try again later", hash, err)
+			continue
+		}
+
+		c.logger.Debugf(ctx, "removed unreferenced blob %q", hash)
+		reclaimed.blobs++
+		reclaimed.bytes += size
+	}
+
+	// Remove the metadata for any unreferenced blobs that aren't in the blob
+	// store.
+	for hash, isUnreferenced := range unreferenced {
+		if !isUnreferenced {
+			continue
+		}
+		if _, err := c.removeBlob(ctx, hash); err != nil && !errors.Is(err, domainobjectstoreerrors.ErrBlobReferenced) {
+			c.logger.Infof(ctx, "failed to remove unreferenced blob %q: %v, will try again later", hash, err)
+		}
+	}
+
+	return reclaimed, nil
+}
+
+// removeBlob removes the metadata and the file for the blob, returning the
+// size of the file removed.
+func (c *blobCollector) removeBlob(ctx context.Context, hash string) (int64, error) {
+	var size int64
+	err := c.withLock(ctx, hash, func(ctx context.Context) error {
+		// Removing the metadata ensures that the blob is still unreferenced.
+		if err := c.metadataService.RemoveBlob(ctx, hash); err != nil {
+			return errors.Capture(err)
+		}
+
+		filePath := filepath.Join(c.path, hash)
+		info, err := os.Stat(filePath)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		if err := os.Remove(filePath); err != nil {
+			return errors.Capture(err)
+		}
+		size = info.Size()
+		return nil
+	})
+	return size, errors.Capture(err)
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/clock"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type blobCollectorSuite struct {
+	baseSuite
+
+	metadataService *MockBlobCollectorMetadata
+}
+
+var _ = gc.Suite(&blobCollectorSuite{})
+
+func (s *blobCollectorSuite) TestValidateConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := s.newConfig(c, c.MkDir())
+	c.Check(cfg.Validate(), jc.ErrorIsNil)
+
+	cfg.RootDir = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, coreerrors.NotValid)
+
+	cfg = s.newConfig(c, c.MkDir())
+	cfg.MetadataService = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, coreerrors.NotValid)
+
+	cfg = s.newConfig(c, c.MkDir())
+	cfg.Claimer = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, coreerrors.NotValid)
+
+	cfg = s.newConfig(c, c.MkDir())
+	cfg.Logger = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, coreerrors.NotValid)
+
+	cfg = s.newConfig(c, c.MkDir())
+	cfg.Clock = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *blobCollectorSuite) TestCollect(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	_, referenced, referenced256 := s.createFile(c, blobBasePath(path), "foo", "some content")
+	size, unreferenced, unreferenced256 := s.createFile(c, blobBasePath(path), "bar", "other content")
+	untrackedSize, untracked, _ := s.createFile(c, blobBasePath(path), "baz", "untracked content")
+	missing := s.calculateHexSHA384(c, "missing content")
+
+	// Files that aren't named by a hash are never removed.
+	err := os.WriteFile(filepath.Join(blobBasePath(path), "other"), []byte("other"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	collector := s.newBlobCollector(c, path)
+	defer workertest.CleanKill(c, collector)
+
+	s.metadataService.EXPECT().RemoveOrphanedBlobReferences(gomock.Any()).Return(1, nil)
+	s.metadataService.EXPECT().ListBlobs(gomock.Any()).Return([]objectstore.Blob{{
+		SHA384:     referenced,
+		SHA256:     referenced256,
+		Size:       12,
+		References: 1,
+	}, {
+		SHA384: unreferenced,
+		SHA256: unreferenced256,
+		Size:   size,
+	}, {
+		SHA384: missing,
+		SHA256: s.calculateHexSHA256(c, "missing content"),
+		Size:   15,
+	}}, nil)
+
+	for _, hash := range []string{unreferenced, untracked, missing} {
+		s.expectClaim(hash, 1)
+		s.expectRelease(hash, 1)
+		s.metadataService.EXPECT().RemoveBlob(gomock.Any(), hash).Return(nil)
+	}
+
+	reclaimed, err := collector.collect(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(reclaimed, gc.Equals, collection{
+		blobs: 2,
+		bytes: size + untrackedSize,
+	})
+
+	s.expectBlobExists(c, path, referenced)
+	s.expectBlobExists(c, path, "other")
+	s.expectBlobDoesNotExist(c, path, unreferenced)
+	s.expectBlobDoesNotExist(c, path, untracked)
+}
+
+func (s *blobCollectorSuite) TestCollectBlobReferencedWhileCollecting(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, blobBasePath(path), "foo", "some content")
+
+	collector := s.newBlobCollector(c, path)
+	defer workertest.CleanKill(c, collector)
+
+	s.metadataService.EXPECT().RemoveOrphanedBlobReferences(gomock.Any()).Return(0, nil)
+	s.metadataService.EXPECT().ListBlobs(gomock.Any()).Return([]objectstore.Blob{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   size,
+	}}, nil)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.metadataService.EXPECT().RemoveBlob(gomock.Any(), hash384).Return(domainobjectstoreerrors.ErrBlobReferenced)
+
+	reclaimed, err := collector.collect(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(reclaimed, gc.Equals, collection{})
+
+	s.expectBlobExists(c, path, hash384)
+}
+
+func (s *blobCollectorSuite) TestCollectNoBlobStore(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	collector := s.newBlobCollector(c, c.MkDir())
+	defer workertest.CleanKill(c, collector)
+
+	s.metadataService.EXPECT().RemoveOrphanedBlobReferences(gomock.Any()).Return(0, nil)
+	s.metadataService.EXPECT().ListBlobs(gomock.Any()).Return(nil, nil)
+
+	reclaimed, err := collector.collect(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(reclaimed, gc.Equals, collection{})
+}
+
+func (s *blobCollectorSuite) TestReport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	collector := s.newBlobCollector(c, c.MkDir())
+	defer workertest.CleanKill(c, collector)
+
+	c.Check(collector.Report(), gc.DeepEquals, map[string]any{
+		"reclaimed-blobs": 0,
+		"reclaimed-bytes": int64(0),
+	})
+}
+
+func (s *blobCollectorSuite) expectBlobExists(c *gc.C, path, hash string) {
+	_, err := os.Stat(filepath.Join(blobBasePath(path), hash))
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *blobCollectorSuite) expectBlobDoesNotExist(c *gc.C, path, hash string) {
+	_, err := os.Stat(filepath.Join(blobBasePath(path), hash))
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+}
+
+func (s *blobCollectorSuite) newConfig(c *gc.C, path string) BlobCollectorConfig {
+	return BlobCollectorConfig{
+		RootDir:         path,
+		MetadataService: s.metadataService,
+		Claimer:         s.claimer,
+		Logger:          loggertesting.WrapCheckLog(c),
+		Clock:           clock.WallClock,
+	}
+}
+
+func (s *blobCollectorSuite) newBlobCollector(c *gc.C, path string) *blobCollector {
+	collector, err := NewBlobCollector(s.newConfig(c, path))
+	c.Assert(err, jc.ErrorIsNil)
+	return collector.(*blobCollector)
+}
+
+func (s *blobCollectorSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := s.baseSuite.setupMocks(c)
+
+	s.metadataService = NewMockBlobCollectorMetadata(ctrl)
+
+	return ctrl
+}
@@ -12,4 +12,8 @@ const (
 	// ObjectAlreadyExists is returned an object is placed in the store, but
 	// that object already exists.
 	ObjectAlreadyExists = errors.ConstError("object already exists")
+
+	// QuotaExceeded is returned when storing an object would exceed the
+	// quota for the storage used by the objects in the store.
+	QuotaExceeded = errors.ConstError("object store quota exceeded")
 )
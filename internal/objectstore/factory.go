@@ -60,6 +60,30 @@ func WithMetadataService(metadataService MetadataService) Option {
 	}
 }
 
+// WithBlobMetadataService is the option to set the blob metadata service to
+// use.
+func WithBlobMetadataService(blobMetadataService objectstore.BlobMetadata) Option {
+	return func(o *options) {
+		o.blobMetadataService = blobMetadataService
+	}
+}
+
+// WithObjectSources is the option to set the sources used to repair corrupt
+// or missing objects.
+func WithObjectSources(sources ...ObjectSource) Option {
+	return func(o *options) {
+		o.objectSources = sources
+	}
+}
+
+// WithQuotaChecker is the option to set the quota checker used to check that
+// the objects put into the object store don't exceed the quotas.
+func WithQuotaChecker(checker QuotaChecker) Option {
+	return func(o *options) {
+		o.quotaChecker = checker
+	}
+}
+
 // WithLogger is the option to set the logger to use.
 func WithLogger(logger logger.Logger) Option {
 	return func(o *options) {
@@ -89,14 +113,17 @@ func WithAllowDraining(allowDraining bool) Option {
 }
 
 type options struct {
-	rootDir         string
-	rootBucket      string
-	s3Client        objectstore.Client
-	metadataService MetadataService
-	claimer         Claimer
-	logger          logger.Logger
-	clock           clock.Clock
-	allowDraining   bool
+	rootDir             string
+	rootBucket          string
+	s3Client            objectstore.Client
+	metadataService     MetadataService
+	blobMetadataService objectstore.BlobMetadata
+	claimer             Claimer
+	objectSources       []ObjectSource
+	quotaChecker        QuotaChecker
+	logger              logger.Logger
+	clock               clock.Clock
+	allowDraining       bool
 }
 
 func newOptions() *options {
@@ -121,12 +148,15 @@ func ObjectStoreFactory(ctx context.Context, backendType objectstore.BackendType
 	switch backendType {
 	case objectstore.FileBackend:
 		return NewFileObjectStore(FileObjectStoreConfig{
-			Namespace:       namespace,
-			RootDir:         opts.rootDir,
-			MetadataService: opts.metadataService.ObjectStore(),
-			Claimer:         opts.claimer,
-			Logger:          opts.logger,
-			Clock:           opts.clock,
+			Namespace:           namespace,
+			RootDir:             opts.rootDir,
+			MetadataService:     opts.metadataService.ObjectStore(),
+			BlobMetadataService: opts.blobMetadataService,
+			Claimer:             opts.claimer,
+			ObjectSources:       opts.objectSources,
+			QuotaChecker:        opts.quotaChecker,
+			Logger:              opts.logger,
+			Clock:               opts.clock,
 		})
 	case objectstore.S3Backend:
 		return NewS3ObjectStore(S3ObjectStoreConfig{
@@ -139,8 +169,10 @@ func ObjectStoreFactory(ctx context.Context, backendType objectstore.BackendType
 			Logger:          opts.logger,
 			Clock:           opts.clock,
 			AllowDraining:   opts.allowDraining,
+			ObjectSources:   opts.objectSources,
+			QuotaChecker:    opts.quotaChecker,
 
-			HashFileSystemAccessor: newHashFileSystemAccessor(namespace, opts.rootDir, opts.logger),
+			HashFileSystemAccessor: newHashFileSystemAccessor(namespace, opts.rootDir, opts.blobMetadataService, opts.logger),
 		})
 	default:
 		return nil, errors.NotValidf("backend type %q", backendType)
@@ -25,6 +25,10 @@ import (
 
 const (
 	defaultFileDirectory = "objectstore"
+
+	// defaultBlobDirectory is the directory, within the file object store
+	// directory, that holds the blobs shared by all namespaces.
+	defaultBlobDirectory = "blobs"
 )
 
 // FileObjectStoreConfig is the configuration for the file object store.
@@ -37,35 +41,68 @@ type FileObjectStoreConfig struct {
 	// MetadataService is the metadata service for translating paths to
 	// hashes.
 	MetadataService objectstore.ObjectStoreMetadata
-	// Claimer is the claimer for the file object store.
+	// BlobMetadataService is the metadata service for tracking the
+	// references the namespace holds to the blobs in the controller-wide
+	// blob store.
+	BlobMetadataService objectstore.BlobMetadata
+	// Claimer is the claimer for the file object store. The blobs are shared
+	// by all namespaces, so the claimer must lock the blob for every
+	// namespace, not just this one.
 	Claimer Claimer
+	// ObjectSources are the sources used to repair the objects that are
+	// found to be corrupt or missing when the object store is scrubbed.
+	ObjectSources []ObjectSource
+	// QuotaChecker checks that the objects put into the object store don't
+	// exceed the quotas. If nil, then the objects aren't subject to a quota.
+	QuotaChecker QuotaChecker
 
 	Logger logger.Logger
 	Clock  clock.Clock
 }
 
+// fileObjectStore stores the objects for a namespace in the controller-wide,
+// content addressed, blob store. An object is stored once as a blob named by
+// its hash, no matter how many namespaces store it. Each namespace holds a
+// reference to the blobs it has metadata for, and blobs that are no longer
+// referenced are removed by the blob collector.
+//
+// Objects that were stored before the blob store existed are kept in the
+// directory of the namespace, until they're moved into the blob store.
 type fileObjectStore struct {
 	baseObjectStore
-	fs        fs.FS
-	namespace string
-	requests  chan request
+	fs                  fs.FS
+	blobFS              fs.FS
+	blobPath            string
+	blobMetadataService objectstore.BlobMetadata
+	namespace           string
+	scrubber            *scrubber
+	requests            chan request
 }
 
 // NewFileObjectStore returns a new object store worker based on the file
 // storage.
 func NewFileObjectStore(cfg FileObjectStoreConfig) (TrackedObjectStore, error) {
 	path := basePath(cfg.RootDir, cfg.Namespace)
+	blobPath := blobBasePath(cfg.RootDir)
 
 	s := &fileObjectStore{
 		baseObjectStore: baseObjectStore{
 			path:            path,
 			claimer:         cfg.Claimer,
 			metadataService: cfg.MetadataService,
+			quotaChecker:    quotaCheckerOrDefault(cfg.QuotaChecker),
 			logger:          cfg.Logger,
 			clock:           cfg.Clock,
 		},
-		fs:        os.DirFS(path),
-		namespace: cfg.Namespace,
+		fs:                  os.DirFS(path),
+		blobFS:              os.DirFS(blobPath),
+		blobPath:            blobPath,
+		blobMetadataService: cfg.BlobMetadataService,
+		namespace:           cfg.Namespace,
+		scrubber: &scrubber{
+			sources: cfg.ObjectSources,
+			rate:    defaultScrubRate,
+		},
 
 		requests: make(chan request),
 	}
@@ -256,7 +293,8 @@ func (t *fileObjectStore) PutAndCheckHash(ctx context.Context, path string, r io
 	}
 }
 
-// Remove removes data at path, namespaced to the model.
+// Remove removes data at path, namespaced to the model. The blob holding the
+// data is removed by the blob collector once no namespace references it.
 func (t *fileObjectStore) Remove(ctx context.Context, path string) error {
 	response := make(chan response)
 	select {
@@ -289,6 +327,9 @@ func (t *fileObjectStore) loop() error {
 	if err := t.ensureDirectories(); err != nil {
 		return errors.Errorf("ensuring file store directories exist: %w", err)
 	}
+	if err := os.MkdirAll(t.blobPath, 0755); err != nil {
+		return errors.Errorf("ensuring blob store directory exists: %w", err)
+	}
 
 	// Remove any temporary files that may have been left behind. We don't
 	// provide continuation for these operations, so a retry will be required
@@ -300,6 +341,10 @@ func (t *fileObjectStore) loop() error {
 	ctx, cancel := t.scopedContext()
 	defer cancel()
 
+	// The scrub runs in its own goroutine, so that it doesn't block the
+	// requests to the object store.
+	t.tomb.Go(t.scrubLoop(t.scrubber, t.openObject, t.replaceObject))
+
 	timer := t.clock.NewTimer(jitter(defaultPruneInterval))
 	defer timer.Stop()
 
@@ -386,6 +431,13 @@ func (t *fileObjectStore) loop() error {
 			// the loop.
 			timer.Reset(defaultPruneInterval)
 
+			// Move any objects stored before the blob store existed into
+			// the blob store, before pruning the ones that are no longer
+			// referenced.
+			if err := t.moveToBlobStore(ctx); err != nil {
+				t.logger.Errorf(context.TODO(), "moving objects to blob store: %v", err)
+			}
+
 			if err := t.prune(ctx, t.list, t.deleteObject); err != nil {
 				t.logger.Errorf(context.TODO(), "prune: %v", err)
 				continue
@@ -394,6 +446,14 @@ func (t *fileObjectStore) loop() error {
 	}
 }
 
+// Report returns the outcome of the last scrub of the object store. This is
+// used by the engine report.
+func (t *fileObjectStore) Report() map[string]any {
+	return map[string]any{
+		"scrub": t.scrubber.Report(),
+	}
+}
+
 func (t *fileObjectStore) get(ctx context.Context, path string) (io.ReadCloser, int64, error) {
 	t.logger.Debugf(context.TODO(), "getting object %q from file storage", path)
 
@@ -439,7 +499,12 @@ func (t *fileObjectStore) getBySHA256Prefix(ctx context.Context, sha256 string)
 func (t *fileObjectStore) getWithMetadata(ctx context.Context, metadata objectstore.Metadata) (io.ReadCloser, int64, error) {
 	hash := selectFileHash(metadata)
 
-	file, err := t.fs.Open(hash)
+	file, err := t.blobFS.Open(hash)
+	if errors.Is(err, os.ErrNotExist) {
+		// The object may have been stored before the blob store existed,
+		// and not been moved into it yet.
+		file, err = t.fs.Open(hash)
+	}
 	if errors.Is(err, os.ErrNotExist) {
 		return nil, -1, objectstoreerrors.ObjectNotFound
 	}
@@ -479,6 +544,12 @@ func (t *fileObjectStore) put(ctx context.Context, path string, r io.Reader, siz
 	// file and create TeeReader with a MultiWriter.
 	hash256 := sha256.New()
 
+	// Reject objects that could never be stored, before they use any disk
+	// space.
+	if err := t.checkSize(ctx, path, size); err != nil {
+		return "", errors.Capture(err)
+	}
+
 	// We need to write this to a temp file, because if the client retries
 	// then we need seek back to the beginning of the file.
 	tmpFileName, tmpFileCleanup, err := t.writeToTmpFile(t.path, io.TeeReader(r, io.MultiWriter(hash384, hash256)), size)
@@ -498,6 +569,18 @@ func (t *fileObjectStore) put(ctx context.Context, path string, r io.Reader, siz
 		return "", errors.Errorf("hash mismatch for %q: expected %q, got %q: %w", path, expected, encoded384, objectstore.ErrHashMismatch)
 	}
 
+	metadata := objectstore.Metadata{
+		Path:   path,
+		SHA256: encoded256,
+		SHA384: encoded384,
+		Size:   size,
+	}
+
+	quotas, err := t.quotas(ctx, path)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
 	// Lock the file with the given hash, so that we can't remove the file
 	// while we're writing it.
 	var uuid objectstore.UUID
@@ -507,16 +590,23 @@ func (t *fileObjectStore) put(ctx context.Context, path string, r io.Reader, siz
 			return errors.Capture(err)
 		}
 
+		// Reference the blob before saving the metadata, so the blob
+		// collector never sees a blob that has metadata, but isn't
+		// referenced.
+		if err := t.addBlobReference(ctx, metadata, quotas.Controller); err != nil {
+			return errors.Capture(err)
+		}
+
 		// Save the metadata for the file after we've written it. That way we
 		// correctly sequence the watch events. Otherwise there is a potential
 		// race where the watch event is emitted before the file is written.
 		var err error
-		if uuid, err = t.metadataService.PutMetadata(ctx, objectstore.Metadata{
-			Path:   path,
-			SHA256: encoded256,
-			SHA384: encoded384,
-			Size:   size,
-		}); err != nil {
+		if uuid, err = t.putMetadata(ctx, metadata, quotas.Model); err != nil {
+			// Drop the reference if the namespace doesn't have any other
+			// metadata for the blob, otherwise it would never be removed.
+			if releaseErr := t.releaseBlob(ctx, metadata); releaseErr != nil {
+				t.logger.Warningf(ctx, "releasing blob %q: %v", encoded384, releaseErr)
+			}
 			return errors.Capture(err)
 		}
 		return nil
@@ -526,6 +616,33 @@ func (t *fileObjectStore) put(ctx context.Context, path string, r io.Reader, siz
 	return uuid, nil
 }
 
+// addBlobReference records that the namespace references the blob for the
+// object, enforcing the controller quota in the same transaction. A blob that
+// isn't referenced because the quota was exceeded is removed by the blob
+// collector.
+func (t *fileObjectStore) addBlobReference(ctx context.Context, metadata objectstore.Metadata, quota int64) error {
+	blob := objectstore.Blob{
+		SHA256: metadata.SHA256,
+		SHA384: metadata.SHA384,
+		Size:   metadata.Size,
+	}
+
+	var err error
+	if quota <= 0 {
+		err = t.blobMetadataService.AddBlobReference(ctx, t.namespace, blob)
+	} else {
+		err = t.blobMetadataService.AddBlobReferenceWithinQuota(ctx, t.namespace, blob, quota)
+	}
+	if errors.Is(err, domainobjectstoreerrors.ErrQuotaExceeded) {
+		return quotaError(metadata.Path, err)
+	} else if err != nil {
+		return errors.Errorf("adding blob reference: %w", err)
+	}
+	return nil
+}
+
+// persistTmpFile moves the temporary file into the blob store, unless the
+// blob store already has the blob.
 func (t *fileObjectStore) persistTmpFile(_ context.Context, tmpFileName, hash string, size int64) error {
 	filePath := t.filePath(hash)
 
@@ -564,14 +681,127 @@ func (t *fileObjectStore) remove(ctx context.Context, path string) error {
 		if err := t.metadataService.RemoveMetadata(ctx, path); err != nil {
 			return errors.Errorf("remove metadata: %w", err)
 		}
-		return t.deleteObject(ctx, hash)
+		return t.releaseBlob(ctx, metadata)
 	})
 }
 
+// releaseBlob removes the reference the namespace holds to the blob for the
+// metadata, once the namespace no longer has any metadata for the blob. The
+// blob is removed from the blob store by the blob collector, once no
+// namespace references it. This must be called with the blob locked.
+func (t *fileObjectStore) releaseBlob(ctx context.Context, metadata objectstore.Metadata) error {
+	// Other paths in the namespace may be for the same blob.
+	_, err := t.metadataService.GetMetadataBySHA256(ctx, metadata.SHA256)
+	if err == nil {
+		return nil
+	} else if !errors.Is(err, domainobjectstoreerrors.ErrNotFound) {
+		return errors.Errorf("get metadata by SHA256: %w", err)
+	}
+
+	hash := selectFileHash(metadata)
+	if err := t.blobMetadataService.RemoveBlobReference(ctx, t.namespace, hash); err != nil {
+		return errors.Errorf("removing blob reference: %w", err)
+	}
+
+	// The object may have been stored before the blob store existed, in
+	// which case it's only used by this namespace.
+	return t.deleteObject(ctx, hash)
+}
+
+// moveToBlobStore moves the objects that were stored in the namespace
+// directory, before the blob store existed, into the blob store. Objects
+// without any metadata are left to be pruned.
+func (t *fileObjectStore) moveToBlobStore(ctx context.Context) error {
+	metadata, files, err := t.list(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	hashes := make(map[string]objectstore.Metadata)
+	for _, m := range metadata {
+		hashes[selectFileHash(m)] = m
+	}
+
+	for _, file := range files {
+		m, ok := hashes[file]
+		if !ok {
+			continue
+		}
+
+		if err := t.withLock(ctx, file, func(ctx context.Context) error {
+			if err := t.persistTmpFile(ctx, t.legacyFilePath(file), file, m.Size); err != nil {
+				return errors.Capture(err)
+			}
+			if err := t.blobMetadataService.AddBlobReference(ctx, t.namespace, objectstore.Blob{
+				SHA256: m.SHA256,
+				SHA384: m.SHA384,
+				Size:   m.Size,
+			}); err != nil {
+				return errors.Errorf("adding blob reference: %w", err)
+			}
+			// The blob store already had the blob, so this copy is no
+			// longer needed.
+			return t.deleteObject(ctx, file)
+		}); err != nil {
+			t.logger.Infof(ctx, "failed to move object %q to blob store: %v, will try again later", file, err)
+			continue
+		}
+
+		t.logger.Debugf(ctx, "moved object %q to blob store", file)
+	}
+	return nil
+}
+
+// openObject opens the stored copy of the object with the given hash, for
+// scrubbing.
+func (t *fileObjectStore) openObject(_ context.Context, hash string) (io.ReadCloser, error) {
+	file, err := t.blobFS.Open(hash)
+	if errors.Is(err, os.ErrNotExist) {
+		file, err = t.fs.Open(hash)
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, objectstoreerrors.ObjectNotFound
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return file, nil
+}
+
+// replaceObject replaces the stored copy of the object with the verified
+// copy in the file. The repaired object is always stored in the blob store.
+// This must be called with the blob locked.
+func (t *fileObjectStore) replaceObject(ctx context.Context, metadata objectstore.Metadata, fileName string) error {
+	hash := selectFileHash(metadata)
+	if err := os.Rename(fileName, t.filePath(hash)); err != nil {
+		return errors.Capture(err)
+	}
+	if err := t.blobMetadataService.AddBlobReference(ctx, t.namespace, objectstore.Blob{
+		SHA256: metadata.SHA256,
+		SHA384: metadata.SHA384,
+		Size:   metadata.Size,
+	}); err != nil {
+		return errors.Errorf("adding blob reference: %w", err)
+	}
+	// Any copy stored before the blob store existed is the corrupt one.
+	return t.deleteObject(ctx, hash)
+}
+
+// filePath returns the path of the blob with the given hash.
 func (t *fileObjectStore) filePath(hash string) string {
+	return filepath.Join(t.blobPath, hash)
+}
+
+// legacyFilePath returns the path of an object with the given hash, that was
+// stored in the namespace directory before the blob store existed.
+func (t *fileObjectStore) legacyFilePath(hash string) string {
 	return filepath.Join(t.path, hash)
 }
 
+// list returns the metadata for the namespace, and the objects that are still
+// stored in the namespace directory.
 func (t *fileObjectStore) list(ctx context.Context) ([]objectstore.Metadata, []string, error) {
 	t.logger.Debugf(context.TODO(), "listing objects from file storage")
 
@@ -598,8 +828,10 @@ func (t *fileObjectStore) list(ctx context.Context) ([]objectstore.Metadata, []s
 	return metadata, files, nil
 }
 
+// deleteObject deletes an object stored in the namespace directory. Blobs
+// are never deleted by a namespace, as they may be used by other namespaces.
 func (t *fileObjectStore) deleteObject(ctx context.Context, hash string) error {
-	filePath := t.filePath(hash)
+	filePath := t.legacyFilePath(hash)
 
 	// File doesn't exist. It was probably already removed. Return early,
 	// nothing we can do in this case.
@@ -623,3 +855,9 @@ func (t *fileObjectStore) deleteObject(ctx context.Context, hash string) error {
 func basePath(rootDir, namespace string) string {
 	return filepath.Join(rootDir, defaultFileDirectory, namespace)
 }
+
+// blobBasePath returns the path of the controller-wide blob store.
+// typically: /var/lib/juju/objectstore/blobs
+func blobBasePath(rootDir string) string {
+	return filepath.Join(rootDir, defaultFileDirectory, defaultBlobDirectory)
+}
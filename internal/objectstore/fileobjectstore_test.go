@@ -377,6 +377,8 @@ func (s *fileObjectStoreSuite) TestPut(c *gc.C) {
 
 	uuid := objectstoretesting.GenObjectStoreUUID(c)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 1)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
@@ -406,6 +408,8 @@ func (s *fileObjectStoreSuite) TestPutFileAlreadyExists(c *gc.C) {
 
 	uuid := objectstoretesting.GenObjectStoreUUID(c)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 2)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
@@ -443,12 +447,15 @@ func (s *fileObjectStoreSuite) TestPutCleansUpFileOnMetadataFailure(c *gc.C) {
 
 	uuid := objectstoretesting.GenObjectStoreUUID(c)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 1)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
 		Path:   "foo",
 		Size:   12,
 	}).Return(uuid, errors.Errorf("boom"))
+	s.expectReleaseBlob(hash384, hash256)
 
 	_, err := store.Put(context.Background(), "foo", strings.NewReader("some content"), 12)
 	c.Assert(err, gc.ErrorMatches, `.*boom`)
@@ -476,6 +483,8 @@ func (s *fileObjectStoreSuite) TestPutDoesNotCleansUpFileOnMetadataFailure(c *gc
 
 	uuid := objectstoretesting.GenObjectStoreUUID(c)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 2)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
@@ -493,6 +502,15 @@ func (s *fileObjectStoreSuite) TestPutDoesNotCleansUpFileOnMetadataFailure(c *gc
 		Size:   12,
 	}).Return(uuid, errors.Errorf("boom"))
 
+	// The namespace has other metadata for the blob, so the reference is
+	// kept.
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(objectstore.Metadata{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "bar",
+		Size:   12,
+	}, nil)
+
 	_, err = store.Put(context.Background(), "foo", strings.NewReader("some content"), 12)
 	c.Assert(err, gc.ErrorMatches, `.*boom`)
 
@@ -515,6 +533,8 @@ func (s *fileObjectStoreSuite) TestPutAndCheckHash(c *gc.C) {
 
 	uuid := objectstoretesting.GenObjectStoreUUID(c)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 1)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
@@ -559,6 +579,8 @@ func (s *fileObjectStoreSuite) TestPutAndCheckHashFileAlreadyExists(c *gc.C) {
 
 	uuid := objectstoretesting.GenObjectStoreUUID(c)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 2)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
@@ -594,12 +616,15 @@ func (s *fileObjectStoreSuite) TestPutAndCheckHashCleansUpFileOnMetadataFailure(
 	store := s.newFileObjectStore(c, path)
 	defer workertest.DirtyKill(c, store)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 1)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
 		Path:   "foo",
 		Size:   12,
 	}).Return("", errors.Errorf("boom"))
+	s.expectReleaseBlob(hash384, hash256)
 
 	_, err := store.PutAndCheckHash(context.Background(), "foo", strings.NewReader("some content"), 12, hash384)
 	c.Assert(err, gc.ErrorMatches, `.*boom`)
@@ -625,6 +650,8 @@ func (s *fileObjectStoreSuite) TestPutAndCheckHashDoesNotCleansUpFileOnMetadataF
 	store := s.newFileObjectStore(c, path)
 	defer workertest.DirtyKill(c, store)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 2)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
@@ -642,6 +669,15 @@ func (s *fileObjectStoreSuite) TestPutAndCheckHashDoesNotCleansUpFileOnMetadataF
 		Size:   12,
 	}).Return("", errors.Errorf("boom"))
 
+	// The namespace has other metadata for the blob, so the reference is
+	// kept.
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(objectstore.Metadata{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "bar",
+		Size:   12,
+	}, nil)
+
 	_, err = store.PutAndCheckHash(context.Background(), "foo", strings.NewReader("some content"), 12, hash384)
 	c.Assert(err, gc.ErrorMatches, `.*boom`)
 
@@ -673,6 +709,7 @@ func (s *fileObjectStoreSuite) TestRemoveFileNotFound(c *gc.C) {
 	}, nil)
 
 	s.service.EXPECT().RemoveMetadata(gomock.Any(), "foo").Return(nil)
+	s.expectReleaseBlob("blah", "blah")
 
 	err := store.Remove(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIsNil)
@@ -692,6 +729,8 @@ func (s *fileObjectStoreSuite) TestRemove(c *gc.C) {
 	store := s.newFileObjectStore(c, path)
 	defer workertest.DirtyKill(c, store)
 
+	s.expectAddBlobReference(hash384, hash256, 12, 1)
+
 	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
 		SHA384: hash384,
 		SHA256: hash256,
@@ -712,11 +751,14 @@ func (s *fileObjectStoreSuite) TestRemove(c *gc.C) {
 	}, nil)
 
 	s.service.EXPECT().RemoveMetadata(gomock.Any(), "foo").Return(nil)
+	s.expectReleaseBlob(hash384, hash256)
 
 	err = store.Remove(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIsNil)
 
-	s.expectFileDoesNotExist(c, path, hash384)
+	// The blob is left for the blob collector to remove, as other namespaces
+	// may reference it.
+	s.expectFileDoesExist(c, path, hash384)
 }
 
 func (s *fileObjectStoreSuite) TestList(c *gc.C) {
@@ -751,13 +793,85 @@ func (s *fileObjectStoreSuite) TestList(c *gc.C) {
 	c.Check(files, gc.DeepEquals, []string{hash384})
 }
 
+func (s *fileObjectStoreSuite) TestMoveToBlobStore(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	namespace := "inferi"
+	size, hash384, hash256 := s.createFile(c, s.filePath(path, namespace), "foo", "some content")
+	_, unreferenced, _ := s.createFile(c, s.filePath(path, namespace), "bar", "other content")
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.expectAddBlobReference(hash384, hash256, size, 1)
+
+	store := s.newFileObjectStore(c, path).(*fileObjectStore)
+	defer workertest.DirtyKill(c, store)
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+
+	err := store.moveToBlobStore(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.expectFileDoesExist(c, path, hash384)
+	_, err = os.Stat(filepath.Join(s.filePath(path, namespace), hash384))
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+
+	// Objects without metadata are left to be pruned.
+	s.expectFileDoesNotExist(c, path, unreferenced)
+	_, err = os.Stat(filepath.Join(s.filePath(path, namespace), unreferenced))
+	c.Check(err, jc.ErrorIsNil)
+}
+
+func (s *fileObjectStoreSuite) TestGetFromBlobStore(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, blobBasePath(path), "foo", "some content")
+
+	store := s.newFileObjectStore(c, path)
+	defer workertest.DirtyKill(c, store)
+
+	s.service.EXPECT().GetMetadata(gomock.Any(), "foo").Return(objectstore.Metadata{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}, nil)
+
+	file, fileSize, err := store.Get(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(size, gc.Equals, fileSize)
+	c.Check(s.readFile(c, file), gc.Equals, "some content")
+}
+
+func (s *fileObjectStoreSuite) expectAddBlobReference(hash384, hash256 string, size int64, num int) {
+	s.blobService.EXPECT().AddBlobReference(gomock.Any(), "inferi", objectstore.Blob{
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   size,
+	}).Return(nil).Times(num)
+}
+
+func (s *fileObjectStoreSuite) expectReleaseBlob(hash384, hash256 string) {
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(objectstore.Metadata{}, domainobjectstoreerrors.ErrNotFound)
+	s.blobService.EXPECT().RemoveBlobReference(gomock.Any(), "inferi", hash384).Return(nil)
+}
+
 func (s *fileObjectStoreSuite) expectFileDoesNotExist(c *gc.C, path, hash string) {
-	_, err := os.Stat(filepath.Join(path, defaultFileDirectory, "inferi", hash))
+	_, err := os.Stat(filepath.Join(blobBasePath(path), hash))
 	c.Assert(err, jc.Satisfies, os.IsNotExist)
 }
 
 func (s *fileObjectStoreSuite) expectFileDoesExist(c *gc.C, path, hash string) {
-	_, err := os.Stat(filepath.Join(path, defaultFileDirectory, "inferi", hash))
+	_, err := os.Stat(filepath.Join(blobBasePath(path), hash))
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -777,12 +891,13 @@ func (s *fileObjectStoreSuite) filePath(path, namespace string) string {
 
 func (s *fileObjectStoreSuite) newFileObjectStore(c *gc.C, path string) TrackedObjectStore {
 	store, err := NewFileObjectStore(FileObjectStoreConfig{
-		Namespace:       "inferi",
-		RootDir:         path,
-		MetadataService: s.service,
-		Claimer:         s.claimer,
-		Logger:          loggertesting.WrapCheckLog(c),
-		Clock:           clock.WallClock,
+		Namespace:           "inferi",
+		RootDir:             path,
+		MetadataService:     s.service,
+		BlobMetadataService: s.blobService,
+		Claimer:             s.claimer,
+		Logger:              loggertesting.WrapCheckLog(c),
+		Clock:               clock.WallClock,
 	})
 	c.Assert(err, gc.IsNil)
 
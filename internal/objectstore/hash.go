@@ -13,22 +13,34 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
 )
 
+// hashFileSystemAccessor accesses the objects of a namespace in the file
+// storage by hash. The objects are either in the blob store shared by all
+// namespaces, or in the namespace directory if they were stored before the
+// blob store existed.
 type hashFileSystemAccessor struct {
-	fs        fs.FS
-	namespace string
-	path      string
-	logger    logger.Logger
+	fs                  fs.FS
+	blobFS              fs.FS
+	namespace           string
+	path                string
+	blobPath            string
+	blobMetadataService objectstore.BlobMetadata
+	logger              logger.Logger
 }
 
-func newHashFileSystemAccessor(namespace, rootDir string, logger logger.Logger) *hashFileSystemAccessor {
+func newHashFileSystemAccessor(namespace, rootDir string, blobMetadataService objectstore.BlobMetadata, logger logger.Logger) *hashFileSystemAccessor {
 	path := basePath(rootDir, namespace)
+	blobPath := blobBasePath(rootDir)
 	return &hashFileSystemAccessor{
-		fs:        os.DirFS(path),
-		path:      path,
-		namespace: namespace,
-		logger:    logger,
+		fs:                  os.DirFS(path),
+		blobFS:              os.DirFS(blobPath),
+		path:                path,
+		blobPath:            blobPath,
+		namespace:           namespace,
+		blobMetadataService: blobMetadataService,
+		logger:              logger,
 	}
 }
 
@@ -36,7 +48,10 @@ func newHashFileSystemAccessor(namespace, rootDir string, logger logger.Logger)
 func (t *hashFileSystemAccessor) HashExists(ctx context.Context, hash string) error {
 	t.logger.Debugf(context.TODO(), "checking object %q in file storage", hash)
 
-	_, err := os.Stat(t.filePath(hash))
+	_, err := os.Stat(t.blobFilePath(hash))
+	if errors.Is(err, os.ErrNotExist) {
+		_, err = os.Stat(t.filePath(hash))
+	}
 	if err == nil {
 		return nil
 	}
@@ -51,7 +66,10 @@ func (t *hashFileSystemAccessor) HashExists(ctx context.Context, hash string) er
 func (t *hashFileSystemAccessor) GetByHash(ctx context.Context, hash string) (io.ReadCloser, int64, error) {
 	t.logger.Debugf(context.TODO(), "getting object %q from file storage", hash)
 
-	file, err := t.fs.Open(hash)
+	file, err := t.blobFS.Open(hash)
+	if errors.Is(err, os.ErrNotExist) {
+		file, err = t.fs.Open(hash)
+	}
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil, -1, errors.NotFoundf("hash %q%w", hash, errors.Hide(err))
@@ -68,10 +86,17 @@ func (t *hashFileSystemAccessor) GetByHash(ctx context.Context, hash string) (io
 	return file, stat.Size(), nil
 }
 
-// DeleteByHash deletes a file at hash, namespaced to the model.
+// DeleteByHash deletes a file at hash, namespaced to the model. A blob in the
+// blob store may be used by other namespaces, so only the reference the
+// namespace holds to it is removed, leaving the blob to be removed by the
+// blob collector.
 func (t *hashFileSystemAccessor) DeleteByHash(ctx context.Context, hash string) error {
 	t.logger.Debugf(context.TODO(), "deleting object %q from file storage", hash)
 
+	if err := t.blobMetadataService.RemoveBlobReference(ctx, t.namespace, hash); err != nil {
+		return errors.Annotatef(err, "removing reference to blob %q", hash)
+	}
+
 	filePath := t.filePath(hash)
 
 	// File doesn't exist, return early, nothing we can do in this case.
@@ -87,3 +112,7 @@ func (t *hashFileSystemAccessor) DeleteByHash(ctx context.Context, hash string)
 func (t *hashFileSystemAccessor) filePath(hash string) string {
 	return filepath.Join(t.path, hash)
 }
+
+func (t *hashFileSystemAccessor) blobFilePath(hash string) string {
+	return filepath.Join(t.blobPath, hash)
+}
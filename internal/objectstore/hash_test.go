@@ -12,6 +12,7 @@ import (
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -30,7 +31,7 @@ func (s *hashFileSystemAccessorSuite) TestHashExistsNotFound(c *gc.C) {
 	err := os.MkdirAll(s.namespaceFilePath(dir), 0755)
 	c.Assert(err, jc.ErrorIsNil)
 
-	accessor := newHashFileSystemAccessor("namespace", dir, loggertesting.WrapCheckLog(c))
+	accessor := newHashFileSystemAccessor("namespace", dir, s.blobService, loggertesting.WrapCheckLog(c))
 	err = accessor.HashExists(context.Background(), "hash")
 	c.Assert(err, jc.ErrorIs, errors.NotFound)
 }
@@ -45,7 +46,7 @@ func (s *hashFileSystemAccessorSuite) TestHashExists(c *gc.C) {
 	_, err = os.Create(filepath.Join(s.namespaceFilePath(dir), "foo"))
 	c.Assert(err, jc.ErrorIsNil)
 
-	accessor := newHashFileSystemAccessor("namespace", dir, loggertesting.WrapCheckLog(c))
+	accessor := newHashFileSystemAccessor("namespace", dir, s.blobService, loggertesting.WrapCheckLog(c))
 	err = accessor.HashExists(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIsNil)
 }
@@ -66,7 +67,7 @@ func (s *hashFileSystemAccessorSuite) TestGetByHash(c *gc.C) {
 	// Note this will include the new line character. This is on purpose and
 	// is baked into the implementation.
 
-	accessor := newHashFileSystemAccessor("namespace", dir, loggertesting.WrapCheckLog(c))
+	accessor := newHashFileSystemAccessor("namespace", dir, s.blobService, loggertesting.WrapCheckLog(c))
 	reader, size, err := accessor.GetByHash(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIsNil)
 	c.Check(size, gc.Equals, int64(7))
@@ -76,6 +77,26 @@ func (s *hashFileSystemAccessorSuite) TestGetByHash(c *gc.C) {
 	c.Check(string(bytes), gc.Equals, "inferi\n")
 }
 
+func (s *hashFileSystemAccessorSuite) TestGetByHashFromBlobStore(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	err := os.MkdirAll(blobBasePath(dir), 0755)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = os.WriteFile(filepath.Join(blobBasePath(dir), "foo"), []byte("inferi"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	accessor := newHashFileSystemAccessor("namespace", dir, s.blobService, loggertesting.WrapCheckLog(c))
+	reader, size, err := accessor.GetByHash(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(size, gc.Equals, int64(6))
+
+	bytes, err := io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(bytes), gc.Equals, "inferi")
+}
+
 func (s *hashFileSystemAccessorSuite) TestGetByHashNotFound(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -83,7 +104,7 @@ func (s *hashFileSystemAccessorSuite) TestGetByHashNotFound(c *gc.C) {
 	err := os.MkdirAll(s.namespaceFilePath(dir), 0755)
 	c.Assert(err, jc.ErrorIsNil)
 
-	accessor := newHashFileSystemAccessor("namespace", dir, loggertesting.WrapCheckLog(c))
+	accessor := newHashFileSystemAccessor("namespace", dir, s.blobService, loggertesting.WrapCheckLog(c))
 	_, _, err = accessor.GetByHash(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIs, errors.NotFound)
 }
@@ -98,7 +119,9 @@ func (s *hashFileSystemAccessorSuite) TestDeleteByHash(c *gc.C) {
 	_, err = os.Create(filepath.Join(s.namespaceFilePath(dir), "foo"))
 	c.Assert(err, jc.ErrorIsNil)
 
-	accessor := newHashFileSystemAccessor("namespace", dir, loggertesting.WrapCheckLog(c))
+	accessor := newHashFileSystemAccessor("namespace", dir, s.blobService, loggertesting.WrapCheckLog(c))
+
+	s.blobService.EXPECT().RemoveBlobReference(gomock.Any(), "namespace", "foo").Return(nil)
 
 	err = accessor.DeleteByHash(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIsNil)
@@ -114,7 +137,9 @@ func (s *hashFileSystemAccessorSuite) TestDeleteByHashNotFound(c *gc.C) {
 	err := os.MkdirAll(s.namespaceFilePath(dir), 0755)
 	c.Assert(err, jc.ErrorIsNil)
 
-	accessor := newHashFileSystemAccessor("namespace", dir, loggertesting.WrapCheckLog(c))
+	accessor := newHashFileSystemAccessor("namespace", dir, s.blobService, loggertesting.WrapCheckLog(c))
+
+	s.blobService.EXPECT().RemoveBlobReference(gomock.Any(), "namespace", "foo").Return(nil)
 
 	err = accessor.DeleteByHash(context.Background(), "foo")
 	c.Assert(err, jc.ErrorIsNil)
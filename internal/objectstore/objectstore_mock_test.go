@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/core/objectstore (interfaces: ObjectStoreMetadata,Session)
+// Source: github.com/juju/juju/core/objectstore (interfaces: ObjectStoreMetadata,Session,BlobMetadata)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStoreMetadata,Session
+//	mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStoreMetadata,Session,BlobMetadata
 //
 
 // Package objectstore is a generated GoMock package.
@@ -237,6 +237,45 @@ func (c *MockObjectStoreMetadataPutMetadataCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// PutMetadataWithinQuota mocks base method.
+func (m *MockObjectStoreMetadata) PutMetadataWithinQuota(arg0 context.Context, arg1 objectstore.Metadata, arg2 int64) (objectstore.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMetadataWithinQuota", arg0, arg1, arg2)
+	ret0, _ := ret[0].(objectstore.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMetadataWithinQuota indicates an expected call of PutMetadataWithinQuota.
+func (mr *MockObjectStoreMetadataMockRecorder) PutMetadataWithinQuota(arg0, arg1, arg2 any) *MockObjectStoreMetadataPutMetadataWithinQuotaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMetadataWithinQuota", reflect.TypeOf((*MockObjectStoreMetadata)(nil).PutMetadataWithinQuota), arg0, arg1, arg2)
+	return &MockObjectStoreMetadataPutMetadataWithinQuotaCall{Call: call}
+}
+
+// MockObjectStoreMetadataPutMetadataWithinQuotaCall wrap *gomock.Call
+type MockObjectStoreMetadataPutMetadataWithinQuotaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreMetadataPutMetadataWithinQuotaCall) Return(arg0 objectstore.UUID, arg1 error) *MockObjectStoreMetadataPutMetadataWithinQuotaCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreMetadataPutMetadataWithinQuotaCall) Do(f func(context.Context, objectstore.Metadata, int64) (objectstore.UUID, error)) *MockObjectStoreMetadataPutMetadataWithinQuotaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreMetadataPutMetadataWithinQuotaCall) DoAndReturn(f func(context.Context, objectstore.Metadata, int64) (objectstore.UUID, error)) *MockObjectStoreMetadataPutMetadataWithinQuotaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveMetadata mocks base method.
 func (m *MockObjectStoreMetadata) RemoveMetadata(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -568,3 +607,140 @@ func (c *MockSessionPutObjectCall) DoAndReturn(f func(context.Context, string, s
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockBlobMetadata is a mock of BlobMetadata interface.
+type MockBlobMetadata struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobMetadataMockRecorder
+}
+
+// MockBlobMetadataMockRecorder is the mock recorder for MockBlobMetadata.
+type MockBlobMetadataMockRecorder struct {
+	mock *MockBlobMetadata
+}
+
+// NewMockBlobMetadata creates a new mock instance.
+func NewMockBlobMetadata(ctrl *gomock.Controller) *MockBlobMetadata {
+	mock := &MockBlobMetadata{ctrl: ctrl}
+	mock.recorder = &MockBlobMetadataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobMetadata) EXPECT() *MockBlobMetadataMockRecorder {
+	return m.recorder
+}
+
+// AddBlobReference mocks base method.
+func (m *MockBlobMetadata) AddBlobReference(arg0 context.Context, arg1 string, arg2 objectstore.Blob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlobReference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBlobReference indicates an expected call of AddBlobReference.
+func (mr *MockBlobMetadataMockRecorder) AddBlobReference(arg0, arg1, arg2 any) *MockBlobMetadataAddBlobReferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlobReference", reflect.TypeOf((*MockBlobMetadata)(nil).AddBlobReference), arg0, arg1, arg2)
+	return &MockBlobMetadataAddBlobReferenceCall{Call: call}
+}
+
+// MockBlobMetadataAddBlobReferenceCall wrap *gomock.Call
+type MockBlobMetadataAddBlobReferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataAddBlobReferenceCall) Return(arg0 error) *MockBlobMetadataAddBlobReferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataAddBlobReferenceCall) Do(f func(context.Context, string, objectstore.Blob) error) *MockBlobMetadataAddBlobReferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataAddBlobReferenceCall) DoAndReturn(f func(context.Context, string, objectstore.Blob) error) *MockBlobMetadataAddBlobReferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddBlobReferenceWithinQuota mocks base method.
+func (m *MockBlobMetadata) AddBlobReferenceWithinQuota(arg0 context.Context, arg1 string, arg2 objectstore.Blob, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlobReferenceWithinQuota", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBlobReferenceWithinQuota indicates an expected call of AddBlobReferenceWithinQuota.
+func (mr *MockBlobMetadataMockRecorder) AddBlobReferenceWithinQuota(arg0, arg1, arg2, arg3 any) *MockBlobMetadataAddBlobReferenceWithinQuotaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlobReferenceWithinQuota", reflect.TypeOf((*MockBlobMetadata)(nil).AddBlobReferenceWithinQuota), arg0, arg1, arg2, arg3)
+	return &MockBlobMetadataAddBlobReferenceWithinQuotaCall{Call: call}
+}
+
+// MockBlobMetadataAddBlobReferenceWithinQuotaCall wrap *gomock.Call
+type MockBlobMetadataAddBlobReferenceWithinQuotaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataAddBlobReferenceWithinQuotaCall) Return(arg0 error) *MockBlobMetadataAddBlobReferenceWithinQuotaCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataAddBlobReferenceWithinQuotaCall) Do(f func(context.Context, string, objectstore.Blob, int64) error) *MockBlobMetadataAddBlobReferenceWithinQuotaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataAddBlobReferenceWithinQuotaCall) DoAndReturn(f func(context.Context, string, objectstore.Blob, int64) error) *MockBlobMetadataAddBlobReferenceWithinQuotaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveBlobReference mocks base method.
+func (m *MockBlobMetadata) RemoveBlobReference(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlobReference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlobReference indicates an expected call of RemoveBlobReference.
+func (mr *MockBlobMetadataMockRecorder) RemoveBlobReference(arg0, arg1, arg2 any) *MockBlobMetadataRemoveBlobReferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlobReference", reflect.TypeOf((*MockBlobMetadata)(nil).RemoveBlobReference), arg0, arg1, arg2)
+	return &MockBlobMetadataRemoveBlobReferenceCall{Call: call}
+}
+
+// MockBlobMetadataRemoveBlobReferenceCall wrap *gomock.Call
+type MockBlobMetadataRemoveBlobReferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataRemoveBlobReferenceCall) Return(arg0 error) *MockBlobMetadataRemoveBlobReferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataRemoveBlobReferenceCall) Do(f func(context.Context, string, string) error) *MockBlobMetadataRemoveBlobReferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataRemoveBlobReferenceCall) DoAndReturn(f func(context.Context, string, string) error) *MockBlobMetadataRemoveBlobReferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -22,8 +22,8 @@ import (
 	gc "gopkg.in/check.v1"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination state_mock_test.go github.com/juju/juju/internal/objectstore Claimer,ClaimExtender,HashFileSystemAccessor
-//go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStoreMetadata,Session
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination state_mock_test.go github.com/juju/juju/internal/objectstore Claimer,ClaimExtender,HashFileSystemAccessor,BlobCollectorMetadata
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStoreMetadata,Session,BlobMetadata
 //go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination clock_mock_test.go github.com/juju/clock Clock
 
 func TestAll(t *stdtesting.T) {
@@ -36,6 +36,7 @@ type baseSuite struct {
 	testing.IsolationSuite
 
 	service       *MockObjectStoreMetadata
+	blobService   *MockBlobMetadata
 	claimer       *MockClaimer
 	claimExtender *MockClaimExtender
 }
@@ -44,6 +45,7 @@ func (s *baseSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.service = NewMockObjectStoreMetadata(ctrl)
+	s.blobService = NewMockBlobMetadata(ctrl)
 	s.claimer = NewMockClaimer(ctrl)
 	s.claimExtender = NewMockClaimExtender(ctrl)
 
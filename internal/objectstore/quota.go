@@ -0,0 +1,105 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+// QuotaChecker checks that storing an object in the object store doesn't
+// exceed the quotas for the storage used by the objects.
+type QuotaChecker interface {
+	// CheckSize checks that an object of the given size could be stored
+	// without exceeding the quotas. This is checked before the object is
+	// written, so that an object that could never be stored doesn't use any
+	// disk space. If the object is too large, then an error satisfying
+	// [objectstoreerrors.QuotaExceeded] is returned.
+	CheckSize(ctx context.Context, size int64) error
+
+	// Quotas returns the quotas that the objects are stored within. The
+	// quotas are enforced when the metadata for an object is saved, so that
+	// concurrent puts can't exceed them.
+	Quotas(ctx context.Context) (Quotas, error)
+}
+
+// Quotas are the quotas, in bytes, for the storage used by the objects. A
+// quota of 0 means that there is no quota.
+type Quotas struct {
+	// Model is the quota for the distinct objects stored by the namespace.
+	Model int64
+
+	// Controller is the quota for the blobs stored by every namespace in the
+	// controller-wide blob store.
+	Controller int64
+}
+
+// unlimitedQuota is a QuotaChecker that allows every object to be stored.
+type unlimitedQuota struct{}
+
+// CheckSize implements QuotaChecker.
+func (unlimitedQuota) CheckSize(context.Context, int64) error {
+	return nil
+}
+
+// Quotas implements QuotaChecker.
+func (unlimitedQuota) Quotas(context.Context) (Quotas, error) {
+	return Quotas{}, nil
+}
+
+// quotaCheckerOrDefault returns the quota checker, or a quota checker that
+// allows every object if there isn't one.
+func quotaCheckerOrDefault(checker QuotaChecker) QuotaChecker {
+	if checker == nil {
+		return unlimitedQuota{}
+	}
+	return checker
+}
+
+// checkSize checks that an object of the given size could be stored at the
+// path without exceeding the quotas.
+func (w *baseObjectStore) checkSize(ctx context.Context, path string, size int64) error {
+	if err := w.quotaChecker.CheckSize(ctx, size); errors.Is(err, objectstoreerrors.QuotaExceeded) {
+		return quotaError(path, err)
+	} else if err != nil {
+		return errors.Errorf("checking quota for %q: %w", path, err)
+	}
+	return nil
+}
+
+// quotas returns the quotas that the object at the path is stored within.
+func (w *baseObjectStore) quotas(ctx context.Context, path string) (Quotas, error) {
+	quotas, err := w.quotaChecker.Quotas(ctx)
+	if err != nil {
+		return Quotas{}, errors.Errorf("reading quotas for %q: %w", path, err)
+	}
+	return quotas, nil
+}
+
+// putMetadata saves the metadata for the object, enforcing the model quota in
+// the same transaction.
+func (w *baseObjectStore) putMetadata(ctx context.Context, metadata objectstore.Metadata, quota int64) (objectstore.UUID, error) {
+	if quota <= 0 {
+		return w.metadataService.PutMetadata(ctx, metadata)
+	}
+
+	uuid, err := w.metadataService.PutMetadataWithinQuota(ctx, metadata, quota)
+	if errors.Is(err, domainobjectstoreerrors.ErrQuotaExceeded) {
+		return "", quotaError(metadata.Path, err)
+	}
+	return uuid, err
+}
+
+// quotaError ensures that exceeding a quota is reported to the clients of the
+// object store as a quota limit being exceeded.
+func quotaError(path string, err error) error {
+	return errors.Errorf("storing %q: %w", path, err).
+		Add(objectstoreerrors.QuotaExceeded).
+		Add(coreerrors.QuotaLimitExceeded)
+}
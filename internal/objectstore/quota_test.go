@@ -0,0 +1,162 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/clock"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/objectstore"
+	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+type quotaSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&quotaSuite{})
+
+func (s *quotaSuite) TestPutWithinQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	hash384 := s.calculateHexSHA384(c, "some content")
+	hash256 := s.calculateHexSHA256(c, "some content")
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+
+	store := s.newFileObjectStore(c, c.MkDir(), fakeQuotaChecker{model: 12, controller: 24})
+	defer workertest.DirtyKill(c, store)
+
+	uuid := objectstoretesting.GenObjectStoreUUID(c)
+
+	// The quotas are enforced when the blob reference and the metadata are
+	// saved.
+	s.blobService.EXPECT().AddBlobReferenceWithinQuota(gomock.Any(), "inferi", objectstore.Blob{
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   12,
+	}, int64(24)).Return(nil)
+	s.service.EXPECT().PutMetadataWithinQuota(gomock.Any(), objectstore.Metadata{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   12,
+	}, int64(12)).Return(uuid, nil)
+
+	received, err := store.Put(context.Background(), "foo", strings.NewReader("some content"), 12)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(received, gc.Equals, uuid)
+}
+
+func (s *quotaSuite) TestPutExceedsQuotaSize(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The object is rejected before it's written, so it's never hashed or
+	// locked.
+	store := s.newFileObjectStore(c, c.MkDir(), fakeQuotaChecker{model: 11})
+	defer workertest.DirtyKill(c, store)
+
+	_, err := store.Put(context.Background(), "foo", strings.NewReader("some content"), 12)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.QuotaExceeded)
+	c.Check(err, jc.ErrorIs, coreerrors.QuotaLimitExceeded)
+}
+
+func (s *quotaSuite) TestPutExceedsModelQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+	hash384 := s.calculateHexSHA384(c, "some content")
+	hash256 := s.calculateHexSHA256(c, "some content")
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+
+	store := s.newFileObjectStore(c, path, fakeQuotaChecker{model: 12})
+	defer workertest.DirtyKill(c, store)
+
+	s.blobService.EXPECT().AddBlobReference(gomock.Any(), "inferi", gomock.Any()).Return(nil)
+	s.service.EXPECT().PutMetadataWithinQuota(gomock.Any(), gomock.Any(), int64(12)).Return("", domainobjectstoreerrors.ErrQuotaExceeded)
+
+	// The blob reference is released, so that the blob is collected.
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(objectstore.Metadata{}, domainobjectstoreerrors.ErrNotFound)
+	s.blobService.EXPECT().RemoveBlobReference(gomock.Any(), "inferi", hash384).Return(nil)
+
+	_, err := store.PutAndCheckHash(context.Background(), "foo", strings.NewReader("some content"), 12, hash384)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.QuotaExceeded)
+	c.Check(err, jc.ErrorIs, coreerrors.QuotaLimitExceeded)
+
+	// The temporary file is removed.
+	entries, err := os.ReadDir(filepath.Join(basePath(path, "inferi"), defaultTempDirectoryName))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(entries, gc.HasLen, 0)
+}
+
+func (s *quotaSuite) TestPutExceedsControllerQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	hash384 := s.calculateHexSHA384(c, "some content")
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+
+	store := s.newFileObjectStore(c, c.MkDir(), fakeQuotaChecker{controller: 12})
+	defer workertest.DirtyKill(c, store)
+
+	// The metadata isn't saved if the blob can't be referenced.
+	s.blobService.EXPECT().AddBlobReferenceWithinQuota(gomock.Any(), "inferi", gomock.Any(), int64(12)).Return(domainobjectstoreerrors.ErrQuotaExceeded)
+
+	_, err := store.PutAndCheckHash(context.Background(), "foo", strings.NewReader("some content"), 12, hash384)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.QuotaExceeded)
+	c.Check(err, jc.ErrorIs, coreerrors.QuotaLimitExceeded)
+}
+
+func (s *quotaSuite) newFileObjectStore(c *gc.C, path string, checker QuotaChecker) TrackedObjectStore {
+	store, err := NewFileObjectStore(FileObjectStoreConfig{
+		Namespace:           "inferi",
+		RootDir:             path,
+		MetadataService:     s.service,
+		BlobMetadataService: s.blobService,
+		Claimer:             s.claimer,
+		QuotaChecker:        checker,
+		Logger:              loggertesting.WrapCheckLog(c),
+		Clock:               clock.WallClock,
+	})
+	c.Assert(err, gc.IsNil)
+
+	return store
+}
+
+// fakeQuotaChecker rejects objects larger than the model quota, and returns
+// the quotas to be enforced when the object is saved.
+type fakeQuotaChecker struct {
+	model      int64
+	controller int64
+}
+
+func (f fakeQuotaChecker) CheckSize(_ context.Context, size int64) error {
+	if f.model > 0 && size > f.model {
+		return objectstoreerrors.QuotaExceeded
+	}
+	return nil
+}
+
+func (f fakeQuotaChecker) Quotas(context.Context) (Quotas, error) {
+	return Quotas{
+		Model:      f.model,
+		Controller: f.controller,
+	}, nil
+}
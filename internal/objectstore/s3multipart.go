@@ -0,0 +1,459 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	jujuerrors "github.com/juju/errors"
+
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+const (
+	// defaultMultipartThreshold is the size at which objects are streamed to
+	// the s3 object store using a multipart upload, instead of being written
+	// to a temporary file first.
+	defaultMultipartThreshold = 64 * 1024 * 1024
+
+	// defaultMinPartSize is the smallest size of a part of a multipart
+	// upload. S3 requires every part, other than the last, to be at least
+	// 5MiB.
+	defaultMinPartSize = 16 * 1024 * 1024
+
+	// maxParts is the maximum number of parts of a multipart upload allowed
+	// by S3.
+	maxParts = 10000
+
+	// partAttempts is the number of times a part is uploaded before the
+	// multipart upload is abandoned.
+	partAttempts = 3
+
+	// partRetryDelay is the delay between attempts to upload a part.
+	partRetryDelay = time.Second
+
+	// staleUploadAge is the age at which a multipart upload is considered to
+	// have been abandoned, and is aborted when the object store is pruned.
+	staleUploadAge = 24 * time.Hour
+
+	// uploadsDirectory is the prefix, under the namespace, of the objects
+	// being uploaded before their hash is known.
+	uploadsDirectory = "uploads"
+)
+
+const (
+	// errMultipartNotSupported is returned when the object store session
+	// doesn't support multipart uploads.
+	errMultipartNotSupported = errors.ConstError("multipart uploads not supported")
+
+	// errTooMuchData is returned when the reader has more data than the size
+	// of the object.
+	errTooMuchData = errors.ConstError("too much data")
+)
+
+// putMultipart streams the data from the reader to the s3 object store using
+// a multipart upload, hashing the data as it's uploaded. Only one part is
+// held in memory at a time, so the object doesn't need to be written to disk
+// before it's uploaded.
+//
+// If the expected hash is known up front, the object is uploaded straight to
+// its final location. The upload is only completed once the hash has been
+// verified, so an object with the wrong contents is never visible.
+// Otherwise, the object is uploaded to a staging location and copied to its
+// final location once the hash is known.
+//
+// If the expected hash is known and the data can't be uploaded in full, then
+// the upload is left in place, so that a later put of the same object can
+// resume it. The object store records the upload and its parts, so the upload
+// can be resumed after a restart, or by another controller. The parts that
+// were already uploaded, and match the data, aren't uploaded again. Uploads
+// that are never resumed are aborted once they're stale.
+//
+// If the session doesn't support multipart uploads, then an error satisfying
+// [errMultipartNotSupported] is returned before any data is read.
+func (t *s3ObjectStore) putMultipart(ctx context.Context, path string, r io.Reader, size int64, validator hashValidator) (objectstore.UUID, error) {
+	t.logger.Debugf(context.TODO(), "streaming object %q to s3 storage", path)
+
+	// If there is an expected hash, we can upload the object straight to
+	// where it belongs.
+	expected, _ := validator("")
+	objectName := t.filePath(expected)
+	if expected == "" {
+		id, err := uuid.NewUUID()
+		if err != nil {
+			return "", errors.Capture(err)
+		}
+		objectName = t.uploadPath(id.String())
+	}
+
+	var (
+		uploadID string
+		uploaded map[int32]objectstore.CompletedPart
+	)
+	if expected != "" {
+		uploadID, uploaded = t.findMultipartUpload(ctx, objectName)
+	}
+	if uploadID == "" {
+		var err error
+		if uploadID, err = t.createMultipartUpload(ctx, objectName); err != nil {
+			return "", errors.Capture(err)
+		}
+	} else {
+		t.logger.Debugf(context.TODO(), "resuming multipart upload of %q with %d parts", path, len(uploaded))
+	}
+
+	// Abort the upload if we fail to complete it, so that the parts don't
+	// linger in the object store, unless it can be resumed.
+	var completed, resumable bool
+	defer func() {
+		if completed {
+			return
+		} else if resumable {
+			t.logger.Debugf(context.TODO(), "leaving multipart upload of %q to be resumed", path)
+			return
+		}
+		if err := t.abortMultipartUpload(ctx, objectName, uploadID); err != nil {
+			t.logger.Warningf(context.TODO(), "aborting multipart upload of %q: %v", path, err)
+		}
+	}()
+
+	hash384 := sha512.New384()
+	hash256 := sha256.New()
+
+	parts, err := t.uploadParts(ctx, objectName, uploadID, io.TeeReader(r, io.MultiWriter(hash384, hash256)), size, uploaded)
+	if errors.Is(err, errTooMuchData) {
+		return "", errors.Capture(err)
+	} else if err != nil {
+		// The parts that were uploaded are kept, so that the upload can
+		// be resumed. This is only possible if the object is being
+		// uploaded to its final location.
+		resumable = expected != ""
+		return "", errors.Capture(err)
+	}
+
+	encoded384 := hex.EncodeToString(hash384.Sum(nil))
+	encoded256 := hex.EncodeToString(hash256.Sum(nil))
+
+	// Ensure that the hash of the object matches the expected hash, before
+	// the upload is completed.
+	if expected, ok := validator(encoded384); !ok {
+		return "", errors.Errorf("hash mismatch for %q: expected %q, got %q: %w", path, expected, encoded384, objectstore.ErrHashMismatch)
+	}
+
+	metadata := objectstore.Metadata{
+		Path:   path,
+		SHA256: encoded256,
+		SHA384: encoded384,
+		Size:   size,
+	}
+
+	quotas, err := t.quotas(ctx, path)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	// The object was uploaded to its final location, so it only needs to be
+	// completed.
+	if expected != "" {
+		var uuid objectstore.UUID
+		if err := t.withLock(ctx, encoded384, func(ctx context.Context) error {
+			// If the object is already stored, then the upload is aborted
+			// rather than replacing the object with the same contents.
+			if err := t.objectExists(ctx, objectName); errors.Is(err, jujuerrors.NotFound) {
+				if err := t.completeMultipartUpload(ctx, objectName, uploadID, parts); err != nil {
+					return errors.Capture(err)
+				}
+				completed = true
+			} else if err != nil {
+				return errors.Capture(err)
+			}
+
+			var err error
+			if uuid, err = t.putMetadata(ctx, metadata, quotas.Model); err != nil {
+				return errors.Capture(err)
+			}
+			return nil
+		}); err != nil {
+			return "", errors.Capture(err)
+		}
+		return uuid, nil
+	}
+
+	// Complete the upload to the staging location, then copy it to the
+	// final location. The staging object is always removed.
+	if err := t.completeMultipartUpload(ctx, objectName, uploadID, parts); err != nil {
+		return "", errors.Capture(err)
+	}
+	completed = true
+
+	defer func() {
+		if err := t.deleteObjectName(ctx, objectName); err != nil {
+			t.logger.Warningf(context.TODO(), "removing staged object %q: %v", objectName, err)
+		}
+	}()
+
+	var uuid objectstore.UUID
+	if err := t.withLock(ctx, encoded384, func(ctx context.Context) error {
+		if err := t.objectExists(ctx, t.filePath(encoded384)); errors.Is(err, jujuerrors.NotFound) {
+			if err := t.copyObject(ctx, objectName, t.filePath(encoded384)); err != nil {
+				return errors.Capture(err)
+			}
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		var err error
+		if uuid, err = t.putMetadata(ctx, metadata, quotas.Model); err != nil {
+			return errors.Capture(err)
+		}
+		return nil
+	}); err != nil {
+		return "", errors.Capture(err)
+	}
+	return uuid, nil
+}
+
+// uploadParts reads exactly size bytes from the reader, uploading them in
+// parts. Each part is retried if it fails to upload, without having to
+// upload the parts before it again. Parts that have already been uploaded
+// with the same contents are not uploaded again.
+func (t *s3ObjectStore) uploadParts(
+	ctx context.Context,
+	objectName, uploadID string,
+	r io.Reader, size int64,
+	uploaded map[int32]objectstore.CompletedPart,
+) ([]objectstore.CompletedPart, error) {
+	partSize := t.partSize(size)
+	buffer := make([]byte, partSize)
+
+	var (
+		parts   []objectstore.CompletedPart
+		written int64
+	)
+	for partNumber := int32(1); written < size; partNumber++ {
+		n, err := io.ReadFull(r, buffer[:min(partSize, size-written)])
+		written += int64(n)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errors.Errorf("partially written data: written %d, expected %d", written, size)
+		} else if err != nil {
+			return nil, errors.Capture(err)
+		}
+
+		hash := sha256.Sum256(buffer[:n])
+		encodedHash := base64.StdEncoding.EncodeToString(hash[:])
+		if part, ok := uploaded[partNumber]; ok && part.Hash == encodedHash {
+			parts = append(parts, part)
+			continue
+		}
+
+		part, err := t.uploadPart(ctx, objectName, uploadID, partNumber, buffer[:n], encodedHash)
+		if err != nil {
+			return nil, errors.Errorf("uploading part %d: %w", partNumber, err)
+		}
+		parts = append(parts, part)
+	}
+
+	// Ensure that there isn't any more data than we expected.
+	if n, err := io.ReadFull(r, buffer[:1]); n > 0 {
+		return nil, errors.Errorf("%w: expected %d", errTooMuchData, size)
+	} else if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Capture(err)
+	}
+
+	return parts, nil
+}
+
+// uploadPart uploads the part, retrying if it fails. The hash is the base64
+// encoded SHA256 of the part.
+func (t *s3ObjectStore) uploadPart(ctx context.Context, objectName, uploadID string, partNumber int32, data []byte, encodedHash string) (objectstore.CompletedPart, error) {
+	var lastErr error
+	for attempt := 1; attempt <= partAttempts; attempt++ {
+		var part objectstore.CompletedPart
+		err := t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+			var err error
+			part, err = s.UploadPart(ctx, t.rootBucket, objectName, uploadID, partNumber, bytes.NewReader(data), encodedHash)
+			return err
+		})
+		if err == nil {
+			return part, nil
+		}
+		lastErr = err
+
+		t.logger.Debugf(context.TODO(), "uploading part %d of %q failed (attempt %d): %v", partNumber, objectName, attempt, err)
+
+		if attempt == partAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return objectstore.CompletedPart{}, ctx.Err()
+		case <-t.clock.After(partRetryDelay):
+		}
+	}
+	return objectstore.CompletedPart{}, errors.Capture(lastErr)
+}
+
+// partSize returns the size of the parts used to upload an object of the
+// given size, keeping within the maximum number of parts.
+func (t *s3ObjectStore) partSize(size int64) int64 {
+	return max(t.minPartSize, (size+maxParts-1)/maxParts)
+}
+
+// abortStaleUploads aborts the multipart uploads in the namespace that were
+// started long enough ago that they must have been abandoned. Uploads that
+// are still in progress, possibly by another controller, are left alone.
+func (t *s3ObjectStore) abortStaleUploads(ctx context.Context) error {
+	var uploads []objectstore.MultipartUpload
+	if err := t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+		var err error
+		uploads, err = s.ListMultipartUploads(ctx, t.rootBucket, t.namespace+"/")
+		return err
+	}); errors.Is(err, errMultipartNotSupported) {
+		return nil
+	} else if err != nil {
+		return errors.Errorf("listing multipart uploads: %w", err)
+	}
+
+	cutoff := t.clock.Now().Add(-staleUploadAge)
+	for _, upload := range uploads {
+		if upload.Initiated.After(cutoff) {
+			continue
+		}
+
+		t.logger.Debugf(context.TODO(), "aborting stale multipart upload of %q", upload.ObjectName)
+
+		if err := t.abortMultipartUpload(ctx, upload.ObjectName, upload.UploadID); err != nil {
+			t.logger.Infof(context.TODO(), "failed to abort stale multipart upload of %q: %v, will try again later", upload.ObjectName, err)
+		}
+	}
+	return nil
+}
+
+// findMultipartUpload returns the most recent multipart upload of the object
+// that isn't stale, along with the parts that have already been uploaded,
+// keyed by their part number. If there isn't an upload that can be resumed,
+// then an empty upload ID is returned.
+func (t *s3ObjectStore) findMultipartUpload(ctx context.Context, objectName string) (string, map[int32]objectstore.CompletedPart) {
+	var uploads []objectstore.MultipartUpload
+	if err := t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+		var err error
+		uploads, err = s.ListMultipartUploads(ctx, t.rootBucket, objectName)
+		return err
+	}); err != nil {
+		t.logger.Debugf(context.TODO(), "listing multipart uploads of %q: %v", objectName, err)
+		return "", nil
+	}
+
+	var found *objectstore.MultipartUpload
+	cutoff := t.clock.Now().Add(-staleUploadAge)
+	for i, upload := range uploads {
+		if upload.ObjectName != objectName || !upload.Initiated.After(cutoff) {
+			continue
+		}
+		if found == nil || upload.Initiated.After(found.Initiated) {
+			found = &uploads[i]
+		}
+	}
+	if found == nil {
+		return "", nil
+	}
+
+	var parts []objectstore.CompletedPart
+	if err := t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+		var err error
+		parts, err = s.ListParts(ctx, t.rootBucket, objectName, found.UploadID)
+		return err
+	}); err != nil {
+		t.logger.Debugf(context.TODO(), "listing parts of multipart upload of %q: %v", objectName, err)
+		return "", nil
+	}
+
+	uploaded := make(map[int32]objectstore.CompletedPart, len(parts))
+	for _, part := range parts {
+		// Parts without a hash can't be verified, so they're uploaded
+		// again.
+		if part.Hash != "" {
+			uploaded[part.PartNumber] = part
+		}
+	}
+	return found.UploadID, uploaded
+}
+
+func (t *s3ObjectStore) createMultipartUpload(ctx context.Context, objectName string) (string, error) {
+	var uploadID string
+	if err := t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+		var err error
+		uploadID, err = s.CreateMultipartUpload(ctx, t.rootBucket, objectName)
+		return err
+	}); err != nil {
+		return "", errors.Capture(err)
+	}
+	return uploadID, nil
+}
+
+func (t *s3ObjectStore) completeMultipartUpload(ctx context.Context, objectName, uploadID string, parts []objectstore.CompletedPart) error {
+	return t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+		return s.CompleteMultipartUpload(ctx, t.rootBucket, objectName, uploadID, parts)
+	})
+}
+
+func (t *s3ObjectStore) abortMultipartUpload(ctx context.Context, objectName, uploadID string) error {
+	return t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+		err := s.AbortMultipartUpload(ctx, t.rootBucket, objectName, uploadID)
+		if err == nil || errors.Is(err, jujuerrors.NotFound) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+}
+
+func (t *s3ObjectStore) copyObject(ctx context.Context, srcObjectName, dstObjectName string) error {
+	return t.multipartSession(ctx, func(ctx context.Context, s objectstore.MultipartSession) error {
+		return s.CopyObject(ctx, t.rootBucket, srcObjectName, dstObjectName)
+	})
+}
+
+func (t *s3ObjectStore) objectExists(ctx context.Context, objectName string) error {
+	return t.client.Session(ctx, func(ctx context.Context, s objectstore.Session) error {
+		return s.ObjectExists(ctx, t.rootBucket, objectName)
+	})
+}
+
+func (t *s3ObjectStore) deleteObjectName(ctx context.Context, objectName string) error {
+	return t.client.Session(ctx, func(ctx context.Context, s objectstore.Session) error {
+		err := s.DeleteObject(ctx, t.rootBucket, objectName)
+		if err == nil || errors.Is(err, jujuerrors.NotFound) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+}
+
+// multipartSession calls the function with the session, if the session
+// supports multipart uploads.
+func (t *s3ObjectStore) multipartSession(ctx context.Context, f func(context.Context, objectstore.MultipartSession) error) error {
+	return t.client.Session(ctx, func(ctx context.Context, s objectstore.Session) error {
+		ms, ok := s.(objectstore.MultipartSession)
+		if !ok {
+			return errMultipartNotSupported
+		}
+		return f(ctx, ms)
+	})
+}
+
+// uploadPath returns the staging location of an object that is being
+// uploaded before its hash is known.
+func (t *s3ObjectStore) uploadPath(id string) string {
+	return fmt.Sprintf("%s/%s/%s", t.namespace, uploadsDirectory, id)
+}
@@ -0,0 +1,636 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+type s3MultipartSuite struct {
+	baseSuite
+
+	clock   *testclock.Clock
+	session *fakeMultipartSession
+}
+
+var _ = gc.Suite(&s3MultipartSuite{})
+
+func (s *s3MultipartSuite) TestPutAndCheckHashStreamed(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+	hash256 := s.calculateHexSHA256(c, content)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   int64(len(content)),
+	}).Return("abc", nil)
+
+	store := s.newS3ObjectStore(c)
+
+	uuid, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(uuid, gc.Equals, objectstore.UUID("abc"))
+
+	// The object is uploaded straight to its final location, in parts of
+	// the minimum size.
+	c.Check(s.session.objects, jc.DeepEquals, map[string]string{
+		filePath(hash384): content,
+	})
+	c.Check(s.session.partsUploaded, gc.Equals, 6)
+	c.Check(s.session.uploads, gc.HasLen, 0)
+}
+
+func (s *s3MultipartSuite) TestPutAndCheckHashStreamedHashMismatch(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	fakeHash := s.calculateHexSHA384(c, "other content")
+
+	store := s.newS3ObjectStore(c)
+
+	_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(fakeHash))
+	c.Assert(err, jc.ErrorIs, objectstore.ErrHashMismatch)
+
+	// The upload is aborted, so the object is never visible.
+	c.Check(s.session.objects, gc.HasLen, 0)
+	c.Check(s.session.uploads, gc.HasLen, 0)
+}
+
+func (s *s3MultipartSuite) TestPutAndCheckHashStreamedAlreadyExists(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+	hash256 := s.calculateHexSHA256(c, content)
+
+	s.session.objects[filePath(hash384)] = content
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   int64(len(content)),
+	}).Return("abc", nil)
+
+	store := s.newS3ObjectStore(c)
+
+	_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The upload is aborted, rather than replacing the object.
+	c.Check(s.session.objects, gc.HasLen, 1)
+	c.Check(s.session.uploads, gc.HasLen, 0)
+	c.Check(s.session.completed, gc.Equals, 0)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedRetriesPart(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+	hash256 := s.calculateHexSHA256(c, content)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   int64(len(content)),
+	}).Return("abc", nil)
+
+	// The third part fails once, so only that part is uploaded again.
+	s.session.failParts = map[int32]int{3: 1}
+
+	store := s.newS3ObjectStore(c)
+
+	result := make(chan error)
+	go func() {
+		_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+		result <- err
+	}()
+
+	err := s.clock.WaitAdvance(partRetryDelay, time.Second, 1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case err := <-result:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(time.Second * 10):
+		c.Fatalf("timed out waiting for put")
+	}
+
+	c.Check(s.session.objects, jc.DeepEquals, map[string]string{
+		filePath(hash384): content,
+	})
+	c.Check(s.session.partsUploaded, gc.Equals, 6)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedPartFails(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+
+	s.session.failParts = map[int32]int{2: partAttempts}
+
+	store := s.newS3ObjectStore(c)
+
+	result := make(chan error)
+	go func() {
+		_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+		result <- err
+	}()
+
+	for i := 1; i < partAttempts; i++ {
+		err := s.clock.WaitAdvance(partRetryDelay, time.Second, 1)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	select {
+	case err := <-result:
+		c.Assert(err, gc.ErrorMatches, `.*uploading part 2: boom`)
+	case <-time.After(time.Second * 10):
+		c.Fatalf("timed out waiting for put")
+	}
+
+	// The upload is left in place, so that it can be resumed.
+	c.Check(s.session.objects, gc.HasLen, 0)
+	c.Check(s.session.uploads, gc.HasLen, 1)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedResumesUpload(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+	hash256 := s.calculateHexSHA256(c, content)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   int64(len(content)),
+	}).Return("abc", nil)
+
+	// The reader fails part way through the third part, after the first
+	// two parts have been uploaded.
+	store := s.newS3ObjectStore(c)
+
+	_, err := store.put(context.Background(), "foo", io.LimitReader(strings.NewReader(content), 10), int64(len(content)), checkHash(hash384))
+	c.Assert(err, gc.ErrorMatches, `.*partially written data: written 10, expected 22`)
+	c.Assert(s.session.uploads, gc.HasLen, 1)
+	c.Assert(s.session.partsUploaded, gc.Equals, 2)
+
+	// A new store, as if the controller was restarted, resumes the upload
+	// without uploading the first two parts again.
+	store = s.newS3ObjectStore(c)
+
+	uuid, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(uuid, gc.Equals, objectstore.UUID("abc"))
+
+	c.Check(s.session.objects, jc.DeepEquals, map[string]string{
+		filePath(hash384): content,
+	})
+	c.Check(s.session.partsUploaded, gc.Equals, 6)
+	c.Check(s.session.completed, gc.Equals, 1)
+	c.Check(s.session.uploads, gc.HasLen, 0)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedDoesNotResumeStaleUpload(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadata(gomock.Any(), gomock.Any()).Return("abc", nil)
+
+	s.session.uploads = map[string]*fakeUpload{
+		"stale": {
+			objectName: filePath(hash384),
+			initiated:  s.clock.Now().Add(-staleUploadAge - time.Minute),
+			parts: map[int32]string{
+				1: content[:4],
+			},
+		},
+	}
+
+	store := s.newS3ObjectStore(c)
+
+	_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The stale upload is left to be aborted when the store is pruned.
+	c.Check(s.session.partsUploaded, gc.Equals, 6)
+	c.Check(s.session.uploads, gc.HasLen, 1)
+	c.Check(s.session.uploads["stale"], gc.NotNil)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedPartialData(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+
+	store := s.newS3ObjectStore(c)
+
+	_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content))+1, ignoreHash)
+	c.Assert(err, gc.ErrorMatches, `.*partially written data: written 22, expected 23`)
+
+	c.Check(s.session.objects, gc.HasLen, 0)
+	c.Check(s.session.uploads, gc.HasLen, 0)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedTooMuchData(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+
+	store := s.newS3ObjectStore(c)
+
+	_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content))-1, ignoreHash)
+	c.Assert(err, gc.ErrorMatches, `.*too much data: expected 21`)
+
+	c.Check(s.session.objects, gc.HasLen, 0)
+	c.Check(s.session.uploads, gc.HasLen, 0)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedWithoutHash(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+	hash256 := s.calculateHexSHA256(c, content)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadata(gomock.Any(), objectstore.Metadata{
+		Path:   "foo",
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   int64(len(content)),
+	}).Return("abc", nil)
+
+	store := s.newS3ObjectStore(c)
+
+	uuid, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), ignoreHash)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(uuid, gc.Equals, objectstore.UUID("abc"))
+
+	// The object is copied from the staging location, which is removed.
+	c.Check(s.session.objects, jc.DeepEquals, map[string]string{
+		filePath(hash384): content,
+	})
+	c.Check(s.session.uploads, gc.HasLen, 0)
+}
+
+func (s *s3MultipartSuite) TestPutStreamedQuotaExceeded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadataWithinQuota(gomock.Any(), gomock.Any(), int64(1024)).Return("", domainobjectstoreerrors.ErrQuotaExceeded)
+
+	store := s.newS3ObjectStore(c)
+	store.quotaChecker = modelQuotaChecker{quota: 1024}
+
+	_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.QuotaExceeded)
+
+	// The object without any metadata is left to be pruned.
+	c.Check(s.session.objects, jc.DeepEquals, map[string]string{
+		filePath(hash384): content,
+	})
+	c.Check(s.session.uploads, gc.HasLen, 0)
+}
+
+func (s *s3MultipartSuite) TestPutBelowThresholdNotStreamed(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	content := "some content to stream"
+	hash384 := s.calculateHexSHA384(c, content)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.service.EXPECT().PutMetadata(gomock.Any(), gomock.Any()).Return("abc", nil)
+
+	store := s.newS3ObjectStore(c)
+	store.multipartThreshold = int64(len(content)) + 1
+
+	_, err := store.put(context.Background(), "foo", strings.NewReader(content), int64(len(content)), checkHash(hash384))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.session.objects, jc.DeepEquals, map[string]string{
+		filePath(hash384): content,
+	})
+	c.Check(s.session.partsUploaded, gc.Equals, 0)
+	c.Check(s.session.putHashes, jc.DeepEquals, []string{s.calculateBase64SHA256(c, content)})
+}
+
+func (s *s3MultipartSuite) TestAbortStaleUploads(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	s.session.uploads = map[string]*fakeUpload{
+		"stale": {
+			objectName: "inferi/foo",
+			initiated:  now.Add(-staleUploadAge - time.Minute),
+		},
+		"recent": {
+			objectName: "inferi/bar",
+			initiated:  now.Add(-time.Minute),
+		},
+		"other": {
+			objectName: "other/foo",
+			initiated:  now.Add(-staleUploadAge - time.Minute),
+		},
+	}
+
+	store := s.newS3ObjectStore(c)
+
+	err := store.abortStaleUploads(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	var remaining []string
+	for id := range s.session.uploads {
+		remaining = append(remaining, id)
+	}
+	sort.Strings(remaining)
+	c.Check(remaining, jc.DeepEquals, []string{"other", "recent"})
+}
+
+func (s *s3MultipartSuite) TestPartSize(c *gc.C) {
+	store := &s3ObjectStore{minPartSize: defaultMinPartSize}
+
+	c.Check(store.partSize(1), gc.Equals, int64(defaultMinPartSize))
+	c.Check(store.partSize(defaultMinPartSize*maxParts), gc.Equals, int64(defaultMinPartSize))
+	c.Check(store.partSize(defaultMinPartSize*maxParts+1), gc.Equals, int64(defaultMinPartSize+1))
+}
+
+func (s *s3MultipartSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := s.baseSuite.setupMocks(c)
+
+	s.clock = testclock.NewClock(time.Now())
+	s.session = newFakeMultipartSession()
+
+	return ctrl
+}
+
+// newS3ObjectStore returns a s3 object store without starting its loop, so
+// that puts can be called directly. Any object of 4 bytes or more is
+// streamed, in parts of 4 bytes.
+func (s *s3MultipartSuite) newS3ObjectStore(c *gc.C) *s3ObjectStore {
+	store := &s3ObjectStore{
+		baseObjectStore: baseObjectStore{
+			path:            c.MkDir(),
+			claimer:         s.claimer,
+			metadataService: s.service,
+			quotaChecker:    quotaCheckerOrDefault(nil),
+			logger:          loggertesting.WrapCheckLog(c),
+			clock:           s.clock,
+		},
+		client:             &client{session: s.session},
+		rootBucket:         defaultBucketName,
+		namespace:          "inferi",
+		multipartThreshold: 4,
+		minPartSize:        4,
+	}
+
+	err := store.ensureDirectories()
+	c.Assert(err, jc.ErrorIsNil)
+
+	return store
+}
+
+// modelQuotaChecker returns a model quota to be enforced when the metadata is
+// saved.
+type modelQuotaChecker struct {
+	quota int64
+}
+
+func (modelQuotaChecker) CheckSize(context.Context, int64) error {
+	return nil
+}
+
+func (q modelQuotaChecker) Quotas(context.Context) (Quotas, error) {
+	return Quotas{Model: q.quota}, nil
+}
+
+// fakeMultipartSession is an in-memory stand-in for an s3 compatible object
+// store that supports multipart uploads.
+type fakeMultipartSession struct {
+	objects map[string]string
+	uploads map[string]*fakeUpload
+
+	// failParts is the number of times each part fails to upload.
+	failParts map[int32]int
+
+	nextID        int
+	partsUploaded int
+	completed     int
+	putHashes     []string
+}
+
+type fakeUpload struct {
+	objectName string
+	initiated  time.Time
+	parts      map[int32]string
+}
+
+func newFakeMultipartSession() *fakeMultipartSession {
+	return &fakeMultipartSession{
+		objects: make(map[string]string),
+		uploads: make(map[string]*fakeUpload),
+	}
+}
+
+func (f *fakeMultipartSession) ObjectExists(_ context.Context, _, objectName string) error {
+	if _, ok := f.objects[objectName]; !ok {
+		return errors.NotFoundf("object %q", objectName)
+	}
+	return nil
+}
+
+func (f *fakeMultipartSession) GetObject(_ context.Context, _, objectName string) (io.ReadCloser, int64, string, error) {
+	content, ok := f.objects[objectName]
+	if !ok {
+		return nil, -1, "", errors.NotFoundf("object %q", objectName)
+	}
+	return io.NopCloser(strings.NewReader(content)), int64(len(content)), "", nil
+}
+
+func (f *fakeMultipartSession) ListObjects(context.Context, string) ([]string, error) {
+	var objects []string
+	for name := range f.objects {
+		objects = append(objects, name)
+	}
+	return objects, nil
+}
+
+func (f *fakeMultipartSession) PutObject(_ context.Context, _, objectName string, body io.Reader, hash string) error {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	f.objects[objectName] = string(content)
+	f.putHashes = append(f.putHashes, hash)
+	return nil
+}
+
+func (f *fakeMultipartSession) DeleteObject(_ context.Context, _, objectName string) error {
+	if _, ok := f.objects[objectName]; !ok {
+		return errors.NotFoundf("object %q", objectName)
+	}
+	delete(f.objects, objectName)
+	return nil
+}
+
+func (f *fakeMultipartSession) CreateBucket(context.Context, string) error {
+	return nil
+}
+
+func (f *fakeMultipartSession) CreateMultipartUpload(_ context.Context, _, objectName string) (string, error) {
+	f.nextID++
+	id := fmt.Sprintf("upload-%d", f.nextID)
+	f.uploads[id] = &fakeUpload{
+		objectName: objectName,
+		initiated:  time.Now(),
+		parts:      make(map[int32]string),
+	}
+	return id, nil
+}
+
+func (f *fakeMultipartSession) UploadPart(_ context.Context, _, objectName, uploadID string, partNumber int32, body io.ReadSeeker, hash string) (objectstore.CompletedPart, error) {
+	upload, ok := f.uploads[uploadID]
+	if !ok || upload.objectName != objectName {
+		return objectstore.CompletedPart{}, errors.NotFoundf("upload %q", uploadID)
+	}
+	if f.failParts[partNumber] > 0 {
+		f.failParts[partNumber]--
+		return objectstore.CompletedPart{}, errors.New("boom")
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return objectstore.CompletedPart{}, err
+	}
+	sum := sha256.Sum256(content)
+	if actual := base64.StdEncoding.EncodeToString(sum[:]); actual != hash {
+		return objectstore.CompletedPart{}, errors.Errorf("part hash mismatch: expected %q, got %q", hash, actual)
+	}
+
+	upload.parts[partNumber] = string(content)
+	f.partsUploaded++
+	return objectstore.CompletedPart{
+		PartNumber: partNumber,
+		ETag:       fmt.Sprintf("etag-%d", partNumber),
+		Hash:       hash,
+	}, nil
+}
+
+func (f *fakeMultipartSession) CompleteMultipartUpload(_ context.Context, _, objectName, uploadID string, parts []objectstore.CompletedPart) error {
+	upload, ok := f.uploads[uploadID]
+	if !ok || upload.objectName != objectName {
+		return errors.NotFoundf("upload %q", uploadID)
+	}
+
+	var content strings.Builder
+	for i, part := range parts {
+		if part.PartNumber != int32(i+1) {
+			return errors.Errorf("unexpected part %d", part.PartNumber)
+		}
+		content.WriteString(upload.parts[part.PartNumber])
+	}
+	f.objects[objectName] = content.String()
+	f.completed++
+	delete(f.uploads, uploadID)
+	return nil
+}
+
+func (f *fakeMultipartSession) AbortMultipartUpload(_ context.Context, _, objectName, uploadID string) error {
+	upload, ok := f.uploads[uploadID]
+	if !ok || upload.objectName != objectName {
+		return errors.NotFoundf("upload %q", uploadID)
+	}
+	delete(f.uploads, uploadID)
+	return nil
+}
+
+func (f *fakeMultipartSession) ListMultipartUploads(_ context.Context, _, prefix string) ([]objectstore.MultipartUpload, error) {
+	var uploads []objectstore.MultipartUpload
+	for id, upload := range f.uploads {
+		if !strings.HasPrefix(upload.objectName, prefix) {
+			continue
+		}
+		uploads = append(uploads, objectstore.MultipartUpload{
+			ObjectName: upload.objectName,
+			UploadID:   id,
+			Initiated:  upload.initiated,
+		})
+	}
+	return uploads, nil
+}
+
+func (f *fakeMultipartSession) ListParts(_ context.Context, _, objectName, uploadID string) ([]objectstore.CompletedPart, error) {
+	upload, ok := f.uploads[uploadID]
+	if !ok || upload.objectName != objectName {
+		return nil, errors.NotFoundf("upload %q", uploadID)
+	}
+
+	var parts []objectstore.CompletedPart
+	for partNumber, content := range upload.parts {
+		sum := sha256.Sum256([]byte(content))
+		parts = append(parts, objectstore.CompletedPart{
+			PartNumber: partNumber,
+			ETag:       fmt.Sprintf("etag-%d", partNumber),
+			Hash:       base64.StdEncoding.EncodeToString(sum[:]),
+		})
+	}
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].PartNumber < parts[j].PartNumber
+	})
+	return parts, nil
+}
+
+func (f *fakeMultipartSession) CopyObject(_ context.Context, _, srcObjectName, dstObjectName string) error {
+	content, ok := f.objects[srcObjectName]
+	if !ok {
+		return errors.NotFoundf("object %q", srcObjectName)
+	}
+	f.objects[dstObjectName] = content
+	return nil
+}
@@ -73,6 +73,12 @@ type S3ObjectStoreConfig struct {
 	// AllowDraining is a flag to allow draining files from the file backed
 	// object store to the s3 object store.
 	AllowDraining bool
+	// ObjectSources are the sources used to repair the objects that are
+	// found to be corrupt or missing when the object store is scrubbed.
+	ObjectSources []ObjectSource
+	// QuotaChecker checks that the objects put into the object store don't
+	// exceed the quotas. If nil, then the objects aren't subject to a quota.
+	QuotaChecker QuotaChecker
 
 	Logger logger.Logger
 	Clock  clock.Clock
@@ -113,6 +119,13 @@ type s3ObjectStore struct {
 	// object store to the s3 object store.
 	fileSystemAccessor HashFileSystemAccessor
 	allowDraining      bool
+
+	scrubber *scrubber
+
+	// multipartThreshold is the size at which objects are streamed to the
+	// object store, and minPartSize is the smallest part that is uploaded.
+	multipartThreshold int64
+	minPartSize        int64
 }
 
 // NewS3ObjectStore returns a new object store worker based on the s3 backing
@@ -129,6 +142,7 @@ func newS3ObjectStore(cfg S3ObjectStoreConfig, internalStates chan string) (*s3O
 			path:            path,
 			claimer:         cfg.Claimer,
 			metadataService: cfg.MetadataService,
+			quotaChecker:    quotaCheckerOrDefault(cfg.QuotaChecker),
 			logger:          cfg.Logger,
 			clock:           cfg.Clock,
 		},
@@ -140,6 +154,14 @@ func newS3ObjectStore(cfg S3ObjectStoreConfig, internalStates chan string) (*s3O
 		fileSystemAccessor: cfg.HashFileSystemAccessor,
 		allowDraining:      cfg.AllowDraining,
 
+		scrubber: &scrubber{
+			sources: cfg.ObjectSources,
+			rate:    defaultScrubRate,
+		},
+
+		multipartThreshold: defaultMultipartThreshold,
+		minPartSize:        defaultMinPartSize,
+
 		requests:      make(chan request),
 		drainRequests: make(chan drainRequest),
 	}
@@ -385,6 +407,10 @@ func (t *s3ObjectStore) loop() error {
 		return errors.Capture(err)
 	}
 
+	// The scrub runs in its own goroutine, so that it doesn't block the
+	// requests to the object store.
+	t.tomb.Go(t.scrubLoop(t.scrubber, t.openObject, t.replaceObject))
+
 	timer := t.clock.NewTimer(jitter(defaultPruneInterval))
 	defer timer.Stop()
 
@@ -515,6 +541,11 @@ func (t *s3ObjectStore) loop() error {
 				continue
 			}
 
+			if err := t.abortStaleUploads(ctx); err != nil {
+				t.logger.Errorf(context.TODO(), "aborting stale uploads: %v", err)
+				continue
+			}
+
 		case req, ok := <-t.drainRequests:
 			if !ok {
 				// File draining has completed, so we can stop processing
@@ -630,6 +661,22 @@ func (t *s3ObjectStore) put(ctx context.Context, path string, r io.Reader, size
 	// file and create TeeReader with a MultiWriter.
 	hash256 := sha256.New()
 
+	// Reject objects that could never be stored, before they use any disk
+	// space.
+	if err := t.checkSize(ctx, path, size); err != nil {
+		return "", errors.Capture(err)
+	}
+
+	// Large objects are streamed straight to the object store, so that they
+	// don't need the same amount of free local disk space. If the object
+	// store doesn't support it, then fallback to writing a temp file.
+	if size >= t.multipartThreshold {
+		uuid, err := t.putMultipart(ctx, path, r, size, validator)
+		if !errors.Is(err, errMultipartNotSupported) {
+			return uuid, err
+		}
+	}
+
 	// We need to write this to a temp file, because if the client retries
 	// then we need seek back to the beginning of the file.
 	fileName, tmpFileCleanup, err := t.writeToTmpFile(t.path, io.TeeReader(r, io.MultiWriter(hash384, hash256)), size)
@@ -651,6 +698,18 @@ func (t *s3ObjectStore) put(ctx context.Context, path string, r io.Reader, size
 		return "", errors.Errorf("hash mismatch for %q: expected %q, got %q: %w", path, expected, encoded384, objectstore.ErrHashMismatch)
 	}
 
+	metadata := objectstore.Metadata{
+		Path:   path,
+		SHA256: encoded256,
+		SHA384: encoded384,
+		Size:   size,
+	}
+
+	quotas, err := t.quotas(ctx, path)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
 	// Lock the file with the given hash (encoded384), so that we can't
 	// remove the file while we're writing it.
 	var uuid objectstore.UUID
@@ -664,12 +723,7 @@ func (t *s3ObjectStore) put(ctx context.Context, path string, r io.Reader, size
 		// correctly sequence the watch events. Otherwise there is a potential
 		// race where the watch event is emitted before the file is written.
 		var err error
-		if uuid, err = t.metadataService.PutMetadata(ctx, objectstore.Metadata{
-			Path:   path,
-			SHA256: encoded256,
-			SHA384: encoded384,
-			Size:   size,
-		}); err != nil {
+		if uuid, err = t.putMetadata(ctx, metadata, quotas.Model); err != nil {
 			return errors.Capture(err)
 		}
 		return nil
@@ -740,6 +794,46 @@ func (t *s3ObjectStore) remove(ctx context.Context, path string) error {
 	})
 }
 
+// Report returns the outcome of the last scrub of the object store. This is
+// used by the engine report.
+func (t *s3ObjectStore) Report() map[string]any {
+	return map[string]any{
+		"scrub": t.scrubber.Report(),
+	}
+}
+
+// openObject opens the stored copy of the object with the given hash, for
+// scrubbing.
+func (t *s3ObjectStore) openObject(ctx context.Context, hash string) (io.ReadCloser, error) {
+	var reader io.ReadCloser
+	if err := t.client.Session(ctx, func(ctx context.Context, s objectstore.Session) error {
+		var err error
+		reader, _, _, err = s.GetObject(ctx, t.rootBucket, t.filePath(hash))
+		return err
+	}); errors.Is(err, jujuerrors.NotFound) {
+		return nil, objectstoreerrors.ObjectNotFound
+	} else if err != nil {
+		return nil, errors.Errorf("get object: %w", err)
+	}
+	return reader, nil
+}
+
+// replaceObject replaces the stored copy of the object with the verified
+// copy in the file. This must be called with the object locked.
+func (t *s3ObjectStore) replaceObject(ctx context.Context, metadata objectstore.Metadata, fileName string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer file.Close()
+
+	hash256, err := hex.DecodeString(metadata.SHA256)
+	if err != nil {
+		return errors.Errorf("decoding SHA256 %q: %w", metadata.SHA256, err)
+	}
+	return t.putFile(ctx, file, selectFileHash(metadata), base64.StdEncoding.EncodeToString(hash256))
+}
+
 func (t *s3ObjectStore) filePath(hash string) string {
 	return fmt.Sprintf("%s/%s", t.namespace, hash)
 }
@@ -0,0 +1,364 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/ratelimit"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	"github.com/juju/juju/internal/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+const (
+	// defaultScrubInterval is the interval between scrubs of the objects in
+	// the object store. Every object is read back in full, so this should be
+	// infrequent.
+	defaultScrubInterval = time.Hour * 24
+
+	// defaultScrubRate is the rate, in bytes per second, at which the
+	// objects are read back during a scrub. This stops a scrub from
+	// saturating the disk or network, and starving the requests to the
+	// object store.
+	defaultScrubRate = 32 * 1024 * 1024
+
+	// errObjectRemoved is returned when an object is removed from the object
+	// store while it's being repaired.
+	errObjectRemoved = errors.ConstError("object removed")
+)
+
+// ObjectSource is a source of the contents of the objects in a namespace. It
+// is used to repair objects that are corrupt or missing from the object store.
+type ObjectSource interface {
+	// GetObject returns the contents of the object described by the
+	// metadata. If the source doesn't have the object, then an error
+	// satisfying [objectstoreerrors.ObjectNotFound] is returned.
+	//
+	// The contents are verified against the metadata before they're used,
+	// so the source doesn't need to verify them.
+	GetObject(ctx context.Context, metadata objectstore.Metadata) (io.ReadCloser, int64, error)
+}
+
+// scrubOpenFunc opens the stored copy of the object with the given hash. If
+// there is no stored copy, then an error satisfying
+// [objectstoreerrors.ObjectNotFound] is returned.
+type scrubOpenFunc func(ctx context.Context, hash string) (io.ReadCloser, error)
+
+// scrubRepairFunc replaces the stored copy of the object with the verified
+// copy in the file. The object is locked while it's repaired.
+type scrubRepairFunc func(ctx context.Context, metadata objectstore.Metadata, fileName string) error
+
+// scrubResult describes the outcome of a scrub of the object store.
+type scrubResult struct {
+	verified   int
+	repaired   []string
+	unrepaired []string
+}
+
+// scrubber verifies the objects in the object store against the hashes
+// recorded in their metadata, and repairs the objects that are corrupt or
+// missing from the object sources.
+type scrubber struct {
+	sources []ObjectSource
+
+	// rate is the rate, in bytes per second, at which the objects are read
+	// back. If it's zero, then the reads aren't limited.
+	rate int64
+
+	mu        sync.Mutex
+	lastScrub time.Time
+	last      scrubResult
+}
+
+// Report returns the outcome of the last scrub. The objects that couldn't be
+// repaired are flagged until a scrub finds them intact again.
+func (s *scrubber) Report() map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastScrub.IsZero() {
+		return map[string]any{}
+	}
+	report := map[string]any{
+		"last-scrub":      s.lastScrub.Format(time.RFC3339),
+		"verified":        s.last.verified,
+		"repaired":        len(s.last.repaired),
+		"corrupt":         len(s.last.unrepaired),
+		"corrupt-objects": s.last.unrepaired,
+	}
+	return report
+}
+
+func (s *scrubber) record(now time.Time, result scrubResult) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastScrub = now
+	s.last = result
+}
+
+// scrubLoop periodically scrubs the object store until the object store is
+// killed. It runs in its own goroutine, so that a scrub never blocks the
+// requests to the object store. The objects are only locked while they're
+// repaired.
+func (w *baseObjectStore) scrubLoop(s *scrubber, open scrubOpenFunc, repair scrubRepairFunc) func() error {
+	return func() error {
+		ctx, cancel := w.scopedContext()
+		defer cancel()
+
+		timer := w.clock.NewTimer(jitter(defaultScrubInterval))
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-w.tomb.Dying():
+				return tomb.ErrDying
+
+			case <-timer.Chan():
+				if err := w.scrub(ctx, s, open, repair); err != nil {
+					w.logger.Errorf(ctx, "scrub: %v", err)
+				}
+				timer.Reset(defaultScrubInterval)
+			}
+		}
+	}
+}
+
+// scrub reads back every object in the object store, verifying the size and
+// hashes against the metadata. Objects that are corrupt or missing are
+// repaired from the first object source that has a verified copy.
+func (w *baseObjectStore) scrub(ctx context.Context, s *scrubber, open scrubOpenFunc, repair scrubRepairFunc) error {
+	w.logger.Debugf(ctx, "scrubbing objects")
+
+	metadata, err := w.metadataService.ListMetadata(ctx)
+	if err != nil {
+		return errors.Errorf("listing metadata: %w", err)
+	}
+
+	// Only the first read of each object is limited. The object is read
+	// again whilst it's locked for a repair, and that shouldn't be held up.
+	limitedOpen := open
+	if s.rate > 0 {
+		bucket := ratelimit.NewBucketWithRate(float64(s.rate), s.rate)
+		limitedOpen = func(ctx context.Context, hash string) (io.ReadCloser, error) {
+			reader, err := open(ctx, hash)
+			if err != nil {
+				return nil, err
+			}
+			return &limitedReader{
+				ctx:    ctx,
+				reader: reader,
+				bucket: bucket,
+				clock:  w.clock,
+			}, nil
+		}
+	}
+
+	var result scrubResult
+	scrubbed := make(map[string]struct{})
+	for _, m := range metadata {
+		if err := ctx.Err(); err != nil {
+			return errors.Capture(err)
+		}
+
+		// Objects with the same contents are stored once, so only verify
+		// them once.
+		hash := selectFileHash(m)
+		if _, ok := scrubbed[hash]; ok {
+			continue
+		}
+		scrubbed[hash] = struct{}{}
+
+		problem, err := w.verifyStoredObject(ctx, m, limitedOpen)
+		if err != nil {
+			// We couldn't read the object, which doesn't mean it's corrupt.
+			w.logger.Infof(ctx, "failed to verify object %q: %v, will try again later", m.Path, err)
+			continue
+		} else if problem == "" {
+			result.verified++
+			continue
+		}
+
+		w.logger.Errorf(ctx, "object %q (%s) is corrupt: %s", m.Path, hash, problem)
+
+		if err := w.repairObject(ctx, m, s.sources, open, repair); errors.Is(err, errObjectRemoved) {
+			w.logger.Debugf(ctx, "object %q was removed during the scrub", m.Path)
+			continue
+		} else if err != nil {
+			w.logger.Errorf(ctx, "failed to repair object %q: %v", m.Path, err)
+			result.unrepaired = append(result.unrepaired, m.Path)
+			continue
+		}
+
+		w.logger.Infof(ctx, "repaired object %q", m.Path)
+		result.repaired = append(result.repaired, m.Path)
+	}
+
+	s.record(w.clock.Now(), result)
+	return nil
+}
+
+// repairObject replaces the stored copy of the object with the first verified
+// copy from the object sources.
+func (w *baseObjectStore) repairObject(
+	ctx context.Context,
+	metadata objectstore.Metadata,
+	sources []ObjectSource,
+	open scrubOpenFunc,
+	repair scrubRepairFunc,
+) error {
+	for _, source := range sources {
+		fileName, cleanup, err := w.fetchObject(ctx, source, metadata)
+		if errors.Is(err, objectstoreerrors.ObjectNotFound) {
+			continue
+		} else if err != nil {
+			w.logger.Debugf(ctx, "failed to fetch object %q from source: %v", metadata.Path, err)
+			continue
+		}
+
+		err = w.withLock(ctx, selectFileHash(metadata), func(ctx context.Context) error {
+			// The object may have been removed while it was fetched, in
+			// which case it mustn't be put back.
+			if _, err := w.metadataService.GetMetadataBySHA256(ctx, metadata.SHA256); errors.Is(err, domainobjectstoreerrors.ErrNotFound) {
+				return errObjectRemoved
+			} else if err != nil {
+				return errors.Capture(err)
+			}
+
+			// The object may have been replaced while it was fetched.
+			if problem, err := w.verifyStoredObject(ctx, metadata, open); err == nil && problem == "" {
+				return nil
+			}
+			return repair(ctx, metadata, fileName)
+		})
+		_ = cleanup()
+		if errors.Is(err, errObjectRemoved) {
+			return errors.Capture(err)
+		} else if err != nil {
+			return errors.Errorf("replacing object: %w", err)
+		}
+		return nil
+	}
+	return errors.Errorf("no verified copy of the object found")
+}
+
+// fetchObject writes the contents of the object from the source to a
+// temporary file, and verifies them against the metadata.
+func (w *baseObjectStore) fetchObject(ctx context.Context, source ObjectSource, metadata objectstore.Metadata) (string, func() error, error) {
+	reader, size, err := source.GetObject(ctx, metadata)
+	if err != nil {
+		return "", nopCloser, errors.Capture(err)
+	}
+	defer reader.Close()
+
+	if size != metadata.Size {
+		return "", nopCloser, errors.Errorf("size mismatch: expected %d, got %d", metadata.Size, size)
+	}
+
+	fileName, cleanup, err := w.writeToTmpFile(w.path, reader, size)
+	if err != nil {
+		return "", nopCloser, errors.Capture(err)
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		_ = cleanup()
+		return "", nopCloser, errors.Capture(err)
+	}
+	defer file.Close()
+
+	if problem, err := verifyObject(file, metadata); err != nil {
+		_ = cleanup()
+		return "", nopCloser, errors.Capture(err)
+	} else if problem != "" {
+		_ = cleanup()
+		return "", nopCloser, errors.Errorf("source copy is corrupt: %s", problem)
+	}
+	return fileName, cleanup, nil
+}
+
+// verifyStoredObject verifies the stored copy of the object against the
+// metadata. It returns a description of the problem if the stored copy is
+// corrupt or missing.
+func (w *baseObjectStore) verifyStoredObject(ctx context.Context, metadata objectstore.Metadata, open scrubOpenFunc) (string, error) {
+	reader, err := open(ctx, selectFileHash(metadata))
+	if errors.Is(err, objectstoreerrors.ObjectNotFound) {
+		return "missing", nil
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+	defer reader.Close()
+
+	return verifyObject(reader, metadata)
+}
+
+// verifyObject reads the object in full, and returns a description of the
+// problem if the size or hashes don't match the metadata.
+func verifyObject(reader io.Reader, metadata objectstore.Metadata) (string, error) {
+	hash384 := sha512.New384()
+	hash256 := sha256.New()
+
+	size, err := io.Copy(io.MultiWriter(hash384, hash256), reader)
+	if err != nil {
+		return "", errors.Errorf("reading object: %w", err)
+	}
+
+	if size != metadata.Size {
+		return fmt.Sprintf("expected size %d, got %d", metadata.Size, size), nil
+	}
+	if encoded := hex.EncodeToString(hash384.Sum(nil)); encoded != metadata.SHA384 {
+		return fmt.Sprintf("expected SHA384 %q, got %q", metadata.SHA384, encoded), nil
+	}
+	if encoded := hex.EncodeToString(hash256.Sum(nil)); encoded != metadata.SHA256 {
+		return fmt.Sprintf("expected SHA256 %q, got %q", metadata.SHA256, encoded), nil
+	}
+	return "", nil
+}
+
+// limitedReader limits the rate at which the reader is read, using the
+// bucket. The wait for the bucket is abandoned if the context is cancelled.
+type limitedReader struct {
+	ctx    context.Context
+	reader io.ReadCloser
+	bucket *ratelimit.Bucket
+	clock  clock.Clock
+}
+
+// Read reads from the underlying reader, waiting until the bucket has
+// enough capacity for the bytes read.
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > int(r.bucket.Capacity()) {
+		p = p[:r.bucket.Capacity()]
+	}
+	n, err := r.reader.Read(p)
+	if n <= 0 {
+		return n, err
+	}
+	if wait := r.bucket.Take(int64(n)); wait > 0 {
+		select {
+		case <-r.ctx.Done():
+			return n, r.ctx.Err()
+		case <-r.clock.After(wait):
+		}
+	}
+	return n, err
+}
+
+// Close closes the underlying reader.
+func (r *limitedReader) Close() error {
+	return r.reader.Close()
+}
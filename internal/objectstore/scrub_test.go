@@ -0,0 +1,323 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/clock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+type scrubSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&scrubSuite{})
+
+func (s *scrubSuite) TestScrubVerified(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, blobBasePath(path), "foo", "some content")
+
+	store := s.newFileObjectStore(c, path)
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}, {
+		// Objects with the same contents are only verified once.
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "bar",
+		Size:   size,
+	}}, nil)
+
+	err := store.scrub(context.Background(), store.scrubber, store.openObject, store.replaceObject)
+	c.Assert(err, jc.ErrorIsNil)
+
+	report := store.Report()["scrub"].(map[string]any)
+	c.Check(report["verified"], gc.Equals, 1)
+	c.Check(report["repaired"], gc.Equals, 0)
+	c.Check(report["corrupt"], gc.Equals, 0)
+}
+
+func (s *scrubSuite) TestScrubRepairsCorruptObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, c.MkDir(), "foo", "some content")
+
+	// Corrupt the blob, without changing the size.
+	err := os.MkdirAll(blobBasePath(path), 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	err = os.WriteFile(filepath.Join(blobBasePath(path), hash384), []byte("some CONTENT"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.blobService.EXPECT().AddBlobReference(gomock.Any(), "inferi", objectstore.Blob{
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   size,
+	}).Return(nil)
+
+	store := s.newFileObjectStore(c, path, fakeObjectSource{
+		hash384: "some content",
+	})
+
+	metadata := objectstore.Metadata{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{metadata}, nil)
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(metadata, nil)
+
+	err = store.scrub(context.Background(), store.scrubber, store.openObject, store.replaceObject)
+	c.Assert(err, jc.ErrorIsNil)
+
+	content, err := os.ReadFile(filepath.Join(blobBasePath(path), hash384))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(content), gc.Equals, "some content")
+
+	report := store.Report()["scrub"].(map[string]any)
+	c.Check(report["verified"], gc.Equals, 0)
+	c.Check(report["repaired"], gc.Equals, 1)
+	c.Check(report["corrupt"], gc.Equals, 0)
+}
+
+func (s *scrubSuite) TestScrubRepairsMissingObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, c.MkDir(), "foo", "some content")
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+	s.blobService.EXPECT().AddBlobReference(gomock.Any(), "inferi", objectstore.Blob{
+		SHA384: hash384,
+		SHA256: hash256,
+		Size:   size,
+	}).Return(nil)
+
+	// The first source doesn't have the object, so the next one is used.
+	store := s.newFileObjectStore(c, path, fakeObjectSource{}, fakeObjectSource{
+		hash384: "some content",
+	})
+
+	metadata := objectstore.Metadata{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{metadata}, nil)
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(metadata, nil)
+
+	err := store.scrub(context.Background(), store.scrubber, store.openObject, store.replaceObject)
+	c.Assert(err, jc.ErrorIsNil)
+
+	content, err := os.ReadFile(filepath.Join(blobBasePath(path), hash384))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(content), gc.Equals, "some content")
+}
+
+func (s *scrubSuite) TestScrubSkipsRemovedObject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, c.MkDir(), "foo", "some content")
+
+	s.expectClaim(hash384, 1)
+	s.expectRelease(hash384, 1)
+
+	store := s.newFileObjectStore(c, path, fakeObjectSource{
+		hash384: "some content",
+	})
+
+	// The object is removed while the copy is fetched from the source, so
+	// it mustn't be put back.
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+	s.service.EXPECT().GetMetadataBySHA256(gomock.Any(), hash256).Return(objectstore.Metadata{}, domainobjectstoreerrors.ErrNotFound)
+
+	err := store.scrub(context.Background(), store.scrubber, store.openObject, store.replaceObject)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = os.Stat(filepath.Join(blobBasePath(path), hash384))
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+
+	report := store.Report()["scrub"].(map[string]any)
+	c.Check(report["repaired"], gc.Equals, 0)
+	c.Check(report["corrupt"], gc.Equals, 0)
+}
+
+func (s *scrubSuite) TestScrubCancelled(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, blobBasePath(path), "foo", "some content")
+
+	store := s.newFileObjectStore(c, path)
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := store.scrub(ctx, store.scrubber, store.openObject, store.replaceObject)
+	c.Assert(err, jc.ErrorIs, context.Canceled)
+	c.Check(store.Report()["scrub"], gc.DeepEquals, map[string]any{})
+}
+
+func (s *scrubSuite) TestScrubSourceCorrupt(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	size, hash384, hash256 := s.createFile(c, c.MkDir(), "foo", "some content")
+
+	// The copy from the source is verified before it's used, so the object
+	// is never locked.
+	store := s.newFileObjectStore(c, path, fakeObjectSource{
+		hash384: "some CONTENT",
+	})
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+
+	err := store.scrub(context.Background(), store.scrubber, store.openObject, store.replaceObject)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = os.Stat(filepath.Join(blobBasePath(path), hash384))
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+
+	report := store.Report()["scrub"].(map[string]any)
+	c.Check(report["repaired"], gc.Equals, 0)
+	c.Check(report["corrupt"], gc.Equals, 1)
+	c.Check(report["corrupt-objects"], jc.DeepEquals, []string{"foo"})
+}
+
+func (s *scrubSuite) TestScrubNoSources(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	path := c.MkDir()
+
+	err := os.MkdirAll(blobBasePath(path), 0755)
+	c.Assert(err, jc.ErrorIsNil)
+
+	size, hash384, hash256 := s.createFile(c, c.MkDir(), "foo", "some content")
+	err = os.WriteFile(filepath.Join(blobBasePath(path), hash384), []byte("other content"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	store := s.newFileObjectStore(c, path)
+
+	s.service.EXPECT().ListMetadata(gomock.Any()).Return([]objectstore.Metadata{{
+		SHA384: hash384,
+		SHA256: hash256,
+		Path:   "foo",
+		Size:   size,
+	}}, nil)
+
+	err = store.scrub(context.Background(), store.scrubber, store.openObject, store.replaceObject)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The corrupt object is left in place, so it can be inspected.
+	content, err := os.ReadFile(filepath.Join(blobBasePath(path), hash384))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(content), gc.Equals, "other content")
+
+	report := store.Report()["scrub"].(map[string]any)
+	c.Check(report["corrupt"], gc.Equals, 1)
+	c.Check(report["corrupt-objects"], jc.DeepEquals, []string{"foo"})
+}
+
+func (s *scrubSuite) TestReportNoScrub(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	store := s.newFileObjectStore(c, c.MkDir())
+
+	c.Check(store.Report(), gc.DeepEquals, map[string]any{
+		"scrub": map[string]any{},
+	})
+}
+
+// newFileObjectStore returns a file object store without starting its loop,
+// so that the scrub can be called directly without racing the loop.
+func (s *scrubSuite) newFileObjectStore(c *gc.C, path string, sources ...ObjectSource) *fileObjectStore {
+	namespacePath := basePath(path, "inferi")
+	blobPath := blobBasePath(path)
+
+	store := &fileObjectStore{
+		baseObjectStore: baseObjectStore{
+			path:            namespacePath,
+			claimer:         s.claimer,
+			metadataService: s.service,
+			logger:          loggertesting.WrapCheckLog(c),
+			clock:           clock.WallClock,
+		},
+		fs:                  os.DirFS(namespacePath),
+		blobFS:              os.DirFS(blobPath),
+		blobPath:            blobPath,
+		blobMetadataService: s.blobService,
+		namespace:           "inferi",
+		scrubber: &scrubber{
+			sources: sources,
+			rate:    defaultScrubRate,
+		},
+	}
+
+	err := store.ensureDirectories()
+	c.Assert(err, jc.ErrorIsNil)
+	err = os.MkdirAll(blobPath, 0755)
+	c.Assert(err, jc.ErrorIsNil)
+
+	return store
+}
+
+// fakeObjectSource is an object source with the contents of the objects
+// keyed by their SHA384 hash.
+type fakeObjectSource map[string]string
+
+func (s fakeObjectSource) GetObject(_ context.Context, metadata objectstore.Metadata) (io.ReadCloser, int64, error) {
+	content, ok := s[metadata.SHA384]
+	if !ok {
+		return nil, -1, objectstoreerrors.ObjectNotFound
+	}
+	return io.NopCloser(strings.NewReader(content)), int64(len(content)), nil
+}
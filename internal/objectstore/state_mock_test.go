@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/objectstore (interfaces: Claimer,ClaimExtender,HashFileSystemAccessor)
+// Source: github.com/juju/juju/internal/objectstore (interfaces: Claimer,ClaimExtender,HashFileSystemAccessor,BlobCollectorMetadata)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package objectstore -destination state_mock_test.go github.com/juju/juju/internal/objectstore Claimer,ClaimExtender,HashFileSystemAccessor
+//	mockgen -typed -package objectstore -destination state_mock_test.go github.com/juju/juju/internal/objectstore Claimer,ClaimExtender,HashFileSystemAccessor,BlobCollectorMetadata
 //
 
 // Package objectstore is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	objectstore "github.com/juju/juju/core/objectstore"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -355,3 +356,142 @@ func (c *MockHashFileSystemAccessorHashExistsCall) DoAndReturn(f func(context.Co
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockBlobCollectorMetadata is a mock of BlobCollectorMetadata interface.
+type MockBlobCollectorMetadata struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobCollectorMetadataMockRecorder
+}
+
+// MockBlobCollectorMetadataMockRecorder is the mock recorder for MockBlobCollectorMetadata.
+type MockBlobCollectorMetadataMockRecorder struct {
+	mock *MockBlobCollectorMetadata
+}
+
+// NewMockBlobCollectorMetadata creates a new mock instance.
+func NewMockBlobCollectorMetadata(ctrl *gomock.Controller) *MockBlobCollectorMetadata {
+	mock := &MockBlobCollectorMetadata{ctrl: ctrl}
+	mock.recorder = &MockBlobCollectorMetadataMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobCollectorMetadata) EXPECT() *MockBlobCollectorMetadataMockRecorder {
+	return m.recorder
+}
+
+// ListBlobs mocks base method.
+func (m *MockBlobCollectorMetadata) ListBlobs(arg0 context.Context) ([]objectstore.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlobs", arg0)
+	ret0, _ := ret[0].([]objectstore.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlobs indicates an expected call of ListBlobs.
+func (mr *MockBlobCollectorMetadataMockRecorder) ListBlobs(arg0 any) *MockBlobCollectorMetadataListBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobs", reflect.TypeOf((*MockBlobCollectorMetadata)(nil).ListBlobs), arg0)
+	return &MockBlobCollectorMetadataListBlobsCall{Call: call}
+}
+
+// MockBlobCollectorMetadataListBlobsCall wrap *gomock.Call
+type MockBlobCollectorMetadataListBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobCollectorMetadataListBlobsCall) Return(arg0 []objectstore.Blob, arg1 error) *MockBlobCollectorMetadataListBlobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobCollectorMetadataListBlobsCall) Do(f func(context.Context) ([]objectstore.Blob, error)) *MockBlobCollectorMetadataListBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobCollectorMetadataListBlobsCall) DoAndReturn(f func(context.Context) ([]objectstore.Blob, error)) *MockBlobCollectorMetadataListBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveBlob mocks base method.
+func (m *MockBlobCollectorMetadata) RemoveBlob(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlob indicates an expected call of RemoveBlob.
+func (mr *MockBlobCollectorMetadataMockRecorder) RemoveBlob(arg0, arg1 any) *MockBlobCollectorMetadataRemoveBlobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlob", reflect.TypeOf((*MockBlobCollectorMetadata)(nil).RemoveBlob), arg0, arg1)
+	return &MockBlobCollectorMetadataRemoveBlobCall{Call: call}
+}
+
+// MockBlobCollectorMetadataRemoveBlobCall wrap *gomock.Call
+type MockBlobCollectorMetadataRemoveBlobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobCollectorMetadataRemoveBlobCall) Return(arg0 error) *MockBlobCollectorMetadataRemoveBlobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobCollectorMetadataRemoveBlobCall) Do(f func(context.Context, string) error) *MockBlobCollectorMetadataRemoveBlobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobCollectorMetadataRemoveBlobCall) DoAndReturn(f func(context.Context, string) error) *MockBlobCollectorMetadataRemoveBlobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveOrphanedBlobReferences mocks base method.
+func (m *MockBlobCollectorMetadata) RemoveOrphanedBlobReferences(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrphanedBlobReferences", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrphanedBlobReferences indicates an expected call of RemoveOrphanedBlobReferences.
+func (mr *MockBlobCollectorMetadataMockRecorder) RemoveOrphanedBlobReferences(arg0 any) *MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrphanedBlobReferences", reflect.TypeOf((*MockBlobCollectorMetadata)(nil).RemoveOrphanedBlobReferences), arg0)
+	return &MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall{Call: call}
+}
+
+// MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall wrap *gomock.Call
+type MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall) Return(arg0 int64, arg1 error) *MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall) Do(f func(context.Context) (int64, error)) *MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall) DoAndReturn(f func(context.Context) (int64, error)) *MockBlobCollectorMetadataRemoveOrphanedBlobReferencesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
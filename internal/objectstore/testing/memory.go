@@ -90,6 +90,12 @@ func (s *objectStore) PutMetadata(ctx context.Context, metadata coreobjectstore.
 	return s.store.put(metadata)
 }
 
+// PutMetadataWithinQuota implements objectstore.ObjectStoreMetadata. The
+// quota isn't enforced.
+func (s *objectStore) PutMetadataWithinQuota(ctx context.Context, metadata coreobjectstore.Metadata, _ int64) (coreobjectstore.UUID, error) {
+	return s.PutMetadata(ctx, metadata)
+}
+
 // RemoveMetadata implements objectstore.ObjectStoreMetadata.
 func (s *objectStore) RemoveMetadata(ctx context.Context, path string) error {
 	if path == "" {
@@ -265,3 +271,45 @@ func (m memoryExtender) Duration() time.Duration {
 func (m memoryExtender) Extend(ctx context.Context) error {
 	return m.fn()
 }
+
+// MemoryBlobMetadataService is an in-memory implementation of the objectstore
+// BlobMetadata interface.
+func MemoryBlobMetadataService() coreobjectstore.BlobMetadata {
+	return &blobMetadataService{
+		references: make(map[string]map[string]struct{}),
+	}
+}
+
+type blobMetadataService struct {
+	mutex      sync.Mutex
+	references map[string]map[string]struct{}
+}
+
+// AddBlobReference implements coreobjectstore.BlobMetadata.
+func (m *blobMetadataService) AddBlobReference(ctx context.Context, namespace string, blob coreobjectstore.Blob) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	namespaces, ok := m.references[blob.SHA384]
+	if !ok {
+		namespaces = make(map[string]struct{})
+		m.references[blob.SHA384] = namespaces
+	}
+	namespaces[namespace] = struct{}{}
+	return nil
+}
+
+// AddBlobReferenceWithinQuota implements coreobjectstore.BlobMetadata. The
+// quota isn't enforced.
+func (m *blobMetadataService) AddBlobReferenceWithinQuota(ctx context.Context, namespace string, blob coreobjectstore.Blob, _ int64) error {
+	return m.AddBlobReference(ctx, namespace, blob)
+}
+
+// RemoveBlobReference implements coreobjectstore.BlobMetadata.
+func (m *blobMetadataService) RemoveBlobReference(ctx context.Context, namespace, sha384 string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	delete(m.references[sha384], namespace)
+	return nil
+}
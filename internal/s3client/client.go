@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -22,6 +23,7 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/objectstore"
 )
 
 // HTTPClient represents the http client used to access the object store.
@@ -229,6 +231,307 @@ func (c *S3Client) PutObject(ctx context.Context, bucketName, objectName string,
 	return nil
 }
 
+const (
+	// maxCopyObjectSize is the largest object that can be copied in a single
+	// request. Larger objects are copied in parts.
+	maxCopyObjectSize = 5 * 1024 * 1024 * 1024
+
+	// copyPartSize is the size of the parts that large objects are copied
+	// in.
+	copyPartSize = 512 * 1024 * 1024
+)
+
+// CreateMultipartUpload starts a multipart upload of an object based on the
+// bucket name and object name, returning the ID of the upload.
+func (c *S3Client) CreateMultipartUpload(ctx context.Context, bucketName, objectName string) (string, error) {
+	c.logger.Tracef(ctx, "creating multipart upload of bucket %s object %s to s3 storage", bucketName, objectName)
+
+	upload, err := c.client.CreateMultipartUpload(ctx,
+		&s3.CreateMultipartUploadInput{
+			Bucket:            aws.String(bucketName),
+			Key:               aws.String(objectName),
+			ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+
+			// Prevent the object from being deleted for 10 years, in the
+			// same way as objects that are put in one request.
+			ObjectLockMode:            types.ObjectLockModeGovernance,
+			ObjectLockLegalHoldStatus: types.ObjectLockLegalHoldStatusOn,
+			ObjectLockRetainUntilDate: aws.Time(time.Now().Add(retentionLockDate)),
+		})
+	if err != nil {
+		if err := handleError(err); err != nil {
+			return "", errors.Trace(err)
+		}
+		return "", errors.Annotatef(err, "creating multipart upload of object %s on bucket %s using S3 client", objectName, bucketName)
+	}
+	if upload.UploadId == nil {
+		return "", errors.Errorf("creating multipart upload of object %s on bucket %s: missing upload id", objectName, bucketName)
+	}
+	return *upload.UploadId, nil
+}
+
+// UploadPart uploads a part of the object for the multipart upload. The hash
+// is the base64 encoded SHA256 of the part, which is verified by the object
+// store.
+func (c *S3Client) UploadPart(ctx context.Context, bucketName, objectName, uploadID string, partNumber int32, body io.ReadSeeker, hash string) (objectstore.CompletedPart, error) {
+	c.logger.Tracef(ctx, "uploading part %d of bucket %s object %s to s3 storage", partNumber, bucketName, objectName)
+
+	part, err := c.client.UploadPart(ctx,
+		&s3.UploadPartInput{
+			Bucket:            aws.String(bucketName),
+			Key:               aws.String(objectName),
+			UploadId:          aws.String(uploadID),
+			PartNumber:        aws.Int32(partNumber),
+			Body:              body,
+			ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+			ChecksumSHA256:    aws.String(hash),
+		})
+	if err != nil {
+		if err := handleError(err); err != nil {
+			return objectstore.CompletedPart{}, errors.Trace(err)
+		}
+		return objectstore.CompletedPart{}, errors.Annotatef(err, "uploading part %d of object %s on bucket %s using S3 client", partNumber, objectName, bucketName)
+	}
+	if part.ChecksumSHA256 != nil && hash != *part.ChecksumSHA256 {
+		return objectstore.CompletedPart{}, errors.Errorf("hash mismatch for part %d, expected %q got %q", partNumber, hash, *part.ChecksumSHA256)
+	}
+	return objectstore.CompletedPart{
+		PartNumber: partNumber,
+		ETag:       aws.ToString(part.ETag),
+		Hash:       hash,
+	}, nil
+}
+
+// CompleteMultipartUpload assembles the uploaded parts into the object.
+func (c *S3Client) CompleteMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string, parts []objectstore.CompletedPart) error {
+	c.logger.Tracef(ctx, "completing multipart upload of bucket %s object %s to s3 storage", bucketName, objectName)
+
+	completed := make([]types.CompletedPart, len(parts))
+	for i, part := range parts {
+		completed[i] = types.CompletedPart{
+			PartNumber: aws.Int32(part.PartNumber),
+			ETag:       aws.String(part.ETag),
+		}
+		if part.Hash != "" {
+			completed[i].ChecksumSHA256 = aws.String(part.Hash)
+		}
+	}
+
+	_, err := c.client.CompleteMultipartUpload(ctx,
+		&s3.CompleteMultipartUploadInput{
+			Bucket:   aws.String(bucketName),
+			Key:      aws.String(objectName),
+			UploadId: aws.String(uploadID),
+			MultipartUpload: &types.CompletedMultipartUpload{
+				Parts: completed,
+			},
+		})
+	if err != nil {
+		if err := handleError(err); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Annotatef(err, "completing multipart upload of object %s on bucket %s using S3 client", objectName, bucketName)
+	}
+	return nil
+}
+
+// AbortMultipartUpload aborts the multipart upload, removing any parts that
+// have been uploaded.
+func (c *S3Client) AbortMultipartUpload(ctx context.Context, bucketName, objectName, uploadID string) error {
+	c.logger.Tracef(ctx, "aborting multipart upload of bucket %s object %s to s3 storage", bucketName, objectName)
+
+	_, err := c.client.AbortMultipartUpload(ctx,
+		&s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucketName),
+			Key:      aws.String(objectName),
+			UploadId: aws.String(uploadID),
+		})
+	if err != nil {
+		if err := handleError(err); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Annotatef(err, "aborting multipart upload of object %s on bucket %s using S3 client", objectName, bucketName)
+	}
+	return nil
+}
+
+// ListMultipartUploads returns the multipart uploads in progress for the
+// objects with the given prefix.
+func (c *S3Client) ListMultipartUploads(ctx context.Context, bucketName, prefix string) ([]objectstore.MultipartUpload, error) {
+	c.logger.Tracef(ctx, "listing multipart uploads in bucket %s from s3 storage", bucketName)
+
+	var (
+		uploads        []objectstore.MultipartUpload
+		keyMarker      *string
+		uploadIDMarker *string
+	)
+	for {
+		result, err := c.client.ListMultipartUploads(ctx,
+			&s3.ListMultipartUploadsInput{
+				Bucket:         aws.String(bucketName),
+				Prefix:         aws.String(prefix),
+				KeyMarker:      keyMarker,
+				UploadIdMarker: uploadIDMarker,
+			})
+		if err != nil {
+			if err := handleError(err); err != nil {
+				return nil, errors.Trace(err)
+			}
+			return nil, errors.Annotatef(err, "listing multipart uploads on bucket %s using S3 client", bucketName)
+		}
+
+		for _, upload := range result.Uploads {
+			if upload.Key == nil || upload.UploadId == nil {
+				continue
+			}
+			uploads = append(uploads, objectstore.MultipartUpload{
+				ObjectName: *upload.Key,
+				UploadID:   *upload.UploadId,
+				Initiated:  aws.ToTime(upload.Initiated),
+			})
+		}
+
+		if !aws.ToBool(result.IsTruncated) {
+			return uploads, nil
+		}
+		keyMarker, uploadIDMarker = result.NextKeyMarker, result.NextUploadIdMarker
+	}
+}
+
+// ListParts returns the parts that have been uploaded for the multipart
+// upload, ordered by their part number.
+func (c *S3Client) ListParts(ctx context.Context, bucketName, objectName, uploadID string) ([]objectstore.CompletedPart, error) {
+	c.logger.Tracef(ctx, "listing parts of multipart upload of bucket %s object %s from s3 storage", bucketName, objectName)
+
+	var (
+		parts  []objectstore.CompletedPart
+		marker *string
+	)
+	for {
+		result, err := c.client.ListParts(ctx,
+			&s3.ListPartsInput{
+				Bucket:           aws.String(bucketName),
+				Key:              aws.String(objectName),
+				UploadId:         aws.String(uploadID),
+				PartNumberMarker: marker,
+			})
+		if err != nil {
+			if err := handleError(err); err != nil {
+				return nil, errors.Trace(err)
+			}
+			return nil, errors.Annotatef(err, "listing parts of multipart upload of object %s on bucket %s using S3 client", objectName, bucketName)
+		}
+
+		for _, part := range result.Parts {
+			if part.PartNumber == nil {
+				continue
+			}
+			parts = append(parts, objectstore.CompletedPart{
+				PartNumber: *part.PartNumber,
+				ETag:       aws.ToString(part.ETag),
+				Hash:       aws.ToString(part.ChecksumSHA256),
+			})
+		}
+
+		if !aws.ToBool(result.IsTruncated) {
+			return parts, nil
+		}
+		marker = result.NextPartNumberMarker
+	}
+}
+
+// CopyObject copies an object to another object in the same bucket, without
+// downloading it. Objects that are too large to be copied in a single request
+// are copied in parts.
+func (c *S3Client) CopyObject(ctx context.Context, bucketName, srcObjectName, dstObjectName string) error {
+	c.logger.Tracef(ctx, "copying bucket %s object %s to object %s in s3 storage", bucketName, srcObjectName, dstObjectName)
+
+	head, err := c.client.HeadObject(ctx,
+		&s3.HeadObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(srcObjectName),
+		})
+	if err != nil {
+		if err := handleError(err); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Annotatef(err, "getting size of object %s on bucket %s using S3 client", srcObjectName, bucketName)
+	}
+
+	size := aws.ToInt64(head.ContentLength)
+	if size > maxCopyObjectSize {
+		return c.copyObjectInParts(ctx, bucketName, srcObjectName, dstObjectName, size)
+	}
+
+	_, err = c.client.CopyObject(ctx,
+		&s3.CopyObjectInput{
+			Bucket:            aws.String(bucketName),
+			Key:               aws.String(dstObjectName),
+			CopySource:        aws.String(copySource(bucketName, srcObjectName)),
+			ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+
+			ObjectLockMode:            types.ObjectLockModeGovernance,
+			ObjectLockLegalHoldStatus: types.ObjectLockLegalHoldStatusOn,
+			ObjectLockRetainUntilDate: aws.Time(time.Now().Add(retentionLockDate)),
+		})
+	if err != nil {
+		if err := handleError(err); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Annotatef(err, "copying object %s to %s on bucket %s using S3 client", srcObjectName, dstObjectName, bucketName)
+	}
+	return nil
+}
+
+func (c *S3Client) copyObjectInParts(ctx context.Context, bucketName, srcObjectName, dstObjectName string, size int64) (err error) {
+	uploadID, err := c.CreateMultipartUpload(ctx, bucketName, dstObjectName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = c.AbortMultipartUpload(ctx, bucketName, dstObjectName, uploadID)
+		}
+	}()
+
+	var parts []objectstore.CompletedPart
+	for offset, partNumber := int64(0), int32(1); offset < size; offset, partNumber = offset+copyPartSize, partNumber+1 {
+		end := min(offset+copyPartSize, size) - 1
+
+		result, err := c.client.UploadPartCopy(ctx,
+			&s3.UploadPartCopyInput{
+				Bucket:          aws.String(bucketName),
+				Key:             aws.String(dstObjectName),
+				UploadId:        aws.String(uploadID),
+				PartNumber:      aws.Int32(partNumber),
+				CopySource:      aws.String(copySource(bucketName, srcObjectName)),
+				CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", offset, end)),
+			})
+		if err != nil {
+			if err := handleError(err); err != nil {
+				return errors.Trace(err)
+			}
+			return errors.Annotatef(err, "copying part %d of object %s on bucket %s using S3 client", partNumber, srcObjectName, bucketName)
+		}
+
+		part := objectstore.CompletedPart{
+			PartNumber: partNumber,
+		}
+		if result.CopyPartResult != nil {
+			part.ETag = aws.ToString(result.CopyPartResult.ETag)
+			part.Hash = aws.ToString(result.CopyPartResult.ChecksumSHA256)
+		}
+		parts = append(parts, part)
+	}
+
+	return errors.Trace(c.CompleteMultipartUpload(ctx, bucketName, dstObjectName, uploadID, parts))
+}
+
+// copySource returns the URL encoded source of a copy of an object.
+func copySource(bucketName, objectName string) string {
+	return url.PathEscape(bucketName + "/" + objectName)
+}
+
 // DeleteObject deletes an object from the object store based on the bucket name
 // and object name.
 func (c *S3Client) DeleteObject(ctx context.Context, bucketName, objectName string) error {
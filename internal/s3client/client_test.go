@@ -16,6 +16,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/objectstore"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
@@ -164,6 +165,136 @@ func (s *s3ClientSuite) TestCreateBucket(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *s3ClientSuite) TestCreateMultipartUpload(c *gc.C) {
+	url, httpClient, cleanup := s.setupServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, gc.Equals, http.MethodPost)
+		c.Check(r.URL.Path, gc.Equals, "/bucket/object")
+		c.Check(r.URL.Query().Has("uploads"), jc.IsTrue)
+
+		// The object lock is set when the upload is started.
+		c.Check(r.Header.Get("x-amz-object-lock-legal-hold"), gc.Equals, "ON")
+		c.Check(r.Header.Get("x-amz-object-lock-mode"), gc.Equals, "GOVERNANCE")
+
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<InitiateMultipartUploadResult>
+	<Bucket>bucket</Bucket>
+	<Key>object</Key>
+	<UploadId>upload-id</UploadId>
+</InitiateMultipartUploadResult>`))
+	})
+	defer cleanup()
+
+	client, err := NewS3Client(url, httpClient, AnonymousCredentials{}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	uploadID, err := client.CreateMultipartUpload(context.Background(), "bucket", "object")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(uploadID, gc.Equals, "upload-id")
+}
+
+func (s *s3ClientSuite) TestUploadPart(c *gc.C) {
+	hash := "+iyMxPKBdrvu1Lc231aaNMec03I+nsQvlnS01GrGuLg="
+
+	url, httpClient, cleanup := s.setupServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, gc.Equals, http.MethodPut)
+		c.Check(r.URL.Path, gc.Equals, "/bucket/object")
+		c.Check(r.URL.Query().Get("uploadId"), gc.Equals, "upload-id")
+		c.Check(r.URL.Query().Get("partNumber"), gc.Equals, "2")
+		c.Check(r.Header.Get("x-amz-checksum-sha256"), gc.Equals, hash)
+
+		body, err := io.ReadAll(r.Body)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(string(body), jc.Contains, "blob")
+
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("x-amz-checksum-sha256", hash)
+	})
+	defer cleanup()
+
+	client, err := NewS3Client(url, httpClient, AnonymousCredentials{}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	part, err := client.UploadPart(context.Background(), "bucket", "object", "upload-id", 2, strings.NewReader("blob"), hash)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(part, gc.DeepEquals, objectstore.CompletedPart{
+		PartNumber: 2,
+		ETag:       `"etag"`,
+		Hash:       hash,
+	})
+}
+
+func (s *s3ClientSuite) TestCompleteMultipartUpload(c *gc.C) {
+	url, httpClient, cleanup := s.setupServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, gc.Equals, http.MethodPost)
+		c.Check(r.URL.Path, gc.Equals, "/bucket/object")
+		c.Check(r.URL.Query().Get("uploadId"), gc.Equals, "upload-id")
+
+		body, err := io.ReadAll(r.Body)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(string(body), jc.Contains, "<PartNumber>1</PartNumber>")
+		c.Check(string(body), jc.Contains, "<PartNumber>2</PartNumber>")
+
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<CompleteMultipartUploadResult>
+	<Bucket>bucket</Bucket>
+	<Key>object</Key>
+	<ETag>"etag"</ETag>
+</CompleteMultipartUploadResult>`))
+	})
+	defer cleanup()
+
+	client, err := NewS3Client(url, httpClient, AnonymousCredentials{}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = client.CompleteMultipartUpload(context.Background(), "bucket", "object", "upload-id", []objectstore.CompletedPart{
+		{PartNumber: 1, ETag: `"etag1"`},
+		{PartNumber: 2, ETag: `"etag2"`},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *s3ClientSuite) TestAbortMultipartUpload(c *gc.C) {
+	url, httpClient, cleanup := s.setupServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, gc.Equals, http.MethodDelete)
+		c.Check(r.URL.Path, gc.Equals, "/bucket/object")
+		c.Check(r.URL.Query().Get("uploadId"), gc.Equals, "upload-id")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer cleanup()
+
+	client, err := NewS3Client(url, httpClient, AnonymousCredentials{}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = client.AbortMultipartUpload(context.Background(), "bucket", "object", "upload-id")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *s3ClientSuite) TestCopyObject(c *gc.C) {
+	url, httpClient, cleanup := s.setupServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodHead:
+			c.Check(r.URL.Path, gc.Equals, "/bucket/src")
+			w.Header().Set("Content-Length", "4")
+		case http.MethodPut:
+			c.Check(r.URL.Path, gc.Equals, "/bucket/dst")
+			c.Check(r.Header.Get("x-amz-copy-source"), gc.Equals, "bucket%2Fsrc")
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<CopyObjectResult>
+	<ETag>"etag"</ETag>
+</CopyObjectResult>`))
+		default:
+			c.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer cleanup()
+
+	client, err := NewS3Client(url, httpClient, AnonymousCredentials{}, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = client.CopyObject(context.Background(), "bucket", "src", "dst")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *s3ClientSuite) setupServer(c *gc.C, handler http.HandlerFunc) (string, HTTPClient, func()) {
 	server := httptest.NewTLSServer(handler)
 	return server.URL, server.Client(), func() {
@@ -0,0 +1,151 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package envelope provides the primitives used for envelope encryption of
+// secret content, where content is encrypted with a data key which is in
+// turn encrypted (wrapped) with a key-encryption key.
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/juju/errors"
+)
+
+// KeySize is the size in bytes of both key-encryption keys and data
+// keys, selecting AES-256.
+const KeySize = 32
+
+// NewKey returns a new random key.
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return key, nil
+}
+
+// KeyID returns an identifier for the key which does not reveal it,
+// used to record which key-encryption key wrapped a data key.
+func KeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Seal encrypts plaintext with AES-GCM, prefixing the result with a random
+// nonce. The additional data is authenticated but not encrypted, and must
+// be supplied unchanged to Open.
+func Seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// Open decrypts and authenticates the result of Seal.
+func Open(key, sealed, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// KeyRing holds the key-encryption keys used to wrap data keys. The first
+// key is the active key, which wraps new data keys; the others are previous
+// keys, kept so that data keys they wrapped can be unwrapped until they are
+// re-wrapped with the active key.
+type KeyRing struct {
+	keys [][]byte
+}
+
+// NewKeyRing returns a key ring holding the keys, the first of which is
+// the active key.
+func NewKeyRing(keys ...[]byte) (KeyRing, error) {
+	if len(keys) == 0 {
+		return KeyRing{}, errors.NotValidf("empty key ring")
+	}
+	for _, key := range keys {
+		if len(key) != KeySize {
+			return KeyRing{}, errors.NotValidf("key of %d bytes", len(key))
+		}
+	}
+	return KeyRing{keys: keys}, nil
+}
+
+// GenerateKeyRing returns a key ring holding a single new random key.
+func GenerateKeyRing() (KeyRing, error) {
+	key, err := NewKey()
+	if err != nil {
+		return KeyRing{}, errors.Trace(err)
+	}
+	return NewKeyRing(key)
+}
+
+// ParseKeyRing returns a key ring holding the base64 encoded keys, the
+// first of which is the active key.
+func ParseKeyRing(encoded []string) (KeyRing, error) {
+	keys := make([][]byte, len(encoded))
+	for i, s := range encoded {
+		key, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return KeyRing{}, errors.Annotatef(err, "decoding key %d", i)
+		}
+		keys[i] = key
+	}
+	return NewKeyRing(keys...)
+}
+
+// Encode returns the base64 encoded keys, as accepted by ParseKeyRing.
+func (r KeyRing) Encode() []string {
+	result := make([]string, len(r.keys))
+	for i, key := range r.keys {
+		result[i] = base64.StdEncoding.EncodeToString(key)
+	}
+	return result
+}
+
+// IsZero reports whether the key ring holds no keys.
+func (r KeyRing) IsZero() bool {
+	return len(r.keys) == 0
+}
+
+// Active returns the key used to wrap new data keys.
+func (r KeyRing) Active() []byte {
+	if r.IsZero() {
+		return nil
+	}
+	return r.keys[0]
+}
+
+// Key returns the key with the specified id, as returned by KeyID.
+func (r KeyRing) Key(id string) ([]byte, bool) {
+	for _, key := range r.keys {
+		if KeyID(key) == id {
+			return key, true
+		}
+	}
+	return nil, false
+}
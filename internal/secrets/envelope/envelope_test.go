@@ -0,0 +1,109 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package envelope
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type envelopeSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&envelopeSuite{})
+
+func (s *envelopeSuite) TestSealOpen(c *gc.C) {
+	key, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(key, gc.HasLen, KeySize)
+
+	sealed, err := Seal(key, []byte("hello"), []byte("rev-1"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(sealed), gc.Not(jc.Contains), "hello")
+
+	plaintext, err := Open(key, sealed, []byte("rev-1"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(plaintext), gc.Equals, "hello")
+}
+
+func (s *envelopeSuite) TestSealUsesNewNonce(c *gc.C) {
+	key, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+
+	sealed1, err := Seal(key, []byte("hello"), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	sealed2, err := Seal(key, []byte("hello"), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sealed1, gc.Not(jc.DeepEquals), sealed2)
+}
+
+func (s *envelopeSuite) TestOpenWrongAdditionalData(c *gc.C) {
+	key, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	sealed, err := Seal(key, []byte("hello"), []byte("rev-1"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = Open(key, sealed, []byte("rev-2"))
+	c.Assert(err, gc.ErrorMatches, "cipher: message authentication failed")
+}
+
+func (s *envelopeSuite) TestOpenWrongKey(c *gc.C) {
+	key, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	sealed, err := Seal(key, []byte("hello"), nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	other, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = Open(other, sealed, nil)
+	c.Assert(err, gc.ErrorMatches, "cipher: message authentication failed")
+}
+
+func (s *envelopeSuite) TestOpenTooShort(c *gc.C) {
+	key, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = Open(key, []byte("short"), nil)
+	c.Assert(err, gc.ErrorMatches, "ciphertext too short")
+}
+
+func (s *envelopeSuite) TestKeyID(c *gc.C) {
+	key, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(KeyID(key), gc.HasLen, 16)
+	c.Assert(KeyID(key), gc.Equals, KeyID(key))
+
+	other, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(KeyID(key), gc.Not(gc.Equals), KeyID(other))
+}
+
+func (s *envelopeSuite) TestKeyRing(c *gc.C) {
+	_, err := NewKeyRing()
+	c.Assert(err, gc.ErrorMatches, "empty key ring not valid")
+	_, err = NewKeyRing([]byte("short"))
+	c.Assert(err, gc.ErrorMatches, "key of 5 bytes not valid")
+
+	key, err := NewKey()
+	c.Assert(err, jc.ErrorIsNil)
+	ring, err := NewKeyRing(key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ring.Active(), jc.DeepEquals, key)
+
+	parsed, err := ParseKeyRing(ring.Encode())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(parsed, jc.DeepEquals, ring)
+}
+
+func (s *envelopeSuite) TestGenerateKeyRing(c *gc.C) {
+	ring, err := GenerateKeyRing()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ring.Encode(), gc.HasLen, 1)
+	c.Assert(ring.Active(), gc.HasLen, KeySize)
+
+	other, err := GenerateKeyRing()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(other.Active(), gc.Not(jc.DeepEquals), ring.Active())
+}
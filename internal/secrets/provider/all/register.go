@@ -5,6 +5,7 @@ package all
 
 import (
 	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/file"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/secrets/provider/vault"
@@ -14,4 +15,5 @@ func init() {
 	provider.Register(juju.NewProvider())
 	provider.Register(kubernetes.NewProvider())
 	provider.Register(vault.NewProvider())
+	provider.Register(file.NewProvider())
 }
@@ -0,0 +1,142 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+type fileBackend struct {
+	dir  string
+	keks []*kek
+}
+
+func (k fileBackend) revisionPath(revisionId string) (string, error) {
+	if revisionId == "" || strings.HasPrefix(revisionId, ".") || strings.ContainsAny(revisionId, `/\`) {
+		return "", errors.NotValidf("secret revision id %q", revisionId)
+	}
+	return filepath.Join(k.dir, revisionId), nil
+}
+
+// GetContent implements SecretsBackend.
+func (k fileBackend) GetContent(_ context.Context, revisionId string) (_ secrets.SecretValue, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	path, err := k.revisionPath(revisionId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	e, err := readEnvelope(path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "getting secret %q", revisionId)
+	}
+	content, err := e.openContent(k.keks, revisionId)
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting secret %q", revisionId)
+	}
+	val := make(map[string]string)
+	if err := json.Unmarshal(content, &val); err != nil {
+		return nil, errors.Annotatef(err, "parsing secret %q", revisionId)
+	}
+	return secrets.NewSecretValue(val), nil
+}
+
+// DeleteContent implements SecretsBackend.
+func (k fileBackend) DeleteContent(_ context.Context, revisionId string) (err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	path, err := k.revisionPath(revisionId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	}
+	return errors.Annotatef(err, "deleting secret %q", revisionId)
+}
+
+// SaveContent implements SecretsBackend.
+func (k fileBackend) SaveContent(_ context.Context, uri *secrets.URI, revision int, value secrets.SecretValue) (_ string, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	revisionId := uri.Name(revision)
+	path, err := k.revisionPath(revisionId)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	content, err := json.Marshal(value.EncodedValues())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	e, err := sealContent(k.keks[0], revisionId, content)
+	if err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	if err := os.MkdirAll(k.dir, 0700); err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	if err := writeEnvelope(path, e); err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	return revisionId, nil
+}
+
+// Ping implements SecretsBackend.
+func (k fileBackend) Ping() error {
+	info, err := os.Stat(k.dir)
+	if err != nil {
+		return errors.Annotate(err, "backend not reachable")
+	}
+	if !info.IsDir() {
+		return errors.Errorf("backend path %q is not a directory", k.dir)
+	}
+	return nil
+}
+
+// agentBackend is the backend client used by agents, which do not have
+// access to the backend. Agents send secret content to the controller,
+// which reads, writes and deletes the content in the backend for them.
+type agentBackend struct{}
+
+// GetContent implements SecretsBackend.
+func (agentBackend) GetContent(_ context.Context, revisionId string) (secrets.SecretValue, error) {
+	return nil, errors.NotSupportedf("reading secret %q outside the controller", revisionId)
+}
+
+// DeleteContent implements SecretsBackend.
+// The content is deleted by the controller when the
+// secret revision is removed, so there's nothing to do.
+func (agentBackend) DeleteContent(context.Context, string) error {
+	return nil
+}
+
+// SaveContent implements SecretsBackend.
+// Returning NotSupported means the content is sent to the controller.
+func (agentBackend) SaveContent(context.Context, *secrets.URI, int, secrets.SecretValue) (string, error) {
+	return "", errors.NotSupportedf("saving secret content outside the controller")
+}
+
+// Ping implements SecretsBackend.
+func (agentBackend) Ping() error {
+	return nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	coreconfig "github.com/juju/juju/core/config"
+	"github.com/juju/juju/internal/configschema"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+const (
+	PathKey        = "path"
+	KeyKey         = "key"
+	PreviousKeyKey = "previous-key"
+)
+
+var configSchema = configschema.Fields{
+	PathKey: {
+		Description: "The absolute path of the directory in which to store secrets.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+		Mandatory:   true,
+	},
+	KeyKey: {
+		Description: "The base64 encoded 256 bit key used to wrap secret data keys. Generated by the controller if not specified.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+		Secret:      true,
+	},
+	PreviousKeyKey: {
+		Description: "The key replaced by the most recent key rotation.",
+		Type:        configschema.Tstring,
+		Secret:      true,
+	},
+}
+
+var configDefaults = schema.Defaults{}
+
+type backendConfig struct {
+	validAttrs map[string]interface{}
+}
+
+func (c *backendConfig) path() string {
+	return c.validAttrs[PathKey].(string)
+}
+
+func (c *backendConfig) key() string {
+	v, _ := c.validAttrs[KeyKey].(string)
+	return v
+}
+
+func (c *backendConfig) previousKey() string {
+	v, _ := c.validAttrs[PreviousKeyKey].(string)
+	return v
+}
+
+// keks returns the key-encryption keys which may be used to unwrap data
+// keys, with the current key first.
+func (c *backendConfig) keks() ([]*kek, error) {
+	current, err := parseKEK(c.key())
+	if err != nil {
+		return nil, errors.Annotatef(err, "parsing %q", KeyKey)
+	}
+	result := []*kek{current}
+	if c.previousKey() == "" {
+		return result, nil
+	}
+	previous, err := parseKEK(c.previousKey())
+	if err != nil {
+		return nil, errors.Annotatef(err, "parsing %q", PreviousKeyKey)
+	}
+	return append(result, previous), nil
+}
+
+// ConfigSchema implements SecretBackendProvider.
+func (p fileProvider) ConfigSchema() configschema.Fields {
+	return configSchema
+}
+
+// ConfigDefaults implements SecretBackendProvider.
+// A new key is generated each time the defaults are requested so that
+// a backend added without a key gets one held by the controller.
+func (p fileProvider) ConfigDefaults() schema.Defaults {
+	k, err := newKEK()
+	if err != nil {
+		logger.Errorf(context.TODO(), "generating secret backend key: %v", err)
+		return schema.Defaults{}
+	}
+	return schema.Defaults{
+		KeyKey: k.String(),
+	}
+}
+
+// ValidateConfig implements SecretBackendProvider.
+func (p fileProvider) ValidateConfig(oldCfg, newCfg provider.ConfigAttrs, tokenRotateInterval *time.Duration) error {
+	newValidCfg, err := newConfig(newCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !filepath.IsAbs(newValidCfg.path()) {
+		return errors.NotValidf("relative path %q", newValidCfg.path())
+	}
+	if newValidCfg.key() == "" {
+		return errors.NotValidf("file config missing key")
+	}
+	if _, err := newValidCfg.keks(); err != nil {
+		return errors.NewNotValid(err, "file config")
+	}
+
+	if oldCfg == nil {
+		return nil
+	}
+	oldValidCfg, err := newConfig(oldCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for n, field := range configSchema {
+		if !field.Immutable {
+			continue
+		}
+		oldV := oldValidCfg.validAttrs[n]
+		newV := newValidCfg.validAttrs[n]
+		if oldV != newV {
+			return errors.Errorf("cannot change immutable field %q", n)
+		}
+	}
+	return nil
+}
+
+func newConfig(attrs map[string]interface{}) (*backendConfig, error) {
+	cfg, err := coreconfig.NewConfig(attrs, configSchema, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &backendConfig{cfg.Attributes()}, nil
+}
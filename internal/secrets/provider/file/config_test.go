@@ -0,0 +1,74 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+type configSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&configSuite{})
+
+const (
+	testKey      = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	otherTestKey = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+)
+
+func (s *configSuite) TestValidateConfig(c *gc.C) {
+	configValidator, ok := NewProvider().(provider.ProviderConfig)
+	c.Assert(ok, jc.IsTrue)
+	for _, t := range []struct {
+		cfg    map[string]interface{}
+		oldCfg map[string]interface{}
+		err    string
+	}{{
+		cfg: map[string]interface{}{},
+		err: "path: expected string, got nothing",
+	}, {
+		cfg: map[string]interface{}{"path": "secrets", "key": testKey},
+		err: `relative path "secrets" not valid`,
+	}, {
+		cfg: map[string]interface{}{"path": "/secrets"},
+		err: `file config missing key not valid`,
+	}, {
+		cfg: map[string]interface{}{"path": "/secrets", "key": "Zm9v"},
+		err: `file config: parsing "key": key length 3 bytes, expected 32 not valid`,
+	}, {
+		cfg: map[string]interface{}{"path": "/secrets", "key": testKey, "previous-key": "!"},
+		err: `file config: parsing "previous-key": key encoding not valid`,
+	}, {
+		cfg:    map[string]interface{}{"path": "/secrets", "key": testKey},
+		oldCfg: map[string]interface{}{"path": "/other", "key": testKey},
+		err:    `cannot change immutable field "path"`,
+	}, {
+		cfg:    map[string]interface{}{"path": "/secrets", "key": testKey},
+		oldCfg: map[string]interface{}{"path": "/secrets", "key": otherTestKey},
+		err:    `cannot change immutable field "key"`,
+	}} {
+		err := configValidator.ValidateConfig(t.oldCfg, t.cfg, nil)
+		c.Assert(err, gc.ErrorMatches, t.err)
+	}
+}
+
+func (s *configSuite) TestConfigDefaultsGeneratesKey(c *gc.C) {
+	p := NewProvider().(provider.ProviderConfig)
+	defaults := p.ConfigDefaults()
+	key, ok := defaults[KeyKey].(string)
+	c.Assert(ok, jc.IsTrue)
+	_, err := parseKEK(key)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Each backend gets its own key.
+	c.Assert(p.ConfigDefaults()[KeyKey], gc.Not(gc.Equals), key)
+
+	err = p.ValidateConfig(nil, provider.ConfigAttrs{"path": "/secrets", "key": key}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
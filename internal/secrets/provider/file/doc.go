@@ -0,0 +1,18 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package file provides the file secrets backend.
+//
+// Each secret revision is stored as a file holding the content encrypted
+// with its own AES-GCM data key. The data key is in turn wrapped by a
+// key-encryption key (KEK) held in the backend config; the KEK is either
+// supplied by the operator or generated by the controller when the backend
+// is added. Setting token-rotate on the backend rotates the KEK, re-wrapping
+// the data keys of all stored revisions.
+//
+// Only the controllers access the backend. Agents are not given the KEK
+// or the path; they read and write secret content through the controller,
+// which stores it in the backend on their behalf. The backend path must be
+// reachable at the same location from each controller, eg a shared network
+// filesystem.
+package file
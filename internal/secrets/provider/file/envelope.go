@@ -0,0 +1,197 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/envelope"
+)
+
+const envelopeVersion = 1
+
+// kek is a key-encryption key used to wrap the data keys of
+// secret revisions.
+type kek struct {
+	// id identifies the key without revealing it, so that an envelope
+	// records which keys its data key is wrapped with.
+	id  string
+	key []byte
+}
+
+func newKEK() (*kek, error) {
+	key, err := envelope.NewKey()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return makeKEK(key), nil
+}
+
+func parseKEK(encoded string) (*kek, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.NotValidf("key encoding")
+	}
+	if len(key) != envelope.KeySize {
+		return nil, errors.NotValidf("key length %d bytes, expected %d", len(key), envelope.KeySize)
+	}
+	return makeKEK(key), nil
+}
+
+func makeKEK(key []byte) *kek {
+	return &kek{
+		id:  envelope.KeyID(key),
+		key: key,
+	}
+}
+
+func (k *kek) String() string {
+	return base64.StdEncoding.EncodeToString(k.key)
+}
+
+// wrappedKey is a data key encrypted with a key-encryption key.
+type wrappedKey struct {
+	KEKID string `json:"kek-id"`
+	Key   []byte `json:"key"`
+}
+
+// secretEnvelope is the on disk representation of a secret revision.
+type secretEnvelope struct {
+	Version int          `json:"version"`
+	Keys    []wrappedKey `json:"keys"`
+	Content []byte       `json:"content"`
+}
+
+// sealContent encrypts content with a new data key wrapped by the supplied
+// key-encryption key. The revision id is authenticated along with the
+// content so that envelopes cannot be swapped between revisions.
+func sealContent(k *kek, revisionId string, content []byte) (*secretEnvelope, error) {
+	dataKey, err := envelope.NewKey()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sealed, err := envelope.Seal(dataKey, content, []byte(revisionId))
+	if err != nil {
+		return nil, errors.Annotate(err, "encrypting secret content")
+	}
+	wrapped, err := wrap(k, dataKey)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &secretEnvelope{
+		Version: envelopeVersion,
+		Keys:    []wrappedKey{wrapped},
+		Content: sealed,
+	}, nil
+}
+
+// openContent decrypts the envelope content using the first of the
+// supplied key-encryption keys that its data key is wrapped with.
+func (e *secretEnvelope) openContent(keks []*kek, revisionId string) ([]byte, error) {
+	dataKey, err := e.dataKey(keks)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	content, err := envelope.Open(dataKey, e.Content, []byte(revisionId))
+	if err != nil {
+		return nil, errors.Annotate(err, "decrypting secret content")
+	}
+	return content, nil
+}
+
+// rewrap replaces the wrapped data keys with ones wrapped by the current
+// and next key-encryption keys, so the content can be read using either
+// until the backend config is updated to use the next key.
+func (e *secretEnvelope) rewrap(keks []*kek, next *kek) error {
+	dataKey, err := e.dataKey(keks)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	keys := make([]wrappedKey, 0, 2)
+	for _, k := range []*kek{keks[0], next} {
+		wrapped, err := wrap(k, dataKey)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		keys = append(keys, wrapped)
+	}
+	e.Keys = keys
+	return nil
+}
+
+func (e *secretEnvelope) dataKey(keks []*kek) ([]byte, error) {
+	if e.Version != envelopeVersion {
+		return nil, errors.NotSupportedf("secret envelope version %d", e.Version)
+	}
+	for _, k := range keks {
+		for _, wrapped := range e.Keys {
+			if wrapped.KEKID != k.id {
+				continue
+			}
+			dataKey, err := envelope.Open(k.key, wrapped.Key, []byte(k.id))
+			if err != nil {
+				return nil, errors.Annotate(err, "unwrapping secret data key")
+			}
+			return dataKey, nil
+		}
+	}
+	return nil, errors.WithType(
+		errors.New("secret data key not wrapped with a configured key"), secrets.PermissionDenied)
+}
+
+func wrap(k *kek, dataKey []byte) (wrappedKey, error) {
+	sealed, err := envelope.Seal(k.key, dataKey, []byte(k.id))
+	if err != nil {
+		return wrappedKey{}, errors.Annotate(err, "wrapping secret data key")
+	}
+	return wrappedKey{KEKID: k.id, Key: sealed}, nil
+}
+
+func readEnvelope(path string) (*secretEnvelope, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var e secretEnvelope
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, errors.Annotatef(err, "parsing secret envelope %q", path)
+	}
+	return &e, nil
+}
+
+// writeEnvelope atomically writes the envelope to path, so that readers
+// never see a partially written file.
+func writeEnvelope(path string, e *secretEnvelope) (err error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	dir, name := filepath.Split(path)
+	f, err := os.CreateTemp(dir, "."+name+".tmp-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+	if _, err := f.Write(data); err != nil {
+		return errors.Trace(err)
+	}
+	if err := f.Sync(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(os.Rename(f.Name(), path))
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	internallogger "github.com/juju/juju/internal/logger"
+	internalsecrets "github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+var logger = internallogger.GetLogger("juju.secrets.file")
+
+const (
+	// BackendType is the type of the file secrets backend.
+	BackendType = "file"
+)
+
+// NewProvider returns a file secrets provider.
+func NewProvider() provider.SecretBackendProvider {
+	return fileProvider{}
+}
+
+type fileProvider struct {
+}
+
+func (p fileProvider) Type() string {
+	return BackendType
+}
+
+func modelPathPrefix(name, modelUUID string) string {
+	if name == "" || modelUUID == "" {
+		return ""
+	}
+	suffix := modelUUID[len(modelUUID)-6:]
+	return name + "-" + suffix
+}
+
+// Initialise creates the directory holding the model's secrets.
+func (p fileProvider) Initialise(cfg *provider.ModelBackendConfig) error {
+	backend, err := p.newBackend(modelPathPrefix(cfg.ModelName, cfg.ModelUUID), &cfg.BackendConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = os.MkdirAll(backend.dir, 0700)
+	return errors.Annotatef(maybePermissionDenied(err), "creating secrets directory %q", backend.dir)
+}
+
+// CleanupModel deletes all secrets associated with the model.
+func (p fileProvider) CleanupModel(_ context.Context, cfg *provider.ModelBackendConfig) error {
+	modelPath := modelPathPrefix(cfg.ModelName, cfg.ModelUUID)
+	if modelPath == "" {
+		return nil
+	}
+	backend, err := p.newBackend(modelPath, &cfg.BackendConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = os.RemoveAll(backend.dir)
+	return errors.Annotatef(maybePermissionDenied(err), "removing secrets directory %q", backend.dir)
+}
+
+// CleanupSecrets is not used since there are no resources
+// associated with secrets other than their content.
+func (p fileProvider) CleanupSecrets(context.Context, *provider.ModelBackendConfig, secrets.Accessor, provider.SecretRevisions) error {
+	return nil
+}
+
+// ControllerOnlyAccess implements SupportControllerOnlyAccess.
+// The backend config holds the key which wraps the data keys of every
+// secret in the backend, and the path is only known to be reachable from
+// the controllers, so agents access secret content through the controller.
+func (p fileProvider) ControllerOnlyAccess() {}
+
+// RestrictedConfig returns the config needed to create a
+// secrets backend client. Only the controller, acting for the model,
+// is given the full config; other accessors get a config without the
+// key or path, which creates a client that defers to the controller.
+func (p fileProvider) RestrictedConfig(
+	_ context.Context, adminCfg *provider.ModelBackendConfig, _, _ bool, accessor secrets.Accessor, _ provider.SecretRevisions, _ provider.SecretRevisions,
+) (*provider.BackendConfig, error) {
+	cfg := provider.BackendConfig{
+		BackendType: adminCfg.BackendType,
+	}
+	if accessor.Kind != secrets.ModelAccessor {
+		return &cfg, nil
+	}
+	cfg.Config = make(provider.ConfigAttrs)
+	for k, v := range adminCfg.Config {
+		cfg.Config[k] = v
+	}
+	return &cfg, nil
+}
+
+// NewBackend returns a file backed secrets backend client. A restricted
+// config without a path returns a client for agents, which leaves the
+// secret content to the controller.
+func (p fileProvider) NewBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+	if _, ok := cfg.Config[PathKey]; !ok {
+		return agentBackend{}, nil
+	}
+	return p.newBackend(modelPathPrefix(cfg.ModelName, cfg.ModelUUID), &cfg.BackendConfig)
+}
+
+func (p fileProvider) newBackend(modelPathPrefix string, cfg *provider.BackendConfig) (*fileBackend, error) {
+	validCfg, err := newConfig(cfg.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file config")
+	}
+	keks, err := validCfg.keks()
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file config")
+	}
+	return &fileBackend{
+		dir:  filepath.Join(validCfg.path(), modelPathPrefix),
+		keks: keks,
+	}, nil
+}
+
+// RefreshAuth implements SupportAuthRefresh.
+// It rotates the key used to wrap secret data keys, re-wrapping the data
+// keys of all secrets in the backend. The replaced key is retained as the
+// previous key so that secrets written concurrently with the rotation
+// remain readable; it is discarded by the following rotation.
+func (p fileProvider) RefreshAuth(ctx context.Context, backendConfig provider.BackendConfig, _ time.Duration) (_ *provider.BackendConfig, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	validCfg, err := newConfig(backendConfig.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file config")
+	}
+	keks, err := validCfg.keks()
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid file config")
+	}
+	next, err := newKEK()
+	if err != nil {
+		return nil, errors.Annotate(err, "generating new key")
+	}
+
+	// Any failure leaves the existing wrapped keys in place, so the
+	// backend remains readable with the current config.
+	root := validCfg.path()
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		e, err := readEnvelope(path)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := e.rewrap(keks, next); err != nil {
+			if errors.Is(err, internalsecrets.PermissionDenied) {
+				// The content is not readable with the configured keys
+				// so there's nothing we can do.
+				logger.Warningf(ctx, "not re-wrapping secret %q: %v", path, err)
+				return nil
+			}
+			return errors.Annotatef(err, "re-wrapping secret %q", path)
+		}
+		return errors.Annotatef(writeEnvelope(path, e), "re-wrapping secret %q", path)
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "rotating secret backend key")
+	}
+
+	result := provider.BackendConfig{
+		BackendType: backendConfig.BackendType,
+		Config:      make(provider.ConfigAttrs),
+	}
+	for k, v := range backendConfig.Config {
+		result.Config[k] = v
+	}
+	result.Config[KeyKey] = next.String()
+	result.Config[PreviousKeyKey] = keks[0].String()
+	return &result, nil
+}
+
+func maybePermissionDenied(err error) error {
+	if errors.Is(err, fs.ErrPermission) {
+		return errors.WithType(err, internalsecrets.PermissionDenied)
+	}
+	return err
+}
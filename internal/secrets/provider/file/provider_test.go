@@ -0,0 +1,259 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	internalsecrets "github.com/juju/juju/internal/secrets"
+	"github.com/juju/juju/internal/secrets/provider"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type providerSuite struct {
+	testing.IsolationSuite
+
+	path string
+}
+
+var _ = gc.Suite(&providerSuite{})
+
+func (s *providerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.path = c.MkDir()
+}
+
+func (s *providerSuite) modelConfig(key string) *provider.ModelBackendConfig {
+	return &provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: BackendType,
+			Config: map[string]interface{}{
+				"path": s.path,
+				"key":  key,
+			},
+		},
+	}
+}
+
+func (s *providerSuite) TestInitialise(c *gc.C) {
+	p := NewProvider()
+	err := p.Initialise(s.modelConfig(testKey))
+	c.Assert(err, jc.ErrorIsNil)
+
+	info, err := os.Stat(filepath.Join(s.path, "fred-06f00d"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info.IsDir(), jc.IsTrue)
+	c.Assert(info.Mode().Perm(), gc.Equals, os.FileMode(0700))
+}
+
+func (s *providerSuite) TestNewBackend(c *gc.C) {
+	p := NewProvider()
+	b, err := p.NewBackend(s.modelConfig(testKey))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(b.(*fileBackend).dir, gc.Equals, filepath.Join(s.path, "fred-06f00d"))
+}
+
+func (s *providerSuite) TestNewBackendInvalidKey(c *gc.C) {
+	p := NewProvider()
+	_, err := p.NewBackend(s.modelConfig("Zm9v"))
+	c.Assert(err, gc.ErrorMatches, `invalid file config: parsing "key": key length 3 bytes, expected 32 not valid`)
+}
+
+func (s *providerSuite) TestPing(c *gc.C) {
+	p := NewProvider()
+	cfg := s.modelConfig(testKey)
+	cfg.ModelName = ""
+	cfg.ModelUUID = ""
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(b.Ping(), jc.ErrorIsNil)
+
+	cfg.Config["path"] = filepath.Join(s.path, "missing")
+	b, err = p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(b.Ping(), gc.ErrorMatches, "backend not reachable: .*")
+}
+
+func (s *providerSuite) TestContent(c *gc.C) {
+	p := NewProvider()
+	b, err := p.NewBackend(s.modelConfig(testKey))
+	c.Assert(err, jc.ErrorIsNil)
+
+	uri := secrets.NewURI()
+	value := secrets.NewSecretValue(map[string]string{"foo": "YmFy"})
+	revisionId, err := b.SaveContent(context.Background(), uri, 1, value)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(revisionId, gc.Equals, uri.Name(1))
+
+	// The content is not stored in the clear.
+	data, err := os.ReadFile(filepath.Join(s.path, "fred-06f00d", revisionId))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(strings.Contains(string(data), "YmFy"), jc.IsFalse)
+
+	result, err := b.GetContent(context.Background(), revisionId)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.EncodedValues(), jc.DeepEquals, map[string]string{"foo": "YmFy"})
+
+	err = b.DeleteContent(context.Background(), revisionId)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = b.GetContent(context.Background(), revisionId)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionNotFound)
+	err = b.DeleteContent(context.Background(), revisionId)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *providerSuite) TestGetContentInvalidRevisionId(c *gc.C) {
+	p := NewProvider()
+	b, err := p.NewBackend(s.modelConfig(testKey))
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = b.GetContent(context.Background(), "../escape")
+	c.Assert(err, gc.ErrorMatches, `secret revision id "../escape" not valid`)
+}
+
+func (s *providerSuite) TestGetContentWrongKey(c *gc.C) {
+	p := NewProvider()
+	b, err := p.NewBackend(s.modelConfig(testKey))
+	c.Assert(err, jc.ErrorIsNil)
+	uri := secrets.NewURI()
+	revisionId, err := b.SaveContent(context.Background(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	b, err = p.NewBackend(s.modelConfig(otherTestKey))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = b.GetContent(context.Background(), revisionId)
+	c.Assert(err, jc.ErrorIs, internalsecrets.PermissionDenied)
+}
+
+func (s *providerSuite) TestRestrictedConfig(c *gc.C) {
+	p := NewProvider()
+	adminCfg := s.modelConfig(testKey)
+	cfg, err := p.RestrictedConfig(context.Background(), adminCfg, true, false, secrets.Accessor{
+		Kind: secrets.UnitAccessor,
+		ID:   "gitlab/0",
+	}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg, jc.DeepEquals, &provider.BackendConfig{
+		BackendType: BackendType,
+	})
+}
+
+func (s *providerSuite) TestRestrictedConfigForModel(c *gc.C) {
+	p := NewProvider()
+	adminCfg := s.modelConfig(testKey)
+	cfg, err := p.RestrictedConfig(context.Background(), adminCfg, true, false, secrets.Accessor{
+		Kind: secrets.ModelAccessor,
+		ID:   coretesting.ModelTag.Id(),
+	}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg, jc.DeepEquals, &provider.BackendConfig{
+		BackendType: BackendType,
+		Config: provider.ConfigAttrs{
+			"path": s.path,
+			"key":  testKey,
+		},
+	})
+
+	// The admin config is not shared.
+	cfg.Config["key"] = otherTestKey
+	c.Assert(adminCfg.Config["key"], gc.Equals, testKey)
+}
+
+func (s *providerSuite) TestNewBackendForAgent(c *gc.C) {
+	p := NewProvider()
+	c.Assert(provider.HasControllerOnlyAccess(p), jc.IsTrue)
+
+	cfg, err := p.RestrictedConfig(context.Background(), s.modelConfig(testKey), true, false, secrets.Accessor{
+		Kind: secrets.UnitAccessor,
+		ID:   "gitlab/0",
+	}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	b, err := p.NewBackend(&provider.ModelBackendConfig{
+		ModelName:     "fred",
+		ModelUUID:     coretesting.ModelTag.Id(),
+		BackendConfig: *cfg,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = b.SaveContent(context.Background(), secrets.NewURI(), 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+	_, err = b.GetContent(context.Background(), "rev-id")
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+	err = b.DeleteContent(context.Background(), "rev-id")
+	c.Assert(err, jc.ErrorIsNil)
+
+	entries, err := os.ReadDir(s.path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, gc.HasLen, 0)
+}
+
+func (s *providerSuite) TestCleanupModel(c *gc.C) {
+	p := NewProvider()
+	cfg := s.modelConfig(testKey)
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = b.SaveContent(context.Background(), secrets.NewURI(), 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = p.CleanupModel(context.Background(), cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = os.Stat(filepath.Join(s.path, "fred-06f00d"))
+	c.Assert(os.IsNotExist(err), jc.IsTrue)
+	_, err = os.Stat(s.path)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestRefreshAuth(c *gc.C) {
+	p := NewProvider()
+	cfg := s.modelConfig(testKey)
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	uri := secrets.NewURI()
+	revisionId, err := b.SaveContent(context.Background(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, jc.ErrorIsNil)
+
+	rotated, err := p.(provider.SupportAuthRefresh).RefreshAuth(context.Background(), cfg.BackendConfig, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	newKey := rotated.Config["key"]
+	c.Assert(newKey, gc.Not(gc.Equals), testKey)
+	c.Assert(rotated.Config["previous-key"], gc.Equals, testKey)
+	c.Assert(rotated.Config["path"], gc.Equals, s.path)
+	// The supplied config is not changed.
+	c.Assert(cfg.Config["key"], gc.Equals, testKey)
+
+	// The content can be read with just the new key.
+	newCfg := s.modelConfig(newKey.(string))
+	b, err = p.NewBackend(newCfg)
+	c.Assert(err, jc.ErrorIsNil)
+	result, err := b.GetContent(context.Background(), revisionId)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.EncodedValues(), jc.DeepEquals, map[string]string{"foo": "YmFy"})
+
+	// Rotating again discards the original key.
+	rotatedCfg := *cfg
+	rotatedCfg.BackendConfig = *rotated
+	rotated, err = p.(provider.SupportAuthRefresh).RefreshAuth(context.Background(), rotatedCfg.BackendConfig, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rotated.Config["previous-key"], gc.Equals, newKey)
+
+	b, err = p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = b.GetContent(context.Background(), revisionId)
+	c.Assert(err, jc.ErrorIs, internalsecrets.PermissionDenied)
+}
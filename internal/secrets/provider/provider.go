@@ -95,8 +95,31 @@ type SupportAuthRefresh interface {
 	RefreshAuth(ctx context.Context, adminCfg BackendConfig, validFor time.Duration) (*BackendConfig, error)
 }
 
+// SupportAuthExpiry is implemented by providers whose refreshed auth
+// can expire before the token rotate interval has elapsed.
+type SupportAuthExpiry interface {
+	// AuthExpiry returns when the auth held in the config
+	// expires, or the zero time if it does not expire.
+	AuthExpiry(cfg BackendConfig) time.Time
+}
+
 // HasAuthRefresh returns true if the provider supports token refresh.
 func HasAuthRefresh(p SecretBackendProvider) bool {
 	_, ok := p.(SupportAuthRefresh)
 	return ok
 }
+
+// SupportControllerOnlyAccess is implemented by providers whose backends
+// are only accessed by the controller. Agents are not given the config
+// of these backends; they read and write secret content through the
+// controller, which stores it in the backend on their behalf.
+type SupportControllerOnlyAccess interface {
+	ControllerOnlyAccess()
+}
+
+// HasControllerOnlyAccess returns true if the provider's backends are
+// only accessed by the controller.
+func HasControllerOnlyAccess(p SecretBackendProvider) bool {
+	_, ok := p.(SupportControllerOnlyAccess)
+	return ok
+}
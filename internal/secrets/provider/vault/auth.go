@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package vault
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	vault "github.com/mittwald/vaultgo"
+)
+
+const (
+	// AuthMethodToken uses the token in the backend config.
+	AuthMethodToken = "token"
+	// AuthMethodAppRole logs in with an AppRole role ID and secret ID.
+	AuthMethodAppRole = "approle"
+	// AuthMethodKubernetes logs in with a Kubernetes service account token.
+	AuthMethodKubernetes = "kubernetes"
+	// AuthMethodJWT logs in with a JWT.
+	AuthMethodJWT = "jwt"
+)
+
+// minTokenTTL is the shortest remaining lifetime for which a renewed
+// token is kept; a token which cannot be renewed for longer is about
+// to reach its max TTL and is replaced by logging in again.
+const minTokenTTL = time.Minute
+
+// loginToken is a token obtained by logging in to vault.
+type loginToken struct {
+	token string
+	// expiry is when the token expires, zero if it does not.
+	expiry time.Time
+}
+
+// login authenticates the client using the auth method in the config
+// and returns the resulting token, which the client is updated to use.
+func login(ctx context.Context, client *vault.Client, cfg *backendConfig) (loginToken, error) {
+	method := cfg.authMethod()
+	data := make(map[string]interface{})
+	switch method {
+	case AuthMethodAppRole:
+		data["role_id"] = cfg.roleID()
+		data["secret_id"] = cfg.secretID()
+	case AuthMethodKubernetes, AuthMethodJWT:
+		jwt := cfg.jwt()
+		if jwt == "" {
+			content, err := os.ReadFile(cfg.jwtPath())
+			if err != nil {
+				return loginToken{}, errors.Annotatef(err, "reading JWT for %s login", method)
+			}
+			jwt = strings.TrimSpace(string(content))
+		}
+		data["role"] = cfg.role()
+		data["jwt"] = jwt
+	default:
+		return loginToken{}, errors.NotSupportedf("login with vault auth method %q", method)
+	}
+
+	// Any existing token must not be sent with the login request, which
+	// is made in the backend namespace where the auth method is mounted,
+	// even if the client is being used in a model namespace.
+	client.ClearToken()
+	s, err := client.WithNamespace(cfg.namespace()).Logical().WriteWithContext(ctx, "auth/"+cfg.authMountPath()+"/login", data)
+	if err != nil {
+		return loginToken{}, errors.Annotatef(err, "logging in with %s auth method", method)
+	}
+	if s == nil || s.Auth == nil || s.Auth.ClientToken == "" {
+		return loginToken{}, errors.Errorf("logging in with %s auth method: no token returned", method)
+	}
+	client.SetToken(s.Auth.ClientToken)
+	return newLoginToken(s.Auth.ClientToken, s.Auth.LeaseDuration), nil
+}
+
+// renewOrLogin renews the token from a previous login for the specified
+// duration. If there is no token, or it cannot be renewed for at least
+// minTokenTTL, it logs in again instead.
+func renewOrLogin(ctx context.Context, client *vault.Client, cfg *backendConfig, validFor time.Duration) (loginToken, error) {
+	if cfg.token() == "" {
+		return login(ctx, client, cfg)
+	}
+	s, err := client.Auth().Token().RenewSelfWithContext(ctx, int(validFor.Seconds()))
+	if err != nil {
+		logger.Debugf(ctx, "cannot renew vault token, logging in again: %v", err)
+		return login(ctx, client, cfg)
+	}
+	if s == nil || s.Auth == nil || time.Duration(s.Auth.LeaseDuration)*time.Second < minTokenTTL {
+		logger.Debugf(ctx, "vault token has reached its max TTL, logging in again")
+		return login(ctx, client, cfg)
+	}
+	return newLoginToken(cfg.token(), s.Auth.LeaseDuration), nil
+}
+
+func newLoginToken(token string, leaseSeconds int) loginToken {
+	tok := loginToken{token: token}
+	if leaseSeconds > 0 {
+		tok.expiry = time.Now().Add(time.Duration(leaseSeconds) * time.Second).UTC()
+	}
+	return tok
+}
+
+// setLoginToken records the token and its expiry in the config attrs.
+func setLoginToken(attrs map[string]interface{}, tok loginToken) {
+	attrs[TokenKey] = tok.token
+	if tok.expiry.IsZero() {
+		delete(attrs, TokenExpiryKey)
+		return
+	}
+	attrs[TokenExpiryKey] = tok.expiry.Format(time.RFC3339)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/vault/api"
 	"github.com/juju/errors"
 	vault "github.com/mittwald/vaultgo"
 
@@ -17,6 +18,29 @@ import (
 type vaultBackend struct {
 	mountPath string
 	client    *vault.Client
+	// relogin is set when the client logs in to get its token,
+	// and logs in again to replace it.
+	relogin func(context.Context) error
+}
+
+// withLogin runs f, logging in first if the client has no token
+// yet. If f is denied because the token from a previous login has
+// expired or been revoked, it logs in again and retries f once.
+func (k vaultBackend) withLogin(ctx context.Context, f func() error) error {
+	if k.relogin != nil && k.client.Token() == "" {
+		if err := k.relogin(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	err := f()
+	if k.relogin == nil || !isPermissionDenied(err) {
+		return err
+	}
+	logger.Debugf(ctx, "vault token rejected, logging in again")
+	if err := k.relogin(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	return f()
 }
 
 // GetContent implements SecretsBackend.
@@ -25,7 +49,11 @@ func (k vaultBackend) GetContent(ctx context.Context, revisionId string) (_ secr
 		err = maybePermissionDenied(err)
 	}()
 
-	s, err := k.client.KVv1(k.mountPath).Get(ctx, revisionId)
+	var s *api.KVSecret
+	err = k.withLogin(ctx, func() error {
+		s, err = k.client.KVv1(k.mountPath).Get(ctx, revisionId)
+		return err
+	})
 	if isNotFound(err) {
 		return nil, fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
 	} else if err != nil {
@@ -46,11 +74,13 @@ func (k vaultBackend) DeleteContent(ctx context.Context, revisionId string) (err
 
 	// Read the content first so we can return a not found error
 	// if it doesn't exist.
-	_, err = k.client.KVv1(k.mountPath).Get(ctx, revisionId)
-	if isNotFound(err) {
-		return fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
-	}
-	return k.client.KVv1(k.mountPath).Delete(ctx, revisionId)
+	return k.withLogin(ctx, func() error {
+		_, err := k.client.KVv1(k.mountPath).Get(ctx, revisionId)
+		if isNotFound(err) {
+			return fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+		}
+		return k.client.KVv1(k.mountPath).Delete(ctx, revisionId)
+	})
 }
 
 // SaveContent implements SecretsBackend.
@@ -64,7 +94,9 @@ func (k vaultBackend) SaveContent(ctx context.Context, uri *secrets.URI, revisio
 	for k, v := range value.EncodedValues() {
 		val[k] = v
 	}
-	err = k.client.KVv1(k.mountPath).Put(ctx, path, val)
+	err = k.withLogin(ctx, func() error {
+		return k.client.KVv1(k.mountPath).Put(ctx, path, val)
+	})
 	if err != nil {
 		return "", errors.Annotatef(err, "saving secret content for %q", path)
 	}
@@ -83,7 +115,10 @@ func (k vaultBackend) Ping() error {
 	if h.Sealed {
 		return errors.New("vault is sealed")
 	}
-	_, err = k.client.Sys().KeyStatus()
+	err = k.withLogin(context.Background(), func() error {
+		_, err := k.client.Sys().KeyStatus()
+		return err
+	})
 	if err == nil {
 		return nil
 	}
@@ -5,6 +5,7 @@ package vault
 
 import (
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/juju/errors"
@@ -19,12 +20,28 @@ const (
 	EndpointKey      = "endpoint"
 	NamespaceKey     = "namespace"
 	TokenKey         = "token"
+	TokenExpiryKey   = "token-expiry"
 	CACertKey        = "ca-cert"
 	ClientCertKey    = "client-cert"
 	ClientKeyKey     = "client-key"
 	TLSServerNameKey = "tls-server-name"
+	MountPrefixKey   = "mount-prefix"
+
+	NamespacePerModelKey = "namespace-per-model"
+
+	AuthMethodKey    = "auth-method"
+	AuthMountPathKey = "auth-mount-path"
+	RoleIDKey        = "role-id"
+	SecretIDKey      = "secret-id"
+	RoleKey          = "role"
+	JWTKey           = "jwt"
+	JWTPathKey       = "jwt-path"
 )
 
+// defaultJWTPath is where the service account token
+// is mounted in a Kubernetes pod.
+const defaultJWTPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
 var configSchema = configschema.Fields{
 	EndpointKey: {
 		Description: "The vault service endpoint.",
@@ -33,14 +50,58 @@ var configSchema = configschema.Fields{
 		Mandatory:   true,
 	},
 	TokenKey: {
-		Description: "The vault access token.",
+		Description: "The vault access token, used when auth-method is token.",
+		Type:        configschema.Tstring,
+		Secret:      true,
+	},
+	TokenExpiryKey: {
+		Description: "When the token obtained by logging in expires, managed by Juju.",
+		Type:        configschema.Tstring,
+	},
+	AuthMethodKey: {
+		Description: "The vault auth method, one of token, approle, kubernetes or jwt.",
+		Type:        configschema.Tstring,
+	},
+	AuthMountPathKey: {
+		Description: "The path at which the auth method is mounted, defaults to the auth method name.",
+		Type:        configschema.Tstring,
+	},
+	RoleIDKey: {
+		Description: "The AppRole role ID.",
+		Type:        configschema.Tstring,
+	},
+	SecretIDKey: {
+		Description: "The AppRole secret ID.",
+		Type:        configschema.Tstring,
+		Secret:      true,
+	},
+	RoleKey: {
+		Description: "The vault role to log in with, used by the kubernetes and jwt auth methods.",
+		Type:        configschema.Tstring,
+	},
+	JWTKey: {
+		Description: "The JWT to log in with, used by the kubernetes and jwt auth methods.",
 		Type:        configschema.Tstring,
 		Secret:      true,
 	},
+	JWTPathKey: {
+		Description: "The path of a file containing the JWT to log in with, defaults to the Kubernetes service account token.",
+		Type:        configschema.Tstring,
+	},
+	MountPrefixKey: {
+		Description: "The path under which the per model kv stores are mounted.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+	},
 	NamespaceKey: {
 		Description: "The namespace in which to store secrets.",
 		Type:        configschema.Tstring,
 	},
+	NamespacePerModelKey: {
+		Description: "Whether each model's secrets are stored in a child namespace of the backend namespace.",
+		Type:        configschema.Tbool,
+		Immutable:   true,
+	},
 	CACertKey: {
 		Description: "The vault CA certificate.",
 		Type:        configschema.Tstring,
@@ -75,11 +136,76 @@ func (c *backendConfig) namespace() string {
 	return v
 }
 
+func (c *backendConfig) namespacePerModel() bool {
+	v, _ := c.validAttrs[NamespacePerModelKey].(bool)
+	return v
+}
+
 func (c *backendConfig) token() string {
 	v, _ := c.validAttrs[TokenKey].(string)
 	return v
 }
 
+func (c *backendConfig) tokenExpiry() string {
+	v, _ := c.validAttrs[TokenExpiryKey].(string)
+	return v
+}
+
+// tokenExpired reports whether the token from a previous
+// login is known to have expired.
+func (c *backendConfig) tokenExpired() bool {
+	v := c.tokenExpiry()
+	if v == "" {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, v)
+	return err != nil || !time.Now().Before(expiry)
+}
+
+func (c *backendConfig) authMethod() string {
+	v, _ := c.validAttrs[AuthMethodKey].(string)
+	if v == "" {
+		return AuthMethodToken
+	}
+	return v
+}
+
+func (c *backendConfig) authMountPath() string {
+	v, _ := c.validAttrs[AuthMountPathKey].(string)
+	if v == "" {
+		return c.authMethod()
+	}
+	return strings.Trim(v, "/")
+}
+
+func (c *backendConfig) roleID() string {
+	v, _ := c.validAttrs[RoleIDKey].(string)
+	return v
+}
+
+func (c *backendConfig) secretID() string {
+	v, _ := c.validAttrs[SecretIDKey].(string)
+	return v
+}
+
+func (c *backendConfig) role() string {
+	v, _ := c.validAttrs[RoleKey].(string)
+	return v
+}
+
+func (c *backendConfig) jwt() string {
+	v, _ := c.validAttrs[JWTKey].(string)
+	return v
+}
+
+func (c *backendConfig) jwtPath() string {
+	v, _ := c.validAttrs[JWTPathKey].(string)
+	if v == "" {
+		return defaultJWTPath
+	}
+	return v
+}
+
 func (c *backendConfig) clientCert() string {
 	v, _ := c.validAttrs[ClientCertKey].(string)
 	return v
@@ -130,13 +256,19 @@ func (p vaultProvider) ValidateConfig(oldCfg, newCfg provider.ConfigAttrs, token
 		return errors.NotValidf("vault config missing client certificate")
 	}
 
-	if oldCfg == nil {
-		return nil
+	var oldValidCfg *backendConfig
+	if oldCfg != nil {
+		if oldValidCfg, err = newConfig(oldCfg); err != nil {
+			return errors.Trace(err)
+		}
 	}
-	oldValidCfg, err := newConfig(oldCfg)
-	if err != nil {
+	if err := validateAuthConfig(oldValidCfg, newValidCfg, tokenRotateInterval); err != nil {
 		return errors.Trace(err)
 	}
+
+	if oldValidCfg == nil {
+		return nil
+	}
 	for n, field := range configSchema {
 		if !field.Immutable {
 			continue
@@ -150,6 +282,46 @@ func (p vaultProvider) ValidateConfig(oldCfg, newCfg provider.ConfigAttrs, token
 	return nil
 }
 
+func validateAuthConfig(oldCfg, newCfg *backendConfig, tokenRotateInterval *time.Duration) error {
+	method := newCfg.authMethod()
+	switch method {
+	case AuthMethodToken:
+		return nil
+	case AuthMethodAppRole:
+		if newCfg.roleID() == "" {
+			return errors.NotValidf("vault config missing role ID")
+		}
+		if newCfg.secretID() == "" {
+			return errors.NotValidf("vault config missing secret ID")
+		}
+	case AuthMethodKubernetes, AuthMethodJWT:
+		if newCfg.role() == "" {
+			return errors.NotValidf("vault config missing role")
+		}
+	default:
+		return errors.NotValidf("vault auth method %q", method)
+	}
+
+	// When logging in, the token and its expiry are managed by Juju
+	// and cannot be supplied by the user; an existing token obtained
+	// from a login using the same auth method is carried over unchanged.
+	methodChanged := oldCfg == nil || oldCfg.authMethod() != method
+	token, expiry := newCfg.token(), newCfg.tokenExpiry()
+	if token != "" || expiry != "" {
+		if methodChanged || oldCfg.token() != token || oldCfg.tokenExpiry() != expiry {
+			return errors.NotValidf("vault config with token and %s auth method", method)
+		}
+	}
+
+	// The token from a login is only kept, rather than every client
+	// logging in again, when it is rotated. So token-rotate is needed
+	// when first using a login auth method, and can't be turned off.
+	if (methodChanged && tokenRotateInterval == nil) || (tokenRotateInterval != nil && *tokenRotateInterval <= 0) {
+		return errors.NotValidf("vault config with %s auth method without token-rotate", method)
+	}
+	return nil
+}
+
 func newConfig(attrs map[string]interface{}) (*backendConfig, error) {
 	cfg, err := coreconfig.NewConfig(attrs, configSchema, configDefaults)
 	if err != nil {
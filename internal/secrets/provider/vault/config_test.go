@@ -4,6 +4,8 @@
 package vault_test
 
 import (
+	"time"
+
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -41,8 +43,98 @@ func (s *configSuite) TestValidateConfig(c *gc.C) {
 	}, {
 		cfg: map[string]interface{}{"endpoint": "newep", "client-key": "aaa"},
 		err: `vault config missing client certificate not valid`,
+	}, {
+		cfg: map[string]interface{}{"endpoint": "newep", "auth-method": "foo"},
+		err: `vault auth method "foo" not valid`,
+	}, {
+		cfg: map[string]interface{}{"endpoint": "newep", "auth-method": "approle", "secret-id": "secret"},
+		err: `vault config missing role ID not valid`,
+	}, {
+		cfg: map[string]interface{}{"endpoint": "newep", "auth-method": "approle", "role-id": "role"},
+		err: `vault config missing secret ID not valid`,
+	}, {
+		cfg: map[string]interface{}{"endpoint": "newep", "auth-method": "kubernetes"},
+		err: `vault config missing role not valid`,
+	}, {
+		cfg: map[string]interface{}{"endpoint": "newep", "auth-method": "jwt", "role": "juju", "token": "root"},
+		err: `vault config with token and jwt auth method not valid`,
+	}, {
+		cfg: map[string]interface{}{
+			"endpoint": "newep", "auth-method": "approle", "role-id": "role", "secret-id": "secret", "token": "root",
+		},
+		oldCfg: map[string]interface{}{"endpoint": "newep", "token": "root"},
+		err:    `vault config with token and approle auth method not valid`,
+	}, {
+		cfg:    map[string]interface{}{"endpoint": "newep", "mount-prefix": "new"},
+		oldCfg: map[string]interface{}{"endpoint": "newep", "mount-prefix": "old"},
+		err:    `cannot change immutable field "mount-prefix"`,
+	}, {
+		cfg: map[string]interface{}{
+			"endpoint": "newep", "auth-method": "approle", "role-id": "role", "secret-id": "secret", "token-expiry": "2100-01-01T00:00:00Z",
+		},
+		err: `vault config with token and approle auth method not valid`,
+	}, {
+		cfg:    map[string]interface{}{"endpoint": "newep", "namespace-per-model": true},
+		oldCfg: map[string]interface{}{"endpoint": "newep", "namespace-per-model": false},
+		err:    `cannot change immutable field "namespace-per-model"`,
 	}} {
 		err = configValidator.ValidateConfig(t.oldCfg, t.cfg, nil)
 		c.Assert(err, gc.ErrorMatches, t.err)
 	}
 }
+
+func (s *configSuite) TestValidateConfigLogin(c *gc.C) {
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, jc.IsTrue)
+
+	tokenRotate := time.Hour
+	cfg := map[string]interface{}{
+		"endpoint": "newep", "auth-method": "approle", "role-id": "role", "secret-id": "secret",
+	}
+	err = configValidator.ValidateConfig(nil, cfg, &tokenRotate)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A token obtained by a previous login is carried over.
+	oldCfg := map[string]interface{}{
+		"endpoint": "newep", "auth-method": "approle", "role-id": "role", "secret-id": "secret", "token": "login-token",
+	}
+	cfg = map[string]interface{}{
+		"endpoint": "newep", "auth-method": "approle", "role-id": "role", "secret-id": "new-secret", "token": "login-token",
+	}
+	err = configValidator.ValidateConfig(oldCfg, cfg, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg = map[string]interface{}{
+		"endpoint": "newep", "auth-method": "kubernetes", "role": "juju",
+	}
+	err = configValidator.ValidateConfig(nil, cfg, &tokenRotate)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *configSuite) TestValidateConfigLoginRequiresTokenRotate(c *gc.C) {
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, jc.IsTrue)
+
+	cfg := map[string]interface{}{
+		"endpoint": "newep", "auth-method": "approle", "role-id": "role", "secret-id": "secret",
+	}
+	err = configValidator.ValidateConfig(nil, cfg, nil)
+	c.Assert(err, gc.ErrorMatches, `vault config with approle auth method without token-rotate not valid`)
+
+	// Changing to a login auth method needs token-rotate too.
+	oldCfg := map[string]interface{}{"endpoint": "newep", "token": "root"}
+	err = configValidator.ValidateConfig(oldCfg, cfg, nil)
+	c.Assert(err, gc.ErrorMatches, `vault config with approle auth method without token-rotate not valid`)
+
+	// Other updates leave the existing token-rotate in place, but it
+	// can't be turned off.
+	err = configValidator.ValidateConfig(cfg, cfg, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	noRotate := time.Duration(0)
+	err = configValidator.ValidateConfig(cfg, cfg, &noRotate)
+	c.Assert(err, gc.ErrorMatches, `vault config with approle auth method without token-rotate not valid`)
+}
@@ -20,3 +20,11 @@ func TestPackage(t *testing.T) {
 func MountPath(b provider.SecretsBackend) string {
 	return b.(*vaultBackend).mountPath
 }
+
+func ClientToken(b provider.SecretsBackend) string {
+	return b.(*vaultBackend).client.Token()
+}
+
+func ClientNamespace(b provider.SecretsBackend) string {
+	return b.(*vaultBackend).client.Namespace()
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
 	"strings"
 	"time"
 
@@ -46,22 +47,80 @@ func modelPathPrefix(name, modelUUID string) string {
 	return name + "-" + suffix
 }
 
-// Initialise sets up a kv store mounted on the model uuid.
+// modelMountPath returns the path of the kv store for the model,
+// placed under the mount prefix if one is configured.
+func modelMountPath(cfg *provider.ModelBackendConfig) string {
+	modelPath := modelPathPrefix(cfg.ModelName, cfg.ModelUUID)
+	prefix, _ := cfg.Config[MountPrefixKey].(string)
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" || modelPath == "" {
+		return modelPath
+	}
+	return prefix + "/" + modelPath
+}
+
+// modelNamespace returns the namespace holding the model's secrets
+// when each model has its own namespace, otherwise "".
+func modelNamespace(validCfg *backendConfig, cfg *provider.ModelBackendConfig) string {
+	if !validCfg.namespacePerModel() {
+		return ""
+	}
+	return path.Join(validCfg.namespace(), modelPathPrefix(cfg.ModelName, cfg.ModelUUID))
+}
+
+// policyPrefix returns the prefix used to name the policies for
+// the model with the specified mount path.
+func policyPrefix(mountPath string) string {
+	return strings.ReplaceAll(mountPath, "/", "-")
+}
+
+// Initialise sets up a kv store mounted on the model uuid, in
+// the model's own namespace if the backend is so configured.
 func (p vaultProvider) Initialise(cfg *provider.ModelBackendConfig) error {
-	client, err := p.newBackendNoMount(&cfg.BackendConfig)
+	backend, validCfg, err := p.newBackendNoMount(&cfg.BackendConfig)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	sys := client.client.Sys()
 	ctx := context.Background()
+	return backend.withLogin(ctx, func() error {
+		client := backend.client.Client
+		if ns := modelNamespace(validCfg, cfg); ns != "" {
+			if err := ensureNamespace(ctx, client, path.Base(ns)); err != nil {
+				return errors.Trace(err)
+			}
+			client = client.WithNamespace(ns)
+		}
+		return ensureMount(ctx, client.Sys(), cfg)
+	})
+}
+
+// ensureNamespace creates the named child namespace of
+// the client's namespace if it does not already exist.
+func ensureNamespace(ctx context.Context, client *api.Client, name string) error {
+	s, err := client.Logical().ListWithContext(ctx, "sys/namespaces")
+	if err != nil && !isNotFound(err) {
+		return errors.Annotate(err, "listing namespaces")
+	}
+	if s != nil {
+		keys, _ := s.Data["keys"].([]interface{})
+		for _, k := range keys {
+			if fmt.Sprintf("%s", k) == name+"/" {
+				return nil
+			}
+		}
+	}
+	_, err = client.Logical().WriteWithContext(ctx, "sys/namespaces/"+name, nil)
+	return errors.Annotatef(err, "creating namespace %q", name)
+}
 
+func ensureMount(ctx context.Context, sys *api.Sys, cfg *provider.ModelBackendConfig) error {
 	mounts, err := sys.ListMountsWithContext(ctx)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	logger.Debugf(context.TODO(), "kv mounts: %v", mounts)
 	modelUUID := cfg.ModelUUID
-	mountPath := modelPathPrefix(cfg.ModelName, modelUUID)
+	mountPath := modelMountPath(cfg)
 	if _, ok := mounts[mountPath+"/"]; ok {
 		return nil
 	}
@@ -93,11 +152,27 @@ func (p vaultProvider) CleanupModel(ctx context.Context, cfg *provider.ModelBack
 		}
 	}()
 
-	modelPath := modelPathPrefix(cfg.ModelName, cfg.ModelUUID)
-	k, err := p.newBackend(modelPath, &cfg.BackendConfig)
+	k, validCfg, err := p.newBackend(cfg)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	return k.withLogin(ctx, func() error {
+		if err := cleanupModel(ctx, k, cfg); err != nil {
+			return errors.Trace(err)
+		}
+		ns := modelNamespace(validCfg, cfg)
+		if ns == "" {
+			return nil
+		}
+		_, err := k.client.WithNamespace(validCfg.namespace()).Logical().DeleteWithContext(ctx, "sys/namespaces/"+path.Base(ns))
+		if err != nil && !isNotFound(err) {
+			return errors.Annotatef(err, "deleting namespace %q", ns)
+		}
+		return nil
+	})
+}
+
+func cleanupModel(ctx context.Context, k *vaultBackend, cfg *provider.ModelBackendConfig) error {
 	sys := k.client.Sys()
 
 	// First remove any policies.
@@ -105,9 +180,10 @@ func (p vaultProvider) CleanupModel(ctx context.Context, cfg *provider.ModelBack
 	if err != nil {
 		return errors.Trace(err)
 	}
+	modelPolicyPrefix := policyPrefix(k.mountPath)
 	for _, p := range policies {
 		// TODO(juju4) - remove legacy mount point
-		if strings.HasPrefix(p, modelPath) || strings.HasPrefix(p, "model-"+cfg.ModelUUID) {
+		if strings.HasPrefix(p, modelPolicyPrefix) || strings.HasPrefix(p, "model-"+cfg.ModelUUID) {
 			if err := sys.DeletePolicyWithContext(ctx, p); err != nil {
 				if isNotFound(err) {
 					continue
@@ -140,16 +216,22 @@ func (p vaultProvider) CleanupModel(ctx context.Context, cfg *provider.ModelBack
 
 // CleanupSecrets removes policies associated with the removed secrets.
 func (p vaultProvider) CleanupSecrets(ctx context.Context, cfg *provider.ModelBackendConfig, _ secrets.Accessor, removed provider.SecretRevisions) error {
-	modelPath := modelPathPrefix(cfg.ModelName, cfg.ModelUUID)
-	client, err := p.newBackend(modelPath, &cfg.BackendConfig)
+	client, _, err := p.newBackend(cfg)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	return client.withLogin(ctx, func() error {
+		return cleanupSecrets(ctx, client, cfg, removed)
+	})
+}
+
+func cleanupSecrets(ctx context.Context, client *vaultBackend, cfg *provider.ModelBackendConfig, removed provider.SecretRevisions) error {
 	sys := client.client.Sys()
 
+	modelPolicyPrefix := policyPrefix(client.mountPath)
 	isRelevantPolicy := func(p string) bool {
 		for id := range removed {
-			if strings.HasPrefix(p, fmt.Sprintf("%s-%s-", modelPath, id)) {
+			if strings.HasPrefix(p, fmt.Sprintf("%s-%s-", modelPolicyPrefix, id)) {
 				return true
 			}
 			// TODO(juju4) - remove legacy mount point
@@ -183,13 +265,46 @@ func (p vaultProvider) CleanupSecrets(ctx context.Context, cfg *provider.ModelBa
 func (p vaultProvider) RestrictedConfig(
 	ctx context.Context, adminCfg *provider.ModelBackendConfig, _, forDrain bool, accessor secrets.Accessor, owned provider.SecretRevisions, read provider.SecretRevisions,
 ) (*provider.BackendConfig, error) {
-	adminUser := accessor.Kind == secrets.ModelAccessor
 	// Get an admin backend client so we can set up the policies.
-	mountPath := modelPathPrefix(adminCfg.ModelName, adminCfg.ModelUUID)
-	backend, err := p.newBackend(mountPath, &adminCfg.BackendConfig)
+	backend, validCfg, err := p.newBackend(adminCfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var token string
+	err = backend.withLogin(ctx, func() error {
+		var err error
+		token, err = restrictedToken(ctx, backend, validCfg, forDrain, accessor, owned, read)
+		return err
+	})
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+
+	// The restricted config only carries the new token; any credentials
+	// used by the controller to log in are not passed on.
+	cfg := provider.BackendConfig{
+		BackendType: adminCfg.BackendType,
+		Config:      make(provider.ConfigAttrs),
+	}
+	for k, v := range adminCfg.Config {
+		switch k {
+		case RoleIDKey, SecretIDKey, JWTKey, JWTPathKey, AuthMountPathKey, RoleKey, TokenExpiryKey:
+			continue
+		}
+		cfg.Config[k] = v
+	}
+	cfg.Config[AuthMethodKey] = AuthMethodToken
+	cfg.Config[TokenKey] = token
+	return &cfg, nil
+}
+
+// restrictedToken creates a token with policies allowing access
+// to the owned and read secrets for the given accessor.
+func restrictedToken(
+	ctx context.Context, backend *vaultBackend, validCfg *backendConfig, forDrain bool, accessor secrets.Accessor, owned provider.SecretRevisions, read provider.SecretRevisions,
+) (string, error) {
+	adminUser := accessor.Kind == secrets.ModelAccessor
+	mountPath := backend.mountPath
 	sys := backend.client.Sys()
 
 	var policies []string
@@ -199,26 +314,26 @@ func (p vaultProvider) RestrictedConfig(
 		// before it can update the secret to the new backend, we need to allow the worker to update the content
 		// after it's coming up again.
 		if err := ensurePolicy(ctx, sys, &policies, mountPath, "update"); err != nil {
-			return nil, errors.Trace(err)
+			return "", errors.Trace(err)
 		}
 	} else if adminUser {
 		// For admin users, all secrets for the model can be read.
 		if err := ensurePolicy(ctx, sys, &policies, mountPath, "read"); err != nil {
-			return nil, errors.Trace(err)
+			return "", errors.Trace(err)
 		}
 	}
 	// Agents, drain workers and admin users (creates user secrets) can create new secrets in the model.
 	if err := ensurePolicy(ctx, sys, &policies, mountPath, "create"); err != nil {
-		return nil, errors.Trace(err)
+		return "", errors.Trace(err)
 	}
 	// Any secrets owned by the agent can be updated/deleted etc.
 	logger.Debugf(context.TODO(), "owned secrets: %#v", owned)
 	for id := range owned {
 		rule := fmt.Sprintf(`path "%s/%s-*" {capabilities = ["create", "read", "update", "delete", "list"]}`, mountPath, id)
-		policyName := fmt.Sprintf("%s-%s-owner", mountPath, id)
-		err = sys.PutPolicyWithContext(ctx, policyName, rule)
+		policyName := fmt.Sprintf("%s-%s-owner", policyPrefix(mountPath), id)
+		err := sys.PutPolicyWithContext(ctx, policyName, rule)
 		if err != nil {
-			return nil, errors.Annotatef(err, "creating owner policy for %q", id)
+			return "", errors.Annotatef(err, "creating owner policy for %q", id)
 		}
 		policies = append(policies, policyName)
 	}
@@ -227,31 +342,38 @@ func (p vaultProvider) RestrictedConfig(
 	logger.Debugf(context.TODO(), "consumed secrets: %#v", read)
 	for id := range read {
 		rule := fmt.Sprintf(`path "%s/%s-*" {capabilities = ["read"]}`, mountPath, id)
-		policyName := fmt.Sprintf("%s-%s-read", mountPath, id)
-		err = sys.PutPolicyWithContext(ctx, policyName, rule)
+		policyName := fmt.Sprintf("%s-%s-read", policyPrefix(mountPath), id)
+		err := sys.PutPolicyWithContext(ctx, policyName, rule)
 		if err != nil {
-			return nil, errors.Annotatef(err, "creating read policy for %q", id)
+			return "", errors.Annotatef(err, "creating read policy for %q", id)
 		}
 		policies = append(policies, policyName)
 	}
 	logger.Tracef(context.TODO(), "policies: %#v", policies)
-	s, err := backend.client.Auth().Token().Create(&api.TokenCreateRequest{
+	req := &api.TokenCreateRequest{
 		TTL:             "10m", // 10 minutes for now, can configure later.
 		NoDefaultPolicy: true,
 		Policies:        policies,
-	})
+	}
+	var s *api.Secret
+	var err error
+	if validCfg.authMethod() == AuthMethodToken {
+		s, err = backend.client.Auth().Token().CreateWithContext(ctx, req)
+	} else {
+		// A token obtained by logging in is replaced when it can no
+		// longer be renewed, so agent tokens are created as orphans
+		// rather than as its children, which would be revoked with it.
+		s, err = backend.client.Auth().Token().CreateOrphanWithContext(ctx, req)
+	}
 	if err != nil {
-		return nil, errors.Annotate(err, "creating secret access token")
+		return "", errors.Annotate(err, "creating secret access token")
 	}
-
-	cfg := adminCfg.BackendConfig
-	cfg.Config[TokenKey] = s.Auth.ClientToken
-	return &cfg, nil
+	return s.Auth.ClientToken, nil
 }
 
 func ensurePolicy(ctx context.Context, sys *api.Sys, policies *[]string, mountPath, capability string) error {
 	rule := fmt.Sprintf(`path "%s/*" {capabilities = [%q]}`, mountPath, capability)
-	policyName := fmt.Sprintf("%s-%s", mountPath, capability)
+	policyName := fmt.Sprintf("%s-%s", policyPrefix(mountPath), capability)
 	err := sys.PutPolicyWithContext(ctx, policyName, rule)
 	if err != nil {
 		return errors.Annotatef(err, "creating create policy for model %q", mountPath)
@@ -265,24 +387,51 @@ var NewVaultClient = vault.NewClient
 
 // NewBackend returns a vault backed secrets backend client.
 func (p vaultProvider) NewBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
-	return p.newBackend(modelPathPrefix(cfg.ModelName, cfg.ModelUUID), &cfg.BackendConfig)
+	backend, _, err := p.newBackend(cfg)
+	return backend, err
 }
 
-func (p vaultProvider) newBackend(modelPathPrefix string, cfg *provider.BackendConfig) (*vaultBackend, error) {
-	backend, err := p.newBackendNoMount(cfg)
+func (p vaultProvider) newBackend(cfg *provider.ModelBackendConfig) (*vaultBackend, *backendConfig, error) {
+	backend, validCfg, err := p.newBackendNoMount(&cfg.BackendConfig)
 	if err != nil {
-		return nil, errors.Trace(err)
+		return nil, nil, errors.Trace(err)
 	}
-	backend.mountPath = modelPathPrefix
-	return backend, nil
+	backend.mountPath = modelMountPath(cfg)
+	if ns := modelNamespace(validCfg, cfg); ns != "" {
+		backend.client.SetNamespace(ns)
+	}
+	return backend, validCfg, nil
 }
 
-func (p vaultProvider) newBackendNoMount(cfg *provider.BackendConfig) (*vaultBackend, error) {
+func (p vaultProvider) newBackendNoMount(cfg *provider.BackendConfig) (*vaultBackend, *backendConfig, error) {
 	validCfg, err := newConfig(cfg.Config)
 	if err != nil {
-		return nil, errors.Annotatef(err, "invalid vault config")
+		return nil, nil, errors.Annotatef(err, "invalid vault config")
 	}
+	c, err := p.newClient(validCfg)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	backend := &vaultBackend{client: c}
+	if validCfg.authMethod() == AuthMethodToken {
+		return backend, validCfg, nil
+	}
+	// A token from a previous login is used until it is refreshed,
+	// unless it has expired. Without a usable token, the backend logs
+	// in when it is first used, with the context of that call. If the
+	// token turns out to have been revoked, log in again when it is
+	// rejected.
+	if validCfg.token() == "" || validCfg.tokenExpired() {
+		c.ClearToken()
+	}
+	backend.relogin = func(ctx context.Context) error {
+		_, err := login(ctx, c, validCfg)
+		return err
+	}
+	return backend, validCfg, nil
+}
 
+func (p vaultProvider) newClient(validCfg *backendConfig) (*vault.Client, error) {
 	var clientCertPath, clientKeyPath string
 	clientCert := validCfg.clientCert()
 	clientKey := validCfg.clientKey()
@@ -326,16 +475,44 @@ func (p vaultProvider) newBackendNoMount(cfg *provider.BackendConfig) (*vaultBac
 	if ns := validCfg.namespace(); ns != "" {
 		c.SetNamespace(ns)
 	}
-	return &vaultBackend{client: c}, nil
+	return c, nil
+}
+
+// AuthExpiry implements SupportAuthExpiry.
+func (p vaultProvider) AuthExpiry(cfg provider.BackendConfig) time.Time {
+	v, _ := cfg.Config[TokenExpiryKey].(string)
+	expiry, _ := time.Parse(time.RFC3339, v)
+	return expiry
 }
 
 // RefreshAuth implements SupportAuthRefresh.
-func (p vaultProvider) RefreshAuth(_ context.Context, backendConfig provider.BackendConfig, validFor time.Duration) (_ *provider.BackendConfig, err error) {
+func (p vaultProvider) RefreshAuth(ctx context.Context, backendConfig provider.BackendConfig, validFor time.Duration) (_ *provider.BackendConfig, err error) {
 	defer func() {
 		err = maybePermissionDenied(err)
 	}()
 
-	backend, err := p.newBackendNoMount(&backendConfig)
+	validCfg, err := newConfig(backendConfig.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid vault config")
+	}
+	if validCfg.authMethod() != AuthMethodToken {
+		// Renew the token from the previous login, or log in again if
+		// it can't be renewed. A token which is replaced is left to
+		// expire rather than being revoked, so that any clients still
+		// using it are not cut off.
+		c, err := p.newClient(validCfg)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		tok, err := renewOrLogin(ctx, c, validCfg, validFor)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		setLoginToken(backendConfig.Config, tok)
+		return &backendConfig, nil
+	}
+
+	backend, _, err := p.newBackendNoMount(&backendConfig)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -5,9 +5,13 @@ package vault_test
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/juju/collections/set"
@@ -19,6 +23,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/secrets"
+	internalsecrets "github.com/juju/juju/internal/secrets"
 	"github.com/juju/juju/internal/secrets/provider"
 	_ "github.com/juju/juju/internal/secrets/provider/all"
 	jujuvault "github.com/juju/juju/internal/secrets/provider/vault"
@@ -43,6 +48,10 @@ func (s *providerSuite) SetUpTest(c *gc.C) {
 type newVaultClientFunc func(addr string, tlsConf *vault.TLSConfig, opts ...vault.ClientOpts) (*vault.Client, error)
 
 func (s *providerSuite) newVaultClient(c *gc.C, returnErr error) (*gomock.Controller, newVaultClientFunc) {
+	return s.newVaultClientWithToken(c, "vault-token", returnErr)
+}
+
+func (s *providerSuite) newVaultClientWithToken(c *gc.C, token string, returnErr error) (*gomock.Controller, newVaultClientFunc) {
 	ctrl := gomock.NewController(c)
 	s.mockRoundTripper = mocks.NewMockRoundTripper(ctrl)
 
@@ -60,7 +69,7 @@ func (s *providerSuite) newVaultClient(c *gc.C, returnErr error) (*gomock.Contro
 		c.Assert(opts, gc.HasLen, 1)
 		err = opts[0](client)
 		c.Assert(err, jc.ErrorIsNil)
-		c.Assert(client.Token(), gc.Equals, "vault-token")
+		c.Assert(client.Token(), gc.Equals, token)
 		if returnErr != nil {
 			return nil, returnErr
 		}
@@ -74,6 +83,8 @@ func (s *providerSuite) newVaultClient(c *gc.C, returnErr error) (*gomock.Contro
 		conf.HttpClient.Transport = s.mockRoundTripper
 		client.Client, err = api.NewClient(conf)
 		c.Assert(err, jc.ErrorIsNil)
+		// The replacement api client must carry over the auth token.
+		client.SetToken(token)
 
 		return client, nil
 	}
@@ -372,3 +383,475 @@ func (s *providerSuite) TestNewBackend(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(jujuvault.MountPath(b), gc.Equals, "fred-06f00d")
 }
+
+func (s *providerSuite) expectLogin(c *gc.C, path string, body map[string]interface{}) {
+	s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+		func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.Method, gc.Equals, http.MethodPut)
+			c.Assert(req.URL.String(), gc.Equals, "http://vault-ip:8200/v1/"+path)
+			c.Assert(req.Header.Get("X-Vault-Token"), gc.Equals, "")
+			var got map[string]interface{}
+			err := json.NewDecoder(req.Body).Decode(&got)
+			c.Assert(err, jc.ErrorIsNil)
+			c.Assert(got, jc.DeepEquals, body)
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"auth": {"client_token": "login-token"}}`)),
+			}, nil
+		},
+	)
+}
+
+// expectGetContent expects the content at the path to be read with the
+// token from a login.
+func (s *providerSuite) expectGetContent(c *gc.C, path string) {
+	s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+		func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.URL.String(), gc.Equals, "http://vault-ip:8200/v1/"+path)
+			c.Assert(req.Header.Get("X-Vault-Token"), gc.Equals, "login-token")
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data": {"foo": "bar"}}`)),
+			}, nil
+		},
+	)
+}
+
+func (s *providerSuite) TestNewBackendAppRole(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClientWithToken(c, "", nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	s.expectLogin(c, "auth/my-approle/login", map[string]interface{}{
+		"role_id":   "role-id",
+		"secret_id": "secret-id",
+	})
+	s.expectGetContent(c, "juju/models/fred-06f00d/secret-1")
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg := &provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]interface{}{
+				"endpoint":        "http://vault-ip:8200/",
+				"auth-method":     "approle",
+				"auth-mount-path": "/my-approle/",
+				"role-id":         "role-id",
+				"secret-id":       "secret-id",
+				"mount-prefix":    "juju/models",
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+			},
+		},
+	}
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(jujuvault.MountPath(b), gc.Equals, "juju/models/fred-06f00d")
+
+	// The backend logs in when it is first used.
+	c.Assert(jujuvault.ClientToken(b), gc.Equals, "")
+	_, err = b.GetContent(context.Background(), "secret-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(jujuvault.ClientToken(b), gc.Equals, "login-token")
+}
+
+func (s *providerSuite) TestNewBackendKubernetesJWTPath(c *gc.C) {
+	jwtPath := filepath.Join(c.MkDir(), "token")
+	err := os.WriteFile(jwtPath, []byte("service-account-jwt\n"), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctrl, newVaultClient := s.newVaultClientWithToken(c, "", nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	s.expectLogin(c, "auth/kubernetes/login", map[string]interface{}{
+		"role": "juju",
+		"jwt":  "service-account-jwt",
+	})
+	s.expectGetContent(c, "fred-06f00d/secret-1")
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg := &provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]interface{}{
+				"endpoint":        "http://vault-ip:8200/",
+				"auth-method":     "kubernetes",
+				"role":            "juju",
+				"jwt-path":        jwtPath,
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+			},
+		},
+	}
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = b.GetContent(context.Background(), "secret-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(jujuvault.ClientToken(b), gc.Equals, "login-token")
+}
+
+func (s *providerSuite) TestNewBackendAppRoleCachedToken(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg := &provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]interface{}{
+				"endpoint":        "http://vault-ip:8200/",
+				"auth-method":     "approle",
+				"role-id":         "role-id",
+				"secret-id":       "secret-id",
+				"token":           "vault-token",
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+			},
+		},
+	}
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(jujuvault.ClientToken(b), gc.Equals, "vault-token")
+}
+
+func (s *providerSuite) expectRenewSelf(c *gc.C, status int, body string) {
+	s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+		func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.Method, gc.Equals, http.MethodPut)
+			c.Assert(req.URL.String(), gc.Equals, "http://vault-ip:8200/v1/auth/token/renew-self")
+			c.Assert(req.Header.Get("X-Vault-Token"), gc.Equals, "vault-token")
+			return &http.Response{
+				Request:    req,
+				StatusCode: status,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		},
+	)
+}
+
+func (s *providerSuite) TestNewBackendAppRoleExpiredToken(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	s.expectLogin(c, "auth/approle/login", map[string]interface{}{
+		"role_id":   "role-id",
+		"secret_id": "secret-id",
+	})
+	s.expectGetContent(c, "fred-06f00d/secret-1")
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg := &provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]interface{}{
+				"endpoint":        "http://vault-ip:8200/",
+				"auth-method":     "approle",
+				"role-id":         "role-id",
+				"secret-id":       "secret-id",
+				"token":           "vault-token",
+				"token-expiry":    time.Now().Add(-time.Minute).Format(time.RFC3339),
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+			},
+		},
+	}
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The expired token isn't used.
+	c.Assert(jujuvault.ClientToken(b), gc.Equals, "")
+	_, err = b.GetContent(context.Background(), "secret-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(jujuvault.ClientToken(b), gc.Equals, "login-token")
+}
+
+func (s *providerSuite) TestBackendAppRoleReloginOnPermissionDenied(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	gomock.InOrder(
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				c.Assert(req.Header.Get("X-Vault-Token"), gc.Equals, "vault-token")
+				return &http.Response{
+					Request:    req,
+					StatusCode: http.StatusForbidden,
+					Body:       io.NopCloser(strings.NewReader(`{"errors": ["permission denied"]}`)),
+				}, nil
+			},
+		),
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				c.Assert(req.URL.String(), gc.Equals, "http://vault-ip:8200/v1/auth/approle/login")
+				return &http.Response{
+					Request:    req,
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"auth": {"client_token": "login-token"}}`)),
+				}, nil
+			},
+		),
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				c.Assert(req.URL.String(), gc.Equals, "http://vault-ip:8200/v1/fred-06f00d/secret-1")
+				c.Assert(req.Header.Get("X-Vault-Token"), gc.Equals, "login-token")
+				return &http.Response{
+					Request:    req,
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"data": {"foo": "bar"}}`)),
+				}, nil
+			},
+		),
+	)
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg := &provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]interface{}{
+				"endpoint":        "http://vault-ip:8200/",
+				"auth-method":     "approle",
+				"role-id":         "role-id",
+				"secret-id":       "secret-id",
+				"token":           "vault-token",
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+			},
+		},
+	}
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	val, err := b.GetContent(context.Background(), "secret-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), jc.DeepEquals, map[string]string{"foo": "bar"})
+}
+
+func (s *providerSuite) TestNewBackendNamespacePerModel(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg := &provider.ModelBackendConfig{
+		ModelName: "fred",
+		ModelUUID: coretesting.ModelTag.Id(),
+		BackendConfig: provider.BackendConfig{
+			BackendType: jujuvault.BackendType,
+			Config: map[string]interface{}{
+				"endpoint":            "http://vault-ip:8200/",
+				"namespace":           "juju",
+				"namespace-per-model": true,
+				"token":               "vault-token",
+				"ca-cert":             coretesting.CACert,
+				"tls-server-name":     "tls-server",
+			},
+		},
+	}
+	b, err := p.NewBackend(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(jujuvault.MountPath(b), gc.Equals, "fred-06f00d")
+	c.Assert(jujuvault.ClientNamespace(b), gc.Equals, "juju/fred-06f00d")
+}
+
+func (s *providerSuite) TestRefreshAuthAppRoleRenew(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	s.expectRenewSelf(c, http.StatusOK, `{"auth": {"client_token": "vault-token", "lease_duration": 1200, "renewable": true}}`)
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg, err := p.(provider.SupportAuthRefresh).RefreshAuth(context.Background(), provider.BackendConfig{
+		BackendType: jujuvault.BackendType,
+		Config: map[string]interface{}{
+			"endpoint":        "http://vault-ip:8200/",
+			"auth-method":     "approle",
+			"role-id":         "role-id",
+			"secret-id":       "secret-id",
+			"token":           "vault-token",
+			"ca-cert":         coretesting.CACert,
+			"tls-server-name": "tls-server",
+		},
+	}, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.Config["token"], gc.Equals, "vault-token")
+	// The token is renewed for the lease granted by vault,
+	// which may be less than was asked for.
+	expiry := p.(provider.SupportAuthExpiry).AuthExpiry(*cfg)
+	c.Assert(expiry.After(time.Now().Add(19*time.Minute)), jc.IsTrue)
+	c.Assert(expiry.Before(time.Now().Add(21*time.Minute)), jc.IsTrue)
+}
+
+func (s *providerSuite) TestRefreshAuthAppRoleMaxTTL(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	s.expectRenewSelf(c, http.StatusOK, `{"auth": {"client_token": "vault-token", "lease_duration": 10, "renewable": true}}`)
+	s.expectLogin(c, "auth/approle/login", map[string]interface{}{
+		"role_id":   "role-id",
+		"secret_id": "secret-id",
+	})
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg, err := p.(provider.SupportAuthRefresh).RefreshAuth(context.Background(), provider.BackendConfig{
+		BackendType: jujuvault.BackendType,
+		Config: map[string]interface{}{
+			"endpoint":        "http://vault-ip:8200/",
+			"auth-method":     "approle",
+			"role-id":         "role-id",
+			"secret-id":       "secret-id",
+			"token":           "vault-token",
+			"ca-cert":         coretesting.CACert,
+			"tls-server-name": "tls-server",
+		},
+	}, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.Config["token"], gc.Equals, "login-token")
+}
+
+func (s *providerSuite) TestRefreshAuthAppRole(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	s.expectRenewSelf(c, http.StatusForbidden, `{"errors": ["permission denied"]}`)
+	s.expectLogin(c, "auth/approle/login", map[string]interface{}{
+		"role_id":   "role-id",
+		"secret_id": "secret-id",
+	})
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg, err := p.(provider.SupportAuthRefresh).RefreshAuth(context.Background(), provider.BackendConfig{
+		BackendType: jujuvault.BackendType,
+		Config: map[string]interface{}{
+			"endpoint":        "http://vault-ip:8200/",
+			"auth-method":     "approle",
+			"role-id":         "role-id",
+			"secret-id":       "secret-id",
+			"token":           "vault-token",
+			"ca-cert":         coretesting.CACert,
+			"tls-server-name": "tls-server",
+		},
+	}, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.Config["token"], gc.Equals, "login-token")
+	c.Assert(cfg.Config["secret-id"], gc.Equals, "secret-id")
+}
+
+func (s *providerSuite) TestRefreshAuthAppRolePermissionDenied(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClientWithToken(c, "", nil)
+	defer ctrl.Finish()
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+		func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusForbidden,
+				Body:       io.NopCloser(strings.NewReader(`{"errors": ["invalid role or secret ID"]}`)),
+			}, nil
+		},
+	)
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = p.(provider.SupportAuthRefresh).RefreshAuth(context.Background(), provider.BackendConfig{
+		BackendType: jujuvault.BackendType,
+		Config: map[string]interface{}{
+			"endpoint":        "http://vault-ip:8200/",
+			"auth-method":     "approle",
+			"role-id":         "role-id",
+			"secret-id":       "secret-id",
+			"ca-cert":         coretesting.CACert,
+			"tls-server-name": "tls-server",
+		},
+	}, time.Hour)
+	c.Assert(err, jc.ErrorIs, internalsecrets.PermissionDenied)
+}
+
+func (s *providerSuite) TestBackendConfigAppRole(c *gc.C) {
+	ctrl, newVaultClient := s.newVaultClient(c, nil)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				c.Assert(req.URL.String(), gc.Equals, `http://vault-ip:8200/v1/sys/policies/acl/juju-fred-06f00d-create`)
+				return &http.Response{
+					Request:    req,
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(nil),
+				}, nil
+			},
+		),
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				// Agent tokens outlive the login token they are created with.
+				c.Assert(req.URL.String(), gc.Equals, `http://vault-ip:8200/v1/auth/token/create-orphan`)
+				return &http.Response{
+					Request:    req,
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"auth": {"client_token": "foo"}}`)),
+				}, nil
+			},
+		),
+	)
+
+	s.PatchValue(&jujuvault.NewVaultClient, newVaultClient)
+	p, err := provider.Provider(jujuvault.BackendType)
+	c.Assert(err, jc.ErrorIsNil)
+
+	adminCfg := &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig: provider.BackendConfig{
+			BackendType: "vault",
+			Config: map[string]interface{}{
+				"endpoint":        "http://vault-ip:8200/",
+				"auth-method":     "approle",
+				"role-id":         "role-id",
+				"secret-id":       "secret-id",
+				"token":           "vault-token",
+				"token-expiry":    time.Now().Add(time.Hour).Format(time.RFC3339),
+				"mount-prefix":    "juju",
+				"ca-cert":         coretesting.CACert,
+				"tls-server-name": "tls-server",
+			},
+		},
+	}
+
+	accessor := secrets.Accessor{
+		Kind: secrets.UnitAccessor,
+		ID:   "gitlab/0",
+	}
+	cfg, err := p.RestrictedConfig(context.Background(), adminCfg, true, false, accessor, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.Config, jc.DeepEquals, provider.ConfigAttrs{
+		"endpoint":        "http://vault-ip:8200/",
+		"auth-method":     "token",
+		"token":           "foo",
+		"mount-prefix":    "juju",
+		"ca-cert":         coretesting.CACert,
+		"tls-server-name": "tls-server",
+	})
+	// The admin config is not modified.
+	c.Assert(adminCfg.Config["token"], gc.Equals, "vault-token")
+}
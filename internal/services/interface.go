@@ -10,8 +10,10 @@ import (
 	annotationService "github.com/juju/juju/domain/annotation/service"
 	applicationservice "github.com/juju/juju/domain/application/service"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
+	backupservice "github.com/juju/juju/domain/backup/service"
 	blockcommandservice "github.com/juju/juju/domain/blockcommand/service"
 	blockdeviceservice "github.com/juju/juju/domain/blockdevice/service"
+	changelogservice "github.com/juju/juju/domain/changelog/service"
 	cloudservice "github.com/juju/juju/domain/cloud/service"
 	cloudimagemetadataservice "github.com/juju/juju/domain/cloudimagemetadata/service"
 	controllerservice "github.com/juju/juju/domain/controller/service"
@@ -40,6 +42,7 @@ import (
 	stubservice "github.com/juju/juju/domain/stub"
 	unitstateservice "github.com/juju/juju/domain/unitstate/service"
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
+	workloadmetricsservice "github.com/juju/juju/domain/workloadmetrics/service"
 )
 
 // ControllerDomainServices provides access to the services required by the
@@ -74,6 +77,9 @@ type ControllerDomainServices interface {
 	SecretBackend() *secretbackendservice.WatchableService
 	// Macaroon returns the macaroon bakery backend service
 	Macaroon() *macaroonservice.Service
+	// Backup returns the service for taking and restoring snapshots of the
+	// controller database.
+	Backup() *backupservice.Service
 }
 
 // ModelDomainServices provides access to the services required by the
@@ -135,8 +141,22 @@ type ModelDomainServices interface {
 	Stub() *stubservice.StubService
 	// BlockCommand returns the service for blocking commands.
 	BlockCommand() *blockcommandservice.Service
+	// ModelBackup returns the service for taking and restoring snapshots of
+	// the model database.
+	// Note: This should be called backup, but we have naming conflicts with
+	// the controller backup service.
+	ModelBackup() *backupservice.Service
 	// Resource returns the service for managing resources
 	Resource() *resourceservice.Service
+	// ChangeLog returns the service for reading and watching the model
+	// change log.
+	ChangeLog() *changelogservice.WatchableService
+	// ObjectStoreUsage returns the service for accounting for the storage
+	// used by the model's object store.
+	ObjectStoreUsage() *objectstoreservice.UsageService
+	// WorkloadMetrics returns the service for recording and retrieving the
+	// workload metrics published by charms.
+	WorkloadMetrics() *workloadmetricsservice.Service
 }
 
 // DomainServices provides access to the services required by the apiserver.
@@ -187,6 +207,10 @@ type ControllerObjectStoreServices interface {
 	// Primarily used for agent blob store. Although can be used for other
 	// blob related operations.
 	AgentObjectStore() *objectstoreservice.WatchableService
+
+	// ObjectStoreBlobs returns the service for tracking the namespaces that
+	// reference the blobs in the controller-wide blob store.
+	ObjectStoreBlobs() *objectstoreservice.BlobService
 }
 
 // ObjectStoreServices provides access to the services required by the
@@ -196,6 +220,10 @@ type ObjectStoreServices interface {
 
 	// ObjectStore returns the object store service.
 	ObjectStore() *objectstoreservice.WatchableService
+
+	// ObjectStoreCharms returns the service for locating the origin of the
+	// charm archives in the object store.
+	ObjectStoreCharms() *objectstoreservice.CharmService
 }
 
 // ObjectStoreServicesGetter represents a way to get a ObjectStoreServices
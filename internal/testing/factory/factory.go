@@ -846,6 +846,7 @@ func NewObjectStore(c *gc.C, modelUUID string, metadataService internalobjectsto
 		modelUUID,
 		internalobjectstore.WithRootDir(c.MkDir()),
 		internalobjectstore.WithMetadataService(metadataService),
+		internalobjectstore.WithBlobMetadataService(objectstoretesting.MemoryBlobMetadataService()),
 		internalobjectstore.WithClaimer(claimer),
 		internalobjectstore.WithLogger(loggertesting.WrapCheckLog(c)),
 	)
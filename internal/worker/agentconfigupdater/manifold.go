@@ -5,6 +5,7 @@ package agentconfigupdater
 
 import (
 	"context"
+	"slices"
 
 	"github.com/juju/errors"
 	"github.com/juju/pubsub/v2"
@@ -154,6 +155,13 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			if err != nil {
 				return nil, errors.Annotate(err, "getting state serving info")
 			}
+
+			// The secret content keys are only read when the agent starts,
+			// so if the controller has generated them, or they otherwise
+			// differ, the agent must be restarted to use them.
+			agentsServingInfo, _ := currentConfig.StateServingInfo()
+			secretContentKeysChanged := len(info.SecretContentKeys) > 0 &&
+				!slices.Equal(agentsServingInfo.SecretContentKeys, info.SecretContentKeys)
 			err = agent.ChangeConfig(func(config jujuagent.ConfigSetter) error {
 				existing, hasInfo := config.StateServingInfo()
 				if hasInfo {
@@ -166,6 +174,9 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 					// apiState.
 					info.Cert = existing.Cert
 					info.PrivateKey = existing.PrivateKey
+					if len(info.SecretContentKeys) == 0 {
+						info.SecretContentKeys = existing.SecretContentKeys
+					}
 				}
 				config.SetStateServingInfo(info)
 				if mongoProfileChanged {
@@ -253,6 +264,9 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			} else if objectStoreTypeChanged {
 				logger.Infof(context.TODO(), "restarting agent for new object store type")
 				return nil, jworker.ErrRestartAgent
+			} else if secretContentKeysChanged {
+				logger.Infof(context.TODO(), "restarting agent for new secret content keys")
+				return nil, jworker.ErrRestartAgent
 			}
 
 			// Only get the hub if we are a controller and we haven't updated
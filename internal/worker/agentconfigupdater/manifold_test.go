@@ -36,6 +36,8 @@ type AgentConfigUpdaterSuite struct {
 	testing.BaseSuite
 	manifold dependency.Manifold
 	hub      *pubsub.StructuredHub
+
+	secretContentKeys []string
 }
 
 var _ = gc.Suite(&AgentConfigUpdaterSuite{})
@@ -52,6 +54,7 @@ func (s *AgentConfigUpdaterSuite) SetUpTest(c *gc.C) {
 	s.hub = pubsub.NewStructuredHub(&pubsub.StructuredHubConfig{
 		Logger: internalpubsub.WrapLogger(logger),
 	})
+	s.secretContentKeys = nil
 }
 
 func (s *AgentConfigUpdaterSuite) TestInputs(c *gc.C) {
@@ -235,9 +238,10 @@ func (s *AgentConfigUpdaterSuite) startManifold(c *gc.C, a agent.Agent, mockAPIP
 			case "StateServingInfo":
 				result := response.(*params.StateServingInfo)
 				*result = params.StateServingInfo{
-					Cert:       "cert",
-					PrivateKey: "key",
-					APIPort:    mockAPIPort,
+					Cert:              "cert",
+					PrivateKey:        "key",
+					APIPort:           mockAPIPort,
+					SecretContentKeys: s.secretContentKeys,
 				}
 			case "ControllerConfig":
 				result := response.(*params.ControllerConfigResult)
@@ -324,6 +328,52 @@ func (s *AgentConfigUpdaterSuite) TestJobManageEnvironNotOverwriteCert(c *gc.C)
 	c.Assert(a.conf.ssi.PrivateKey, gc.Equals, existingKey)
 }
 
+func (s *AgentConfigUpdaterSuite) TestSecretContentKeysChangedRestarts(c *gc.C) {
+	// A controller upgraded from before secret content was encrypted
+	// gets its secret content keys from the controller.
+	s.secretContentKeys = []string{"secret-content-key"}
+
+	a := &mockAgent{}
+	w, err := s.startManifold(c, a, 1234)
+	c.Assert(w, gc.IsNil)
+	c.Assert(err, gc.Equals, jworker.ErrRestartAgent)
+
+	c.Assert(a.conf.ssiSet, jc.IsTrue)
+	c.Assert(a.conf.ssi.SecretContentKeys, jc.DeepEquals, []string{"secret-content-key"})
+}
+
+func (s *AgentConfigUpdaterSuite) TestSecretContentKeysUnchanged(c *gc.C) {
+	s.secretContentKeys = []string{"secret-content-key"}
+
+	a := &mockAgent{}
+	a.conf.SetStateServingInfo(controller.StateServingInfo{
+		Cert:              "cert",
+		PrivateKey:        "key",
+		SecretContentKeys: []string{"secret-content-key"},
+	})
+	w, err := s.startManifold(c, a, 1234)
+	c.Assert(w, gc.NotNil)
+	c.Assert(err, jc.ErrorIsNil)
+	workertest.CleanKill(c, w)
+
+	c.Assert(a.conf.ssi.SecretContentKeys, jc.DeepEquals, []string{"secret-content-key"})
+}
+
+func (s *AgentConfigUpdaterSuite) TestSecretContentKeysNotCleared(c *gc.C) {
+	a := &mockAgent{}
+	a.conf.SetStateServingInfo(controller.StateServingInfo{
+		Cert:              "cert",
+		PrivateKey:        "key",
+		SecretContentKeys: []string{"secret-content-key"},
+	})
+	w, err := s.startManifold(c, a, 1234)
+	c.Assert(w, gc.NotNil)
+	c.Assert(err, jc.ErrorIsNil)
+	workertest.CleanKill(c, w)
+
+	c.Assert(a.conf.ssi.SecretContentKeys, jc.DeepEquals, []string{"secret-content-key"})
+}
+
 func (s *AgentConfigUpdaterSuite) TestJobHostUnits(c *gc.C) {
 	// State serving info should not be set for JobHostUnits.
 	s.checkNotController(c, model.JobHostUnits)
@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/apiserver"
+	"github.com/juju/juju/internal/secrets/envelope"
 )
 
 func getLogSinkConfig(cfg agent.Config) (apiserver.LogSinkConfig, error) {
@@ -34,3 +35,17 @@ func getLogSinkConfig(cfg agent.Config) (apiserver.LogSinkConfig, error) {
 	}
 	return result, nil
 }
+
+// getSecretContentKeys returns the keys which wrap the keys encrypting
+// secret content stored in model databases.
+func getSecretContentKeys(cfg agent.Config) (envelope.KeyRing, error) {
+	info, ok := cfg.StateServingInfo()
+	if !ok || len(info.SecretContentKeys) == 0 {
+		return envelope.KeyRing{}, nil
+	}
+	keys, err := envelope.ParseKeyRing(info.SecretContentKeys)
+	if err != nil {
+		return envelope.KeyRing{}, errors.Annotate(err, "parsing secret content keys")
+	}
+	return keys, nil
+}
@@ -147,6 +147,11 @@ func NewWorker(ctx context.Context, config Config) (worker.Worker, error) {
 		return nil, errors.Annotate(err, "getting log sink config")
 	}
 
+	secretContentKeys, err := getSecretContentKeys(config.AgentConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	controllerConfig, err := config.ControllerConfigService.ControllerConfig(ctx)
 	if err != nil {
 		return nil, errors.Annotate(err, "getting controller config")
@@ -199,6 +204,7 @@ func NewWorker(ctx context.Context, config Config) (worker.Worker, error) {
 		CharmhubHTTPClient:            config.CharmhubHTTPClient,
 		DBGetter:                      config.DBGetter,
 		DBDeleter:                     config.DBDeleter,
+		SecretContentKeys:             secretContentKeys,
 		DomainServicesGetter:          config.DomainServicesGetter,
 		TracerGetter:                  config.TracerGetter,
 		ObjectStoreGetter:             config.ObjectStoreGetter,
@@ -15,8 +15,10 @@ import (
 	service "github.com/juju/juju/domain/agentprovisioner/service"
 	service0 "github.com/juju/juju/domain/annotation/service"
 	service1 "github.com/juju/juju/domain/application/service"
+	service21 "github.com/juju/juju/domain/backup/service"
 	service2 "github.com/juju/juju/domain/blockcommand/service"
 	service3 "github.com/juju/juju/domain/blockdevice/service"
+	service20 "github.com/juju/juju/domain/changelog/service"
 	service4 "github.com/juju/juju/domain/cloudimagemetadata/service"
 	service5 "github.com/juju/juju/domain/keymanager/service"
 	service6 "github.com/juju/juju/domain/keyupdater/service"
@@ -26,6 +28,7 @@ import (
 	service10 "github.com/juju/juju/domain/modelconfig/service"
 	service11 "github.com/juju/juju/domain/modelmigration/service"
 	service12 "github.com/juju/juju/domain/network/service"
+	service22 "github.com/juju/juju/domain/objectstore/service"
 	service13 "github.com/juju/juju/domain/port/service"
 	service14 "github.com/juju/juju/domain/proxy/service"
 	service15 "github.com/juju/juju/domain/resource/service"
@@ -34,6 +37,7 @@ import (
 	service18 "github.com/juju/juju/domain/storage/service"
 	stub "github.com/juju/juju/domain/stub"
 	service19 "github.com/juju/juju/domain/unitstate/service"
+	service23 "github.com/juju/juju/domain/workloadmetrics/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -288,6 +292,44 @@ func (c *MockModelDomainServicesBlockDeviceCall) DoAndReturn(f func() *service3.
 	return c
 }
 
+// ChangeLog mocks base method.
+func (m *MockModelDomainServices) ChangeLog() *service20.WatchableService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeLog")
+	ret0, _ := ret[0].(*service20.WatchableService)
+	return ret0
+}
+
+// ChangeLog indicates an expected call of ChangeLog.
+func (mr *MockModelDomainServicesMockRecorder) ChangeLog() *MockModelDomainServicesChangeLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeLog", reflect.TypeOf((*MockModelDomainServices)(nil).ChangeLog))
+	return &MockModelDomainServicesChangeLogCall{Call: call}
+}
+
+// MockModelDomainServicesChangeLogCall wrap *gomock.Call
+type MockModelDomainServicesChangeLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesChangeLogCall) Return(arg0 *service20.WatchableService) *MockModelDomainServicesChangeLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesChangeLogCall) Do(f func() *service20.WatchableService) *MockModelDomainServicesChangeLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesChangeLogCall) DoAndReturn(f func() *service20.WatchableService) *MockModelDomainServicesChangeLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CloudImageMetadata mocks base method.
 func (m *MockModelDomainServices) CloudImageMetadata() *service4.Service {
 	m.ctrl.T.Helper()
@@ -516,6 +558,44 @@ func (c *MockModelDomainServicesMachineCall) DoAndReturn(f func() *service7.Watc
 	return c
 }
 
+// ModelBackup mocks base method.
+func (m *MockModelDomainServices) ModelBackup() *service21.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelBackup")
+	ret0, _ := ret[0].(*service21.Service)
+	return ret0
+}
+
+// ModelBackup indicates an expected call of ModelBackup.
+func (mr *MockModelDomainServicesMockRecorder) ModelBackup() *MockModelDomainServicesModelBackupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelBackup", reflect.TypeOf((*MockModelDomainServices)(nil).ModelBackup))
+	return &MockModelDomainServicesModelBackupCall{Call: call}
+}
+
+// MockModelDomainServicesModelBackupCall wrap *gomock.Call
+type MockModelDomainServicesModelBackupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesModelBackupCall) Return(arg0 *service21.Service) *MockModelDomainServicesModelBackupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesModelBackupCall) Do(f func() *service21.Service) *MockModelDomainServicesModelBackupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesModelBackupCall) DoAndReturn(f func() *service21.Service) *MockModelDomainServicesModelBackupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ModelInfo mocks base method.
 func (m *MockModelDomainServices) ModelInfo() *service8.ModelService {
 	m.ctrl.T.Helper()
@@ -668,6 +748,44 @@ func (c *MockModelDomainServicesNetworkCall) DoAndReturn(f func() *service12.Wat
 	return c
 }
 
+// ObjectStoreUsage mocks base method.
+func (m *MockModelDomainServices) ObjectStoreUsage() *service22.UsageService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStoreUsage")
+	ret0, _ := ret[0].(*service22.UsageService)
+	return ret0
+}
+
+// ObjectStoreUsage indicates an expected call of ObjectStoreUsage.
+func (mr *MockModelDomainServicesMockRecorder) ObjectStoreUsage() *MockModelDomainServicesObjectStoreUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStoreUsage", reflect.TypeOf((*MockModelDomainServices)(nil).ObjectStoreUsage))
+	return &MockModelDomainServicesObjectStoreUsageCall{Call: call}
+}
+
+// MockModelDomainServicesObjectStoreUsageCall wrap *gomock.Call
+type MockModelDomainServicesObjectStoreUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesObjectStoreUsageCall) Return(arg0 *service22.UsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesObjectStoreUsageCall) Do(f func() *service22.UsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesObjectStoreUsageCall) DoAndReturn(f func() *service22.UsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Port mocks base method.
 func (m *MockModelDomainServices) Port() *service13.WatchableService {
 	m.ctrl.T.Helper()
@@ -933,3 +1051,41 @@ func (c *MockModelDomainServicesUnitStateCall) DoAndReturn(f func() *service19.S
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// WorkloadMetrics mocks base method.
+func (m *MockModelDomainServices) WorkloadMetrics() *service23.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadMetrics")
+	ret0, _ := ret[0].(*service23.Service)
+	return ret0
+}
+
+// WorkloadMetrics indicates an expected call of WorkloadMetrics.
+func (mr *MockModelDomainServicesMockRecorder) WorkloadMetrics() *MockModelDomainServicesWorkloadMetricsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadMetrics", reflect.TypeOf((*MockModelDomainServices)(nil).WorkloadMetrics))
+	return &MockModelDomainServicesWorkloadMetricsCall{Call: call}
+}
+
+// MockModelDomainServicesWorkloadMetricsCall wrap *gomock.Call
+type MockModelDomainServicesWorkloadMetricsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesWorkloadMetricsCall) Return(arg0 *service23.Service) *MockModelDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesWorkloadMetricsCall) Do(f func() *service23.Service) *MockModelDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesWorkloadMetricsCall) DoAndReturn(f func() *service23.Service) *MockModelDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
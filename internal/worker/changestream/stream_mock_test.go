@@ -222,6 +222,50 @@ func (c *MockWatchableDBWorkerSubscribeCall) DoAndReturn(f func(...changestream.
 	return c
 }
 
+// SubscribeDurable mocks base method.
+func (m *MockWatchableDBWorker) SubscribeDurable(arg0 string, arg1 ...changestream.SubscriptionOption) (changestream.DurableSubscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubscribeDurable", varargs...)
+	ret0, _ := ret[0].(changestream.DurableSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeDurable indicates an expected call of SubscribeDurable.
+func (mr *MockWatchableDBWorkerMockRecorder) SubscribeDurable(arg0 any, arg1 ...any) *MockWatchableDBWorkerSubscribeDurableCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeDurable", reflect.TypeOf((*MockWatchableDBWorker)(nil).SubscribeDurable), varargs...)
+	return &MockWatchableDBWorkerSubscribeDurableCall{Call: call}
+}
+
+// MockWatchableDBWorkerSubscribeDurableCall wrap *gomock.Call
+type MockWatchableDBWorkerSubscribeDurableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWatchableDBWorkerSubscribeDurableCall) Return(arg0 changestream.DurableSubscription, arg1 error) *MockWatchableDBWorkerSubscribeDurableCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWatchableDBWorkerSubscribeDurableCall) Do(f func(string, ...changestream.SubscriptionOption) (changestream.DurableSubscription, error)) *MockWatchableDBWorkerSubscribeDurableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWatchableDBWorkerSubscribeDurableCall) DoAndReturn(f func(string, ...changestream.SubscriptionOption) (changestream.DurableSubscription, error)) *MockWatchableDBWorkerSubscribeDurableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Txn mocks base method.
 func (m *MockWatchableDBWorker) Txn(arg0 context.Context, arg1 func(context.Context, *sqlair.TX) error) error {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/changestream/durable"
 	"github.com/juju/juju/internal/changestream/eventmultiplexer"
 	"github.com/juju/juju/internal/changestream/stream"
 )
@@ -25,6 +26,7 @@ import (
 type WatchableDBWorker interface {
 	worker.Worker
 	changestream.WatchableDB
+	changestream.DurableEventSource
 }
 
 // WatchableDB is a worker that is responsible for managing the lifecycle
@@ -101,6 +103,12 @@ func (w *WatchableDB) Subscribe(opts ...changestream.SubscriptionOption) (change
 	return w.mux.Subscribe(opts...)
 }
 
+// SubscribeDurable returns a durable subscription for the consumer, which
+// resumes from the last change log ID that the consumer checkpointed.
+func (w *WatchableDB) SubscribeDurable(consumerID string, opts ...changestream.SubscriptionOption) (changestream.DurableSubscription, error) {
+	return w.mux.SubscribeDurable(durable.NewConsumer(consumerID, w.db), opts...)
+}
+
 func (w *WatchableDB) loop() error {
 	<-w.catacomb.Dying()
 	return w.catacomb.ErrDying()
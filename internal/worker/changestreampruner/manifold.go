@@ -23,6 +23,11 @@ type NewWorkerFn = func(WorkerConfig) (worker.Worker, error)
 type ManifoldConfig struct {
 	DBAccessor string
 
+	// DurableConsumerMaxLag is the maximum number of change log entries that
+	// a durable consumer can fall behind, before it is evicted. If zero, the
+	// default is used.
+	DurableConsumerMaxLag int64
+
 	Clock     clock.Clock
 	Logger    logger.Logger
 	NewWorker NewWorkerFn
@@ -32,6 +37,9 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.DBAccessor == "" {
 		return errors.NotValidf("empty DBAccessorName")
 	}
+	if cfg.DurableConsumerMaxLag < 0 {
+		return errors.NotValidf("negative DurableConsumerMaxLag")
+	}
 	if cfg.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
@@ -62,9 +70,10 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			}
 
 			cfg := WorkerConfig{
-				DBGetter: dbGetter,
-				Clock:    config.Clock,
-				Logger:   config.Logger,
+				DBGetter:              dbGetter,
+				DurableConsumerMaxLag: config.DurableConsumerMaxLag,
+				Clock:                 config.Clock,
+				Logger:                config.Logger,
 			}
 
 			w, err := config.NewWorker(cfg)
@@ -31,6 +31,12 @@ const (
 	// watermarks are outside of this window, they will not be selected and the
 	// pruner will discard those watermarks.
 	defaultWindowDuration = time.Minute * 10
+
+	// defaultDurableConsumerMaxLag is the default maximum number of change
+	// log entries that a durable consumer can fall behind the latest change,
+	// before the pruner evicts it. This prevents a stalled consumer from
+	// causing the change log to grow without bound.
+	defaultDurableConsumerMaxLag = 100000
 )
 
 var (
@@ -48,6 +54,11 @@ type WorkerConfig struct {
 	DBGetter DBGetter
 	Clock    clock.Clock
 	Logger   logger.Logger
+
+	// DurableConsumerMaxLag is the maximum number of change log entries that
+	// a durable consumer can fall behind, before it is evicted. If zero,
+	// defaultDurableConsumerMaxLag is used.
+	DurableConsumerMaxLag int64
 }
 
 // Validate ensures that the config values are valid.
@@ -61,6 +72,9 @@ func (c *WorkerConfig) Validate() error {
 	if c.Logger == nil {
 		return errors.NotValidf("missing logger")
 	}
+	if c.DurableConsumerMaxLag < 0 {
+		return errors.NotValidf("negative durable consumer max lag")
+	}
 	return nil
 }
 
@@ -74,6 +88,10 @@ type Pruner struct {
 	// determine if the change stream is keeping up with the pruner. If the
 	// watermark is outside of the window, we should log a warning message.
 	windows map[string]window
+
+	// durableConsumerMaxLag is the maximum number of change log entries that
+	// a durable consumer can fall behind, before it is evicted.
+	durableConsumerMaxLag int64
 }
 
 // New creates a new Pruner.
@@ -84,8 +102,12 @@ func newWorker(cfg WorkerConfig) (*Pruner, error) {
 	}
 
 	pruner := &Pruner{
-		cfg:     cfg,
-		windows: make(map[string]window),
+		cfg:                   cfg,
+		windows:               make(map[string]window),
+		durableConsumerMaxLag: defaultDurableConsumerMaxLag,
+	}
+	if cfg.DurableConsumerMaxLag > 0 {
+		pruner.durableConsumerMaxLag = cfg.DurableConsumerMaxLag
 	}
 
 	pruner.tomb.Go(pruner.loop)
@@ -232,6 +254,13 @@ func (w *Pruner) pruneModel(ctx context.Context, namespace string) (int64, error
 			return errors.Annotatef(err, "failed to locate lowest watermark")
 		}
 
+		// Ensure that we retain the changes that durable consumers have not
+		// yet seen.
+		lowest, err = w.honourDurableConsumers(ctx, tx, namespace, lowest)
+		if err != nil {
+			return errors.Annotatef(err, "failed to honour durable consumers")
+		}
+
 		// Prune the change log, using the lowest watermark.
 		pruned, err = w.deleteChangeLog(ctx, tx, lowest)
 		return errors.Annotatef(err, "failed to prune change log")
@@ -286,6 +315,57 @@ func (w *Pruner) locateLowestWatermark(ctx context.Context, tx *sqlair.TX, names
 	return sorted[0], nil
 }
 
+var (
+	selectDurableConsumersQuery = sqlair.MustPrepare(`
+SELECT (consumer_id, last_seen_id) AS (&DurableConsumer.*)
+FROM change_log_durable_consumer
+WHERE evicted_at IS NULL;`, DurableConsumer{})
+
+	selectLatestChangeLogIDQuery = sqlair.MustPrepare(`
+SELECT IFNULL(MAX(id), -1) AS &ChangeLogID.id FROM change_log;`, ChangeLogID{})
+
+	evictDurableConsumerQuery = sqlair.MustPrepare(`
+UPDATE change_log_durable_consumer
+SET evicted_at = DATETIME('now')
+WHERE consumer_id = $DurableConsumer.consumer_id;`, DurableConsumer{})
+)
+
+// honourDurableConsumers lowers the watermark, so that any change log entries
+// that a durable consumer has not yet seen are retained. Consumers that have
+// fallen too far behind the latest change are evicted, and must resync.
+func (w *Pruner) honourDurableConsumers(ctx context.Context, tx *sqlair.TX, namespace string, lowest Watermark) (Watermark, error) {
+	var consumers []DurableConsumer
+	if err := tx.Query(ctx, selectDurableConsumersQuery).GetAll(&consumers); errors.Is(err, sqlair.ErrNoRows) {
+		return lowest, nil
+	} else if err != nil {
+		return Watermark{}, errors.Trace(err)
+	}
+
+	var latest ChangeLogID
+	if err := tx.Query(ctx, selectLatestChangeLogIDQuery).Get(&latest); err != nil {
+		return Watermark{}, errors.Trace(err)
+	}
+
+	for _, consumer := range consumers {
+		// Nothing to retain for this consumer.
+		if consumer.LastSeenID >= lowest.LowerBound {
+			continue
+		}
+
+		if latest.ID-consumer.LastSeenID > w.durableConsumerMaxLag {
+			w.cfg.Logger.Warningf(context.TODO(), "namespace %s durable consumer %q is more than %d changes behind, evicting", namespace, consumer.ConsumerID, w.durableConsumerMaxLag)
+
+			if err := tx.Query(ctx, evictDurableConsumerQuery, consumer).Run(); err != nil {
+				return Watermark{}, errors.Annotatef(err, "evicting durable consumer %q", consumer.ConsumerID)
+			}
+			continue
+		}
+
+		lowest.LowerBound = consumer.LastSeenID
+	}
+	return lowest, nil
+}
+
 var deleteQuery = sqlair.MustPrepare(`DELETE FROM change_log WHERE id <= $M.id;`, sqlair.M{})
 
 func (w *Pruner) deleteChangeLog(ctx context.Context, tx *sqlair.TX, lowest Watermark) (int64, error) {
@@ -337,6 +417,17 @@ type Watermark struct {
 	UpdatedAt    time.Time `db:"updated_at"`
 }
 
+// DurableConsumer represents a row from the change_log_durable_consumer table.
+type DurableConsumer struct {
+	ConsumerID string `db:"consumer_id"`
+	LastSeenID int64  `db:"last_seen_id"`
+}
+
+// ChangeLogID represents the ID of a change log entry.
+type ChangeLogID struct {
+	ID int64 `db:"id"`
+}
+
 type window struct {
 	start, end time.Time
 }
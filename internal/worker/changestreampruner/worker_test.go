@@ -41,6 +41,10 @@ func (s *workerSuite) TestValidateConfig(c *gc.C) {
 	cfg = s.getConfig(c)
 	cfg.DBGetter = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.DurableConsumerMaxLag = -1
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *workerSuite) getConfig(c *gc.C) WorkerConfig {
@@ -91,6 +95,64 @@ func (s *workerSuite) TestPruneControllerNS(c *gc.C) {
 	})
 }
 
+func (s *workerSuite) TestPruneControllerNSWithDurableConsumer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerDBGet()
+	s.expectClock()
+
+	pruner := s.newPruner(c)
+
+	now := time.Now()
+
+	s.insertControllerNodes(c, 1)
+	s.insertChangeLogWitness(c, s.TxnRunner(), Watermark{ControllerID: "0", LowerBound: 1002, UpdatedAt: now.Add(-time.Minute)})
+	s.truncateChangeLog(c, s.TxnRunner())
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 10, now)
+	s.insertDurableConsumers(c, s.TxnRunner(), DurableConsumer{ConsumerID: "audit", LastSeenID: 1000})
+
+	result, err := pruner.prune()
+	c.Check(err, jc.ErrorIsNil)
+
+	// The durable consumer has only seen the first change, so only that
+	// change is pruned.
+	c.Check(result, gc.DeepEquals, map[string]int64{
+		coredatabase.ControllerNS: 1,
+	})
+	s.expectEvictedDurableConsumers(c, s.TxnRunner(), nil)
+}
+
+func (s *workerSuite) TestPruneControllerNSEvictsLaggingDurableConsumer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerDBGet()
+	s.expectClock()
+
+	pruner := s.newPruner(c)
+	pruner.durableConsumerMaxLag = 8
+
+	now := time.Now()
+
+	s.insertControllerNodes(c, 1)
+	s.insertChangeLogWitness(c, s.TxnRunner(), Watermark{ControllerID: "0", LowerBound: 1002, UpdatedAt: now.Add(-time.Minute)})
+	s.truncateChangeLog(c, s.TxnRunner())
+	s.insertChangeLogItems(c, s.TxnRunner(), 0, 10, now)
+	s.insertDurableConsumers(c, s.TxnRunner(),
+		DurableConsumer{ConsumerID: "audit", LastSeenID: 1000},
+		DurableConsumer{ConsumerID: "cmdb", LastSeenID: 1001},
+	)
+
+	result, err := pruner.prune()
+	c.Check(err, jc.ErrorIsNil)
+
+	// The audit consumer is more than 8 changes behind the latest change, so
+	// it is evicted and the cmdb consumer is honoured.
+	c.Check(result, gc.DeepEquals, map[string]int64{
+		coredatabase.ControllerNS: 2,
+	})
+	s.expectEvictedDurableConsumers(c, s.TxnRunner(), []string{"audit"})
+}
+
 func (s *workerSuite) TestPruneModelList(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -514,7 +576,8 @@ func (s *workerSuite) newPrunerWithLogger(c *gc.C, logger logger.Logger) *Pruner
 			Clock:    s.clock,
 			Logger:   logger,
 		},
-		windows: make(map[string]window),
+		windows:               make(map[string]window),
+		durableConsumerMaxLag: defaultDurableConsumerMaxLag,
 	}
 }
 
@@ -581,6 +644,48 @@ VALUES ($M.id, 4, 2, 0, $M.created_at);
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *workerSuite) insertDurableConsumers(c *gc.C, runner coredatabase.TxnRunner, consumers ...DurableConsumer) {
+	query, err := sqlair.Prepare(`
+INSERT INTO change_log_durable_consumer (consumer_id, last_seen_id)
+VALUES ($DurableConsumer.consumer_id, $DurableConsumer.last_seen_id);
+			`, DurableConsumer{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = runner.Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		for _, consumer := range consumers {
+			err := tx.Query(ctx, query, consumer).Run()
+			c.Assert(err, jc.ErrorIsNil)
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *workerSuite) expectEvictedDurableConsumers(c *gc.C, runner coredatabase.TxnRunner, expected []string) {
+	query, err := sqlair.Prepare(`
+SELECT &DurableConsumer.consumer_id FROM change_log_durable_consumer
+WHERE evicted_at IS NOT NULL
+ORDER BY consumer_id;
+`, DurableConsumer{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var got []DurableConsumer
+	err = runner.Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, query).GetAll(&got)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var ids []string
+	for _, consumer := range got {
+		ids = append(ids, consumer.ConsumerID)
+	}
+	c.Check(ids, jc.DeepEquals, expected)
+}
+
 func (s *workerSuite) expectChangeLogWitnesses(c *gc.C, runner coredatabase.TxnRunner, watermarks []Watermark) {
 	query, err := sqlair.Prepare(`
 SELECT (controller_id, lower_bound, updated_at) AS (&Watermark.*) FROM change_log_witness;
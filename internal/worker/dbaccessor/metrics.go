@@ -22,6 +22,10 @@ type Collector struct {
 	DBSuccess   *prometheus.CounterVec
 	TxnRequests *prometheus.CounterVec
 	TxnRetries  *prometheus.CounterVec
+
+	TxnCallerDuration *prometheus.HistogramVec
+	TxnCallerAttempts *prometheus.HistogramVec
+	TxnCallerBusyWait *prometheus.HistogramVec
 }
 
 // NewMetricsCollector returns a new Collector.
@@ -63,6 +67,25 @@ func NewMetricsCollector() *Collector {
 			Name:      "txn_retries_total",
 			Help:      "Total number of txn retries.",
 		}, []string{"namespace"}),
+		TxnCallerDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: dbaccessorMetricsNamespace,
+			Subsystem: dbaccessorSubsystemNamespace,
+			Name:      "txn_caller_duration_seconds",
+			Help:      "Total time spent in a txn, including retries, by caller.",
+		}, []string{"namespace", "caller"}),
+		TxnCallerAttempts: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: dbaccessorMetricsNamespace,
+			Subsystem: dbaccessorSubsystemNamespace,
+			Name:      "txn_caller_attempts",
+			Help:      "Number of attempts to complete a txn, by caller.",
+			Buckets:   []float64{1, 2, 3, 5, 10, 25, 50, 100, 250},
+		}, []string{"namespace", "caller"}),
+		TxnCallerBusyWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: dbaccessorMetricsNamespace,
+			Subsystem: dbaccessorSubsystemNamespace,
+			Name:      "txn_caller_busy_wait_seconds",
+			Help:      "Time spent waiting on a busy or locked db in a txn, by caller.",
+		}, []string{"namespace", "caller"}),
 	}
 }
 
@@ -74,6 +97,9 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.DBSuccess.Describe(ch)
 	c.TxnRequests.Describe(ch)
 	c.TxnRetries.Describe(ch)
+	c.TxnCallerDuration.Describe(ch)
+	c.TxnCallerAttempts.Describe(ch)
+	c.TxnCallerBusyWait.Describe(ch)
 }
 
 // Collect is part of the prometheus.Collector interface.
@@ -84,6 +110,9 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.DBSuccess.Collect(ch)
 	c.TxnRequests.Collect(ch)
 	c.TxnRetries.Collect(ch)
+	c.TxnCallerDuration.Collect(ch)
+	c.TxnCallerAttempts.Collect(ch)
+	c.TxnCallerBusyWait.Collect(ch)
 }
 
 // DBMetricsForNamespace returns a Metrics implementation for the given
@@ -32,6 +32,7 @@ func (s *metricsSuite) TestMetricsAreCollected(c *gc.C) {
 		collector.DBSuccess.WithLabelValues("foo").Inc()
 		collector.TxnRequests.WithLabelValues("foo").Inc()
 		collector.TxnRetries.WithLabelValues("foo").Inc()
+		collector.TxnCallerAttempts.WithLabelValues("foo", "domain/foo/service.(*Service).Bar").Observe(3)
 	}()
 
 	select {
@@ -66,6 +67,20 @@ juju_db_requests_total{namespace="foo"} 1
 # HELP juju_db_success_total Total number of successful db operations.
 # TYPE juju_db_success_total counter
 juju_db_success_total{namespace="foo"} 1
+# HELP juju_db_txn_caller_attempts Number of attempts to complete a txn, by caller.
+# TYPE juju_db_txn_caller_attempts histogram
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="1"} 0
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="2"} 0
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="3"} 1
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="5"} 1
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="10"} 1
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="25"} 1
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="50"} 1
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="100"} 1
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="250"} 1
+juju_db_txn_caller_attempts_bucket{caller="domain/foo/service.(*Service).Bar",namespace="foo",le="+Inf"} 1
+juju_db_txn_caller_attempts_sum{caller="domain/foo/service.(*Service).Bar",namespace="foo"} 3
+juju_db_txn_caller_attempts_count{caller="domain/foo/service.(*Service).Bar",namespace="foo"} 1
 # HELP juju_db_txn_requests_total Total number of txn requests including retries.
 # TYPE juju_db_txn_requests_total counter
 juju_db_txn_requests_total{namespace="foo"} 1
@@ -82,6 +97,7 @@ juju_db_txn_retries_total{namespace="foo"} 1
 		"juju_db_success_total",
 		"juju_db_txn_requests_total",
 		"juju_db_txn_retries_total",
+		"juju_db_txn_caller_attempts",
 	)
 	if !c.Check(err, jc.ErrorIsNil) {
 		c.Logf("\nerror:\n%v", err)
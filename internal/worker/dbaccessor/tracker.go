@@ -209,7 +209,7 @@ func (w *trackedDBWorker) ensureModelDBInitialised(ctx context.Context) error {
 // This is the function that almost all downstream database consumers
 // should use.
 func (w *trackedDBWorker) Txn(ctx context.Context, fn func(context.Context, *sqlair.TX) error) error {
-	return w.run(ctx, func(db *sqlair.DB) error {
+	return w.run(ctx, func(ctx context.Context, db *sqlair.DB) error {
 		// Tie the worker tomb to the context, so that if the worker dies, we
 		// can correctly kill the transaction via the context. The context will
 		// now have the correct reason for the death of the transaction. Either
@@ -225,7 +225,7 @@ func (w *trackedDBWorker) Txn(ctx context.Context, fn func(context.Context, *sql
 // This is the function that almost all downstream database consumers
 // should use.
 func (w *trackedDBWorker) StdTxn(ctx context.Context, fn func(context.Context, *sql.Tx) error) error {
-	return w.run(ctx, func(db *sqlair.DB) error {
+	return w.run(ctx, func(ctx context.Context, db *sqlair.DB) error {
 		// Tie the worker tomb to the context, so that if the worker dies, we
 		// can correctly kill the transaction via the context. The context will
 		// now have the correct reason for the death of the transaction. Either
@@ -240,7 +240,7 @@ func (w *trackedDBWorker) Err() error {
 	return w.tomb.Err()
 }
 
-func (w *trackedDBWorker) run(ctx context.Context, fn func(*sqlair.DB) error) error {
+func (w *trackedDBWorker) run(ctx context.Context, fn func(context.Context, *sqlair.DB) error) error {
 	w.metrics.TxnRequests.WithLabelValues(w.namespace).Inc()
 
 	// Tie the tomb to the context for the retry semantics.
@@ -249,9 +249,18 @@ func (w *trackedDBWorker) run(ctx context.Context, fn func(*sqlair.DB) error) er
 	// Inject the metrics into the context for the txn.
 	ctx = txn.WithMetrics(ctx, w.dbTxnMetrics)
 
+	// Inject the stats into the context, so that the txn can be attributed
+	// to the caller across all the retries.
+	stats := txn.NewStats()
+	ctx = txn.WithStats(ctx, stats)
+
+	start := w.clock.Now()
+	defer w.meterTxnCaller(start, stats)
+
 	// Retry the so long as the tomb and the context are valid.
 	return database.Retry(ctx, func() (err error) {
 		begin := w.clock.Now()
+		stats.RecordAttempt(begin)
 		w.metrics.TxnRetries.WithLabelValues(w.namespace).Inc()
 		w.metrics.DBRequests.WithLabelValues(w.namespace).Inc()
 		defer w.meterDBOpResult(begin, err)
@@ -273,7 +282,7 @@ func (w *trackedDBWorker) run(ctx context.Context, fn func(*sqlair.DB) error) er
 			return errors.Trace(err)
 		}
 
-		return fn(db)
+		return fn(ctx, db)
 	})
 }
 
@@ -288,6 +297,24 @@ func (w *trackedDBWorker) meterDBOpResult(begin time.Time, err error) {
 	w.metrics.DBDuration.WithLabelValues(w.namespace, result).Observe(w.clock.Now().Sub(begin).Seconds())
 }
 
+// meterTxnCaller records the duration, attempts and time spent waiting on a
+// busy database for the caller of the txn.
+func (w *trackedDBWorker) meterTxnCaller(start time.Time, stats *txn.Stats) {
+	caller := stats.Caller()
+	duration := w.clock.Now().Sub(start)
+
+	w.metrics.TxnCallerDuration.WithLabelValues(w.namespace, caller).Observe(duration.Seconds())
+	w.metrics.TxnCallerAttempts.WithLabelValues(w.namespace, caller).Observe(float64(stats.Attempts()))
+	w.metrics.TxnCallerBusyWait.WithLabelValues(w.namespace, caller).Observe(stats.BusyWait().Seconds())
+
+	w.report.Set(func(r *report) {
+		if duration > r.maxTxnDuration {
+			r.maxTxnDuration = duration
+			r.maxTxnCaller = caller
+		}
+	})
+}
+
 // Kill implements worker.Worker
 func (w *trackedDBWorker) Kill() {
 	w.tomb.Kill(nil)
@@ -489,6 +516,11 @@ type report struct {
 	// dbReplacements is the number of times the database has been replaced
 	// due to a failed ping.
 	dbReplacements uint32
+	// maxTxnDuration is the maximum duration of a txn, including retries,
+	// for a given lifetime of the worker.
+	maxTxnDuration time.Duration
+	// maxTxnCaller is the caller of the txn with the maximum duration.
+	maxTxnCaller string
 }
 
 // Report provides information for the engine report.
@@ -501,6 +533,8 @@ func (r *report) Report() map[string]any {
 		"last-ping-attempts": r.pingAttempts,
 		"max-ping-duration":  r.maxPingDuration.String(),
 		"db-replacements":    r.dbReplacements,
+		"max-txn-duration":   r.maxTxnDuration.String(),
+		"max-txn-caller":     r.maxTxnCaller,
 	}
 }
 
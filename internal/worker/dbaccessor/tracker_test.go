@@ -4,6 +4,7 @@
 package dbaccessor
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4/workertest"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
@@ -65,6 +67,8 @@ func (s *trackedDBWorkerSuite) TestWorkerReport(c *gc.C) {
 		"max-ping-duration",
 		"last-ping-attempts",
 		"last-ping-duration",
+		"max-txn-caller",
+		"max-txn-duration",
 	})
 
 	workertest.CleanKill(c, w)
@@ -157,6 +161,56 @@ func (s *trackedDBWorkerSuite) TestWorkerStdTxnIsNotNil(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *trackedDBWorkerSuite) TestWorkerTxnMetricsCaller(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+	defer s.expectTimer(0)()
+
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").Return(s.DB(), nil)
+
+	collector := NewMetricsCollector()
+	w, err := newTrackedDBWorker(context.Background(),
+		s.states,
+		s.dbApp, "controller",
+		WithClock(s.clock),
+		WithLogger(s.logger),
+		WithPingDBFunc(defaultPingDBFunc),
+		WithMetricsCollector(collector),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	// The transaction isn't traced, but the caller is still recorded.
+	err = w.StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	expected := bytes.NewBuffer([]byte(`
+# HELP juju_db_txn_caller_attempts Number of attempts to complete a txn, by caller.
+# TYPE juju_db_txn_caller_attempts histogram
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="1"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="2"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="3"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="5"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="10"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="25"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="50"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="100"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="250"} 1
+juju_db_txn_caller_attempts_bucket{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller",le="+Inf"} 1
+juju_db_txn_caller_attempts_sum{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller"} 1
+juju_db_txn_caller_attempts_count{caller="internal/worker/dbaccessor.(*trackedDBWorkerSuite).TestWorkerTxnMetricsCaller",namespace="controller"} 1
+		`[1:]))
+	err = testutil.CollectAndCompare(collector, expected, "juju_db_txn_caller_attempts")
+	if !c.Check(err, jc.ErrorIsNil) {
+		c.Logf("\nerror:\n%v", err)
+	}
+
+	workertest.CleanKill(c, w)
+}
+
 func (s *trackedDBWorkerSuite) TestWorkerAttemptsToVerifyDB(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -19,7 +19,9 @@ import (
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/database"
+	coreschema "github.com/juju/juju/core/database/schema"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/schema"
 	"github.com/juju/juju/internal/database/client"
 	"github.com/juju/juju/internal/database/dqlite"
 	"github.com/juju/juju/internal/worker"
@@ -234,6 +236,10 @@ func (w *dbReplWorker) loop() (err error) {
 			w.execViews(ctx)
 		case ".ddl":
 			w.execShowDDL(ctx, args[1:])
+		case ".drift":
+			w.execDrift(ctx, args[1:])
+		case ".repair":
+			w.execRepair(ctx)
 
 		default:
 			if err := w.executeQuery(ctx, w.currentDB, input); err != nil {
@@ -331,6 +337,96 @@ func (w *dbReplWorker) execViews(ctx context.Context) {
 	}
 }
 
+func (w *dbReplWorker) execDrift(ctx context.Context, args []string) {
+	if len(args) == 1 && args[0] == "all" {
+		w.execDriftAll(ctx)
+		return
+	} else if len(args) != 0 {
+		fmt.Fprintln(w.cfg.Stderr, "usage: .drift [all]")
+		return
+	}
+
+	w.printDrift(ctx, w.currentNamespace, w.currentSchema(), w.currentDB)
+}
+
+func (w *dbReplWorker) execDriftAll(ctx context.Context) {
+	w.printDrift(ctx, "controller", schema.ControllerDDL(), w.controllerDB)
+
+	var models [][2]string
+	if err := w.controllerDB.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT uuid, name FROM model")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var uuid, name string
+			if err := rows.Scan(&uuid, &name); err != nil {
+				return err
+			}
+			models = append(models, [2]string{uuid, name})
+		}
+		return rows.Err()
+	}); err != nil {
+		w.cfg.Logger.Errorf(context.TODO(), "failed to select models: %v", err)
+		return
+	}
+
+	for _, model := range models {
+		db, err := w.dbGetter.GetDB(model[0])
+		if err != nil {
+			fmt.Fprintf(w.cfg.Stderr, "failed to get database for model %q: %v\n", model[1], err)
+			continue
+		}
+		w.printDrift(ctx, "model-"+model[1], schema.ModelDDL(), db)
+	}
+}
+
+func (w *dbReplWorker) printDrift(ctx context.Context, namespace string, ddl *coreschema.Schema, db database.TxnRunner) {
+	report, err := ddl.CheckDrift(ctx, db)
+	if err != nil {
+		fmt.Fprintf(w.cfg.Stderr, "failed to check schema drift for %q: %v\n", namespace, err)
+		return
+	}
+	if report.OK() {
+		fmt.Fprintf(w.cfg.Stdout, "%s: schema version %d, no drift\n", namespace, report.Version)
+		return
+	}
+
+	fmt.Fprintf(w.cfg.Stdout, "%s: schema version %d of %d\n", namespace, report.Version, report.Expected)
+	for _, drift := range report.Drift {
+		repairable := ""
+		if drift.Repairable() {
+			repairable = " (repairable)"
+		}
+		fmt.Fprintf(w.cfg.Stdout, "  %s%s\n", drift, repairable)
+	}
+}
+
+func (w *dbReplWorker) execRepair(ctx context.Context) {
+	repaired, err := w.currentSchema().RepairDrift(ctx, w.currentDB)
+	if err != nil {
+		fmt.Fprintf(w.cfg.Stderr, "failed to repair schema drift for %q: %v\n", w.currentNamespace, err)
+		return
+	}
+	if len(repaired) == 0 {
+		fmt.Fprintf(w.cfg.Stdout, "%s: nothing to repair\n", w.currentNamespace)
+		return
+	}
+	for _, drift := range repaired {
+		fmt.Fprintf(w.cfg.Stdout, "%s: recreated %s %q\n", w.currentNamespace, drift.Object.Type, drift.Object.Name)
+	}
+}
+
+// currentSchema returns the schema of the current database.
+func (w *dbReplWorker) currentSchema() *coreschema.Schema {
+	if w.currentNamespace == "controller" {
+		return schema.ControllerDDL()
+	}
+	return schema.ModelDDL()
+}
+
 // Kill is part of the worker.Worker interface.
 func (w *dbReplWorker) Kill() {
 	w.tomb.Kill(nil)
@@ -430,5 +526,9 @@ The following commands are available:
   .triggers                Show all trigger tables in the current database.
   .views                   Show all views in the current database.
   .ddl <name>              Show the DDL for the specified table, trigger, or view.
+  .drift                   Show how the schema of the current database has drifted.
+  .drift all               Show how the schema of every database has drifted.
+  .repair                  Recreate missing or modified indexes, triggers and views
+                           in the current database.
 
 `
@@ -18,8 +18,10 @@ import (
 	service1 "github.com/juju/juju/domain/annotation/service"
 	service2 "github.com/juju/juju/domain/application/service"
 	service3 "github.com/juju/juju/domain/autocert/service"
+	service33 "github.com/juju/juju/domain/backup/service"
 	service4 "github.com/juju/juju/domain/blockcommand/service"
 	service5 "github.com/juju/juju/domain/blockdevice/service"
+	service32 "github.com/juju/juju/domain/changelog/service"
 	service6 "github.com/juju/juju/domain/cloud/service"
 	service7 "github.com/juju/juju/domain/cloudimagemetadata/service"
 	service8 "github.com/juju/juju/domain/controller/service"
@@ -38,6 +40,7 @@ import (
 	service21 "github.com/juju/juju/domain/modeldefaults/service"
 	service22 "github.com/juju/juju/domain/modelmigration/service"
 	service23 "github.com/juju/juju/domain/network/service"
+	service34 "github.com/juju/juju/domain/objectstore/service"
 	service24 "github.com/juju/juju/domain/port/service"
 	service25 "github.com/juju/juju/domain/proxy/service"
 	service26 "github.com/juju/juju/domain/resource/service"
@@ -47,6 +50,7 @@ import (
 	stub "github.com/juju/juju/domain/stub"
 	service30 "github.com/juju/juju/domain/unitstate/service"
 	service31 "github.com/juju/juju/domain/upgrade/service"
+	service35 "github.com/juju/juju/domain/workloadmetrics/service"
 	services "github.com/juju/juju/internal/services"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -150,6 +154,44 @@ func (c *MockControllerDomainServicesAutocertCacheCall) DoAndReturn(f func() *se
 	return c
 }
 
+// Backup mocks base method.
+func (m *MockControllerDomainServices) Backup() *service33.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup")
+	ret0, _ := ret[0].(*service33.Service)
+	return ret0
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockControllerDomainServicesMockRecorder) Backup() *MockControllerDomainServicesBackupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockControllerDomainServices)(nil).Backup))
+	return &MockControllerDomainServicesBackupCall{Call: call}
+}
+
+// MockControllerDomainServicesBackupCall wrap *gomock.Call
+type MockControllerDomainServicesBackupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerDomainServicesBackupCall) Return(arg0 *service33.Service) *MockControllerDomainServicesBackupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerDomainServicesBackupCall) Do(f func() *service33.Service) *MockControllerDomainServicesBackupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerDomainServicesBackupCall) DoAndReturn(f func() *service33.Service) *MockControllerDomainServicesBackupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Cloud mocks base method.
 func (m *MockControllerDomainServices) Cloud() *service6.WatchableService {
 	m.ctrl.T.Helper()
@@ -857,6 +899,44 @@ func (c *MockModelDomainServicesBlockDeviceCall) DoAndReturn(f func() *service5.
 	return c
 }
 
+// ChangeLog mocks base method.
+func (m *MockModelDomainServices) ChangeLog() *service32.WatchableService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeLog")
+	ret0, _ := ret[0].(*service32.WatchableService)
+	return ret0
+}
+
+// ChangeLog indicates an expected call of ChangeLog.
+func (mr *MockModelDomainServicesMockRecorder) ChangeLog() *MockModelDomainServicesChangeLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeLog", reflect.TypeOf((*MockModelDomainServices)(nil).ChangeLog))
+	return &MockModelDomainServicesChangeLogCall{Call: call}
+}
+
+// MockModelDomainServicesChangeLogCall wrap *gomock.Call
+type MockModelDomainServicesChangeLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesChangeLogCall) Return(arg0 *service32.WatchableService) *MockModelDomainServicesChangeLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesChangeLogCall) Do(f func() *service32.WatchableService) *MockModelDomainServicesChangeLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesChangeLogCall) DoAndReturn(f func() *service32.WatchableService) *MockModelDomainServicesChangeLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CloudImageMetadata mocks base method.
 func (m *MockModelDomainServices) CloudImageMetadata() *service7.Service {
 	m.ctrl.T.Helper()
@@ -1085,6 +1165,44 @@ func (c *MockModelDomainServicesMachineCall) DoAndReturn(f func() *service17.Wat
 	return c
 }
 
+// ModelBackup mocks base method.
+func (m *MockModelDomainServices) ModelBackup() *service33.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelBackup")
+	ret0, _ := ret[0].(*service33.Service)
+	return ret0
+}
+
+// ModelBackup indicates an expected call of ModelBackup.
+func (mr *MockModelDomainServicesMockRecorder) ModelBackup() *MockModelDomainServicesModelBackupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelBackup", reflect.TypeOf((*MockModelDomainServices)(nil).ModelBackup))
+	return &MockModelDomainServicesModelBackupCall{Call: call}
+}
+
+// MockModelDomainServicesModelBackupCall wrap *gomock.Call
+type MockModelDomainServicesModelBackupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesModelBackupCall) Return(arg0 *service33.Service) *MockModelDomainServicesModelBackupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesModelBackupCall) Do(f func() *service33.Service) *MockModelDomainServicesModelBackupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesModelBackupCall) DoAndReturn(f func() *service33.Service) *MockModelDomainServicesModelBackupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ModelInfo mocks base method.
 func (m *MockModelDomainServices) ModelInfo() *service18.ModelService {
 	m.ctrl.T.Helper()
@@ -1237,6 +1355,44 @@ func (c *MockModelDomainServicesNetworkCall) DoAndReturn(f func() *service23.Wat
 	return c
 }
 
+// ObjectStoreUsage mocks base method.
+func (m *MockModelDomainServices) ObjectStoreUsage() *service34.UsageService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStoreUsage")
+	ret0, _ := ret[0].(*service34.UsageService)
+	return ret0
+}
+
+// ObjectStoreUsage indicates an expected call of ObjectStoreUsage.
+func (mr *MockModelDomainServicesMockRecorder) ObjectStoreUsage() *MockModelDomainServicesObjectStoreUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStoreUsage", reflect.TypeOf((*MockModelDomainServices)(nil).ObjectStoreUsage))
+	return &MockModelDomainServicesObjectStoreUsageCall{Call: call}
+}
+
+// MockModelDomainServicesObjectStoreUsageCall wrap *gomock.Call
+type MockModelDomainServicesObjectStoreUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesObjectStoreUsageCall) Return(arg0 *service34.UsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesObjectStoreUsageCall) Do(f func() *service34.UsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesObjectStoreUsageCall) DoAndReturn(f func() *service34.UsageService) *MockModelDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Port mocks base method.
 func (m *MockModelDomainServices) Port() *service24.WatchableService {
 	m.ctrl.T.Helper()
@@ -1503,6 +1659,44 @@ func (c *MockModelDomainServicesUnitStateCall) DoAndReturn(f func() *service30.S
 	return c
 }
 
+// WorkloadMetrics mocks base method.
+func (m *MockModelDomainServices) WorkloadMetrics() *service35.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadMetrics")
+	ret0, _ := ret[0].(*service35.Service)
+	return ret0
+}
+
+// WorkloadMetrics indicates an expected call of WorkloadMetrics.
+func (mr *MockModelDomainServicesMockRecorder) WorkloadMetrics() *MockModelDomainServicesWorkloadMetricsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadMetrics", reflect.TypeOf((*MockModelDomainServices)(nil).WorkloadMetrics))
+	return &MockModelDomainServicesWorkloadMetricsCall{Call: call}
+}
+
+// MockModelDomainServicesWorkloadMetricsCall wrap *gomock.Call
+type MockModelDomainServicesWorkloadMetricsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesWorkloadMetricsCall) Return(arg0 *service35.Service) *MockModelDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesWorkloadMetricsCall) Do(f func() *service35.Service) *MockModelDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesWorkloadMetricsCall) DoAndReturn(f func() *service35.Service) *MockModelDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockDomainServices is a mock of DomainServices interface.
 type MockDomainServices struct {
 	ctrl     *gomock.Controller
@@ -1754,6 +1948,44 @@ func (c *MockDomainServicesAutocertCacheCall) DoAndReturn(f func() *service3.Ser
 	return c
 }
 
+// Backup mocks base method.
+func (m *MockDomainServices) Backup() *service33.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup")
+	ret0, _ := ret[0].(*service33.Service)
+	return ret0
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockDomainServicesMockRecorder) Backup() *MockDomainServicesBackupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockDomainServices)(nil).Backup))
+	return &MockDomainServicesBackupCall{Call: call}
+}
+
+// MockDomainServicesBackupCall wrap *gomock.Call
+type MockDomainServicesBackupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesBackupCall) Return(arg0 *service33.Service) *MockDomainServicesBackupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesBackupCall) Do(f func() *service33.Service) *MockDomainServicesBackupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesBackupCall) DoAndReturn(f func() *service33.Service) *MockDomainServicesBackupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // BlockCommand mocks base method.
 func (m *MockDomainServices) BlockCommand() *service4.Service {
 	m.ctrl.T.Helper()
@@ -1830,6 +2062,44 @@ func (c *MockDomainServicesBlockDeviceCall) DoAndReturn(f func() *service5.Watch
 	return c
 }
 
+// ChangeLog mocks base method.
+func (m *MockDomainServices) ChangeLog() *service32.WatchableService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeLog")
+	ret0, _ := ret[0].(*service32.WatchableService)
+	return ret0
+}
+
+// ChangeLog indicates an expected call of ChangeLog.
+func (mr *MockDomainServicesMockRecorder) ChangeLog() *MockDomainServicesChangeLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeLog", reflect.TypeOf((*MockDomainServices)(nil).ChangeLog))
+	return &MockDomainServicesChangeLogCall{Call: call}
+}
+
+// MockDomainServicesChangeLogCall wrap *gomock.Call
+type MockDomainServicesChangeLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesChangeLogCall) Return(arg0 *service32.WatchableService) *MockDomainServicesChangeLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesChangeLogCall) Do(f func() *service32.WatchableService) *MockDomainServicesChangeLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesChangeLogCall) DoAndReturn(f func() *service32.WatchableService) *MockDomainServicesChangeLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Cloud mocks base method.
 func (m *MockDomainServices) Cloud() *service6.WatchableService {
 	m.ctrl.T.Helper()
@@ -2400,6 +2670,44 @@ func (c *MockDomainServicesModelCall) DoAndReturn(f func() *service18.Service) *
 	return c
 }
 
+// ModelBackup mocks base method.
+func (m *MockDomainServices) ModelBackup() *service33.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelBackup")
+	ret0, _ := ret[0].(*service33.Service)
+	return ret0
+}
+
+// ModelBackup indicates an expected call of ModelBackup.
+func (mr *MockDomainServicesMockRecorder) ModelBackup() *MockDomainServicesModelBackupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelBackup", reflect.TypeOf((*MockDomainServices)(nil).ModelBackup))
+	return &MockDomainServicesModelBackupCall{Call: call}
+}
+
+// MockDomainServicesModelBackupCall wrap *gomock.Call
+type MockDomainServicesModelBackupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesModelBackupCall) Return(arg0 *service33.Service) *MockDomainServicesModelBackupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesModelBackupCall) Do(f func() *service33.Service) *MockDomainServicesModelBackupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesModelBackupCall) DoAndReturn(f func() *service33.Service) *MockDomainServicesModelBackupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ModelDefaults mocks base method.
 func (m *MockDomainServices) ModelDefaults() *service21.Service {
 	m.ctrl.T.Helper()
@@ -2590,6 +2898,44 @@ func (c *MockDomainServicesNetworkCall) DoAndReturn(f func() *service23.Watchabl
 	return c
 }
 
+// ObjectStoreUsage mocks base method.
+func (m *MockDomainServices) ObjectStoreUsage() *service34.UsageService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStoreUsage")
+	ret0, _ := ret[0].(*service34.UsageService)
+	return ret0
+}
+
+// ObjectStoreUsage indicates an expected call of ObjectStoreUsage.
+func (mr *MockDomainServicesMockRecorder) ObjectStoreUsage() *MockDomainServicesObjectStoreUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStoreUsage", reflect.TypeOf((*MockDomainServices)(nil).ObjectStoreUsage))
+	return &MockDomainServicesObjectStoreUsageCall{Call: call}
+}
+
+// MockDomainServicesObjectStoreUsageCall wrap *gomock.Call
+type MockDomainServicesObjectStoreUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesObjectStoreUsageCall) Return(arg0 *service34.UsageService) *MockDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesObjectStoreUsageCall) Do(f func() *service34.UsageService) *MockDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesObjectStoreUsageCall) DoAndReturn(f func() *service34.UsageService) *MockDomainServicesObjectStoreUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Port mocks base method.
 func (m *MockDomainServices) Port() *service24.WatchableService {
 	m.ctrl.T.Helper()
@@ -2932,6 +3278,44 @@ func (c *MockDomainServicesUpgradeCall) DoAndReturn(f func() *service31.Watchabl
 	return c
 }
 
+// WorkloadMetrics mocks base method.
+func (m *MockDomainServices) WorkloadMetrics() *service35.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadMetrics")
+	ret0, _ := ret[0].(*service35.Service)
+	return ret0
+}
+
+// WorkloadMetrics indicates an expected call of WorkloadMetrics.
+func (mr *MockDomainServicesMockRecorder) WorkloadMetrics() *MockDomainServicesWorkloadMetricsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadMetrics", reflect.TypeOf((*MockDomainServices)(nil).WorkloadMetrics))
+	return &MockDomainServicesWorkloadMetricsCall{Call: call}
+}
+
+// MockDomainServicesWorkloadMetricsCall wrap *gomock.Call
+type MockDomainServicesWorkloadMetricsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesWorkloadMetricsCall) Return(arg0 *service35.Service) *MockDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesWorkloadMetricsCall) Do(f func() *service35.Service) *MockDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesWorkloadMetricsCall) DoAndReturn(f func() *service35.Service) *MockDomainServicesWorkloadMetricsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockDomainServicesGetter is a mock of DomainServicesGetter interface.
 type MockDomainServicesGetter struct {
 	ctrl     *gomock.Controller
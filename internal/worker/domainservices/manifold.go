@@ -11,6 +11,7 @@ import (
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
 
+	"github.com/juju/juju/agent"
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
 	corehttp "github.com/juju/juju/core/http"
@@ -21,6 +22,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 	"github.com/juju/juju/internal/worker/common"
@@ -29,6 +31,7 @@ import (
 // ManifoldConfig holds the information necessary to run a domain services
 // worker in a dependency.Engine.
 type ManifoldConfig struct {
+	AgentName                   string
 	DBAccessorName              string
 	ChangeStreamName            string
 	ProviderFactoryName         string
@@ -53,6 +56,7 @@ type DomainServicesGetterFn func(
 	objectstore.ObjectStoreGetter,
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	envelope.KeyRing,
 	lease.Manager,
 	clock.Clock,
 	logger.Logger,
@@ -75,6 +79,7 @@ type ModelDomainServicesFn func(
 	objectstore.ModelObjectStoreGetter,
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	envelope.KeyRing,
 	lease.ModelLeaseManagerGetter,
 	clock.Clock,
 	logger.Logger,
@@ -82,6 +87,9 @@ type ModelDomainServicesFn func(
 
 // Validate validates the manifold configuration.
 func (config ManifoldConfig) Validate() error {
+	if config.AgentName == "" {
+		return errors.NotValidf("empty AgentName")
+	}
 	if config.DBAccessorName == "" {
 		return errors.NotValidf("empty DBAccessorName")
 	}
@@ -129,6 +137,7 @@ func (config ManifoldConfig) Validate() error {
 func Manifold(config ManifoldConfig) dependency.Manifold {
 	return dependency.Manifold{
 		Inputs: []string{
+			config.AgentName,
 			config.ChangeStreamName,
 			config.DBAccessorName,
 			config.ProviderFactoryName,
@@ -148,6 +157,15 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		return nil, errors.Trace(err)
 	}
 
+	var agent agent.Agent
+	if err := getter.Get(config.AgentName, &agent); err != nil {
+		return nil, errors.Trace(err)
+	}
+	secretContentKeys, err := secretContentKeys(agent.CurrentConfig())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	var dbGetter changestream.WatchableDBGetter
 	if err := getter.Get(config.ChangeStreamName, &dbGetter); err != nil {
 		return nil, errors.Trace(err)
@@ -195,6 +213,7 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		ObjectStoreGetter:           objectStoreGetter,
 		StorageRegistryGetter:       storageRegistryGetter,
 		PublicKeyImporter:           sshimporter.NewImporter(sshImporterClient),
+		SecretContentKeys:           secretContentKeys,
 		LeaseManager:                leaseManager,
 		Logger:                      config.Logger,
 		Clock:                       config.Clock,
@@ -204,6 +223,22 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 	})
 }
 
+// secretContentKeys returns the keys which wrap the keys encrypting secret
+// content stored in model databases. They are read once from the agent
+// config, rather than from the database, so that backups of the database
+// can't be used to decrypt the content.
+func secretContentKeys(agentConfig agent.Config) (envelope.KeyRing, error) {
+	info, ok := agentConfig.StateServingInfo()
+	if !ok || len(info.SecretContentKeys) == 0 {
+		return envelope.KeyRing{}, nil
+	}
+	keys, err := envelope.ParseKeyRing(info.SecretContentKeys)
+	if err != nil {
+		return envelope.KeyRing{}, errors.Annotate(err, "parsing secret content keys")
+	}
+	return keys, nil
+}
+
 func (config ManifoldConfig) output(in worker.Worker, out any) error {
 	if w, ok := in.(*common.CleanupWorker); ok {
 		in = w.Worker
@@ -250,6 +285,7 @@ func NewProviderTrackerModelDomainServices(
 	objectStore objectstore.ModelObjectStoreGetter,
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
+	secretContentKeys envelope.KeyRing,
 	leaseManager lease.ModelLeaseManagerGetter,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -262,6 +298,7 @@ func NewProviderTrackerModelDomainServices(
 		objectStore,
 		storageRegistry,
 		publicKeyImporter,
+		secretContentKeys,
 		leaseManager,
 		clock,
 		logger,
@@ -277,6 +314,7 @@ func NewDomainServicesGetter(
 	objectStoreGetter objectstore.ObjectStoreGetter,
 	storageRegistryGetter storage.StorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
+	secretContentKeys envelope.KeyRing,
 	leaseManager lease.Manager,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -291,6 +329,7 @@ func NewDomainServicesGetter(
 		objectStoreGetter:      objectStoreGetter,
 		storageRegistryGetter:  storageRegistryGetter,
 		publicKeyImporter:      publicKeyImporter,
+		secretContentKeys:      secretContentKeys,
 		leaseManager:           leaseManager,
 	}
 }
@@ -5,6 +5,7 @@ package domainservices
 
 import (
 	"context"
+	"encoding/base64"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
@@ -15,6 +16,7 @@ import (
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
 	corehttp "github.com/juju/juju/core/http"
@@ -25,6 +27,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -44,6 +47,10 @@ func (s *manifoldSuite) TestValidateConfig(c *gc.C) {
 	cfg.Logger = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig()
+	cfg.AgentName = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig()
 	cfg.DBAccessorName = ""
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
@@ -98,7 +105,13 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 
 	s.httpClientGetter.EXPECT().GetHTTPClient(gomock.Any(), corehttp.SSHImporterPurpose).Return(s.httpClient, nil)
 
+	s.agent.EXPECT().CurrentConfig().Return(s.agentConfig)
+	s.agentConfig.EXPECT().StateServingInfo().Return(controller.StateServingInfo{
+		SecretContentKeys: []string{base64.StdEncoding.EncodeToString(make([]byte, envelope.KeySize))},
+	}, true)
+
 	getter := map[string]any{
+		"agent":           s.agent,
 		"dbaccessor":      s.dbDeleter,
 		"changestream":    s.dbGetter,
 		"providerfactory": s.providerFactory,
@@ -109,6 +122,7 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 	}
 
 	manifold := Manifold(ManifoldConfig{
+		AgentName:                   "agent",
 		DBAccessorName:              "dbaccessor",
 		ChangeStreamName:            "changestream",
 		ProviderFactoryName:         "providerfactory",
@@ -223,6 +237,7 @@ func (s *manifoldSuite) TestNewModelDomainServices(c *gc.C) {
 		s.modelObjectStoreGetter,
 		s.modelStorageRegistryGetter,
 		s.publicKeyImporter,
+		envelope.KeyRing{},
 		s.modelLeaseManagerGetter,
 		s.clock,
 		s.logger,
@@ -240,6 +255,7 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *gc.C) {
 		s.objectStoreGetter,
 		s.storageRegistryGetter,
 		s.publicKeyImporter,
+		envelope.KeyRing{},
 		s.leaseManager,
 		s.clock,
 		s.logger,
@@ -250,8 +266,43 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *gc.C) {
 	c.Assert(modelFactory, gc.NotNil)
 }
 
+func (s *manifoldSuite) TestSecretContentKeys(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	key := make([]byte, envelope.KeySize)
+	s.agentConfig.EXPECT().StateServingInfo().Return(controller.StateServingInfo{
+		SecretContentKeys: []string{base64.StdEncoding.EncodeToString(key)},
+	}, true)
+
+	keys, err := secretContentKeys(s.agentConfig)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(keys.Active(), jc.DeepEquals, key)
+}
+
+func (s *manifoldSuite) TestSecretContentKeysNotController(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.agentConfig.EXPECT().StateServingInfo().Return(controller.StateServingInfo{}, false)
+
+	keys, err := secretContentKeys(s.agentConfig)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(keys.IsZero(), jc.IsTrue)
+}
+
+func (s *manifoldSuite) TestSecretContentKeysInvalid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.agentConfig.EXPECT().StateServingInfo().Return(controller.StateServingInfo{
+		SecretContentKeys: []string{"!!"},
+	}, true)
+
+	_, err := secretContentKeys(s.agentConfig)
+	c.Assert(err, gc.ErrorMatches, "parsing secret content keys: decoding key 0: .*")
+}
+
 func (s *manifoldSuite) getConfig() ManifoldConfig {
 	return ManifoldConfig{
+		AgentName:           "agent",
 		DBAccessorName:      "dbaccessor",
 		ChangeStreamName:    "changestream",
 		ProviderFactoryName: "providerfactory",
@@ -278,6 +329,7 @@ func noopDomainServicesGetter(
 	objectstore.ObjectStoreGetter,
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	envelope.KeyRing,
 	lease.Manager,
 	clock.Clock,
 	logger.Logger,
@@ -301,6 +353,7 @@ func noopModelDomainServices(
 	objectstore.ModelObjectStoreGetter,
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	envelope.KeyRing,
 	lease.ModelLeaseManagerGetter,
 	clock.Clock,
 	logger.Logger,
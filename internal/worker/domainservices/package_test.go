@@ -21,10 +21,12 @@ import (
 	domaintesting "github.com/juju/juju/domain/schema/testing"
 	domainservices "github.com/juju/juju/domain/services"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/envelope"
 	services "github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 )
 
+//go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination agent_mock_test.go github.com/juju/juju/agent Agent,Config
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination domainservices_mock_test.go github.com/juju/juju/internal/services ControllerDomainServices,ModelDomainServices,DomainServices,DomainServicesGetter
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination database_mock_test.go github.com/juju/juju/core/database DBDeleter
 //go:generate go run go.uber.org/mock/mockgen -typed -package domainservices -destination changestream_mock_test.go github.com/juju/juju/core/changestream WatchableDBGetter
@@ -41,10 +43,12 @@ func TestPackage(t *testing.T) {
 type baseSuite struct {
 	domaintesting.ControllerSuite
 
-	logger    logger.Logger
-	clock     clock.Clock
-	dbDeleter *MockDBDeleter
-	dbGetter  *MockWatchableDBGetter
+	logger      logger.Logger
+	clock       clock.Clock
+	agent       *MockAgent
+	agentConfig *MockConfig
+	dbDeleter   *MockDBDeleter
+	dbGetter    *MockWatchableDBGetter
 
 	domainServicesGetter     *MockDomainServicesGetter
 	controllerDomainServices *MockControllerDomainServices
@@ -75,6 +79,8 @@ func (s *baseSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	s.logger = loggertesting.WrapCheckLog(c)
 	s.clock = clock.WallClock
+	s.agent = NewMockAgent(ctrl)
+	s.agentConfig = NewMockConfig(ctrl)
 	s.dbDeleter = NewMockDBDeleter(ctrl)
 	s.dbGetter = NewMockWatchableDBGetter(ctrl)
 
@@ -113,6 +119,7 @@ func NewModelDomainServices(
 	objectStore objectstore.ModelObjectStoreGetter,
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
+	secretContentKeys envelope.KeyRing,
 	leaseManager lease.ModelLeaseManagerGetter,
 	clock clock.Clock,
 	logger logger.Logger,
@@ -125,6 +132,7 @@ func NewModelDomainServices(
 		objectStore,
 		storageRegistry,
 		publicKeyImporter,
+		secretContentKeys,
 		leaseManager,
 		clock,
 		logger,
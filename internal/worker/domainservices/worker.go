@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 	internalstorage "github.com/juju/juju/internal/storage"
 )
@@ -45,6 +46,10 @@ type Config struct {
 	// PublicKeyImporter is used to import public keys.
 	PublicKeyImporter domainservices.PublicKeyImporter
 
+	// SecretContentKeys wrap the keys which encrypt secret content
+	// stored in model databases.
+	SecretContentKeys envelope.KeyRing
+
 	// LeaseManager is used to manage leases.
 	LeaseManager lease.Manager
 
@@ -118,6 +123,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 			config.ObjectStoreGetter,
 			config.StorageRegistryGetter,
 			config.PublicKeyImporter,
+			config.SecretContentKeys,
 			config.LeaseManager,
 			config.Clock,
 			config.Logger,
@@ -184,6 +190,7 @@ type domainServicesGetter struct {
 	objectStoreGetter      objectstore.ObjectStoreGetter
 	storageRegistryGetter  storage.StorageRegistryGetter
 	publicKeyImporter      domainservices.PublicKeyImporter
+	secretContentKeys      envelope.KeyRing
 	leaseManager           lease.Manager
 }
 
@@ -204,6 +211,7 @@ func (s *domainServicesGetter) ServicesForModel(modelUUID coremodel.UUID) servic
 				storageRegistryGetter: s.storageRegistryGetter,
 			},
 			s.publicKeyImporter,
+			s.secretContentKeys,
 			modelApplicationLeaseManager{
 				modelUUID: modelUUID,
 				manager:   s.leaseManager,
@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
+	"github.com/juju/juju/internal/secrets/envelope"
 	"github.com/juju/juju/internal/services"
 )
 
@@ -103,6 +104,7 @@ func (s *workerSuite) getConfig() Config {
 			objectstore.ObjectStoreGetter,
 			storage.StorageRegistryGetter,
 			domainservices.PublicKeyImporter,
+			envelope.KeyRing,
 			lease.Manager,
 			clock.Clock,
 			logger.Logger,
@@ -124,6 +126,7 @@ func (s *workerSuite) getConfig() Config {
 			objectstore.ModelObjectStoreGetter,
 			storage.ModelStorageRegistryGetter,
 			domainservices.PublicKeyImporter,
+			envelope.KeyRing,
 			lease.ModelLeaseManagerGetter,
 			clock.Clock,
 			logger.Logger,
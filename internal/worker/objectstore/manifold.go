@@ -16,12 +16,14 @@ import (
 	"github.com/juju/juju/agent"
 	"github.com/juju/juju/controller"
 	coredependency "github.com/juju/juju/core/dependency"
+	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
 	coreobjectstore "github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/internal/objectstore"
 	"github.com/juju/juju/internal/services"
+	"github.com/juju/juju/internal/worker/apiremotecaller"
 	"github.com/juju/juju/internal/worker/common"
 	"github.com/juju/juju/internal/worker/trace"
 )
@@ -36,6 +38,10 @@ type MetadataServiceGetter interface {
 // ModelClaimGetter is the interface that is used to get a model claimer.
 type ModelClaimGetter interface {
 	ForModelUUID(model.UUID) (objectstore.Claimer, error)
+
+	// ForController returns the claimer for the controller-wide blob store,
+	// which is scoped to the controller rather than any one model.
+	ForController() (objectstore.Claimer, error)
 }
 
 // MetadataService is the interface that is used to get a object store.
@@ -58,6 +64,10 @@ type GetControllerConfigServiceFunc func(getter dependency.Getter, name string)
 // the manifold.
 type GetMetadataServiceFunc func(getter dependency.Getter, name string) (MetadataService, error)
 
+// GetBlobMetadataServiceFunc is a helper function that gets a service from
+// the manifold.
+type GetBlobMetadataServiceFunc func(getter dependency.Getter, name string) (BlobMetadataService, error)
+
 // IsBootstrapControllerFunc is a helper function that checks if the controller
 // is the initial bootstrap controller.
 type IsBootstrapControllerFunc func(dataDir string) bool
@@ -69,12 +79,16 @@ type ManifoldConfig struct {
 	ObjectStoreServicesName string
 	LeaseManagerName        string
 	S3ClientName            string
+	APIRemoteCallerName     string
+	HTTPClientName          string
 
 	Clock                      clock.Clock
 	Logger                     logger.Logger
 	NewObjectStoreWorker       objectstore.ObjectStoreWorkerFunc
 	GetControllerConfigService GetControllerConfigServiceFunc
 	GetMetadataService         GetMetadataServiceFunc
+	GetBlobMetadataService     GetBlobMetadataServiceFunc
+	NewBlobCollector           NewBlobCollectorFunc
 	IsBootstrapController      IsBootstrapControllerFunc
 }
 
@@ -95,6 +109,12 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.GetMetadataService == nil {
 		return errors.NotValidf("nil GetMetadataService")
 	}
+	if cfg.GetBlobMetadataService == nil {
+		return errors.NotValidf("nil GetBlobMetadataService")
+	}
+	if cfg.NewBlobCollector == nil {
+		return errors.NotValidf("nil NewBlobCollector")
+	}
 	if cfg.IsBootstrapController == nil {
 		return errors.NotValidf("nil IsBootstrapController")
 	}
@@ -104,6 +124,12 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.S3ClientName == "" {
 		return errors.NotValidf("empty S3ClientName")
 	}
+	if cfg.APIRemoteCallerName == "" {
+		return errors.NotValidf("empty APIRemoteCallerName")
+	}
+	if cfg.HTTPClientName == "" {
+		return errors.NotValidf("empty HTTPClientName")
+	}
 	if cfg.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
@@ -125,6 +151,8 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			config.ObjectStoreServicesName,
 			config.LeaseManagerName,
 			config.S3ClientName,
+			config.APIRemoteCallerName,
+			config.HTTPClientName,
 		},
 		Output: output,
 		Start: func(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
@@ -150,6 +178,10 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
+			blobMetadataService, err := config.GetBlobMetadataService(getter, config.ObjectStoreServicesName)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
 
 			var leaseManager lease.Manager
 			if err := getter.Get(config.LeaseManagerName, &leaseManager); err != nil {
@@ -166,6 +198,20 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				return nil, errors.Trace(err)
 			}
 
+			var apiRemoteCallers apiremotecaller.APIRemoteCallers
+			if err := getter.Get(config.APIRemoteCallerName, &apiRemoteCallers); err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			var httpClientGetter corehttp.HTTPClientGetter
+			if err := getter.Get(config.HTTPClientName, &httpClientGetter); err != nil {
+				return nil, errors.Trace(err)
+			}
+			charmhubHTTPClient, err := httpClientGetter.GetHTTPClient(ctx, corehttp.CharmhubPurpose)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+
 			controllerConfig, err := controllerConfigService.ControllerConfig(ctx)
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -188,7 +234,13 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				S3Client:                   s3Client,
 				ControllerMetadataService:  metadataService,
 				ModelMetadataServiceGetter: modelMetadataServiceGetter{servicesGetter: objectStoreServicesGetter},
-				ModelClaimGetter:           modelClaimGetter{manager: leaseManager},
+				ModelClaimGetter:           modelClaimGetter{manager: leaseManager, controllerUUID: controllerConfig.ControllerUUID()},
+				BlobMetadataService:        blobMetadataService,
+				NewBlobCollector:           config.NewBlobCollector,
+				ModelCharmServiceGetter:    modelCharmServiceGetter{servicesGetter: objectStoreServicesGetter},
+				ControllerConfigService:    controllerConfigService,
+				APIRemoteCallers:           apiRemoteCallers,
+				HTTPClient:                 charmhubHTTPClient,
 				AllowDraining:              AllowDraining(controllerConfig, config.IsBootstrapController(dataDir)),
 			})
 			return w, errors.Trace(err)
@@ -251,17 +303,38 @@ func (s modelMetadataService) ObjectStore() coreobjectstore.ObjectStoreMetadata
 	return s.factory.ObjectStore()
 }
 
+type modelCharmServiceGetter struct {
+	servicesGetter services.ObjectStoreServicesGetter
+}
+
+// ForModelUUID returns the CharmService for the given model UUID.
+func (s modelCharmServiceGetter) ForModelUUID(modelUUID model.UUID) CharmService {
+	return s.servicesGetter.ServicesForModel(modelUUID).ObjectStoreCharms()
+}
+
 type modelClaimGetter struct {
-	manager lease.Manager
+	manager        lease.Manager
+	controllerUUID string
 }
 
 // ForModelUUID returns the Locker for the given model UUID.
 func (s modelClaimGetter) ForModelUUID(modelUUID model.UUID) (objectstore.Claimer, error) {
-	leaseClaimer, err := s.manager.Claimer(lease.ObjectStoreNamespace, modelUUID.String())
+	return s.claimerFor(modelUUID.String())
+}
+
+// ForController returns the Locker for the controller-wide blob store. The
+// leases are held against the controller UUID, in the same way as the
+// singular controller leases.
+func (s modelClaimGetter) ForController() (objectstore.Claimer, error) {
+	return s.claimerFor(s.controllerUUID)
+}
+
+func (s modelClaimGetter) claimerFor(uuid string) (objectstore.Claimer, error) {
+	leaseClaimer, err := s.manager.Claimer(lease.ObjectStoreNamespace, uuid)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	leaseRevoker, err := s.manager.Revoker(lease.ObjectStoreNamespace, modelUUID.String())
+	leaseRevoker, err := s.manager.Revoker(lease.ObjectStoreNamespace, uuid)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -337,6 +410,14 @@ func GetMetadataService(getter dependency.Getter, name string) (MetadataService,
 	})
 }
 
+// GetBlobMetadataService is a helper function that gets a service from the
+// manifold.
+func GetBlobMetadataService(getter dependency.Getter, name string) (BlobMetadataService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerObjectStoreServices) BlobMetadataService {
+		return factory.ObjectStoreBlobs()
+	})
+}
+
 // AllowDraining returns true if the worker should allow draining. This
 // currently is only true for the bootstrap controller.
 func AllowDraining(config controller.Config, isBootstrapController bool) bool {
@@ -5,15 +5,19 @@ package objectstore
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
 	dependencytesting "github.com/juju/worker/v4/dependency/testing"
 	"github.com/juju/worker/v4/workertest"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	corehttp "github.com/juju/juju/core/http"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/trace"
@@ -21,6 +25,7 @@ import (
 	internalobjectstore "github.com/juju/juju/internal/objectstore"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/apiremotecaller"
 )
 
 type manifoldSuite struct {
@@ -62,6 +67,22 @@ func (s *manifoldSuite) TestValidateConfig(c *gc.C) {
 	cfg = s.getConfig()
 	cfg.NewObjectStoreWorker = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.GetBlobMetadataService = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.NewBlobCollector = nil
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.APIRemoteCallerName = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.HTTPClientName = ""
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *manifoldSuite) getConfig() ManifoldConfig {
@@ -71,6 +92,8 @@ func (s *manifoldSuite) getConfig() ManifoldConfig {
 		ObjectStoreServicesName: "object-store-services",
 		LeaseManagerName:        "lease-manager",
 		S3ClientName:            "s3-client",
+		APIRemoteCallerName:     "api-remote-caller",
+		HTTPClientName:          "http-client",
 		Clock:                   s.clock,
 		Logger:                  s.logger,
 		NewObjectStoreWorker: func(context.Context, objectstore.BackendType, string, ...internalobjectstore.Option) (internalobjectstore.TrackedObjectStore, error) {
@@ -82,6 +105,12 @@ func (s *manifoldSuite) getConfig() ManifoldConfig {
 		GetMetadataService: func(getter dependency.Getter, name string) (MetadataService, error) {
 			return s.metadataService, nil
 		},
+		GetBlobMetadataService: func(getter dependency.Getter, name string) (BlobMetadataService, error) {
+			return s.blobMetadataService, nil
+		},
+		NewBlobCollector: func(internalobjectstore.BlobCollectorConfig) (worker.Worker, error) {
+			return workertest.NewErrorWorker(nil), nil
+		},
 		IsBootstrapController: func(dataDir string) bool {
 			return false
 		},
@@ -95,11 +124,13 @@ func (s *manifoldSuite) newGetter() dependency.Getter {
 		"object-store-services": &stubObjectStoreServicesGetter{},
 		"lease-manager":         s.leaseManager,
 		"s3-client":             s.s3Client,
+		"api-remote-caller":     &stubAPIRemoteCallers{},
+		"http-client":           &stubHTTPClientGetter{},
 	}
 	return dependencytesting.StubGetter(resources)
 }
 
-var expectedInputs = []string{"agent", "trace", "object-store-services", "lease-manager", "s3-client"}
+var expectedInputs = []string{"agent", "trace", "object-store-services", "lease-manager", "s3-client", "api-remote-caller", "http-client"}
 
 func (s *manifoldSuite) TestInputs(c *gc.C) {
 	c.Assert(Manifold(s.getConfig()).Inputs, jc.SameContents, expectedInputs)
@@ -110,6 +141,7 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 
 	s.expectAgentConfig(c)
 	s.expectControllerConfig()
+	s.expectBlobStoreClaimer()
 
 	w, err := Manifold(s.getConfig()).Start(context.Background(), s.newGetter())
 	c.Assert(err, jc.ErrorIsNil)
@@ -125,6 +157,24 @@ func (s *manifoldSuite) expectControllerConfig() {
 	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(testing.FakeControllerConfig(), nil)
 }
 
+func (s *manifoldSuite) expectBlobStoreClaimer() {
+	controllerUUID := testing.FakeControllerConfig().ControllerUUID()
+	s.leaseManager.EXPECT().Claimer(lease.ObjectStoreNamespace, controllerUUID).Return(nil, nil)
+	s.leaseManager.EXPECT().Revoker(lease.ObjectStoreNamespace, controllerUUID).Return(nil, nil)
+}
+
+type stubAPIRemoteCallers struct{}
+
+func (s *stubAPIRemoteCallers) GetAPIRemotes() []apiremotecaller.RemoteConnection {
+	return nil
+}
+
+type stubHTTPClientGetter struct{}
+
+func (s *stubHTTPClientGetter) GetHTTPClient(context.Context, corehttp.Purpose) (corehttp.HTTPClient, error) {
+	return &http.Client{}, nil
+}
+
 type stubTracerGetter struct{}
 
 func (s *stubTracerGetter) GetTracer(ctx context.Context, namespace trace.TracerNamespace) (trace.Tracer, error) {
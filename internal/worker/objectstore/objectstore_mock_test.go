@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/objectstore (interfaces: TrackedObjectStore,MetadataServiceGetter,MetadataService,ModelClaimGetter,ControllerConfigService)
+// Source: github.com/juju/juju/internal/worker/objectstore (interfaces: TrackedObjectStore,MetadataServiceGetter,MetadataService,ModelClaimGetter,ControllerConfigService,BlobMetadataService,CharmServiceGetter,CharmService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/internal/worker/objectstore TrackedObjectStore,MetadataServiceGetter,MetadataService,ModelClaimGetter,ControllerConfigService
+//	mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/internal/worker/objectstore TrackedObjectStore,MetadataServiceGetter,MetadataService,ModelClaimGetter,ControllerConfigService,BlobMetadataService,CharmServiceGetter,CharmService
 //
 
 // Package objectstore is a generated GoMock package.
@@ -499,6 +499,45 @@ func (m *MockModelClaimGetter) EXPECT() *MockModelClaimGetterMockRecorder {
 	return m.recorder
 }
 
+// ForController mocks base method.
+func (m *MockModelClaimGetter) ForController() (objectstore0.Claimer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForController")
+	ret0, _ := ret[0].(objectstore0.Claimer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForController indicates an expected call of ForController.
+func (mr *MockModelClaimGetterMockRecorder) ForController() *MockModelClaimGetterForControllerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForController", reflect.TypeOf((*MockModelClaimGetter)(nil).ForController))
+	return &MockModelClaimGetterForControllerCall{Call: call}
+}
+
+// MockModelClaimGetterForControllerCall wrap *gomock.Call
+type MockModelClaimGetterForControllerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelClaimGetterForControllerCall) Return(arg0 objectstore0.Claimer, arg1 error) *MockModelClaimGetterForControllerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelClaimGetterForControllerCall) Do(f func() (objectstore0.Claimer, error)) *MockModelClaimGetterForControllerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelClaimGetterForControllerCall) DoAndReturn(f func() (objectstore0.Claimer, error)) *MockModelClaimGetterForControllerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ForModelUUID mocks base method.
 func (m *MockModelClaimGetter) ForModelUUID(arg0 model.UUID) (objectstore0.Claimer, error) {
 	m.ctrl.T.Helper()
@@ -599,3 +638,379 @@ func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockBlobMetadataService is a mock of BlobMetadataService interface.
+type MockBlobMetadataService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobMetadataServiceMockRecorder
+}
+
+// MockBlobMetadataServiceMockRecorder is the mock recorder for MockBlobMetadataService.
+type MockBlobMetadataServiceMockRecorder struct {
+	mock *MockBlobMetadataService
+}
+
+// NewMockBlobMetadataService creates a new mock instance.
+func NewMockBlobMetadataService(ctrl *gomock.Controller) *MockBlobMetadataService {
+	mock := &MockBlobMetadataService{ctrl: ctrl}
+	mock.recorder = &MockBlobMetadataServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobMetadataService) EXPECT() *MockBlobMetadataServiceMockRecorder {
+	return m.recorder
+}
+
+// AddBlobReference mocks base method.
+func (m *MockBlobMetadataService) AddBlobReference(arg0 context.Context, arg1 string, arg2 objectstore.Blob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlobReference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBlobReference indicates an expected call of AddBlobReference.
+func (mr *MockBlobMetadataServiceMockRecorder) AddBlobReference(arg0, arg1, arg2 any) *MockBlobMetadataServiceAddBlobReferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlobReference", reflect.TypeOf((*MockBlobMetadataService)(nil).AddBlobReference), arg0, arg1, arg2)
+	return &MockBlobMetadataServiceAddBlobReferenceCall{Call: call}
+}
+
+// MockBlobMetadataServiceAddBlobReferenceCall wrap *gomock.Call
+type MockBlobMetadataServiceAddBlobReferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataServiceAddBlobReferenceCall) Return(arg0 error) *MockBlobMetadataServiceAddBlobReferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataServiceAddBlobReferenceCall) Do(f func(context.Context, string, objectstore.Blob) error) *MockBlobMetadataServiceAddBlobReferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataServiceAddBlobReferenceCall) DoAndReturn(f func(context.Context, string, objectstore.Blob) error) *MockBlobMetadataServiceAddBlobReferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddBlobReferenceWithinQuota mocks base method.
+func (m *MockBlobMetadataService) AddBlobReferenceWithinQuota(arg0 context.Context, arg1 string, arg2 objectstore.Blob, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBlobReferenceWithinQuota", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddBlobReferenceWithinQuota indicates an expected call of AddBlobReferenceWithinQuota.
+func (mr *MockBlobMetadataServiceMockRecorder) AddBlobReferenceWithinQuota(arg0, arg1, arg2, arg3 any) *MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBlobReferenceWithinQuota", reflect.TypeOf((*MockBlobMetadataService)(nil).AddBlobReferenceWithinQuota), arg0, arg1, arg2, arg3)
+	return &MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall{Call: call}
+}
+
+// MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall wrap *gomock.Call
+type MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall) Return(arg0 error) *MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall) Do(f func(context.Context, string, objectstore.Blob, int64) error) *MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall) DoAndReturn(f func(context.Context, string, objectstore.Blob, int64) error) *MockBlobMetadataServiceAddBlobReferenceWithinQuotaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListBlobs mocks base method.
+func (m *MockBlobMetadataService) ListBlobs(arg0 context.Context) ([]objectstore.Blob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlobs", arg0)
+	ret0, _ := ret[0].([]objectstore.Blob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlobs indicates an expected call of ListBlobs.
+func (mr *MockBlobMetadataServiceMockRecorder) ListBlobs(arg0 any) *MockBlobMetadataServiceListBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobs", reflect.TypeOf((*MockBlobMetadataService)(nil).ListBlobs), arg0)
+	return &MockBlobMetadataServiceListBlobsCall{Call: call}
+}
+
+// MockBlobMetadataServiceListBlobsCall wrap *gomock.Call
+type MockBlobMetadataServiceListBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataServiceListBlobsCall) Return(arg0 []objectstore.Blob, arg1 error) *MockBlobMetadataServiceListBlobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataServiceListBlobsCall) Do(f func(context.Context) ([]objectstore.Blob, error)) *MockBlobMetadataServiceListBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataServiceListBlobsCall) DoAndReturn(f func(context.Context) ([]objectstore.Blob, error)) *MockBlobMetadataServiceListBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveBlob mocks base method.
+func (m *MockBlobMetadataService) RemoveBlob(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlob indicates an expected call of RemoveBlob.
+func (mr *MockBlobMetadataServiceMockRecorder) RemoveBlob(arg0, arg1 any) *MockBlobMetadataServiceRemoveBlobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlob", reflect.TypeOf((*MockBlobMetadataService)(nil).RemoveBlob), arg0, arg1)
+	return &MockBlobMetadataServiceRemoveBlobCall{Call: call}
+}
+
+// MockBlobMetadataServiceRemoveBlobCall wrap *gomock.Call
+type MockBlobMetadataServiceRemoveBlobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataServiceRemoveBlobCall) Return(arg0 error) *MockBlobMetadataServiceRemoveBlobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataServiceRemoveBlobCall) Do(f func(context.Context, string) error) *MockBlobMetadataServiceRemoveBlobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataServiceRemoveBlobCall) DoAndReturn(f func(context.Context, string) error) *MockBlobMetadataServiceRemoveBlobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveBlobReference mocks base method.
+func (m *MockBlobMetadataService) RemoveBlobReference(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBlobReference", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBlobReference indicates an expected call of RemoveBlobReference.
+func (mr *MockBlobMetadataServiceMockRecorder) RemoveBlobReference(arg0, arg1, arg2 any) *MockBlobMetadataServiceRemoveBlobReferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlobReference", reflect.TypeOf((*MockBlobMetadataService)(nil).RemoveBlobReference), arg0, arg1, arg2)
+	return &MockBlobMetadataServiceRemoveBlobReferenceCall{Call: call}
+}
+
+// MockBlobMetadataServiceRemoveBlobReferenceCall wrap *gomock.Call
+type MockBlobMetadataServiceRemoveBlobReferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataServiceRemoveBlobReferenceCall) Return(arg0 error) *MockBlobMetadataServiceRemoveBlobReferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataServiceRemoveBlobReferenceCall) Do(f func(context.Context, string, string) error) *MockBlobMetadataServiceRemoveBlobReferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataServiceRemoveBlobReferenceCall) DoAndReturn(f func(context.Context, string, string) error) *MockBlobMetadataServiceRemoveBlobReferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveOrphanedBlobReferences mocks base method.
+func (m *MockBlobMetadataService) RemoveOrphanedBlobReferences(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrphanedBlobReferences", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOrphanedBlobReferences indicates an expected call of RemoveOrphanedBlobReferences.
+func (mr *MockBlobMetadataServiceMockRecorder) RemoveOrphanedBlobReferences(arg0 any) *MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrphanedBlobReferences", reflect.TypeOf((*MockBlobMetadataService)(nil).RemoveOrphanedBlobReferences), arg0)
+	return &MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall{Call: call}
+}
+
+// MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall wrap *gomock.Call
+type MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall) Return(arg0 int64, arg1 error) *MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall) Do(f func(context.Context) (int64, error)) *MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall) DoAndReturn(f func(context.Context) (int64, error)) *MockBlobMetadataServiceRemoveOrphanedBlobReferencesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockCharmServiceGetter is a mock of CharmServiceGetter interface.
+type MockCharmServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharmServiceGetterMockRecorder
+}
+
+// MockCharmServiceGetterMockRecorder is the mock recorder for MockCharmServiceGetter.
+type MockCharmServiceGetterMockRecorder struct {
+	mock *MockCharmServiceGetter
+}
+
+// NewMockCharmServiceGetter creates a new mock instance.
+func NewMockCharmServiceGetter(ctrl *gomock.Controller) *MockCharmServiceGetter {
+	mock := &MockCharmServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockCharmServiceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharmServiceGetter) EXPECT() *MockCharmServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// ForModelUUID mocks base method.
+func (m *MockCharmServiceGetter) ForModelUUID(arg0 model.UUID) CharmService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForModelUUID", arg0)
+	ret0, _ := ret[0].(CharmService)
+	return ret0
+}
+
+// ForModelUUID indicates an expected call of ForModelUUID.
+func (mr *MockCharmServiceGetterMockRecorder) ForModelUUID(arg0 any) *MockCharmServiceGetterForModelUUIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForModelUUID", reflect.TypeOf((*MockCharmServiceGetter)(nil).ForModelUUID), arg0)
+	return &MockCharmServiceGetterForModelUUIDCall{Call: call}
+}
+
+// MockCharmServiceGetterForModelUUIDCall wrap *gomock.Call
+type MockCharmServiceGetterForModelUUIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCharmServiceGetterForModelUUIDCall) Return(arg0 CharmService) *MockCharmServiceGetterForModelUUIDCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCharmServiceGetterForModelUUIDCall) Do(f func(model.UUID) CharmService) *MockCharmServiceGetterForModelUUIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCharmServiceGetterForModelUUIDCall) DoAndReturn(f func(model.UUID) CharmService) *MockCharmServiceGetterForModelUUIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockCharmService is a mock of CharmService interface.
+type MockCharmService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCharmServiceMockRecorder
+}
+
+// MockCharmServiceMockRecorder is the mock recorder for MockCharmService.
+type MockCharmServiceMockRecorder struct {
+	mock *MockCharmService
+}
+
+// NewMockCharmService creates a new mock instance.
+func NewMockCharmService(ctrl *gomock.Controller) *MockCharmService {
+	mock := &MockCharmService{ctrl: ctrl}
+	mock.recorder = &MockCharmServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCharmService) EXPECT() *MockCharmServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCharmDownloadURL mocks base method.
+func (m *MockCharmService) GetCharmDownloadURL(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmDownloadURL", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmDownloadURL indicates an expected call of GetCharmDownloadURL.
+func (mr *MockCharmServiceMockRecorder) GetCharmDownloadURL(arg0, arg1 any) *MockCharmServiceGetCharmDownloadURLCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmDownloadURL", reflect.TypeOf((*MockCharmService)(nil).GetCharmDownloadURL), arg0, arg1)
+	return &MockCharmServiceGetCharmDownloadURLCall{Call: call}
+}
+
+// MockCharmServiceGetCharmDownloadURLCall wrap *gomock.Call
+type MockCharmServiceGetCharmDownloadURLCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCharmServiceGetCharmDownloadURLCall) Return(arg0 string, arg1 error) *MockCharmServiceGetCharmDownloadURLCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCharmServiceGetCharmDownloadURLCall) Do(f func(context.Context, string) (string, error)) *MockCharmServiceGetCharmDownloadURLCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCharmServiceGetCharmDownloadURLCall) DoAndReturn(f func(context.Context, string) (string, error)) *MockCharmServiceGetCharmDownloadURLCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -18,7 +18,7 @@ import (
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination clock_mock_test.go github.com/juju/clock Clock,Timer
 //go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination agent_mock_test.go github.com/juju/juju/agent Agent,Config
-//go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/internal/worker/objectstore TrackedObjectStore,MetadataServiceGetter,MetadataService,ModelClaimGetter,ControllerConfigService
+//go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination objectstore_mock_test.go github.com/juju/juju/internal/worker/objectstore TrackedObjectStore,MetadataServiceGetter,MetadataService,ModelClaimGetter,ControllerConfigService,BlobMetadataService,CharmServiceGetter,CharmService
 //go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination claimer_mock_test.go github.com/juju/juju/internal/objectstore Claimer
 //go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination lease_mock_test.go github.com/juju/juju/core/lease Manager
 //go:generate go run go.uber.org/mock/mockgen -typed -package objectstore -destination client_mock_test.go github.com/juju/juju/core/objectstore Client,Session
@@ -43,6 +43,7 @@ type baseSuite struct {
 
 	controllerConfigService *MockControllerConfigService
 	metadataService         *MockMetadataService
+	blobMetadataService     *MockBlobMetadataService
 }
 
 func (s *baseSuite) setupMocks(c *gc.C) *gomock.Controller {
@@ -57,6 +58,7 @@ func (s *baseSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	s.controllerConfigService = NewMockControllerConfigService(ctrl)
 	s.metadataService = NewMockMetadataService(ctrl)
+	s.blobMetadataService = NewMockBlobMetadataService(ctrl)
 
 	s.logger = loggertesting.WrapCheckLog(c)
 
@@ -0,0 +1,79 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+// bytesPerMiB is the number of bytes in a MiB, the unit of the object store
+// quotas in the controller config.
+const bytesPerMiB = 1024 * 1024
+
+// quotaChecker provides the model and controller quotas in the controller
+// config for the objects put into a model's object store. The quotas are read
+// for every put, so that changes to them take effect immediately.
+type quotaChecker struct {
+	backendType             objectstore.BackendType
+	controllerConfigService ControllerConfigService
+}
+
+// CheckSize checks that an object of the given size is no larger than either
+// of the quotas.
+func (q quotaChecker) CheckSize(ctx context.Context, size int64) error {
+	quotas, err := q.Quotas(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if quotas.Model > 0 && size > quotas.Model {
+		return errors.Annotatef(objectstoreerrors.QuotaExceeded, "object of %d bytes is larger than the model quota of %d bytes", size, quotas.Model)
+	}
+	if quotas.Controller > 0 && size > quotas.Controller {
+		return errors.Annotatef(objectstoreerrors.QuotaExceeded, "object of %d bytes is larger than the controller quota of %d bytes", size, quotas.Controller)
+	}
+	return nil
+}
+
+// Quotas returns the model and controller quotas in bytes. A quota of 0
+// means there is no quota. The controller quota only applies to the file
+// backend, as the s3 backend doesn't store the objects on the controllers.
+func (q quotaChecker) Quotas(ctx context.Context) (internalobjectstore.Quotas, error) {
+	config, err := q.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return internalobjectstore.Quotas{}, errors.Annotate(err, "getting controller config")
+	}
+
+	quotas := internalobjectstore.Quotas{
+		Model: int64(config.ObjectStoreModelQuotaMB()) * bytesPerMiB,
+	}
+	if q.backendType == objectstore.FileBackend {
+		quotas.Controller = int64(config.ObjectStoreControllerQuotaMB()) * bytesPerMiB
+	}
+	return quotas, nil
+}
+
+// quotaChecker returns the quota checker for the objects put into the
+// namespace's object store. The controller's own object store holds the
+// agent binaries, which aren't subject to a quota, so no quota checker is
+// returned for it.
+func (w *objectStoreWorker) quotaChecker(
+	namespace string,
+	backendType objectstore.BackendType,
+) internalobjectstore.QuotaChecker {
+	if namespace == database.ControllerNS {
+		return nil
+	}
+	return quotaChecker{
+		backendType:             backendType,
+		controllerConfigService: w.cfg.ControllerConfigService,
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+	"github.com/juju/juju/internal/testing"
+)
+
+type quotaSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&quotaSuite{})
+
+func (s *quotaSuite) TestCheckSizeNoQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig("0", "0")
+
+	err := s.newQuotaChecker(objectstore.FileBackend).CheckSize(context.Background(), 1<<40)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *quotaSuite) TestCheckSizeLargerThanModelQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig("1M", "0")
+
+	err := s.newQuotaChecker(objectstore.FileBackend).CheckSize(context.Background(), bytesPerMiB+1)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.QuotaExceeded)
+}
+
+func (s *quotaSuite) TestCheckSizeLargerThanControllerQuota(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig("0", "1M")
+
+	err := s.newQuotaChecker(objectstore.FileBackend).CheckSize(context.Background(), bytesPerMiB+1)
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.QuotaExceeded)
+}
+
+func (s *quotaSuite) TestCheckSizeControllerQuotaIgnoredForS3(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig("0", "1M")
+
+	err := s.newQuotaChecker(objectstore.S3Backend).CheckSize(context.Background(), bytesPerMiB+1)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *quotaSuite) TestQuotas(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig("1M", "2M")
+
+	quotas, err := s.newQuotaChecker(objectstore.FileBackend).Quotas(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(quotas, gc.Equals, internalobjectstore.Quotas{
+		Model:      bytesPerMiB,
+		Controller: 2 * bytesPerMiB,
+	})
+}
+
+func (s *quotaSuite) TestQuotasControllerQuotaIgnoredForS3(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectControllerConfig("1M", "2M")
+
+	quotas, err := s.newQuotaChecker(objectstore.S3Backend).Quotas(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(quotas, gc.Equals, internalobjectstore.Quotas{
+		Model: bytesPerMiB,
+	})
+}
+
+func (s *quotaSuite) TestNoQuotaCheckerForControllerNamespace(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	w := &objectStoreWorker{}
+	checker := w.quotaChecker(database.ControllerNS, objectstore.FileBackend)
+	c.Check(checker, gc.IsNil)
+}
+
+func (s *quotaSuite) expectControllerConfig(modelQuota, controllerQuota string) {
+	config := testing.FakeControllerConfig()
+	config[controller.ObjectStoreModelQuota] = modelQuota
+	config[controller.ObjectStoreControllerQuota] = controllerQuota
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(config, nil)
+}
+
+func (s *quotaSuite) newQuotaChecker(backendType objectstore.BackendType) quotaChecker {
+	return quotaChecker{
+		backendType:             backendType,
+		controllerConfigService: s.controllerConfigService,
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/juju/errors"
+	httprequest "gopkg.in/httprequest.v1"
+
+	"github.com/juju/juju/core/database"
+	corehttp "github.com/juju/juju/core/http"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	internalobjectstore "github.com/juju/juju/internal/objectstore"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+	"github.com/juju/juju/internal/s3client"
+	"github.com/juju/juju/internal/worker/apiremotecaller"
+)
+
+// CharmService is the interface that is used to locate the charmhub URL that
+// a charm archive in the object store was downloaded from.
+type CharmService interface {
+	// GetCharmDownloadURL returns the charmhub URL that the charm archive
+	// stored with the specified SHA256 was downloaded from.
+	GetCharmDownloadURL(ctx context.Context, sha256 string) (string, error)
+}
+
+// CharmServiceGetter is the interface that is used to get the CharmService
+// for a given model UUID.
+type CharmServiceGetter interface {
+	// ForModelUUID returns the CharmService for the given model UUID.
+	ForModelUUID(model.UUID) CharmService
+}
+
+// peerObjectSource retrieves the objects for a namespace from the other
+// controllers in a HA controller. Each controller keeps its own copy of the
+// objects when using the file backend, so a corrupt copy can be replaced by
+// the copy from a peer.
+type peerObjectSource struct {
+	remoteCallers apiremotecaller.APIRemoteCallers
+	namespace     string
+	logger        logger.Logger
+}
+
+// GetObject returns the contents of the object from the first controller
+// that has it. If none of the controllers have the object, then an error
+// satisfying [objectstoreerrors.ObjectNotFound] is returned.
+func (s peerObjectSource) GetObject(ctx context.Context, metadata objectstore.Metadata) (io.ReadCloser, int64, error) {
+	for _, remote := range s.remoteCallers.GetAPIRemotes() {
+		conn := remote.Connection()
+		if conn == nil {
+			continue
+		}
+
+		httpClient, err := conn.RootHTTPClient()
+		if err != nil {
+			s.logger.Debugf(ctx, "getting http client for peer %q: %v", conn.Addr(), err)
+			continue
+		}
+
+		session, err := s3client.NewS3Client(ensureHTTPS(httpClient.BaseURL), newHTTPClient(httpClient), s3client.AnonymousCredentials{}, s.logger)
+		if err != nil {
+			s.logger.Debugf(ctx, "creating s3 client for peer %q: %v", conn.Addr(), err)
+			continue
+		}
+
+		reader, size, err := s3client.NewBlobsS3Client(session).GetObject(ctx, s.namespace, metadata.SHA256)
+		if errors.Is(err, errors.NotFound) {
+			continue
+		} else if err != nil {
+			s.logger.Debugf(ctx, "getting object %q from peer %q: %v", metadata.Path, conn.Addr(), err)
+			continue
+		}
+		return reader, size, nil
+	}
+	return nil, -1, objectstoreerrors.ObjectNotFound
+}
+
+// charmhubObjectSource downloads the charm archives in a model's object store
+// again from charmhub.
+type charmhubObjectSource struct {
+	charmService CharmService
+	client       corehttp.HTTPClient
+}
+
+// GetObject returns the contents of the charm archive from charmhub. If the
+// object isn't a charm archive downloaded from charmhub, then an error
+// satisfying [objectstoreerrors.ObjectNotFound] is returned.
+func (s charmhubObjectSource) GetObject(ctx context.Context, metadata objectstore.Metadata) (io.ReadCloser, int64, error) {
+	url, err := s.charmService.GetCharmDownloadURL(ctx, metadata.SHA256)
+	if errors.Is(err, domainobjectstoreerrors.ErrNotFound) {
+		return nil, -1, objectstoreerrors.ObjectNotFound
+	} else if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, -1, errors.Annotatef(err, "downloading charm %q", url)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		_ = resp.Body.Close()
+		return nil, -1, objectstoreerrors.ObjectNotFound
+	} else if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, -1, errors.Errorf("downloading charm %q: http status %d", url, resp.StatusCode)
+	}
+
+	// The size isn't always known up front, in which case the size of the
+	// download is checked once it's been written.
+	size := resp.ContentLength
+	if size < 0 {
+		size = metadata.Size
+	}
+	return resp.Body, size, nil
+}
+
+// objectSources returns the sources used to repair the corrupt or missing
+// objects in the namespace.
+func (w *objectStoreWorker) objectSources(namespace string, backendType objectstore.BackendType) []internalobjectstore.ObjectSource {
+	var sources []internalobjectstore.ObjectSource
+
+	// The controllers share the same s3 bucket, so there is no separate copy
+	// on a peer to repair from.
+	if backendType == objectstore.FileBackend && namespace != database.ControllerNS {
+		sources = append(sources, peerObjectSource{
+			remoteCallers: w.cfg.APIRemoteCallers,
+			namespace:     namespace,
+			logger:        w.cfg.Logger,
+		})
+	}
+
+	// Only the model object stores hold charm archives.
+	if namespace != database.ControllerNS {
+		sources = append(sources, charmhubObjectSource{
+			charmService: w.cfg.ModelCharmServiceGetter.ForModelUUID(model.UUID(namespace)),
+			client:       w.cfg.HTTPClient,
+		})
+	}
+	return sources
+}
+
+// httpClient is a shim around the httprequest.Client, which is itself a shim
+// around the stdlib http.Client.
+type httpClient struct {
+	client *httprequest.Client
+}
+
+func newHTTPClient(client *httprequest.Client) *httpClient {
+	return &httpClient{
+		client: client,
+	}
+}
+
+// Do sends the HTTP request and returns the HTTP response.
+func (c *httpClient) Do(req *http.Request) (*http.Response, error) {
+	var res *http.Response
+	err := c.client.Do(req.Context(), req, &res)
+	return res, err
+}
+
+// ensureHTTPS takes a URI and ensures that it is a HTTPS URL.
+func ensureHTTPS(address string) string {
+	if strings.HasPrefix(address, "https://") {
+		return address
+	}
+	if strings.HasPrefix(address, "http://") {
+		return strings.Replace(address, "http://", "https://", 1)
+	}
+	return "https://" + address
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package objectstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/objectstore"
+	domainobjectstoreerrors "github.com/juju/juju/domain/objectstore/errors"
+	objectstoreerrors "github.com/juju/juju/internal/objectstore/errors"
+)
+
+type sourcesSuite struct {
+	baseSuite
+
+	charmService       *MockCharmService
+	charmServiceGetter *MockCharmServiceGetter
+}
+
+var _ = gc.Suite(&sourcesSuite{})
+
+func (s *sourcesSuite) TestCharmhubObjectSource(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, gc.Equals, "/foo_1.charm")
+		_, _ = w.Write([]byte("some content"))
+	}))
+	defer server.Close()
+
+	s.charmService.EXPECT().GetCharmDownloadURL(gomock.Any(), "sha256").Return(server.URL+"/foo_1.charm", nil)
+
+	source := charmhubObjectSource{
+		charmService: s.charmService,
+		client:       server.Client(),
+	}
+	reader, size, err := source.GetObject(context.Background(), objectstore.Metadata{
+		SHA256: "sha256",
+		Size:   12,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(content), gc.Equals, "some content")
+	c.Check(size, gc.Equals, int64(12))
+}
+
+func (s *sourcesSuite) TestCharmhubObjectSourceNotCharm(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmService.EXPECT().GetCharmDownloadURL(gomock.Any(), "sha256").Return("", domainobjectstoreerrors.ErrNotFound)
+
+	source := charmhubObjectSource{
+		charmService: s.charmService,
+		client:       &http.Client{},
+	}
+	_, _, err := source.GetObject(context.Background(), objectstore.Metadata{
+		SHA256: "sha256",
+	})
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ObjectNotFound)
+}
+
+func (s *sourcesSuite) TestCharmhubObjectSourceDownloadNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	s.charmService.EXPECT().GetCharmDownloadURL(gomock.Any(), "sha256").Return(server.URL+"/foo_1.charm", nil)
+
+	source := charmhubObjectSource{
+		charmService: s.charmService,
+		client:       server.Client(),
+	}
+	_, _, err := source.GetObject(context.Background(), objectstore.Metadata{
+		SHA256: "sha256",
+	})
+	c.Assert(err, jc.ErrorIs, objectstoreerrors.ObjectNotFound)
+}
+
+func (s *sourcesSuite) TestObjectSourcesFileBackend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmServiceGetter.EXPECT().ForModelUUID(gomock.Any()).Return(s.charmService)
+
+	sources := s.newWorker().objectSources("inferi", objectstore.FileBackend)
+	c.Assert(sources, gc.HasLen, 2)
+	c.Check(sources[0], gc.FitsTypeOf, peerObjectSource{})
+	c.Check(sources[1], gc.FitsTypeOf, charmhubObjectSource{})
+}
+
+func (s *sourcesSuite) TestObjectSourcesS3Backend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.charmServiceGetter.EXPECT().ForModelUUID(gomock.Any()).Return(s.charmService)
+
+	// The controllers share the same bucket, so there is no peer to repair
+	// from.
+	sources := s.newWorker().objectSources("inferi", objectstore.S3Backend)
+	c.Assert(sources, gc.HasLen, 1)
+	c.Check(sources[0], gc.FitsTypeOf, charmhubObjectSource{})
+}
+
+func (s *sourcesSuite) TestObjectSourcesControllerNamespace(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sources := s.newWorker().objectSources(database.ControllerNS, objectstore.FileBackend)
+	c.Check(sources, gc.HasLen, 0)
+}
+
+func (s *sourcesSuite) newWorker() *objectStoreWorker {
+	return &objectStoreWorker{
+		cfg: WorkerConfig{
+			Logger:                  s.logger,
+			ModelCharmServiceGetter: s.charmServiceGetter,
+			APIRemoteCallers:        &stubAPIRemoteCallers{},
+			HTTPClient:              &http.Client{},
+		},
+	}
+}
+
+func (s *sourcesSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := s.baseSuite.setupMocks(c)
+
+	s.charmService = NewMockCharmService(ctrl)
+	s.charmServiceGetter = NewMockCharmServiceGetter(ctrl)
+
+	return ctrl
+}
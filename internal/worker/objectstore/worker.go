@@ -14,12 +14,14 @@ import (
 	"github.com/juju/worker/v4/catacomb"
 
 	"github.com/juju/juju/core/database"
+	corehttp "github.com/juju/juju/core/http"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
 	coretrace "github.com/juju/juju/core/trace"
 	internalobjectstore "github.com/juju/juju/internal/objectstore"
 	internalworker "github.com/juju/juju/internal/worker"
+	"github.com/juju/juju/internal/worker/apiremotecaller"
 	"github.com/juju/juju/internal/worker/trace"
 )
 
@@ -35,6 +37,18 @@ type TrackedObjectStore interface {
 	objectstore.ObjectStore
 }
 
+// BlobMetadataService is the interface that is used to reference the blobs
+// in the controller-wide blob store, and to garbage collect them once they're
+// no longer referenced.
+type BlobMetadataService interface {
+	objectstore.BlobMetadata
+	internalobjectstore.BlobCollectorMetadata
+}
+
+// NewBlobCollectorFunc is the function that is used to create the worker
+// that garbage collects the blobs in the blob store.
+type NewBlobCollectorFunc func(internalobjectstore.BlobCollectorConfig) (worker.Worker, error)
+
 // WorkerConfig encapsulates the configuration options for the
 // objectStore worker.
 type WorkerConfig struct {
@@ -49,6 +63,12 @@ type WorkerConfig struct {
 	ControllerMetadataService  MetadataService
 	ModelMetadataServiceGetter MetadataServiceGetter
 	ModelClaimGetter           ModelClaimGetter
+	BlobMetadataService        BlobMetadataService
+	NewBlobCollector           NewBlobCollectorFunc
+	ModelCharmServiceGetter    CharmServiceGetter
+	ControllerConfigService    ControllerConfigService
+	APIRemoteCallers           apiremotecaller.APIRemoteCallers
+	HTTPClient                 corehttp.HTTPClient
 	AllowDraining              bool
 }
 
@@ -84,6 +104,24 @@ func (c *WorkerConfig) Validate() error {
 	if c.ModelClaimGetter == nil {
 		return errors.NotValidf("nil ModelClaimGetter")
 	}
+	if c.BlobMetadataService == nil {
+		return errors.NotValidf("nil BlobMetadataService")
+	}
+	if c.NewBlobCollector == nil {
+		return errors.NotValidf("nil NewBlobCollector")
+	}
+	if c.ModelCharmServiceGetter == nil {
+		return errors.NotValidf("nil ModelCharmServiceGetter")
+	}
+	if c.ControllerConfigService == nil {
+		return errors.NotValidf("nil ControllerConfigService")
+	}
+	if c.APIRemoteCallers == nil {
+		return errors.NotValidf("nil APIRemoteCallers")
+	}
+	if c.HTTPClient == nil {
+		return errors.NotValidf("nil HTTPClient")
+	}
 	return nil
 }
 
@@ -101,6 +139,11 @@ type objectStoreWorker struct {
 
 	runner *worker.Runner
 
+	// blobClaimer is the claimer for the controller-wide blob store, which is
+	// shared by the file object stores and the blob collector.
+	blobClaimer   internalobjectstore.Claimer
+	blobCollector worker.Worker
+
 	objectStoreRequests chan objectStoreRequest
 }
 
@@ -114,6 +157,24 @@ func newWorker(cfg WorkerConfig, internalStates chan string) (*objectStoreWorker
 		return nil, errors.Trace(err)
 	}
 
+	// The blobs are shared by all the file object stores, so they must all
+	// claim them with the same controller-scoped claimer.
+	blobClaimer, err := cfg.ModelClaimGetter.ForController()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	blobCollector, err := cfg.NewBlobCollector(internalobjectstore.BlobCollectorConfig{
+		RootDir:         cfg.RootDir,
+		MetadataService: cfg.BlobMetadataService,
+		Claimer:         blobClaimer,
+		Logger:          cfg.Logger,
+		Clock:           cfg.Clock,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	w := &objectStoreWorker{
 		internalStates: internalStates,
 		cfg:            cfg,
@@ -128,6 +189,8 @@ func newWorker(cfg WorkerConfig, internalStates chan string) (*objectStoreWorker
 			RestartDelay: time.Second * 10,
 			Logger:       internalworker.WrapLogger(cfg.Logger),
 		}),
+		blobClaimer:         blobClaimer,
+		blobCollector:       blobCollector,
 		objectStoreRequests: make(chan objectStoreRequest),
 	}
 
@@ -136,8 +199,10 @@ func newWorker(cfg WorkerConfig, internalStates chan string) (*objectStoreWorker
 		Work: w.loop,
 		Init: []worker.Worker{
 			w.runner,
+			blobCollector,
 		},
 	}); err != nil {
+		worker.Stop(blobCollector)
 		return nil, errors.Trace(err)
 	}
 
@@ -184,6 +249,18 @@ func (w *objectStoreWorker) Wait() error {
 	return w.catacomb.Wait()
 }
 
+// Report returns a map of internal state for the object store worker. This
+// includes the space reclaimed by the blob collector, and the outcome of the
+// last scrub of each object store.
+func (w *objectStoreWorker) Report() map[string]any {
+	report := make(map[string]any)
+	if reporter, ok := w.blobCollector.(worker.Reporter); ok {
+		report["blob-collector"] = reporter.Report()
+	}
+	report["object-stores"] = w.runner.Report()
+	return report
+}
+
 // GetObjectStore returns a objectStore for the given namespace.
 func (w *objectStoreWorker) GetObjectStore(ctx context.Context, namespace string) (objectstore.ObjectStore, error) {
 	// First check if we've already got the objectStore worker already running.
@@ -271,10 +348,17 @@ func (w *objectStoreWorker) initObjectStore(namespace string) error {
 			return nil, errors.Trace(err)
 		}
 
-		// Grab the claimer for the model.
-		claimer, err := w.cfg.ModelClaimGetter.ForModelUUID(model.UUID(namespace))
-		if err != nil {
-			return nil, errors.Trace(err)
+		backendType := internalobjectstore.BackendTypeOrDefault(w.cfg.ObjectStoreType)
+
+		// Grab the claimer for the model. The file object stores share the
+		// blobs in the blob store, so they must claim them in the same
+		// namespace as each other and the blob collector.
+		claimer := w.blobClaimer
+		if backendType != objectstore.FileBackend {
+			claimer, err = w.cfg.ModelClaimGetter.ForModelUUID(model.UUID(namespace))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
 		}
 
 		var metadataService MetadataService
@@ -286,13 +370,16 @@ func (w *objectStoreWorker) initObjectStore(namespace string) error {
 
 		objectStore, err := w.cfg.NewObjectStoreWorker(
 			ctx,
-			internalobjectstore.BackendTypeOrDefault(w.cfg.ObjectStoreType),
+			backendType,
 			namespace,
 			internalobjectstore.WithRootDir(w.cfg.RootDir),
 			internalobjectstore.WithRootBucket(w.cfg.RootBucket),
 			internalobjectstore.WithS3Client(w.cfg.S3Client),
 			internalobjectstore.WithMetadataService(metadataService),
+			internalobjectstore.WithBlobMetadataService(w.cfg.BlobMetadataService),
 			internalobjectstore.WithClaimer(claimer),
+			internalobjectstore.WithObjectSources(w.objectSources(namespace, backendType)...),
+			internalobjectstore.WithQuotaChecker(w.quotaChecker(namespace, backendType)),
 			internalobjectstore.WithLogger(w.cfg.Logger),
 			internalobjectstore.WithAllowDraining(w.cfg.AllowDraining),
 		)
@@ -334,6 +421,14 @@ type tracedWorker struct {
 	tracer coretrace.Tracer
 }
 
+// Report returns the report of the underlying object store, if it has one.
+func (t *tracedWorker) Report() map[string]any {
+	if reporter, ok := t.TrackedObjectStore.(worker.Reporter); ok {
+		return reporter.Report()
+	}
+	return nil
+}
+
 // Get returns an io.ReadCloser for data at path, namespaced to the
 // model.
 func (t *tracedWorker) Get(ctx context.Context, path string) (_ io.ReadCloser, _ int64, err error) {
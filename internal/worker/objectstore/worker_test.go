@@ -6,6 +6,7 @@ package objectstore
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -31,6 +32,9 @@ type workerSuite struct {
 	modelMetadataServiceGetter *MockMetadataServiceGetter
 	modelClaimGetter           *MockModelClaimGetter
 	modelMetadataService       *MockMetadataService
+	charmServiceGetter         *MockCharmServiceGetter
+	charmService               *MockCharmService
+	blobCollectorConfig        internalobjectstore.BlobCollectorConfig
 	called                     int64
 }
 
@@ -51,6 +55,19 @@ func (s *workerSuite) TestKilledGetObjectStoreErrDying(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, objectstore.ErrObjectStoreDying)
 }
 
+func (s *workerSuite) TestStartsBlobCollector(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.ensureStartup(c)
+
+	c.Check(s.blobCollectorConfig.RootDir, gc.Not(gc.Equals), "")
+	c.Check(s.blobCollectorConfig.MetadataService, gc.Equals, s.blobMetadataService)
+	c.Check(s.blobCollectorConfig.Claimer, gc.Equals, s.claimer)
+}
+
 func (s *workerSuite) TestGetObjectStore(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -194,8 +211,17 @@ func (s *workerSuite) newWorker(c *gc.C) worker.Worker {
 		ControllerMetadataService:  s.controllerMetadataService,
 		ModelMetadataServiceGetter: s.modelMetadataServiceGetter,
 		ModelClaimGetter:           s.modelClaimGetter,
-		RootDir:                    c.MkDir(),
-		RootBucket:                 uuid.MustNewUUID().String(),
+		BlobMetadataService:        s.blobMetadataService,
+		NewBlobCollector: func(cfg internalobjectstore.BlobCollectorConfig) (worker.Worker, error) {
+			s.blobCollectorConfig = cfg
+			return workertest.NewErrorWorker(nil), nil
+		},
+		ModelCharmServiceGetter: s.charmServiceGetter,
+		ControllerConfigService: s.controllerConfigService,
+		APIRemoteCallers:        &stubAPIRemoteCallers{},
+		HTTPClient:              &http.Client{},
+		RootDir:                 c.MkDir(),
+		RootBucket:              uuid.MustNewUUID().String(),
 	}, s.states)
 	c.Assert(err, jc.ErrorIsNil)
 	return w
@@ -218,6 +244,11 @@ func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	s.modelClaimGetter = NewMockModelClaimGetter(ctrl)
 	s.modelClaimGetter.EXPECT().ForModelUUID(gomock.Any()).Return(s.claimer, nil).AnyTimes()
+	s.modelClaimGetter.EXPECT().ForController().Return(s.claimer, nil).AnyTimes()
+
+	s.charmService = NewMockCharmService(ctrl)
+	s.charmServiceGetter = NewMockCharmServiceGetter(ctrl)
+	s.charmServiceGetter.EXPECT().ForModelUUID(gomock.Any()).Return(s.charmService).AnyTimes()
 
 	return ctrl
 }
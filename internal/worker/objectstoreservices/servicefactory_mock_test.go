@@ -216,3 +216,79 @@ func (c *MockObjectStoreServicesGetterServicesForModelCall) DoAndReturn(f func(m
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ObjectStoreBlobs mocks base method.
+func (m *MockObjectStoreServices) ObjectStoreBlobs() *service0.BlobService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStoreBlobs")
+	ret0, _ := ret[0].(*service0.BlobService)
+	return ret0
+}
+
+// ObjectStoreBlobs indicates an expected call of ObjectStoreBlobs.
+func (mr *MockObjectStoreServicesMockRecorder) ObjectStoreBlobs() *MockObjectStoreServicesObjectStoreBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStoreBlobs", reflect.TypeOf((*MockObjectStoreServices)(nil).ObjectStoreBlobs))
+	return &MockObjectStoreServicesObjectStoreBlobsCall{Call: call}
+}
+
+// MockObjectStoreServicesObjectStoreBlobsCall wrap *gomock.Call
+type MockObjectStoreServicesObjectStoreBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreServicesObjectStoreBlobsCall) Return(arg0 *service0.BlobService) *MockObjectStoreServicesObjectStoreBlobsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreServicesObjectStoreBlobsCall) Do(f func() *service0.BlobService) *MockObjectStoreServicesObjectStoreBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreServicesObjectStoreBlobsCall) DoAndReturn(f func() *service0.BlobService) *MockObjectStoreServicesObjectStoreBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ObjectStoreCharms mocks base method.
+func (m *MockObjectStoreServices) ObjectStoreCharms() *service0.CharmService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectStoreCharms")
+	ret0, _ := ret[0].(*service0.CharmService)
+	return ret0
+}
+
+// ObjectStoreCharms indicates an expected call of ObjectStoreCharms.
+func (mr *MockObjectStoreServicesMockRecorder) ObjectStoreCharms() *MockObjectStoreServicesObjectStoreCharmsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectStoreCharms", reflect.TypeOf((*MockObjectStoreServices)(nil).ObjectStoreCharms))
+	return &MockObjectStoreServicesObjectStoreCharmsCall{Call: call}
+}
+
+// MockObjectStoreServicesObjectStoreCharmsCall wrap *gomock.Call
+type MockObjectStoreServicesObjectStoreCharmsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockObjectStoreServicesObjectStoreCharmsCall) Return(arg0 *service0.CharmService) *MockObjectStoreServicesObjectStoreCharmsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockObjectStoreServicesObjectStoreCharmsCall) Do(f func() *service0.CharmService) *MockObjectStoreServicesObjectStoreCharmsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockObjectStoreServicesObjectStoreCharmsCall) DoAndReturn(f func() *service0.CharmService) *MockObjectStoreServicesObjectStoreCharmsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
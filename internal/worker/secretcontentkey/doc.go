@@ -0,0 +1,8 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretcontentkey provides a worker which rotates the key used to
+// encrypt secret content stored in the model database, and re-encrypts
+// content which is not encrypted with the current key. This includes any
+// content stored before encryption at rest was introduced.
+package secretcontentkey
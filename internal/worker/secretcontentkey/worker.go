@@ -0,0 +1,160 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretcontentkey
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/logger"
+)
+
+const (
+	// DefaultKeyRotationPeriod is how long a secret content key is used
+	// to encrypt new content before it is replaced.
+	DefaultKeyRotationPeriod = 90 * 24 * time.Hour
+
+	// checkInterval is how often the worker checks whether the key
+	// needs to be rotated.
+	checkInterval = time.Hour
+
+	// reencryptBatchSize is the number of items of secret content
+	// re-encrypted in each transaction.
+	reencryptBatchSize = 100
+)
+
+// SecretService provides the methods needed to manage
+// the keys which encrypt secret content.
+type SecretService interface {
+	// RotateSecretContentKey replaces the key used to encrypt secret
+	// content if it is older than maxAge, or if the controller key which
+	// wrapped it has been rotated out.
+	RotateSecretContentKey(ctx context.Context, maxAge time.Duration) (bool, error)
+
+	// ReencryptSecretContent re-encrypts up to batchSize items of secret
+	// content not encrypted with the current key, returning the number
+	// of items re-encrypted.
+	ReencryptSecretContent(ctx context.Context, batchSize int) (int, error)
+}
+
+// Config defines the operation of the Worker.
+type Config struct {
+	SecretService     SecretService
+	KeyRotationPeriod time.Duration
+	Clock             clock.Clock
+	Logger            logger.Logger
+}
+
+// Validate returns an error if config cannot drive the Worker.
+func (config Config) Validate() error {
+	if config.SecretService == nil {
+		return errors.NotValidf("nil SecretService")
+	}
+	if config.KeyRotationPeriod <= 0 {
+		return errors.NotValidf("invalid KeyRotationPeriod")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// NewWorker returns a secret content key Worker backed by config, or an error.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &Worker{config: config}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+// Worker rotates the secret content key and re-encrypts secret content.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+}
+
+// Kill is defined on worker.Worker.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() error {
+	ctx, cancel := w.scopeContext()
+	defer cancel()
+
+	interval := checkInterval
+	if w.config.KeyRotationPeriod < interval {
+		interval = w.config.KeyRotationPeriod
+	}
+
+	// Check straight away so that content stored before
+	// encryption was introduced is encrypted on startup.
+	var delay time.Duration
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-w.config.Clock.After(delay):
+			if err := w.process(ctx); err != nil {
+				return errors.Trace(err)
+			}
+			delay = interval
+		}
+	}
+}
+
+func (w *Worker) process(ctx context.Context) error {
+	rotated, err := w.config.SecretService.RotateSecretContentKey(ctx, w.config.KeyRotationPeriod)
+	if err != nil {
+		return errors.Annotate(err, "rotating secret content key")
+	}
+	if rotated {
+		w.config.Logger.Infof(ctx, "rotated secret content key")
+	}
+
+	var total int
+	for {
+		count, err := w.config.SecretService.ReencryptSecretContent(ctx, reencryptBatchSize)
+		if err != nil {
+			return errors.Annotate(err, "re-encrypting secret content")
+		}
+		if count == 0 {
+			break
+		}
+		total += count
+
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		default:
+		}
+	}
+	if total > 0 {
+		w.config.Logger.Infof(ctx, "re-encrypted %d items of secret content", total)
+	}
+	return nil
+}
+
+func (w *Worker) scopeContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(w.catacomb.Context(context.Background()))
+}
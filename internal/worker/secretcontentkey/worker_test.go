@@ -0,0 +1,127 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretcontentkey
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/core/testing"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type workerSuite struct {
+	testing.IsolationSuite
+
+	clock   *testclock.Clock
+	service *MockSecretService
+}
+
+var _ = gc.Suite(&workerSuite{})
+
+func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.service = NewMockSecretService(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+	return ctrl
+}
+
+func (s *workerSuite) newWorker(c *gc.C) worker.Worker {
+	w, err := NewWorker(Config{
+		SecretService:     s.service,
+		KeyRotationPeriod: 24 * time.Hour,
+		Clock:             s.clock,
+		Logger:            loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return w
+}
+
+func (s *workerSuite) waitDone(c *gc.C, done chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for worker")
+	}
+}
+
+func (s *workerSuite) TestValidateConfig(c *gc.C) {
+	cfg := Config{
+		SecretService:     NewMockSecretService(gomock.NewController(c)),
+		KeyRotationPeriod: time.Hour,
+		Clock:             testclock.NewClock(time.Now()),
+		Logger:            loggertesting.WrapCheckLog(c),
+	}
+	c.Check(cfg.Validate(), jc.ErrorIsNil)
+
+	cfg.KeyRotationPeriod = 0
+	c.Check(cfg.Validate(), gc.ErrorMatches, "invalid KeyRotationPeriod not valid")
+	cfg.KeyRotationPeriod = time.Hour
+
+	cfg.SecretService = nil
+	c.Check(cfg.Validate(), gc.ErrorMatches, "nil SecretService not valid")
+}
+
+func (s *workerSuite) TestReencryptsOnStartup(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	s.service.EXPECT().RotateSecretContentKey(gomock.Any(), 24*time.Hour).Return(false, nil)
+	gomock.InOrder(
+		s.service.EXPECT().ReencryptSecretContent(gomock.Any(), reencryptBatchSize).Return(reencryptBatchSize, nil),
+		s.service.EXPECT().ReencryptSecretContent(gomock.Any(), reencryptBatchSize).Return(5, nil),
+		s.service.EXPECT().ReencryptSecretContent(gomock.Any(), reencryptBatchSize).DoAndReturn(func(context.Context, int) (int, error) {
+			close(done)
+			return 0, nil
+		}),
+	)
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+	s.waitDone(c, done)
+}
+
+func (s *workerSuite) TestChecksPeriodically(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	first := make(chan struct{})
+	s.service.EXPECT().RotateSecretContentKey(gomock.Any(), 24*time.Hour).Return(false, nil)
+	s.service.EXPECT().ReencryptSecretContent(gomock.Any(), reencryptBatchSize).DoAndReturn(func(context.Context, int) (int, error) {
+		close(first)
+		return 0, nil
+	})
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+	s.waitDone(c, first)
+
+	second := make(chan struct{})
+	s.service.EXPECT().RotateSecretContentKey(gomock.Any(), 24*time.Hour).Return(true, nil)
+	s.service.EXPECT().ReencryptSecretContent(gomock.Any(), reencryptBatchSize).DoAndReturn(func(context.Context, int) (int, error) {
+		close(second)
+		return 0, nil
+	})
+	err := s.clock.WaitAdvance(checkInterval, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	s.waitDone(c, second)
+}
+
+func (s *workerSuite) TestRotateError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.EXPECT().RotateSecretContentKey(gomock.Any(), 24*time.Hour).Return(false, errors.New("boom"))
+
+	w := s.newWorker(c)
+	err := workertest.CheckKilled(c, w)
+	c.Assert(err, gc.ErrorMatches, "rotating secret content key: boom")
+}
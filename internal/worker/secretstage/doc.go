@@ -0,0 +1,8 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretstage provides a worker which resolves staged secret
+// rotations. A staged revision is committed once every consumer tracks it,
+// and rolled back to the previous revision if that has not happened by the
+// stage deadline.
+package secretstage
@@ -0,0 +1,83 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretstage
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/core/logger"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/services"
+)
+
+// ManifoldConfig describes the resources used by the secret stage worker.
+type ManifoldConfig struct {
+	DomainServicesName string
+	CheckInterval      time.Duration
+	NewWorker          func(Config) (worker.Worker, error)
+	Clock              clock.Clock
+	Logger             logger.Logger
+}
+
+// Validate is called by start to check for bad configuration.
+func (cfg ManifoldConfig) Validate() error {
+	if cfg.DomainServicesName == "" {
+		return jujuerrors.NotValidf("empty DomainServicesName")
+	}
+	if cfg.CheckInterval <= 0 {
+		return jujuerrors.NotValidf("invalid CheckInterval")
+	}
+	if cfg.NewWorker == nil {
+		return jujuerrors.NotValidf("nil NewWorker")
+	}
+	if cfg.Clock == nil {
+		return jujuerrors.NotValidf("nil Clock")
+	}
+	if cfg.Logger == nil {
+		return jujuerrors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// Manifold returns a dependency.Manifold that runs the secret
+// stage worker according to the supplied configuration.
+func Manifold(cfg ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			cfg.DomainServicesName,
+		},
+		Start: func(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+			if err := cfg.Validate(); err != nil {
+				return nil, errors.Capture(err)
+			}
+
+			var domainServices services.DomainServices
+			if err := getter.Get(cfg.DomainServicesName, &domainServices); err != nil {
+				return nil, errors.Capture(err)
+			}
+
+			w, err := cfg.NewWorker(Config{
+				// The worker only updates revision metadata in the model,
+				// so doesn't need access to any secret backends.
+				SecretService: domainServices.Secret(secretservice.SecretServiceParams{
+					BackendUserSecretConfigGetter: secretservice.NotImplementedBackendUserSecretConfigGetter,
+				}),
+				CheckInterval: cfg.CheckInterval,
+				Clock:         cfg.Clock,
+				Logger:        cfg.Logger,
+			})
+			if err != nil {
+				return nil, errors.Errorf("creating worker: %w", err)
+			}
+			return w, nil
+		},
+	}
+}
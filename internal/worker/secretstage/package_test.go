@@ -0,0 +1,19 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretstage
+
+import (
+	"testing"
+
+	"go.uber.org/goleak"
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package secretstage -destination package_mocks_test.go github.com/juju/juju/internal/worker/secretstage SecretService
+
+func TestPackage(t *testing.T) {
+	defer goleak.VerifyNone(t)
+
+	gc.TestingT(t)
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretstage
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/logger"
+)
+
+// DefaultCheckInterval is how often the worker checks for staged
+// revisions which can be committed or rolled back.
+const DefaultCheckInterval = time.Minute
+
+// SecretService provides the methods needed to resolve
+// staged secret rotations.
+type SecretService interface {
+	// ProcessStagedRevisions commits staged secret revisions which all
+	// consumers are tracking, and rolls back those past their deadline.
+	ProcessStagedRevisions(ctx context.Context) error
+}
+
+// Config defines the operation of the Worker.
+type Config struct {
+	SecretService SecretService
+	CheckInterval time.Duration
+	Clock         clock.Clock
+	Logger        logger.Logger
+}
+
+// Validate returns an error if config cannot drive the Worker.
+func (config Config) Validate() error {
+	if config.SecretService == nil {
+		return errors.NotValidf("nil SecretService")
+	}
+	if config.CheckInterval <= 0 {
+		return errors.NotValidf("invalid CheckInterval")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// NewWorker returns a secret stage Worker backed by config, or an error.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &Worker{config: config}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+// Worker commits or rolls back staged secret revisions.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+}
+
+// Kill is defined on worker.Worker.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() error {
+	ctx, cancel := w.scopeContext()
+	defer cancel()
+
+	// Check straight away so that any stage whose deadline
+	// passed while the controller was down is resolved.
+	var delay time.Duration
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-w.config.Clock.After(delay):
+			if err := w.config.SecretService.ProcessStagedRevisions(ctx); err != nil {
+				return errors.Annotate(err, "processing staged secret revisions")
+			}
+			delay = w.config.CheckInterval
+		}
+	}
+}
+
+func (w *Worker) scopeContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(w.catacomb.Context(context.Background()))
+}
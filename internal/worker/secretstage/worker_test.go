@@ -0,0 +1,106 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretstage
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/core/testing"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type workerSuite struct {
+	testing.IsolationSuite
+
+	clock   *testclock.Clock
+	service *MockSecretService
+}
+
+var _ = gc.Suite(&workerSuite{})
+
+func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.service = NewMockSecretService(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+	return ctrl
+}
+
+func (s *workerSuite) newWorker(c *gc.C) worker.Worker {
+	w, err := NewWorker(Config{
+		SecretService: s.service,
+		CheckInterval: time.Minute,
+		Clock:         s.clock,
+		Logger:        loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return w
+}
+
+func (s *workerSuite) waitDone(c *gc.C, done chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for worker")
+	}
+}
+
+func (s *workerSuite) TestValidateConfig(c *gc.C) {
+	cfg := Config{
+		SecretService: NewMockSecretService(gomock.NewController(c)),
+		CheckInterval: time.Minute,
+		Clock:         testclock.NewClock(time.Now()),
+		Logger:        loggertesting.WrapCheckLog(c),
+	}
+	c.Check(cfg.Validate(), jc.ErrorIsNil)
+
+	cfg.CheckInterval = 0
+	c.Check(cfg.Validate(), gc.ErrorMatches, "invalid CheckInterval not valid")
+	cfg.CheckInterval = time.Minute
+
+	cfg.SecretService = nil
+	c.Check(cfg.Validate(), gc.ErrorMatches, "nil SecretService not valid")
+}
+
+func (s *workerSuite) TestProcessesPeriodically(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	first := make(chan struct{})
+	s.service.EXPECT().ProcessStagedRevisions(gomock.Any()).DoAndReturn(func(context.Context) error {
+		close(first)
+		return nil
+	})
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+	s.waitDone(c, first)
+
+	second := make(chan struct{})
+	s.service.EXPECT().ProcessStagedRevisions(gomock.Any()).DoAndReturn(func(context.Context) error {
+		close(second)
+		return nil
+	})
+	err := s.clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	s.waitDone(c, second)
+}
+
+func (s *workerSuite) TestProcessError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.EXPECT().ProcessStagedRevisions(gomock.Any()).Return(errors.New("boom"))
+
+	w := s.newWorker(c)
+	err := workertest.CheckKilled(c, w)
+	c.Assert(err, gc.ErrorMatches, "processing staged secret revisions: boom")
+}
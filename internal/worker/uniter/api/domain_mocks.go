@@ -12,6 +12,7 @@ package api
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	uniter "github.com/juju/juju/api/agent/uniter"
 	life "github.com/juju/juju/core/life"
@@ -47,6 +48,44 @@ func (m *MockUnit) EXPECT() *MockUnitMockRecorder {
 	return m.recorder
 }
 
+// AddWorkloadMetrics mocks base method.
+func (m *MockUnit) AddWorkloadMetrics(arg0 context.Context, arg1 []params.WorkloadMetric) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkloadMetrics", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWorkloadMetrics indicates an expected call of AddWorkloadMetrics.
+func (mr *MockUnitMockRecorder) AddWorkloadMetrics(arg0, arg1 any) *MockUnitAddWorkloadMetricsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkloadMetrics", reflect.TypeOf((*MockUnit)(nil).AddWorkloadMetrics), arg0, arg1)
+	return &MockUnitAddWorkloadMetricsCall{Call: call}
+}
+
+// MockUnitAddWorkloadMetricsCall wrap *gomock.Call
+type MockUnitAddWorkloadMetricsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitAddWorkloadMetricsCall) Return(arg0 error) *MockUnitAddWorkloadMetricsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitAddWorkloadMetricsCall) Do(f func(context.Context, []params.WorkloadMetric) error) *MockUnitAddWorkloadMetricsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitAddWorkloadMetricsCall) DoAndReturn(f func(context.Context, []params.WorkloadMetric) error) *MockUnitAddWorkloadMetricsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Application mocks base method.
 func (m *MockUnit) Application(arg0 context.Context) (Application, error) {
 	m.ctrl.T.Helper()
@@ -1163,6 +1202,44 @@ func (c *MockUnitSetCharmURLCall) DoAndReturn(f func(context.Context, string) er
 	return c
 }
 
+// SetPebbleCheckStatus mocks base method.
+func (m *MockUnit) SetPebbleCheckStatus(arg0 context.Context, arg1, arg2, arg3, arg4 string, arg5 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPebbleCheckStatus", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPebbleCheckStatus indicates an expected call of SetPebbleCheckStatus.
+func (mr *MockUnitMockRecorder) SetPebbleCheckStatus(arg0, arg1, arg2, arg3, arg4, arg5 any) *MockUnitSetPebbleCheckStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPebbleCheckStatus", reflect.TypeOf((*MockUnit)(nil).SetPebbleCheckStatus), arg0, arg1, arg2, arg3, arg4, arg5)
+	return &MockUnitSetPebbleCheckStatusCall{Call: call}
+}
+
+// MockUnitSetPebbleCheckStatusCall wrap *gomock.Call
+type MockUnitSetPebbleCheckStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitSetPebbleCheckStatusCall) Return(arg0 error) *MockUnitSetPebbleCheckStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitSetPebbleCheckStatusCall) Do(f func(context.Context, string, string, string, string, time.Time) error) *MockUnitSetPebbleCheckStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitSetPebbleCheckStatusCall) DoAndReturn(f func(context.Context, string, string, string, string, time.Time) error) *MockUnitSetPebbleCheckStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetState mocks base method.
 func (m *MockUnit) SetState(arg0 context.Context, arg1 params.SetUnitStateArg) error {
 	m.ctrl.T.Helper()
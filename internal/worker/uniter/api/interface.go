@@ -5,6 +5,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/names/v6"
 
@@ -59,6 +60,8 @@ type Unit interface {
 	Tag() names.UnitTag
 	UnitStatus(context.Context) (params.StatusResult, error)
 	CommitHookChanges(context.Context, params.CommitHookChangesArgs) error
+	AddWorkloadMetrics(context.Context, []params.WorkloadMetric) error
+	SetPebbleCheckStatus(ctx context.Context, container, check, status, message string, since time.Time) error
 	PublicAddress(context.Context) (string, error)
 	PrincipalName(context.Context) (string, bool, error)
 	AssignedMachine(context.Context) (names.MachineTag, error)
@@ -0,0 +1,254 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+
+	coredeferred "github.com/juju/juju/core/deferred"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+)
+
+// DeferredHooks tracks the hooks deferred by the charm. A deferred hook
+// is run again after the next hook which is not itself a deferred hook,
+// or once its retry time has passed, whichever is sooner.
+type DeferredHooks struct {
+	clock    clock.Clock
+	logger   logger.Logger
+	stateOps *stateOps
+	changes  chan struct{}
+
+	mu    sync.Mutex
+	state *state
+}
+
+// NewDeferredHooks returns a new deferred hook tracker.
+func NewDeferredHooks(
+	ctx context.Context,
+	rw UnitStateReadWriter,
+	clock clock.Clock,
+	logger logger.Logger,
+) (DeferredHookTracker, error) {
+	d := &DeferredHooks{
+		clock:    clock,
+		logger:   logger,
+		stateOps: newStateOps(rw),
+		// The changes channel is buffered so that changes
+		// are coalesced while the timer is busy.
+		changes: make(chan struct{}, 1),
+	}
+	st, err := d.stateOps.Read(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading deferred hook state")
+	}
+	d.state = st
+	return d, nil
+}
+
+// changed writes the deferred hook state and signals the changes
+// channel. It must be called with the mutex held.
+func (d *DeferredHooks) changed(ctx context.Context) error {
+	if err := d.stateOps.Write(ctx, d.state); err != nil {
+		return errors.Trace(err)
+	}
+	select {
+	case d.changes <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// find returns the index of the supplied deferred hook, or -1 if it
+// is not deferred. It must be called with the mutex held.
+func (d *DeferredHooks) find(hi hook.Info) int {
+	id := hi.DeferredID
+	hi.DeferredID = ""
+	for i, entry := range d.state.Hooks {
+		if id != "" && entry.ID == id {
+			return i
+		}
+		if id == "" && entry.Info == hi {
+			return i
+		}
+	}
+	return -1
+}
+
+// Hooks implements DeferredHookTracker.
+func (d *DeferredHooks) Hooks() []coredeferred.Entry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := make([]coredeferred.Entry, len(d.state.Hooks))
+	for i, entry := range d.state.Hooks {
+		result[i] = entry.Entry
+	}
+	return result
+}
+
+// Ready implements DeferredHookTracker.
+func (d *DeferredHooks) Ready() []hook.Info {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.clock.Now()
+	var ready []hook.Info
+	for _, entry := range d.state.Hooks {
+		retryDue := !entry.RetryAt.IsZero() && !entry.RetryAt.After(now)
+		if !entry.Ready && !retryDue {
+			continue
+		}
+		hi := entry.Info
+		hi.DeferredID = entry.ID
+		ready = append(ready, hi)
+	}
+	return ready
+}
+
+// Changes implements DeferredHookTracker.
+func (d *DeferredHooks) Changes() <-chan struct{} {
+	return d.changes
+}
+
+// Defer implements DeferredHookTracker.
+func (d *DeferredHooks) Defer(ctx context.Context, hi hook.Info, deferral hook.Deferral) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.clock.Now()
+	i := d.find(hi)
+	if i < 0 && hi.DeferredID != "" {
+		// The hook was discarded while it was being run again.
+		hi.DeferredID = ""
+		i = d.find(hi)
+	}
+	if i < 0 {
+		d.state.LastID++
+		hi.DeferredID = ""
+		d.state.Hooks = append(d.state.Hooks, deferredHook{
+			Entry: coredeferred.Entry{
+				ID:         strconv.Itoa(d.state.LastID),
+				Hook:       deferral.Hook,
+				DeferredAt: now,
+			},
+			Info: hi,
+		})
+		i = len(d.state.Hooks) - 1
+	}
+
+	entry := &d.state.Hooks[i]
+	entry.Reason = deferral.Reason
+	entry.Deferrals++
+	entry.Ready = false
+	entry.DropOnUpgrade = deferral.DropOnUpgrade
+	entry.RetryAt = time.Time{}
+	if deferral.RetryAfter > 0 {
+		entry.RetryAt = now.Add(deferral.RetryAfter)
+	}
+	d.logger.Debugf(ctx, "hook %q deferred as %q (%d times)", entry.Hook, entry.ID, entry.Deferrals)
+	return d.changed(ctx)
+}
+
+// PrepareHook implements DeferredHookTracker.
+func (d *DeferredHooks) PrepareHook(hi hook.Info) (string, error) {
+	if hi.DeferredID == "" {
+		return "", errors.Errorf("not a deferred hook: %#v", hi)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := d.find(hi)
+	if i < 0 {
+		return "", errors.NotFoundf("deferred hook %q", hi.DeferredID)
+	}
+	return d.state.Hooks[i].Hook, nil
+}
+
+// CommitHook implements DeferredHookTracker.
+func (d *DeferredHooks) CommitHook(ctx context.Context, hi hook.Info) error {
+	if hi.DeferredID == "" {
+		return errors.Errorf("not a deferred hook: %#v", hi)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	i := d.find(hi)
+	if i < 0 {
+		// The hook was discarded while it was being run again.
+		return nil
+	}
+	d.state.Hooks = append(d.state.Hooks[:i], d.state.Hooks[i+1:]...)
+	return d.changed(ctx)
+}
+
+// Trigger implements DeferredHookTracker.
+func (d *DeferredHooks) Trigger(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	changed := false
+	for i := range d.state.Hooks {
+		if !d.state.Hooks[i].Ready {
+			d.state.Hooks[i].Ready = true
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return d.changed(ctx)
+}
+
+// CharmUpgraded implements DeferredHookTracker.
+func (d *DeferredHooks) CharmUpgraded(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	kept := d.state.Hooks[:0]
+	for _, entry := range d.state.Hooks {
+		if entry.DropOnUpgrade {
+			d.logger.Infof(ctx, "discarding deferred hook %q after charm upgrade", entry.Hook)
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	if len(kept) == len(d.state.Hooks) {
+		return nil
+	}
+	d.state.Hooks = kept
+	return d.changed(ctx)
+}
+
+// Report implements DeferredHookTracker.
+func (d *DeferredHooks) Report() map[string]interface{} {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := make(map[string]interface{})
+	for _, entry := range d.state.Hooks {
+		report := map[string]interface{}{
+			"hook":        entry.Hook,
+			"deferred-at": entry.DeferredAt,
+			"deferrals":   entry.Deferrals,
+			"ready":       entry.Ready,
+		}
+		if entry.Reason != "" {
+			report["reason"] = entry.Reason
+		}
+		if !entry.RetryAt.IsZero() {
+			report["retry-at"] = entry.RetryAt
+		}
+		result[entry.ID] = report
+	}
+	return result
+}
@@ -0,0 +1,330 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coredeferred "github.com/juju/juju/core/deferred"
+	"github.com/juju/juju/internal/charm/hooks"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/uniter/deferred"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+	operationmocks "github.com/juju/juju/internal/worker/uniter/operation/mocks"
+	"github.com/juju/juju/rpc/params"
+)
+
+var (
+	now = time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+
+	configChanged = hook.Info{Kind: hooks.ConfigChanged}
+	updateStatus  = hook.Info{Kind: hooks.UpdateStatus}
+)
+
+type deferredSuite struct {
+	clock           *testclock.Clock
+	stateReadWriter *operationmocks.MockUnitStateReadWriter
+
+	// written holds the last deferred hook state written to the unit state.
+	written *coredeferred.State
+}
+
+var _ = gc.Suite(&deferredSuite{})
+
+func (s *deferredSuite) SetUpTest(c *gc.C) {
+	s.clock = testclock.NewClock(now)
+	s.written = nil
+}
+
+func (s *deferredSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.stateReadWriter = operationmocks.NewMockUnitStateReadWriter(ctrl)
+	return ctrl
+}
+
+func (s *deferredSuite) expectState(in string) {
+	s.stateReadWriter.EXPECT().State(gomock.Any()).Return(params.UnitStateResult{DeferredState: in}, nil)
+}
+
+func (s *deferredSuite) expectSetState(c *gc.C) *operationmocks.MockUnitStateReadWriterSetStateCall {
+	return s.stateReadWriter.EXPECT().SetState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg params.SetUnitStateArg) error {
+			c.Assert(arg.DeferredState, gc.NotNil)
+			st, err := coredeferred.ParseState(*arg.DeferredState)
+			c.Assert(err, jc.ErrorIsNil)
+			s.written = st
+			return nil
+		},
+	)
+}
+
+func (s *deferredSuite) newDeferredHooks(c *gc.C) deferred.DeferredHookTracker {
+	tracker, err := deferred.NewDeferredHooks(context.Background(), s.stateReadWriter, s.clock, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+	return tracker
+}
+
+func (s *deferredSuite) assertChanged(c *gc.C, tracker deferred.DeferredHookTracker) {
+	select {
+	case <-tracker.Changes():
+	default:
+		c.Fatalf("deferred hooks not changed")
+	}
+}
+
+func (s *deferredSuite) assertNotChanged(c *gc.C, tracker deferred.DeferredHookTracker) {
+	select {
+	case <-tracker.Changes():
+		c.Fatalf("deferred hooks changed unexpectedly")
+	default:
+	}
+}
+
+func withID(hi hook.Info, id string) hook.Info {
+	hi.DeferredID = id
+	return hi
+}
+
+func (s *deferredSuite) TestNewDeferredHooksNoState(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	tracker := s.newDeferredHooks(c)
+	c.Assert(tracker.Hooks(), gc.HasLen, 0)
+	c.Assert(tracker.Ready(), gc.HasLen, 0)
+	s.assertNotChanged(c, tracker)
+}
+
+func (s *deferredSuite) TestNewDeferredHooksInvalidState(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("hooks: {")
+	_, err := deferred.NewDeferredHooks(context.Background(), s.stateReadWriter, s.clock, loggertesting.WrapCheckLog(c))
+	c.Assert(err, gc.ErrorMatches, "reading deferred hook state: parsing deferred hook state: .*")
+}
+
+func (s *deferredSuite) TestNewDeferredHooksRestoresState(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(`
+last-id: 4
+hooks:
+- id: "4"
+  hook: config-changed
+  deferred-at: 2025-03-14T10:00:00Z
+  deferrals: 2
+  ready: true
+  info:
+    kind: config-changed
+`[1:])
+	tracker := s.newDeferredHooks(c)
+	c.Assert(tracker.Hooks(), jc.DeepEquals, []coredeferred.Entry{{
+		ID:         "4",
+		Hook:       "config-changed",
+		DeferredAt: now.Add(-30 * time.Minute),
+		Deferrals:  2,
+		Ready:      true,
+	}})
+	c.Assert(tracker.Ready(), jc.DeepEquals, []hook.Info{withID(configChanged, "4")})
+}
+
+func (s *deferredSuite) TestDefer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	s.expectSetState(c)
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.Defer(context.Background(), configChanged, hook.Deferral{
+		Hook:          "config-changed",
+		Reason:        "waiting for database",
+		DropOnUpgrade: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+
+	expected := []coredeferred.Entry{{
+		ID:            "1",
+		Hook:          "config-changed",
+		Reason:        "waiting for database",
+		DeferredAt:    now,
+		Deferrals:     1,
+		DropOnUpgrade: true,
+	}}
+	c.Assert(tracker.Hooks(), jc.DeepEquals, expected)
+	c.Assert(s.written.Hooks, jc.DeepEquals, expected)
+	c.Assert(tracker.Ready(), gc.HasLen, 0)
+}
+
+func (s *deferredSuite) TestDeferSameHookTwice(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	s.expectSetState(c).Times(3)
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.Defer(context.Background(), configChanged, hook.Deferral{Hook: "config-changed"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = tracker.Defer(context.Background(), updateStatus, hook.Deferral{Hook: "update-status"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The same event is only queued once.
+	s.clock.Advance(time.Minute)
+	err = tracker.Defer(context.Background(), configChanged, hook.Deferral{Hook: "config-changed", Reason: "again"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tracker.Hooks(), jc.DeepEquals, []coredeferred.Entry{{
+		ID:         "1",
+		Hook:       "config-changed",
+		Reason:     "again",
+		DeferredAt: now,
+		Deferrals:  2,
+	}, {
+		ID:         "2",
+		Hook:       "update-status",
+		DeferredAt: now,
+		Deferrals:  1,
+	}})
+}
+
+func (s *deferredSuite) TestTrigger(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	s.expectSetState(c).Times(3)
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.Defer(context.Background(), configChanged, hook.Deferral{Hook: "config-changed"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = tracker.Defer(context.Background(), updateStatus, hook.Deferral{Hook: "update-status"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+
+	err = tracker.Trigger(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+	c.Assert(tracker.Ready(), jc.DeepEquals, []hook.Info{
+		withID(configChanged, "1"),
+		withID(updateStatus, "2"),
+	})
+
+	// Triggering again changes nothing.
+	err = tracker.Trigger(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertNotChanged(c, tracker)
+}
+
+func (s *deferredSuite) TestRetryAfter(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	s.expectSetState(c)
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.Defer(context.Background(), configChanged, hook.Deferral{
+		Hook:       "config-changed",
+		RetryAfter: 5 * time.Minute,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.written.Hooks[0].RetryAt, jc.DeepEquals, now.Add(5*time.Minute))
+	c.Assert(tracker.Ready(), gc.HasLen, 0)
+
+	s.clock.Advance(5 * time.Minute)
+	c.Assert(tracker.Ready(), jc.DeepEquals, []hook.Info{withID(configChanged, "1")})
+}
+
+func (s *deferredSuite) TestRunAgainAndCommit(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	s.expectSetState(c).Times(3)
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.Defer(context.Background(), configChanged, hook.Deferral{Hook: "config-changed"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = tracker.Trigger(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	hi := tracker.Ready()[0]
+	name, err := tracker.PrepareHook(hi)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(name, gc.Equals, "config-changed")
+
+	err = tracker.CommitHook(context.Background(), hi)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tracker.Hooks(), gc.HasLen, 0)
+	c.Assert(s.written.Hooks, gc.HasLen, 0)
+
+	_, err = tracker.PrepareHook(hi)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *deferredSuite) TestRunAgainAndDeferAgain(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	s.expectSetState(c).Times(3)
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.Defer(context.Background(), configChanged, hook.Deferral{Hook: "config-changed"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = tracker.Trigger(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	hi := tracker.Ready()[0]
+	err = tracker.Defer(context.Background(), hi, hook.Deferral{Hook: "config-changed"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(tracker.Ready(), gc.HasLen, 0)
+	c.Assert(tracker.Hooks(), jc.DeepEquals, []coredeferred.Entry{{
+		ID:         "1",
+		Hook:       "config-changed",
+		DeferredAt: now,
+		Deferrals:  2,
+	}})
+}
+
+func (s *deferredSuite) TestCommitHookNotDeferred(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.CommitHook(context.Background(), configChanged)
+	c.Assert(err, gc.ErrorMatches, "not a deferred hook: .*")
+	err = tracker.CommitHook(context.Background(), withID(configChanged, "1"))
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertNotChanged(c, tracker)
+}
+
+func (s *deferredSuite) TestCharmUpgraded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState("")
+	s.expectSetState(c).Times(3)
+
+	tracker := s.newDeferredHooks(c)
+	err := tracker.Defer(context.Background(), configChanged, hook.Deferral{Hook: "config-changed", DropOnUpgrade: true})
+	c.Assert(err, jc.ErrorIsNil)
+	err = tracker.Defer(context.Background(), updateStatus, hook.Deferral{Hook: "update-status"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = tracker.CharmUpgraded(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.written.Hooks, jc.DeepEquals, []coredeferred.Entry{{
+		ID:         "2",
+		Hook:       "update-status",
+		DeferredAt: now,
+		Deferrals:  1,
+	}})
+
+	// Nothing more to drop.
+	err = tracker.CharmUpgraded(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred
+
+import (
+	"context"
+
+	coredeferred "github.com/juju/juju/core/deferred"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+)
+
+// DeferredHookTracker provides access to the unit agent's
+// state for hooks deferred by the charm.
+type DeferredHookTracker interface {
+	// Hooks returns the deferred hooks in the order they were deferred.
+	Hooks() []coredeferred.Entry
+
+	// Ready returns the deferred hooks which are ready to be run again,
+	// in the order they were deferred.
+	Ready() []hook.Info
+
+	// Changes returns a channel which is signalled whenever the
+	// deferred hooks change.
+	Changes() <-chan struct{}
+
+	// Defer adds the supplied hook to the deferred hooks, or updates it
+	// if the hook is already deferred.
+	Defer(context.Context, hook.Info, hook.Deferral) error
+
+	// PrepareHook returns the name of the supplied deferred hook, or a
+	// NotFound error if the hook is no longer deferred.
+	PrepareHook(hook.Info) (string, error)
+
+	// CommitHook records that the supplied deferred hook has been run
+	// again without being deferred.
+	CommitHook(context.Context, hook.Info) error
+
+	// Trigger records that a hook other than a deferred hook has been
+	// run, so the deferred hooks are ready to be run again.
+	Trigger(context.Context) error
+
+	// CharmUpgraded discards the hooks deferred with drop-on-upgrade.
+	CharmUpgraded(context.Context) error
+
+	// Report provides information for the engine report.
+	Report() map[string]interface{}
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred
+
+import (
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/worker/uniter/hooktimer"
+	"github.com/juju/juju/internal/worker/uniter/remotestate"
+	"github.com/juju/juju/internal/worker/uniter/resolver"
+)
+
+// NewResolver returns a new Resolver that returns operations to run
+// deferred hooks again once they are ready. When a hook is committed, the
+// "completed" callback is invoked to remove the hook from the remote
+// state.
+func NewResolver(logger logger.Logger, tracker DeferredHookTracker, completed func(string)) resolver.Resolver {
+	return hooktimer.NewResolver(hooktimer.ResolverConfig{
+		Logger: logger,
+		Sent: func(remoteState remotestate.Snapshot) []string {
+			return remoteState.DeferredHooks
+		},
+		Due: func() []hooktimer.Hook {
+			var due []hooktimer.Hook
+			for _, hi := range tracker.Ready() {
+				due = append(due, hooktimer.Hook{ID: hi.DeferredID, Info: hi})
+			}
+			return due
+		},
+		// Deferred hooks are also ready once another hook has run,
+		// before the timer sends them.
+		RunUnsent: true,
+		Completed: completed,
+	})
+}
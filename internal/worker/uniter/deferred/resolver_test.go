@@ -0,0 +1,164 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred_test
+
+import (
+	"context"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/life"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/uniter/deferred"
+	"github.com/juju/juju/internal/worker/uniter/operation"
+	operationmocks "github.com/juju/juju/internal/worker/uniter/operation/mocks"
+	"github.com/juju/juju/internal/worker/uniter/remotestate"
+	"github.com/juju/juju/internal/worker/uniter/resolver"
+	runnermocks "github.com/juju/juju/internal/worker/uniter/runner/mocks"
+	"github.com/juju/juju/rpc/params"
+)
+
+type resolverSuite struct {
+	remoteState     remotestate.Snapshot
+	stateReadWriter *operationmocks.MockUnitStateReadWriter
+	mockCallbacks   *operationmocks.MockCallbacks
+	mockFactory     *runnermocks.MockFactory
+	mockRunner      *runnermocks.MockRunner
+	mockContext     *runnermocks.MockContext
+	opFactory       operation.Factory
+	resolver        resolver.Resolver
+	completed       []string
+}
+
+var _ = gc.Suite(&resolverSuite{})
+
+func (s *resolverSuite) SetUpTest(c *gc.C) {
+	s.remoteState = remotestate.Snapshot{
+		Life: life.Alive,
+	}
+	s.completed = nil
+}
+
+func (s *resolverSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.stateReadWriter = operationmocks.NewMockUnitStateReadWriter(ctrl)
+	s.mockCallbacks = operationmocks.NewMockCallbacks(ctrl)
+	s.mockFactory = runnermocks.NewMockFactory(ctrl)
+	s.mockRunner = runnermocks.NewMockRunner(ctrl)
+	s.mockContext = runnermocks.NewMockContext(ctrl)
+	s.opFactory = operation.NewFactory(operation.FactoryParams{
+		Callbacks:     s.mockCallbacks,
+		RunnerFactory: s.mockFactory,
+		Logger:        loggertesting.WrapCheckLog(c),
+	})
+
+	// The config-changed hook is ready to run again, the
+	// update-status hook is not.
+	s.stateReadWriter.EXPECT().State(gomock.Any()).Return(params.UnitStateResult{DeferredState: `
+last-id: 2
+hooks:
+- id: "1"
+  hook: config-changed
+  deferred-at: 2025-03-14T10:00:00Z
+  deferrals: 1
+  ready: true
+  info:
+    kind: config-changed
+- id: "2"
+  hook: update-status
+  deferred-at: 2025-03-14T10:00:00Z
+  deferrals: 1
+  info:
+    kind: update-status
+`[1:]}, nil)
+
+	logger := loggertesting.WrapCheckLog(c)
+	tracker, err := deferred.NewDeferredHooks(context.Background(), s.stateReadWriter, testclock.NewClock(now), logger)
+	c.Assert(err, jc.ErrorIsNil)
+	s.resolver = deferred.NewResolver(logger, tracker, func(id string) {
+		s.completed = append(s.completed, id)
+	})
+	return ctrl
+}
+
+func (s *resolverSuite) startedState() resolver.LocalState {
+	return resolver.LocalState{
+		State: operation.State{
+			Kind:      operation.Continue,
+			Installed: true,
+			Started:   true,
+		},
+	}
+}
+
+func (s *resolverSuite) TestNextOpNotStarted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	localState := s.startedState()
+	localState.Started = false
+	_, err := s.resolver.NextOp(context.Background(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *resolverSuite) TestNextOpDying(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.remoteState.Life = life.Dying
+	_, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *resolverSuite) TestNextOpNotReady(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	localState := s.startedState()
+	localState.Kind = operation.Upgrade
+	_, err := s.resolver.NextOp(context.Background(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *resolverSuite) TestNextOpNoLongerDue(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.remoteState.DeferredHooks = []string{"1", "2", "3"}
+	op, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run deferred config-changed hook")
+	c.Assert(s.completed, jc.DeepEquals, []string{"2", "3"})
+}
+
+func (s *resolverSuite) TestNextOpRunsReadyHook(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The hook is ready because another hook has run since it was
+	// deferred, not because its retry time has passed.
+	op, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run deferred config-changed hook")
+}
+
+func (s *resolverSuite) TestCommit(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.remoteState.DeferredHooks = []string{"1"}
+	op, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+
+	hi := withID(configChanged, "1")
+	s.mockCallbacks.EXPECT().PrepareHook(gomock.Any(), hi).Return("config-changed", nil)
+	s.mockFactory.EXPECT().NewHookRunner(gomock.Any(), hi).Return(s.mockRunner, nil)
+	s.mockRunner.EXPECT().Context().Return(s.mockContext).AnyTimes()
+	s.mockContext.EXPECT().Prepare(gomock.Any()).Return(nil)
+	_, err = op.Prepare(context.Background(), operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.completed, gc.HasLen, 0)
+
+	s.mockCallbacks.EXPECT().CommitDeferredHook(gomock.Any(), hi).Return(nil)
+	_, err = op.Commit(context.Background(), operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.completed, jc.DeepEquals, []string{"1"})
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+
+	coredeferred "github.com/juju/juju/core/deferred"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+	"github.com/juju/juju/internal/worker/uniter/hooktimer"
+	"github.com/juju/juju/rpc/params"
+)
+
+// UnitStateReadWriter encapsulates the methods from a state.Unit
+// required to set and get unit state.
+type UnitStateReadWriter = hooktimer.UnitStateReadWriter
+
+// deferredHook is a deferred hook as recorded in the unit state. The
+// hook info is only read by the unit agent, which needs it to run the
+// hook again.
+type deferredHook struct {
+	coredeferred.Entry `yaml:",inline"`
+
+	// Info is the deferred hook, without its deferred ID.
+	Info hook.Info `yaml:"info"`
+}
+
+// state holds the deferred hooks of the unit.
+type state struct {
+	// LastID is the ID given to the most recently deferred hook.
+	LastID int `yaml:"last-id,omitempty"`
+
+	Hooks []deferredHook `yaml:"hooks,omitempty"`
+}
+
+// stateOps reads and writes deferred hook state from/to the controller.
+type stateOps struct {
+	ops *hooktimer.StateOps
+}
+
+func newStateOps(rw UnitStateReadWriter) *stateOps {
+	return &stateOps{
+		ops: hooktimer.NewStateOps(rw,
+			func(unitState params.UnitStateResult) string {
+				return unitState.DeferredState
+			},
+			func(str *string) params.SetUnitStateArg {
+				return params.SetUnitStateArg{DeferredState: str}
+			},
+		),
+	}
+}
+
+// Read reads deferred hook state from the controller. If there is no
+// saved state, an empty state is returned.
+func (f *stateOps) Read(ctx context.Context) (*state, error) {
+	str, err := f.ops.Read(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	st := &state{}
+	if str == "" {
+		return st, nil
+	}
+	if err := yaml.Unmarshal([]byte(str), st); err != nil {
+		return nil, errors.Annotate(err, "parsing deferred hook state")
+	}
+	return st, nil
+}
+
+// Write stores the supplied deferred hook state to the controller.
+func (f *stateOps) Write(ctx context.Context, st *state) error {
+	if st == nil {
+		return errors.Trace(errors.BadRequestf("arg is nil"))
+	}
+	data, err := yaml.Marshal(st)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return f.ops.Write(ctx, string(data))
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred
+
+import (
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+
+	coredeferred "github.com/juju/juju/core/deferred"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/worker/uniter/hooktimer"
+)
+
+// DeferredHookSource provides the deferred hooks watched by the timer.
+type DeferredHookSource interface {
+	// Hooks returns the deferred hooks in the order they were deferred.
+	Hooks() []coredeferred.Entry
+
+	// Changes returns a channel which is signalled whenever the
+	// deferred hooks change.
+	Changes() <-chan struct{}
+}
+
+// NewTimer starts a worker which sends the ID of each deferred hook on
+// the out channel when its retry time passes. A hook is sent once for
+// each retry time, so it is not sent again until it has been deferred
+// again.
+func NewTimer(source DeferredHookSource, clock clock.Clock, out chan<- string, logger logger.Logger) worker.Worker {
+	return hooktimer.NewTimer(timerSource{source}, clock, out, logger)
+}
+
+// timerSource adapts a DeferredHookSource to the source of a hook timer.
+type timerSource struct {
+	DeferredHookSource
+}
+
+// Due is part of the hooktimer.Source interface.
+func (s timerSource) Due() []hooktimer.Due {
+	entries := s.Hooks()
+	due := make([]hooktimer.Due, len(entries))
+	for i, entry := range entries {
+		due[i] = hooktimer.Due{ID: entry.ID, At: entry.RetryAt}
+	}
+	return due
+}
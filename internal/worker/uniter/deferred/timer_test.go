@@ -0,0 +1,121 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package deferred_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	gc "gopkg.in/check.v1"
+
+	coredeferred "github.com/juju/juju/core/deferred"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/uniter/deferred"
+)
+
+type fakeDeferredHookSource struct {
+	mu      sync.Mutex
+	entries []coredeferred.Entry
+	changes chan struct{}
+}
+
+func (f *fakeDeferredHookSource) Hooks() []coredeferred.Entry {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.entries
+}
+
+func (f *fakeDeferredHookSource) Changes() <-chan struct{} {
+	return f.changes
+}
+
+func (f *fakeDeferredHookSource) setEntries(entries ...coredeferred.Entry) {
+	f.mu.Lock()
+	f.entries = entries
+	f.mu.Unlock()
+	f.changes <- struct{}{}
+}
+
+type timerSuite struct {
+	clock  *testclock.Clock
+	source *fakeDeferredHookSource
+	out    chan string
+}
+
+var _ = gc.Suite(&timerSuite{})
+
+func (s *timerSuite) SetUpTest(c *gc.C) {
+	s.clock = testclock.NewClock(now)
+	s.source = &fakeDeferredHookSource{changes: make(chan struct{})}
+	s.out = make(chan string)
+}
+
+func (s *timerSuite) assertSent(c *gc.C, id string) {
+	select {
+	case got := <-s.out:
+		c.Assert(got, gc.Equals, id)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("deferred hook %q not sent", id)
+	}
+}
+
+func (s *timerSuite) assertNotSent(c *gc.C) {
+	select {
+	case got := <-s.out:
+		c.Fatalf("unexpected deferred hook %q sent", got)
+	case <-time.After(testing.ShortWait):
+	}
+}
+
+func (s *timerSuite) TestSendsDueHook(c *gc.C) {
+	s.source.entries = []coredeferred.Entry{
+		{ID: "1", RetryAt: now.Add(2 * time.Minute)},
+		{ID: "2", RetryAt: now.Add(time.Minute)},
+		{ID: "3"},
+	}
+	w := deferred.NewTimer(s.source, s.clock, s.out, loggertesting.WrapCheckLog(c))
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(s.clock.WaitAdvance(time.Minute, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "2")
+
+	c.Assert(s.clock.WaitAdvance(time.Minute, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "1")
+
+	// Hooks without a retry time are never sent, and the others are
+	// not sent again until they are deferred again.
+	s.assertNotSent(c)
+}
+
+func (s *timerSuite) TestSendsAgainWhenDeferredAgain(c *gc.C) {
+	s.source.entries = []coredeferred.Entry{
+		{ID: "1", RetryAt: now.Add(time.Minute)},
+	}
+	w := deferred.NewTimer(s.source, s.clock, s.out, loggertesting.WrapCheckLog(c))
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(s.clock.WaitAdvance(time.Minute, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "1")
+
+	s.source.setEntries(coredeferred.Entry{ID: "1", RetryAt: now.Add(2 * time.Minute)})
+	c.Assert(s.clock.WaitAdvance(time.Minute, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "1")
+}
+
+func (s *timerSuite) TestRemovedHook(c *gc.C) {
+	s.source.entries = []coredeferred.Entry{
+		{ID: "1", RetryAt: now.Add(time.Minute)},
+	}
+	w := deferred.NewTimer(s.source, s.clock, s.out, loggertesting.WrapCheckLog(c))
+	defer workertest.CleanKill(c, w)
+
+	// The timer receives the change once it is waiting for the hook.
+	s.source.setEntries()
+	s.clock.Advance(time.Minute)
+	s.assertNotSent(c)
+}
@@ -5,6 +5,7 @@ package hook
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -70,6 +71,49 @@ type Info struct {
 
 	// SecretLabel is the secret label to expose to the hook.
 	SecretLabel string `yaml:"secret-label,omitempty"`
+
+	// ScheduleName is the name of the schedule relevant to the hook.
+	ScheduleName string `yaml:"schedule-name,omitempty"`
+
+	// DeferredID identifies the deferred hook which is being run again.
+	// It is only set when the charm previously deferred the hook.
+	DeferredID string `yaml:"deferred-id,omitempty"`
+}
+
+// Deferral holds how the charm asked for a hook to be deferred.
+type Deferral struct {
+	// Hook is the name of the deferred hook.
+	Hook string `yaml:"hook"`
+
+	// Reason is why the charm deferred the hook.
+	Reason string `yaml:"reason,omitempty"`
+
+	// RetryAfter is how long to wait before running the hook again if
+	// no other hook is run first. If zero, the hook waits for another
+	// hook to be run.
+	RetryAfter time.Duration `yaml:"retry-after,omitempty"`
+
+	// DropOnUpgrade is true if the hook is discarded when the charm
+	// is upgraded.
+	DropOnUpgrade bool `yaml:"drop-on-upgrade,omitempty"`
+}
+
+// CanDefer returns true if a hook of the given kind can be deferred
+// by the charm. Hooks which change the lifecycle of the unit, or are
+// the last of their kind for a relation or storage instance, cannot
+// be deferred.
+func CanDefer(kind hooks.Kind) bool {
+	switch kind {
+	case hooks.ConfigChanged, hooks.UpdateStatus,
+		hooks.LeaderElected,
+		hooks.RelationCreated, hooks.RelationJoined, hooks.RelationChanged, hooks.RelationDeparted,
+		hooks.StorageAttached,
+		hooks.PebbleReady, hooks.PebbleCustomNotice, hooks.PebbleCheckFailed, hooks.PebbleCheckRecovered,
+		hooks.SecretChanged,
+		hooks.Schedule:
+		return true
+	}
+	return false
 }
 
 // SecretHookRequiresRevision returns true if the hook context needs a secret revision.
@@ -130,6 +174,11 @@ func (hi Info) Validate() error {
 		return nil
 	case hooks.LeaderElected, hooks.LeaderDeposed:
 		return nil
+	case hooks.Schedule:
+		if hi.ScheduleName == "" {
+			return errors.Errorf("%q hook requires a schedule name", hi.Kind)
+		}
+		return nil
 	case hooks.SecretRotate, hooks.SecretChanged, hooks.SecretExpired, hooks.SecretRemove:
 		if hi.SecretURI == "" {
 			return errors.Errorf("%q hook requires a secret URI", hi.Kind)
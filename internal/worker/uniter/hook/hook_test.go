@@ -73,6 +73,9 @@ var validateTests = []struct {
 	}, {
 		hook.Info{Kind: hooks.SecretRotate, SecretURI: "foo"},
 		`invalid secret URI "foo"`,
+	}, {
+		hook.Info{Kind: hooks.Schedule},
+		`"schedule" hook requires a schedule name`,
 	},
 	{hook.Info{Kind: hooks.Install}, ""},
 	{hook.Info{Kind: hooks.Start}, ""},
@@ -90,6 +93,7 @@ var validateTests = []struct {
 	{hook.Info{Kind: hooks.StorageAttached, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.StorageDetaching, StorageId: "data/0"}, ""},
 	{hook.Info{Kind: hooks.PebbleReady, WorkloadName: "gitlab"}, ""},
+	{hook.Info{Kind: hooks.Schedule, ScheduleName: "backup"}, ""},
 }
 
 func (s *InfoSuite) TestValidate(c *gc.C) {
@@ -103,3 +107,19 @@ func (s *InfoSuite) TestValidate(c *gc.C) {
 		}
 	}
 }
+
+func (s *InfoSuite) TestCanDefer(c *gc.C) {
+	for _, kind := range []hooks.Kind{
+		hooks.ConfigChanged, hooks.UpdateStatus, hooks.RelationChanged,
+		hooks.PebbleReady, hooks.SecretChanged, hooks.Schedule,
+	} {
+		c.Check(hook.CanDefer(kind), jc.IsTrue, gc.Commentf("%s", kind))
+	}
+	for _, kind := range []hooks.Kind{
+		hooks.Install, hooks.Start, hooks.Stop, hooks.Remove, hooks.UpgradeCharm,
+		hooks.RelationBroken, hooks.StorageDetaching, hooks.LeaderDeposed,
+		hooks.SecretRotate, hooks.SecretExpired, hooks.SecretRemove, hooks.Action,
+	} {
+		c.Check(hook.CanDefer(kind), jc.IsFalse, gc.Commentf("%s", kind))
+	}
+}
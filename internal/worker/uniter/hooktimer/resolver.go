@@ -0,0 +1,130 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hooktimer
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+	"github.com/juju/juju/internal/worker/uniter/operation"
+	"github.com/juju/juju/internal/worker/uniter/remotestate"
+	"github.com/juju/juju/internal/worker/uniter/resolver"
+)
+
+// Hook is a hook which is due to be run.
+type Hook struct {
+	// ID identifies the hook, as sent by the timer.
+	ID string
+
+	// Info is the hook to run.
+	Info hook.Info
+}
+
+// ResolverConfig holds the configuration for a Resolver.
+type ResolverConfig struct {
+	Logger logger.Logger
+
+	// Sent returns the IDs of the hooks that the timer has sent, as
+	// recorded in the remote state.
+	Sent func(remotestate.Snapshot) []string
+
+	// Due returns the hooks which are due to be run, in the order they
+	// should be run.
+	Due func() []Hook
+
+	// RunUnsent is true if a due hook is run even if the timer hasn't
+	// sent it, for hooks that can become due before their time passes.
+	RunUnsent bool
+
+	// Completed is called with the ID of a hook once it has been run, or
+	// once it is found to be no longer due, to remove it from the remote
+	// state.
+	Completed func(id string)
+}
+
+type hookResolver struct {
+	config ResolverConfig
+}
+
+// NewResolver returns a new Resolver that returns operations to run the
+// hooks which are due. When a hook is committed, the Completed callback is
+// invoked to remove the hook from the remote state.
+func NewResolver(config ResolverConfig) resolver.Resolver {
+	return &hookResolver{config: config}
+}
+
+// NextOp is part of the resolver.Resolver interface.
+func (r *hookResolver) NextOp(
+	ctx context.Context,
+	localState resolver.LocalState,
+	remoteState remotestate.Snapshot,
+	opFactory operation.Factory,
+) (operation.Operation, error) {
+	// Nothing to do if not yet started, or the unit is dying.
+	if !localState.Started || remoteState.Life == life.Dying {
+		return nil, resolver.ErrNoOperation
+	}
+
+	// We should only evaluate the resolver logic if there is no other pending operation
+	if localState.Kind != operation.Continue {
+		return nil, resolver.ErrNoOperation
+	}
+
+	due := r.config.Due()
+	dueIDs := set.NewStrings()
+	for _, h := range due {
+		dueIDs.Add(h.ID)
+	}
+	sent := set.NewStrings()
+	for _, id := range r.config.Sent(remoteState) {
+		if !dueIDs.Contains(id) {
+			// The hook has been removed, or has already been run
+			// some other way.
+			r.config.Logger.Debugf(ctx, "hook %q no longer due", id)
+			r.config.Completed(id)
+			continue
+		}
+		sent.Add(id)
+	}
+
+	for _, h := range due {
+		if !r.config.RunUnsent && !sent.Contains(h.ID) {
+			continue
+		}
+		op, err := opFactory.NewRunHook(h.Info)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		id := h.ID
+		return &completer{
+			Operation: op,
+			completed: func() { r.config.Completed(id) },
+		}, nil
+	}
+	return nil, resolver.ErrNoOperation
+}
+
+type completer struct {
+	operation.Operation
+	completed func()
+}
+
+// Commit is part of the Operation interface.
+func (c *completer) Commit(ctx context.Context, st operation.State) (*operation.State, error) {
+	result, err := c.Operation.Commit(ctx, st)
+	if err == nil {
+		c.completed()
+	}
+	return result, err
+}
+
+// WrappedOperation is part of the WrappedOperation interface.
+func (c *completer) WrappedOperation() operation.Operation {
+	return c.Operation
+}
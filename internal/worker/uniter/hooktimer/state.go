@@ -0,0 +1,56 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hooktimer
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// UnitStateReadWriter encapsulates the methods from a state.Unit
+// required to set and get unit state.
+type UnitStateReadWriter interface {
+	State(context.Context) (params.UnitStateResult, error)
+	SetState(ctx context.Context, unitState params.SetUnitStateArg) error
+}
+
+// StateOps reads and writes one serialised field of the unit state
+// from/to the controller.
+type StateOps struct {
+	unitStateRW UnitStateReadWriter
+	get         func(params.UnitStateResult) string
+	set         func(*string) params.SetUnitStateArg
+}
+
+// NewStateOps returns a StateOps which reads the field with get, and
+// writes it with the unit state argument returned by set.
+func NewStateOps(
+	rw UnitStateReadWriter,
+	get func(params.UnitStateResult) string,
+	set func(*string) params.SetUnitStateArg,
+) *StateOps {
+	return &StateOps{
+		unitStateRW: rw,
+		get:         get,
+		set:         set,
+	}
+}
+
+// Read reads the field from the controller. If there is no saved state,
+// an empty string is returned.
+func (f *StateOps) Read(ctx context.Context) (string, error) {
+	unitState, err := f.unitStateRW.State(ctx)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return f.get(unitState), nil
+}
+
+// Write stores the supplied field to the controller.
+func (f *StateOps) Write(ctx context.Context, str string) error {
+	return errors.Trace(f.unitStateRW.SetState(ctx, f.set(&str)))
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package hooktimer
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+	"gopkg.in/tomb.v2"
+
+	"github.com/juju/juju/core/logger"
+)
+
+// Due is when a hook is next due to be run.
+type Due struct {
+	// ID identifies the hook, and is sent by the timer when the hook
+	// becomes due.
+	ID string
+
+	// At is when the hook is next due. A hook with a zero time is not
+	// due to be run.
+	At time.Time
+}
+
+// Source provides the hooks watched by the timer.
+type Source interface {
+	// Due returns when each hook is next due. Hooks that are due at the
+	// same time are sent in the order they are returned.
+	Due() []Due
+
+	// Changes returns a channel which is signalled whenever the hooks
+	// or the times they are next due change.
+	Changes() <-chan struct{}
+}
+
+type timer struct {
+	tomb   tomb.Tomb
+	source Source
+	clock  clock.Clock
+	out    chan<- string
+	logger logger.Logger
+}
+
+// NewTimer starts a worker which sends the ID of each hook on the out
+// channel when it becomes due. A hook is sent once for each time it is
+// due, so it is not sent again until the time it is next due changes.
+func NewTimer(source Source, clock clock.Clock, out chan<- string, logger logger.Logger) worker.Worker {
+	t := &timer{
+		source: source,
+		clock:  clock,
+		out:    out,
+		logger: logger,
+	}
+	t.tomb.Go(t.loop)
+	return t
+}
+
+// Kill is part of the worker.Worker interface.
+func (t *timer) Kill() {
+	t.tomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (t *timer) Wait() error {
+	return t.tomb.Wait()
+}
+
+func (t *timer) loop() error {
+	sent := make(map[string]time.Time)
+	for {
+		var next Due
+		for _, due := range t.source.Due() {
+			if due.At.IsZero() || sent[due.ID].Equal(due.At) {
+				continue
+			}
+			if next.ID == "" || due.At.Before(next.At) {
+				next = due
+			}
+		}
+
+		var fire <-chan time.Time
+		if next.ID != "" {
+			t.logger.Tracef(context.TODO(), "hook %q next due at %v", next.ID, next.At)
+			fire = t.clock.After(next.At.Sub(t.clock.Now()))
+		}
+
+		select {
+		case <-t.tomb.Dying():
+			return tomb.ErrDying
+		case <-t.source.Changes():
+		case <-fire:
+			select {
+			case <-t.tomb.Dying():
+				return tomb.ErrDying
+			case t.out <- next.ID:
+			}
+			sent[next.ID] = next.At
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/internal/charm/hooks"
 	"github.com/juju/juju/internal/worker/uniter/charm"
 	"github.com/juju/juju/internal/worker/uniter/hook"
+	"github.com/juju/juju/internal/worker/uniter/operation"
 	"github.com/juju/juju/internal/worker/uniter/runner/context"
 	"github.com/juju/juju/rpc/params"
 )
@@ -26,6 +27,9 @@ type operationCallbacks struct {
 
 // PrepareHook is part of the operation.Callbacks interface.
 func (opc *operationCallbacks) PrepareHook(ctx stdcontext.Context, hi hook.Info) (string, error) {
+	if hi.DeferredID != "" {
+		return opc.prepareDeferredHook(hi)
+	}
 	name := string(hi.Kind)
 	switch {
 	case hi.Kind.IsWorkload():
@@ -52,6 +56,8 @@ func (opc *operationCallbacks) PrepareHook(ctx stdcontext.Context, hi hook.Info)
 		if err != nil {
 			return "", err
 		}
+	case hi.Kind.IsSchedule():
+		name = fmt.Sprintf("%s-%s", hi.Kind, hi.ScheduleName)
 	case hi.Kind == hooks.ConfigChanged:
 		// TODO(axw)
 		//opc.u.f.DiscardConfigEvent()
@@ -59,8 +65,27 @@ func (opc *operationCallbacks) PrepareHook(ctx stdcontext.Context, hi hook.Info)
 	return name, nil
 }
 
+// prepareDeferredHook prepares a deferred hook to be run again. The hook
+// is skipped, and so discarded, if it no longer applies to the unit.
+func (opc *operationCallbacks) prepareDeferredHook(hi hook.Info) (string, error) {
+	if hi.Kind.IsRelation() && !opc.u.relationStateTracker.IsKnown(hi.RelationId) {
+		opc.u.logger.Infof(stdcontext.TODO(), "discarding deferred %q hook for departed relation %d", hi.Kind, hi.RelationId)
+		return "", operation.ErrSkipExecute
+	}
+	name, err := opc.u.deferredHooks.PrepareHook(hi)
+	if errors.Is(err, errors.NotFound) {
+		return "", operation.ErrSkipExecute
+	}
+	return name, err
+}
+
 // CommitHook is part of the operation.Callbacks interface.
 func (opc *operationCallbacks) CommitHook(ctx stdcontext.Context, hi hook.Info) error {
+	// Any hook run gives the charm a chance to handle the
+	// hooks it deferred earlier.
+	if err := opc.u.deferredHooks.Trigger(ctx); err != nil {
+		return errors.Trace(err)
+	}
 	switch {
 	case hi.Kind == hooks.Start:
 		opc.u.Probe.SetHasStarted(true)
@@ -73,10 +98,31 @@ func (opc *operationCallbacks) CommitHook(ctx stdcontext.Context, hi hook.Info)
 		return opc.u.storage.CommitHook(ctx, hi)
 	case hi.Kind.IsSecret():
 		return opc.u.secretsTracker.CommitHook(ctx, hi)
+	case hi.Kind.IsSchedule():
+		return opc.u.schedules.CommitHook(ctx, hi)
+	case hi.Kind == hooks.UpgradeCharm:
+		if err := opc.u.deferredHooks.CharmUpgraded(ctx); err != nil {
+			return errors.Trace(err)
+		}
+		// The charm's declared schedules may have changed.
+		return opc.u.refreshCharmSchedules(ctx)
+	case hi.Kind == hooks.Install:
+		// The charm's declared schedules may have changed.
+		return opc.u.refreshCharmSchedules(ctx)
 	}
 	return nil
 }
 
+// DeferHook is part of the operation.Callbacks interface.
+func (opc *operationCallbacks) DeferHook(ctx stdcontext.Context, hi hook.Info, deferral hook.Deferral) error {
+	return opc.u.deferredHooks.Defer(ctx, hi, deferral)
+}
+
+// CommitDeferredHook is part of the operation.Callbacks interface.
+func (opc *operationCallbacks) CommitDeferredHook(ctx stdcontext.Context, hi hook.Info) error {
+	return opc.u.deferredHooks.CommitHook(ctx, hi)
+}
+
 func notifyHook(hook string, ctx context.Context, method func(string)) {
 	if r, err := ctx.HookRelation(); err == nil {
 		remote, _ := ctx.RemoteUnitName()
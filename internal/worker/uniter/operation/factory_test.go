@@ -231,6 +231,12 @@ func (s *FactorySuite) TestNewHookString_Skip(c *gc.C) {
 	c.Check(op.String(), gc.Equals, "skip run relation-joined (123; unit: foo/22) hook")
 }
 
+func (s *FactorySuite) TestNewHookString_Deferred(c *gc.C) {
+	op, err := s.factory.NewRunHook(hook.Info{Kind: hooks.ConfigChanged, DeferredID: "1"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(op.String(), gc.Equals, "run deferred config-changed hook")
+}
+
 func (s *FactorySuite) TestNewAcceptLeadershipString(c *gc.C) {
 	op, err := s.factory.NewAcceptLeadership()
 	c.Assert(err, jc.ErrorIsNil)
@@ -183,6 +183,13 @@ type Callbacks interface {
 	PrepareHook(ctx stdcontext.Context, info hook.Info) (name string, err error)
 	CommitHook(ctx stdcontext.Context, info hook.Info) error
 
+	// DeferHook adds the supplied hook to the queue of hooks deferred by
+	// the charm, or updates it if the hook was being run again.
+	// CommitDeferredHook removes a deferred hook which has been run again
+	// without being deferred. They're only used by RunHook operations.
+	DeferHook(ctx stdcontext.Context, info hook.Info, deferral hook.Deferral) error
+	CommitDeferredHook(ctx stdcontext.Context, info hook.Info) error
+
 	// SetExecutingStatus sets the agent state to "Executing" with a message.
 	SetExecutingStatus(stdcontext.Context, string) error
 
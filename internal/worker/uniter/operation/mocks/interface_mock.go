@@ -864,6 +864,44 @@ func (c *MockCallbacksActionStatusCall) DoAndReturn(f func(context.Context, stri
 	return c
 }
 
+// CommitDeferredHook mocks base method.
+func (m *MockCallbacks) CommitDeferredHook(arg0 context.Context, arg1 hook.Info) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitDeferredHook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitDeferredHook indicates an expected call of CommitDeferredHook.
+func (mr *MockCallbacksMockRecorder) CommitDeferredHook(arg0, arg1 any) *MockCallbacksCommitDeferredHookCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitDeferredHook", reflect.TypeOf((*MockCallbacks)(nil).CommitDeferredHook), arg0, arg1)
+	return &MockCallbacksCommitDeferredHookCall{Call: call}
+}
+
+// MockCallbacksCommitDeferredHookCall wrap *gomock.Call
+type MockCallbacksCommitDeferredHookCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCallbacksCommitDeferredHookCall) Return(arg0 error) *MockCallbacksCommitDeferredHookCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCallbacksCommitDeferredHookCall) Do(f func(context.Context, hook.Info) error) *MockCallbacksCommitDeferredHookCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCallbacksCommitDeferredHookCall) DoAndReturn(f func(context.Context, hook.Info) error) *MockCallbacksCommitDeferredHookCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CommitHook mocks base method.
 func (m *MockCallbacks) CommitHook(arg0 context.Context, arg1 hook.Info) error {
 	m.ctrl.T.Helper()
@@ -902,6 +940,44 @@ func (c *MockCallbacksCommitHookCall) DoAndReturn(f func(context.Context, hook.I
 	return c
 }
 
+// DeferHook mocks base method.
+func (m *MockCallbacks) DeferHook(arg0 context.Context, arg1 hook.Info, arg2 hook.Deferral) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferHook", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferHook indicates an expected call of DeferHook.
+func (mr *MockCallbacksMockRecorder) DeferHook(arg0, arg1, arg2 any) *MockCallbacksDeferHookCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferHook", reflect.TypeOf((*MockCallbacks)(nil).DeferHook), arg0, arg1, arg2)
+	return &MockCallbacksDeferHookCall{Call: call}
+}
+
+// MockCallbacksDeferHookCall wrap *gomock.Call
+type MockCallbacksDeferHookCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCallbacksDeferHookCall) Return(arg0 error) *MockCallbacksDeferHookCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCallbacksDeferHookCall) Do(f func(context.Context, hook.Info, hook.Deferral) error) *MockCallbacksDeferHookCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCallbacksDeferHookCall) DoAndReturn(f func(context.Context, hook.Info, hook.Deferral) error) *MockCallbacksDeferHookCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FailAction mocks base method.
 func (m *MockCallbacks) FailAction(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
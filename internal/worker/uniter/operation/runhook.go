@@ -57,6 +57,11 @@ func (rh *runHook) String() string {
 		} else {
 			suffix = fmt.Sprintf(" (%s/%d)", rh.info.SecretURI, rh.info.SecretRevision)
 		}
+	case rh.info.Kind.IsSchedule():
+		suffix = fmt.Sprintf(" (%s)", rh.info.ScheduleName)
+	}
+	if rh.info.DeferredID != "" {
+		return fmt.Sprintf("run deferred %s%s hook", rh.info.Kind, suffix)
 	}
 	return fmt.Sprintf("run %s%s hook", rh.info.Kind, suffix)
 }
@@ -153,6 +158,7 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 
 	handlerType, err := rh.runner.RunHook(ctx, rh.name)
 	cause := errors.Cause(err)
+	timeoutErr, timedOut := errors.AsType[*runner.TimeoutError](err)
 	switch {
 	case charmrunner.IsMissingHookError(cause):
 		rh.hookFound = false
@@ -162,6 +168,18 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 		fallthrough
 	case cause == context.ErrReboot:
 		err = ErrNeedsReboot
+	case timedOut:
+		// The hook was killed, so the unit is put into an error state that
+		// records the timeout, which can be resolved like any other failed
+		// hook.
+		rh.logger.Errorf(ctx, "hook %q (via %s) %v", rh.name, handlerType, timeoutErr)
+		rh.callbacks.NotifyHookFailed(rh.name, rh.runner.Context())
+		return stateChange{
+			Kind:        RunHook,
+			Step:        Pending,
+			Hook:        &rh.info,
+			HookTimeout: timeoutErr.Timeout,
+		}.apply(state), ErrHookFailed
 	case cause == runner.ErrTerminated:
 		// Queue the hook again so it is re-run.
 		// It is likely the whole process group was terminated as
@@ -194,11 +212,25 @@ func (rh *runHook) Execute(ctx stdcontext.Context, state State) (*State, error)
 	if hasRunStatusSet, afterHookErr = rh.afterHook(ctx, state); afterHookErr != nil {
 		return nil, afterHookErr
 	}
+
+	// The deferral is recorded in the state, so that the hook is
+	// deferred when it is committed even if the agent restarts.
+	var deferral *hook.Deferral
+	if d := rh.runner.Context().HookDeferral(); d != nil && rh.hookFound && step == Done {
+		rh.logger.Infof(ctx, "%q hook deferred: %s", rh.name, d.Reason)
+		deferral = &hook.Deferral{
+			Hook:          rh.name,
+			Reason:        d.Reason,
+			RetryAfter:    d.RetryAfter,
+			DropOnUpgrade: d.DropOnUpgrade,
+		}
+	}
 	return stateChange{
 		Kind:            RunHook,
 		Step:            step,
 		Hook:            &rh.info,
 		HookStep:        &step,
+		Deferral:        deferral,
 		HasRunStatusSet: hasRunStatusSet,
 	}.apply(state), err
 }
@@ -285,11 +317,20 @@ func (rh *runHook) afterHook(stdCtx stdcontext.Context, state State) (_ bool, er
 // config-changed hooks to directly follow install and upgrade-charm hooks.
 // Commit is part of the Operation interface.
 func (rh *runHook) Commit(ctx stdcontext.Context, state State) (*State, error) {
+	if rh.info.DeferredID != "" {
+		return rh.commitDeferred(ctx, state)
+	}
+
 	var err error
 	err = rh.callbacks.CommitHook(ctx, rh.info)
 	if err != nil {
 		return nil, errors.Annotatef(err, "committing hook %q", rh.name)
 	}
+	if state.Deferral != nil {
+		if err := rh.callbacks.DeferHook(ctx, rh.info, *state.Deferral); err != nil {
+			return nil, errors.Annotatef(err, "deferring hook %q", state.Deferral.Hook)
+		}
+	}
 
 	change := stateChange{
 		Kind: Continue,
@@ -347,6 +388,25 @@ func (rh *runHook) Commit(ctx stdcontext.Context, state State) (*State, error) {
 	return newState, nil
 }
 
+// commitDeferred commits a deferred hook which has been run again. The
+// hook's effects on the uniter state were committed when it was first
+// run, so only the queue of deferred hooks is updated.
+func (rh *runHook) commitDeferred(ctx stdcontext.Context, state State) (*State, error) {
+	var err error
+	if state.Deferral != nil {
+		err = rh.callbacks.DeferHook(ctx, rh.info, *state.Deferral)
+	} else {
+		err = rh.callbacks.CommitDeferredHook(ctx, rh.info)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "committing deferred hook %q", rh.name)
+	}
+	return stateChange{
+		Kind: Continue,
+		Step: Pending,
+	}.apply(state), nil
+}
+
 // RemoteStateChanged is called when the remote state changed during execution
 // of the operation.
 func (rh *runHook) RemoteStateChanged(snapshot remotestate.Snapshot) {
@@ -5,6 +5,7 @@ package operation_test
 
 import (
 	stdcontext "context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/testing"
@@ -291,6 +292,25 @@ func (s *RunHookSuite) TestExecuteOtherError(c *gc.C) {
 	c.Assert(callbacks.MockNotifyHookCompleted.gotName, gc.IsNil)
 }
 
+func (s *RunHookSuite) TestExecuteTimedOut(c *gc.C) {
+	runErr := errors.Trace(&runner.TimeoutError{Timeout: 10 * time.Minute})
+	op, callbacks, runnerFactory := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, runErr)
+	_, err := op.Prepare(stdcontext.Background(), operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	newState, err := op.Execute(stdcontext.Background(), operation.State{})
+	c.Assert(err, gc.Equals, operation.ErrHookFailed)
+
+	// The hook is left pending, like any other failed hook, but with the
+	// timeout recorded.
+	s.assertStateMatches(c, newState, operation.RunHook, operation.Pending, hooks.ConfigChanged)
+	c.Assert(newState.HookTimeout, gc.Equals, 10*time.Minute)
+
+	c.Assert(*runnerFactory.MockNewHookRunner.runner.MockRunHook.gotName, gc.Equals, "config-changed")
+	c.Assert(*callbacks.MockNotifyHookFailed.gotName, gc.Equals, "config-changed")
+	c.Assert(callbacks.MockNotifyHookCompleted.gotName, gc.IsNil)
+}
+
 func (s *RunHookSuite) TestExecuteTerminated(c *gc.C) {
 	runErr := runner.ErrTerminated
 	op, callbacks, runnerFactory := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, runErr)
@@ -980,3 +1000,119 @@ func (s *RunHookSuite) assertPrepareSecretHookErrorNotLeader(c *gc.C, kind hooks
 	_, err = op.Prepare(stdcontext.Background(), operation.State{})
 	c.Assert(err, gc.Equals, operation.ErrSkipExecute)
 }
+
+func (s *RunHookSuite) TestExecuteDeferral(c *gc.C) {
+	op, _, _ := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, nil,
+		func(ctx *MockContext) {
+			ctx.deferral = &jujuc.HookDeferral{Reason: "database not ready", RetryAfter: time.Minute}
+		},
+	)
+	midState, err := op.Prepare(stdcontext.Background(), operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	newState, err := op.Execute(stdcontext.Background(), *midState)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertStateMatches(c, newState, operation.RunHook, operation.Done, hooks.ConfigChanged)
+	c.Assert(newState.Deferral, jc.DeepEquals, &hook.Deferral{
+		Hook:       "config-changed",
+		Reason:     "database not ready",
+		RetryAfter: time.Minute,
+	})
+}
+
+func (s *RunHookSuite) TestExecuteDeferralHookFailed(c *gc.C) {
+	op, _, _ := s.getExecuteRunnerTest(c, operation.Factory.NewRunHook, hooks.ConfigChanged, errors.New("graaargh"),
+		func(ctx *MockContext) {
+			ctx.deferral = &jujuc.HookDeferral{Reason: "database not ready"}
+		},
+	)
+	midState, err := op.Prepare(stdcontext.Background(), operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	newState, err := op.Execute(stdcontext.Background(), *midState)
+	c.Assert(err, gc.Equals, operation.ErrHookFailed)
+	c.Assert(newState, gc.IsNil)
+}
+
+func (s *RunHookSuite) TestCommitDeferral(c *gc.C) {
+	callbacks := &CommitHookCallbacks{
+		MockCommitHook: &MockCommitHook{},
+	}
+	factory := newOpFactory(c, nil, callbacks)
+	op, err := factory.NewRunHook(hook.Info{Kind: hooks.UpdateStatus})
+	c.Assert(err, jc.ErrorIsNil)
+
+	deferral := hook.Deferral{Hook: "update-status", Reason: "workload busy"}
+	newState, err := op.Commit(stdcontext.Background(), operation.State{
+		Started:  true,
+		Kind:     operation.RunHook,
+		Step:     operation.Done,
+		Hook:     &hook.Info{Kind: hooks.UpdateStatus},
+		Deferral: &deferral,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(newState, jc.DeepEquals, &operation.State{
+		Started: true,
+		Kind:    operation.Continue,
+		Step:    operation.Pending,
+	})
+	c.Assert(*callbacks.MockCommitHook.gotHook, gc.Equals, hook.Info{Kind: hooks.UpdateStatus})
+	c.Assert(*callbacks.deferredHook, gc.Equals, hook.Info{Kind: hooks.UpdateStatus})
+	c.Assert(*callbacks.deferral, gc.Equals, deferral)
+	c.Assert(callbacks.committedDeferred, gc.IsNil)
+}
+
+func (s *RunHookSuite) TestCommitDeferredHook(c *gc.C) {
+	for i, newHook := range []newHook{
+		operation.Factory.NewRunHook,
+		operation.Factory.NewSkipHook,
+	} {
+		c.Logf("variant %d", i)
+		callbacks := &CommitHookCallbacks{
+			MockCommitHook: &MockCommitHook{},
+		}
+		factory := newOpFactory(c, nil, callbacks)
+		info := hook.Info{Kind: hooks.ConfigChanged, DeferredID: "1"}
+		op, err := newHook(factory, info)
+		c.Assert(err, jc.ErrorIsNil)
+
+		// The effects of the hook were committed when it was first run,
+		// so the start hook is not queued again.
+		newState, err := op.Commit(stdcontext.Background(), operation.State{})
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(newState, jc.DeepEquals, &operation.State{
+			Kind: operation.Continue,
+			Step: operation.Pending,
+		})
+		c.Assert(callbacks.MockCommitHook.gotHook, gc.IsNil)
+		c.Assert(*callbacks.committedDeferred, gc.Equals, info)
+		c.Assert(callbacks.deferredHook, gc.IsNil)
+	}
+}
+
+func (s *RunHookSuite) TestCommitDeferredHookDeferredAgain(c *gc.C) {
+	callbacks := &CommitHookCallbacks{
+		MockCommitHook: &MockCommitHook{},
+	}
+	factory := newOpFactory(c, nil, callbacks)
+	info := hook.Info{Kind: hooks.ConfigChanged, DeferredID: "1"}
+	op, err := factory.NewRunHook(info)
+	c.Assert(err, jc.ErrorIsNil)
+
+	deferral := hook.Deferral{Hook: "config-changed", Reason: "still not ready"}
+	newState, err := op.Commit(stdcontext.Background(), operation.State{
+		Kind:     operation.RunHook,
+		Step:     operation.Done,
+		Hook:     &info,
+		Deferral: &deferral,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(newState, jc.DeepEquals, &operation.State{
+		Kind: operation.Continue,
+		Step: operation.Pending,
+	})
+	c.Assert(callbacks.MockCommitHook.gotHook, gc.IsNil)
+	c.Assert(*callbacks.deferredHook, gc.Equals, info)
+	c.Assert(*callbacks.deferral, gc.Equals, deferral)
+	c.Assert(callbacks.committedDeferred, gc.IsNil)
+}
@@ -5,6 +5,7 @@ package operation
 
 import (
 	stdcontext "context"
+	"time"
 
 	"github.com/juju/errors"
 	"gopkg.in/yaml.v2"
@@ -98,6 +99,14 @@ type State struct {
 	// state when initialising the agent and running any upgrade operation.
 	HookStep *Step `yaml:"hook-step,omitempty"`
 
+	// HookTimeout is set to the timeout of the hook if the hook failed
+	// because it was killed for running for longer than the timeout.
+	HookTimeout time.Duration `yaml:"hook-timeout,omitempty"`
+
+	// Deferral is set if the charm deferred the hook, which is added
+	// to the unit's deferred hooks when the hook is committed.
+	Deferral *hook.Deferral `yaml:"deferral,omitempty"`
+
 	// ActionId holds action information relevant to the current operation. If
 	// Kind is Continue, it holds the last action that was executed; if Kind is
 	// RunAction, it holds the running action.
@@ -215,6 +224,8 @@ type stateChange struct {
 	Step            Step
 	Hook            *hook.Info
 	HookStep        *Step
+	HookTimeout     time.Duration
+	Deferral        *hook.Deferral
 	ActionId        *string
 	CharmURL        string
 	HasRunStatusSet bool
@@ -225,6 +236,8 @@ func (change stateChange) apply(state State) *State {
 	state.Step = change.Step
 	state.Hook = change.Hook
 	state.HookStep = change.HookStep
+	state.HookTimeout = change.HookTimeout
+	state.Deferral = change.Deferral
 	state.ActionId = change.ActionId
 	state.CharmURL = change.CharmURL
 	state.StatusSet = state.StatusSet || change.HasRunStatusSet
@@ -226,6 +226,10 @@ type CommitHookCallbacks struct {
 
 	rotatedSecretURI   string
 	rotatedOldRevision int
+
+	deferredHook      *hook.Info
+	deferral          *hook.Deferral
+	committedDeferred *hook.Info
 }
 
 func (cb *CommitHookCallbacks) PrepareHook(_ context.Context, hookInfo hook.Info) (string, error) {
@@ -236,6 +240,17 @@ func (cb *CommitHookCallbacks) CommitHook(_ context.Context, hookInfo hook.Info)
 	return cb.MockCommitHook.Call(hookInfo)
 }
 
+func (cb *CommitHookCallbacks) DeferHook(_ context.Context, hookInfo hook.Info, deferral hook.Deferral) error {
+	cb.deferredHook = &hookInfo
+	cb.deferral = &deferral
+	return nil
+}
+
+func (cb *CommitHookCallbacks) CommitDeferredHook(_ context.Context, hookInfo hook.Info) error {
+	cb.committedDeferred = &hookInfo
+	return nil
+}
+
 func (cb *CommitHookCallbacks) SetSecretRotated(_ context.Context, url string, oldRevision int) error {
 	cb.rotatedSecretURI = url
 	cb.rotatedOldRevision = oldRevision
@@ -326,6 +341,7 @@ type MockContext struct {
 	status          jujuc.StatusInfo
 	isLeader        bool
 	relation        *MockRelation
+	deferral        *jujuc.HookDeferral
 }
 
 func (mock *MockContext) SecretMetadata() (map[string]jujuc.SecretMetadata, error) {
@@ -356,6 +372,10 @@ func (mock *MockContext) ResetExecutionSetUnitStatus() {
 	mock.setStatusCalled = false
 }
 
+func (mock *MockContext) HookDeferral() *jujuc.HookDeferral {
+	return mock.deferral
+}
+
 func (mock *MockContext) SetUnitStatus(_ context.Context, status jujuc.StatusInfo) error {
 	mock.setStatusCalled = true
 	mock.status = status
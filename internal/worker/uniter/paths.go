@@ -69,6 +69,11 @@ func (paths Paths) GetMetricsSpoolDir() string {
 	return paths.State.MetricsSpoolDir
 }
 
+// GetHookSnapshotsDir exists to satisfy the runner.Paths interface.
+func (paths Paths) GetHookSnapshotsDir() string {
+	return paths.State.HookSnapshotsDir
+}
+
 // SocketPair is a server+client pair of socket descriptors.
 type SocketPair struct {
 	Server sockets.Socket
@@ -108,6 +113,10 @@ type StatePaths struct {
 	// MetricsSpoolDir acts as temporary storage for metrics being sent from
 	// the uniter to state.
 	MetricsSpoolDir string
+
+	// HookSnapshotsDir holds the snapshots of the hooks run by the uniter,
+	// used to replay them.
+	HookSnapshotsDir string
 }
 
 // SocketConfig specifies information for remote sockets.
@@ -141,12 +150,13 @@ func NewWorkerPaths(dataDir string, unitTag names.UnitTag, worker string, socket
 			LocalJujucServerSocket: newUnixSocket(baseDir, unitTag, worker, "agent"),
 		},
 		State: StatePaths{
-			BaseDir:         baseDir,
-			CharmDir:        join(baseDir, "charm"),
-			ResourcesDir:    join(baseDir, "resources"),
-			BundlesDir:      join(stateDir, "bundles"),
-			DeployerDir:     join(stateDir, "deployer"),
-			MetricsSpoolDir: join(stateDir, "spool", "metrics"),
+			BaseDir:          baseDir,
+			CharmDir:         join(baseDir, "charm"),
+			ResourcesDir:     join(baseDir, "resources"),
+			BundlesDir:       join(stateDir, "bundles"),
+			DeployerDir:      join(stateDir, "deployer"),
+			MetricsSpoolDir:  join(stateDir, "spool", "metrics"),
+			HookSnapshotsDir: join(stateDir, "hook-snapshots"),
 		},
 	}
 }
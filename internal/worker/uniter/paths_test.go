@@ -50,12 +50,13 @@ func (s *PathsSuite) TestOther(c *gc.C) {
 			LocalJujucServerSocket: uniter.SocketPair{localJujucSocket, localJujucSocket},
 		},
 		State: uniter.StatePaths{
-			BaseDir:         relAgent(),
-			CharmDir:        relAgent("charm"),
-			ResourcesDir:    relAgent("resources"),
-			BundlesDir:      relAgent("state", "bundles"),
-			DeployerDir:     relAgent("state", "deployer"),
-			MetricsSpoolDir: relAgent("state", "spool", "metrics"),
+			BaseDir:          relAgent(),
+			CharmDir:         relAgent("charm"),
+			ResourcesDir:     relAgent("resources"),
+			BundlesDir:       relAgent("state", "bundles"),
+			DeployerDir:      relAgent("state", "deployer"),
+			MetricsSpoolDir:  relAgent("state", "spool", "metrics"),
+			HookSnapshotsDir: relAgent("state", "hook-snapshots"),
 		},
 	})
 }
@@ -79,12 +80,13 @@ func (s *PathsSuite) TestWorkerPaths(c *gc.C) {
 			LocalJujucServerSocket: uniter.SocketPair{localJujucSocket, localJujucSocket},
 		},
 		State: uniter.StatePaths{
-			BaseDir:         relAgent(),
-			CharmDir:        relAgent("charm"),
-			ResourcesDir:    relAgent("resources"),
-			BundlesDir:      relAgent("state", "bundles"),
-			DeployerDir:     relAgent("state", "deployer"),
-			MetricsSpoolDir: relAgent("state", "spool", "metrics"),
+			BaseDir:          relAgent(),
+			CharmDir:         relAgent("charm"),
+			ResourcesDir:     relAgent("resources"),
+			BundlesDir:       relAgent("state", "bundles"),
+			DeployerDir:      relAgent("state", "deployer"),
+			MetricsSpoolDir:  relAgent("state", "spool", "metrics"),
+			HookSnapshotsDir: relAgent("state", "hook-snapshots"),
 		},
 	})
 }
@@ -96,12 +98,14 @@ func (s *PathsSuite) TestContextInterface(c *gc.C) {
 			LocalJujucServerSocket: uniter.SocketPair{Server: sockets.Socket{Network: "unix", Address: "/path/to/socket"}},
 		},
 		State: uniter.StatePaths{
-			CharmDir:        "/path/to/charm",
-			MetricsSpoolDir: "/path/to/spool/metrics",
+			CharmDir:         "/path/to/charm",
+			MetricsSpoolDir:  "/path/to/spool/metrics",
+			HookSnapshotsDir: "/path/to/hook-snapshots",
 		},
 	}
 	c.Assert(paths.GetToolsDir(), gc.Equals, "/path/to/tools")
 	c.Assert(paths.GetCharmDir(), gc.Equals, "/path/to/charm")
 	c.Assert(paths.GetJujucServerSocket(), gc.DeepEquals, sockets.Socket{Address: "/path/to/socket", Network: "unix"})
 	c.Assert(paths.GetMetricsSpoolDir(), gc.Equals, "/path/to/spool/metrics")
+	c.Assert(paths.GetHookSnapshotsDir(), gc.Equals, "/path/to/hook-snapshots")
 }
@@ -22,19 +22,30 @@ type pebbleNoticer struct {
 	clock             clock.Clock
 	workloadEventChan chan string
 	workloadEvents    container.WorkloadEvents
+	checkStatusSetter PebbleCheckStatusSetter
 	newPebbleClient   NewPebbleClientFunc
 
 	tomb tomb.Tomb
 }
 
+// PebbleCheckStatusSetter records the status of Pebble checks with the
+// controller.
+type PebbleCheckStatusSetter interface {
+	// SetPebbleCheckStatus records the status, either "up" or "down", of a
+	// Pebble check in one of the unit's workload containers.
+	SetPebbleCheckStatus(ctx stdcontext.Context, container, check, status, message string, since time.Time) error
+}
+
 // NewPebbleNoticer starts a worker that watches for Pebble notices on the
-// specified containers.
+// specified containers. Pebble check failures and recoveries are recorded
+// with the checkStatusSetter, if one is given, before being sent to the charm.
 func NewPebbleNoticer(
 	logger logger.Logger,
 	clock clock.Clock,
 	containerNames []string,
 	workloadEventChan chan string,
 	workloadEvents container.WorkloadEvents,
+	checkStatusSetter PebbleCheckStatusSetter,
 	newPebbleClient NewPebbleClientFunc,
 ) worker.Worker {
 	if newPebbleClient == nil {
@@ -47,6 +58,7 @@ func NewPebbleNoticer(
 		clock:             clock,
 		workloadEventChan: workloadEventChan,
 		workloadEvents:    workloadEvents,
+		checkStatusSetter: checkStatusSetter,
 		newPebbleClient:   newPebbleClient,
 	}
 	for _, name := range containerNames {
@@ -173,15 +185,23 @@ func (n *pebbleNoticer) processNotice(containerName string, notice *client.Notic
 		// implementation detail and provide specific hook types. We have a pair
 		// of hooks as this reflects a change of (workload) state, rather than
 		// a change of data. See OP046 for more background.
+		var checkStatus, checkMessage string
 		switch {
 		case kind == "perform-check" && chg.Status == "Error":
 			eventType = container.CheckFailedEvent
+			checkStatus, checkMessage = "down", chg.Err
 		case kind == "recover-check" && chg.Status == "Done":
 			eventType = container.CheckRecoveredEvent
+			checkStatus = "up"
 		default:
 			n.logger.Debugf(stdcontext.TODO(), "container %q: ignoring %s, status %s", containerName, kind, chg.Status)
 			return nil
 		}
+		since := chg.ReadyTime
+		if since.IsZero() {
+			since = notice.LastRepeated
+		}
+		n.setCheckStatus(containerName, data["check-name"], checkStatus, checkMessage, since)
 		event = container.WorkloadEvent{
 			Type:         eventType,
 			WorkloadName: containerName,
@@ -219,3 +239,19 @@ func (n *pebbleNoticer) processNotice(containerName string, notice *client.Notic
 
 	return nil
 }
+
+// setCheckStatus records the status of a Pebble check so that operators can
+// see it without the charm's involvement. Failing to do so is not fatal: the
+// check's hook is still run.
+func (n *pebbleNoticer) setCheckStatus(containerName, checkName, status, message string, since time.Time) {
+	if n.checkStatusSetter == nil {
+		return
+	}
+	ctx := n.tomb.Context(nil)
+	err := n.checkStatusSetter.SetPebbleCheckStatus(ctx, containerName, checkName, status, message, since)
+	if errors.Is(err, errors.NotImplemented) {
+		n.logger.Debugf(ctx, "container %q: not recording status of check %q: %v", containerName, checkName, err)
+	} else if err != nil {
+		n.logger.Warningf(ctx, "container %q: cannot record status of check %q: %v", containerName, checkName, err)
+	}
+}
@@ -4,11 +4,14 @@
 package uniter_test
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/canonical/pebble/client"
 	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/workertest"
@@ -26,6 +29,7 @@ type pebbleNoticerSuite struct {
 	clients           map[string]*fakePebbleClient
 	workloadEventChan chan string
 	workloadEvents    container.WorkloadEvents
+	checkStatuses     *fakeCheckStatusSetter
 }
 
 var _ = gc.Suite(&pebbleNoticerSuite{})
@@ -34,6 +38,7 @@ func (s *pebbleNoticerSuite) setUpWorker(c *gc.C, containerNames []string) {
 	s.clock = testclock.NewClock(time.Time{})
 	s.workloadEventChan = make(chan string)
 	s.workloadEvents = container.NewWorkloadEvents()
+	s.checkStatuses = &fakeCheckStatusSetter{}
 	s.clients = make(map[string]*fakePebbleClient)
 	for _, name := range containerNames {
 		s.clients[name] = &fakePebbleClient{
@@ -46,7 +51,7 @@ func (s *pebbleNoticerSuite) setUpWorker(c *gc.C, containerNames []string) {
 		matches := pebbleSocketPathRegexp.FindAllStringSubmatch(cfg.Socket, 1)
 		return s.clients[matches[0][1]], nil
 	}
-	s.worker = uniter.NewPebbleNoticer(loggertesting.WrapCheckLog(c), s.clock, containerNames, s.workloadEventChan, s.workloadEvents, newClient)
+	s.worker = uniter.NewPebbleNoticer(loggertesting.WrapCheckLog(c), s.clock, containerNames, s.workloadEventChan, s.workloadEvents, s.checkStatuses, newClient)
 }
 
 func (s *pebbleNoticerSuite) waitWorkloadEvent(c *gc.C, expected container.WorkloadEvent) {
@@ -119,10 +124,13 @@ func (s *pebbleNoticerSuite) TestCheckFailed(c *gc.C) {
 	s.setUpWorker(c, []string{"c1"})
 	defer workertest.CleanKill(c, s.worker)
 
+	readyTime := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
 	s.clients["c1"].AddChange(c, &client.Change{
-		ID:     "42",
-		Kind:   "perform-check",
-		Status: "Error",
+		ID:        "42",
+		Kind:      "perform-check",
+		Status:    "Error",
+		Err:       "connection refused",
+		ReadyTime: readyTime,
 	})
 	s.clients["c1"].AddNotice(c, &client.Notice{
 		ID:           "1",
@@ -136,6 +144,13 @@ func (s *pebbleNoticerSuite) TestCheckFailed(c *gc.C) {
 		WorkloadName: "c1",
 		CheckName:    "http-check",
 	})
+	c.Check(s.checkStatuses.recorded(), jc.DeepEquals, []checkStatus{{
+		container: "c1",
+		check:     "http-check",
+		status:    "down",
+		message:   "connection refused",
+		since:     readyTime,
+	}})
 }
 
 // TestCheckRecovered verifies that a change-updated notice that is of kind
@@ -144,6 +159,7 @@ func (s *pebbleNoticerSuite) TestCheckRecovered(c *gc.C) {
 	s.setUpWorker(c, []string{"c1"})
 	defer workertest.CleanKill(c, s.worker)
 
+	lastRepeated := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
 	s.clients["c1"].AddChange(c, &client.Change{
 		ID:     "42",
 		Kind:   "recover-check",
@@ -153,7 +169,7 @@ func (s *pebbleNoticerSuite) TestCheckRecovered(c *gc.C) {
 		ID:           "1",
 		Type:         "change-update",
 		Key:          "42",
-		LastRepeated: time.Now(),
+		LastRepeated: lastRepeated,
 		LastData:     map[string]string{"kind": "recover-check", "check-name": "tcp-check"},
 	})
 	s.waitWorkloadEvent(c, container.WorkloadEvent{
@@ -161,6 +177,38 @@ func (s *pebbleNoticerSuite) TestCheckRecovered(c *gc.C) {
 		WorkloadName: "c1",
 		CheckName:    "tcp-check",
 	})
+	c.Check(s.checkStatuses.recorded(), jc.DeepEquals, []checkStatus{{
+		container: "c1",
+		check:     "tcp-check",
+		status:    "up",
+		since:     lastRepeated,
+	}})
+}
+
+// TestCheckStatusError verifies that failing to record the status of a check
+// doesn't stop its hook from being run.
+func (s *pebbleNoticerSuite) TestCheckStatusError(c *gc.C) {
+	s.setUpWorker(c, []string{"c1"})
+	defer workertest.CleanKill(c, s.worker)
+
+	s.checkStatuses.err = errors.NotImplementedf("SetPebbleCheckStatuses()")
+	s.clients["c1"].AddChange(c, &client.Change{
+		ID:     "42",
+		Kind:   "perform-check",
+		Status: "Error",
+	})
+	s.clients["c1"].AddNotice(c, &client.Notice{
+		ID:           "1",
+		Type:         "change-update",
+		Key:          "42",
+		LastRepeated: time.Now(),
+		LastData:     map[string]string{"kind": "perform-check", "check-name": "http-check"},
+	})
+	s.waitWorkloadEvent(c, container.WorkloadEvent{
+		Type:         container.CheckFailedEvent,
+		WorkloadName: "c1",
+		CheckName:    "http-check",
+	})
 }
 
 func (s *pebbleNoticerSuite) TestWaitNoticesError(c *gc.C) {
@@ -225,3 +273,39 @@ func (s *pebbleNoticerSuite) TestMultipleContainers(c *gc.C) {
 		})
 	}
 }
+
+type checkStatus struct {
+	container string
+	check     string
+	status    string
+	message   string
+	since     time.Time
+}
+
+type fakeCheckStatusSetter struct {
+	mu       sync.Mutex
+	statuses []checkStatus
+	err      error
+}
+
+func (f *fakeCheckStatusSetter) SetPebbleCheckStatus(_ context.Context, container, check, status, message string, since time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.statuses = append(f.statuses, checkStatus{
+		container: container,
+		check:     check,
+		status:    status,
+		message:   message,
+		since:     since,
+	})
+	return nil
+}
+
+func (f *fakeCheckStatusSetter) recorded() []checkStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]checkStatus(nil), f.statuses...)
+}
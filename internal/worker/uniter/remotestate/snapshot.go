@@ -111,6 +111,14 @@ type Snapshot struct {
 	// processed.
 	WorkloadEvents []string
 
+	// ScheduledHooks is a list of the names of schedules which are due,
+	// and whose hooks need to be run.
+	ScheduledHooks []string
+
+	// DeferredHooks is a list of the IDs of deferred hooks whose retry
+	// time has passed, and which need to be run again.
+	DeferredHooks []string
+
 	// Shutdown is true on CAAS sidecar applications when SIGTERM is recevied
 	// but the unit isn't going to die, just a uniter restart/pod reschedule.
 	Shutdown bool
@@ -53,6 +53,8 @@ type RemoteStateWatcher struct {
 	retryHookChannel          watcher.NotifyChannel
 	canApplyCharmProfile      bool
 	workloadEventChannel      <-chan string
+	scheduleChannel           <-chan string
+	deferredHookChannel       <-chan string
 	shutdownChannel           <-chan bool
 
 	secretsClient api.SecretsWatcher
@@ -94,6 +96,9 @@ type WatcherConfig struct {
 	CanApplyCharmProfile         bool
 	WorkloadEventChannel         <-chan string
 	InitialWorkloadEventIDs      []string
+	ScheduleChannel              <-chan string
+	InitialScheduledHooks        []string
+	DeferredHookChannel          <-chan string
 	ShutdownChannel              <-chan bool
 }
 
@@ -139,12 +144,15 @@ func NewWatcher(config WatcherConfig) (*RemoteStateWatcher, error) {
 			Storage:                 make(map[names.StorageTag]StorageSnapshot),
 			ActionChanged:           make(map[string]int),
 			WorkloadEvents:          config.InitialWorkloadEventIDs,
+			ScheduledHooks:          config.InitialScheduledHooks,
 			ConsumedSecretInfo:      make(map[string]secrets.SecretRevisionInfo),
 			ObsoleteSecretRevisions: make(map[string][]int),
 		},
 		sidecar:                      config.Sidecar,
 		enforcedCharmModifiedVersion: config.EnforcedCharmModifiedVersion,
 		workloadEventChannel:         config.WorkloadEventChannel,
+		scheduleChannel:              config.ScheduleChannel,
+		deferredHookChannel:          config.DeferredHookChannel,
 		shutdownChannel:              config.ShutdownChannel,
 	}
 	err := catacomb.Invoke(catacomb.Plan{
@@ -203,6 +211,10 @@ func (w *RemoteStateWatcher) Snapshot() Snapshot {
 	copy(snapshot.Commands, w.current.Commands)
 	snapshot.WorkloadEvents = make([]string, len(w.current.WorkloadEvents))
 	copy(snapshot.WorkloadEvents, w.current.WorkloadEvents)
+	snapshot.ScheduledHooks = make([]string, len(w.current.ScheduledHooks))
+	copy(snapshot.ScheduledHooks, w.current.ScheduledHooks)
+	snapshot.DeferredHooks = make([]string, len(w.current.DeferredHooks))
+	copy(snapshot.DeferredHooks, w.current.DeferredHooks)
 	snapshot.ActionChanged = make(map[string]int)
 	for k, v := range w.current.ActionChanged {
 		snapshot.ActionChanged[k] = v
@@ -260,6 +272,40 @@ func (w *RemoteStateWatcher) WorkloadEventCompleted(workloadEventID string) {
 	}
 }
 
+// ScheduledHookCompleted is called when the hook of the named schedule
+// has been run.
+func (w *RemoteStateWatcher) ScheduledHookCompleted(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i, scheduled := range w.current.ScheduledHooks {
+		if scheduled != name {
+			continue
+		}
+		w.current.ScheduledHooks = append(
+			w.current.ScheduledHooks[:i],
+			w.current.ScheduledHooks[i+1:]...,
+		)
+		break
+	}
+}
+
+// DeferredHookCompleted is called when the deferred hook with the
+// given ID has been run again, or is no longer deferred.
+func (w *RemoteStateWatcher) DeferredHookCompleted(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i, deferred := range w.current.DeferredHooks {
+		if deferred != id {
+			continue
+		}
+		w.current.DeferredHooks = append(
+			w.current.DeferredHooks[:i],
+			w.current.DeferredHooks[i+1:]...,
+		)
+		break
+	}
+}
+
 // RotateSecretCompleted is called when a secret identified by the URL
 // has been rotated.
 func (w *RemoteStateWatcher) RotateSecretCompleted(rotatedURL string) {
@@ -702,6 +748,20 @@ func (w *RemoteStateWatcher) loop(unitTag names.UnitTag) (err error) {
 			w.logger.Debugf(context.TODO(), "workloadEvent enqueued for %s: %v", w.unit.Tag().Id(), id)
 			w.workloadEventsChanged(id)
 
+		case name, ok := <-w.scheduleChannel:
+			if !ok {
+				return errors.New("scheduleChannel closed")
+			}
+			w.logger.Debugf(context.TODO(), "schedule %q due for %s", name, w.unit.Tag().Id())
+			w.scheduledHooksChanged(name)
+
+		case id, ok := <-w.deferredHookChannel:
+			if !ok {
+				return errors.New("deferredHookChannel closed")
+			}
+			w.logger.Debugf(context.TODO(), "deferred hook %q due for %s", id, w.unit.Tag().Id())
+			w.deferredHooksChanged(id)
+
 		case _, ok := <-w.retryHookChannel:
 			if !ok {
 				return errors.New("retryHookChannel closed")
@@ -750,6 +810,33 @@ func (w *RemoteStateWatcher) workloadEventsChanged(id string) {
 	w.current.WorkloadEvents = append(w.current.WorkloadEvents, id)
 }
 
+// scheduledHooksChanged is called when a schedule is due.
+func (w *RemoteStateWatcher) scheduledHooksChanged(name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	// A schedule which comes due again before its hook has run
+	// only runs the hook once.
+	for _, scheduled := range w.current.ScheduledHooks {
+		if scheduled == name {
+			return
+		}
+	}
+	w.current.ScheduledHooks = append(w.current.ScheduledHooks, name)
+}
+
+// deferredHooksChanged is called when the retry time of a deferred
+// hook has passed.
+func (w *RemoteStateWatcher) deferredHooksChanged(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, deferred := range w.current.DeferredHooks {
+		if deferred == id {
+			return
+		}
+	}
+	w.current.DeferredHooks = append(w.current.DeferredHooks, id)
+}
+
 // retryHookTimerTriggered is called when the retry hook timer expires.
 func (w *RemoteStateWatcher) retryHookTimerTriggered() {
 	w.mu.Lock()
@@ -40,6 +40,8 @@ type WatcherSuite struct {
 	applicationWatcher *mockNotifyWatcher
 
 	workloadEventChannel chan string
+	scheduleChannel      chan string
+	deferredHookChannel  chan string
 	shutdownChannel      chan bool
 }
 
@@ -124,6 +126,8 @@ func (s *WatcherSuite) SetUpTest(c *gc.C) {
 	s.clock = testclock.NewClock(time.Now())
 
 	s.workloadEventChannel = make(chan string)
+	s.scheduleChannel = make(chan string)
+	s.deferredHookChannel = make(chan string)
 	s.shutdownChannel = make(chan bool)
 }
 
@@ -190,6 +194,8 @@ func (s *WatcherSuite) setupWatcherConfig(c *gc.C) remotestate.WatcherConfig {
 		UpdateStatusChannel:  statusTicker,
 		CanApplyCharmProfile: s.modelType == model.IAAS,
 		WorkloadEventChannel: s.workloadEventChannel,
+		ScheduleChannel:      s.scheduleChannel,
+		DeferredHookChannel:  s.deferredHookChannel,
 		ShutdownChannel:      s.shutdownChannel,
 	}
 }
@@ -1047,6 +1053,65 @@ func (s *WatcherSuite) TestInitialWorkloadEventIDs(c *gc.C) {
 	c.Assert(snapshot.WorkloadEvents, gc.DeepEquals, []string{"a", "b", "c"})
 }
 
+func (s *WatcherSuite) TestScheduleSignal(c *gc.C) {
+	s.signalAll()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+
+	snap := s.watcher.Snapshot()
+	c.Assert(snap.ScheduledHooks, gc.HasLen, 0)
+
+	for _, name := range []string{"backup", "poll", "backup"} {
+		select {
+		case s.scheduleChannel <- name:
+		case <-time.After(testing.ShortWait):
+			c.Fatalf("timed out waiting to signal schedule channel")
+		}
+	}
+
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	snap = s.watcher.Snapshot()
+	c.Assert(snap.ScheduledHooks, gc.DeepEquals, []string{"backup", "poll"})
+
+	s.watcher.ScheduledHookCompleted("backup")
+	snap = s.watcher.Snapshot()
+	c.Assert(snap.ScheduledHooks, gc.DeepEquals, []string{"poll"})
+}
+
+func (s *WatcherSuite) TestInitialScheduledHooks(c *gc.C) {
+	config := remotestate.WatcherConfig{
+		InitialScheduledHooks: []string{"backup"},
+		Logger:                loggertesting.WrapCheckLog(c),
+	}
+	w, err := remotestate.NewWatcher(config)
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot := w.Snapshot()
+	c.Assert(snapshot.ScheduledHooks, gc.DeepEquals, []string{"backup"})
+}
+
+func (s *WatcherSuite) TestDeferredHookSignal(c *gc.C) {
+	s.signalAll()
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+
+	snap := s.watcher.Snapshot()
+	c.Assert(snap.DeferredHooks, gc.HasLen, 0)
+
+	for _, id := range []string{"1", "2", "1"} {
+		select {
+		case s.deferredHookChannel <- id:
+		case <-time.After(testing.ShortWait):
+			c.Fatalf("timed out waiting to signal deferred hook channel")
+		}
+	}
+
+	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
+	snap = s.watcher.Snapshot()
+	c.Assert(snap.DeferredHooks, gc.DeepEquals, []string{"1", "2"})
+
+	s.watcher.DeferredHookCompleted("1")
+	snap = s.watcher.Snapshot()
+	c.Assert(snap.DeferredHooks, gc.DeepEquals, []string{"2"})
+}
+
 func (s *WatcherSuite) TestShutdown(c *gc.C) {
 	s.signalAll()
 	assertNotifyEvent(c, s.watcher.RemoteStateChanged(), "waiting for remote state change")
@@ -129,3 +129,41 @@ func (cache *RelationCache) InvalidateApplication(appName string) {
 func (cache *RelationCache) RemoveMember(memberName string) {
 	delete(cache.members, memberName)
 }
+
+// snapshot returns a copy of the relation's membership and the settings
+// currently cached.
+func (cache *RelationCache) snapshot() RelationSnapshot {
+	return RelationSnapshot{
+		Members:      copySettingsMap(cache.members),
+		Applications: copySettingsMap(cache.applications),
+		Others:       copySettingsMap(cache.others),
+	}
+}
+
+// newRelationCacheFromSnapshot creates a RelationCache holding the
+// membership and settings recorded in the snapshot. Settings which weren't
+// recorded are read using the supplied SettingsFunc.
+func newRelationCacheFromSnapshot(readSettings SettingsFunc, snapshot RelationSnapshot) *RelationCache {
+	return &RelationCache{
+		readSettings: readSettings,
+		members:      copySettingsMap(snapshot.Members),
+		applications: copySettingsMap(snapshot.Applications),
+		others:       copySettingsMap(snapshot.Others),
+	}
+}
+
+func copySettingsMap(in SettingsMap) SettingsMap {
+	out := make(SettingsMap, len(in))
+	for name, settings := range in {
+		if settings == nil {
+			out[name] = nil
+			continue
+		}
+		copied := make(params.Settings, len(settings))
+		for k, v := range settings {
+			copied[k] = v
+		}
+		out[name] = copied
+	}
+	return out
+}
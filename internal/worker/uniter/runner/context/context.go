@@ -26,6 +26,7 @@ import (
 	coretrace "github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/version"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charm/hooks"
 	"github.com/juju/juju/internal/storage"
@@ -50,6 +51,7 @@ type Context interface {
 	HasExecutionSetUnitStatus() bool
 	ResetExecutionSetUnitStatus()
 	ModelType() model.ModelType
+	HookDeferral() *jujuc.HookDeferral
 
 	Prepare(ctx context.Context) error
 	Flush(ctx context.Context, badge string, failure error) error
@@ -85,6 +87,10 @@ type Paths interface {
 	// to store metrics recorded during a single hook run.
 	GetMetricsSpoolDir() string
 
+	// GetHookSnapshotsDir returns the path to the directory holding the
+	// snapshots of the hooks run by the unit, which can be replayed.
+	GetHookSnapshotsDir() string
+
 	// GetResourcesDir returns the filesystem path to the directory
 	// containing resource data files.
 	GetResourcesDir() string
@@ -122,6 +128,7 @@ type HookUnit interface {
 	UnitStatus(context.Context) (params.StatusResult, error)
 	CommitHookChanges(context.Context, params.CommitHookChangesArgs) error
 	PublicAddress(context.Context) (string, error)
+	AddWorkloadMetrics(context.Context, []params.WorkloadMetric) error
 }
 
 // HookContext is the implementation of runner.Context.
@@ -227,6 +234,21 @@ type HookContext struct {
 	// that the uniter knows about.
 	jujuProxySettings proxy.Settings
 
+	// hookTimeouts are how long hooks can run for, from the model config.
+	hookTimeouts config.HookTimeouts
+
+	// hookTimeout is how long the hook being run can run for before it is
+	// killed. It is zero for actions and commands, or if the hook is never
+	// killed.
+	hookTimeout time.Duration
+
+	// hookSnapshots is true if the model records a snapshot of each hook
+	// run, so that the hook can be replayed.
+	hookSnapshots bool
+
+	// replay is set when the context is replaying a hook from a snapshot.
+	replay *hookReplay
+
 	// a helper for recording requests to open/close port ranges for this unit.
 	portRangeChanges *portRangeChangeRecorder
 
@@ -315,6 +337,28 @@ type HookContext struct {
 	// secretChanges records changes to secrets during a hook execution.
 	secretChanges *secretsChangeRecorder
 
+	// scheduleName is the name of the schedule which triggered the hook.
+	scheduleName string
+
+	// schedules holds the unit's schedules.
+	schedules ScheduleRegistry
+
+	// scheduleChanges records the schedules registered and removed
+	// during a hook execution.
+	scheduleChanges *scheduleChangeRecorder
+
+	// hookKind is the kind of the running hook, if the context is
+	// running a hook.
+	hookKind hooks.Kind
+
+	// deferral holds how the charm asked for the running hook to be
+	// deferred, if it did.
+	deferral *jujuc.HookDeferral
+
+	// pendingMetrics holds the workload metrics added during a hook
+	// execution, to be published when the context is flushed.
+	pendingMetrics []params.WorkloadMetric
+
 	mu sync.Mutex
 }
 
@@ -432,6 +476,15 @@ func (c *HookContext) ensureCharmStateLoaded(ctx context.Context) error {
 // RequestReboot will set the reboot flag to true on the machine agent
 // Implements jujuc.HookContext.ContextInstance, part of runner.Context.
 func (c *HookContext) RequestReboot(priority jujuc.RebootPriority) error {
+	if c.replay != nil {
+		if priority == jujuc.RebootNow {
+			c.replay.record("juju-reboot --now")
+		} else {
+			c.replay.record("juju-reboot")
+		}
+		return nil
+	}
+
 	// Must set reboot priority first, because killing the hook
 	// process will trigger the completion of the hook. If killing
 	// the hook fails, then we can reset the priority.
@@ -561,6 +614,10 @@ func (c *HookContext) ApplicationStatus(ctx context.Context) (jujuc.ApplicationS
 func (c *HookContext) SetUnitStatus(ctx context.Context, unitStatus jujuc.StatusInfo) error {
 	c.hasRunStatusSet = true
 	c.logger.Tracef(context.TODO(), "[WORKLOAD-STATUS] %s: %s", unitStatus.Status, unitStatus.Info)
+	if c.replay != nil {
+		c.replay.record("status-set %s %q", unitStatus.Status, unitStatus.Info)
+		return nil
+	}
 	return c.unit.SetUnitStatus(ctx,
 		status.Status(unitStatus.Status),
 		unitStatus.Info,
@@ -592,6 +649,10 @@ func (c *HookContext) SetApplicationStatus(ctx context.Context, applicationStatu
 	if !isLeader {
 		return ErrIsNotLeader
 	}
+	if c.replay != nil {
+		c.replay.record("status-set --application %s %q", applicationStatus.Status, applicationStatus.Info)
+		return nil
+	}
 
 	app, err := c.unit.Application(ctx)
 	if err != nil {
@@ -616,6 +677,12 @@ func (c *HookContext) ResetExecutionSetUnitStatus() {
 	c.hasRunStatusSet = false
 }
 
+// HookTimeout returns how long the hook can run for before it is killed. A
+// zero duration means the hook is never killed.
+func (c *HookContext) HookTimeout() time.Duration {
+	return c.hookTimeout
+}
+
 // PublicAddress fetches the executing unit's public address if it has
 // not yet been retrieved.
 // The cached value is returned, or an error if it is not available.
@@ -908,6 +975,10 @@ func (c *HookContext) CreateSecret(ctx context.Context, args *jujuc.SecretCreate
 	if args.Value == nil || args.Value.IsEmpty() {
 		return nil, errors.NotValidf("empty secret content")
 	}
+	expireTime, err := validateTypedSecretContent(args.Type, args.Value, args.ExpireTime)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	checksum, err := args.Value.Checksum()
 	if err != nil {
 		return nil, errors.Annotate(err, "calculating secret checksum")
@@ -920,13 +991,14 @@ func (c *HookContext) CreateSecret(ctx context.Context, args *jujuc.SecretCreate
 		SecretUpsertArg: uniter.SecretUpsertArg{
 			URI:          uris[0],
 			RotatePolicy: args.RotatePolicy,
-			ExpireTime:   args.ExpireTime,
+			ExpireTime:   expireTime,
 			Description:  args.Description,
 			Label:        args.Label,
 			Value:        args.Value,
 			Checksum:     checksum,
 		},
 		Owner: args.Owner,
+		Type:  args.Type,
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -934,6 +1006,24 @@ func (c *HookContext) CreateSecret(ctx context.Context, args *jujuc.SecretCreate
 	return uris[0], nil
 }
 
+// validateTypedSecretContent checks that the secret value has the
+// structure required by the secret type. The content is checked here
+// as well as on the controller since it may be saved directly to an
+// external backend. For tls secrets, the returned expire time is
+// the earlier of the requested expire time and the certificate expiry.
+func validateTypedSecretContent(
+	secretType coresecrets.SecretType, value coresecrets.SecretValue, expireTime *time.Time,
+) (*time.Time, error) {
+	certExpireTime, err := secretType.ValidateContent(value.EncodedValues(), time.Now())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if certExpireTime != nil && (expireTime == nil || certExpireTime.Before(*expireTime)) {
+		return certExpireTime, nil
+	}
+	return expireTime, nil
+}
+
 // UpdateSecret creates a secret with the specified data.
 func (c *HookContext) UpdateSecret(uri *coresecrets.URI, args *jujuc.SecretUpdateArgs) error {
 	md, knowSecret := c.secretMetadata[uri.ID]
@@ -962,12 +1052,20 @@ func (c *HookContext) UpdateSecret(uri *coresecrets.URI, args *jujuc.SecretUpdat
 			return errors.Annotate(err, "calculating secret checksum")
 		}
 		if !knowSecret || md.LatestChecksum != checksum {
+			expireTime, err := validateTypedSecretContent(md.Type, args.Value, args.ExpireTime)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			updateArg.ExpireTime = expireTime
 			updateArg.Value = args.Value
 			updateArg.Checksum = checksum
+			updateArg.StageTimeout = args.StageTimeout
+			updateArg.RollbackOnTimeout = args.RollbackOnTimeout
 		}
 	}
+	updateArg.RollbackRevision = args.RollbackRevision
 	if args.RotatePolicy == nil && args.Description == nil && args.ExpireTime == nil &&
-		args.Label == nil && updateArg.Value == nil {
+		args.Label == nil && updateArg.Value == nil && updateArg.RollbackRevision == nil {
 		return nil
 	}
 
@@ -998,6 +1096,7 @@ func (c *HookContext) SecretMetadata() (map[string]jujuc.SecretMetadata, error)
 	for _, c := range c.secretChanges.pendingCreates {
 		md := jujuc.SecretMetadata{
 			Owner:          c.Owner,
+			Type:           c.Type,
 			LatestRevision: 1,
 			LatestChecksum: c.Checksum,
 		}
@@ -1267,18 +1366,6 @@ func (c *HookContext) RelationIds() ([]int, error) {
 	return ids, nil
 }
 
-// AddMetric adds metrics to the hook context.
-// Implements jujuc.HookContext.ContextMetrics, part of runner.Context.
-func (c *HookContext) AddMetric(key, value string, created time.Time) error {
-	return errors.New("metrics not allowed in this context")
-}
-
-// AddMetricLabels adds metrics with labels to the hook context.
-// Implements jujuc.HookContext.ContextMetrics, part of runner.Context.
-func (c *HookContext) AddMetricLabels(key, value string, created time.Time, labels map[string]string) error {
-	return errors.New("metrics not allowed in this context")
-}
-
 // ActionData returns the context's internal action data. It's meant to be
 // transitory; it exists to allow uniter and runner code to keep working as
 // it did; it should be considered deprecated, and not used by new clients.
@@ -1301,6 +1388,9 @@ func (c *HookContext) HookVars(
 	paths Paths,
 	env Environmenter,
 ) ([]string, error) {
+	if c.replay != nil {
+		return c.replayVars(paths), nil
+	}
 	vars := c.legacyProxySettings.AsEnvironmentValues()
 	vars = append(vars, ContextDependentEnvVars(env)...)
 
@@ -1352,6 +1442,9 @@ func (c *HookContext) HookVars(
 			"JUJU_ACTION_TAG="+c.actionData.Tag.String(),
 		)
 	}
+	if c.scheduleName != "" {
+		vars = append(vars, "JUJU_SCHEDULE_NAME="+c.scheduleName)
+	}
 	if c.workloadName != "" {
 		vars = append(vars, "JUJU_WORKLOAD_NAME="+c.workloadName)
 		if c.noticeID != "" {
@@ -1441,6 +1534,15 @@ func (c *HookContext) Prepare(ctx context.Context) error {
 
 // Flush implements the runner.Context interface.
 func (c *HookContext) Flush(ctx context.Context, process string, ctxErr error) error {
+	if c.replay != nil {
+		// Nothing is committed when a hook is replayed, the changes it
+		// requested are reported in the action's results instead.
+		if err := c.finishReplay(); err != nil {
+			c.logger.Errorf(context.TODO(), "recording replayed hook changes: %v", err)
+		}
+		return c.finalizeAction(ctx, ctxErr, nil)
+	}
+
 	// Apply the changes if no error reported while the hook was executing.
 	var flushErr error
 	if ctxErr == nil {
@@ -1623,6 +1725,13 @@ func (c *HookContext) doFlush(ctx context.Context, process string) error {
 
 	// Call completed successfully; update local state
 	c.charmStateCacheDirty = false
+
+	// The schedules are held by the unit agent, so are only
+	// changed once the hook's other changes have been committed.
+	if err := c.flushSchedules(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	c.flushMetrics(ctx)
 	return nil
 }
 
@@ -1731,6 +1840,10 @@ func (c *HookContext) UnitWorkloadVersion(ctx context.Context) (string, error) {
 // the specified value.
 // Implements jujuc.HookContext.ContextVersion, part of runner.Context.
 func (c *HookContext) SetUnitWorkloadVersion(ctx context.Context, version string) error {
+	if c.replay != nil {
+		c.replay.record("application-version-set %q", version)
+		return nil
+	}
 	return c.uniter.SetUnitWorkloadVersion(ctx, c.unit.Tag(), version)
 }
 
@@ -1579,6 +1579,67 @@ func (s *HookContextSuite) assertSecretCreate(c *gc.C, owner coresecrets.Owner)
 		}})
 }
 
+func (s *HookContextSuite) TestSecretCreateTyped(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	value := coresecrets.NewSecretValue(map[string]string{"username": "YWRtaW4=", "password": "czNjcmV0"})
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Assert(request, gc.Equals, "CreateSecretURIs")
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{{
+				Result: "secret:9m4e2mr0ui3e8a215n4g",
+			}},
+		}
+		return nil
+	})
+	s.mockLeadership.EXPECT().IsLeader().Return(true, nil)
+
+	hookContext := context.NewMockUnitHookContext(c, s.mockUnit, model.IAAS, s.mockLeadership)
+	jujuSecretsAPI := secretsmanager.NewClient(apiCaller)
+	context.SetEnvironmentHookContextSecret(hookContext, "", nil, jujuSecretsAPI, nil)
+
+	uri, err := hookContext.CreateSecret(stdcontext.Background(), &jujuc.SecretCreateArgs{
+		SecretUpdateArgs: jujuc.SecretUpdateArgs{
+			Value: value,
+		},
+		Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+		Type:  coresecrets.TypePassword,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	checksum, err := value.Checksum()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(hookContext.PendingSecretCreates(), jc.DeepEquals, map[string]uniter.SecretCreateArg{
+		uri.ID: {
+			SecretUpsertArg: uniter.SecretUpsertArg{
+				URI:      uri,
+				Value:    value,
+				Checksum: checksum,
+			},
+			Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+			Type:  coresecrets.TypePassword,
+		}})
+	md, err := hookContext.SecretMetadata()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(md[uri.ID].Type, gc.Equals, coresecrets.TypePassword)
+}
+
+func (s *HookContextSuite) TestSecretCreateInvalidTypedContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mockLeadership.EXPECT().IsLeader().Return(true, nil)
+	hookContext := context.NewMockUnitHookContext(c, s.mockUnit, model.IAAS, s.mockLeadership)
+
+	_, err := hookContext.CreateSecret(stdcontext.Background(), &jujuc.SecretCreateArgs{
+		SecretUpdateArgs: jujuc.SecretUpdateArgs{
+			Value: coresecrets.NewSecretValue(map[string]string{"username": "YWRtaW4="}),
+		},
+		Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+		Type:  coresecrets.TypePassword,
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `password secret missing "password"`)
+}
+
 func (s *HookContextSuite) TestSecretCreateDupLabel(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1675,6 +1736,94 @@ func (s *HookContextSuite) TestSecretUpdate(c *gc.C) {
 		}})
 }
 
+func (s *HookContextSuite) TestSecretUpdateStaged(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.mockLeadership.EXPECT().IsLeader().Return(true, nil)
+	hookContext := context.NewMockUnitHookContext(c, s.mockUnit, model.IAAS, s.mockLeadership)
+	context.SetEnvironmentHookContextSecret(hookContext, uri.String(), map[string]jujuc.SecretMetadata{
+		uri.ID: {
+			LatestRevision: 666,
+			LatestChecksum: "deadbeef",
+			Owner:          coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+		},
+	}, nil, nil)
+
+	value := coresecrets.NewSecretValue(map[string]string{"password": "bar"})
+	checksum, err := value.Checksum()
+	c.Assert(err, jc.ErrorIsNil)
+	err = hookContext.UpdateSecret(uri, &jujuc.SecretUpdateArgs{
+		Value:             value,
+		StageTimeout:      ptr(30 * time.Minute),
+		RollbackOnTimeout: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(hookContext.PendingSecretUpdates(), jc.DeepEquals, map[string]uniter.SecretUpdateArg{
+		uri.ID: {
+			CurrentRevision: 666,
+			SecretUpsertArg: uniter.SecretUpsertArg{
+				URI:               uri,
+				Value:             value,
+				Checksum:          checksum,
+				StageTimeout:      ptr(30 * time.Minute),
+				RollbackOnTimeout: true,
+			},
+		}})
+}
+
+func (s *HookContextSuite) TestSecretUpdateRollback(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.mockLeadership.EXPECT().IsLeader().Return(true, nil)
+	hookContext := context.NewMockUnitHookContext(c, s.mockUnit, model.IAAS, s.mockLeadership)
+	context.SetEnvironmentHookContextSecret(hookContext, uri.String(), map[string]jujuc.SecretMetadata{
+		uri.ID: {
+			LatestRevision: 666,
+			LatestChecksum: "deadbeef",
+			Owner:          coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+		},
+	}, nil, nil)
+
+	err := hookContext.UpdateSecret(uri, &jujuc.SecretUpdateArgs{
+		Value:            coresecrets.NewSecretValue(nil),
+		RollbackRevision: ptr(665),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(hookContext.PendingSecretUpdates(), jc.DeepEquals, map[string]uniter.SecretUpdateArg{
+		uri.ID: {
+			CurrentRevision: 666,
+			SecretUpsertArg: uniter.SecretUpsertArg{
+				URI:              uri,
+				RollbackRevision: ptr(665),
+			},
+		}})
+}
+
+func (s *HookContextSuite) TestSecretUpdateInvalidTypedContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.mockLeadership.EXPECT().IsLeader().Return(true, nil)
+	hookContext := context.NewMockUnitHookContext(c, s.mockUnit, model.IAAS, s.mockLeadership)
+	context.SetEnvironmentHookContextSecret(hookContext, uri.String(), map[string]jujuc.SecretMetadata{
+		uri.ID: {
+			Type:           coresecrets.TypeDockerRegistry,
+			LatestRevision: 666,
+			LatestChecksum: "deadbeef",
+			Owner:          coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mariadb"},
+		},
+	}, nil, nil)
+
+	err := hookContext.UpdateSecret(uri, &jujuc.SecretUpdateArgs{
+		Value: coresecrets.NewSecretValue(map[string]string{"username": "YWRtaW4=", "password": "czNjcmV0"}),
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `docker-registry secret missing "registry"`)
+	c.Assert(hookContext.PendingSecretUpdates(), gc.HasLen, 0)
+}
+
 func (s *HookContextSuite) TestSecretUpdateSameContent(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -80,6 +80,7 @@ type contextFactory struct {
 	secretsClient        api.SecretsAccessor
 	secretsBackendGetter SecretsBackendGetter
 	tracker              leadership.Tracker
+	schedules            ScheduleRegistry
 
 	logger logger.Logger
 
@@ -108,6 +109,7 @@ type FactoryConfig struct {
 	Resources            resources.OpenedResourceClient
 	Tracker              leadership.Tracker
 	GetRelationInfos     RelationsFunc
+	Schedules            ScheduleRegistry
 	Paths                Paths
 	Clock                Clock
 	Logger               logger.Logger
@@ -156,6 +158,7 @@ func NewContextFactory(ctx context.Context, config FactoryConfig) (ContextFactor
 		secretsClient:        config.SecretsClient,
 		secretsBackendGetter: config.SecretsBackendGetter,
 		tracker:              config.Tracker,
+		schedules:            config.Schedules,
 		logger:               config.Logger,
 		paths:                config.Paths,
 		modelUUID:            m.UUID,
@@ -194,6 +197,7 @@ func (f *contextFactory) coreContext(stdCtx context.Context) (*HookContext, erro
 		secretsClient:        f.secretsClient,
 		secretsBackendGetter: f.secretsBackendGetter,
 		LeadershipContext:    leadershipContext,
+		schedules:            f.schedules,
 		uuid:                 f.modelUUID,
 		modelName:            f.modelName,
 		modelType:            f.modelType,
@@ -305,11 +309,21 @@ func (f *contextFactory) HookContext(stdCtx context.Context, hookInfo hook.Info)
 			ctx.secretLabel = md.Label
 		}
 	}
+	if hookInfo.Kind.IsSchedule() {
+		ctx.scheduleName = hookInfo.ScheduleName
+		hookName = charm.ScheduleHookName(hookInfo.ScheduleName)
+	}
 	ctx.id, err = f.newId(hookName)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	ctx.hookName = hookName
+	ctx.hookKind = hookInfo.Kind
+	application, err := names.UnitApplication(ctx.unitName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ctx.hookTimeout = ctx.hookTimeouts.For(application, string(hookInfo.Kind))
 	return ctx, nil
 }
 
@@ -394,6 +408,8 @@ func (f *contextFactory) updateContext(stdCtx context.Context, ctx *HookContext)
 	}
 	ctx.legacyProxySettings = modelConfig.LegacyProxySettings()
 	ctx.jujuProxySettings = modelConfig.JujuProxySettings()
+	ctx.hookTimeouts = modelConfig.HookTimeouts()
+	ctx.hookSnapshots = modelConfig.HookSnapshots()
 
 	var machPortRanges map[names.UnitTag]network.GroupedPortRanges
 	var appPortRanges map[names.UnitTag]network.GroupedPortRanges
@@ -415,6 +431,7 @@ func (f *contextFactory) updateContext(stdCtx context.Context, ctx *HookContext)
 
 	ctx.portRangeChanges = newPortRangeChangeRecorder(ctx.logger, f.unit.Tag(), f.modelType, machPortRanges, appPortRanges)
 	ctx.secretChanges = newSecretsChangeRecorder(ctx.logger)
+	ctx.scheduleChanges = newScheduleChangeRecorder()
 	info, err := ctx.secretsClient.SecretMetadata(stdCtx)
 	if err != nil {
 		return err
@@ -423,6 +440,7 @@ func (f *contextFactory) updateContext(stdCtx context.Context, ctx *HookContext)
 	for _, v := range info {
 		md := v.Metadata
 		ctx.secretMetadata[md.URI.ID] = jujuc.SecretMetadata{
+			Type:             md.Type,
 			Description:      md.Description,
 			Label:            md.Label,
 			Owner:            md.Owner,
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
@@ -25,6 +26,7 @@ import (
 	uniterapi "github.com/juju/juju/internal/worker/uniter/api"
 	"github.com/juju/juju/internal/worker/uniter/hook"
 	"github.com/juju/juju/internal/worker/uniter/runner/context"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
 	runnertesting "github.com/juju/juju/internal/worker/uniter/runner/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -236,6 +238,46 @@ func (s *ContextFactorySuite) TestSecretHookContext(c *gc.C) {
 	s.AssertNotStorageContext(c, ctx)
 }
 
+func (s *ContextFactorySuite) TestScheduleHookContext(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.setupContextFactory(c, ctrl)
+
+	ctx, err := s.factory.HookContext(stdcontext.Background(), hook.Info{
+		Kind:         hooks.Schedule,
+		ScheduleName: "nightly",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.AssertCoreContext(c, ctx)
+	c.Assert(ctx.Id(), gc.Matches, `u/0-schedule-nightly-[0-9a-f]+`)
+	s.AssertNotWorkloadContext(c, ctx)
+	s.AssertNotActionContext(c, ctx)
+	s.AssertNotRelationContext(c, ctx)
+	s.AssertNotStorageContext(c, ctx)
+	s.AssertNotSecretContext(c, ctx)
+}
+
+func (s *ContextFactorySuite) TestHookContextDeferHook(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	s.setupContextFactory(c, ctrl)
+
+	ctx, err := s.factory.HookContext(stdcontext.Background(), hook.Info{Kind: hooks.ConfigChanged})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.HookDeferral(), gc.IsNil)
+	deferral := jujuc.HookDeferral{Reason: "database not ready", RetryAfter: time.Minute}
+	err = ctx.DeferHook(deferral)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.HookDeferral(), jc.DeepEquals, &deferral)
+
+	ctx, err = s.factory.HookContext(stdcontext.Background(), hook.Info{Kind: hooks.Install})
+	c.Assert(err, jc.ErrorIsNil)
+	err = ctx.DeferHook(deferral)
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+	c.Assert(err, gc.ErrorMatches, "deferring install hook not supported")
+	c.Assert(ctx.HookDeferral(), gc.IsNil)
+}
+
 func (s *ContextFactorySuite) TestNewHookContextCAASModel(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
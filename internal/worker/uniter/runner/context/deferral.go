@@ -0,0 +1,30 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/worker/uniter/hook"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+)
+
+// DeferHook implements jujuc.ContextDeferral.
+func (c *HookContext) DeferHook(deferral jujuc.HookDeferral) error {
+	if c.hookKind == "" {
+		return errors.NotSupportedf("deferring outside of a hook")
+	}
+	if !hook.CanDefer(c.hookKind) {
+		return errors.NotSupportedf("deferring %s hook", c.hookKind)
+	}
+	c.deferral = &deferral
+	return nil
+}
+
+// HookDeferral returns how the charm asked for the running hook to be
+// deferred, or nil if it did not. The hook is only deferred if it
+// completes successfully.
+func (c *HookContext) HookDeferral() *jujuc.HookDeferral {
+	return c.deferral
+}
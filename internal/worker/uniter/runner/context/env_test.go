@@ -163,6 +163,15 @@ func (s *EnvSuite) setCheck(ctx *context.HookContext) (expectVars []string) {
 	}
 }
 
+// setSchedule sets the context for a schedule hook.
+func (s *EnvSuite) setSchedule(ctx *context.HookContext) (expectVars []string) {
+	name := "nightly"
+	context.SetEnvironmentHookContextSchedule(ctx, name)
+	return []string{
+		"JUJU_SCHEDULE_NAME=" + name,
+	}
+}
+
 func (s *EnvSuite) setDepartingRelation(ctx *context.HookContext) (expectVars []string) {
 	context.SetEnvironmentHookContextRelation(ctx, 22, "an-endpoint", "that-unit/456", "that-app", "that-unit/456")
 	return []string{
@@ -238,9 +247,10 @@ func (s *EnvSuite) TestHostEnv(c *gc.C) {
 	workloadVars := s.setWorkload(hookContext)
 	noticeVars := s.setNotice(hookContext)
 	checkVars := s.setCheck(hookContext)
+	scheduleVars := s.setSchedule(hookContext)
 	actualVars, err = hookContext.HookVars(stdcontext.Background(), paths, environmenter)
 	c.Assert(err, jc.ErrorIsNil)
-	s.assertVars(c, actualVars, contextVars, pathsVars, ubuntuVars, relationVars, secretVars, storageVars, workloadVars, noticeVars, checkVars)
+	s.assertVars(c, actualVars, contextVars, pathsVars, ubuntuVars, relationVars, secretVars, storageVars, workloadVars, noticeVars, checkVars, scheduleVars)
 }
 
 func (s *EnvSuite) TestContextDependentDoesNotIncludeUnSet(c *gc.C) {
@@ -208,6 +208,12 @@ func SetEnvironmentHookContextCheck(context *HookContext, checkName string) {
 	context.checkName = checkName
 }
 
+// SetEnvironmentHookContextSchedule exists purely to set the fields used in hookVars.
+// It makes no assumptions about the validity of context.
+func SetEnvironmentHookContextSchedule(context *HookContext, scheduleName string) {
+	context.scheduleName = scheduleName
+}
+
 // SetRelationBroken sets the relation as broken.
 func SetRelationBroken(context jujuc.Context, relId int) {
 	context.(*HookContext).relations[relId].broken = true
@@ -239,6 +245,18 @@ func WithActionContext(ctx *HookContext, in map[string]interface{}, cancel <-cha
 	}
 }
 
+// MaxHookSnapshots is how many hook snapshots a unit keeps.
+const MaxHookSnapshots = maxHookSnapshots
+
+func SetHookSnapshots(ctx *HookContext, enabled bool) {
+	ctx.hookSnapshots = enabled
+}
+
+func SetSchedules(ctx *HookContext, schedules ScheduleRegistry) {
+	ctx.schedules = schedules
+	ctx.scheduleChanges = newScheduleChangeRecorder()
+}
+
 func StorageAddDirectives(ctx *HookContext) map[string][]params.StorageDirectives {
 	return ctx.storageAddDirectives
 }
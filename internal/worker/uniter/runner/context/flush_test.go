@@ -5,6 +5,7 @@ package context_test
 
 import (
 	stdcontext "context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -14,8 +15,10 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/network"
+	coreschedule "github.com/juju/juju/core/schedule"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/uuid"
 	"github.com/juju/juju/internal/worker/uniter/runner/context"
 	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
@@ -351,6 +354,160 @@ func (s *FlushContextSuite) TestRunHookUpdatesSecrets(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+type fakeScheduleRegistry struct {
+	stub    *testing.Stub
+	entries []coreschedule.Entry
+}
+
+func (r *fakeScheduleRegistry) Schedules() []coreschedule.Entry {
+	r.stub.AddCall("Schedules")
+	return r.entries
+}
+
+func (r *fakeScheduleRegistry) Register(_ stdcontext.Context, schedule charm.Schedule) error {
+	r.stub.AddCall("Register", schedule)
+	return r.stub.NextErr()
+}
+
+func (r *fakeScheduleRegistry) Remove(_ stdcontext.Context, name string) error {
+	r.stub.AddCall("Remove", name)
+	return r.stub.NextErr()
+}
+
+func (s *FlushContextSuite) scheduleRegistry() *fakeScheduleRegistry {
+	return &fakeScheduleRegistry{
+		stub: &s.stub,
+		entries: []coreschedule.Entry{{
+			Schedule: charm.Schedule{Name: "nightly", Cron: "@daily", CatchUp: charm.CatchUpSkip},
+			Source:   coreschedule.SourceCharm,
+		}, {
+			Schedule: charm.Schedule{Name: "old", Interval: time.Hour, CatchUp: charm.CatchUpSkip},
+			Source:   coreschedule.SourceRuntime,
+		}},
+	}
+}
+
+func (s *FlushContextSuite) TestRunHookUpdatesSchedules(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	context.SetSchedules(ctx, s.scheduleRegistry())
+
+	err := ctx.SetSchedule(charm.Schedule{Name: "nightly", Interval: time.Hour})
+	c.Assert(err, gc.ErrorMatches, `replacing schedule "nightly" declared in charm metadata not valid`)
+	err = ctx.RemoveSchedule("nightly")
+	c.Assert(err, gc.ErrorMatches, `removing schedule "nightly" declared in charm metadata not valid`)
+	err = ctx.RemoveSchedule("missing")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+
+	err = ctx.SetSchedule(charm.Schedule{Name: "hourly", Interval: time.Hour, Jitter: time.Minute})
+	c.Assert(err, jc.ErrorIsNil)
+	err = ctx.RemoveSchedule("old")
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.ResetCalls()
+
+	err = ctx.Flush(stdcontext.Background(), "some badge", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.CheckCalls(c, []testing.StubCall{
+		{FuncName: "Remove", Args: []interface{}{"old"}},
+		{FuncName: "Register", Args: []interface{}{charm.Schedule{
+			Name:     "hourly",
+			Interval: time.Hour,
+			Jitter:   time.Minute,
+			CatchUp:  charm.CatchUpSkip,
+		}}},
+	})
+}
+
+func (s *FlushContextSuite) TestRunHookSchedulesNotUpdatedOnError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	context.SetSchedules(ctx, s.scheduleRegistry())
+
+	err := ctx.SetSchedule(charm.Schedule{Name: "hourly", Interval: time.Hour})
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.ResetCalls()
+
+	expErr := errors.New("hook execution failed")
+	err = ctx.Flush(stdcontext.Background(), "some badge", expErr)
+	c.Assert(err, gc.Equals, expErr)
+	s.stub.CheckNoCalls(c)
+}
+
+func (s *FlushContextSuite) TestAddMetricNotValid(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	now := time.Now()
+
+	err := ctx.AddMetric("queue-depth", "1", now)
+	c.Check(err, gc.ErrorMatches, `metric key "queue-depth" not valid`)
+	err = ctx.AddMetric("queue_depth", "lots", now)
+	c.Check(err, gc.ErrorMatches, `value "lots" for metric "queue_depth" not valid`)
+	err = ctx.AddMetric("queue_depth", "NaN", now)
+	c.Check(err, gc.ErrorMatches, `value "NaN" for metric "queue_depth" not valid`)
+	err = ctx.AddMetricLabels("queue_depth", "1", now, map[string]string{"juju_unit": "u/1"})
+	c.Check(err, gc.ErrorMatches, `label "juju_unit" for metric "queue_depth" not valid`)
+}
+
+func (s *FlushContextSuite) TestRunHookPublishesMetrics(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	err := ctx.AddMetric("queue_depth", "42", now)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ctx.AddMetricLabels("lag_seconds", "0.5", now, map[string]string{"peer": "u/1"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.unit.EXPECT().AddWorkloadMetrics(gomock.Any(), []params.WorkloadMetric{{
+		Key:   "queue_depth",
+		Value: 42,
+		Time:  now,
+	}, {
+		Key:    "lag_seconds",
+		Value:  0.5,
+		Labels: map[string]string{"peer": "u/1"},
+		Time:   now,
+	}}).Return(nil)
+
+	err = ctx.Flush(stdcontext.Background(), "some badge", nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *FlushContextSuite) TestRunHookMetricsPublishErrorIgnored(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	err := ctx.AddMetric("queue_depth", "42", time.Now())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.unit.EXPECT().AddWorkloadMetrics(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+
+	err = ctx.Flush(stdcontext.Background(), "some badge", nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *FlushContextSuite) TestRunHookMetricsNotPublishedOnError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	err := ctx.AddMetric("queue_depth", "42", time.Now())
+	c.Assert(err, jc.ErrorIsNil)
+
+	expErr := errors.New("hook execution failed")
+	err = ctx.Flush(stdcontext.Background(), "some badge", expErr)
+	c.Assert(err, gc.Equals, expErr)
+}
+
 func (s *BaseHookContextSuite) context(c *gc.C, ctrl *gomock.Controller) *context.HookContext {
 	uuid, err := uuid.NewUUID()
 	c.Assert(err, jc.ErrorIsNil)
@@ -0,0 +1,73 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/quota"
+	"github.com/juju/juju/domain/workloadmetrics"
+	"github.com/juju/juju/rpc/params"
+)
+
+// AddMetric implements jujuc.ContextMetrics.
+func (c *HookContext) AddMetric(key, value string, created time.Time) error {
+	return c.AddMetricLabels(key, value, created, nil)
+}
+
+// AddMetricLabels implements jujuc.ContextMetrics.
+func (c *HookContext) AddMetricLabels(key, value string, created time.Time, labels map[string]string) error {
+	if !workloadmetrics.IsValidKey(key) {
+		return errors.NotValidf("metric key %q", key)
+	}
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return errors.NotValidf("value %q for metric %q", value, key)
+	}
+	for name := range labels {
+		if !workloadmetrics.IsValidLabel(name) {
+			return errors.NotValidf("label %q for metric %q", name, key)
+		}
+	}
+	if len(c.pendingMetrics) >= quota.MaxWorkloadMetricsPerHook {
+		return errors.QuotaLimitExceededf("cannot add more than %d metrics", quota.MaxWorkloadMetricsPerHook)
+	}
+
+	var metricLabels map[string]string
+	if len(labels) > 0 {
+		metricLabels = make(map[string]string, len(labels))
+		for name, labelValue := range labels {
+			metricLabels[name] = labelValue
+		}
+	}
+	c.pendingMetrics = append(c.pendingMetrics, params.WorkloadMetric{
+		Key:    key,
+		Value:  v,
+		Labels: metricLabels,
+		Time:   created.UTC(),
+	})
+	return nil
+}
+
+// flushMetrics publishes the workload metrics added by the hook. Metrics
+// are informational, so failing to publish them does not fail the hook.
+func (c *HookContext) flushMetrics(ctx context.Context) {
+	if len(c.pendingMetrics) == 0 {
+		return
+	}
+	metrics := c.pendingMetrics
+	c.pendingMetrics = nil
+
+	err := c.unit.AddWorkloadMetrics(ctx, metrics)
+	if errors.Is(err, errors.NotImplemented) {
+		c.logger.Debugf(ctx, "controller does not support workload metrics, discarding %d metrics", len(metrics))
+	} else if err != nil {
+		c.logger.Warningf(ctx, "cannot publish workload metrics: %v", err)
+	}
+}
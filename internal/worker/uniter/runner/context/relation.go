@@ -134,6 +134,19 @@ func (c *ContextRelation) FinalSettings() (unitSettings, appSettings params.Sett
 	return unitSettings, appSettings
 }
 
+// PendingSettings returns the changes made to the relation settings (unit
+// and application) which have not yet been committed. Deleted keys have an
+// empty value.
+func (c *ContextRelation) PendingSettings() (unitSettings, appSettings params.Settings) {
+	if c.settings != nil && c.settings.IsDirty() {
+		unitSettings = c.settings.Changes()
+	}
+	if c.applicationSettings != nil && c.applicationSettings.IsDirty() {
+		appSettings = c.applicationSettings.Changes()
+	}
+	return unitSettings, appSettings
+}
+
 // Suspended returns true if the relation is suspended.
 func (c *ContextRelation) Suspended() bool {
 	return c.ru.Relation().Suspended()
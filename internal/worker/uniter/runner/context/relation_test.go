@@ -100,3 +100,30 @@ func (s *ContextRelationSuite) TestRemoteApplicationName(c *gc.C) {
 	ctx := context.NewContextRelation(s.relUnit, nil, false)
 	c.Assert(ctx.RemoteApplicationName(), gc.Equals, "u")
 }
+
+func (s *ContextRelationSuite) TestPendingSettings(c *gc.C) {
+	defer s.setUp(c).Finish()
+
+	s.relUnit.EXPECT().Settings(gomock.Any()).Return(
+		apiuniter.NewSettings("relation-666", "unit-u-0", params.Settings{"host": "old", "port": "80"}), nil)
+	s.relUnit.EXPECT().ApplicationSettings(gomock.Any()).Return(
+		apiuniter.NewSettings("relation-666", "application-u", params.Settings{"endpoint": "old"}), nil)
+
+	cache := context.NewRelationCache(s.relUnit.ReadSettings, nil)
+	ctx := context.NewContextRelation(s.relUnit, cache, false)
+
+	unitSettings, appSettings := ctx.PendingSettings()
+	c.Check(unitSettings, gc.IsNil)
+	c.Check(appSettings, gc.IsNil)
+
+	node, err := ctx.Settings(stdcontext.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	node.Set("host", "new")
+	node.Delete("port")
+	_, err = ctx.ApplicationSettings(stdcontext.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	unitSettings, appSettings = ctx.PendingSettings()
+	c.Check(unitSettings, jc.DeepEquals, params.Settings{"host": "new", "port": ""})
+	c.Check(appSettings, gc.IsNil)
+}
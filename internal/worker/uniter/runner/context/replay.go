@@ -0,0 +1,285 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/charm/hooks"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/rpc/params"
+)
+
+// hookReplay holds the state of a hook being replayed from a snapshot.
+type hookReplay struct {
+	snapshot HookSnapshot
+
+	// changes describes the changes requested by the hook, which are
+	// recorded instead of being committed.
+	changes []string
+}
+
+func (r *hookReplay) record(format string, args ...interface{}) {
+	r.changes = append(r.changes, fmt.Sprintf(format, args...))
+}
+
+// StartReplay prepares an action context to replay the hook recorded in the
+// snapshot. The hook is given the environment, config and relation settings
+// it read when it was recorded; data it didn't read is read as it is now.
+// Nothing the replayed hook requests is committed, instead the changes are
+// reported in the action's results when the context is flushed.
+func (c *HookContext) StartReplay(snapshot HookSnapshot) error {
+	if c.actionData == nil {
+		return errors.New("hooks can only be replayed by an action")
+	}
+	if snapshot.RelationId != -1 {
+		if _, ok := c.relations[snapshot.RelationId]; !ok {
+			return errors.NotFoundf("relation %d of hook %q", snapshot.RelationId, snapshot.Hook)
+		}
+	}
+	c.hookName = snapshot.Hook
+	c.hookKind = hooks.Kind(snapshot.Kind)
+	c.relationId = snapshot.RelationId
+	c.remoteUnitName = snapshot.RemoteUnit
+	c.remoteApplicationName = snapshot.RemoteApplication
+	c.departingUnitName = snapshot.DepartingUnit
+	if snapshot.StorageId != "" {
+		c.storageTag = names.NewStorageTag(snapshot.StorageId)
+	}
+	c.workloadName = snapshot.WorkloadName
+	c.noticeID = snapshot.NoticeID
+	c.noticeType = snapshot.NoticeType
+	c.noticeKey = snapshot.NoticeKey
+	c.checkName = snapshot.CheckName
+	c.secretURI = snapshot.SecretURI
+	c.secretLabel = snapshot.SecretLabel
+	c.secretRevision = snapshot.SecretRevision
+	c.scheduleName = snapshot.ScheduleName
+	if snapshot.Config != nil {
+		c.configSettings = snapshot.Config
+	}
+	if snapshot.Secrets != nil {
+		c.secretMetadata = snapshot.Secrets
+	}
+
+	// The relation caches are shared with the hooks the uniter runs, so the
+	// replayed hook is given its own.
+	for id, relation := range snapshot.Relations {
+		rc, ok := c.relations[id]
+		if !ok {
+			c.logger.Warningf(context.TODO(), "relation %d no longer exists, not replaying its settings", id)
+			continue
+		}
+		cache := newRelationCacheFromSnapshot(rc.cache.readSettings, relation)
+		c.relations[id] = NewContextRelation(rc.ru, cache, rc.broken)
+	}
+
+	c.replay = &hookReplay{snapshot: snapshot}
+	return nil
+}
+
+// replayVars returns the environment the replayed hook was recorded with,
+// updated so that the hook tools call back into this context.
+func (c *HookContext) replayVars(paths Paths) []string {
+	overrides := map[string]string{
+		"JUJU_CONTEXT_ID":           c.id,
+		"JUJU_AGENT_SOCKET_ADDRESS": paths.GetJujucClientSocket().Address,
+		"JUJU_AGENT_SOCKET_NETWORK": paths.GetJujucClientSocket().Network,
+	}
+	vars := make([]string, 0, len(c.replay.snapshot.Env))
+	for _, v := range c.replay.snapshot.Env {
+		key, _, _ := strings.Cut(v, "=")
+		if value, ok := overrides[key]; ok {
+			v = key + "=" + value
+			delete(overrides, key)
+		}
+		vars = append(vars, v)
+	}
+	for _, key := range sortedKeys(overrides) {
+		vars = append(vars, key+"="+overrides[key])
+	}
+	return vars
+}
+
+// finishReplay reports the changes requested by the replayed hook in the
+// action's results.
+func (c *HookContext) finishReplay() error {
+	for _, id := range sortedKeys(c.relations) {
+		rc := c.relations[id]
+		if rc.settings != nil && rc.settings.IsDirty() {
+			c.replay.record("relation-set -r %s %s", rc.FakeId(), formatSettings(rc.settings.FinalResult()))
+		}
+		if rc.applicationSettings != nil && rc.applicationSettings.IsDirty() {
+			c.replay.record("relation-set -r %s --app %s", rc.FakeId(), formatSettings(rc.applicationSettings.FinalResult()))
+		}
+	}
+
+	if c.charmStateCacheDirty {
+		c.replay.record("state-set %s", formatSettings(c.cachedCharmState))
+	}
+
+	for _, endpoint := range sortedKeys(c.portRangeChanges.pendingOpenRanges) {
+		for _, pr := range c.portRangeChanges.pendingOpenRanges[endpoint] {
+			c.replay.record("open-port %s%s", pr, endpointsFlag(endpoint))
+		}
+	}
+	for _, endpoint := range sortedKeys(c.portRangeChanges.pendingCloseRanges) {
+		for _, pr := range c.portRangeChanges.pendingCloseRanges[endpoint] {
+			c.replay.record("close-port %s%s", pr, endpointsFlag(endpoint))
+		}
+	}
+
+	for _, name := range sortedKeys(c.storageAddDirectives) {
+		for _, directive := range c.storageAddDirectives[name] {
+			if directive.Count != nil {
+				c.replay.record("storage-add %s=%d", name, *directive.Count)
+			} else {
+				c.replay.record("storage-add %s", name)
+			}
+		}
+	}
+
+	secrets := c.secretChanges
+	for _, id := range sortedKeys(secrets.pendingCreates) {
+		c.replay.record("secret-add %s", secrets.pendingCreates[id].URI)
+	}
+	for _, id := range sortedKeys(secrets.pendingUpdates) {
+		c.replay.record("secret-set %s", secrets.pendingUpdates[id].URI)
+	}
+	for _, id := range sortedKeys(secrets.pendingDeletes) {
+		d := secrets.pendingDeletes[id]
+		if d.Revision != nil {
+			c.replay.record("secret-remove %s --revision %d", d.URI, *d.Revision)
+		} else {
+			c.replay.record("secret-remove %s", d.URI)
+		}
+	}
+	for _, id := range sortedKeys(secrets.pendingGrants) {
+		grants := secrets.pendingGrants[id]
+		for _, key := range sortedKeys(grants) {
+			g := grants[key]
+			c.replay.record("secret-grant %s -r %s%s", g.URI, key, unitFlag(g.UnitName))
+		}
+	}
+	for _, id := range sortedKeys(secrets.pendingRevokes) {
+		for _, r := range secrets.pendingRevokes[id] {
+			target := ""
+			if r.RelationKey != nil {
+				target = " -r " + *r.RelationKey
+			}
+			c.replay.record("secret-revoke %s%s%s", r.URI, target, unitFlag(r.UnitName))
+		}
+	}
+
+	if schedules := c.scheduleChanges; schedules != nil {
+		for _, name := range sortedKeys(schedules.pendingSets) {
+			c.replay.record("schedule-set %s", formatSchedule(schedules.pendingSets[name]))
+		}
+		for _, name := range sortedKeys(schedules.pendingRemoves) {
+			c.replay.record("schedule-remove %s", name)
+		}
+	}
+
+	for _, m := range c.pendingMetrics {
+		c.replay.record("metric-add%s %s=%s", labelsFlag(m.Labels), m.Key, strconv.FormatFloat(m.Value, 'g', -1, 64))
+	}
+
+	if c.deferral != nil {
+		c.replay.record("hook-defer%s", formatDeferral(*c.deferral))
+	}
+
+	if err := c.UpdateActionResults([]string{"snapshot"}, c.replay.snapshot.ID); err != nil {
+		return errors.Trace(err)
+	}
+	if err := c.UpdateActionResults([]string{"hook"}, c.replay.snapshot.Hook); err != nil {
+		return errors.Trace(err)
+	}
+	if len(c.replay.changes) == 0 {
+		return nil
+	}
+	return errors.Trace(c.UpdateActionResults([]string{"changes"}, c.replay.changes))
+}
+
+func formatSettings(settings params.Settings) string {
+	pairs := make([]string, 0, len(settings))
+	for _, k := range sortedKeys(settings) {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, settings[k]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+func formatSchedule(schedule charm.Schedule) string {
+	args := []string{schedule.Name}
+	if schedule.Cron != "" {
+		args = append(args, "--cron", strconv.Quote(schedule.Cron))
+	} else {
+		args = append(args, "--interval", schedule.Interval.String())
+	}
+	if schedule.Jitter > 0 {
+		args = append(args, "--jitter", schedule.Jitter.String())
+	}
+	if schedule.CatchUp != "" {
+		args = append(args, "--catch-up", string(schedule.CatchUp))
+	}
+	return strings.Join(args, " ")
+}
+
+func endpointsFlag(endpoint string) string {
+	if endpoint == "" {
+		return ""
+	}
+	return " --endpoints " + endpoint
+}
+
+func unitFlag(unitName *string) string {
+	if unitName == nil || *unitName == "" {
+		return ""
+	}
+	return " --unit " + *unitName
+}
+
+func sortedKeys[K string | int, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+func labelsFlag(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(labels))
+	for _, k := range sortedKeys(labels) {
+		pairs = append(pairs, k+"="+labels[k])
+	}
+	return " --labels " + strings.Join(pairs, ",")
+}
+
+func formatDeferral(deferral jujuc.HookDeferral) string {
+	var args []string
+	if deferral.Reason != "" {
+		args = append(args, "--reason", strconv.Quote(deferral.Reason))
+	}
+	if deferral.RetryAfter > 0 {
+		args = append(args, "--retry-after", deferral.RetryAfter.String())
+	}
+	if deferral.DropOnUpgrade {
+		args = append(args, "--drop-on-upgrade")
+	}
+	if len(args) == 0 {
+		return ""
+	}
+	return " " + strings.Join(args, " ")
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	coreschedule "github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/charm"
+)
+
+// ScheduleRegistry holds the schedules of the unit, and allows the charm
+// to register and remove its own schedules.
+type ScheduleRegistry interface {
+	// Schedules returns the unit's schedules sorted by name.
+	Schedules() []coreschedule.Entry
+
+	// Register adds or replaces a schedule registered by the charm.
+	Register(context.Context, charm.Schedule) error
+
+	// Remove removes a schedule registered by the charm.
+	Remove(_ context.Context, name string) error
+}
+
+// scheduleChangeRecorder records the schedules registered and removed
+// during a hook execution. The changes are applied when the context is
+// flushed.
+type scheduleChangeRecorder struct {
+	pendingSets    map[string]charm.Schedule
+	pendingRemoves map[string]bool
+}
+
+func newScheduleChangeRecorder() *scheduleChangeRecorder {
+	return &scheduleChangeRecorder{
+		pendingSets:    make(map[string]charm.Schedule),
+		pendingRemoves: make(map[string]bool),
+	}
+}
+
+func (r *scheduleChangeRecorder) set(schedule charm.Schedule) {
+	delete(r.pendingRemoves, schedule.Name)
+	r.pendingSets[schedule.Name] = schedule
+}
+
+func (r *scheduleChangeRecorder) remove(name string) {
+	delete(r.pendingSets, name)
+	r.pendingRemoves[name] = true
+}
+
+// SetSchedule implements jujuc.ContextSchedules.
+func (c *HookContext) SetSchedule(schedule charm.Schedule) error {
+	if schedule.CatchUp == "" {
+		schedule.CatchUp = charm.CatchUpSkip
+	}
+	if err := schedule.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if c.schedules == nil || c.scheduleChanges == nil {
+		return errors.NotSupportedf("schedules")
+	}
+	for _, entry := range c.schedules.Schedules() {
+		if entry.Name == schedule.Name && entry.Source == coreschedule.SourceCharm {
+			return errors.NotValidf("replacing schedule %q declared in charm metadata", schedule.Name)
+		}
+	}
+	c.scheduleChanges.set(schedule)
+	return nil
+}
+
+// RemoveSchedule implements jujuc.ContextSchedules.
+func (c *HookContext) RemoveSchedule(name string) error {
+	if c.schedules == nil || c.scheduleChanges == nil {
+		return errors.NotSupportedf("schedules")
+	}
+	if _, ok := c.scheduleChanges.pendingSets[name]; ok {
+		c.scheduleChanges.remove(name)
+		return nil
+	}
+	for _, entry := range c.schedules.Schedules() {
+		if entry.Name != name || c.scheduleChanges.pendingRemoves[name] {
+			continue
+		}
+		if entry.Source == coreschedule.SourceCharm {
+			return errors.NotValidf("removing schedule %q declared in charm metadata", name)
+		}
+		c.scheduleChanges.remove(name)
+		return nil
+	}
+	return errors.NotFoundf("schedule %q", name)
+}
+
+// flushSchedules applies the schedule changes made by the hook.
+func (c *HookContext) flushSchedules(ctx context.Context) error {
+	if c.schedules == nil || c.scheduleChanges == nil {
+		return nil
+	}
+	for _, name := range sortedKeys(c.scheduleChanges.pendingRemoves) {
+		if err := c.schedules.Remove(ctx, name); err != nil && !errors.Is(err, errors.NotFound) {
+			return errors.Annotatef(err, "removing schedule %q", name)
+		}
+	}
+	for _, name := range sortedKeys(c.scheduleChanges.pendingSets) {
+		if err := c.schedules.Register(ctx, c.scheduleChanges.pendingSets[name]); err != nil {
+			return errors.Annotatef(err, "registering schedule %q", name)
+		}
+	}
+	c.scheduleChanges = newScheduleChangeRecorder()
+	return nil
+}
@@ -95,6 +95,16 @@ func (s *secretsChangeRecorder) update(arg uniter.SecretUpdateArg) {
 	if arg.Value != nil && !arg.Value.IsEmpty() {
 		previous.Value = arg.Value
 		previous.Checksum = arg.Checksum
+		previous.StageTimeout = arg.StageTimeout
+		previous.RollbackOnTimeout = arg.RollbackOnTimeout
+		previous.RollbackRevision = nil
+	}
+	if arg.RollbackRevision != nil {
+		previous.Value = nil
+		previous.Checksum = ""
+		previous.StageTimeout = nil
+		previous.RollbackOnTimeout = false
+		previous.RollbackRevision = arg.RollbackRevision
 	}
 	if arg.RotatePolicy != nil {
 		previous.RotatePolicy = arg.RotatePolicy
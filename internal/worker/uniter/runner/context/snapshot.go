@@ -0,0 +1,242 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+)
+
+// maxHookSnapshots is how many hook snapshots a unit keeps. The oldest
+// snapshots are removed when a new one is recorded.
+const maxHookSnapshots = 20
+
+// HookSnapshot records what a hook was run with, so that it can be replayed.
+// Only the data the hook read is recorded, and secret content never is.
+type HookSnapshot struct {
+	// ID identifies the snapshot amongst those recorded by the unit.
+	ID string `yaml:"id"`
+
+	// Hook is the name of the hook that was run.
+	Hook string `yaml:"hook"`
+
+	// Kind is the kind of the hook that was run.
+	Kind string `yaml:"kind,omitempty"`
+
+	// Recorded is when the hook finished running.
+	Recorded time.Time `yaml:"recorded"`
+
+	// Error is the error the hook failed with, if any.
+	Error string `yaml:"error,omitempty"`
+
+	// Env is the environment the hook was run with.
+	Env []string `yaml:"env"`
+
+	RelationId        int    `yaml:"relation-id"`
+	RemoteUnit        string `yaml:"remote-unit,omitempty"`
+	RemoteApplication string `yaml:"remote-application,omitempty"`
+	DepartingUnit     string `yaml:"departing-unit,omitempty"`
+	StorageId         string `yaml:"storage-id,omitempty"`
+	WorkloadName      string `yaml:"workload-name,omitempty"`
+	NoticeID          string `yaml:"notice-id,omitempty"`
+	NoticeType        string `yaml:"notice-type,omitempty"`
+	NoticeKey         string `yaml:"notice-key,omitempty"`
+	CheckName         string `yaml:"check-name,omitempty"`
+	SecretURI         string `yaml:"secret-uri,omitempty"`
+	SecretLabel       string `yaml:"secret-label,omitempty"`
+	SecretRevision    int    `yaml:"secret-revision,omitempty"`
+	ScheduleName      string `yaml:"schedule-name,omitempty"`
+
+	// Config is the application config the hook read.
+	Config charm.Settings `yaml:"config,omitempty"`
+
+	// Relations holds the membership and remote settings of each relation,
+	// keyed on relation id.
+	Relations map[int]RelationSnapshot `yaml:"relations,omitempty"`
+
+	// Secrets holds the metadata of the secrets owned by the unit, keyed on
+	// secret id.
+	Secrets map[string]jujuc.SecretMetadata `yaml:"secrets,omitempty"`
+}
+
+// RelationSnapshot records the contents of a relation's cache.
+type RelationSnapshot struct {
+	// Members holds the settings of the remote units in the relation. The
+	// settings of members the hook didn't read are null.
+	Members SettingsMap `yaml:"members"`
+
+	// Applications holds the settings of the remote applications the hook
+	// read.
+	Applications SettingsMap `yaml:"applications,omitempty"`
+
+	// Others holds the settings of non-member units the hook read.
+	Others SettingsMap `yaml:"others,omitempty"`
+}
+
+// MarshalYAML implements yaml.Marshaler. Settings that weren't read are
+// written as null rather than as empty settings, so that a replay reads them
+// instead of seeing no settings.
+func (m SettingsMap) MarshalYAML() (interface{}, error) {
+	out := make(map[string]interface{}, len(m))
+	for name, settings := range m {
+		if settings == nil {
+			out[name] = nil
+			continue
+		}
+		out[name] = map[string]string(settings)
+	}
+	return out, nil
+}
+
+// HookSnapshot returns what the running hook has read so far, to be recorded
+// in a snapshot. It returns false if the model doesn't record snapshots, or
+// if the context isn't running a hook.
+func (c *HookContext) HookSnapshot() (HookSnapshot, bool) {
+	if !c.hookSnapshots || c.actionData != nil || c.replay != nil {
+		return HookSnapshot{}, false
+	}
+	snapshot := HookSnapshot{
+		Kind:              string(c.hookKind),
+		RelationId:        c.relationId,
+		RemoteUnit:        c.remoteUnitName,
+		RemoteApplication: c.remoteApplicationName,
+		DepartingUnit:     c.departingUnitName,
+		WorkloadName:      c.workloadName,
+		NoticeID:          c.noticeID,
+		NoticeType:        c.noticeType,
+		NoticeKey:         c.noticeKey,
+		CheckName:         c.checkName,
+		SecretURI:         c.secretURI,
+		SecretLabel:       c.secretLabel,
+		SecretRevision:    c.secretRevision,
+		ScheduleName:      c.scheduleName,
+		Config:            c.configSettings,
+		Relations:         make(map[int]RelationSnapshot),
+		Secrets:           c.secretMetadata,
+	}
+	if c.storageTag.Id() != "" {
+		snapshot.StorageId = c.storageTag.Id()
+	}
+	for id, relation := range c.relations {
+		snapshot.Relations[id] = relation.cache.snapshot()
+	}
+	return snapshot, true
+}
+
+// WriteHookSnapshot saves the snapshot in the given directory, and returns
+// the id it is saved with. The oldest snapshots are removed so that only the
+// most recent ones are kept.
+func WriteHookSnapshot(dir string, snapshot HookSnapshot) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errors.Trace(err)
+	}
+	ids, err := hookSnapshotIds(dir)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	next := 1
+	if len(ids) > 0 {
+		next = ids[len(ids)-1] + 1
+	}
+	snapshot.ID = strconv.Itoa(next)
+	data, err := yaml.Marshal(snapshot)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	// Snapshots hold the hook's environment, which may include credentials
+	// such as proxy passwords, so only the agent can read them.
+	if err := utils.AtomicWriteFile(hookSnapshotPath(dir, next), data, 0600); err != nil {
+		return "", errors.Annotatef(err, "writing hook snapshot %q", snapshot.ID)
+	}
+
+	ids = append(ids, next)
+	for len(ids) > maxHookSnapshots {
+		if err := os.Remove(hookSnapshotPath(dir, ids[0])); err != nil && !os.IsNotExist(err) {
+			return "", errors.Annotatef(err, "removing hook snapshot %d", ids[0])
+		}
+		ids = ids[1:]
+	}
+	return snapshot.ID, nil
+}
+
+// ReadHookSnapshot returns the snapshot with the given id from the given
+// directory.
+func ReadHookSnapshot(dir, id string) (HookSnapshot, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil || n <= 0 {
+		return HookSnapshot{}, errors.NotValidf("hook snapshot id %q", id)
+	}
+	data, err := os.ReadFile(hookSnapshotPath(dir, n))
+	if os.IsNotExist(err) {
+		return HookSnapshot{}, errors.NotFoundf("hook snapshot %q", id)
+	} else if err != nil {
+		return HookSnapshot{}, errors.Trace(err)
+	}
+	var snapshot HookSnapshot
+	if err := yaml.Unmarshal(data, &snapshot); err != nil {
+		return HookSnapshot{}, errors.Annotatef(err, "reading hook snapshot %q", id)
+	}
+	return snapshot, nil
+}
+
+// ListHookSnapshots returns the snapshots in the given directory, oldest
+// first.
+func ListHookSnapshots(dir string) ([]HookSnapshot, error) {
+	ids, err := hookSnapshotIds(dir)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshots := make([]HookSnapshot, 0, len(ids))
+	for _, id := range ids {
+		snapshot, err := ReadHookSnapshot(dir, strconv.Itoa(id))
+		if errors.Is(err, errors.NotFound) {
+			// The snapshot was removed while listing them.
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// hookSnapshotIds returns the ids of the snapshots in the given directory,
+// in the order they were recorded.
+func hookSnapshotIds(dir string) ([]int, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var ids []int
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".yaml")
+		if !ok || entry.IsDir() {
+			continue
+		}
+		id, err := strconv.Atoi(name)
+		if err != nil || id <= 0 {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, nil
+}
+
+func hookSnapshotPath(dir string, id int) string {
+	return filepath.Join(dir, strconv.Itoa(id)+".yaml")
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package context_test
+
+import (
+	stdcontext "context"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/charm"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/uniter/runner/context"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/rpc/params"
+)
+
+type HookSnapshotSuite struct {
+	BaseHookContextSuite
+}
+
+var _ = gc.Suite(&HookSnapshotSuite{})
+
+func (s *HookSnapshotSuite) TestWriteAndReadHookSnapshots(c *gc.C) {
+	dir := c.MkDir()
+
+	id, err := context.WriteHookSnapshot(dir, context.HookSnapshot{Hook: "install", RelationId: -1})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(id, gc.Equals, "1")
+	id, err = context.WriteHookSnapshot(dir, context.HookSnapshot{
+		Hook:       "db-relation-changed",
+		Recorded:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Error:      "exit status 1",
+		Env:        []string{"JUJU_HOOK_NAME=db-relation-changed"},
+		RelationId: 0,
+		RemoteUnit: "mysql/0",
+		Config:     charm.Settings{"foo": "bar"},
+		Relations: map[int]context.RelationSnapshot{
+			0: {
+				Members: context.SettingsMap{"mysql/0": {"host": "db"}, "mysql/1": nil},
+			},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(id, gc.Equals, "2")
+
+	snapshot, err := context.ReadHookSnapshot(dir, "2")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(snapshot.ID, gc.Equals, "2")
+	c.Check(snapshot.Hook, gc.Equals, "db-relation-changed")
+	c.Check(snapshot.Error, gc.Equals, "exit status 1")
+	c.Check(snapshot.Env, jc.DeepEquals, []string{"JUJU_HOOK_NAME=db-relation-changed"})
+	c.Check(snapshot.RemoteUnit, gc.Equals, "mysql/0")
+	c.Check(snapshot.Config, jc.DeepEquals, charm.Settings{"foo": "bar"})
+	c.Check(snapshot.Relations[0].Members, jc.DeepEquals, context.SettingsMap{
+		"mysql/0": {"host": "db"},
+		"mysql/1": nil,
+	})
+
+	snapshots, err := context.ListHookSnapshots(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshots, gc.HasLen, 2)
+	c.Check(snapshots[0].Hook, gc.Equals, "install")
+	c.Check(snapshots[1].Hook, gc.Equals, "db-relation-changed")
+}
+
+func (s *HookSnapshotSuite) TestWriteHookSnapshotRemovesOldest(c *gc.C) {
+	dir := c.MkDir()
+	for i := 0; i <= context.MaxHookSnapshots; i++ {
+		_, err := context.WriteHookSnapshot(dir, context.HookSnapshot{Hook: "update-status", RelationId: -1})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	snapshots, err := context.ListHookSnapshots(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshots, gc.HasLen, context.MaxHookSnapshots)
+	c.Check(snapshots[0].ID, gc.Equals, "2")
+	c.Check(snapshots[len(snapshots)-1].ID, gc.Equals, strconv.Itoa(context.MaxHookSnapshots+1))
+
+	_, err = context.ReadHookSnapshot(dir, "1")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *HookSnapshotSuite) TestReadHookSnapshotInvalidId(c *gc.C) {
+	_, err := context.ReadHookSnapshot(c.MkDir(), "../1")
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *HookSnapshotSuite) TestListHookSnapshotsNoDirectory(c *gc.C) {
+	snapshots, err := context.ListHookSnapshots(c.MkDir() + "/missing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(snapshots, gc.HasLen, 0)
+}
+
+func (s *HookSnapshotSuite) TestHookSnapshotDisabled(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+
+	_, ok := ctx.HookSnapshot()
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *HookSnapshotSuite) TestHookSnapshot(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.AddContextRelation(c, ctrl, "db0")
+	s.AddContextRelation(c, ctrl, "db1")
+	ctx := s.getHookContext(c, ctrl, coretesting.ModelTag.Id(), 0, "mysql/0", names.StorageTag{})
+	context.SetHookSnapshots(ctx, true)
+
+	s.relunits[0].EXPECT().ReadSettings(gomock.Any(), "mysql/0").Return(params.Settings{"host": "db"}, nil)
+	rel, err := ctx.Relation(0)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = rel.ReadSettings(stdcontext.Background(), "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	snapshot, ok := ctx.HookSnapshot()
+	c.Assert(ok, jc.IsTrue)
+	c.Check(snapshot.RelationId, gc.Equals, 0)
+	c.Check(snapshot.RemoteUnit, gc.Equals, "mysql/0")
+	c.Check(snapshot.Relations[0].Others, jc.DeepEquals, context.SettingsMap{"mysql/0": {"host": "db"}})
+	c.Check(snapshot.Relations[1].Others, gc.HasLen, 0)
+}
+
+func (s *HookSnapshotSuite) TestStartReplayNotAction(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+
+	err := ctx.StartReplay(context.HookSnapshot{Hook: "install", RelationId: -1})
+	c.Assert(err, gc.ErrorMatches, "hooks can only be replayed by an action")
+}
+
+func (s *HookSnapshotSuite) TestStartReplayUnknownRelation(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	context.WithActionContext(ctx, map[string]interface{}{}, nil)
+
+	err := ctx.StartReplay(context.HookSnapshot{Hook: "db-relation-changed", RelationId: 7})
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *HookSnapshotSuite) TestReplay(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ctx := s.context(c, ctrl)
+	results := map[string]interface{}{}
+	context.WithActionContext(ctx, results, nil)
+
+	err := ctx.StartReplay(context.HookSnapshot{
+		ID:         "3",
+		Hook:       "db-relation-changed",
+		Kind:       "relation-changed",
+		Env:        []string{"JUJU_CONTEXT_ID=u/0-db-relation-changed-1234", "JUJU_HOOK_NAME=db-relation-changed"},
+		RelationId: 0,
+		RemoteUnit: "mysql/0",
+		Config:     charm.Settings{"foo": "bar"},
+		Relations: map[int]context.RelationSnapshot{
+			0: {Members: context.SettingsMap{"mysql/0": {"host": "recorded"}}},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The hook is given the environment it was recorded with.
+	vars, err := ctx.HookVars(stdcontext.Background(), MockEnvPaths{}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(vars, jc.DeepEquals, []string{
+		"JUJU_CONTEXT_ID=TestCtx",
+		"JUJU_HOOK_NAME=db-relation-changed",
+		"JUJU_AGENT_SOCKET_ADDRESS=path-to-jujuc.socket",
+		"JUJU_AGENT_SOCKET_NETWORK=unix",
+	})
+
+	// The recorded data is read without calling the API.
+	remoteUnit, err := ctx.RemoteUnitName()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(remoteUnit, gc.Equals, "mysql/0")
+	cfg, err := ctx.ConfigSettings(stdcontext.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cfg, jc.DeepEquals, charm.Settings{"foo": "bar"})
+	rel, err := ctx.HookRelation()
+	c.Assert(err, jc.ErrorIsNil)
+	settings, err := rel.ReadSettings(stdcontext.Background(), "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(settings, jc.DeepEquals, params.Settings{"host": "recorded"})
+
+	// Changes are recorded rather than committed.
+	err = ctx.SetUnitStatus(stdcontext.Background(), jujuc.StatusInfo{Status: "active", Info: "ready"})
+	c.Assert(err, jc.ErrorIsNil)
+	node, err := rel.Settings(stdcontext.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	node.Set("address", "10.0.0.1")
+	err = ctx.AddMetricLabels("lag_seconds", "0.5", time.Now(), map[string]string{"peer": "mysql/0"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = ctx.DeferHook(jujuc.HookDeferral{Reason: "database not ready"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.uniter.EXPECT().ActionFinish(gomock.Any(), names.NewActionTag("2"), params.ActionCompleted, map[string]interface{}{
+		"snapshot": "3",
+		"hook":     "db-relation-changed",
+		"changes": []string{
+			`status-set active "ready"`,
+			"relation-set -r db:0 address=10.0.0.1",
+			"metric-add --labels peer=mysql/0 lag_seconds=0.5",
+			`hook-defer --reason "database not ready"`,
+		},
+	}, "").Return(nil)
+
+	err = ctx.Flush(stdcontext.Background(), "db-relation-changed", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results["changes"], gc.HasLen, 4)
+}
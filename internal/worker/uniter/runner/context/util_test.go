@@ -356,3 +356,7 @@ func (MockEnvPaths) GetJujucServerSocket() sockets.Socket {
 func (MockEnvPaths) GetMetricsSpoolDir() string {
 	return "path-to-metrics-spool-dir"
 }
+
+func (MockEnvPaths) GetHookSnapshotsDir() string {
+	return "path-to-hook-snapshots-dir"
+}
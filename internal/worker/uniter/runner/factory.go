@@ -79,7 +79,7 @@ func (f *factory) NewHookRunner(stdCtx stdcontext.Context, hookInfo hook.Info) (
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	runner := f.newProcessRunner(ctx, f.paths)
+	runner := f.newProcessRunner(ctx, f.paths, WithTimeout(ctx.HookTimeout()))
 	return runner, nil
 }
 
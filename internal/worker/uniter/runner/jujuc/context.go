@@ -5,9 +5,6 @@ package jujuc
 
 import (
 	"context"
-	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/juju/errors"
@@ -49,6 +46,9 @@ type HookContext interface {
 	ContextRelations
 	ContextVersion
 	ContextSecrets
+	ContextSchedules
+	ContextDeferral
+	ContextMetrics
 
 	// GetLogger returns a juju logger Logger for the supplied module that is
 	// correctly wired up for the given context
@@ -156,6 +156,7 @@ type SecretCreateArgs struct {
 	SecretUpdateArgs
 
 	Owner secrets.Owner
+	Type  secrets.SecretType
 }
 
 // SecretUpdateArgs specifies args used to update a secret.
@@ -169,6 +170,19 @@ type SecretUpdateArgs struct {
 
 	Description *string
 	Label       *string
+
+	// StageTimeout is set if the new value is to be staged, and is
+	// how long consumers have to track the new revision before the
+	// rotation expires.
+	StageTimeout *time.Duration
+
+	// RollbackOnTimeout is set if the previous revision is to be
+	// restored when a staged rotation expires.
+	RollbackOnTimeout bool
+
+	// RollbackRevision is set if an existing revision is to be
+	// restored as the latest revision.
+	RollbackRevision *int
 }
 
 // SecretGrantRevokeArgs specify the args used to grant or revoke access to a secret.
@@ -182,6 +196,7 @@ type SecretGrantRevokeArgs struct {
 // SecretMetadata holds a secret's metadata.
 type SecretMetadata struct {
 	Owner            secrets.Owner
+	Type             secrets.SecretType
 	Description      string
 	Label            string
 	RotatePolicy     secrets.RotatePolicy
@@ -217,6 +232,52 @@ type ContextSecrets interface {
 	SecretMetadata() (map[string]SecretMetadata, error)
 }
 
+// ContextSchedules is the part of a hook context related to the
+// schedules on which the charm's schedule hooks are run.
+type ContextSchedules interface {
+	// SetSchedule registers a schedule, or replaces a schedule previously
+	// registered by the charm.
+	SetSchedule(charm.Schedule) error
+
+	// RemoveSchedule removes a schedule registered by the charm.
+	RemoveSchedule(name string) error
+}
+
+// HookDeferral holds how the charm asked for the running hook to be
+// deferred.
+type HookDeferral struct {
+	// Reason is why the hook was deferred.
+	Reason string
+
+	// RetryAfter is how long to wait before running the hook again if
+	// no other hook is run first. If zero, the hook waits for another
+	// hook to be run.
+	RetryAfter time.Duration
+
+	// DropOnUpgrade is true if the hook is discarded when the charm
+	// is upgraded.
+	DropOnUpgrade bool
+}
+
+// ContextDeferral is the part of a hook context related to deferring
+// the running hook.
+type ContextDeferral interface {
+	// DeferHook asks for the running hook to be run again later.
+	DeferHook(HookDeferral) error
+}
+
+// ContextMetrics is the part of a hook context related to the workload
+// metrics published by the charm.
+type ContextMetrics interface {
+	// AddMetric records a workload metric to be published when the hook
+	// completes.
+	AddMetric(key, value string, created time.Time) error
+
+	// AddMetricLabels records a labelled workload metric to be published
+	// when the hook completes.
+	AddMetricLabels(key, value string, created time.Time, labels map[string]string) error
+}
+
 // ContextStatus is the part of a hook context related to the unit's status.
 type ContextStatus interface {
 	// UnitStatus returns the executing unit's current status.
@@ -358,6 +419,11 @@ type ContextRelation interface {
 	// ReadApplicationSettings returns the application settings of any remote unit in the relation.
 	ReadApplicationSettings(ctx context.Context, app string) (params.Settings, error)
 
+	// PendingSettings returns the changes to the local unit's and
+	// application's settings in this relation which have not yet been
+	// committed. Deleted keys have an empty value.
+	PendingSettings() (unitSettings, appSettings params.Settings)
+
 	// Suspended returns true if the relation is suspended.
 	Suspended() bool
 
@@ -442,13 +508,9 @@ func (v *relationIdValue) String() string {
 // if it is not known to the system. The parsed relation id will be written
 // to v.result.
 func (v *relationIdValue) Set(value string) error {
-	trim := value
-	if idx := strings.LastIndex(trim, ":"); idx != -1 {
-		trim = trim[idx+1:]
-	}
-	id, err := strconv.Atoi(trim)
+	id, err := parseRelationId(value)
 	if err != nil {
-		return fmt.Errorf("invalid relation id")
+		return errors.Trace(err)
 	}
 	if _, err := v.ctx.Relation(id); err != nil {
 		return errors.Trace(err)
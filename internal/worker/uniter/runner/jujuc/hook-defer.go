@@ -0,0 +1,77 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+type hookDeferCommand struct {
+	cmd.CommandBase
+	ctx Context
+
+	deferral   HookDeferral
+	retryAfter string
+}
+
+// NewHookDeferCommand returns a command to defer the running hook.
+func NewHookDeferCommand(ctx Context) (cmd.Command, error) {
+	return &hookDeferCommand{ctx: ctx}, nil
+}
+
+// Info implements cmd.Command.
+func (c *hookDeferCommand) Info() *cmd.Info {
+	doc := `
+Defer the running hook, so that it is run again later with the same
+environment. The hook is run again after the next hook run for the unit, or
+once the retry period has passed if no other hook has been run by then. A
+hook which is deferred again waits for another hook to be run.
+
+Hooks which change the lifecycle of the unit, such as install, start and
+stop, cannot be deferred.
+
+The hook is only deferred if it completes successfully. The deferred hooks
+of a unit are shown by "juju show-unit --deferred".
+`
+	examples := `
+    hook-defer --reason "database not ready"
+    hook-defer --reason "waiting for certificate" --retry-after 5m --drop-on-upgrade
+`
+	return jujucmd.Info(&cmd.Info{
+		Name:     "hook-defer",
+		Purpose:  "Defer the running hook until later.",
+		Doc:      doc,
+		Examples: examples,
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *hookDeferCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.deferral.Reason, "reason", "", "why the hook is deferred")
+	f.StringVar(&c.retryAfter, "retry-after", "", "run the hook again after this long if no other hook has been run")
+	f.BoolVar(&c.deferral.DropOnUpgrade, "drop-on-upgrade", false, "discard the hook if the charm is upgraded")
+}
+
+// Init implements cmd.Command.
+func (c *hookDeferCommand) Init(args []string) error {
+	if c.retryAfter != "" {
+		retryAfter, err := time.ParseDuration(c.retryAfter)
+		if err != nil || retryAfter <= 0 {
+			return errors.NotValidf("retry-after %q", c.retryAfter)
+		}
+		c.deferral.RetryAfter = retryAfter
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.
+func (c *hookDeferCommand) Run(_ *cmd.Context) error {
+	return c.ctx.DeferHook(c.deferral)
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+)
+
+type HookDeferSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&HookDeferSuite{})
+
+func (s *HookDeferSuite) TestDeferHookInvalidArgs(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{"--retry-after", "soon"},
+			err:  `ERROR retry-after "soon" not valid`,
+		}, {
+			args: []string{"--retry-after", "-5m"},
+			err:  `ERROR retry-after "-5m" not valid`,
+		}, {
+			args: []string{"extra"},
+			err:  `ERROR unrecognized args: ["extra"]`,
+		},
+	} {
+		com, err := jujuc.NewCommand(hctx, "hook-defer")
+		c.Assert(err, jc.ErrorIsNil)
+		ctx := cmdtesting.Context(c)
+		code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, t.args)
+
+		c.Check(code, gc.Equals, 2)
+		c.Check(bufferString(ctx.Stderr), gc.Equals, t.err+"\n")
+	}
+}
+
+func (s *HookDeferSuite) TestDeferHook(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "hook-defer")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"--reason", "database not ready", "--retry-after", "5m", "--drop-on-upgrade",
+	})
+
+	c.Assert(code, gc.Equals, 0)
+	s.Stub.CheckCall(c, 0, "DeferHook", jujuc.HookDeferral{
+		Reason:        "database not ready",
+		RetryAfter:    5 * time.Minute,
+		DropOnUpgrade: true,
+	})
+}
+
+func (s *HookDeferSuite) TestDeferHookDefaults(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "hook-defer")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, nil)
+
+	c.Assert(code, gc.Equals, 0)
+	s.Stub.CheckCall(c, 0, "DeferHook", jujuc.HookDeferral{})
+}
+
+func (s *HookDeferSuite) TestDeferHookError(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+	s.Stub.SetErrors(errors.NotSupportedf("deferring install hook"))
+
+	com, err := jujuc.NewCommand(hctx, "hook-defer")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, nil)
+
+	c.Assert(code, gc.Equals, 1)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "ERROR deferring install hook not supported\n")
+}
@@ -73,6 +73,9 @@ type Context struct {
 	ContextVersion
 	ContextWorkloadHook
 	ContextSecrets
+	ContextSchedules
+	ContextDeferral
+	ContextMetrics
 }
 
 // NewContext builds a jujuc.Context test double.
@@ -104,6 +107,9 @@ func NewContext(stub *testing.Stub, info *ContextInfo) *Context {
 	ctx.ContextWorkloadHook.stub = stub
 	ctx.ContextWorkloadHook.info = &info.WorkloadHook
 	ctx.ContextSecrets.stub = stub
+	ctx.ContextSchedules.stub = stub
+	ctx.ContextDeferral.stub = stub
+	ctx.ContextMetrics.stub = stub
 	return &ctx
 }
 
@@ -0,0 +1,19 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuctesting
+
+import (
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+)
+
+// ContextDeferral is a test double for jujuc.ContextDeferral.
+type ContextDeferral struct {
+	contextBase
+}
+
+// DeferHook implements jujuc.ContextDeferral.
+func (c *ContextDeferral) DeferHook(deferral jujuc.HookDeferral) error {
+	c.stub.AddCall("DeferHook", deferral)
+	return c.stub.NextErr()
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuctesting
+
+import (
+	"time"
+)
+
+// ContextMetrics is a test double for jujuc.ContextMetrics.
+type ContextMetrics struct {
+	contextBase
+}
+
+// AddMetric implements jujuc.ContextMetrics.
+func (c *ContextMetrics) AddMetric(key, value string, created time.Time) error {
+	c.stub.AddCall("AddMetric", key, value, created)
+	return c.stub.NextErr()
+}
+
+// AddMetricLabels implements jujuc.ContextMetrics.
+func (c *ContextMetrics) AddMetricLabels(key, value string, created time.Time, labels map[string]string) error {
+	c.stub.AddCall("AddMetricLabels", key, value, created, labels)
+	return c.stub.NextErr()
+}
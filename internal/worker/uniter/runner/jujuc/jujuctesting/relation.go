@@ -34,6 +34,10 @@ type Relation struct {
 	LocalApplicationSettings Settings
 	// RemoteApplicationName is data for jujuc.ContextRelation
 	RemoteApplicationName string
+	// PendingUnitSettings is data for jujuc.ContextRelation
+	PendingUnitSettings Settings
+	// PendingApplicationSettings is data for jujuc.ContextRelation
+	PendingApplicationSettings Settings
 	// The current life value.
 	Life life.Value
 }
@@ -170,6 +174,21 @@ func (r *ContextRelation) ReadApplicationSettings(_ context.Context, name string
 	return r.info.RemoteApplicationSettings.Map(), nil
 }
 
+// PendingSettings implements jujuc.ContextRelation.
+func (r *ContextRelation) PendingSettings() (params.Settings, params.Settings) {
+	r.stub.AddCall("PendingSettings")
+	_ = r.stub.NextErr()
+
+	var unitSettings, appSettings params.Settings
+	if r.info.PendingUnitSettings != nil {
+		unitSettings = r.info.PendingUnitSettings.Map()
+	}
+	if r.info.PendingApplicationSettings != nil {
+		appSettings = r.info.PendingApplicationSettings.Map()
+	}
+	return unitSettings, appSettings
+}
+
 // Suspended implements jujuc.ContextRelation.
 func (r *ContextRelation) Suspended() bool {
 	return true
@@ -0,0 +1,25 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuctesting
+
+import (
+	"github.com/juju/juju/internal/charm"
+)
+
+// ContextSchedules is a test double for jujuc.ContextSchedules.
+type ContextSchedules struct {
+	contextBase
+}
+
+// SetSchedule implements jujuc.ContextSchedules.
+func (c *ContextSchedules) SetSchedule(schedule charm.Schedule) error {
+	c.stub.AddCall("SetSchedule", schedule)
+	return c.stub.NextErr()
+}
+
+// RemoveSchedule implements jujuc.ContextSchedules.
+func (c *ContextSchedules) RemoveSchedule(name string) error {
+	c.stub.AddCall("RemoveSchedule", name)
+	return c.stub.NextErr()
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/utils/v4/keyvalues"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// metricSample holds a single key=value argument to metric-add.
+type metricSample struct {
+	key   string
+	value string
+}
+
+type metricAddCommand struct {
+	cmd.CommandBase
+	ctx Context
+
+	labelsArg string
+	labels    map[string]string
+	metrics   []metricSample
+}
+
+// NewMetricAddCommand returns a command to publish workload metrics.
+func NewMetricAddCommand(ctx Context) (cmd.Command, error) {
+	return &metricAddCommand{ctx: ctx}, nil
+}
+
+// Info implements cmd.Command.
+func (c *metricAddCommand) Info() *cmd.Info {
+	doc := `
+Publish one or more workload metrics, such as a queue depth or replication
+lag, to the controller. Each metric is a numeric value for a key, optionally
+qualified by labels. Keys and label names must start with a letter or an
+underscore and contain only letters, digits and underscores; names starting
+with "juju_" are reserved.
+
+The metrics are published when the hook completes successfully, and are
+kept for the duration set by the workload-metrics-retention model config.
+They are shown by "juju metrics" and served by the controller's Prometheus
+endpoint.
+`
+	examples := `
+    metric-add queue_depth=42
+    metric-add --labels queue=orders,region=eu queue_depth=42 lag_seconds=0.5
+`
+	return jujucmd.Info(&cmd.Info{
+		Name:     "metric-add",
+		Args:     "key=value [key=value ...]",
+		Purpose:  "Publish workload metrics to the controller.",
+		Doc:      doc,
+		Examples: examples,
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *metricAddCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.labelsArg, "labels", "", "labels to apply to the metrics, as a comma separated list of key=value")
+}
+
+// Init implements cmd.Command.
+func (c *metricAddCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no metrics specified")
+	}
+	if c.labelsArg != "" {
+		labels, err := keyvalues.Parse(strings.Split(c.labelsArg, ","), false)
+		if err != nil {
+			return errors.Annotate(err, "invalid labels")
+		}
+		c.labels = labels
+	}
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return errors.Errorf(`expected "key=value", got %q`, arg)
+		}
+		if seen[key] {
+			return errors.Errorf("metric %q specified more than once", key)
+		}
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.NotValidf("value %q for metric %q", value, key)
+		}
+		seen[key] = true
+		c.metrics = append(c.metrics, metricSample{key: key, value: value})
+	}
+	return nil
+}
+
+// Run implements cmd.Command.
+func (c *metricAddCommand) Run(_ *cmd.Context) error {
+	created := time.Now()
+	for _, metric := range c.metrics {
+		var err error
+		if len(c.labels) == 0 {
+			err = c.ctx.AddMetric(metric.key, metric.value, created)
+		} else {
+			err = c.ctx.AddMetricLabels(metric.key, metric.value, created, c.labels)
+		}
+		if err != nil {
+			return errors.Annotatef(err, "cannot add metric %q", metric.key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+)
+
+type MetricAddSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&MetricAddSuite{})
+
+func (s *MetricAddSuite) TestMetricAddInvalidArgs(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: nil,
+			err:  `ERROR no metrics specified`,
+		}, {
+			args: []string{"queue_depth"},
+			err:  `ERROR expected "key=value", got "queue_depth"`,
+		}, {
+			args: []string{"queue_depth="},
+			err:  `ERROR expected "key=value", got "queue_depth="`,
+		}, {
+			args: []string{"queue_depth=lots"},
+			err:  `ERROR value "lots" for metric "queue_depth" not valid`,
+		}, {
+			args: []string{"queue_depth=1", "queue_depth=2"},
+			err:  `ERROR metric "queue_depth" specified more than once`,
+		}, {
+			args: []string{"--labels", "queue", "queue_depth=1"},
+			err:  `ERROR invalid labels: expected "key=value", got "queue"`,
+		},
+	} {
+		com, err := jujuc.NewCommand(hctx, "metric-add")
+		c.Assert(err, jc.ErrorIsNil)
+		ctx := cmdtesting.Context(c)
+		code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, t.args)
+
+		c.Check(code, gc.Equals, 2, gc.Commentf("%v", t.args))
+		c.Check(bufferString(ctx.Stderr), gc.Equals, t.err+"\n")
+	}
+}
+
+func (s *MetricAddSuite) TestMetricAdd(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "metric-add")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"queue_depth=42", "lag_seconds=0.5",
+	})
+
+	c.Assert(code, gc.Equals, 0)
+	s.Stub.CheckCallNames(c, "AddMetric", "AddMetric")
+	calls := s.Stub.Calls()
+	c.Check(calls[0].Args[:2], jc.DeepEquals, []interface{}{"queue_depth", "42"})
+	c.Check(calls[1].Args[:2], jc.DeepEquals, []interface{}{"lag_seconds", "0.5"})
+	c.Check(calls[0].Args[2], gc.FitsTypeOf, time.Time{})
+	c.Check(calls[1].Args[2], gc.Equals, calls[0].Args[2])
+}
+
+func (s *MetricAddSuite) TestMetricAddLabels(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "metric-add")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"--labels", "queue=orders,region=eu", "queue_depth=42",
+	})
+
+	c.Assert(code, gc.Equals, 0)
+	s.Stub.CheckCallNames(c, "AddMetricLabels")
+	args := s.Stub.Calls()[0].Args
+	c.Check(args[0], gc.Equals, "queue_depth")
+	c.Check(args[1], gc.Equals, "42")
+	c.Check(args[3], jc.DeepEquals, map[string]string{
+		"queue":  "orders",
+		"region": "eu",
+	})
+}
+
+func (s *MetricAddSuite) TestMetricAddError(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+	s.Stub.SetErrors(errors.NotValidf("metric key %q", "juju_up"))
+
+	com, err := jujuc.NewCommand(hctx, "metric-add")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{"juju_up=1"})
+
+	c.Assert(code, gc.Equals, 1)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, `ERROR cannot add metric "juju_up": metric key "juju_up" not valid`+"\n")
+}
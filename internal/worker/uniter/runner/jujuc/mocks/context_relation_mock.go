@@ -235,6 +235,45 @@ func (c *MockContextRelationNameCall) DoAndReturn(f func() string) *MockContextR
 	return c
 }
 
+// PendingSettings mocks base method.
+func (m *MockContextRelation) PendingSettings() (params.Settings, params.Settings) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingSettings")
+	ret0, _ := ret[0].(params.Settings)
+	ret1, _ := ret[1].(params.Settings)
+	return ret0, ret1
+}
+
+// PendingSettings indicates an expected call of PendingSettings.
+func (mr *MockContextRelationMockRecorder) PendingSettings() *MockContextRelationPendingSettingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingSettings", reflect.TypeOf((*MockContextRelation)(nil).PendingSettings))
+	return &MockContextRelationPendingSettingsCall{Call: call}
+}
+
+// MockContextRelationPendingSettingsCall wrap *gomock.Call
+type MockContextRelationPendingSettingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextRelationPendingSettingsCall) Return(arg0, arg1 params.Settings) *MockContextRelationPendingSettingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextRelationPendingSettingsCall) Do(f func() (params.Settings, params.Settings)) *MockContextRelationPendingSettingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextRelationPendingSettingsCall) DoAndReturn(f func() (params.Settings, params.Settings)) *MockContextRelationPendingSettingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReadApplicationSettings mocks base method.
 func (m *MockContextRelation) ReadApplicationSettings(arg0 context.Context, arg1 string) (params.Settings, error) {
 	m.ctrl.T.Helper()
@@ -24,6 +24,7 @@ type RelationGetCommand struct {
 	RelationId      int
 	relationIdProxy gnuflag.Value
 	Application     bool
+	Pending         bool
 
 	Key           string
 	UnitOrAppName string
@@ -81,6 +82,12 @@ that depends on remote unit settings.
 
 Key value pairs for remote units that have departed remain accessible for the lifetime
 of the relation.
+
+With --pending, relation-get prints the changes to the local unit's settings, or with
+--app the local application's settings, which have been made with "relation-set" or
+"relation-set-many" but not yet committed. Removed keys are printed with an empty value.
+If no relation is specified and there is no current relation, the pending changes in
+every relation are printed, keyed on relation id, in the form read by "relation-set-many".
 `
 	examples := `
     # Getting the settings of the default unit in the default relation is done with:
@@ -96,6 +103,18 @@ of the relation.
     $ relation-get -r database:7 - mongodb/5
     username: bob
     password: 2db673e81ffa264c
+
+    # To get the changes made to the local unit's settings which are not yet committed
+    $ relation-get -r database:7 --pending
+    username: jim
+    password: ""
+
+    # To get the changes not yet committed in every relation
+    $ relation-get --pending
+    database:7:
+      unit:
+        username: jim
+        password: ""
 `
 	// There's nothing we can really do about the error here.
 	if name, err := c.ctx.RemoteUnitName(); err == nil {
@@ -121,6 +140,8 @@ func (c *RelationGetCommand) SetFlags(f *gnuflag.FlagSet) {
 
 	f.BoolVar(&c.Application, "app", false,
 		`Get the relation data for the overall application, not just a unit`)
+	f.BoolVar(&c.Pending, "pending", false,
+		`Get the local changes which are not yet committed`)
 }
 
 func (c *RelationGetCommand) determineUnitOrAppName(args *[]string) error {
@@ -191,6 +212,9 @@ func (c *RelationGetCommand) determineUnitOrAppName(args *[]string) error {
 
 // Init is part of the cmd.Command interface.
 func (c *RelationGetCommand) Init(args []string) error {
+	if c.Pending {
+		return c.initPending(args)
+	}
 	if c.RelationId == -1 {
 		return fmt.Errorf("no relation id specified")
 	}
@@ -208,7 +232,38 @@ func (c *RelationGetCommand) Init(args []string) error {
 	return cmd.CheckEmpty(args)
 }
 
+// initPending initialises the command to print pending changes, which are
+// only ever made to the local unit's or application's settings.
+func (c *RelationGetCommand) initPending(args []string) error {
+	c.Key = ""
+	if len(args) > 0 {
+		if c.Key = args[0]; c.Key == "-" {
+			c.Key = ""
+		}
+		args = args[1:]
+	}
+	if c.RelationId == -1 && c.Key != "" {
+		return fmt.Errorf("no relation id specified")
+	}
+	c.UnitOrAppName = c.ctx.UnitName()
+	if len(args) > 0 {
+		localAppName, _ := names.UnitApplication(c.UnitOrAppName)
+		switch args[0] {
+		case c.UnitOrAppName:
+		case localAppName:
+			c.Application = true
+		default:
+			return fmt.Errorf("pending changes are only made to the local unit or application, not %q", args[0])
+		}
+		args = args[1:]
+	}
+	return cmd.CheckEmpty(args)
+}
+
 func (c *RelationGetCommand) Run(ctx *cmd.Context) error {
+	if c.Pending {
+		return c.writePending(ctx)
+	}
 	r, err := c.ctx.Relation(c.RelationId)
 	if err != nil {
 		return errors.Trace(err)
@@ -238,6 +293,53 @@ func (c *RelationGetCommand) Run(ctx *cmd.Context) error {
 	return c.out.Write(ctx, nil)
 }
 
+// writePending prints the pending changes in the relation, or in every
+// relation if none is specified.
+func (c *RelationGetCommand) writePending(ctx *cmd.Context) error {
+	if c.RelationId != -1 {
+		r, err := c.ctx.Relation(c.RelationId)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		unitSettings, appSettings := r.PendingSettings()
+		settings := unitSettings
+		if c.Application {
+			settings = appSettings
+		}
+		if settings == nil {
+			settings = params.Settings{}
+		}
+		if c.Key == "" {
+			return c.out.Write(ctx, settings)
+		}
+		if value, ok := settings[c.Key]; ok {
+			return c.out.Write(ctx, value)
+		}
+		return c.out.Write(ctx, nil)
+	}
+
+	ids, err := c.ctx.RelationIds()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	pending := make(map[string]RelationSettingsChanges)
+	for _, id := range ids {
+		r, err := c.ctx.Relation(id)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		unitSettings, appSettings := r.PendingSettings()
+		if len(unitSettings) == 0 && len(appSettings) == 0 {
+			continue
+		}
+		pending[r.FakeId()] = RelationSettingsChanges{
+			Unit:        unitSettings,
+			Application: appSettings,
+		}
+	}
+	return c.out.Write(ctx, pending)
+}
+
 func (c *RelationGetCommand) mustReadSettingsFromController() (bool, error) {
 	localUnitName := c.ctx.UnitName()
 	if c.UnitOrAppName == localUnitName {
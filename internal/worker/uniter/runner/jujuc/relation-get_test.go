@@ -460,3 +460,77 @@ func (s *RelationGetSuite) TestInit(c *gc.C) {
 		t.check(c, com, err)
 	}
 }
+
+var relationGetPendingTests = []struct {
+	summary string
+	relid   int
+	args    []string
+	code    int
+	out     string
+}{
+	{
+		summary: "pending unit changes in the default relation",
+		relid:   0,
+		args:    []string{"--pending", "--format", "json"},
+		out:     `{"host":"10.0.0.1","private-address":""}`,
+	}, {
+		summary: "pending unit change in the default relation, by key",
+		relid:   0,
+		args:    []string{"--pending", "host"},
+		out:     "10.0.0.1",
+	}, {
+		summary: "pending application changes in an explicit relation",
+		relid:   -1,
+		args:    []string{"--pending", "--app", "-r", "peer0:0", "--format", "json"},
+		out:     `{"endpoint":"new"}`,
+	}, {
+		summary: "pending application changes, by application name",
+		relid:   -1,
+		args:    []string{"--pending", "-r", "peer0:0", "-", "u", "--format", "json"},
+		out:     `{"endpoint":"new"}`,
+	}, {
+		summary: "no pending changes",
+		relid:   1,
+		args:    []string{"--pending", "--format", "json"},
+		out:     `{}`,
+	}, {
+		summary: "pending changes in every relation",
+		relid:   -1,
+		args:    []string{"--pending", "--format", "json"},
+		out:     `{"peer0:0":{"unit":{"host":"10.0.0.1","private-address":""},"app":{"endpoint":"new"}}}`,
+	}, {
+		summary: "key without a relation",
+		relid:   -1,
+		args:    []string{"--pending", "host"},
+		code:    2,
+		out:     "no relation id specified",
+	}, {
+		summary: "remote unit",
+		relid:   0,
+		args:    []string{"--pending", "-", "m/0"},
+		code:    2,
+		out:     `pending changes are only made to the local unit or application, not "m/0"`,
+	},
+}
+
+func (s *RelationGetSuite) TestRelationGetPending(c *gc.C) {
+	for i, t := range relationGetPendingTests {
+		c.Logf("test %d: %s", i, t.summary)
+		hctx, info := s.newHookContext(t.relid, "", "")
+		info.rels[0].PendingUnitSettings = jujuctesting.Settings{"host": "10.0.0.1", "private-address": ""}
+		info.rels[0].PendingApplicationSettings = jujuctesting.Settings{"endpoint": "new"}
+		com, err := jujuc.NewCommand(hctx, "relation-get")
+		c.Assert(err, jc.ErrorIsNil)
+		ctx := cmdtesting.Context(c)
+		code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, t.args)
+		c.Check(code, gc.Equals, t.code)
+		if code == 0 {
+			c.Check(bufferString(ctx.Stderr), gc.Equals, "")
+			c.Check(bufferString(ctx.Stdout), gc.Equals, t.out+"\n")
+		} else {
+			c.Check(bufferString(ctx.Stdout), gc.Equals, "")
+			expect := fmt.Sprintf(`(.|\n)*ERROR %s\n`, t.out)
+			c.Check(bufferString(ctx.Stderr), gc.Matches, expect)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	goyaml "gopkg.in/yaml.v2"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/core/quota"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const relationSetManyDoc = `
+"relation-set-many" writes the local unit's and application's settings for
+several relations at once. The settings are read from a YAML or JSON document,
+keyed on relation id, which holds the "unit" settings and, if the unit is the
+leader, the "app" settings to write in each relation.
+
+As with relation-set, the setting values are stored as strings, and setting an
+empty string causes the setting to be removed.
+
+The whole document is checked before any settings are written. If any relation
+is unknown or given more than once, if application settings are given but the
+unit is not the leader, or if a key or value is too long, every problem found
+is reported and no settings are written.
+
+As with relation-set, the settings are committed when the hook terminates
+successfully. The settings waiting to be committed can be read back with
+'relation-get --pending'.
+
+The document is read from the file given by --file, or from <stdin> if no file
+is given.
+
+The following fixed size limits apply:
+- Length of keys cannot exceed %d bytes.
+- Length of values cannot exceed %d bytes.
+`
+
+const relationSetManyExamples = `
+    relation-set-many --file settings.yaml
+
+    cat <<EOF | relation-set-many
+    db:3:
+      unit:
+        host: 10.0.0.1
+      app:
+        database: wordpress
+    website:7:
+      unit:
+        port: "80"
+    EOF
+`
+
+// RelationSettingsChanges holds the settings to write in a relation.
+type RelationSettingsChanges struct {
+	Unit        map[string]string `yaml:"unit,omitempty" json:"unit,omitempty"`
+	Application map[string]string `yaml:"app,omitempty" json:"app,omitempty"`
+}
+
+// RelationSetManyCommand implements the relation-set-many command.
+type RelationSetManyCommand struct {
+	cmd.CommandBase
+	ctx          Context
+	settingsFile cmd.FileVar
+}
+
+func NewRelationSetManyCommand(ctx Context) (cmd.Command, error) {
+	return &RelationSetManyCommand{ctx: ctx}, nil
+}
+
+func (c *RelationSetManyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "relation-set-many",
+		Purpose: "Set relation settings in several relations.",
+		Doc: fmt.Sprintf(
+			relationSetManyDoc,
+			quota.MaxRelationSettingsKeySize,
+			quota.MaxRelationSettingsValueSize,
+		),
+		Examples: relationSetManyExamples,
+		SeeAlso:  []string{"relation-get", "relation-set"},
+	})
+}
+
+func (c *RelationSetManyCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.settingsFile.SetStdin()
+	f.Var(&c.settingsFile, "file", "file containing the settings for each relation")
+}
+
+func (c *RelationSetManyCommand) Init(args []string) error {
+	if c.settingsFile.Path == "" {
+		c.settingsFile.Path = "-"
+	}
+	return cmd.CheckEmpty(args)
+}
+
+func (c *RelationSetManyCommand) Run(ctx *cmd.Context) error {
+	file, err := c.settingsFile.Open(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer file.Close()
+
+	changes, err := readRelationSettingsChanges(file)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	relations, conflicts := c.checkChanges(changes)
+	if len(conflicts) > 0 {
+		return errors.Errorf("no relation settings written:\n  %s", strings.Join(conflicts, "\n  "))
+	}
+
+	for _, key := range sortedRelationKeys(changes) {
+		r := relations[key]
+		change := changes[key]
+		if len(change.Unit) > 0 {
+			settings, err := r.Settings(ctx)
+			if err != nil {
+				return errors.Annotatef(err, "cannot read relation %s settings", r.FakeId())
+			}
+			applySettings(settings, change.Unit)
+		}
+		if len(change.Application) > 0 {
+			settings, err := r.ApplicationSettings(ctx)
+			if err != nil {
+				return errors.Annotatef(err, "cannot read relation %s application settings", r.FakeId())
+			}
+			applySettings(settings, change.Application)
+		}
+	}
+	return nil
+}
+
+// checkChanges returns the relation each change is written to, and
+// describes each of the changes which cannot be written.
+func (c *RelationSetManyCommand) checkChanges(changes map[string]RelationSettingsChanges) (map[string]ContextRelation, []string) {
+	var conflicts []string
+	conflict := func(key, format string, args ...interface{}) {
+		conflicts = append(conflicts, key+": "+fmt.Sprintf(format, args...))
+	}
+
+	var isLeader *bool
+	relations := make(map[string]ContextRelation)
+	seen := make(map[int]string)
+	for _, key := range sortedRelationKeys(changes) {
+		id, err := parseRelationId(key)
+		if err != nil {
+			conflict(key, "%v", err)
+			continue
+		}
+		r, err := c.ctx.Relation(id)
+		if errors.Is(err, errors.NotFound) {
+			conflict(key, "relation not found")
+			continue
+		} else if err != nil {
+			conflict(key, "%v", err)
+			continue
+		}
+		if other, ok := seen[id]; ok {
+			conflict(key, "relation %s also given as %q", r.FakeId(), other)
+			continue
+		}
+		seen[id] = key
+		relations[key] = r
+
+		change := changes[key]
+		if len(change.Application) > 0 {
+			if isLeader == nil {
+				leader, err := c.ctx.IsLeader()
+				if err != nil {
+					return nil, []string{fmt.Sprintf("cannot determine leadership status: %v", err)}
+				}
+				isLeader = &leader
+			}
+			if !*isLeader {
+				conflict(key, "cannot write application settings, unit is not the leader")
+			}
+		}
+		for _, scope := range []struct {
+			name     string
+			settings map[string]string
+		}{{"unit", change.Unit}, {"app", change.Application}} {
+			for _, k := range sortedKeys(scope.settings) {
+				if err := quota.CheckTupleSize(k, scope.settings[k], quota.MaxRelationSettingsKeySize, quota.MaxRelationSettingsValueSize); err != nil {
+					conflict(key, "%s setting %q: %v", scope.name, k, err)
+				}
+			}
+		}
+	}
+	return relations, conflicts
+}
+
+func readRelationSettingsChanges(in io.Reader) (map[string]RelationSettingsChanges, error) {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// JSON is a subset of YAML, so both are read the same way.
+	changes := make(map[string]RelationSettingsChanges)
+	if err := goyaml.UnmarshalStrict(data, &changes); err != nil {
+		return nil, errors.Annotate(err, "cannot read relation settings")
+	}
+	return changes, nil
+}
+
+// parseRelationId returns the relation id from a string of the form
+// "relation-name:123" or "123".
+func parseRelationId(value string) (int, error) {
+	trim := value
+	if idx := strings.LastIndex(trim, ":"); idx != -1 {
+		trim = trim[idx+1:]
+	}
+	id, err := strconv.Atoi(trim)
+	if err != nil {
+		return -1, errors.New("invalid relation id")
+	}
+	return id, nil
+}
+
+func applySettings(settings Settings, changes map[string]string) {
+	for k, v := range changes {
+		if v != "" {
+			settings.Set(k, v)
+		} else {
+			settings.Delete(k)
+		}
+	}
+}
+
+func sortedRelationKeys(changes map[string]RelationSettingsChanges) []string {
+	keys := make([]string, 0, len(changes))
+	for k := range changes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc/jujuctesting"
+)
+
+type RelationSetManySuite struct {
+	relationSuite
+}
+
+var _ = gc.Suite(&RelationSetManySuite{})
+
+func (s *RelationSetManySuite) newHookContext() (jujuc.Context, *relationInfo) {
+	hctx, info := s.relationSuite.newHookContext(-1, "", "")
+	// Each relation is given its own settings, so changes to one are not
+	// seen in the other.
+	info.rels[0].SetRelated(s.Unit, jujuctesting.Settings{"private-address": "u-0.testing.invalid"})
+	info.rels[1].SetRelated(s.Unit, jujuctesting.Settings{"private-address": "u-0.testing.invalid"})
+	info.rels[0].SetLocalApplicationSettings(jujuctesting.Settings{"endpoint": "old"})
+	info.rels[1].SetLocalApplicationSettings(jujuctesting.Settings{})
+	return hctx, info
+}
+
+func (s *RelationSetManySuite) run(c *gc.C, hctx jujuc.Context, content string, args ...string) (int, *cmd.Context) {
+	com, err := jujuc.NewCommand(hctx, "relation-set-many")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = bytes.NewBufferString(content)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, args)
+	return code, ctx
+}
+
+func (s *RelationSetManySuite) TestHelp(c *gc.C) {
+	hctx, _ := s.newHookContext()
+	code, ctx := s.run(c, hctx, "", "--help")
+	c.Assert(code, gc.Equals, 0)
+	c.Check(strings.Contains(bufferString(ctx.Stdout), "relation-set-many --file settings.yaml"), jc.IsTrue)
+}
+
+func (s *RelationSetManySuite) TestInitUnexpectedArgs(c *gc.C) {
+	hctx, _ := s.newHookContext()
+	code, ctx := s.run(c, hctx, "", "foo=bar")
+	c.Assert(code, gc.Equals, 2)
+	c.Check(bufferString(ctx.Stderr), gc.Matches, `(.|\n)*ERROR unrecognized args: \["foo=bar"\]\n`)
+}
+
+func (s *RelationSetManySuite) TestSetMany(c *gc.C) {
+	hctx, info := s.newHookContext()
+	info.IsLeader = true
+
+	code, ctx := s.run(c, hctx, `
+peer0:0:
+  unit:
+    host: 10.0.0.1
+    private-address: ""
+  app:
+    endpoint: new
+"1":
+  unit:
+    port: "80"
+`[1:])
+	c.Assert(code, gc.Equals, 0, gc.Commentf("stderr: %s", bufferString(ctx.Stderr)))
+	c.Check(info.rels[0].Units["u/0"], jc.DeepEquals, jujuctesting.Settings{"host": "10.0.0.1"})
+	c.Check(info.rels[0].LocalApplicationSettings, jc.DeepEquals, jujuctesting.Settings{"endpoint": "new"})
+	c.Check(info.rels[1].Units["u/0"], jc.DeepEquals, jujuctesting.Settings{
+		"private-address": "u-0.testing.invalid",
+		"port":            "80",
+	})
+	c.Check(info.rels[1].LocalApplicationSettings, gc.HasLen, 0)
+}
+
+func (s *RelationSetManySuite) TestSetManyJSONFile(c *gc.C) {
+	hctx, info := s.newHookContext()
+	path := filepath.Join(c.MkDir(), "settings.json")
+	err := os.WriteFile(path, []byte(`{"peer1:1": {"unit": {"port": "443"}}}`), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	code, ctx := s.run(c, hctx, "", "--file", path)
+	c.Assert(code, gc.Equals, 0, gc.Commentf("stderr: %s", bufferString(ctx.Stderr)))
+	c.Check(info.rels[1].Units["u/0"]["port"], gc.Equals, "443")
+}
+
+func (s *RelationSetManySuite) TestConflictsWriteNothing(c *gc.C) {
+	hctx, info := s.newHookContext()
+
+	content := `
+peer0:0:
+  unit:
+    host: 10.0.0.1
+"0":
+  unit:
+    host: 10.0.0.2
+  app:
+    endpoint: new
+db:7:
+  unit:
+    host: 10.0.0.3
+bad:
+  unit:
+    host: 10.0.0.4
+peer1:1:
+  unit:
+    port: "` + strings.Repeat("x", 1024*1024+1) + `"
+`
+	code, ctx := s.run(c, hctx, content[1:])
+	c.Assert(code, gc.Equals, 1)
+	c.Check(strings.Contains(bufferString(ctx.Stderr), `
+ERROR no relation settings written:
+  0: cannot write application settings, unit is not the leader
+  bad: invalid relation id
+  db:7: relation not found
+  peer0:0: relation peer0:0 also given as "0"
+  peer1:1: unit setting "port": max allowed value length (1048576) exceeded
+`[1:]), jc.IsTrue, gc.Commentf("stderr: %s", bufferString(ctx.Stderr)))
+	c.Check(info.rels[0].Units["u/0"], jc.DeepEquals, jujuctesting.Settings{"private-address": "u-0.testing.invalid"})
+	c.Check(info.rels[0].LocalApplicationSettings, jc.DeepEquals, jujuctesting.Settings{"endpoint": "old"})
+	c.Check(info.rels[1].Units["u/0"], jc.DeepEquals, jujuctesting.Settings{"private-address": "u-0.testing.invalid"})
+}
+
+func (s *RelationSetManySuite) TestInvalidDocument(c *gc.C) {
+	hctx, _ := s.newHookContext()
+
+	code, ctx := s.run(c, hctx, `
+peer0:0:
+  units:
+    host: 10.0.0.1
+`[1:])
+	c.Assert(code, gc.Equals, 1)
+	c.Check(bufferString(ctx.Stderr), gc.Matches, `(.|\n)*ERROR cannot read relation settings: (.|\n)*field units not found(.|\n)*`)
+}
@@ -193,12 +193,6 @@ func (c *RelationSetCommand) Run(ctx *cmd.Context) (err error) {
 			return errors.Annotate(err, "cannot read relation settings")
 		}
 	}
-	for k, v := range c.Settings {
-		if v != "" {
-			settings.Set(k, v)
-		} else {
-			settings.Delete(k)
-		}
-	}
+	applySettings(settings, c.Settings)
 	return nil
 }
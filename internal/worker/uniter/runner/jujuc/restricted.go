@@ -237,3 +237,18 @@ func (c *RestrictedContext) GrantSecret(*secrets.URI, *SecretGrantRevokeArgs) er
 func (c *RestrictedContext) RevokeSecret(*secrets.URI, *SecretGrantRevokeArgs) error {
 	return ErrRestrictedContext
 }
+
+// SetSchedule implements runner.Context.
+func (*RestrictedContext) SetSchedule(charm.Schedule) error {
+	return ErrRestrictedContext
+}
+
+// RemoveSchedule implements runner.Context.
+func (*RestrictedContext) RemoveSchedule(string) error {
+	return ErrRestrictedContext
+}
+
+// DeferHook implements runner.Context.
+func (*RestrictedContext) DeferHook(HookDeferral) error {
+	return ErrRestrictedContext
+}
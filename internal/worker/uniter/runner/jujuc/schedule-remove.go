@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"github.com/juju/errors"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+type scheduleRemoveCommand struct {
+	cmd.CommandBase
+	ctx Context
+
+	name string
+}
+
+// NewScheduleRemoveCommand returns a command to remove a schedule.
+func NewScheduleRemoveCommand(ctx Context) (cmd.Command, error) {
+	return &scheduleRemoveCommand{ctx: ctx}, nil
+}
+
+// Info implements cmd.Command.
+func (c *scheduleRemoveCommand) Info() *cmd.Info {
+	doc := `
+Remove a schedule registered by the charm with schedule-set, so that its
+schedule-<name> hook is no longer run. Schedules declared in the charm
+metadata cannot be removed.
+
+The schedule is removed when the hook completes successfully.
+`
+	examples := `
+    schedule-remove backup
+`
+	return jujucmd.Info(&cmd.Info{
+		Name:     "schedule-remove",
+		Args:     "<name>",
+		Purpose:  "Remove a schedule registered by the charm.",
+		Doc:      doc,
+		Examples: examples,
+		SeeAlso:  []string{"schedule-set"},
+	})
+}
+
+// Init implements cmd.Command.
+func (c *scheduleRemoveCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing schedule name")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *scheduleRemoveCommand) Run(_ *cmd.Context) error {
+	return c.ctx.RemoveSchedule(c.name)
+}
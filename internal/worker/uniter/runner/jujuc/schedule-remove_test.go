@@ -0,0 +1,70 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+)
+
+type ScheduleRemoveSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&ScheduleRemoveSuite{})
+
+func (s *ScheduleRemoveSuite) TestRemoveScheduleInvalidArgs(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{},
+			err:  "ERROR missing schedule name",
+		}, {
+			args: []string{"backup", "extra"},
+			err:  `ERROR unrecognized args: ["extra"]`,
+		},
+	} {
+		com, err := jujuc.NewCommand(hctx, "schedule-remove")
+		c.Assert(err, jc.ErrorIsNil)
+		ctx := cmdtesting.Context(c)
+		code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, t.args)
+
+		c.Check(code, gc.Equals, 2)
+		c.Check(bufferString(ctx.Stderr), gc.Equals, t.err+"\n")
+	}
+}
+
+func (s *ScheduleRemoveSuite) TestRemoveSchedule(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "schedule-remove")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{"backup"})
+
+	c.Assert(code, gc.Equals, 0)
+	s.Stub.CheckCall(c, 0, "RemoveSchedule", "backup")
+}
+
+func (s *ScheduleRemoveSuite) TestRemoveScheduleNotFound(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+	s.Stub.SetErrors(errors.NotFoundf(`schedule "backup"`))
+
+	com, err := jujuc.NewCommand(hctx, "schedule-remove")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{"backup"})
+
+	c.Assert(code, gc.Equals, 1)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "ERROR schedule \"backup\" not found\n")
+}
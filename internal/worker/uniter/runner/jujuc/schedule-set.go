@@ -0,0 +1,96 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/cmd"
+)
+
+type scheduleSetCommand struct {
+	cmd.CommandBase
+	ctx Context
+
+	schedule charm.Schedule
+	interval string
+	jitter   string
+	catchUp  string
+}
+
+// NewScheduleSetCommand returns a command to register a schedule.
+func NewScheduleSetCommand(ctx Context) (cmd.Command, error) {
+	return &scheduleSetCommand{ctx: ctx}, nil
+}
+
+// Info implements cmd.Command.
+func (c *scheduleSetCommand) Info() *cmd.Info {
+	doc := `
+Register a schedule on which the schedule-<name> hook is run, or replace a
+schedule previously registered by the charm. Schedules declared in the charm
+metadata cannot be replaced.
+
+A schedule is either a cron expression, evaluated in UTC, or an interval of
+at least a minute. A random delay of up to the jitter is added to each run.
+The catch-up policy defines what happens to runs missed while the unit agent
+was not running: "skip" waits until the schedule is next due, "once" runs the
+hook once as soon as the agent starts.
+
+The schedule is registered when the hook completes successfully.
+`
+	examples := `
+    schedule-set backup --cron "0 3 * * *" --jitter 15m
+    schedule-set refresh --interval 6h --catch-up once
+`
+	return jujucmd.Info(&cmd.Info{
+		Name:     "schedule-set",
+		Args:     "<name>",
+		Purpose:  "Register a schedule for the schedule-<name> hook.",
+		Doc:      doc,
+		Examples: examples,
+		SeeAlso:  []string{"schedule-remove"},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *scheduleSetCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.schedule.Cron, "cron", "", "a cron expression on which the hook is run")
+	f.StringVar(&c.interval, "interval", "", "the time between runs of the hook")
+	f.StringVar(&c.jitter, "jitter", "", "the maximum random delay added to each run")
+	f.StringVar(&c.catchUp, "catch-up", string(charm.CatchUpSkip), `what to do with runs missed while the agent was down, either "skip" or "once"`)
+}
+
+// Init implements cmd.Command.
+func (c *scheduleSetCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing schedule name")
+	}
+	c.schedule.Name = args[0]
+	var err error
+	if c.interval != "" {
+		if c.schedule.Interval, err = time.ParseDuration(c.interval); err != nil {
+			return errors.NotValidf("interval %q", c.interval)
+		}
+	}
+	if c.jitter != "" {
+		if c.schedule.Jitter, err = time.ParseDuration(c.jitter); err != nil {
+			return errors.NotValidf("jitter %q", c.jitter)
+		}
+	}
+	c.schedule.CatchUp = charm.CatchUpPolicy(c.catchUp)
+	if err := c.schedule.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *scheduleSetCommand) Run(_ *cmd.Context) error {
+	return c.ctx.SetSchedule(c.schedule)
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+)
+
+type ScheduleSetSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&ScheduleSetSuite{})
+
+func (s *ScheduleSetSuite) TestSetScheduleInvalidArgs(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{
+		{
+			args: []string{},
+			err:  "ERROR missing schedule name",
+		}, {
+			args: []string{"Backup", "--interval", "1h"},
+			err:  `ERROR schedule name "Backup" not valid`,
+		}, {
+			args: []string{"backup"},
+			err:  `ERROR schedule "backup" without cron or interval not valid`,
+		}, {
+			args: []string{"backup", "--interval", "1h", "--cron", "@daily"},
+			err:  `ERROR schedule "backup" with both cron and interval not valid`,
+		}, {
+			args: []string{"backup", "--interval", "soon"},
+			err:  `ERROR interval "soon" not valid`,
+		}, {
+			args: []string{"backup", "--interval", "1h", "--jitter", "a bit"},
+			err:  `ERROR jitter "a bit" not valid`,
+		}, {
+			args: []string{"backup", "--interval", "1h", "--catch-up", "all"},
+			err:  `ERROR schedule "backup" catch-up policy "all" not valid`,
+		}, {
+			args: []string{"backup", "--interval", "1h", "extra"},
+			err:  `ERROR unrecognized args: ["extra"]`,
+		},
+	} {
+		com, err := jujuc.NewCommand(hctx, "schedule-set")
+		c.Assert(err, jc.ErrorIsNil)
+		ctx := cmdtesting.Context(c)
+		code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, t.args)
+
+		c.Check(code, gc.Equals, 2)
+		c.Check(bufferString(ctx.Stderr), gc.Equals, t.err+"\n")
+	}
+}
+
+func (s *ScheduleSetSuite) TestSetScheduleCron(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "schedule-set")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"backup", "--cron", "0 3 * * *", "--jitter", "15m",
+	})
+
+	c.Assert(code, gc.Equals, 0)
+	s.Stub.CheckCall(c, 0, "SetSchedule", charm.Schedule{
+		Name:    "backup",
+		Cron:    "0 3 * * *",
+		Jitter:  15 * time.Minute,
+		CatchUp: charm.CatchUpSkip,
+	})
+}
+
+func (s *ScheduleSetSuite) TestSetScheduleInterval(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "schedule-set")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"refresh", "--interval", "6h", "--catch-up", "once",
+	})
+
+	c.Assert(code, gc.Equals, 0)
+	s.Stub.CheckCall(c, 0, "SetSchedule", charm.Schedule{
+		Name:     "refresh",
+		Interval: 6 * time.Hour,
+		CatchUp:  charm.CatchUpOnce,
+	})
+}
+
+func (s *ScheduleSetSuite) TestSetScheduleError(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+	s.Stub.SetErrors(errors.NotValidf(`replacing schedule "backup" declared in charm metadata`))
+
+	com, err := jujuc.NewCommand(hctx, "schedule-set")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"backup", "--interval", "1h",
+	})
+
+	c.Assert(code, gc.Equals, 1)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "ERROR replacing schedule \"backup\" declared in charm metadata not valid\n")
+}
@@ -34,12 +34,14 @@ type secretUpsertCommand struct {
 
 type secretAddCommand struct {
 	secretUpsertCommand
+
+	secretType string
 }
 
 // NewSecretAddCommand returns a command to add a secret.
 func NewSecretAddCommand(ctx Context) (cmd.Command, error) {
 	return &secretAddCommand{
-		secretUpsertCommand{ctx: ctx},
+		secretUpsertCommand: secretUpsertCommand{ctx: ctx},
 	}, nil
 }
 
@@ -54,6 +56,17 @@ prior to being stored.
 
 If a key has the '#file' suffix, the value is read from the corresponding file.
 
+The --type option declares the structure of the secret content, which
+is validated when the secret is created and each time it is updated.
+Supported types are:
+    generic:         any keys (the default)
+    tls:             certificate and private-key, with optional ca;
+                     the certificate must match the key and not be expired,
+                     and the secret expires when the certificate does
+    password:        password, with optional username
+    ssh-key:         private-key, with optional matching public-key
+    docker-registry: registry, username and password
+
 By default, a secret is owned by the application, meaning only the unit
 leader can manage it. Use "--owner unit" to create a secret owned by the
 specific unit which created it.
@@ -72,6 +85,9 @@ specific unit which created it.
     secret-add --label db-password \
         --description "my database password" \
         --file=/path/to/file
+    secret-add --type password username=admin password=s3cret
+    secret-add --type tls \
+        certificate#file=/path/to/cert.pem private-key#file=/path/to/key.pem
 `
 	return jujucmd.Info(&cmd.Info{
 		Name:     "secret-add",
@@ -159,11 +175,20 @@ func (c *secretUpsertCommand) marshallArg() *SecretUpdateArgs {
 	return arg
 }
 
+// SetFlags implements cmd.Command.
+func (c *secretAddCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.secretUpsertCommand.SetFlags(f)
+	f.StringVar(&c.secretType, "type", "", "the secret type, one of generic, tls, password, ssh-key or docker-registry")
+}
+
 // Init implements cmd.Command.
 func (c *secretAddCommand) Init(args []string) error {
 	if len(args) < 1 && c.fileName == "" {
 		return errors.New("missing secret value or filename")
 	}
+	if c.secretType != "" && !secrets.SecretType(c.secretType).IsValid() {
+		return errors.NotValidf("secret type %q", c.secretType)
+	}
 	return c.secretUpsertCommand.Init(args)
 }
 
@@ -184,6 +209,7 @@ func (c *secretAddCommand) Run(ctx *cmd.Context) error {
 	arg := &SecretCreateArgs{
 		SecretUpdateArgs: *updateArgs,
 		Owner:            owner,
+		Type:             secrets.SecretType(c.secretType),
 	}
 	uri, err := c.ctx.CreateSecret(ctx, arg)
 	if err != nil {
@@ -49,6 +49,9 @@ func (s *SecretAddSuite) TestAddSecretInvalidArgs(c *gc.C) {
 		}, {
 			args: []string{"foo=bar", "--expire", "2022-01-01"},
 			err:  `ERROR expire time or duration "2022-01-01" not valid`,
+		}, {
+			args: []string{"foo=bar", "--type", "foo"},
+			err:  `ERROR secret type "foo" not valid`,
 		},
 	} {
 		com, err := jujuc.NewCommand(hctx, "secret-add")
@@ -155,6 +158,27 @@ func (s *SecretAddSuite) TestAddSecretBase64(c *gc.C) {
 	c.Assert(bufferString(ctx.Stdout), gc.Equals, "secret:9m4e2mr0ui3e8a215n4g\n")
 }
 
+func (s *SecretAddSuite) TestAddSecretTyped(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "secret-add")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{"--type", "password", "password=s3cret"})
+
+	c.Assert(code, gc.Equals, 0)
+	val := coresecrets.NewSecretValue(map[string]string{"password": "czNjcmV0"})
+	args := &jujuc.SecretCreateArgs{
+		SecretUpdateArgs: jujuc.SecretUpdateArgs{
+			Value: val,
+		},
+		Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "u"},
+		Type:  coresecrets.TypePassword,
+	}
+	s.Stub.CheckCalls(c, []testing.StubCall{{FuncName: "UnitName"}, {FuncName: "CreateSecret", Args: []interface{}{args}}})
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, "secret:9m4e2mr0ui3e8a215n4g\n")
+}
+
 func (s *SecretAddSuite) TestAddSecretFromFile(c *gc.C) {
 	data := `
     key: |-
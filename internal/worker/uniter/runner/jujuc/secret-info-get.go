@@ -19,8 +19,9 @@ type secretInfoGetCommand struct {
 	ctx Context
 	out cmd.Output
 
-	secretUri *secrets.URI
-	label     string
+	secretUri  *secrets.URI
+	label      string
+	secretType string
 }
 
 // NewSecretInfoGetCommand returns a command to get secret metadata.
@@ -33,10 +34,13 @@ func (c *secretInfoGetCommand) Info() *cmd.Info {
 	doc := `
 Get the metadata of a secret with a given secret ID.
 Either the ID or label can be used to identify the secret.
+Alternatively, use --type to get the metadata of all the
+secrets owned by the unit or application with the given type.
 `
 	examples := `
     secret-info-get secret:9m4e2mr0ui3e8a215n4g
     secret-info-get --label db-password
+    secret-info-get --type tls
 `
 	return jujucmd.Info(&cmd.Info{
 		Name:     "secret-info-get",
@@ -54,6 +58,7 @@ func (c *secretInfoGetCommand) SetFlags(f *gnuflag.FlagSet) {
 		"json": cmd.FormatJson,
 	})
 	f.StringVar(&c.label, "label", "", "a label used to identify the secret")
+	f.StringVar(&c.secretType, "type", "", "get all secrets of the given type")
 }
 
 // Init implements cmd.Command.
@@ -66,6 +71,15 @@ func (c *secretInfoGetCommand) Init(args []string) (err error) {
 		args = args[1:]
 	}
 
+	if c.secretType != "" {
+		if c.secretUri != nil || c.label != "" {
+			return errors.New("--type cannot be used with a secret URI or label")
+		}
+		if !secrets.SecretType(c.secretType).IsValid() {
+			return errors.NotValidf("secret type %q", c.secretType)
+		}
+		return cmd.CheckEmpty(args)
+	}
 	if c.secretUri == nil && c.label == "" {
 		return errors.New("require either a secret URI or label")
 	}
@@ -77,6 +91,7 @@ func (c *secretInfoGetCommand) Init(args []string) (err error) {
 
 type metadataDisplay struct {
 	LatestRevision   int                  `yaml:"revision" json:"revision"`
+	Type             secrets.SecretType   `yaml:"type,omitempty" json:"type,omitempty"`
 	Label            string               `yaml:"label" json:"label"`
 	Owner            string               `yaml:"owner" json:"owner"`
 	Description      string               `yaml:"description,omitempty" json:"description,omitempty"`
@@ -111,18 +126,31 @@ func (c *secretInfoGetCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	display := func(md SecretMetadata) metadataDisplay {
+		return metadataDisplay{
+			LatestRevision:   md.LatestRevision,
+			Type:             md.Type,
+			Label:            md.Label,
+			Owner:            string(md.Owner.Kind),
+			Description:      md.Description,
+			RotatePolicy:     md.RotatePolicy,
+			LatestExpireTime: md.LatestExpireTime,
+			NextRotateTime:   md.NextRotateTime,
+			Access:           toAccessInfo(md.Access),
+		}
+	}
 	print := func(id string, md SecretMetadata) error {
-		return c.out.Write(ctx, map[string]metadataDisplay{
-			id: {
-				LatestRevision:   md.LatestRevision,
-				Label:            md.Label,
-				Owner:            string(md.Owner.Kind),
-				Description:      md.Description,
-				RotatePolicy:     md.RotatePolicy,
-				LatestExpireTime: md.LatestExpireTime,
-				NextRotateTime:   md.NextRotateTime,
-				Access:           toAccessInfo(md.Access),
-			}})
+		return c.out.Write(ctx, map[string]metadataDisplay{id: display(md)})
+	}
+	if c.secretType != "" {
+		want := secrets.SecretType(c.secretType)
+		result := make(map[string]metadataDisplay)
+		for id, md := range all {
+			if md.Type.String() == want.String() {
+				result[id] = display(md)
+			}
+		}
+		return c.out.Write(ctx, result)
 	}
 	var want string
 	if c.secretUri != nil {
@@ -30,6 +30,12 @@ func (s *SecretInfoGetSuite) TestSecretGetInit(c *gc.C) {
 	}, {
 		args: []string{"secret:9m4e2mr0ui3e8a215n4g", "--label", "foo"},
 		err:  "ERROR specify either a secret URI or label but not both",
+	}, {
+		args: []string{"--type", "tls", "--label", "foo"},
+		err:  "ERROR --type cannot be used with a secret URI or label",
+	}, {
+		args: []string{"--type", "foo"},
+		err:  `ERROR secret type "foo" not valid`,
 	}} {
 		hctx, _ := s.ContextSuite.NewHookContext()
 		com, err := jujuc.NewCommand(hctx, "secret-info-get")
@@ -137,3 +143,36 @@ func (s *SecretInfoGetSuite) TestSecretInfoGetByLabel(c *gc.C) {
   rotation: hourly
 `[1:])
 }
+
+func (s *SecretInfoGetSuite) TestSecretInfoGetByType(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "secret-info-get")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{"--type", "generic"})
+	c.Assert(code, gc.Equals, 0)
+
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, `
+9m4e2mr0ui3e8a215n4g:
+  revision: 666
+  label: label
+  owner: application
+  description: description
+  rotation: hourly
+`[1:])
+}
+
+func (s *SecretInfoGetSuite) TestSecretInfoGetByTypeNoneFound(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "secret-info-get")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{"--type", "tls"})
+	c.Assert(code, gc.Equals, 0)
+
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, "{}\n")
+}
@@ -4,7 +4,10 @@
 package jujuc
 
 import (
+	"time"
+
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/core/secrets"
@@ -14,7 +17,11 @@ import (
 type secretUpdateCommand struct {
 	secretUpsertCommand
 
-	secretURI *secrets.URI
+	secretURI         *secrets.URI
+	staged            bool
+	stageTimeout      time.Duration
+	rollbackOnTimeout bool
+	rollbackTo        int
 }
 
 // NewSecretSetCommand returns a command to create a secret.
@@ -32,6 +39,26 @@ If a value has the '#base64' suffix, it is already in base64 format and no
 encoding will be performed, otherwise the value will be base64 encoded
 prior to being stored.
 To just update selected metadata like rotate policy, do not specify any secret value.
+
+Use --staged to rotate the secret content in stages. The new revision is
+created as usual and consumers are notified, but the previous revision is
+kept until all consumers have started tracking the new revision. The
+rotation is then committed and the previous revision can be removed. If
+consumers have not all started tracking the new revision before the
+--stage-timeout elapses, the rotation expires and the previous revision is
+no longer held back. With --rollback-on-timeout, the rotation is instead
+rolled back: the previous content is restored as a new latest revision,
+and consumers are notified again. Either way, the progress of the rotation
+is shown by 'juju show-secret --revisions', and the owner is told to
+remove whichever revision is no longer needed with the secret-remove hook. While a rotation is staged, the secret content
+cannot be updated again.
+
+Use --rollback-to to restore an earlier revision of the secret as its
+latest revision, for example to revert a bad rotation. The revision must
+still be retained, ie not yet obsolete. The restored content is given a new
+revision number and consumers are notified as for any new revision.
+The replaced revision can then be removed with the secret-remove hook.
+--rollback-to cannot be combined with a new secret value.
 `
 	examples := `
     secret-set secret:9m4e2mr0ui3e8a215n4g token=34ae35facd4
@@ -48,6 +75,10 @@ To just update selected metadata like rotate policy, do not specify any secret v
     secret-set secret:9m4e2mr0ui3e8a215n4g --label db-password \
         --description "my database password" \
         --file=/path/to/file
+    secret-set secret:9m4e2mr0ui3e8a215n4g --staged password=n3wpass
+    secret-set secret:9m4e2mr0ui3e8a215n4g --staged --stage-timeout 30m password=n3wpass
+    secret-set secret:9m4e2mr0ui3e8a215n4g --staged --rollback-on-timeout password=n3wpass
+    secret-set secret:9m4e2mr0ui3e8a215n4g --rollback-to 3
 `
 	return jujucmd.Info(&cmd.Info{
 		Name:     "secret-set",
@@ -58,6 +89,15 @@ To just update selected metadata like rotate policy, do not specify any secret v
 	})
 }
 
+// SetFlags implements cmd.Command.
+func (c *secretUpdateCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.secretUpsertCommand.SetFlags(f)
+	f.BoolVar(&c.staged, "staged", false, "keep the previous revision until all consumers track the new one")
+	f.DurationVar(&c.stageTimeout, "stage-timeout", secrets.DefaultStageTimeout, "how long consumers have to track a staged revision before the rotation expires")
+	f.BoolVar(&c.rollbackOnTimeout, "rollback-on-timeout", false, "restore the previous revision if a staged rotation expires")
+	f.IntVar(&c.rollbackTo, "rollback-to", 0, "restore the specified revision as the latest revision")
+}
+
 // Init implements cmd.Command.
 func (c *secretUpdateCommand) Init(args []string) error {
 	if len(args) < 1 {
@@ -67,10 +107,44 @@ func (c *secretUpdateCommand) Init(args []string) error {
 	if c.secretURI, err = secrets.ParseURI(args[0]); err != nil {
 		return errors.Trace(err)
 	}
-	return c.secretUpsertCommand.Init(args[1:])
+	if err := c.secretUpsertCommand.Init(args[1:]); err != nil {
+		return errors.Trace(err)
+	}
+	if c.rollbackTo != 0 {
+		if c.rollbackTo < 0 {
+			return errors.NotValidf("rollback revision %d", c.rollbackTo)
+		}
+		if len(c.data) > 0 || c.staged {
+			return errors.New("--rollback-to cannot be used with a new secret value")
+		}
+	}
+	if !c.staged {
+		if c.stageTimeout != secrets.DefaultStageTimeout {
+			return errors.New("--stage-timeout requires --staged")
+		}
+		if c.rollbackOnTimeout {
+			return errors.New("--rollback-on-timeout requires --staged")
+		}
+		return nil
+	}
+	if len(c.data) == 0 {
+		return errors.New("--staged requires a new secret value")
+	}
+	if c.stageTimeout <= 0 {
+		return errors.NotValidf("stage timeout %v", c.stageTimeout)
+	}
+	return nil
 }
 
 // Run implements cmd.Command.
 func (c *secretUpdateCommand) Run(ctx *cmd.Context) error {
-	return c.ctx.UpdateSecret(c.secretURI, c.marshallArg())
+	arg := c.marshallArg()
+	if c.staged {
+		arg.StageTimeout = &c.stageTimeout
+		arg.RollbackOnTimeout = c.rollbackOnTimeout
+	}
+	if c.rollbackTo > 0 {
+		arg.RollbackRevision = &c.rollbackTo
+	}
+	return c.ctx.UpdateSecret(c.secretURI, arg)
 }
@@ -49,6 +49,24 @@ func (s *SecretUpdateSuite) TestUpdateSecretInvalidArgs(c *gc.C) {
 		}, {
 			args: []string{"secret:9m4e2mr0ui3e8a215n4g", "foo=bar", "--expire", "2022-01-01"},
 			err:  `ERROR expire time or duration "2022-01-01" not valid`,
+		}, {
+			args: []string{"secret:9m4e2mr0ui3e8a215n4g", "--staged", "--label", "foo"},
+			err:  `ERROR --staged requires a new secret value`,
+		}, {
+			args: []string{"secret:9m4e2mr0ui3e8a215n4g", "foo=bar", "--stage-timeout", "30m"},
+			err:  `ERROR --stage-timeout requires --staged`,
+		}, {
+			args: []string{"secret:9m4e2mr0ui3e8a215n4g", "foo=bar", "--staged", "--stage-timeout=-30m"},
+			err:  `ERROR stage timeout -30m0s not valid`,
+		}, {
+			args: []string{"secret:9m4e2mr0ui3e8a215n4g", "foo=bar", "--rollback-on-timeout"},
+			err:  `ERROR --rollback-on-timeout requires --staged`,
+		}, {
+			args: []string{"secret:9m4e2mr0ui3e8a215n4g", "foo=bar", "--rollback-to", "3"},
+			err:  `ERROR --rollback-to cannot be used with a new secret value`,
+		}, {
+			args: []string{"secret:9m4e2mr0ui3e8a215n4g", "--rollback-to=-1"},
+			err:  `ERROR rollback revision -1 not valid`,
 		},
 	} {
 		com, err := jujuc.NewCommand(hctx, "secret-set")
@@ -95,6 +113,44 @@ func (s *SecretUpdateSuite) TestUpdateSecret(c *gc.C) {
 	c.Assert(args, jc.DeepEquals, expectedArgs)
 }
 
+func (s *SecretUpdateSuite) TestUpdateSecretStaged(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "secret-set")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"secret:9m4e2mr0ui3e8a215n4g", "password=secret", "--staged", "--stage-timeout", "30m", "--rollback-on-timeout",
+	})
+
+	c.Assert(code, gc.Equals, 0)
+	val := coresecrets.NewSecretValue(map[string]string{"password": "c2VjcmV0"})
+	args := &jujuc.SecretUpdateArgs{
+		Value:             val,
+		StageTimeout:      ptr(30 * time.Minute),
+		RollbackOnTimeout: true,
+	}
+	s.Stub.CheckCalls(c, []testing.StubCall{{FuncName: "UpdateSecret", Args: []interface{}{"secret:9m4e2mr0ui3e8a215n4g", args}}})
+}
+
+func (s *SecretUpdateSuite) TestUpdateSecretRollback(c *gc.C) {
+	hctx, _ := s.ContextSuite.NewHookContext()
+
+	com, err := jujuc.NewCommand(hctx, "secret-set")
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	code := cmd.Main(jujuc.NewJujucCommandWrappedForTest(com), ctx, []string{
+		"secret:9m4e2mr0ui3e8a215n4g", "--rollback-to", "3",
+	})
+
+	c.Assert(code, gc.Equals, 0)
+	args := &jujuc.SecretUpdateArgs{
+		Value:            coresecrets.NewSecretValue(nil),
+		RollbackRevision: ptr(3),
+	}
+	s.Stub.CheckCalls(c, []testing.StubCall{{FuncName: "UpdateSecret", Args: []interface{}{"secret:9m4e2mr0ui3e8a215n4g", args}}})
+}
+
 func (s *SecretUpdateSuite) TestUpdateSecretBase64(c *gc.C) {
 	hctx, _ := s.ContextSuite.NewHookContext()
 
@@ -46,6 +46,7 @@ var baseCommands = map[string]creator{
 	"relation-list":           NewRelationListCommand,
 	"relation-model-get":      NewRelationModelGetCommand,
 	"relation-set":            NewRelationSetCommand,
+	"relation-set-many":       NewRelationSetManyCommand,
 	"unit-get":                NewUnitGetCommand,
 	"juju-reboot":             NewJujuRebootCommand,
 	"status-get":              NewStatusGetCommand,
@@ -64,6 +65,13 @@ var baseCommands = map[string]creator{
 	"state-get":    NewStateGetCommand,
 	"state-delete": NewStateDeleteCommand,
 	"state-set":    NewStateSetCommand,
+
+	"schedule-set":    NewScheduleSetCommand,
+	"schedule-remove": NewScheduleRemoveCommand,
+
+	"hook-defer": NewHookDeferCommand,
+
+	"metric-add": NewMetricAddCommand,
 }
 
 var secretCommands = map[string]creator{
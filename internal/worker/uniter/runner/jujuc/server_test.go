@@ -247,7 +247,9 @@ var newCommandTests = []struct {
 }{
 	{"close-port", ""},
 	{"config-get", ""},
+	{"hook-defer", ""},
 	{"juju-log", ""},
+	{"metric-add", ""},
 	{"open-port", ""},
 	{"opened-ports", ""},
 	{"relation-get", ""},
@@ -255,6 +257,9 @@ var newCommandTests = []struct {
 	{"relation-list", ""},
 	{"relation-model-get", ""},
 	{"relation-set", ""},
+	{"relation-set-many", ""},
+	{"schedule-remove", ""},
+	{"schedule-set", ""},
 	{"unit-get", ""},
 	{"storage-add", ""},
 	{"storage-get", ""},
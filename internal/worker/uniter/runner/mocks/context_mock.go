@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	application "github.com/juju/juju/core/application"
 	logger "github.com/juju/juju/core/logger"
@@ -127,6 +128,82 @@ func (c *MockContextActionParamsCall) DoAndReturn(f func() (map[string]any, erro
 	return c
 }
 
+// AddMetric mocks base method.
+func (m *MockContext) AddMetric(arg0, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMetric", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMetric indicates an expected call of AddMetric.
+func (mr *MockContextMockRecorder) AddMetric(arg0, arg1, arg2 any) *MockContextAddMetricCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMetric", reflect.TypeOf((*MockContext)(nil).AddMetric), arg0, arg1, arg2)
+	return &MockContextAddMetricCall{Call: call}
+}
+
+// MockContextAddMetricCall wrap *gomock.Call
+type MockContextAddMetricCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextAddMetricCall) Return(arg0 error) *MockContextAddMetricCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextAddMetricCall) Do(f func(string, string, time.Time) error) *MockContextAddMetricCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextAddMetricCall) DoAndReturn(f func(string, string, time.Time) error) *MockContextAddMetricCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddMetricLabels mocks base method.
+func (m *MockContext) AddMetricLabels(arg0, arg1 string, arg2 time.Time, arg3 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMetricLabels", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMetricLabels indicates an expected call of AddMetricLabels.
+func (mr *MockContextMockRecorder) AddMetricLabels(arg0, arg1, arg2, arg3 any) *MockContextAddMetricLabelsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMetricLabels", reflect.TypeOf((*MockContext)(nil).AddMetricLabels), arg0, arg1, arg2, arg3)
+	return &MockContextAddMetricLabelsCall{Call: call}
+}
+
+// MockContextAddMetricLabelsCall wrap *gomock.Call
+type MockContextAddMetricLabelsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextAddMetricLabelsCall) Return(arg0 error) *MockContextAddMetricLabelsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextAddMetricLabelsCall) Do(f func(string, string, time.Time, map[string]string) error) *MockContextAddMetricLabelsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextAddMetricLabelsCall) DoAndReturn(f func(string, string, time.Time, map[string]string) error) *MockContextAddMetricLabelsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddUnitStorage mocks base method.
 func (m *MockContext) AddUnitStorage(arg0 map[string]params.StorageDirectives) error {
 	m.ctrl.T.Helper()
@@ -398,6 +475,44 @@ func (c *MockContextCreateSecretCall) DoAndReturn(f func(context.Context, *jujuc
 	return c
 }
 
+// DeferHook mocks base method.
+func (m *MockContext) DeferHook(arg0 jujuc.HookDeferral) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferHook", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferHook indicates an expected call of DeferHook.
+func (mr *MockContextMockRecorder) DeferHook(arg0 any) *MockContextDeferHookCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferHook", reflect.TypeOf((*MockContext)(nil).DeferHook), arg0)
+	return &MockContextDeferHookCall{Call: call}
+}
+
+// MockContextDeferHookCall wrap *gomock.Call
+type MockContextDeferHookCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextDeferHookCall) Return(arg0 error) *MockContextDeferHookCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextDeferHookCall) Do(f func(jujuc.HookDeferral) error) *MockContextDeferHookCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextDeferHookCall) DoAndReturn(f func(jujuc.HookDeferral) error) *MockContextDeferHookCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteCharmStateValue mocks base method.
 func (m *MockContext) DeleteCharmStateValue(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -783,6 +898,44 @@ func (c *MockContextHasExecutionSetUnitStatusCall) DoAndReturn(f func() bool) *M
 	return c
 }
 
+// HookDeferral mocks base method.
+func (m *MockContext) HookDeferral() *jujuc.HookDeferral {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HookDeferral")
+	ret0, _ := ret[0].(*jujuc.HookDeferral)
+	return ret0
+}
+
+// HookDeferral indicates an expected call of HookDeferral.
+func (mr *MockContextMockRecorder) HookDeferral() *MockContextHookDeferralCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HookDeferral", reflect.TypeOf((*MockContext)(nil).HookDeferral))
+	return &MockContextHookDeferralCall{Call: call}
+}
+
+// MockContextHookDeferralCall wrap *gomock.Call
+type MockContextHookDeferralCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextHookDeferralCall) Return(arg0 *jujuc.HookDeferral) *MockContextHookDeferralCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextHookDeferralCall) Do(f func() *jujuc.HookDeferral) *MockContextHookDeferralCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextHookDeferralCall) DoAndReturn(f func() *jujuc.HookDeferral) *MockContextHookDeferralCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // HookRelation mocks base method.
 func (m *MockContext) HookRelation() (jujuc.ContextRelation, error) {
 	m.ctrl.T.Helper()
@@ -1440,6 +1593,44 @@ func (c *MockContextRemoteUnitNameCall) DoAndReturn(f func() (string, error)) *M
 	return c
 }
 
+// RemoveSchedule mocks base method.
+func (m *MockContext) RemoveSchedule(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSchedule", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSchedule indicates an expected call of RemoveSchedule.
+func (mr *MockContextMockRecorder) RemoveSchedule(arg0 any) *MockContextRemoveScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSchedule", reflect.TypeOf((*MockContext)(nil).RemoveSchedule), arg0)
+	return &MockContextRemoveScheduleCall{Call: call}
+}
+
+// MockContextRemoveScheduleCall wrap *gomock.Call
+type MockContextRemoveScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextRemoveScheduleCall) Return(arg0 error) *MockContextRemoveScheduleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextRemoveScheduleCall) Do(f func(string) error) *MockContextRemoveScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextRemoveScheduleCall) DoAndReturn(f func(string) error) *MockContextRemoveScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveSecret mocks base method.
 func (m *MockContext) RemoveSecret(arg0 *secrets.URI, arg1 *int) error {
 	m.ctrl.T.Helper()
@@ -1817,6 +2008,44 @@ func (c *MockContextSetProcessCall) DoAndReturn(f func(context0.HookProcess)) *M
 	return c
 }
 
+// SetSchedule mocks base method.
+func (m *MockContext) SetSchedule(arg0 charm.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSchedule", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSchedule indicates an expected call of SetSchedule.
+func (mr *MockContextMockRecorder) SetSchedule(arg0 any) *MockContextSetScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSchedule", reflect.TypeOf((*MockContext)(nil).SetSchedule), arg0)
+	return &MockContextSetScheduleCall{Call: call}
+}
+
+// MockContextSetScheduleCall wrap *gomock.Call
+type MockContextSetScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContextSetScheduleCall) Return(arg0 error) *MockContextSetScheduleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContextSetScheduleCall) Do(f func(charm.Schedule) error) *MockContextSetScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContextSetScheduleCall) DoAndReturn(f func(charm.Schedule) error) *MockContextSetScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetUnitStatus mocks base method.
 func (m *MockContext) SetUnitStatus(arg0 context.Context, arg1 jujuc.StatusInfo) error {
 	m.ctrl.T.Helper()
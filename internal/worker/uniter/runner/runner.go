@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 	"unicode/utf8"
@@ -93,6 +94,8 @@ type Option func(*options)
 
 type options struct {
 	executor ExecFunc
+	timeout  time.Duration
+	clock    clock.Clock
 }
 
 // WithExecutor passes a custom executor to the runner.
@@ -102,9 +105,26 @@ func WithExecutor(executor ExecFunc) Option {
 	}
 }
 
+// WithTimeout sets how long a hook can run for before it is killed. A zero
+// timeout means the hook is never killed.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.timeout = timeout
+	}
+}
+
+// WithClock passes a custom clock to the runner, used to time out hooks and
+// to timestamp the snapshots recorded of them.
+func WithClock(clock clock.Clock) Option {
+	return func(o *options) {
+		o.clock = clock
+	}
+}
+
 func newOptions() *options {
 	return &options{
 		executor: execOnMachine,
+		clock:    clock.WallClock,
 	}
 }
 
@@ -118,6 +138,8 @@ func NewRunner(context context.Context, paths context.Paths, options ...Option)
 		context:  context,
 		paths:    paths,
 		executor: opts.executor,
+		timeout:  opts.timeout,
+		clock:    opts.clock,
 	}
 }
 
@@ -164,6 +186,9 @@ type runner struct {
 	paths   context.Paths
 	// executor executes commands on a remote workload pod for CAAS.
 	executor ExecFunc
+	// timeout is how long a hook can run for before it is killed.
+	timeout time.Duration
+	clock   clock.Clock
 }
 
 func (runner *runner) logger() corelogger.Logger {
@@ -311,6 +336,9 @@ func (runner *runner) RunAction(ctx stdcontext.Context, actionName string) (Hook
 	if actions.IsJujuExecAction(actionName) {
 		return InvalidHookHandler, runner.runJujuExecAction(ctx)
 	}
+	if actions.IsJujuReplayHookAction(actionName) {
+		return runner.runReplayHookAction(ctx, actionName)
+	}
 	runner.logger().Debugf(ctx, "running action %q", actionName)
 	return runner.runCharmHookWithLocation(ctx, actionName, "actions")
 }
@@ -328,13 +356,14 @@ func (runner *runner) runCharmHookWithLocation(ctx stdcontext.Context, hookName,
 	defer srv.Close()
 
 	environmenter := context.NewHostEnvironmenter()
-	env, err := runner.context.HookVars(ctx, runner.paths, environmenter)
+	hookVars, err := runner.context.HookVars(ctx, runner.paths, environmenter)
 	if err != nil {
 		return InvalidHookHandler, errors.Trace(err)
 	}
-	env = append(env, "JUJU_DISPATCH_PATH="+charmLocation+"/"+hookName)
+	env := append(hookVars, "JUJU_DISPATCH_PATH="+charmLocation+"/"+hookName)
 
 	defer func() {
+		runner.recordHookSnapshot(ctx, hookName, hookVars, err)
 		err = runner.context.Flush(ctx, hookName, err)
 	}()
 
@@ -364,6 +393,89 @@ func (runner *runner) runCharmHookWithLocation(ctx stdcontext.Context, hookName,
 	return hookHandlerType, runner.runCharmProcessOnLocal(hookScript, hookName, charmDir, env)
 }
 
+// hookReplayer is implemented by contexts which can record snapshots of the
+// hooks they run, and replay them.
+type hookReplayer interface {
+	HookSnapshot() (context.HookSnapshot, bool)
+	StartReplay(snapshot context.HookSnapshot) error
+}
+
+// recordHookSnapshot records a snapshot of the hook that was run, if the
+// context records them, so that the hook can be replayed.
+func (runner *runner) recordHookSnapshot(ctx stdcontext.Context, hookName string, env []string, hookErr error) {
+	replayer, ok := runner.context.(hookReplayer)
+	if !ok {
+		return
+	}
+	snapshot, ok := replayer.HookSnapshot()
+	if !ok {
+		return
+	}
+	snapshot.Hook = hookName
+	snapshot.Env = env
+	snapshot.Recorded = runner.clock.Now()
+	if hookErr != nil {
+		snapshot.Error = hookErr.Error()
+	}
+	id, err := context.WriteHookSnapshot(runner.paths.GetHookSnapshotsDir(), snapshot)
+	if err != nil {
+		runner.logger().Warningf(ctx, "cannot record snapshot of hook %q: %v", hookName, err)
+		return
+	}
+	runner.logger().Infof(ctx, "recorded snapshot %q of hook %q", id, hookName)
+}
+
+// runReplayHookAction is the function that executes when a juju-replay-hook
+// action is run. It replays the hook recorded in the snapshot given by the
+// action's parameters, or lists the recorded snapshots if none is given.
+func (runner *runner) runReplayHookAction(ctx stdcontext.Context, actionName string) (HookHandlerType, error) {
+	data, err := runner.context.ActionData()
+	if err != nil {
+		return InvalidHookHandler, errors.Trace(err)
+	}
+	replayer, ok := runner.context.(hookReplayer)
+	if !ok {
+		return InvalidHookHandler, runner.context.Flush(ctx, actionName, errors.NotSupportedf("replaying hooks"))
+	}
+
+	dir := runner.paths.GetHookSnapshotsDir()
+	id, _ := data.Params["snapshot"].(string)
+	if id == "" {
+		return InvalidHookHandler, runner.context.Flush(ctx, actionName, runner.listHookSnapshots(dir))
+	}
+	snapshot, err := context.ReadHookSnapshot(dir, id)
+	if err == nil {
+		err = replayer.StartReplay(snapshot)
+	}
+	if err != nil {
+		return InvalidHookHandler, runner.context.Flush(ctx, actionName, errors.Annotatef(err, "cannot replay hook snapshot %q", id))
+	}
+	runner.logger().Infof(ctx, "replaying hook %q from snapshot %q", snapshot.Hook, id)
+	return runner.runCharmHookWithLocation(ctx, snapshot.Hook, "hooks")
+}
+
+// listHookSnapshots reports the hook snapshots recorded by the unit in the
+// action's results.
+func (runner *runner) listHookSnapshots(dir string) error {
+	snapshots, err := context.ListHookSnapshots(dir)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(snapshots) == 0 {
+		return runner.context.SetActionMessage("no hook snapshots recorded, they are recorded when the hook-snapshots model config is true")
+	}
+	for _, snapshot := range snapshots {
+		summary := fmt.Sprintf("%s at %s", snapshot.Hook, snapshot.Recorded.UTC().Format(time.RFC3339))
+		if snapshot.Error != "" {
+			summary += " failed: " + snapshot.Error
+		}
+		if err := runner.context.UpdateActionResults([]string{"snapshots", snapshot.ID}, summary); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 // loggerAdaptor implements MessageReceiver and
 // sends messages to a logger.
 type loggerAdaptor struct {
@@ -419,13 +531,33 @@ func (b *bufferAdaptor) Bytes() []byte {
 const (
 	// ErrTerminated indicate the hook or action exited due to a SIGTERM or SIGKILL signal.
 	ErrTerminated = errors.ConstError("terminated")
+
+	// hookKillGracePeriod is how long a hook that has timed out is given to
+	// exit after it is sent a SIGTERM, before it is sent a SIGKILL.
+	hookKillGracePeriod = 10 * time.Second
 )
 
+// TimeoutError is returned when a hook is killed because it ran for longer
+// than its timeout.
+type TimeoutError struct {
+	// Timeout is how long the hook was allowed to run for.
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %v", e.Timeout)
+}
+
 // Check still tested
 func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, env []string) error {
 	ps := exec.Command(hook)
 	ps.Env = env
 	ps.Dir = charmDir
+	if runner.timeout > 0 {
+		// Run the hook in its own process group, so that any processes it
+		// starts are killed along with it if it times out.
+		ps.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	}
 	outReader, outWriter, err := os.Pipe()
 	if err != nil {
 		return errors.Errorf("cannot make logging pipe: %v", err)
@@ -467,13 +599,22 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 
 	err = ps.Start()
 	var exitErr error
+	var timedOut atomic.Bool
 	if err == nil {
 		done := make(chan struct{})
-		if cancel != nil {
+		var expired <-chan time.Time
+		if runner.timeout > 0 {
+			expired = runner.clock.After(runner.timeout)
+		}
+		if cancel != nil || expired != nil {
 			go func() {
 				select {
 				case <-cancel:
 					_ = ps.Process.Kill()
+				case <-expired:
+					timedOut.Store(true)
+					runner.logger().Warningf(stdcontext.TODO(), "hook %q timed out after %v, killing it", hookName, runner.timeout)
+					runner.killProcessGroup(ps.Process, done)
 				case <-done:
 				}
 			}()
@@ -503,6 +644,9 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 			return errors.Trace(err)
 		}
 	}
+	if timedOut.Load() {
+		return errors.Trace(&TimeoutError{Timeout: runner.timeout})
+	}
 	if exitError, ok := exitErr.(*exec.ExitError); ok && exitError != nil {
 		waitStatus := exitError.ProcessState.Sys().(syscall.WaitStatus)
 		if waitStatus.Signal() == syscall.SIGTERM || waitStatus.Signal() == syscall.SIGKILL {
@@ -513,6 +657,18 @@ func (runner *runner) runCharmProcessOnLocal(hook, hookName, charmDir string, en
 	return errors.Trace(exitErr)
 }
 
+// killProcessGroup terminates the process group of a hook that has timed
+// out. Once the hook has exited, or the grace period has passed, anything left
+// in the process group is killed.
+func (runner *runner) killProcessGroup(process *os.Process, done <-chan struct{}) {
+	_ = syscall.Kill(-process.Pid, syscall.SIGTERM)
+	select {
+	case <-done:
+	case <-runner.clock.After(hookKillGracePeriod):
+	}
+	_ = syscall.Kill(-process.Pid, syscall.SIGKILL)
+}
+
 // discoverHookHandler checks to see if the dispatch script exists, if not,
 // check for the given hookName.  Based on what is discovered, return the
 // HookHandlerType and the actual script to be run.
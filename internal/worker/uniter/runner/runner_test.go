@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/loggo/v2"
 	envtesting "github.com/juju/testing"
@@ -433,6 +434,44 @@ func (s *RunMockContextSuite) TestRunHookFlushFailure(c *gc.C) {
 	s.assertRecordedPid(c, ctx.expectPid)
 }
 
+func (s *RunMockContextSuite) TestRunHookTimeout(c *gc.C) {
+	ctx := &MockContext{}
+	makeCharm(c, hookSpec{
+		dir:   "hooks",
+		name:  hookName,
+		perm:  0700,
+		sleep: "100",
+	}, s.paths.GetCharmDir())
+
+	t0 := time.Now()
+	_, err := runner.NewRunner(ctx, s.paths, runner.WithTimeout(100*time.Millisecond)).RunHook(stdcontext.Background(), "something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(time.Since(t0) < testing.LongWait, jc.IsTrue)
+
+	// The hook is killed, rather than being treated as terminated by the
+	// agent shutting down.
+	c.Assert(ctx.flushBadge, gc.Equals, "something-happened")
+	timeoutErr, ok := errors.AsType[*runner.TimeoutError](ctx.flushFailure)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(timeoutErr.Timeout, gc.Equals, 100*time.Millisecond)
+	c.Check(ctx.flushFailure, gc.ErrorMatches, "timed out after 100ms")
+}
+
+func (s *RunMockContextSuite) TestRunHookWithinTimeout(c *gc.C) {
+	ctx := &MockContext{}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+		code: 123,
+	}, s.paths.GetCharmDir())
+
+	_, err := runner.NewRunner(ctx, s.paths, runner.WithTimeout(testing.LongWait)).RunHook(stdcontext.Background(), "something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.flushFailure, gc.ErrorMatches, "exit status 123")
+	s.assertRecordedPid(c, ctx.expectPid)
+}
+
 func (s *RunHookSuite) TestRunActionDispatchingHookHandler(c *gc.C) {
 	ctx := &MockContext{
 		actionData:    &context.ActionData{},
@@ -595,3 +634,136 @@ func (s *RunMockContextSuite) TestRunCommandsFlushFailure(c *gc.C) {
 	c.Assert(ctx.flushFailure, gc.IsNil) // exit code in _ result, as tested elsewhere
 	s.assertRecordedPid(c, ctx.expectPid)
 }
+
+// MockReplayContext is a MockContext which records hook snapshots, and
+// replays them.
+type MockReplayContext struct {
+	*MockContext
+	snapshot   context.HookSnapshot
+	snapshotOK bool
+	replayed   *context.HookSnapshot
+}
+
+func (ctx *MockReplayContext) HookSnapshot() (context.HookSnapshot, bool) {
+	return ctx.snapshot, ctx.snapshotOK
+}
+
+func (ctx *MockReplayContext) StartReplay(snapshot context.HookSnapshot) error {
+	ctx.replayed = &snapshot
+	return nil
+}
+
+func (s *RunMockContextSuite) TestRunHookRecordsSnapshot(c *gc.C) {
+	ctx := &MockReplayContext{
+		MockContext: &MockContext{},
+		snapshot:    context.HookSnapshot{RelationId: -1},
+		snapshotOK:  true,
+	}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+		code: 123,
+	}, s.paths.GetCharmDir())
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := runner.NewRunner(ctx, s.paths, runner.WithClock(testclock.NewClock(now))).RunHook(stdcontext.Background(), "something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.flushFailure, gc.ErrorMatches, "exit status 123")
+
+	snapshot, err := context.ReadHookSnapshot(s.paths.GetHookSnapshotsDir(), "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(snapshot.Hook, gc.Equals, "something-happened")
+	c.Check(snapshot.Recorded.Equal(now), jc.IsTrue)
+	c.Check(snapshot.Error, gc.Equals, "exit status 123")
+	c.Check(snapshot.Env, gc.Not(gc.HasLen), 0)
+	c.Check(snapshot.Env[0], gc.Equals, "VAR=value")
+}
+
+func (s *RunMockContextSuite) TestRunHookSnapshotsDisabled(c *gc.C) {
+	ctx := &MockReplayContext{
+		MockContext: &MockContext{},
+	}
+	makeCharm(c, hookSpec{
+		dir:  "hooks",
+		name: hookName,
+		perm: 0700,
+	}, s.paths.GetCharmDir())
+
+	_, err := runner.NewRunner(ctx, s.paths).RunHook(stdcontext.Background(), "something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+
+	snapshots, err := context.ListHookSnapshots(s.paths.GetHookSnapshotsDir())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(snapshots, gc.HasLen, 0)
+}
+
+func (s *RunMockContextSuite) TestRunReplayHookAction(c *gc.C) {
+	_, err := context.WriteHookSnapshot(s.paths.GetHookSnapshotsDir(), context.HookSnapshot{
+		Hook:       "something-happened",
+		RelationId: -1,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := &MockReplayContext{
+		MockContext: &MockContext{
+			actionData: &context.ActionData{
+				Params: map[string]interface{}{"snapshot": "1"},
+			},
+			actionResults: map[string]interface{}{},
+		},
+	}
+	makeCharm(c, hookSpec{
+		dir:    "hooks",
+		name:   hookName,
+		perm:   0700,
+		stdout: "hello",
+	}, s.paths.GetCharmDir())
+
+	hookType, err := runner.NewRunner(ctx, s.paths).RunAction(stdcontext.Background(), "juju-replay-hook")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(hookType, gc.Equals, runner.ExplicitHookHandler)
+	c.Assert(ctx.replayed, gc.NotNil)
+	c.Check(ctx.replayed.ID, gc.Equals, "1")
+	c.Check(ctx.flushBadge, gc.Equals, "something-happened")
+	c.Check(ctx.flushFailure, gc.IsNil)
+	c.Check(ctx.actionResults["stdout"], gc.Equals, "hello\n")
+}
+
+func (s *RunMockContextSuite) TestRunReplayHookActionMissingSnapshot(c *gc.C) {
+	ctx := &MockReplayContext{
+		MockContext: &MockContext{
+			actionData: &context.ActionData{
+				Params: map[string]interface{}{"snapshot": "9"},
+			},
+			actionResults: map[string]interface{}{},
+		},
+	}
+
+	_, err := runner.NewRunner(ctx, s.paths).RunAction(stdcontext.Background(), "juju-replay-hook")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ctx.replayed, gc.IsNil)
+	c.Check(ctx.flushBadge, gc.Equals, "juju-replay-hook")
+	c.Check(ctx.flushFailure, gc.ErrorMatches, `cannot replay hook snapshot "9": hook snapshot "9" not found`)
+}
+
+func (s *RunMockContextSuite) TestRunReplayHookActionListsSnapshots(c *gc.C) {
+	_, err := context.WriteHookSnapshot(s.paths.GetHookSnapshotsDir(), context.HookSnapshot{
+		Hook:       "something-happened",
+		Recorded:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Error:      "exit status 1",
+		RelationId: -1,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := &MockReplayContext{
+		MockContext: &MockContext{
+			actionData:    &context.ActionData{},
+			actionResults: map[string]interface{}{},
+		},
+	}
+
+	_, err = runner.NewRunner(ctx, s.paths).RunAction(stdcontext.Background(), "juju-replay-hook")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ctx.replayed, gc.IsNil)
+	c.Check(ctx.flushFailure, gc.IsNil)
+	c.Check(ctx.actionResults["1"], gc.Equals, "something-happened at 2025-01-01T00:00:00Z failed: exit status 1")
+}
@@ -26,6 +26,7 @@ type RealPaths struct {
 	base         string
 	socket       sockets.Socket
 	metricsspool string
+	snapshots    string
 }
 
 func osDependentSockPath(c *gc.C) sockets.Socket {
@@ -40,6 +41,7 @@ func NewRealPaths(c *gc.C) RealPaths {
 		base:         c.MkDir(),
 		socket:       osDependentSockPath(c),
 		metricsspool: c.MkDir(),
+		snapshots:    c.MkDir(),
 	}
 }
 
@@ -47,6 +49,10 @@ func (p RealPaths) GetMetricsSpoolDir() string {
 	return p.metricsspool
 }
 
+func (p RealPaths) GetHookSnapshotsDir() string {
+	return p.snapshots
+}
+
 func (p RealPaths) GetToolsDir() string {
 	return p.tools
 }
@@ -192,6 +192,8 @@ type hookSpec struct {
 	stderr string
 	// background holds a string to print in the background after 0.2s.
 	background string
+	// sleep holds how long the hook sleeps for before it exits.
+	sleep string
 	// missingShebang will omit the '#!/bin/bash' line
 	missingShebang bool
 	// charmMissing will remove the charm before running the hook
@@ -243,6 +245,10 @@ func makeCharm(c *gc.C, spec hookSpec, charmDir string) {
 		// expected.
 		printf("(sleep 0.2; echo %s; sleep 10) &", spec.background)
 	}
+	if spec.sleep != "" {
+		// The mock context mangles the hook's PATH, so use an absolute path.
+		printf("/bin/sleep %s", spec.sleep)
+	}
 	printf("exit %d", spec.code)
 }
 
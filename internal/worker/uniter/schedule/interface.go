@@ -0,0 +1,43 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule
+
+import (
+	"context"
+
+	coreschedule "github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+)
+
+// ScheduleTracker provides access to the unit agent's
+// state for scheduled hooks.
+type ScheduleTracker interface {
+	// Schedules returns the unit's schedules sorted by name.
+	Schedules() []coreschedule.Entry
+
+	// Due returns the names of the schedules whose hooks are due.
+	Due() []string
+
+	// Changes returns a channel which is signalled whenever the
+	// schedules or their next run times change.
+	Changes() <-chan struct{}
+
+	// SetCharmSchedules replaces the schedules declared in
+	// the charm metadata.
+	SetCharmSchedules(context.Context, map[string]charm.Schedule) error
+
+	// Register adds or replaces a schedule registered by the charm.
+	Register(context.Context, charm.Schedule) error
+
+	// Remove removes a schedule registered by the charm.
+	Remove(_ context.Context, name string) error
+
+	// CommitHook records that the supplied schedule hook has run, and
+	// works out when it is next due.
+	CommitHook(context.Context, hook.Info) error
+
+	// Report provides information for the engine report.
+	Report() map[string]interface{}
+}
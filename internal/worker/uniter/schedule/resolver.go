@@ -0,0 +1,40 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule
+
+import (
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/charm/hooks"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+	"github.com/juju/juju/internal/worker/uniter/hooktimer"
+	"github.com/juju/juju/internal/worker/uniter/remotestate"
+	"github.com/juju/juju/internal/worker/uniter/resolver"
+)
+
+// NewResolver returns a new Resolver that returns operations to run the
+// hooks of schedules which are due. When a hook is committed, the
+// "completed" callback is invoked to remove the schedule from the
+// remote state.
+func NewResolver(logger logger.Logger, tracker ScheduleTracker, completed func(string)) resolver.Resolver {
+	return hooktimer.NewResolver(hooktimer.ResolverConfig{
+		Logger: logger,
+		Sent: func(remoteState remotestate.Snapshot) []string {
+			return remoteState.ScheduledHooks
+		},
+		Due: func() []hooktimer.Hook {
+			var due []hooktimer.Hook
+			for _, name := range tracker.Due() {
+				due = append(due, hooktimer.Hook{
+					ID: name,
+					Info: hook.Info{
+						Kind:         hooks.Schedule,
+						ScheduleName: name,
+					},
+				})
+			}
+			return due
+		},
+		Completed: completed,
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/life"
+	coreschedule "github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/charm/hooks"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+	"github.com/juju/juju/internal/worker/uniter/operation"
+	operationmocks "github.com/juju/juju/internal/worker/uniter/operation/mocks"
+	"github.com/juju/juju/internal/worker/uniter/remotestate"
+	"github.com/juju/juju/internal/worker/uniter/resolver"
+	runnermocks "github.com/juju/juju/internal/worker/uniter/runner/mocks"
+	"github.com/juju/juju/internal/worker/uniter/schedule"
+	"github.com/juju/juju/rpc/params"
+)
+
+type resolverSuite struct {
+	remoteState     remotestate.Snapshot
+	stateReadWriter *operationmocks.MockUnitStateReadWriter
+	mockCallbacks   *operationmocks.MockCallbacks
+	mockFactory     *runnermocks.MockFactory
+	mockRunner      *runnermocks.MockRunner
+	mockContext     *runnermocks.MockContext
+	opFactory       operation.Factory
+	resolver        resolver.Resolver
+	completed       []string
+}
+
+var _ = gc.Suite(&resolverSuite{})
+
+func (s *resolverSuite) SetUpTest(c *gc.C) {
+	s.remoteState = remotestate.Snapshot{
+		Life: life.Alive,
+	}
+	s.completed = nil
+}
+
+func (s *resolverSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.stateReadWriter = operationmocks.NewMockUnitStateReadWriter(ctrl)
+	s.mockCallbacks = operationmocks.NewMockCallbacks(ctrl)
+	s.mockFactory = runnermocks.NewMockFactory(ctrl)
+	s.mockRunner = runnermocks.NewMockRunner(ctrl)
+	s.mockContext = runnermocks.NewMockContext(ctrl)
+	s.opFactory = operation.NewFactory(operation.FactoryParams{
+		Callbacks:     s.mockCallbacks,
+		RunnerFactory: s.mockFactory,
+		Logger:        loggertesting.WrapCheckLog(c),
+	})
+
+	// The hourly schedule is due, the nightly one is not.
+	st := &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"hourly":  {Schedule: hourly, Source: coreschedule.SourceRuntime, NextRun: now},
+		"nightly": {Schedule: nightly, Source: coreschedule.SourceCharm, NextRun: now.Add(time.Hour)},
+	}}
+	in, err := st.Serialise()
+	c.Assert(err, jc.ErrorIsNil)
+	s.stateReadWriter.EXPECT().State(gomock.Any()).Return(params.UnitStateResult{ScheduleState: in}, nil)
+
+	logger := loggertesting.WrapCheckLog(c)
+	tracker, err := schedule.NewSchedules(context.Background(), s.stateReadWriter, testclock.NewClock(now), logger)
+	c.Assert(err, jc.ErrorIsNil)
+	s.resolver = schedule.NewResolver(logger, tracker, func(name string) {
+		s.completed = append(s.completed, name)
+	})
+	return ctrl
+}
+
+func (s *resolverSuite) startedState() resolver.LocalState {
+	return resolver.LocalState{
+		State: operation.State{
+			Kind:      operation.Continue,
+			Installed: true,
+			Started:   true,
+		},
+	}
+}
+
+func (s *resolverSuite) TestNextOpNotStarted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	localState := s.startedState()
+	localState.Started = false
+	s.remoteState.ScheduledHooks = []string{"hourly"}
+	_, err := s.resolver.NextOp(context.Background(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *resolverSuite) TestNextOpDying(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.remoteState.Life = life.Dying
+	s.remoteState.ScheduledHooks = []string{"hourly"}
+	_, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *resolverSuite) TestNextOpNotReady(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	localState := s.startedState()
+	localState.Kind = operation.Upgrade
+	s.remoteState.ScheduledHooks = []string{"hourly"}
+	_, err := s.resolver.NextOp(context.Background(), localState, s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *resolverSuite) TestNextOpNothingScheduled(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *resolverSuite) TestNextOpNoLongerDue(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.remoteState.ScheduledHooks = []string{"nightly", "removed"}
+	_, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+	c.Assert(s.completed, jc.DeepEquals, []string{"nightly", "removed"})
+}
+
+func (s *resolverSuite) TestNextOpRunsHook(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.remoteState.ScheduledHooks = []string{"hourly"}
+	op, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run schedule (hourly) hook")
+}
+
+func (s *resolverSuite) TestCommit(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.remoteState.ScheduledHooks = []string{"hourly"}
+	op, err := s.resolver.NextOp(context.Background(), s.startedState(), s.remoteState, s.opFactory)
+	c.Assert(err, jc.ErrorIsNil)
+
+	hi := hook.Info{
+		Kind:         hooks.Schedule,
+		ScheduleName: "hourly",
+	}
+	s.mockCallbacks.EXPECT().PrepareHook(gomock.Any(), hi).Return("schedule-hourly", nil)
+	s.mockFactory.EXPECT().NewHookRunner(gomock.Any(), hi).Return(s.mockRunner, nil)
+	s.mockRunner.EXPECT().Context().Return(s.mockContext).AnyTimes()
+	s.mockContext.EXPECT().Prepare(gomock.Any()).Return(nil)
+	_, err = op.Prepare(context.Background(), operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.completed, gc.HasLen, 0)
+
+	s.mockCallbacks.EXPECT().CommitHook(gomock.Any(), hi).Return(nil)
+	_, err = op.Commit(context.Background(), operation.State{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.completed, jc.DeepEquals, []string{"hourly"})
+}
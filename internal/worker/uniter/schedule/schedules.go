@@ -0,0 +1,282 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/logger"
+	coreschedule "github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+)
+
+// Schedules tracks the schedules of a unit, both those declared in the
+// charm metadata and those registered by the charm at runtime, and when
+// each of their hooks is next due.
+type Schedules struct {
+	clock    clock.Clock
+	logger   logger.Logger
+	stateOps *stateOps
+	changes  chan struct{}
+
+	mu    sync.Mutex
+	state *coreschedule.State
+}
+
+// NewSchedules returns a new schedule tracker. Schedules whose runs were
+// missed while the unit agent was not running are either run once as
+// soon as possible, or skipped until they are next due, according to
+// their catch-up policy.
+func NewSchedules(
+	ctx context.Context,
+	rw UnitStateReadWriter,
+	clock clock.Clock,
+	logger logger.Logger,
+) (ScheduleTracker, error) {
+	s := &Schedules{
+		clock:    clock,
+		logger:   logger,
+		stateOps: newStateOps(rw),
+		// The changes channel is buffered so that changes
+		// are coalesced while the timer is busy.
+		changes: make(chan struct{}, 1),
+	}
+	if err := s.init(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Schedules) init(ctx context.Context) error {
+	st, err := s.stateOps.Read(ctx)
+	if err != nil {
+		return errors.Annotate(err, "reading schedule state")
+	}
+	s.state = st
+
+	now := s.clock.Now()
+	changed := false
+	for name, entry := range s.state.Schedules {
+		if entry.NextRun.IsZero() || !entry.NextRun.Before(now) {
+			continue
+		}
+		if entry.CatchUp == charm.CatchUpOnce {
+			// The hook is already due, so runs as soon as possible.
+			continue
+		}
+		s.logger.Debugf(ctx, "skipping missed run of schedule %q due at %v", name, entry.NextRun)
+		if entry.NextRun, err = s.nextRun(entry.Schedule, now); err != nil {
+			return errors.Trace(err)
+		}
+		s.state.Schedules[name] = entry
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return s.stateOps.Write(ctx, s.state)
+}
+
+// nextRun returns when the schedule is next due after the given time,
+// including a random delay of up to the schedule's jitter.
+func (s *Schedules) nextRun(schedule charm.Schedule, after time.Time) (time.Time, error) {
+	next, err := schedule.Next(after)
+	if err != nil {
+		return time.Time{}, errors.Annotatef(err, "schedule %q", schedule.Name)
+	}
+	if schedule.Jitter > 0 {
+		next = next.Add(time.Duration(rand.Int63n(int64(schedule.Jitter))))
+	}
+	return next, nil
+}
+
+// changed writes the schedule state and signals the changes channel.
+// It must be called with the mutex held.
+func (s *Schedules) changed(ctx context.Context) error {
+	if err := s.stateOps.Write(ctx, s.state); err != nil {
+		return errors.Trace(err)
+	}
+	select {
+	case s.changes <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Schedules implements ScheduleTracker.
+func (s *Schedules) Schedules() []coreschedule.Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state.Entries()
+}
+
+// Due implements ScheduleTracker.
+func (s *Schedules) Due() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	var due []string
+	for name, entry := range s.state.Schedules {
+		if !entry.NextRun.IsZero() && !entry.NextRun.After(now) {
+			due = append(due, name)
+		}
+	}
+	sort.Strings(due)
+	return due
+}
+
+// Changes implements ScheduleTracker.
+func (s *Schedules) Changes() <-chan struct{} {
+	return s.changes
+}
+
+// SetCharmSchedules implements ScheduleTracker.
+func (s *Schedules) SetCharmSchedules(ctx context.Context, schedules map[string]charm.Schedule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := false
+	for name, entry := range s.state.Schedules {
+		if entry.Source != coreschedule.SourceCharm {
+			continue
+		}
+		if _, ok := schedules[name]; !ok {
+			delete(s.state.Schedules, name)
+			changed = true
+		}
+	}
+	now := s.clock.Now()
+	for name, schedule := range schedules {
+		existing, ok := s.state.Schedules[name]
+		if ok && existing.Source == coreschedule.SourceCharm && existing.Schedule == schedule {
+			continue
+		}
+		if ok && existing.Source != coreschedule.SourceCharm {
+			s.logger.Warningf(ctx, "schedule %q declared in charm metadata replaces the registered schedule", name)
+		}
+		next, err := s.nextRun(schedule, now)
+		if err != nil {
+			// A schedule which can't be run mustn't stop the uniter
+			// running the charm's other hooks.
+			s.logger.Errorf(ctx, "skipping charm schedule: %v", err)
+			if ok && existing.Source == coreschedule.SourceCharm {
+				delete(s.state.Schedules, name)
+				changed = true
+			}
+			continue
+		}
+		s.state.Schedules[name] = coreschedule.Entry{
+			Schedule: schedule,
+			Source:   coreschedule.SourceCharm,
+			LastRun:  existing.LastRun,
+			NextRun:  next,
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return s.changed(ctx)
+}
+
+// Register implements ScheduleTracker.
+func (s *Schedules) Register(ctx context.Context, schedule charm.Schedule) error {
+	if schedule.CatchUp == "" {
+		schedule.CatchUp = charm.CatchUpSkip
+	}
+	if err := schedule.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.state.Schedules[schedule.Name]
+	if ok && existing.Source == coreschedule.SourceCharm {
+		return errors.NotValidf("replacing schedule %q declared in charm metadata", schedule.Name)
+	}
+	if ok && existing.Schedule == schedule {
+		return nil
+	}
+	next, err := s.nextRun(schedule, s.clock.Now())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	s.state.Schedules[schedule.Name] = coreschedule.Entry{
+		Schedule: schedule,
+		Source:   coreschedule.SourceRuntime,
+		LastRun:  existing.LastRun,
+		NextRun:  next,
+	}
+	return s.changed(ctx)
+}
+
+// Remove implements ScheduleTracker.
+func (s *Schedules) Remove(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.state.Schedules[name]
+	if !ok {
+		return errors.NotFoundf("schedule %q", name)
+	}
+	if existing.Source == coreschedule.SourceCharm {
+		return errors.NotValidf("removing schedule %q declared in charm metadata", name)
+	}
+	delete(s.state.Schedules, name)
+	return s.changed(ctx)
+}
+
+// CommitHook implements ScheduleTracker.
+func (s *Schedules) CommitHook(ctx context.Context, hi hook.Info) error {
+	if !hi.Kind.IsSchedule() {
+		return errors.Errorf("not a schedule hook: %#v", hi)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.state.Schedules[hi.ScheduleName]
+	if !ok {
+		// The schedule was removed while its hook was running.
+		return nil
+	}
+	now := s.clock.Now()
+	next, err := s.nextRun(entry.Schedule, now)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	entry.LastRun = now
+	entry.NextRun = next
+	s.state.Schedules[hi.ScheduleName] = entry
+	return s.changed(ctx)
+}
+
+// Report implements ScheduleTracker.
+func (s *Schedules) Report() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make(map[string]interface{})
+	for name, entry := range s.state.Schedules {
+		report := map[string]interface{}{
+			"source":   string(entry.Source),
+			"next-run": entry.NextRun,
+		}
+		if !entry.LastRun.IsZero() {
+			report["last-run"] = entry.LastRun
+		}
+		result[name] = report
+	}
+	return result
+}
@@ -0,0 +1,322 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreschedule "github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/charm/hooks"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/uniter/hook"
+	operationmocks "github.com/juju/juju/internal/worker/uniter/operation/mocks"
+	"github.com/juju/juju/internal/worker/uniter/schedule"
+	"github.com/juju/juju/rpc/params"
+)
+
+var (
+	now     = time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+	nightly = charm.Schedule{Name: "nightly", Cron: "0 3 * * *", CatchUp: charm.CatchUpSkip}
+	hourly  = charm.Schedule{Name: "hourly", Interval: time.Hour, CatchUp: charm.CatchUpOnce}
+)
+
+type schedulesSuite struct {
+	clock           *testclock.Clock
+	stateReadWriter *operationmocks.MockUnitStateReadWriter
+
+	// written holds the last schedule state written to the unit state.
+	written *coreschedule.State
+}
+
+var _ = gc.Suite(&schedulesSuite{})
+
+func (s *schedulesSuite) SetUpTest(c *gc.C) {
+	s.clock = testclock.NewClock(now)
+	s.written = nil
+}
+
+func (s *schedulesSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.stateReadWriter = operationmocks.NewMockUnitStateReadWriter(ctrl)
+	return ctrl
+}
+
+func (s *schedulesSuite) expectState(c *gc.C, st *coreschedule.State) {
+	var in string
+	if st != nil {
+		var err error
+		in, err = st.Serialise()
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	s.stateReadWriter.EXPECT().State(gomock.Any()).Return(params.UnitStateResult{ScheduleState: in}, nil)
+}
+
+func (s *schedulesSuite) expectSetState(c *gc.C) *operationmocks.MockUnitStateReadWriterSetStateCall {
+	return s.stateReadWriter.EXPECT().SetState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg params.SetUnitStateArg) error {
+			c.Assert(arg.ScheduleState, gc.NotNil)
+			st, err := coreschedule.ParseState(*arg.ScheduleState)
+			c.Assert(err, jc.ErrorIsNil)
+			s.written = st
+			return nil
+		},
+	)
+}
+
+func (s *schedulesSuite) newSchedules(c *gc.C) schedule.ScheduleTracker {
+	tracker, err := schedule.NewSchedules(context.Background(), s.stateReadWriter, s.clock, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+	return tracker
+}
+
+func (s *schedulesSuite) assertChanged(c *gc.C, tracker schedule.ScheduleTracker) {
+	select {
+	case <-tracker.Changes():
+	default:
+		c.Fatalf("schedules not changed")
+	}
+}
+
+func (s *schedulesSuite) assertNotChanged(c *gc.C, tracker schedule.ScheduleTracker) {
+	select {
+	case <-tracker.Changes():
+		c.Fatalf("schedules changed unexpectedly")
+	default:
+	}
+}
+
+func (s *schedulesSuite) TestNewSchedulesNoState(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, nil)
+	tracker := s.newSchedules(c)
+	c.Assert(tracker.Schedules(), gc.HasLen, 0)
+	c.Assert(tracker.Due(), gc.HasLen, 0)
+	s.assertNotChanged(c, tracker)
+}
+
+func (s *schedulesSuite) TestNewSchedulesCatchUp(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Both schedules were due while the agent was down; the nightly
+	// schedule skips its missed run and the hourly one runs it once.
+	s.expectState(c, &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"nightly": {Schedule: nightly, Source: coreschedule.SourceCharm, NextRun: now.Add(-7 * time.Hour)},
+		"hourly":  {Schedule: hourly, Source: coreschedule.SourceRuntime, NextRun: now.Add(-3 * time.Hour)},
+	}})
+	s.expectSetState(c)
+
+	tracker := s.newSchedules(c)
+	c.Assert(tracker.Due(), jc.DeepEquals, []string{"hourly"})
+	c.Assert(s.written.Schedules["nightly"].NextRun, jc.DeepEquals, time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC))
+	c.Assert(s.written.Schedules["hourly"].NextRun, jc.DeepEquals, now.Add(-3*time.Hour))
+}
+
+func (s *schedulesSuite) TestNewSchedulesNothingMissed(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"nightly": {Schedule: nightly, Source: coreschedule.SourceCharm, NextRun: now.Add(time.Hour)},
+	}})
+
+	tracker := s.newSchedules(c)
+	c.Assert(tracker.Due(), gc.HasLen, 0)
+	c.Assert(tracker.Schedules(), gc.HasLen, 1)
+}
+
+func (s *schedulesSuite) TestSetCharmSchedules(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	lastRun := now.Add(-time.Hour)
+	s.expectState(c, &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"nightly": {
+			Schedule: charm.Schedule{Name: "nightly", Cron: "@daily", CatchUp: charm.CatchUpSkip},
+			Source:   coreschedule.SourceCharm,
+			LastRun:  lastRun,
+			NextRun:  now.Add(time.Hour),
+		},
+		"weekly": {
+			Schedule: charm.Schedule{Name: "weekly", Cron: "@weekly", CatchUp: charm.CatchUpSkip},
+			Source:   coreschedule.SourceCharm,
+			NextRun:  now.Add(time.Hour),
+		},
+	}})
+	s.expectSetState(c)
+
+	tracker := s.newSchedules(c)
+	err := tracker.SetCharmSchedules(context.Background(), map[string]charm.Schedule{
+		"nightly": nightly,
+		"hourly":  hourly,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+
+	c.Assert(tracker.Schedules(), jc.DeepEquals, []coreschedule.Entry{{
+		Schedule: hourly,
+		Source:   coreschedule.SourceCharm,
+		NextRun:  now.Add(time.Hour),
+	}, {
+		Schedule: nightly,
+		Source:   coreschedule.SourceCharm,
+		LastRun:  lastRun,
+		NextRun:  time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC),
+	}})
+	c.Assert(s.written.Entries(), jc.DeepEquals, tracker.Schedules())
+}
+
+func (s *schedulesSuite) TestSetCharmSchedulesUnchanged(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"nightly": {Schedule: nightly, Source: coreschedule.SourceCharm, NextRun: now.Add(time.Hour)},
+	}})
+
+	tracker := s.newSchedules(c)
+	err := tracker.SetCharmSchedules(context.Background(), map[string]charm.Schedule{"nightly": nightly})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertNotChanged(c, tracker)
+}
+
+func (s *schedulesSuite) TestSetCharmSchedulesSkipsNeverMatching(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"never": {
+			Schedule: charm.Schedule{Name: "never", Cron: "@daily", CatchUp: charm.CatchUpSkip},
+			Source:   coreschedule.SourceCharm,
+			NextRun:  now.Add(time.Hour),
+		},
+	}})
+	s.expectSetState(c)
+
+	tracker := s.newSchedules(c)
+	err := tracker.SetCharmSchedules(context.Background(), map[string]charm.Schedule{
+		"never":  {Name: "never", Cron: "0 0 31 2 *", CatchUp: charm.CatchUpSkip},
+		"hourly": hourly,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+
+	c.Assert(tracker.Schedules(), jc.DeepEquals, []coreschedule.Entry{{
+		Schedule: hourly,
+		Source:   coreschedule.SourceCharm,
+		NextRun:  now.Add(time.Hour),
+	}})
+}
+
+func (s *schedulesSuite) TestRegisterAndRemove(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, nil)
+	s.expectSetState(c).Times(2)
+
+	tracker := s.newSchedules(c)
+	err := tracker.Register(context.Background(), charm.Schedule{Name: "hourly", Interval: time.Hour})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+	c.Assert(s.written.Entries(), jc.DeepEquals, []coreschedule.Entry{{
+		Schedule: charm.Schedule{Name: "hourly", Interval: time.Hour, CatchUp: charm.CatchUpSkip},
+		Source:   coreschedule.SourceRuntime,
+		NextRun:  now.Add(time.Hour),
+	}})
+
+	err = tracker.Remove(context.Background(), "hourly")
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+	c.Assert(s.written.Schedules, gc.HasLen, 0)
+
+	err = tracker.Remove(context.Background(), "hourly")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *schedulesSuite) TestRegisterInvalid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, nil)
+
+	tracker := s.newSchedules(c)
+	err := tracker.Register(context.Background(), charm.Schedule{Name: "hourly"})
+	c.Assert(err, gc.ErrorMatches, `schedule "hourly" without cron or interval not valid`)
+}
+
+func (s *schedulesSuite) TestCharmSchedulesCannotBeReplacedOrRemoved(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"nightly": {Schedule: nightly, Source: coreschedule.SourceCharm, NextRun: now.Add(time.Hour)},
+	}})
+
+	tracker := s.newSchedules(c)
+	err := tracker.Register(context.Background(), charm.Schedule{Name: "nightly", Interval: time.Hour})
+	c.Assert(err, gc.ErrorMatches, `replacing schedule "nightly" declared in charm metadata not valid`)
+	err = tracker.Remove(context.Background(), "nightly")
+	c.Assert(err, gc.ErrorMatches, `removing schedule "nightly" declared in charm metadata not valid`)
+}
+
+func (s *schedulesSuite) TestJitter(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, nil)
+	s.expectSetState(c)
+
+	tracker := s.newSchedules(c)
+	err := tracker.Register(context.Background(), charm.Schedule{
+		Name:     "hourly",
+		Interval: time.Hour,
+		Jitter:   10 * time.Minute,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	next := s.written.Schedules["hourly"].NextRun
+	c.Assert(next.Before(now.Add(time.Hour)), jc.IsFalse)
+	c.Assert(next.Before(now.Add(70*time.Minute)), jc.IsTrue)
+}
+
+func (s *schedulesSuite) TestCommitHook(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, &coreschedule.State{Schedules: map[string]coreschedule.Entry{
+		"hourly": {Schedule: hourly, Source: coreschedule.SourceRuntime, NextRun: now},
+	}})
+	s.expectSetState(c)
+
+	tracker := s.newSchedules(c)
+	c.Assert(tracker.Due(), jc.DeepEquals, []string{"hourly"})
+
+	s.clock.Advance(time.Minute)
+	err := tracker.CommitHook(context.Background(), hook.Info{Kind: hooks.Schedule, ScheduleName: "hourly"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertChanged(c, tracker)
+	c.Assert(tracker.Due(), gc.HasLen, 0)
+	c.Assert(s.written.Schedules["hourly"].LastRun, jc.DeepEquals, now.Add(time.Minute))
+	c.Assert(s.written.Schedules["hourly"].NextRun, jc.DeepEquals, now.Add(61*time.Minute))
+}
+
+func (s *schedulesSuite) TestCommitHookRemovedSchedule(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, nil)
+
+	tracker := s.newSchedules(c)
+	err := tracker.CommitHook(context.Background(), hook.Info{Kind: hooks.Schedule, ScheduleName: "hourly"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertNotChanged(c, tracker)
+}
+
+func (s *schedulesSuite) TestCommitHookWrongKind(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectState(c, nil)
+
+	tracker := s.newSchedules(c)
+	err := tracker.CommitHook(context.Background(), hook.Info{Kind: hooks.Install})
+	c.Assert(err, gc.ErrorMatches, "not a schedule hook: .*")
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	coreschedule "github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/worker/uniter/hooktimer"
+	"github.com/juju/juju/rpc/params"
+)
+
+// UnitStateReadWriter encapsulates the methods from a state.Unit
+// required to set and get unit state.
+type UnitStateReadWriter = hooktimer.UnitStateReadWriter
+
+// stateOps reads and writes schedule state from/to the controller.
+type stateOps struct {
+	ops *hooktimer.StateOps
+}
+
+func newStateOps(rw UnitStateReadWriter) *stateOps {
+	return &stateOps{
+		ops: hooktimer.NewStateOps(rw,
+			func(unitState params.UnitStateResult) string {
+				return unitState.ScheduleState
+			},
+			func(str *string) params.SetUnitStateArg {
+				return params.SetUnitStateArg{ScheduleState: str}
+			},
+		),
+	}
+}
+
+// Read reads schedule state from the controller. If there is no saved
+// state, an empty State is returned.
+func (f *stateOps) Read(ctx context.Context) (*coreschedule.State, error) {
+	str, err := f.ops.Read(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	st, err := coreschedule.ParseState(str)
+	return st, errors.Trace(err)
+}
+
+// Write stores the supplied schedule state to the controller.
+func (f *stateOps) Write(ctx context.Context, st *coreschedule.State) error {
+	if st == nil {
+		return errors.Trace(errors.BadRequestf("arg is nil"))
+	}
+	str, err := st.Serialise()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return f.ops.Write(ctx, str)
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule
+
+import (
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+
+	"github.com/juju/juju/core/logger"
+	coreschedule "github.com/juju/juju/core/schedule"
+	"github.com/juju/juju/internal/worker/uniter/hooktimer"
+)
+
+// ScheduleSource provides the schedules watched by the timer.
+type ScheduleSource interface {
+	// Schedules returns the unit's schedules sorted by name.
+	Schedules() []coreschedule.Entry
+
+	// Changes returns a channel which is signalled whenever the
+	// schedules or their next run times change.
+	Changes() <-chan struct{}
+}
+
+// NewTimer starts a worker which sends the name of each schedule on the
+// out channel when its hook becomes due. A schedule is sent once for each
+// next run time, so it is not sent again until its hook has been run.
+func NewTimer(source ScheduleSource, clock clock.Clock, out chan<- string, logger logger.Logger) worker.Worker {
+	return hooktimer.NewTimer(timerSource{source}, clock, out, logger)
+}
+
+// timerSource adapts a ScheduleSource to the source of a hook timer.
+type timerSource struct {
+	ScheduleSource
+}
+
+// Due is part of the hooktimer.Source interface.
+func (s timerSource) Due() []hooktimer.Due {
+	entries := s.Schedules()
+	due := make([]hooktimer.Due, len(entries))
+	for i, entry := range entries {
+		due[i] = hooktimer.Due{ID: entry.Name, At: entry.NextRun}
+	}
+	return due
+}
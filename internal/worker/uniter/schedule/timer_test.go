@@ -0,0 +1,130 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schedule_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	gc "gopkg.in/check.v1"
+
+	coreschedule "github.com/juju/juju/core/schedule"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/uniter/schedule"
+)
+
+type fakeScheduleSource struct {
+	mu      sync.Mutex
+	entries []coreschedule.Entry
+	changes chan struct{}
+}
+
+func (f *fakeScheduleSource) Schedules() []coreschedule.Entry {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.entries
+}
+
+func (f *fakeScheduleSource) Changes() <-chan struct{} {
+	return f.changes
+}
+
+func (f *fakeScheduleSource) setEntries(entries ...coreschedule.Entry) {
+	f.mu.Lock()
+	f.entries = entries
+	f.mu.Unlock()
+	f.changes <- struct{}{}
+}
+
+type timerSuite struct {
+	clock  *testclock.Clock
+	source *fakeScheduleSource
+	out    chan string
+}
+
+var _ = gc.Suite(&timerSuite{})
+
+func (s *timerSuite) SetUpTest(c *gc.C) {
+	s.clock = testclock.NewClock(now)
+	s.source = &fakeScheduleSource{changes: make(chan struct{})}
+	s.out = make(chan string)
+}
+
+func (s *timerSuite) assertSent(c *gc.C, name string) {
+	select {
+	case got := <-s.out:
+		c.Assert(got, gc.Equals, name)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("schedule %q not sent", name)
+	}
+}
+
+func (s *timerSuite) assertNotSent(c *gc.C) {
+	select {
+	case got := <-s.out:
+		c.Fatalf("unexpected schedule %q sent", got)
+	case <-time.After(testing.ShortWait):
+	}
+}
+
+func (s *timerSuite) TestSendsDueSchedule(c *gc.C) {
+	s.source.entries = []coreschedule.Entry{
+		{Schedule: nightly, NextRun: now.Add(2 * time.Hour)},
+		{Schedule: hourly, NextRun: now.Add(time.Hour)},
+	}
+	w := schedule.NewTimer(s.source, s.clock, s.out, loggertesting.WrapCheckLog(c))
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(s.clock.WaitAdvance(time.Hour, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "hourly")
+
+	c.Assert(s.clock.WaitAdvance(time.Hour, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "nightly")
+
+	// Neither schedule is sent again until its next run time changes.
+	s.assertNotSent(c)
+}
+
+func (s *timerSuite) TestSendsAgainWhenNextRunChanges(c *gc.C) {
+	s.source.entries = []coreschedule.Entry{
+		{Schedule: hourly, NextRun: now.Add(time.Hour)},
+	}
+	w := schedule.NewTimer(s.source, s.clock, s.out, loggertesting.WrapCheckLog(c))
+	defer workertest.CleanKill(c, w)
+
+	c.Assert(s.clock.WaitAdvance(time.Hour, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "hourly")
+
+	// The hook has run, so the schedule is next due in an hour.
+	s.source.setEntries(coreschedule.Entry{Schedule: hourly, NextRun: now.Add(2 * time.Hour)})
+	c.Assert(s.clock.WaitAdvance(time.Hour, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "hourly")
+}
+
+func (s *timerSuite) TestNewSchedule(c *gc.C) {
+	w := schedule.NewTimer(s.source, s.clock, s.out, loggertesting.WrapCheckLog(c))
+	defer workertest.CleanKill(c, w)
+
+	s.assertNotSent(c)
+	s.source.setEntries(coreschedule.Entry{Schedule: hourly, NextRun: now.Add(time.Minute)})
+	c.Assert(s.clock.WaitAdvance(time.Minute, testing.ShortWait, 1), jc.ErrorIsNil)
+	s.assertSent(c, "hourly")
+}
+
+func (s *timerSuite) TestRemovedSchedule(c *gc.C) {
+	s.source.entries = []coreschedule.Entry{
+		{Schedule: hourly, NextRun: now.Add(time.Hour)},
+	}
+	w := schedule.NewTimer(s.source, s.clock, s.out, loggertesting.WrapCheckLog(c))
+	defer workertest.CleanKill(c, w)
+
+	// The timer receives the change once it is waiting for the schedule.
+	s.source.setEntries()
+	s.clock.Advance(time.Hour)
+	s.assertNotSent(c)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/juju/juju/internal/worker/uniter/api"
 	"github.com/juju/juju/internal/worker/uniter/charm"
 	"github.com/juju/juju/internal/worker/uniter/container"
+	"github.com/juju/juju/internal/worker/uniter/deferred"
 	"github.com/juju/juju/internal/worker/uniter/hook"
 	uniterleadership "github.com/juju/juju/internal/worker/uniter/leadership"
 	"github.com/juju/juju/internal/worker/uniter/operation"
@@ -47,6 +48,7 @@ import (
 	"github.com/juju/juju/internal/worker/uniter/runner/context"
 	"github.com/juju/juju/internal/worker/uniter/runner/context/resources"
 	"github.com/juju/juju/internal/worker/uniter/runner/jujuc"
+	"github.com/juju/juju/internal/worker/uniter/schedule"
 	"github.com/juju/juju/internal/worker/uniter/secrets"
 	"github.com/juju/juju/internal/worker/uniter/storage"
 	"github.com/juju/juju/internal/worker/uniter/verifycharmprofile"
@@ -79,6 +81,12 @@ type Uniter struct {
 
 	secretsTracker secrets.SecretStateTracker
 
+	schedules       schedule.ScheduleTracker
+	scheduleChannel chan string
+
+	deferredHooks       deferred.DeferredHookTracker
+	deferredHookChannel chan string
+
 	// Cache the last reported status information
 	// so we don't make unnecessary api calls.
 	setStatusMutex      sync.Mutex
@@ -377,6 +385,9 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 				EnforcedCharmModifiedVersion: u.enforcedCharmModifiedVersion,
 				WorkloadEventChannel:         u.workloadEventChannel,
 				InitialWorkloadEventIDs:      u.workloadEvents.EventIDs(),
+				ScheduleChannel:              u.scheduleChannel,
+				InitialScheduledHooks:        u.schedules.Due(),
+				DeferredHookChannel:          u.deferredHookChannel,
 				ShutdownChannel:              u.shutdownChannel,
 			})
 		if err != nil {
@@ -467,6 +478,16 @@ func (u *Uniter) loop(unitTag names.UnitTag) (err error) {
 				watcher.WorkloadEventCompleted),
 			)
 		}
+		cfg.OptionalResolvers = append(cfg.OptionalResolvers, schedule.NewResolver(
+			u.logger.Child("schedule"),
+			u.schedules,
+			watcher.ScheduledHookCompleted),
+		)
+		cfg.OptionalResolvers = append(cfg.OptionalResolvers, deferred.NewResolver(
+			u.logger.Child("deferred"),
+			u.deferredHooks,
+			watcher.DeferredHookCompleted),
+		)
 		uniterResolver := NewUniterResolver(cfg)
 
 		// We should not do anything until there has been a change
@@ -803,6 +824,25 @@ func (u *Uniter) init(ctx stdcontext.Context, unitTag names.UnitTag) (err error)
 	}
 	u.secretsTracker = secretsTracker
 
+	schedules, err := schedule.NewSchedules(
+		ctx, u.unit, u.clock, u.logger.Child("schedule"),
+	)
+	if err != nil {
+		return errors.Annotatef(err, "cannot create schedule tracker")
+	}
+	u.schedules = schedules
+	if err := u.refreshCharmSchedules(ctx); err != nil {
+		return errors.Annotatef(err, "cannot read charm schedules")
+	}
+
+	deferredHooks, err := deferred.NewDeferredHooks(
+		ctx, u.unit, u.clock, u.logger.Child("deferred"),
+	)
+	if err != nil {
+		return errors.Annotatef(err, "cannot create deferred hook tracker")
+	}
+	u.deferredHooks = deferredHooks
+
 	if err := charm.ClearDownloads(u.paths.State.BundlesDir); err != nil {
 		u.logger.Warningf(stdcontext.TODO(), err.Error())
 	}
@@ -827,6 +867,7 @@ func (u *Uniter) init(ctx stdcontext.Context, unitTag names.UnitTag) (err error)
 		Resources:            u.resources,
 		Tracker:              u.leadershipTracker,
 		GetRelationInfos:     u.relationStateTracker.GetInfo,
+		Schedules:            u.schedules,
 		Paths:                u.paths,
 		Clock:                u.clock,
 		Logger:               u.logger.Child("context"),
@@ -907,15 +948,39 @@ func (u *Uniter) init(ctx stdcontext.Context, unitTag names.UnitTag) (err error)
 		if err := u.catacomb.Add(poller); err != nil {
 			return errors.Trace(err)
 		}
-		noticer := NewPebbleNoticer(u.logger, u.clock, u.containerNames, u.workloadEventChannel, u.workloadEvents, u.newPebbleClient)
+		noticer := NewPebbleNoticer(u.logger, u.clock, u.containerNames, u.workloadEventChannel, u.workloadEvents, u.unit, u.newPebbleClient)
 		if err := u.catacomb.Add(noticer); err != nil {
 			return errors.Trace(err)
 		}
 	}
 
+	u.scheduleChannel = make(chan string)
+	scheduleTimer := schedule.NewTimer(u.schedules, u.clock, u.scheduleChannel, u.logger.Child("schedule"))
+	if err := u.catacomb.Add(scheduleTimer); err != nil {
+		return errors.Trace(err)
+	}
+
+	u.deferredHookChannel = make(chan string)
+	deferredTimer := deferred.NewTimer(u.deferredHooks, u.clock, u.deferredHookChannel, u.logger.Child("deferred"))
+	if err := u.catacomb.Add(deferredTimer); err != nil {
+		return errors.Trace(err)
+	}
+
 	return nil
 }
 
+// refreshCharmSchedules updates the schedule tracker with the schedules
+// declared in the metadata of the deployed charm, if there is one.
+func (u *Uniter) refreshCharmSchedules(ctx stdcontext.Context) error {
+	meta, err := jujucharm.ReadCharmDirMetadata(u.paths.GetCharmDir())
+	if errors.Is(err, jujucharm.FileNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return u.schedules.SetCharmSchedules(ctx, meta.Schedules)
+}
+
 func (u *Uniter) Kill() {
 	u.catacomb.Kill(nil)
 }
@@ -986,6 +1051,12 @@ func (u *Uniter) reportHookError(ctx stdcontext.Context, hookInfo hook.Info) err
 	}
 	statusData["hook"] = hookName
 	statusMessage := fmt.Sprintf("hook failed: %q", hookMessage)
+	// A hook that was killed for running too long is reported distinctly,
+	// so that it isn't mistaken for the charm failing the hook.
+	if timeout := u.operationExecutor.State().HookTimeout; timeout > 0 {
+		statusData["timeout"] = timeout.String()
+		statusMessage = fmt.Sprintf("hook timed out after %v: %q", timeout, hookMessage)
+	}
 	return setAgentStatus(ctx, u, status.Error, statusMessage, statusData)
 }
 
@@ -1017,6 +1088,12 @@ func (u *Uniter) Report() map[string]interface{} {
 	if u.secretsTracker != nil {
 		result["secrets"] = u.secretsTracker.Report()
 	}
+	if u.schedules != nil {
+		result["schedules"] = u.schedules.Report()
+	}
+	if u.deferredHooks != nil {
+		result["deferred-hooks"] = u.deferredHooks.Report()
+	}
 
 	return result
 }
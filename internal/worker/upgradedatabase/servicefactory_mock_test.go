@@ -14,6 +14,7 @@ import (
 
 	service "github.com/juju/juju/domain/access/service"
 	service0 "github.com/juju/juju/domain/autocert/service"
+	service13 "github.com/juju/juju/domain/backup/service"
 	service1 "github.com/juju/juju/domain/cloud/service"
 	service2 "github.com/juju/juju/domain/controller/service"
 	service3 "github.com/juju/juju/domain/controllerconfig/service"
@@ -128,6 +129,44 @@ func (c *MockControllerDomainServicesAutocertCacheCall) DoAndReturn(f func() *se
 	return c
 }
 
+// Backup mocks base method.
+func (m *MockControllerDomainServices) Backup() *service13.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backup")
+	ret0, _ := ret[0].(*service13.Service)
+	return ret0
+}
+
+// Backup indicates an expected call of Backup.
+func (mr *MockControllerDomainServicesMockRecorder) Backup() *MockControllerDomainServicesBackupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockControllerDomainServices)(nil).Backup))
+	return &MockControllerDomainServicesBackupCall{Call: call}
+}
+
+// MockControllerDomainServicesBackupCall wrap *gomock.Call
+type MockControllerDomainServicesBackupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerDomainServicesBackupCall) Return(arg0 *service13.Service) *MockControllerDomainServicesBackupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerDomainServicesBackupCall) Do(f func() *service13.Service) *MockControllerDomainServicesBackupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerDomainServicesBackupCall) DoAndReturn(f func() *service13.Service) *MockControllerDomainServicesBackupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Cloud mocks base method.
 func (m *MockControllerDomainServices) Cloud() *service1.WatchableService {
 	m.ctrl.T.Helper()
@@ -17,6 +17,7 @@ import (
 
 	"github.com/juju/juju/agent"
 	coredatabase "github.com/juju/juju/core/database"
+	coreschema "github.com/juju/juju/core/database/schema"
 	"github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/upgrade"
@@ -455,6 +456,8 @@ func (w *upgradeDBWorker) upgradeController(ctx context.Context) error {
 		return errors.Annotatef(err, "applying controller schema")
 	}
 	w.logger.Infof(context.TODO(), "applied controller schema changes from: %d to: %d", changeSet.Post, changeSet.Current)
+
+	w.checkSchemaDrift(ctx, coredatabase.ControllerNS, ddl, db)
 	return nil
 }
 
@@ -487,9 +490,25 @@ func (w *upgradeDBWorker) upgradeModel(ctx context.Context, modelUUID coremodel.
 		return errors.Annotatef(err, "applying model schema %s", modelUUID)
 	}
 	w.logger.Infof(context.TODO(), "applied model schema changes from: %d to: %d for model %s", changeSet.Post, changeSet.Current, modelUUID)
+
+	w.checkSchemaDrift(ctx, modelUUID.String(), ddl, db)
 	return nil
 }
 
+// checkSchemaDrift reports any objects in the database that have drifted
+// from the schema, such as triggers that were lost in a failed upgrade. The
+// drift doesn't fail the upgrade, it can be repaired with the database REPL.
+func (w *upgradeDBWorker) checkSchemaDrift(ctx context.Context, namespace string, ddl *coreschema.Schema, db coredatabase.TxnRunner) {
+	report, err := ddl.CheckDrift(ctx, db)
+	if err != nil {
+		w.logger.Warningf(ctx, "checking schema drift for %q: %v", namespace, err)
+		return
+	}
+	for _, drift := range report.Drift {
+		w.logger.Warningf(ctx, "schema drift for %q: %s", namespace, drift)
+	}
+}
+
 func (w *upgradeDBWorker) scopedContext() (context.Context, context.CancelFunc) {
 	return context.WithCancel(w.catacomb.Context(context.Background()))
 }
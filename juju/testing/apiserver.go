@@ -701,6 +701,7 @@ func (s *stubObjectStoreGetter) GetObjectStore(ctx context.Context, namespace st
 		namespace,
 		internalobjectstore.WithRootDir(s.rootDir),
 		internalobjectstore.WithMetadataService(&stubMetadataService{services: services}),
+		internalobjectstore.WithBlobMetadataService(objectstoretesting.MemoryBlobMetadataService()),
 		internalobjectstore.WithClaimer(s.claimer),
 		internalobjectstore.WithLogger(internallogger.GetLogger("juju.objectstore")),
 	)
@@ -36,6 +36,7 @@ func NewObjectStoreWithMetadataService(c *gc.C, modelUUID string, metadataServic
 		// TODO (stickupkid): Swap this over to the real metadata service
 		// when all facades are moved across.
 		objectstore.WithMetadataService(metadataService),
+		objectstore.WithBlobMetadataService(objectstoretesting.MemoryBlobMetadataService()),
 		objectstore.WithClaimer(objectstoretesting.MemoryClaimer()),
 	)
 	c.Assert(err, jc.ErrorIsNil)
@@ -215,6 +215,7 @@ const (
 	CodeSecretBackendNotValid      = "secret backend not valid"
 	CodeAccessRequired             = "access required"
 	CodeAppShouldNotHaveUnits      = "application should not have units"
+	CodeModelChangesPruned         = "model changes pruned"
 
 	//
 	// Tag based error
@@ -550,3 +551,7 @@ func IsCodeDeadlineExceeded(err error) bool {
 func IsCodeAppShouldNotHaveUnits(err error) bool {
 	return ErrCode(err) == CodeAppShouldNotHaveUnits
 }
+
+func IsCodeModelChangesPruned(err error) bool {
+	return ErrCode(err) == CodeModelChangesPruned
+}
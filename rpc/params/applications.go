@@ -501,12 +501,40 @@ type UnitResult struct {
 	Leader          bool                   `json:"leader,omitempty"`
 	Life            string                 `json:"life,omitempty"`
 	RelationData    []EndpointRelationData `json:"relation-data,omitempty"`
+	Schedules       []UnitSchedule         `json:"schedules,omitempty"`
+	DeferredHooks   []UnitDeferredHook     `json:"deferred-hooks,omitempty"`
 
 	// The following are for CAAS models.
 	ProviderId string `json:"provider-id,omitempty"`
 	Address    string `json:"address,omitempty"`
 }
 
+// UnitSchedule holds a schedule on which a unit's schedule hook is run,
+// as last recorded by the unit agent.
+type UnitSchedule struct {
+	Name     string     `json:"name"`
+	Source   string     `json:"source"`
+	Cron     string     `json:"cron,omitempty"`
+	Interval string     `json:"interval,omitempty"`
+	Jitter   string     `json:"jitter,omitempty"`
+	CatchUp  string     `json:"catch-up,omitempty"`
+	LastRun  *time.Time `json:"last-run,omitempty"`
+	NextRun  *time.Time `json:"next-run,omitempty"`
+}
+
+// UnitDeferredHook holds a hook deferred by a unit's charm, as last
+// recorded by the unit agent.
+type UnitDeferredHook struct {
+	ID            string     `json:"id"`
+	Hook          string     `json:"hook"`
+	Reason        string     `json:"reason,omitempty"`
+	DeferredAt    time.Time  `json:"deferred-at"`
+	Deferrals     int        `json:"deferrals"`
+	RetryAt       *time.Time `json:"retry-at,omitempty"`
+	Ready         bool       `json:"ready,omitempty"`
+	DropOnUpgrade bool       `json:"drop-on-upgrade,omitempty"`
+}
+
 // UnitInfoResults holds an unit info result or a retrieval error.
 type UnitInfoResult struct {
 	Result *UnitResult `json:"result,omitempty"`
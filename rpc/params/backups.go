@@ -22,6 +22,18 @@ type BackupsDownloadArgs struct {
 	ID string `json:"id"`
 }
 
+// BackupsRestoreArgs holds the args for the API Restore method.
+type BackupsRestoreArgs struct {
+	ID string `json:"id"`
+}
+
+// BackupsUploadResult holds the result of uploading a backup archive to
+// the controller.
+type BackupsUploadResult struct {
+	ID    string `json:"id"`
+	Error *Error `json:"error,omitempty"`
+}
+
 // BackupsMetadataResult holds the metadata for a backup as returned by
 // an API backups method (such as Create).
 type BackupsMetadataResult struct {
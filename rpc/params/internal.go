@@ -270,6 +270,10 @@ type UnitStateResult struct {
 	StorageState string `json:"storage-state,omitempty"`
 	// SecretState is internal secret state for this unit.
 	SecretState string `json:"secret-state,omitempty"`
+	// ScheduleState is internal schedule state for this unit.
+	ScheduleState string `json:"schedule-state,omitempty"`
+	// DeferredState is internal deferred hook state for this unit.
+	DeferredState string `json:"deferred-state,omitempty"`
 }
 
 // UnitStateResults holds multiple unit state maps or errors.
@@ -297,6 +301,8 @@ type SetUnitStateArg struct {
 	RelationState *map[int]string    `json:"relation-state,omitempty"`
 	StorageState  *string            `json:"storage-state,omitempty"`
 	SecretState   *string            `json:"secret-state,omitempty"`
+	ScheduleState *string            `json:"schedule-state,omitempty"`
+	DeferredState *string            `json:"deferred-state,omitempty"`
 }
 
 // CommitHookChangesArgs serves as a container for CommitHookChangesArg objects
@@ -180,6 +180,19 @@ type ModelInfo struct {
 	// entries (e.g. juju version) and other features that depend on the
 	// substrate the model is deployed to.
 	SupportedFeatures []SupportedFeature `json:"supported-features,omitempty"`
+
+	// ObjectStoreUsage contains information about the storage used by the
+	// model's object store. It'll be nil if the usage isn't known.
+	ObjectStoreUsage *ObjectStoreUsage `json:"object-store-usage,omitempty"`
+}
+
+// ObjectStoreUsage describes the storage used by a model's object store.
+type ObjectStoreUsage struct {
+	// Objects is the number of distinct objects stored.
+	Objects int64 `json:"objects"`
+
+	// Size is the total size of the distinct objects stored, in bytes.
+	Size int64 `json:"size"`
 }
 
 // SupportedFeature describes a feature that is supported by a particular model.
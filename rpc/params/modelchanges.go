@@ -0,0 +1,70 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// ModelChangesArgs holds the arguments for reading changes from the model
+// change log.
+type ModelChangesArgs struct {
+	// Namespaces restricts the changes to those in the given namespaces,
+	// for example "application", "unit", "machine", "relation" or "secret".
+	// If empty, changes in all namespaces are returned.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ResumeToken is the token returned by a previous read, changes after
+	// it are returned. If empty, reading starts from the latest change.
+	ResumeToken string `json:"resume-token,omitempty"`
+
+	// Limit is the maximum number of changes to return.
+	Limit int `json:"limit,omitempty"`
+
+	// Wait indicates that the call should block until at least one change
+	// is available, or the wait times out.
+	Wait bool `json:"wait,omitempty"`
+
+	// Consumer names a durable consumer of the model changes. The controller
+	// retains the changes after the resume token of the consumer's last
+	// read, so that it can resume from it even if the changes would
+	// otherwise have been pruned. If empty, the changes are not retained.
+	Consumer string `json:"consumer,omitempty"`
+}
+
+// ModelChange describes a single change read from the model change log.
+type ModelChange struct {
+	// Token is the resume token of the change, it can be used to read the
+	// changes that occurred after it.
+	Token string `json:"token"`
+
+	// Namespace is the namespace that the change belongs to.
+	Namespace string `json:"namespace"`
+
+	// Entity is the name of the entity (table) that changed.
+	Entity string `json:"entity"`
+
+	// Type is the type of change, one of "create", "update" or "delete".
+	Type string `json:"type"`
+
+	// Changed identifies the entity that changed.
+	Changed string `json:"changed"`
+
+	// ChangedColumns are the columns that changed for an update, if known.
+	ChangedColumns []string `json:"changed-columns,omitempty"`
+
+	// Created is the time that the change was recorded.
+	Created time.Time `json:"created"`
+}
+
+// ModelChangesResult holds the result of reading changes from the model
+// change log.
+type ModelChangesResult struct {
+	// Changes are the changes that were read, in the order they occurred.
+	Changes []ModelChange `json:"changes"`
+
+	// ResumeToken should be passed to the next read to continue reading
+	// changes after the ones returned.
+	ResumeToken string `json:"resume-token"`
+
+	Error *Error `json:"error,omitempty"`
+}
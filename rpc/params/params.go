@@ -730,6 +730,9 @@ type StateServingInfo struct {
 	// this will be passed as the KeyFile argument to MongoDB
 	SharedSecret   string `json:"shared-secret"`
 	SystemIdentity string `json:"system-identity"`
+	// SecretContentKeys holds the keys which wrap the keys encrypting
+	// secret content stored in model databases.
+	SecretContentKeys []string `json:"secret-content-keys,omitempty"`
 }
 
 // IsMasterResult holds the result of an IsMaster API call.
@@ -0,0 +1,44 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// SetPebbleCheckStatusArg holds the status of a Pebble check in one of a
+// unit's workload containers.
+type SetPebbleCheckStatusArg struct {
+	Tag       string `json:"tag"`
+	Container string `json:"container"`
+	Check     string `json:"check"`
+
+	// Status is either "up" or "down".
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+
+	// Since is when the check entered the status.
+	Since time.Time `json:"since"`
+}
+
+// SetPebbleCheckStatusArgs holds the statuses of Pebble checks in units'
+// workload containers.
+type SetPebbleCheckStatusArgs struct {
+	Args []SetPebbleCheckStatusArg `json:"args"`
+}
+
+// PebbleCheckStatus holds the latest status of a Pebble check in one of a
+// unit's workload containers.
+type PebbleCheckStatus struct {
+	Container string `json:"container"`
+	Check     string `json:"check"`
+
+	// Status is either "up" or "down".
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+
+	// Critical indicates that the unit's workload is in error while the
+	// check is down, as configured by the pebble-critical-checks model
+	// configuration key.
+	Critical bool       `json:"critical,omitempty"`
+	Since    *time.Time `json:"since,omitempty"`
+}
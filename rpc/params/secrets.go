@@ -99,6 +99,8 @@ type CreateSecretArg struct {
 	URI *string `json:"uri,omitempty"`
 	// OwnerTag is the owner of the secret.
 	OwnerTag string `json:"owner-tag"`
+	// Type describes the structure of the secret content.
+	Type string `json:"type,omitempty"`
 }
 
 // UpdateSecretArgs holds args for updating secrets.
@@ -112,6 +114,19 @@ type UpdateSecretArg struct {
 
 	// URI identifies the secret to update.
 	URI string `json:"uri"`
+
+	// StageTimeout is set if the new content is to be staged, and is how
+	// long consumers have to track the new revision before the rotation
+	// expires.
+	StageTimeout *time.Duration `json:"stage-timeout,omitempty"`
+
+	// RollbackOnTimeout is set if the previous revision is to be
+	// restored when a staged rotation expires.
+	RollbackOnTimeout bool `json:"rollback-on-timeout,omitempty"`
+
+	// RollbackRevision is set to restore an existing
+	// revision as the latest revision of the secret.
+	RollbackRevision *int `json:"rollback-revision,omitempty"`
 }
 
 // UpdateUserSecretArgs holds args for updating user secrets.
@@ -133,6 +148,10 @@ type UpdateUserSecretArg struct {
 
 	// AutoPrune indicates whether the staled secret revisions should be pruned automatically.
 	AutoPrune *bool `json:"auto-prune,omitempty"`
+
+	// RollbackRevision is set to restore an existing
+	// revision as the latest revision of the secret.
+	RollbackRevision *int `json:"rollback-revision,omitempty"`
 }
 
 // Validate validates the UpdateUserSecretArg.
@@ -153,7 +172,8 @@ func (arg UpdateUserSecretArg) Validate() error {
 func (arg UpdateUserSecretArg) HasUpdate() bool {
 	return arg.AutoPrune != nil || arg.Description != nil || arg.Label != nil ||
 		arg.RotatePolicy != nil || arg.ExpireTime != nil ||
-		len(arg.Content.Data) != 0 || arg.Content.ValueRef != nil
+		len(arg.Content.Data) != 0 || arg.Content.ValueRef != nil ||
+		arg.RollbackRevision != nil
 }
 
 // DeleteSecretArgs holds args for deleting secrets.
@@ -251,8 +271,9 @@ type SecretsFilter struct {
 
 // ListSecretsArgs holds the args for listing secrets.
 type ListSecretsArgs struct {
-	ShowSecrets bool          `json:"show-secrets"`
-	Filter      SecretsFilter `json:"filter"`
+	ShowSecrets   bool          `json:"show-secrets"`
+	ShowAccessLog bool          `json:"show-access-log,omitempty"`
+	Filter        SecretsFilter `json:"filter"`
 }
 
 // ListSecretResults holds secret metadata results.
@@ -275,25 +296,57 @@ type SecretRevision struct {
 	CreateTime  time.Time       `json:"create-time,omitempty"`
 	UpdateTime  time.Time       `json:"update-time,omitempty"`
 	ExpireTime  *time.Time      `json:"expire-time,omitempty"`
+	// Stage is set if the revision was created by a staged rotation.
+	Stage *SecretRevisionStage `json:"stage,omitempty"`
+	// Rollback is set if the revision was restored by a rollback.
+	Rollback *SecretRevisionRollback `json:"rollback,omitempty"`
+	// PinnedApplications are the consuming applications
+	// pinned to the revision.
+	PinnedApplications []string `json:"pinned-applications,omitempty"`
+}
+
+// SecretRevisionStage holds the progress of a staged secret rotation.
+type SecretRevisionStage struct {
+	Status            string    `json:"status"`
+	PreviousRevision  int       `json:"previous-revision"`
+	Deadline          time.Time `json:"deadline"`
+	RollbackOnTimeout bool      `json:"rollback-on-timeout,omitempty"`
+}
+
+// SecretRevisionRollback holds the details of the rollback
+// which restored a secret revision.
+type SecretRevisionRollback struct {
+	RestoredFromRevision int       `json:"restored-from-revision"`
+	ReplacedRevision     int       `json:"replaced-revision"`
+	Time                 time.Time `json:"time"`
 }
 
 // ListSecretResult is the result of getting secret metadata.
 type ListSecretResult struct {
-	URI                    string             `json:"uri"`
-	Version                int                `json:"version"`
-	OwnerTag               string             `json:"owner-tag"`
-	RotatePolicy           string             `json:"rotate-policy,omitempty"`
-	NextRotateTime         *time.Time         `json:"next-rotate-time,omitempty"`
-	Description            string             `json:"description,omitempty"`
-	Label                  string             `json:"label,omitempty"`
-	LatestRevision         int                `json:"latest-revision"`
-	LatestRevisionChecksum string             `json:"latest-revision-checksum"`
-	LatestExpireTime       *time.Time         `json:"latest-expire-time,omitempty"`
-	CreateTime             time.Time          `json:"create-time"`
-	UpdateTime             time.Time          `json:"update-time"`
-	Revisions              []SecretRevision   `json:"revisions"`
-	Value                  *SecretValueResult `json:"value,omitempty"`
-	Access                 []AccessInfo       `json:"access,omitempty"`
+	URI                    string               `json:"uri"`
+	Version                int                  `json:"version"`
+	Type                   string               `json:"type,omitempty"`
+	OwnerTag               string               `json:"owner-tag"`
+	RotatePolicy           string               `json:"rotate-policy,omitempty"`
+	NextRotateTime         *time.Time           `json:"next-rotate-time,omitempty"`
+	Description            string               `json:"description,omitempty"`
+	Label                  string               `json:"label,omitempty"`
+	LatestRevision         int                  `json:"latest-revision"`
+	LatestRevisionChecksum string               `json:"latest-revision-checksum"`
+	LatestExpireTime       *time.Time           `json:"latest-expire-time,omitempty"`
+	CreateTime             time.Time            `json:"create-time"`
+	UpdateTime             time.Time            `json:"update-time"`
+	Revisions              []SecretRevision     `json:"revisions"`
+	Value                  *SecretValueResult   `json:"value,omitempty"`
+	Access                 []AccessInfo         `json:"access,omitempty"`
+	AccessLog              []SecretAccessRecord `json:"access-log,omitempty"`
+}
+
+// SecretAccessRecord holds a read of secret content.
+type SecretAccessRecord struct {
+	Revision    int       `json:"revision"`
+	AccessorTag string    `json:"accessor-tag"`
+	AccessTime  time.Time `json:"access-time"`
 }
 
 // SecretRevisionsToDrainResults holds secret revisions to drain results.
@@ -379,6 +432,28 @@ type GrantRevokeUserSecretArg struct {
 	Applications []string `json:"applications"`
 }
 
+// SecretRevisionPinArgs holds args for pinning consumers to secret revisions.
+type SecretRevisionPinArgs struct {
+	Args []SecretRevisionPinArg `json:"args"`
+}
+
+// SecretRevisionPinArg holds the args for pinning the units of a consuming
+// application to a secret revision, or removing such a pin.
+type SecretRevisionPinArg struct {
+	// Either URI or Label is required.
+
+	// URI identifies the secret.
+	URI string `json:"uri"`
+	// Label identifies the secret.
+	Label string `json:"label"`
+
+	// Application is the name of the consuming application.
+	Application string `json:"application"`
+	// Revision is the revision to pin the application to.
+	// It is ignored when removing a pin.
+	Revision int `json:"revision,omitempty"`
+}
+
 // ListSecretBackendsResults holds secret backend results.
 type ListSecretBackendsResults struct {
 	Results []SecretBackendResult `json:"results"`
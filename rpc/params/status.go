@@ -205,6 +205,10 @@ type UnitStatus struct {
 	// The following are for CAAS models.
 	ProviderId string `json:"provider-id,omitempty"`
 	Address    string `json:"address,omitempty"`
+
+	// PebbleChecks holds the statuses of the Pebble checks in the unit's
+	// workload containers.
+	PebbleChecks []PebbleCheckStatus `json:"pebble-checks,omitempty"`
 }
 
 // RelationStatus holds status info about a relation.
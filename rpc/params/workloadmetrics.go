@@ -0,0 +1,68 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import "time"
+
+// WorkloadMetric is a single sample of a workload metric published by a
+// charm with the metric-add hook tool.
+type WorkloadMetric struct {
+	// Key is the name of the metric.
+	Key string `json:"key"`
+
+	// Value is the value of the metric when it was sampled.
+	Value float64 `json:"value"`
+
+	// Labels further identify the series the sample belongs to.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Time is when the metric was sampled.
+	Time time.Time `json:"time"`
+}
+
+// WorkloadMetricsArg holds the workload metrics published by a unit.
+type WorkloadMetricsArg struct {
+	Tag     string           `json:"tag"`
+	Metrics []WorkloadMetric `json:"metrics"`
+}
+
+// WorkloadMetricsArgs holds the workload metrics published by units.
+type WorkloadMetricsArgs struct {
+	Args []WorkloadMetricsArg `json:"args"`
+}
+
+// UnitWorkloadMetric is a workload metric sample along with the name of the
+// unit which published it.
+type UnitWorkloadMetric struct {
+	Unit   string         `json:"unit"`
+	Metric WorkloadMetric `json:"metric"`
+}
+
+// WorkloadMetricsQuery identifies the application whose workload metrics
+// are requested.
+type WorkloadMetricsQuery struct {
+	ApplicationTag string `json:"application-tag"`
+
+	// History indicates that every retained sample is requested, rather
+	// than only the most recent sample of each series.
+	History bool `json:"history,omitempty"`
+}
+
+// WorkloadMetricsQueries holds the applications whose workload metrics are
+// requested.
+type WorkloadMetricsQueries struct {
+	Queries []WorkloadMetricsQuery `json:"queries"`
+}
+
+// WorkloadMetricsResult holds the workload metrics published by the units
+// of an application, or an error.
+type WorkloadMetricsResult struct {
+	Metrics []UnitWorkloadMetric `json:"metrics,omitempty"`
+	Error   *Error               `json:"error,omitempty"`
+}
+
+// WorkloadMetricsResults holds the results of a workload metrics query.
+type WorkloadMetricsResults struct {
+	Results []WorkloadMetricsResult `json:"results"`
+}
@@ -134,8 +134,9 @@ type stateServingInfo struct {
 	PrivateKey   string `bson:"privatekey"`
 	CAPrivateKey string `bson:"caprivatekey"`
 	// this will be passed as the KeyFile argument to MongoDB
-	SharedSecret   string `bson:"sharedsecret"`
-	SystemIdentity string `bson:"systemidentity"`
+	SharedSecret      string   `bson:"sharedsecret"`
+	SystemIdentity    string   `bson:"systemidentity"`
+	SecretContentKeys []string `bson:"secretcontentkeys,omitempty"`
 }
 
 // StateServingInfo returns information for running a controller machine
@@ -152,13 +153,14 @@ func (st *State) StateServingInfo() (jujucontroller.StateServingInfo, error) {
 		return jujucontroller.StateServingInfo{}, errors.NotFoundf("state serving info")
 	}
 	return jujucontroller.StateServingInfo{
-		APIPort:        info.APIPort,
-		StatePort:      info.StatePort,
-		Cert:           info.Cert,
-		PrivateKey:     info.PrivateKey,
-		CAPrivateKey:   info.CAPrivateKey,
-		SharedSecret:   info.SharedSecret,
-		SystemIdentity: info.SystemIdentity,
+		APIPort:           info.APIPort,
+		StatePort:         info.StatePort,
+		Cert:              info.Cert,
+		PrivateKey:        info.PrivateKey,
+		CAPrivateKey:      info.CAPrivateKey,
+		SharedSecret:      info.SharedSecret,
+		SystemIdentity:    info.SystemIdentity,
+		SecretContentKeys: info.SecretContentKeys,
 	}, nil
 }
 
@@ -180,13 +182,14 @@ func (st *State) SetStateServingInfo(info jujucontroller.StateServingInfo) error
 		C:  controllersC,
 		Id: stateServingInfoKey,
 		Update: bson.D{{"$set", stateServingInfo{
-			APIPort:        info.APIPort,
-			StatePort:      info.StatePort,
-			Cert:           info.Cert,
-			PrivateKey:     info.PrivateKey,
-			CAPrivateKey:   info.CAPrivateKey,
-			SharedSecret:   info.SharedSecret,
-			SystemIdentity: info.SystemIdentity,
+			APIPort:           info.APIPort,
+			StatePort:         info.StatePort,
+			Cert:              info.Cert,
+			PrivateKey:        info.PrivateKey,
+			CAPrivateKey:      info.CAPrivateKey,
+			SharedSecret:      info.SharedSecret,
+			SystemIdentity:    info.SystemIdentity,
+			SecretContentKeys: info.SecretContentKeys,
 		}}},
 	}}
 	if err := st.db().RunTransaction(ops); err != nil {
@@ -195,6 +198,36 @@ func (st *State) SetStateServingInfo(info jujucontroller.StateServingInfo) error
 	return nil
 }
 
+// EnsureSecretContentKeys stores the secret content keys in the state
+// serving info if it doesn't already have any, and returns the keys which
+// are stored. Controllers bootstrapped before secret content was encrypted
+// have no keys, and every controller must use the same keys, so only the
+// first keys stored are kept.
+func (st *State) EnsureSecretContentKeys(keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, errors.NotValidf("empty secret content keys")
+	}
+	ops := []txn.Op{{
+		C:      controllersC,
+		Id:     stateServingInfoKey,
+		Assert: bson.D{{"secretcontentkeys", bson.D{{"$exists", false}}}},
+		Update: bson.D{{"$set", bson.D{{"secretcontentkeys", keys}}}},
+	}}
+	err := st.db().RunTransaction(ops)
+	if err == nil {
+		return keys, nil
+	} else if !errors.Is(err, txn.ErrAborted) {
+		return nil, errors.Annotate(err, "cannot set secret content keys")
+	}
+
+	// The keys have already been stored, possibly by another controller.
+	info, err := st.StateServingInfo()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return info.SecretContentKeys, nil
+}
+
 // sshServerHostKeyKeyDocId holds the document ID to retrieve the
 // host key within the controller configuration collection.
 const sshServerHostKeyDocId = "sshServerHostKey"
@@ -1702,6 +1702,9 @@ func (m *Machine) PrepareActionPayload(name string, payload map[string]interface
 	if !ok {
 		return nil, false, "", errors.Errorf("cannot add action %q to a machine; only predefined actions allowed", name)
 	}
+	if actions.IsJujuReplayHookAction(name) {
+		return nil, false, "", errors.Errorf("cannot add action %q to a machine; hooks are only replayed on units", name)
+	}
 
 	// Reject bad payloads before attempting to insert defaults.
 	err := spec.ValidateParams(payload)
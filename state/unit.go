@@ -1365,6 +1365,29 @@ func (u *Unit) SetStatus(unitStatus status.StatusInfo) error {
 	})
 }
 
+// SetErrorStatus sets the workload status of the unit to error. Charms can't
+// set this status; it's set by the controller when the workload fails outside
+// of a hook, such as when a critical Pebble check is down.
+func (u *Unit) SetErrorStatus(unitStatus status.StatusInfo) error {
+	if unitStatus.Status != status.Error {
+		return errors.Errorf("cannot set status %q as error status", unitStatus.Status)
+	}
+	if unitStatus.Message == "" {
+		return errors.Errorf("cannot set status %q without info", unitStatus.Status)
+	}
+
+	return setStatus(u.st.db(), setStatusParams{
+		badge:      "unit",
+		statusKind: u.Kind(),
+		statusId:   u.Name(),
+		globalKey:  u.globalKey(),
+		status:     unitStatus.Status,
+		message:    unitStatus.Message,
+		rawData:    unitStatus.Data,
+		updated:    timeOrNow(unitStatus.Since, u.st.clock()),
+	})
+}
+
 // CharmURL returns the charm URL this unit is currently using.
 func (u *Unit) CharmURL() *string {
 	return u.doc.CharmURL